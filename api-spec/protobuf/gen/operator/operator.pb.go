@@ -76,6 +76,52 @@ func (StrategyType) EnumDescriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{0}
 }
 
+type ExportFormat int32
+
+const (
+	ExportFormat_CSV  ExportFormat = 0
+	ExportFormat_JSON ExportFormat = 1
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "CSV",
+		1: "JSON",
+	}
+	ExportFormat_value = map[string]int32{
+		"CSV":  0,
+		"JSON": 1,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_operator_proto_enumTypes[1].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_operator_proto_enumTypes[1]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{1}
+}
+
 type TradeStatus int32
 
 const (
@@ -85,6 +131,7 @@ const (
 	TradeStatus_COMPLETE  TradeStatus = 3
 	TradeStatus_SETTLED   TradeStatus = 4
 	TradeStatus_EXPIRED   TradeStatus = 5
+	TradeStatus_CANCELED  TradeStatus = 6
 )
 
 // Enum value maps for TradeStatus.
@@ -96,6 +143,7 @@ var (
 		3: "COMPLETE",
 		4: "SETTLED",
 		5: "EXPIRED",
+		6: "CANCELED",
 	}
 	TradeStatus_value = map[string]int32{
 		"UNDEFINED": 0,
@@ -104,6 +152,7 @@ var (
 		"COMPLETE":  3,
 		"SETTLED":   4,
 		"EXPIRED":   5,
+		"CANCELED":  6,
 	}
 )
 
@@ -118,11 +167,11 @@ func (x TradeStatus) String() string {
 }
 
 func (TradeStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_operator_proto_enumTypes[1].Descriptor()
+	return file_operator_proto_enumTypes[2].Descriptor()
 }
 
 func (TradeStatus) Type() protoreflect.EnumType {
-	return &file_operator_proto_enumTypes[1]
+	return &file_operator_proto_enumTypes[2]
 }
 
 func (x TradeStatus) Number() protoreflect.EnumNumber {
@@ -131,7 +180,7 @@ func (x TradeStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TradeStatus.Descriptor instead.
 func (TradeStatus) EnumDescriptor() ([]byte, []int) {
-	return file_operator_proto_rawDescGZIP(), []int{1}
+	return file_operator_proto_rawDescGZIP(), []int{2}
 }
 
 type DropMarketRequest struct {
@@ -219,14 +268,16 @@ func (*DropMarketReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{1}
 }
 
-type ListUtxosRequest struct {
+type ExcludeUtxoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Outpoint *TxOutpoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
 }
 
-func (x *ListUtxosRequest) Reset() {
-	*x = ListUtxosRequest{}
+func (x *ExcludeUtxoRequest) Reset() {
+	*x = ExcludeUtxoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -234,13 +285,13 @@ func (x *ListUtxosRequest) Reset() {
 	}
 }
 
-func (x *ListUtxosRequest) String() string {
+func (x *ExcludeUtxoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUtxosRequest) ProtoMessage() {}
+func (*ExcludeUtxoRequest) ProtoMessage() {}
 
-func (x *ListUtxosRequest) ProtoReflect() protoreflect.Message {
+func (x *ExcludeUtxoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -252,21 +303,26 @@ func (x *ListUtxosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUtxosRequest.ProtoReflect.Descriptor instead.
-func (*ListUtxosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExcludeUtxoRequest.ProtoReflect.Descriptor instead.
+func (*ExcludeUtxoRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{2}
 }
 
-type ListUtxosReply struct {
+func (x *ExcludeUtxoRequest) GetOutpoint() *TxOutpoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+type ExcludeUtxoReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	InfoPerAccount map[uint64]*UtxoInfoList `protobuf:"bytes,1,rep,name=info_per_account,json=infoPerAccount,proto3" json:"info_per_account,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ListUtxosReply) Reset() {
-	*x = ListUtxosReply{}
+func (x *ExcludeUtxoReply) Reset() {
+	*x = ExcludeUtxoReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -274,13 +330,13 @@ func (x *ListUtxosReply) Reset() {
 	}
 }
 
-func (x *ListUtxosReply) String() string {
+func (x *ExcludeUtxoReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUtxosReply) ProtoMessage() {}
+func (*ExcludeUtxoReply) ProtoMessage() {}
 
-func (x *ListUtxosReply) ProtoReflect() protoreflect.Message {
+func (x *ExcludeUtxoReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -292,30 +348,21 @@ func (x *ListUtxosReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUtxosReply.ProtoReflect.Descriptor instead.
-func (*ListUtxosReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExcludeUtxoReply.ProtoReflect.Descriptor instead.
+func (*ExcludeUtxoReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListUtxosReply) GetInfoPerAccount() map[uint64]*UtxoInfoList {
-	if x != nil {
-		return x.InfoPerAccount
-	}
-	return nil
-}
-
-type UtxoInfoList struct {
+type IncludeUtxoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Unspents []*UtxoInfo `protobuf:"bytes,1,rep,name=unspents,proto3" json:"unspents,omitempty"`
-	Spents   []*UtxoInfo `protobuf:"bytes,2,rep,name=spents,proto3" json:"spents,omitempty"`
-	Locks    []*UtxoInfo `protobuf:"bytes,3,rep,name=locks,proto3" json:"locks,omitempty"`
+	Outpoint *TxOutpoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
 }
 
-func (x *UtxoInfoList) Reset() {
-	*x = UtxoInfoList{}
+func (x *IncludeUtxoRequest) Reset() {
+	*x = IncludeUtxoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -323,13 +370,13 @@ func (x *UtxoInfoList) Reset() {
 	}
 }
 
-func (x *UtxoInfoList) String() string {
+func (x *IncludeUtxoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UtxoInfoList) ProtoMessage() {}
+func (*IncludeUtxoRequest) ProtoMessage() {}
 
-func (x *UtxoInfoList) ProtoReflect() protoreflect.Message {
+func (x *IncludeUtxoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -341,44 +388,26 @@ func (x *UtxoInfoList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UtxoInfoList.ProtoReflect.Descriptor instead.
-func (*UtxoInfoList) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncludeUtxoRequest.ProtoReflect.Descriptor instead.
+func (*IncludeUtxoRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UtxoInfoList) GetUnspents() []*UtxoInfo {
-	if x != nil {
-		return x.Unspents
-	}
-	return nil
-}
-
-func (x *UtxoInfoList) GetSpents() []*UtxoInfo {
-	if x != nil {
-		return x.Spents
-	}
-	return nil
-}
-
-func (x *UtxoInfoList) GetLocks() []*UtxoInfo {
+func (x *IncludeUtxoRequest) GetOutpoint() *TxOutpoint {
 	if x != nil {
-		return x.Locks
+		return x.Outpoint
 	}
 	return nil
 }
 
-type UtxoInfo struct {
+type IncludeUtxoReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Outpoint *TxOutpoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	Value    uint64      `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
-	Asset    string      `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
 }
 
-func (x *UtxoInfo) Reset() {
-	*x = UtxoInfo{}
+func (x *IncludeUtxoReply) Reset() {
+	*x = IncludeUtxoReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -386,13 +415,13 @@ func (x *UtxoInfo) Reset() {
 	}
 }
 
-func (x *UtxoInfo) String() string {
+func (x *IncludeUtxoReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UtxoInfo) ProtoMessage() {}
+func (*IncludeUtxoReply) ProtoMessage() {}
 
-func (x *UtxoInfo) ProtoReflect() protoreflect.Message {
+func (x *IncludeUtxoReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -404,40 +433,19 @@ func (x *UtxoInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UtxoInfo.ProtoReflect.Descriptor instead.
-func (*UtxoInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncludeUtxoReply.ProtoReflect.Descriptor instead.
+func (*IncludeUtxoReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UtxoInfo) GetOutpoint() *TxOutpoint {
-	if x != nil {
-		return x.Outpoint
-	}
-	return nil
-}
-
-func (x *UtxoInfo) GetValue() uint64 {
-	if x != nil {
-		return x.Value
-	}
-	return 0
-}
-
-func (x *UtxoInfo) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-type ReloadUtxosRequest struct {
+type ExportMarketsConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ReloadUtxosRequest) Reset() {
-	*x = ReloadUtxosRequest{}
+func (x *ExportMarketsConfigRequest) Reset() {
+	*x = ExportMarketsConfigRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -445,13 +453,13 @@ func (x *ReloadUtxosRequest) Reset() {
 	}
 }
 
-func (x *ReloadUtxosRequest) String() string {
+func (x *ExportMarketsConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReloadUtxosRequest) ProtoMessage() {}
+func (*ExportMarketsConfigRequest) ProtoMessage() {}
 
-func (x *ReloadUtxosRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportMarketsConfigRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -463,19 +471,22 @@ func (x *ReloadUtxosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReloadUtxosRequest.ProtoReflect.Descriptor instead.
-func (*ReloadUtxosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportMarketsConfigRequest.ProtoReflect.Descriptor instead.
+func (*ExportMarketsConfigRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{6}
 }
 
-type ReloadUtxosReply struct {
+type ExportMarketsConfigReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// JSON configuration of the markets.
+	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *ReloadUtxosReply) Reset() {
-	*x = ReloadUtxosReply{}
+func (x *ExportMarketsConfigReply) Reset() {
+	*x = ExportMarketsConfigReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -483,13 +494,13 @@ func (x *ReloadUtxosReply) Reset() {
 	}
 }
 
-func (x *ReloadUtxosReply) String() string {
+func (x *ExportMarketsConfigReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReloadUtxosReply) ProtoMessage() {}
+func (*ExportMarketsConfigReply) ProtoMessage() {}
 
-func (x *ReloadUtxosReply) ProtoReflect() protoreflect.Message {
+func (x *ExportMarketsConfigReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -501,22 +512,29 @@ func (x *ReloadUtxosReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReloadUtxosReply.ProtoReflect.Descriptor instead.
-func (*ReloadUtxosReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportMarketsConfigReply.ProtoReflect.Descriptor instead.
+func (*ExportMarketsConfigReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{7}
 }
 
-type DepositMarketRequest struct {
+func (x *ExportMarketsConfigReply) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+type ImportMarketsConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Market         *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`                                          // Market to get an address from. Could be empty.
-	NumOfAddresses int64         `protobuf:"varint,2,opt,name=num_of_addresses,json=numOfAddresses,proto3" json:"num_of_addresses,omitempty"` // Number of addresses to be derived for market.
+	// JSON configuration of the markets, as returned by ExportMarketsConfig.
+	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *DepositMarketRequest) Reset() {
-	*x = DepositMarketRequest{}
+func (x *ImportMarketsConfigRequest) Reset() {
+	*x = ImportMarketsConfigRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -524,13 +542,13 @@ func (x *DepositMarketRequest) Reset() {
 	}
 }
 
-func (x *DepositMarketRequest) String() string {
+func (x *ImportMarketsConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositMarketRequest) ProtoMessage() {}
+func (*ImportMarketsConfigRequest) ProtoMessage() {}
 
-func (x *DepositMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportMarketsConfigRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,35 +560,29 @@ func (x *DepositMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositMarketRequest.ProtoReflect.Descriptor instead.
-func (*DepositMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportMarketsConfigRequest.ProtoReflect.Descriptor instead.
+func (*ImportMarketsConfigRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DepositMarketRequest) GetMarket() *types.Market {
-	if x != nil {
-		return x.Market
-	}
-	return nil
-}
-
-func (x *DepositMarketRequest) GetNumOfAddresses() int64 {
+func (x *ImportMarketsConfigRequest) GetConfig() string {
 	if x != nil {
-		return x.NumOfAddresses
+		return x.Config
 	}
-	return 0
+	return ""
 }
 
-type DepositMarketReply struct {
+type ImportMarketsConfigReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The markets created, excluding those skipped because already existing.
+	CreatedMarkets []*types.Market `protobuf:"bytes,1,rep,name=created_markets,json=createdMarkets,proto3" json:"created_markets,omitempty"`
 }
 
-func (x *DepositMarketReply) Reset() {
-	*x = DepositMarketReply{}
+func (x *ImportMarketsConfigReply) Reset() {
+	*x = ImportMarketsConfigReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -578,13 +590,13 @@ func (x *DepositMarketReply) Reset() {
 	}
 }
 
-func (x *DepositMarketReply) String() string {
+func (x *ImportMarketsConfigReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositMarketReply) ProtoMessage() {}
+func (*ImportMarketsConfigReply) ProtoMessage() {}
 
-func (x *DepositMarketReply) ProtoReflect() protoreflect.Message {
+func (x *ImportMarketsConfigReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -596,28 +608,26 @@ func (x *DepositMarketReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositMarketReply.ProtoReflect.Descriptor instead.
-func (*DepositMarketReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportMarketsConfigReply.ProtoReflect.Descriptor instead.
+func (*ImportMarketsConfigReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DepositMarketReply) GetAddresses() []string {
+func (x *ImportMarketsConfigReply) GetCreatedMarkets() []*types.Market {
 	if x != nil {
-		return x.Addresses
+		return x.CreatedMarkets
 	}
 	return nil
 }
 
-type ListDepositMarketRequest struct {
+type ListUtxosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"` // Market to get an address from. Could be empty.
 }
 
-func (x *ListDepositMarketRequest) Reset() {
-	*x = ListDepositMarketRequest{}
+func (x *ListUtxosRequest) Reset() {
+	*x = ListUtxosRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -625,13 +635,13 @@ func (x *ListDepositMarketRequest) Reset() {
 	}
 }
 
-func (x *ListDepositMarketRequest) String() string {
+func (x *ListUtxosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDepositMarketRequest) ProtoMessage() {}
+func (*ListUtxosRequest) ProtoMessage() {}
 
-func (x *ListDepositMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *ListUtxosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -643,28 +653,21 @@ func (x *ListDepositMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDepositMarketRequest.ProtoReflect.Descriptor instead.
-func (*ListDepositMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUtxosRequest.ProtoReflect.Descriptor instead.
+func (*ListUtxosRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListDepositMarketRequest) GetMarket() *types.Market {
-	if x != nil {
-		return x.Market
-	}
-	return nil
-}
-
-type ListDepositMarketReply struct {
+type ListUtxosReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address []string `protobuf:"bytes,1,rep,name=address,proto3" json:"address,omitempty"`
+	InfoPerAccount map[uint64]*UtxoInfoList `protobuf:"bytes,1,rep,name=info_per_account,json=infoPerAccount,proto3" json:"info_per_account,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ListDepositMarketReply) Reset() {
-	*x = ListDepositMarketReply{}
+func (x *ListUtxosReply) Reset() {
+	*x = ListUtxosReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -672,13 +675,13 @@ func (x *ListDepositMarketReply) Reset() {
 	}
 }
 
-func (x *ListDepositMarketReply) String() string {
+func (x *ListUtxosReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDepositMarketReply) ProtoMessage() {}
+func (*ListUtxosReply) ProtoMessage() {}
 
-func (x *ListDepositMarketReply) ProtoReflect() protoreflect.Message {
+func (x *ListUtxosReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -690,28 +693,34 @@ func (x *ListDepositMarketReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDepositMarketReply.ProtoReflect.Descriptor instead.
-func (*ListDepositMarketReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUtxosReply.ProtoReflect.Descriptor instead.
+func (*ListUtxosReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListDepositMarketReply) GetAddress() []string {
+func (x *ListUtxosReply) GetInfoPerAccount() map[uint64]*UtxoInfoList {
 	if x != nil {
-		return x.Address
+		return x.InfoPerAccount
 	}
 	return nil
 }
 
-type DepositFeeAccountRequest struct {
+type ListUtxosForAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumOfAddresses int64 `protobuf:"varint,1,opt,name=num_of_addresses,json=numOfAddresses,proto3" json:"num_of_addresses,omitempty"` // Number of addresses to be derived for Fee Account.
+	AccountIndex uint64 `protobuf:"varint,1,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+	// If not empty, only the utxos of this asset are returned.
+	Asset string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	// The lowest value of the utxos returned.
+	MinValue uint64 `protobuf:"varint,3,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	// Whether to exclude unconfirmed utxos.
+	ConfirmedOnly bool `protobuf:"varint,4,opt,name=confirmed_only,json=confirmedOnly,proto3" json:"confirmed_only,omitempty"`
 }
 
-func (x *DepositFeeAccountRequest) Reset() {
-	*x = DepositFeeAccountRequest{}
+func (x *ListUtxosForAccountRequest) Reset() {
+	*x = ListUtxosForAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -719,13 +728,13 @@ func (x *DepositFeeAccountRequest) Reset() {
 	}
 }
 
-func (x *DepositFeeAccountRequest) String() string {
+func (x *ListUtxosForAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositFeeAccountRequest) ProtoMessage() {}
+func (*ListUtxosForAccountRequest) ProtoMessage() {}
 
-func (x *DepositFeeAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *ListUtxosForAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -737,28 +746,49 @@ func (x *DepositFeeAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositFeeAccountRequest.ProtoReflect.Descriptor instead.
-func (*DepositFeeAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUtxosForAccountRequest.ProtoReflect.Descriptor instead.
+func (*ListUtxosForAccountRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DepositFeeAccountRequest) GetNumOfAddresses() int64 {
+func (x *ListUtxosForAccountRequest) GetAccountIndex() uint64 {
 	if x != nil {
-		return x.NumOfAddresses
+		return x.AccountIndex
 	}
 	return 0
 }
 
-type DepositFeeAccountReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ListUtxosForAccountRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
 
-	AddressWithBlindingKey []*types.AddressWithBlindingKey `protobuf:"bytes,1,rep,name=address_with_blinding_key,json=addressWithBlindingKey,proto3" json:"address_with_blinding_key,omitempty"` // AddressInfo contains address and blinding key
+func (x *ListUtxosForAccountRequest) GetMinValue() uint64 {
+	if x != nil {
+		return x.MinValue
+	}
+	return 0
 }
 
-func (x *DepositFeeAccountReply) Reset() {
-	*x = DepositFeeAccountReply{}
+func (x *ListUtxosForAccountRequest) GetConfirmedOnly() bool {
+	if x != nil {
+		return x.ConfirmedOnly
+	}
+	return false
+}
+
+type ListUtxosForAccountReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info *UtxoInfoList `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *ListUtxosForAccountReply) Reset() {
+	*x = ListUtxosForAccountReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -766,13 +796,13 @@ func (x *DepositFeeAccountReply) Reset() {
 	}
 }
 
-func (x *DepositFeeAccountReply) String() string {
+func (x *ListUtxosForAccountReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositFeeAccountReply) ProtoMessage() {}
+func (*ListUtxosForAccountReply) ProtoMessage() {}
 
-func (x *DepositFeeAccountReply) ProtoReflect() protoreflect.Message {
+func (x *ListUtxosForAccountReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -784,26 +814,26 @@ func (x *DepositFeeAccountReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositFeeAccountReply.ProtoReflect.Descriptor instead.
-func (*DepositFeeAccountReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUtxosForAccountReply.ProtoReflect.Descriptor instead.
+func (*ListUtxosForAccountReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DepositFeeAccountReply) GetAddressWithBlindingKey() []*types.AddressWithBlindingKey {
+func (x *ListUtxosForAccountReply) GetInfo() *UtxoInfoList {
 	if x != nil {
-		return x.AddressWithBlindingKey
+		return x.Info
 	}
 	return nil
 }
 
-type BalanceFeeAccountRequest struct {
+type ListLockedUtxosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *BalanceFeeAccountRequest) Reset() {
-	*x = BalanceFeeAccountRequest{}
+func (x *ListLockedUtxosRequest) Reset() {
+	*x = ListLockedUtxosRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -811,13 +841,13 @@ func (x *BalanceFeeAccountRequest) Reset() {
 	}
 }
 
-func (x *BalanceFeeAccountRequest) String() string {
+func (x *ListLockedUtxosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BalanceFeeAccountRequest) ProtoMessage() {}
+func (*ListLockedUtxosRequest) ProtoMessage() {}
 
-func (x *BalanceFeeAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *ListLockedUtxosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -829,21 +859,21 @@ func (x *BalanceFeeAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BalanceFeeAccountRequest.ProtoReflect.Descriptor instead.
-func (*BalanceFeeAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListLockedUtxosRequest.ProtoReflect.Descriptor instead.
+func (*ListLockedUtxosRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{14}
 }
 
-type BalanceFeeAccountReply struct {
+type ListLockedUtxosReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Locks []*UtxoInfo `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
 }
 
-func (x *BalanceFeeAccountReply) Reset() {
-	*x = BalanceFeeAccountReply{}
+func (x *ListLockedUtxosReply) Reset() {
+	*x = ListLockedUtxosReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -851,13 +881,13 @@ func (x *BalanceFeeAccountReply) Reset() {
 	}
 }
 
-func (x *BalanceFeeAccountReply) String() string {
+func (x *ListLockedUtxosReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BalanceFeeAccountReply) ProtoMessage() {}
+func (*ListLockedUtxosReply) ProtoMessage() {}
 
-func (x *BalanceFeeAccountReply) ProtoReflect() protoreflect.Message {
+func (x *ListLockedUtxosReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -869,26 +899,28 @@ func (x *BalanceFeeAccountReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BalanceFeeAccountReply.ProtoReflect.Descriptor instead.
-func (*BalanceFeeAccountReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListLockedUtxosReply.ProtoReflect.Descriptor instead.
+func (*ListLockedUtxosReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *BalanceFeeAccountReply) GetBalance() int64 {
+func (x *ListLockedUtxosReply) GetLocks() []*UtxoInfo {
 	if x != nil {
-		return x.Balance
+		return x.Locks
 	}
-	return 0
+	return nil
 }
 
-type ListMarketRequest struct {
+type ListPendingDepositsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AccountIndex uint64 `protobuf:"varint,1,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
 }
 
-func (x *ListMarketRequest) Reset() {
-	*x = ListMarketRequest{}
+func (x *ListPendingDepositsRequest) Reset() {
+	*x = ListPendingDepositsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -896,13 +928,13 @@ func (x *ListMarketRequest) Reset() {
 	}
 }
 
-func (x *ListMarketRequest) String() string {
+func (x *ListPendingDepositsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMarketRequest) ProtoMessage() {}
+func (*ListPendingDepositsRequest) ProtoMessage() {}
 
-func (x *ListMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *ListPendingDepositsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,21 +946,28 @@ func (x *ListMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMarketRequest.ProtoReflect.Descriptor instead.
-func (*ListMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPendingDepositsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingDepositsRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{16}
 }
 
-type ListMarketReply struct {
+func (x *ListPendingDepositsRequest) GetAccountIndex() uint64 {
+	if x != nil {
+		return x.AccountIndex
+	}
+	return 0
+}
+
+type ListPendingDepositsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Markets []*MarketInfo `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	Deposits []*PendingDeposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
 }
 
-func (x *ListMarketReply) Reset() {
-	*x = ListMarketReply{}
+func (x *ListPendingDepositsReply) Reset() {
+	*x = ListPendingDepositsReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -936,13 +975,13 @@ func (x *ListMarketReply) Reset() {
 	}
 }
 
-func (x *ListMarketReply) String() string {
+func (x *ListPendingDepositsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMarketReply) ProtoMessage() {}
+func (*ListPendingDepositsReply) ProtoMessage() {}
 
-func (x *ListMarketReply) ProtoReflect() protoreflect.Message {
+func (x *ListPendingDepositsReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -954,29 +993,37 @@ func (x *ListMarketReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMarketReply.ProtoReflect.Descriptor instead.
-func (*ListMarketReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPendingDepositsReply.ProtoReflect.Descriptor instead.
+func (*ListPendingDepositsReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListMarketReply) GetMarkets() []*MarketInfo {
+func (x *ListPendingDepositsReply) GetDeposits() []*PendingDeposit {
 	if x != nil {
-		return x.Markets
+		return x.Deposits
 	}
 	return nil
 }
 
-type ClaimMarketDepositRequest struct {
+type PendingDeposit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Market    *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
-	Outpoints []*TxOutpoint `protobuf:"bytes,2,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	Outpoint *TxOutpoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	Value    uint64      `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Asset    string      `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	// The address of the account receiving the deposit
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// Whether the tx of the deposit is still in mempool. It's false if the tx
+	// has been confirmed since the utxo set of the daemon was last synced
+	Pending bool `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
+	// The number of confirmations of the tx, 0 while pending
+	Confirmations uint64 `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
 }
 
-func (x *ClaimMarketDepositRequest) Reset() {
-	*x = ClaimMarketDepositRequest{}
+func (x *PendingDeposit) Reset() {
+	*x = PendingDeposit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -984,13 +1031,13 @@ func (x *ClaimMarketDepositRequest) Reset() {
 	}
 }
 
-func (x *ClaimMarketDepositRequest) String() string {
+func (x *PendingDeposit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimMarketDepositRequest) ProtoMessage() {}
+func (*PendingDeposit) ProtoMessage() {}
 
-func (x *ClaimMarketDepositRequest) ProtoReflect() protoreflect.Message {
+func (x *PendingDeposit) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1002,33 +1049,65 @@ func (x *ClaimMarketDepositRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimMarketDepositRequest.ProtoReflect.Descriptor instead.
-func (*ClaimMarketDepositRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PendingDeposit.ProtoReflect.Descriptor instead.
+func (*PendingDeposit) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ClaimMarketDepositRequest) GetMarket() *types.Market {
+func (x *PendingDeposit) GetOutpoint() *TxOutpoint {
 	if x != nil {
-		return x.Market
+		return x.Outpoint
 	}
 	return nil
 }
 
-func (x *ClaimMarketDepositRequest) GetOutpoints() []*TxOutpoint {
+func (x *PendingDeposit) GetValue() uint64 {
 	if x != nil {
-		return x.Outpoints
+		return x.Value
 	}
-	return nil
+	return 0
 }
 
-type ClaimMarketDepositReply struct {
+func (x *PendingDeposit) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *PendingDeposit) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PendingDeposit) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *PendingDeposit) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+type UtxoInfoList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Unspents []*UtxoInfo `protobuf:"bytes,1,rep,name=unspents,proto3" json:"unspents,omitempty"`
+	Spents   []*UtxoInfo `protobuf:"bytes,2,rep,name=spents,proto3" json:"spents,omitempty"`
+	Locks    []*UtxoInfo `protobuf:"bytes,3,rep,name=locks,proto3" json:"locks,omitempty"`
 }
 
-func (x *ClaimMarketDepositReply) Reset() {
-	*x = ClaimMarketDepositReply{}
+func (x *UtxoInfoList) Reset() {
+	*x = UtxoInfoList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1036,13 +1115,13 @@ func (x *ClaimMarketDepositReply) Reset() {
 	}
 }
 
-func (x *ClaimMarketDepositReply) String() string {
+func (x *UtxoInfoList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimMarketDepositReply) ProtoMessage() {}
+func (*UtxoInfoList) ProtoMessage() {}
 
-func (x *ClaimMarketDepositReply) ProtoReflect() protoreflect.Message {
+func (x *UtxoInfoList) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1054,21 +1133,50 @@ func (x *ClaimMarketDepositReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimMarketDepositReply.ProtoReflect.Descriptor instead.
-func (*ClaimMarketDepositReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use UtxoInfoList.ProtoReflect.Descriptor instead.
+func (*UtxoInfoList) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{19}
 }
 
-type ClaimFeeDepositRequest struct {
+func (x *UtxoInfoList) GetUnspents() []*UtxoInfo {
+	if x != nil {
+		return x.Unspents
+	}
+	return nil
+}
+
+func (x *UtxoInfoList) GetSpents() []*UtxoInfo {
+	if x != nil {
+		return x.Spents
+	}
+	return nil
+}
+
+func (x *UtxoInfoList) GetLocks() []*UtxoInfo {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+type UtxoInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Outpoints []*TxOutpoint `protobuf:"bytes,1,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	Outpoint *TxOutpoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	Value    uint64      `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Asset    string      `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	// The id of the trade holding the lock, if the utxo is locked.
+	TradeId string `protobuf:"bytes,4,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	// The Unix time the lock was acquired. Returned only by ListLockedUtxos.
+	LockTimestamp uint64 `protobuf:"varint,5,opt,name=lock_timestamp,json=lockTimestamp,proto3" json:"lock_timestamp,omitempty"`
+	// Whether the utxo is excluded from the coin selection.
+	Excluded bool `protobuf:"varint,6,opt,name=excluded,proto3" json:"excluded,omitempty"`
 }
 
-func (x *ClaimFeeDepositRequest) Reset() {
-	*x = ClaimFeeDepositRequest{}
+func (x *UtxoInfo) Reset() {
+	*x = UtxoInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1076,13 +1184,13 @@ func (x *ClaimFeeDepositRequest) Reset() {
 	}
 }
 
-func (x *ClaimFeeDepositRequest) String() string {
+func (x *UtxoInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimFeeDepositRequest) ProtoMessage() {}
+func (*UtxoInfo) ProtoMessage() {}
 
-func (x *ClaimFeeDepositRequest) ProtoReflect() protoreflect.Message {
+func (x *UtxoInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1094,26 +1202,61 @@ func (x *ClaimFeeDepositRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimFeeDepositRequest.ProtoReflect.Descriptor instead.
-func (*ClaimFeeDepositRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UtxoInfo.ProtoReflect.Descriptor instead.
+func (*UtxoInfo) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ClaimFeeDepositRequest) GetOutpoints() []*TxOutpoint {
+func (x *UtxoInfo) GetOutpoint() *TxOutpoint {
 	if x != nil {
-		return x.Outpoints
+		return x.Outpoint
 	}
 	return nil
 }
 
-type ClaimFeeDepositReply struct {
+func (x *UtxoInfo) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *UtxoInfo) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *UtxoInfo) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *UtxoInfo) GetLockTimestamp() uint64 {
+	if x != nil {
+		return x.LockTimestamp
+	}
+	return 0
+}
+
+func (x *UtxoInfo) GetExcluded() bool {
+	if x != nil {
+		return x.Excluded
+	}
+	return false
+}
+
+type ReloadUtxosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ClaimFeeDepositReply) Reset() {
-	*x = ClaimFeeDepositReply{}
+func (x *ReloadUtxosRequest) Reset() {
+	*x = ReloadUtxosRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1121,13 +1264,13 @@ func (x *ClaimFeeDepositReply) Reset() {
 	}
 }
 
-func (x *ClaimFeeDepositReply) String() string {
+func (x *ReloadUtxosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimFeeDepositReply) ProtoMessage() {}
+func (*ReloadUtxosRequest) ProtoMessage() {}
 
-func (x *ClaimFeeDepositReply) ProtoReflect() protoreflect.Message {
+func (x *ReloadUtxosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1139,21 +1282,19 @@ func (x *ClaimFeeDepositReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimFeeDepositReply.ProtoReflect.Descriptor instead.
-func (*ClaimFeeDepositReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReloadUtxosRequest.ProtoReflect.Descriptor instead.
+func (*ReloadUtxosRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{21}
 }
 
-type OpenMarketRequest struct {
+type ReloadUtxosReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"` // Market to be made tradable
 }
 
-func (x *OpenMarketRequest) Reset() {
-	*x = OpenMarketRequest{}
+func (x *ReloadUtxosReply) Reset() {
+	*x = ReloadUtxosReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1161,13 +1302,13 @@ func (x *OpenMarketRequest) Reset() {
 	}
 }
 
-func (x *OpenMarketRequest) String() string {
+func (x *ReloadUtxosReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenMarketRequest) ProtoMessage() {}
+func (*ReloadUtxosReply) ProtoMessage() {}
 
-func (x *OpenMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *ReloadUtxosReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1179,26 +1320,21 @@ func (x *OpenMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenMarketRequest.ProtoReflect.Descriptor instead.
-func (*OpenMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReloadUtxosReply.ProtoReflect.Descriptor instead.
+func (*ReloadUtxosReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *OpenMarketRequest) GetMarket() *types.Market {
-	if x != nil {
-		return x.Market
-	}
-	return nil
-}
-
-type OpenMarketReply struct {
+type RescanAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AccountIndex uint64 `protobuf:"varint,1,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
 }
 
-func (x *OpenMarketReply) Reset() {
-	*x = OpenMarketReply{}
+func (x *RescanAccountRequest) Reset() {
+	*x = RescanAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1206,13 +1342,13 @@ func (x *OpenMarketReply) Reset() {
 	}
 }
 
-func (x *OpenMarketReply) String() string {
+func (x *RescanAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenMarketReply) ProtoMessage() {}
+func (*RescanAccountRequest) ProtoMessage() {}
 
-func (x *OpenMarketReply) ProtoReflect() protoreflect.Message {
+func (x *RescanAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1224,21 +1360,31 @@ func (x *OpenMarketReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenMarketReply.ProtoReflect.Descriptor instead.
-func (*OpenMarketReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RescanAccountRequest.ProtoReflect.Descriptor instead.
+func (*RescanAccountRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{23}
 }
 
-type CloseMarketRequest struct {
+func (x *RescanAccountRequest) GetAccountIndex() uint64 {
+	if x != nil {
+		return x.AccountIndex
+	}
+	return 0
+}
+
+type RescanAccountReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"` // Market to be made NOT tradable
+	// Number of unspents found on-chain and added to the utxo set.
+	AddedUnspents uint64 `protobuf:"varint,1,opt,name=added_unspents,json=addedUnspents,proto3" json:"added_unspents,omitempty"`
+	// Number of stored unspents marked as spent.
+	SpentUnspents uint64 `protobuf:"varint,2,opt,name=spent_unspents,json=spentUnspents,proto3" json:"spent_unspents,omitempty"`
 }
 
-func (x *CloseMarketRequest) Reset() {
-	*x = CloseMarketRequest{}
+func (x *RescanAccountReply) Reset() {
+	*x = RescanAccountReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1246,13 +1392,13 @@ func (x *CloseMarketRequest) Reset() {
 	}
 }
 
-func (x *CloseMarketRequest) String() string {
+func (x *RescanAccountReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseMarketRequest) ProtoMessage() {}
+func (*RescanAccountReply) ProtoMessage() {}
 
-func (x *CloseMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *RescanAccountReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1264,26 +1410,35 @@ func (x *CloseMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseMarketRequest.ProtoReflect.Descriptor instead.
-func (*CloseMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RescanAccountReply.ProtoReflect.Descriptor instead.
+func (*RescanAccountReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CloseMarketRequest) GetMarket() *types.Market {
+func (x *RescanAccountReply) GetAddedUnspents() uint64 {
 	if x != nil {
-		return x.Market
+		return x.AddedUnspents
 	}
-	return nil
+	return 0
 }
 
-type CloseMarketReply struct {
+func (x *RescanAccountReply) GetSpentUnspents() uint64 {
+	if x != nil {
+		return x.SpentUnspents
+	}
+	return 0
+}
+
+type RescanAccountDryRunRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AccountIndex uint64 `protobuf:"varint,1,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
 }
 
-func (x *CloseMarketReply) Reset() {
-	*x = CloseMarketReply{}
+func (x *RescanAccountDryRunRequest) Reset() {
+	*x = RescanAccountDryRunRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1291,13 +1446,13 @@ func (x *CloseMarketReply) Reset() {
 	}
 }
 
-func (x *CloseMarketReply) String() string {
+func (x *RescanAccountDryRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseMarketReply) ProtoMessage() {}
+func (*RescanAccountDryRunRequest) ProtoMessage() {}
 
-func (x *CloseMarketReply) ProtoReflect() protoreflect.Message {
+func (x *RescanAccountDryRunRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1309,27 +1464,31 @@ func (x *CloseMarketReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseMarketReply.ProtoReflect.Descriptor instead.
-func (*CloseMarketReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RescanAccountDryRunRequest.ProtoReflect.Descriptor instead.
+func (*RescanAccountDryRunRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{25}
 }
 
-type UpdateMarketStrategyRequest struct {
+func (x *RescanAccountDryRunRequest) GetAccountIndex() uint64 {
+	if x != nil {
+		return x.AccountIndex
+	}
+	return 0
+}
+
+type RescanAccountDryRunReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Market to change the strategy of
-	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
-	// Market making strategy to use
-	StrategyType StrategyType `protobuf:"varint,2,opt,name=strategy_type,json=strategyType,proto3,enum=StrategyType" json:"strategy_type,omitempty"`
-	//
-	//JSON string representing additional metadata relative to the chosen strategy.
-	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Unspents found on-chain but missing from the utxo set.
+	UnspentsToAdd []*UtxoInfo `protobuf:"bytes,1,rep,name=unspents_to_add,json=unspentsToAdd,proto3" json:"unspents_to_add,omitempty"`
+	// Stored unspents no longer found on-chain.
+	UnspentsToSpend []*UtxoInfo `protobuf:"bytes,2,rep,name=unspents_to_spend,json=unspentsToSpend,proto3" json:"unspents_to_spend,omitempty"`
 }
 
-func (x *UpdateMarketStrategyRequest) Reset() {
-	*x = UpdateMarketStrategyRequest{}
+func (x *RescanAccountDryRunReply) Reset() {
+	*x = RescanAccountDryRunReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1337,13 +1496,13 @@ func (x *UpdateMarketStrategyRequest) Reset() {
 	}
 }
 
-func (x *UpdateMarketStrategyRequest) String() string {
+func (x *RescanAccountDryRunReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMarketStrategyRequest) ProtoMessage() {}
+func (*RescanAccountDryRunReply) ProtoMessage() {}
 
-func (x *UpdateMarketStrategyRequest) ProtoReflect() protoreflect.Message {
+func (x *RescanAccountDryRunReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1355,40 +1514,33 @@ func (x *UpdateMarketStrategyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMarketStrategyRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMarketStrategyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RescanAccountDryRunReply.ProtoReflect.Descriptor instead.
+func (*RescanAccountDryRunReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateMarketStrategyRequest) GetMarket() *types.Market {
+func (x *RescanAccountDryRunReply) GetUnspentsToAdd() []*UtxoInfo {
 	if x != nil {
-		return x.Market
+		return x.UnspentsToAdd
 	}
 	return nil
 }
 
-func (x *UpdateMarketStrategyRequest) GetStrategyType() StrategyType {
-	if x != nil {
-		return x.StrategyType
-	}
-	return StrategyType_PLUGGABLE
-}
-
-func (x *UpdateMarketStrategyRequest) GetMetadata() string {
+func (x *RescanAccountDryRunReply) GetUnspentsToSpend() []*UtxoInfo {
 	if x != nil {
-		return x.Metadata
+		return x.UnspentsToSpend
 	}
-	return ""
+	return nil
 }
 
-type UpdateMarketStrategyReply struct {
+type GetInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UpdateMarketStrategyReply) Reset() {
-	*x = UpdateMarketStrategyReply{}
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1396,13 +1548,13 @@ func (x *UpdateMarketStrategyReply) Reset() {
 	}
 }
 
-func (x *UpdateMarketStrategyReply) String() string {
+func (x *GetInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMarketStrategyReply) ProtoMessage() {}
+func (*GetInfoRequest) ProtoMessage() {}
 
-func (x *UpdateMarketStrategyReply) ProtoReflect() protoreflect.Message {
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1414,22 +1566,28 @@ func (x *UpdateMarketStrategyReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMarketStrategyReply.ProtoReflect.Descriptor instead.
-func (*UpdateMarketStrategyReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{27}
 }
 
-type UpdateMarketPercentageFeeRequest struct {
+type GetInfoReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Market     *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
-	BasisPoint int64         `protobuf:"varint,2,opt,name=basis_point,json=basisPoint,proto3" json:"basis_point,omitempty"`
+	Version   string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit    string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	BuildDate string `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	// One of liquid, testnet or regtest
+	Network       string `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	StartTimeUnix uint64 `protobuf:"varint,5,opt,name=start_time_unix,json=startTimeUnix,proto3" json:"start_time_unix,omitempty"`
+	UptimeSeconds uint64 `protobuf:"varint,6,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	NumOfMarkets  uint64 `protobuf:"varint,7,opt,name=num_of_markets,json=numOfMarkets,proto3" json:"num_of_markets,omitempty"`
 }
 
-func (x *UpdateMarketPercentageFeeRequest) Reset() {
-	*x = UpdateMarketPercentageFeeRequest{}
+func (x *GetInfoReply) Reset() {
+	*x = GetInfoReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1437,13 +1595,13 @@ func (x *UpdateMarketPercentageFeeRequest) Reset() {
 	}
 }
 
-func (x *UpdateMarketPercentageFeeRequest) String() string {
+func (x *GetInfoReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMarketPercentageFeeRequest) ProtoMessage() {}
+func (*GetInfoReply) ProtoMessage() {}
 
-func (x *UpdateMarketPercentageFeeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetInfoReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1455,36 +1613,71 @@ func (x *UpdateMarketPercentageFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMarketPercentageFeeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMarketPercentageFeeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInfoReply.ProtoReflect.Descriptor instead.
+func (*GetInfoReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UpdateMarketPercentageFeeRequest) GetMarket() *types.Market {
+func (x *GetInfoReply) GetVersion() string {
 	if x != nil {
-		return x.Market
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateMarketPercentageFeeRequest) GetBasisPoint() int64 {
+func (x *GetInfoReply) GetCommit() string {
 	if x != nil {
-		return x.BasisPoint
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *GetInfoReply) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+func (x *GetInfoReply) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *GetInfoReply) GetStartTimeUnix() uint64 {
+	if x != nil {
+		return x.StartTimeUnix
 	}
 	return 0
 }
 
-type UpdateMarketFixedFeeRequest struct {
+func (x *GetInfoReply) GetUptimeSeconds() uint64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *GetInfoReply) GetNumOfMarkets() uint64 {
+	if x != nil {
+		return x.NumOfMarkets
+	}
+	return 0
+}
+
+type DepositMarketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
-	Fixed  *types.Fixed  `protobuf:"bytes,2,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	Market         *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`                                          // Market to get an address from. Could be empty.
+	NumOfAddresses int64         `protobuf:"varint,2,opt,name=num_of_addresses,json=numOfAddresses,proto3" json:"num_of_addresses,omitempty"` // Number of addresses to be derived for market.
 }
 
-func (x *UpdateMarketFixedFeeRequest) Reset() {
-	*x = UpdateMarketFixedFeeRequest{}
+func (x *DepositMarketRequest) Reset() {
+	*x = DepositMarketRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1492,13 +1685,13 @@ func (x *UpdateMarketFixedFeeRequest) Reset() {
 	}
 }
 
-func (x *UpdateMarketFixedFeeRequest) String() string {
+func (x *DepositMarketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMarketFixedFeeRequest) ProtoMessage() {}
+func (*DepositMarketRequest) ProtoMessage() {}
 
-func (x *UpdateMarketFixedFeeRequest) ProtoReflect() protoreflect.Message {
+func (x *DepositMarketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1510,35 +1703,35 @@ func (x *UpdateMarketFixedFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMarketFixedFeeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMarketFixedFeeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositMarketRequest.ProtoReflect.Descriptor instead.
+func (*DepositMarketRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpdateMarketFixedFeeRequest) GetMarket() *types.Market {
+func (x *DepositMarketRequest) GetMarket() *types.Market {
 	if x != nil {
 		return x.Market
 	}
 	return nil
 }
 
-func (x *UpdateMarketFixedFeeRequest) GetFixed() *types.Fixed {
+func (x *DepositMarketRequest) GetNumOfAddresses() int64 {
 	if x != nil {
-		return x.Fixed
+		return x.NumOfAddresses
 	}
-	return nil
+	return 0
 }
 
-type UpdateMarketFeeReply struct {
+type DepositMarketReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MarketWithFee *types.MarketWithFee `protobuf:"bytes,1,opt,name=market_with_fee,json=marketWithFee,proto3" json:"market_with_fee,omitempty"`
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (x *UpdateMarketFeeReply) Reset() {
-	*x = UpdateMarketFeeReply{}
+func (x *DepositMarketReply) Reset() {
+	*x = DepositMarketReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1546,13 +1739,13 @@ func (x *UpdateMarketFeeReply) Reset() {
 	}
 }
 
-func (x *UpdateMarketFeeReply) String() string {
+func (x *DepositMarketReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMarketFeeReply) ProtoMessage() {}
+func (*DepositMarketReply) ProtoMessage() {}
 
-func (x *UpdateMarketFeeReply) ProtoReflect() protoreflect.Message {
+func (x *DepositMarketReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1564,31 +1757,29 @@ func (x *UpdateMarketFeeReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMarketFeeReply.ProtoReflect.Descriptor instead.
-func (*UpdateMarketFeeReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositMarketReply.ProtoReflect.Descriptor instead.
+func (*DepositMarketReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UpdateMarketFeeReply) GetMarketWithFee() *types.MarketWithFee {
+func (x *DepositMarketReply) GetAddresses() []string {
 	if x != nil {
-		return x.MarketWithFee
+		return x.Addresses
 	}
 	return nil
 }
 
-type UpdateMarketPriceRequest struct {
+type GetDepositAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Market to update the price of
-	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
-	// Price to be updated
-	Price *types.Price `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	Market         *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`                                          // Market to get the addresses from.
+	NumOfAddresses int64         `protobuf:"varint,2,opt,name=num_of_addresses,json=numOfAddresses,proto3" json:"num_of_addresses,omitempty"` // Number of addresses to be derived for market.
 }
 
-func (x *UpdateMarketPriceRequest) Reset() {
-	*x = UpdateMarketPriceRequest{}
+func (x *GetDepositAddressRequest) Reset() {
+	*x = GetDepositAddressRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1596,13 +1787,13 @@ func (x *UpdateMarketPriceRequest) Reset() {
 	}
 }
 
-func (x *UpdateMarketPriceRequest) String() string {
+func (x *GetDepositAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMarketPriceRequest) ProtoMessage() {}
+func (*GetDepositAddressRequest) ProtoMessage() {}
 
-func (x *UpdateMarketPriceRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDepositAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1614,33 +1805,35 @@ func (x *UpdateMarketPriceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMarketPriceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMarketPriceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDepositAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetDepositAddressRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UpdateMarketPriceRequest) GetMarket() *types.Market {
+func (x *GetDepositAddressRequest) GetMarket() *types.Market {
 	if x != nil {
 		return x.Market
 	}
 	return nil
 }
 
-func (x *UpdateMarketPriceRequest) GetPrice() *types.Price {
+func (x *GetDepositAddressRequest) GetNumOfAddresses() int64 {
 	if x != nil {
-		return x.Price
+		return x.NumOfAddresses
 	}
-	return nil
+	return 0
 }
 
-type UpdateMarketPriceReply struct {
+type GetDepositAddressReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	AddressWithBlindingKey []*types.AddressWithBlindingKey `protobuf:"bytes,1,rep,name=address_with_blinding_key,json=addressWithBlindingKey,proto3" json:"address_with_blinding_key,omitempty"`
 }
 
-func (x *UpdateMarketPriceReply) Reset() {
-	*x = UpdateMarketPriceReply{}
+func (x *GetDepositAddressReply) Reset() {
+	*x = GetDepositAddressReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1648,13 +1841,13 @@ func (x *UpdateMarketPriceReply) Reset() {
 	}
 }
 
-func (x *UpdateMarketPriceReply) String() string {
+func (x *GetDepositAddressReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMarketPriceReply) ProtoMessage() {}
+func (*GetDepositAddressReply) ProtoMessage() {}
 
-func (x *UpdateMarketPriceReply) ProtoReflect() protoreflect.Message {
+func (x *GetDepositAddressReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1666,31 +1859,28 @@ func (x *UpdateMarketPriceReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMarketPriceReply.ProtoReflect.Descriptor instead.
-func (*UpdateMarketPriceReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDepositAddressReply.ProtoReflect.Descriptor instead.
+func (*GetDepositAddressReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{32}
 }
 
-type WithdrawMarketRequest struct {
+func (x *GetDepositAddressReply) GetAddressWithBlindingKey() []*types.AddressWithBlindingKey {
+	if x != nil {
+		return x.AddressWithBlindingKey
+	}
+	return nil
+}
+
+type ListDepositMarketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Market from which funds need to be withdrawn
-	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
-	// Amount for base and quote asset to be withdrawn
-	BalanceToWithdraw *types.Balance `protobuf:"bytes,2,opt,name=balance_to_withdraw,json=balanceToWithdraw,proto3" json:"balance_to_withdraw,omitempty"`
-	// The number of millisatoshis per byte that should be
-	// used when crafting this transaction.
-	MillisatPerByte int64 `protobuf:"varint,3,opt,name=millisat_per_byte,json=millisatPerByte,proto3" json:"millisat_per_byte,omitempty"`
-	// The address to send to the funds
-	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	// Optional: if true the transaction will be pushed to the network
-	Push bool `protobuf:"varint,5,opt,name=push,proto3" json:"push,omitempty"`
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"` // Market to get an address from. Could be empty.
 }
 
-func (x *WithdrawMarketRequest) Reset() {
-	*x = WithdrawMarketRequest{}
+func (x *ListDepositMarketRequest) Reset() {
+	*x = ListDepositMarketRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1698,13 +1888,13 @@ func (x *WithdrawMarketRequest) Reset() {
 	}
 }
 
-func (x *WithdrawMarketRequest) String() string {
+func (x *ListDepositMarketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawMarketRequest) ProtoMessage() {}
+func (*ListDepositMarketRequest) ProtoMessage() {}
 
-func (x *WithdrawMarketRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDepositMarketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1716,71 +1906,42 @@ func (x *WithdrawMarketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawMarketRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawMarketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDepositMarketRequest.ProtoReflect.Descriptor instead.
+func (*ListDepositMarketRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *WithdrawMarketRequest) GetMarket() *types.Market {
+func (x *ListDepositMarketRequest) GetMarket() *types.Market {
 	if x != nil {
 		return x.Market
 	}
 	return nil
 }
 
-func (x *WithdrawMarketRequest) GetBalanceToWithdraw() *types.Balance {
-	if x != nil {
-		return x.BalanceToWithdraw
-	}
-	return nil
+type ListDepositMarketReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address []string `protobuf:"bytes,1,rep,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *WithdrawMarketRequest) GetMillisatPerByte() int64 {
-	if x != nil {
-		return x.MillisatPerByte
+func (x *ListDepositMarketReply) Reset() {
+	*x = ListDepositMarketReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *WithdrawMarketRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *WithdrawMarketRequest) GetPush() bool {
-	if x != nil {
-		return x.Push
-	}
-	return false
-}
-
-type WithdrawMarketReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The serialized transaction
-	RawTx []byte `protobuf:"bytes,1,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
-}
-
-func (x *WithdrawMarketReply) Reset() {
-	*x = WithdrawMarketReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_operator_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *WithdrawMarketReply) String() string {
+func (x *ListDepositMarketReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawMarketReply) ProtoMessage() {}
+func (*ListDepositMarketReply) ProtoMessage() {}
 
-func (x *WithdrawMarketReply) ProtoReflect() protoreflect.Message {
+func (x *ListDepositMarketReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1792,26 +1953,28 @@ func (x *WithdrawMarketReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawMarketReply.ProtoReflect.Descriptor instead.
-func (*WithdrawMarketReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDepositMarketReply.ProtoReflect.Descriptor instead.
+func (*ListDepositMarketReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *WithdrawMarketReply) GetRawTx() []byte {
+func (x *ListDepositMarketReply) GetAddress() []string {
 	if x != nil {
-		return x.RawTx
+		return x.Address
 	}
 	return nil
 }
 
-type ListTradesRequest struct {
+type DepositFeeAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	NumOfAddresses int64 `protobuf:"varint,1,opt,name=num_of_addresses,json=numOfAddresses,proto3" json:"num_of_addresses,omitempty"` // Number of addresses to be derived for Fee Account.
 }
 
-func (x *ListTradesRequest) Reset() {
-	*x = ListTradesRequest{}
+func (x *DepositFeeAccountRequest) Reset() {
+	*x = DepositFeeAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1819,13 +1982,13 @@ func (x *ListTradesRequest) Reset() {
 	}
 }
 
-func (x *ListTradesRequest) String() string {
+func (x *DepositFeeAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTradesRequest) ProtoMessage() {}
+func (*DepositFeeAccountRequest) ProtoMessage() {}
 
-func (x *ListTradesRequest) ProtoReflect() protoreflect.Message {
+func (x *DepositFeeAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1837,21 +2000,28 @@ func (x *ListTradesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTradesRequest.ProtoReflect.Descriptor instead.
-func (*ListTradesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositFeeAccountRequest.ProtoReflect.Descriptor instead.
+func (*DepositFeeAccountRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{35}
 }
 
-type ListTradesReply struct {
+func (x *DepositFeeAccountRequest) GetNumOfAddresses() int64 {
+	if x != nil {
+		return x.NumOfAddresses
+	}
+	return 0
+}
+
+type DepositFeeAccountReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Trades []*TradeInfo `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
+	AddressWithBlindingKey []*types.AddressWithBlindingKey `protobuf:"bytes,1,rep,name=address_with_blinding_key,json=addressWithBlindingKey,proto3" json:"address_with_blinding_key,omitempty"` // AddressInfo contains address and blinding key
 }
 
-func (x *ListTradesReply) Reset() {
-	*x = ListTradesReply{}
+func (x *DepositFeeAccountReply) Reset() {
+	*x = DepositFeeAccountReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1859,13 +2029,13 @@ func (x *ListTradesReply) Reset() {
 	}
 }
 
-func (x *ListTradesReply) String() string {
+func (x *DepositFeeAccountReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTradesReply) ProtoMessage() {}
+func (*DepositFeeAccountReply) ProtoMessage() {}
 
-func (x *ListTradesReply) ProtoReflect() protoreflect.Message {
+func (x *DepositFeeAccountReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1877,28 +2047,26 @@ func (x *ListTradesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTradesReply.ProtoReflect.Descriptor instead.
-func (*ListTradesReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositFeeAccountReply.ProtoReflect.Descriptor instead.
+func (*DepositFeeAccountReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ListTradesReply) GetTrades() []*TradeInfo {
+func (x *DepositFeeAccountReply) GetAddressWithBlindingKey() []*types.AddressWithBlindingKey {
 	if x != nil {
-		return x.Trades
+		return x.AddressWithBlindingKey
 	}
 	return nil
 }
 
-type ReportMarketFeeRequest struct {
+type BalanceFeeAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"` // Market to be updated
 }
 
-func (x *ReportMarketFeeRequest) Reset() {
-	*x = ReportMarketFeeRequest{}
+func (x *BalanceFeeAccountRequest) Reset() {
+	*x = BalanceFeeAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1906,13 +2074,13 @@ func (x *ReportMarketFeeRequest) Reset() {
 	}
 }
 
-func (x *ReportMarketFeeRequest) String() string {
+func (x *BalanceFeeAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportMarketFeeRequest) ProtoMessage() {}
+func (*BalanceFeeAccountRequest) ProtoMessage() {}
 
-func (x *ReportMarketFeeRequest) ProtoReflect() protoreflect.Message {
+func (x *BalanceFeeAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1924,32 +2092,21 @@ func (x *ReportMarketFeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportMarketFeeRequest.ProtoReflect.Descriptor instead.
-func (*ReportMarketFeeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BalanceFeeAccountRequest.ProtoReflect.Descriptor instead.
+func (*BalanceFeeAccountRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ReportMarketFeeRequest) GetMarket() *types.Market {
-	if x != nil {
-		return x.Market
-	}
-	return nil
-}
-
-type ReportMarketFeeReply struct {
+type BalanceFeeAccountReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of the all unique fee being collected on each swap.
-	CollectedFees []*FeeInfo `protobuf:"bytes,1,rep,name=collected_fees,json=collectedFees,proto3" json:"collected_fees,omitempty"`
-	// Map of aggreagated fee count grouped by asset. There should be one unique
-	// entry for each asset and the amount should be the aggregated total.
-	TotalCollectedFeesPerAsset map[string]int64 `protobuf:"bytes,2,rep,name=total_collected_fees_per_asset,json=totalCollectedFeesPerAsset,proto3" json:"total_collected_fees_per_asset,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (x *ReportMarketFeeReply) Reset() {
-	*x = ReportMarketFeeReply{}
+func (x *BalanceFeeAccountReply) Reset() {
+	*x = BalanceFeeAccountReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1957,13 +2114,13 @@ func (x *ReportMarketFeeReply) Reset() {
 	}
 }
 
-func (x *ReportMarketFeeReply) String() string {
+func (x *BalanceFeeAccountReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportMarketFeeReply) ProtoMessage() {}
+func (*BalanceFeeAccountReply) ProtoMessage() {}
 
-func (x *ReportMarketFeeReply) ProtoReflect() protoreflect.Message {
+func (x *BalanceFeeAccountReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1975,40 +2132,26 @@ func (x *ReportMarketFeeReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportMarketFeeReply.ProtoReflect.Descriptor instead.
-func (*ReportMarketFeeReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use BalanceFeeAccountReply.ProtoReflect.Descriptor instead.
+func (*BalanceFeeAccountReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ReportMarketFeeReply) GetCollectedFees() []*FeeInfo {
-	if x != nil {
-		return x.CollectedFees
-	}
-	return nil
-}
-
-func (x *ReportMarketFeeReply) GetTotalCollectedFeesPerAsset() map[string]int64 {
+func (x *BalanceFeeAccountReply) GetBalance() int64 {
 	if x != nil {
-		return x.TotalCollectedFeesPerAsset
+		return x.Balance
 	}
-	return nil
+	return 0
 }
 
-type MarketInfo struct {
+type TotalBalanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Market       *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
-	Fee          *types.Fee    `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
-	Tradable     bool          `protobuf:"varint,3,opt,name=tradable,proto3" json:"tradable,omitempty"`
-	StrategyType StrategyType  `protobuf:"varint,4,opt,name=strategy_type,json=strategyType,proto3,enum=StrategyType" json:"strategy_type,omitempty"`
-	AccountIndex uint64        `protobuf:"varint,5,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
-	Price        *types.Price  `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-func (x *MarketInfo) Reset() {
-	*x = MarketInfo{}
+func (x *TotalBalanceRequest) Reset() {
+	*x = TotalBalanceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2016,13 +2159,13 @@ func (x *MarketInfo) Reset() {
 	}
 }
 
-func (x *MarketInfo) String() string {
+func (x *TotalBalanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketInfo) ProtoMessage() {}
+func (*TotalBalanceRequest) ProtoMessage() {}
 
-func (x *MarketInfo) ProtoReflect() protoreflect.Message {
+func (x *TotalBalanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2034,64 +2177,22 @@ func (x *MarketInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketInfo.ProtoReflect.Descriptor instead.
-func (*MarketInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use TotalBalanceRequest.ProtoReflect.Descriptor instead.
+func (*TotalBalanceRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *MarketInfo) GetMarket() *types.Market {
-	if x != nil {
-		return x.Market
-	}
-	return nil
-}
-
-func (x *MarketInfo) GetFee() *types.Fee {
-	if x != nil {
-		return x.Fee
-	}
-	return nil
-}
-
-func (x *MarketInfo) GetTradable() bool {
-	if x != nil {
-		return x.Tradable
-	}
-	return false
-}
-
-func (x *MarketInfo) GetStrategyType() StrategyType {
-	if x != nil {
-		return x.StrategyType
-	}
-	return StrategyType_PLUGGABLE
-}
-
-func (x *MarketInfo) GetAccountIndex() uint64 {
-	if x != nil {
-		return x.AccountIndex
-	}
-	return 0
-}
-
-func (x *MarketInfo) GetPrice() *types.Price {
-	if x != nil {
-		return x.Price
-	}
-	return nil
-}
-
-type TradeStatusInfo struct {
+type TotalBalanceReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status TradeStatus `protobuf:"varint,1,opt,name=status,proto3,enum=TradeStatus" json:"status,omitempty"`
-	Failed bool        `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// The balance of every asset held by the daemon accounts
+	Balance map[string]*AssetBalanceInfo `protobuf:"bytes,1,rep,name=balance,proto3" json:"balance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *TradeStatusInfo) Reset() {
-	*x = TradeStatusInfo{}
+func (x *TotalBalanceReply) Reset() {
+	*x = TotalBalanceReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2099,13 +2200,13 @@ func (x *TradeStatusInfo) Reset() {
 	}
 }
 
-func (x *TradeStatusInfo) String() string {
+func (x *TotalBalanceReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TradeStatusInfo) ProtoMessage() {}
+func (*TotalBalanceReply) ProtoMessage() {}
 
-func (x *TradeStatusInfo) ProtoReflect() protoreflect.Message {
+func (x *TotalBalanceReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2117,42 +2218,32 @@ func (x *TradeStatusInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TradeStatusInfo.ProtoReflect.Descriptor instead.
-func (*TradeStatusInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use TotalBalanceReply.ProtoReflect.Descriptor instead.
+func (*TotalBalanceReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *TradeStatusInfo) GetStatus() TradeStatus {
-	if x != nil {
-		return x.Status
-	}
-	return TradeStatus_UNDEFINED
-}
-
-func (x *TradeStatusInfo) GetFailed() bool {
+func (x *TotalBalanceReply) GetBalance() map[string]*AssetBalanceInfo {
 	if x != nil {
-		return x.Failed
+		return x.Balance
 	}
-	return false
+	return nil
 }
 
-type SwapInfo struct {
+type AssetBalanceInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The proposer's quantity
-	AmountP uint64 `protobuf:"varint,1,opt,name=amount_p,json=amountP,proto3" json:"amount_p,omitempty"`
-	// The proposer's asset hash
-	AssetP string `protobuf:"bytes,2,opt,name=asset_p,json=assetP,proto3" json:"asset_p,omitempty"`
-	// The responder's quantity
-	AmountR uint64 `protobuf:"varint,3,opt,name=amount_r,json=amountR,proto3" json:"amount_r,omitempty"`
-	// The responder's asset hash
-	AssetR string `protobuf:"bytes,4,opt,name=asset_r,json=assetR,proto3" json:"asset_r,omitempty"`
+	TotalBalance uint64 `protobuf:"varint,1,opt,name=total_balance,json=totalBalance,proto3" json:"total_balance,omitempty"`
+	// The balance with at least 1 confirmation
+	ConfirmedBalance uint64 `protobuf:"varint,2,opt,name=confirmed_balance,json=confirmedBalance,proto3" json:"confirmed_balance,omitempty"`
+	// The balance with no confirmations
+	UnconfirmedBalance uint64 `protobuf:"varint,3,opt,name=unconfirmed_balance,json=unconfirmedBalance,proto3" json:"unconfirmed_balance,omitempty"`
 }
 
-func (x *SwapInfo) Reset() {
-	*x = SwapInfo{}
+func (x *AssetBalanceInfo) Reset() {
+	*x = AssetBalanceInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2160,13 +2251,13 @@ func (x *SwapInfo) Reset() {
 	}
 }
 
-func (x *SwapInfo) String() string {
+func (x *AssetBalanceInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwapInfo) ProtoMessage() {}
+func (*AssetBalanceInfo) ProtoMessage() {}
 
-func (x *SwapInfo) ProtoReflect() protoreflect.Message {
+func (x *AssetBalanceInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2178,50 +2269,46 @@ func (x *SwapInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwapInfo.ProtoReflect.Descriptor instead.
-func (*SwapInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssetBalanceInfo.ProtoReflect.Descriptor instead.
+func (*AssetBalanceInfo) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SwapInfo) GetAmountP() uint64 {
+func (x *AssetBalanceInfo) GetTotalBalance() uint64 {
 	if x != nil {
-		return x.AmountP
+		return x.TotalBalance
 	}
 	return 0
 }
 
-func (x *SwapInfo) GetAssetP() string {
+func (x *AssetBalanceInfo) GetConfirmedBalance() uint64 {
 	if x != nil {
-		return x.AssetP
+		return x.ConfirmedBalance
 	}
-	return ""
+	return 0
 }
 
-func (x *SwapInfo) GetAmountR() uint64 {
+func (x *AssetBalanceInfo) GetUnconfirmedBalance() uint64 {
 	if x != nil {
-		return x.AmountR
+		return x.UnconfirmedBalance
 	}
 	return 0
 }
 
-func (x *SwapInfo) GetAssetR() string {
-	if x != nil {
-		return x.AssetR
-	}
-	return ""
-}
-
-type SwapFailInfo struct {
+type ConsolidateFeeAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FailureCode    uint32 `protobuf:"varint,1,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
-	FailureMessage string `protobuf:"bytes,2,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message,omitempty"`
+	// The max number of utxos to spend, starting from the smallest one
+	MaxInputs uint64 `protobuf:"varint,1,opt,name=max_inputs,json=maxInputs,proto3" json:"max_inputs,omitempty"`
+	// The number of millisatoshis per byte to use for the transaction.
+	// Utxos not worth the fees for spending them are skipped.
+	MillisatPerByte int64 `protobuf:"varint,2,opt,name=millisat_per_byte,json=millisatPerByte,proto3" json:"millisat_per_byte,omitempty"`
 }
 
-func (x *SwapFailInfo) Reset() {
-	*x = SwapFailInfo{}
+func (x *ConsolidateFeeAccountRequest) Reset() {
+	*x = ConsolidateFeeAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2229,13 +2316,13 @@ func (x *SwapFailInfo) Reset() {
 	}
 }
 
-func (x *SwapFailInfo) String() string {
+func (x *ConsolidateFeeAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwapFailInfo) ProtoMessage() {}
+func (*ConsolidateFeeAccountRequest) ProtoMessage() {}
 
-func (x *SwapFailInfo) ProtoReflect() protoreflect.Message {
+func (x *ConsolidateFeeAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2247,36 +2334,38 @@ func (x *SwapFailInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwapFailInfo.ProtoReflect.Descriptor instead.
-func (*SwapFailInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsolidateFeeAccountRequest.ProtoReflect.Descriptor instead.
+func (*ConsolidateFeeAccountRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SwapFailInfo) GetFailureCode() uint32 {
+func (x *ConsolidateFeeAccountRequest) GetMaxInputs() uint64 {
 	if x != nil {
-		return x.FailureCode
+		return x.MaxInputs
 	}
 	return 0
 }
 
-func (x *SwapFailInfo) GetFailureMessage() string {
+func (x *ConsolidateFeeAccountRequest) GetMillisatPerByte() int64 {
 	if x != nil {
-		return x.FailureMessage
+		return x.MillisatPerByte
 	}
-	return ""
+	return 0
 }
 
-type TradePrice struct {
+type ConsolidateFeeAccountReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BasePrice  float64 `protobuf:"fixed64,1,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
-	QuotePrice float64 `protobuf:"fixed64,2,opt,name=quote_price,json=quotePrice,proto3" json:"quote_price,omitempty"`
+	// The hash of the broadcasted transaction
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// The number of utxos spent
+	NumInputs uint64 `protobuf:"varint,2,opt,name=num_inputs,json=numInputs,proto3" json:"num_inputs,omitempty"`
 }
 
-func (x *TradePrice) Reset() {
-	*x = TradePrice{}
+func (x *ConsolidateFeeAccountReply) Reset() {
+	*x = ConsolidateFeeAccountReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2284,13 +2373,13 @@ func (x *TradePrice) Reset() {
 	}
 }
 
-func (x *TradePrice) String() string {
+func (x *ConsolidateFeeAccountReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TradePrice) ProtoMessage() {}
+func (*ConsolidateFeeAccountReply) ProtoMessage() {}
 
-func (x *TradePrice) ProtoReflect() protoreflect.Message {
+func (x *ConsolidateFeeAccountReply) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2302,68 +2391,39 @@ func (x *TradePrice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TradePrice.ProtoReflect.Descriptor instead.
-func (*TradePrice) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsolidateFeeAccountReply.ProtoReflect.Descriptor instead.
+func (*ConsolidateFeeAccountReply) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *TradePrice) GetBasePrice() float64 {
+func (x *ConsolidateFeeAccountReply) GetTxid() string {
 	if x != nil {
-		return x.BasePrice
+		return x.Txid
 	}
-	return 0
+	return ""
 }
 
-func (x *TradePrice) GetQuotePrice() float64 {
+func (x *ConsolidateFeeAccountReply) GetNumInputs() uint64 {
 	if x != nil {
-		return x.QuotePrice
+		return x.NumInputs
 	}
 	return 0
 }
 
-type TradeInfo struct {
+type ListMarketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The id of the trade
-	TradeId string `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
-	// The info about the status of the trade
-	Status *TradeStatusInfo `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	// The info about the swap
-	SwapInfo *SwapInfo `protobuf:"bytes,3,opt,name=swap_info,json=swapInfo,proto3" json:"swap_info,omitempty"`
-	// The info of an eventually failed swap
-	FailInfo *SwapFailInfo `protobuf:"bytes,4,opt,name=fail_info,json=failInfo,proto3" json:"fail_info,omitempty"`
-	// The collected fee on the current swap
-	MarketWithFee *types.MarketWithFee `protobuf:"bytes,5,opt,name=market_with_fee,json=marketWithFee,proto3" json:"market_with_fee,omitempty"`
-	// The prices of the trade at request time
-	Price *TradePrice `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
-	// The eventual tx url with blinders in case the trade is settled
-	TxUrl string `protobuf:"bytes,7,opt,name=tx_url,json=txUrl,proto3" json:"tx_url,omitempty"`
-	// SwapRequest unix timestamp
-	RequestTimeUnix uint64 `protobuf:"varint,8,opt,name=request_time_unix,json=requestTimeUnix,proto3" json:"request_time_unix,omitempty"`
-	// SwapAccpet unix timestamp
-	AcceptTimeUnix uint64 `protobuf:"varint,9,opt,name=accept_time_unix,json=acceptTimeUnix,proto3" json:"accept_time_unix,omitempty"`
-	// SwapComplete unix timestamp
-	CompleteTimeUnix uint64 `protobuf:"varint,10,opt,name=complete_time_unix,json=completeTimeUnix,proto3" json:"complete_time_unix,omitempty"`
-	// Settlement unix timestamp
-	SettleTimeUnix uint64 `protobuf:"varint,11,opt,name=settle_time_unix,json=settleTimeUnix,proto3" json:"settle_time_unix,omitempty"`
-	// Expiration unix timestamp
-	ExpiryTimeUnix uint64 `protobuf:"varint,12,opt,name=expiry_time_unix,json=expiryTimeUnix,proto3" json:"expiry_time_unix,omitempty"`
-	// SwapRequest UTC timestamp
-	RequestTimeUtc string `protobuf:"bytes,13,opt,name=request_time_utc,json=requestTimeUtc,proto3" json:"request_time_utc,omitempty"`
-	// SwapAccept UTC timestamp
-	AcceptTimeUtc string `protobuf:"bytes,14,opt,name=accept_time_utc,json=acceptTimeUtc,proto3" json:"accept_time_utc,omitempty"`
-	// SwapComplete UTC timestamp
-	CompleteTimeUtc string `protobuf:"bytes,15,opt,name=complete_time_utc,json=completeTimeUtc,proto3" json:"complete_time_utc,omitempty"`
-	// Settlement UTC timestamp
-	SettleTimeUtc string `protobuf:"bytes,16,opt,name=settle_time_utc,json=settleTimeUtc,proto3" json:"settle_time_utc,omitempty"`
-	// Expiration UTC timestamp
-	ExpiryTimeUtc string `protobuf:"bytes,17,opt,name=expiry_time_utc,json=expiryTimeUtc,proto3" json:"expiry_time_utc,omitempty"`
+	// Optional: if set, only the markets with this base asset are listed
+	BaseAsset string `protobuf:"bytes,1,opt,name=base_asset,json=baseAsset,proto3" json:"base_asset,omitempty"`
+	// Optional: if set, only the markets with this asset, either as base or
+	// quote asset, are listed. It can't be combined with base_asset
+	Asset string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
 }
 
-func (x *TradeInfo) Reset() {
-	*x = TradeInfo{}
+func (x *ListMarketRequest) Reset() {
+	*x = ListMarketRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_operator_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2371,13 +2431,13 @@ func (x *TradeInfo) Reset() {
 	}
 }
 
-func (x *TradeInfo) String() string {
+func (x *ListMarketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TradeInfo) ProtoMessage() {}
+func (*ListMarketRequest) ProtoMessage() {}
 
-func (x *TradeInfo) ProtoReflect() protoreflect.Message {
+func (x *ListMarketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_operator_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2389,159 +2449,195 @@ func (x *TradeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TradeInfo.ProtoReflect.Descriptor instead.
-func (*TradeInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMarketRequest.ProtoReflect.Descriptor instead.
+func (*ListMarketRequest) Descriptor() ([]byte, []int) {
 	return file_operator_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *TradeInfo) GetTradeId() string {
+func (x *ListMarketRequest) GetBaseAsset() string {
 	if x != nil {
-		return x.TradeId
+		return x.BaseAsset
 	}
 	return ""
 }
 
-func (x *TradeInfo) GetStatus() *TradeStatusInfo {
+func (x *ListMarketRequest) GetAsset() string {
 	if x != nil {
-		return x.Status
+		return x.Asset
 	}
-	return nil
+	return ""
 }
 
-func (x *TradeInfo) GetSwapInfo() *SwapInfo {
-	if x != nil {
-		return x.SwapInfo
-	}
-	return nil
+type ListMarketReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Markets []*MarketInfo `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
 }
 
-func (x *TradeInfo) GetFailInfo() *SwapFailInfo {
-	if x != nil {
-		return x.FailInfo
+func (x *ListMarketReply) Reset() {
+	*x = ListMarketReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *TradeInfo) GetMarketWithFee() *types.MarketWithFee {
-	if x != nil {
-		return x.MarketWithFee
-	}
-	return nil
+func (x *ListMarketReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TradeInfo) GetPrice() *TradePrice {
-	if x != nil {
-		return x.Price
+func (*ListMarketReply) ProtoMessage() {}
+
+func (x *ListMarketReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *TradeInfo) GetTxUrl() string {
-	if x != nil {
-		return x.TxUrl
-	}
-	return ""
+// Deprecated: Use ListMarketReply.ProtoReflect.Descriptor instead.
+func (*ListMarketReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *TradeInfo) GetRequestTimeUnix() uint64 {
+func (x *ListMarketReply) GetMarkets() []*MarketInfo {
 	if x != nil {
-		return x.RequestTimeUnix
+		return x.Markets
 	}
-	return 0
+	return nil
 }
 
-func (x *TradeInfo) GetAcceptTimeUnix() uint64 {
-	if x != nil {
-		return x.AcceptTimeUnix
-	}
-	return 0
+type GetAssetInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
 }
 
-func (x *TradeInfo) GetCompleteTimeUnix() uint64 {
-	if x != nil {
-		return x.CompleteTimeUnix
+func (x *GetAssetInfoRequest) Reset() {
+	*x = GetAssetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *TradeInfo) GetSettleTimeUnix() uint64 {
-	if x != nil {
-		return x.SettleTimeUnix
-	}
-	return 0
+func (x *GetAssetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TradeInfo) GetExpiryTimeUnix() uint64 {
-	if x != nil {
-		return x.ExpiryTimeUnix
+func (*GetAssetInfoRequest) ProtoMessage() {}
+
+func (x *GetAssetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *TradeInfo) GetRequestTimeUtc() string {
-	if x != nil {
-		return x.RequestTimeUtc
-	}
-	return ""
+// Deprecated: Use GetAssetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetAssetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *TradeInfo) GetAcceptTimeUtc() string {
+func (x *GetAssetInfoRequest) GetAsset() string {
 	if x != nil {
-		return x.AcceptTimeUtc
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *TradeInfo) GetCompleteTimeUtc() string {
-	if x != nil {
-		return x.CompleteTimeUtc
+type GetAssetInfoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info *AssetInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *GetAssetInfoReply) Reset() {
+	*x = GetAssetInfoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *TradeInfo) GetSettleTimeUtc() string {
-	if x != nil {
-		return x.SettleTimeUtc
+func (x *GetAssetInfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssetInfoReply) ProtoMessage() {}
+
+func (x *GetAssetInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *TradeInfo) GetExpiryTimeUtc() string {
+// Deprecated: Use GetAssetInfoReply.ProtoReflect.Descriptor instead.
+func (*GetAssetInfoReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetAssetInfoReply) GetInfo() *AssetInfo {
 	if x != nil {
-		return x.ExpiryTimeUtc
+		return x.Info
 	}
-	return ""
+	return nil
 }
 
-type FeeInfo struct {
+type GetBlindingDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TradeId     string  `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
-	BasisPoint  int64   `protobuf:"varint,2,opt,name=basis_point,json=basisPoint,proto3" json:"basis_point,omitempty"`
-	Asset       string  `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	Amount      uint64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	MarketPrice float32 `protobuf:"fixed32,5,opt,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"`
+	Pset string `protobuf:"bytes,1,opt,name=pset,proto3" json:"pset,omitempty"`
+	// Blinding private keys of the inputs, mapped by hex encoded prevout script
+	InputBlindingKeys map[string][]byte `protobuf:"bytes,2,rep,name=input_blinding_keys,json=inputBlindingKeys,proto3" json:"input_blinding_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Blinding private keys of the outputs, mapped by hex encoded script
+	OutputBlindingKeys map[string][]byte `protobuf:"bytes,3,rep,name=output_blinding_keys,json=outputBlindingKeys,proto3" json:"output_blinding_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *FeeInfo) Reset() {
-	*x = FeeInfo{}
+func (x *GetBlindingDataRequest) Reset() {
+	*x = GetBlindingDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_operator_proto_msgTypes[45]
+		mi := &file_operator_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FeeInfo) String() string {
+func (x *GetBlindingDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FeeInfo) ProtoMessage() {}
+func (*GetBlindingDataRequest) ProtoMessage() {}
 
-func (x *FeeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_operator_proto_msgTypes[45]
+func (x *GetBlindingDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2552,72 +2648,172 @@ func (x *FeeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FeeInfo.ProtoReflect.Descriptor instead.
-func (*FeeInfo) Descriptor() ([]byte, []int) {
-	return file_operator_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GetBlindingDataRequest.ProtoReflect.Descriptor instead.
+func (*GetBlindingDataRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *FeeInfo) GetTradeId() string {
+func (x *GetBlindingDataRequest) GetPset() string {
 	if x != nil {
-		return x.TradeId
+		return x.Pset
 	}
 	return ""
 }
 
-func (x *FeeInfo) GetBasisPoint() int64 {
+func (x *GetBlindingDataRequest) GetInputBlindingKeys() map[string][]byte {
 	if x != nil {
-		return x.BasisPoint
+		return x.InputBlindingKeys
 	}
-	return 0
+	return nil
 }
 
-func (x *FeeInfo) GetAsset() string {
+func (x *GetBlindingDataRequest) GetOutputBlindingKeys() map[string][]byte {
 	if x != nil {
-		return x.Asset
+		return x.OutputBlindingKeys
 	}
-	return ""
+	return nil
 }
 
-func (x *FeeInfo) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
+type GetBlindingDataReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sorted by index, they're empty if no keys are given
+	Inputs  []*BlindingData `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs []*BlindingData `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (x *GetBlindingDataReply) Reset() {
+	*x = GetBlindingDataReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *FeeInfo) GetMarketPrice() float32 {
+func (x *GetBlindingDataReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlindingDataReply) ProtoMessage() {}
+
+func (x *GetBlindingDataReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlindingDataReply.ProtoReflect.Descriptor instead.
+func (*GetBlindingDataReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetBlindingDataReply) GetInputs() []*BlindingData {
 	if x != nil {
-		return x.MarketPrice
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *GetBlindingDataReply) GetOutputs() []*BlindingData {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type AccountXPubRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountIndex uint64 `protobuf:"varint,1,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+	// Whether to return the master blinding key along with the xpub
+	WithMasterBlindingKey bool `protobuf:"varint,2,opt,name=with_master_blinding_key,json=withMasterBlindingKey,proto3" json:"with_master_blinding_key,omitempty"`
+}
+
+func (x *AccountXPubRequest) Reset() {
+	*x = AccountXPubRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountXPubRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountXPubRequest) ProtoMessage() {}
+
+func (x *AccountXPubRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountXPubRequest.ProtoReflect.Descriptor instead.
+func (*AccountXPubRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AccountXPubRequest) GetAccountIndex() uint64 {
+	if x != nil {
+		return x.AccountIndex
 	}
 	return 0
 }
 
-type TxOutpoint struct {
+func (x *AccountXPubRequest) GetWithMasterBlindingKey() bool {
+	if x != nil {
+		return x.WithMasterBlindingKey
+	}
+	return false
+}
+
+type AccountXPubReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash  string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Index int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Base58 encoded extended public key of the hardened account
+	Xpub string `protobuf:"bytes,1,opt,name=xpub,proto3" json:"xpub,omitempty"`
+	// Hex encoded SLIP77 master blinding key, empty unless requested
+	MasterBlindingKey string `protobuf:"bytes,2,opt,name=master_blinding_key,json=masterBlindingKey,proto3" json:"master_blinding_key,omitempty"`
 }
 
-func (x *TxOutpoint) Reset() {
-	*x = TxOutpoint{}
+func (x *AccountXPubReply) Reset() {
+	*x = AccountXPubReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_operator_proto_msgTypes[46]
+		mi := &file_operator_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TxOutpoint) String() string {
+func (x *AccountXPubReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxOutpoint) ProtoMessage() {}
+func (*AccountXPubReply) ProtoMessage() {}
 
-func (x *TxOutpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_operator_proto_msgTypes[46]
+func (x *AccountXPubReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2628,560 +2824,7484 @@ func (x *TxOutpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxOutpoint.ProtoReflect.Descriptor instead.
-func (*TxOutpoint) Descriptor() ([]byte, []int) {
-	return file_operator_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use AccountXPubReply.ProtoReflect.Descriptor instead.
+func (*AccountXPubReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *TxOutpoint) GetHash() string {
+func (x *AccountXPubReply) GetXpub() string {
 	if x != nil {
-		return x.Hash
+		return x.Xpub
 	}
 	return ""
 }
 
-func (x *TxOutpoint) GetIndex() int32 {
+func (x *AccountXPubReply) GetMasterBlindingKey() string {
 	if x != nil {
-		return x.Index
+		return x.MasterBlindingKey
 	}
-	return 0
+	return ""
 }
 
-var File_operator_proto protoreflect.FileDescriptor
+type RotateBlindingKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_operator_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x38, 0x0a,
-	0x11, 0x44, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x72, 0x6f, 0x70, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb1,
-	0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x4d, 0x0a, 0x10, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x49, 0x6e, 0x66,
-	0x6f, 0x50, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0e, 0x69, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x1a, 0x50, 0x0a, 0x13, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49,
-	0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x79, 0x0a, 0x0c, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x08, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x70, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x55, 0x74, 0x78, 0x6f,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x05,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x55, 0x74,
-	0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x5f, 0x0a,
-	0x08, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x75, 0x74,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x78,
-	0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x14,
-	0x0a, 0x12, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x74,
-	0x78, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x61, 0x0a, 0x14, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d,
-	0x4f, 0x66, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x12, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22,
-	0x3b, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x32, 0x0a, 0x16,
-	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x44, 0x0a, 0x18, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x65, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10,
-	0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x16, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x52, 0x0a, 0x19, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68,
-	0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74,
-	0x68, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x16, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x4b, 0x65, 0x79, 0x22, 0x1a, 0x0a, 0x18, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46,
-	0x65, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x32, 0x0a, 0x16, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x38, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x07,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x19, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x12, 0x29, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x19, 0x0a, 0x17,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x43, 0x0a, 0x16, 0x43, 0x6c, 0x61, 0x69, 0x6d,
-	0x46, 0x65, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x29, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x16, 0x0a, 0x14,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x46, 0x65, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x34, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x4f, 0x70,
-	0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x35, 0x0a,
-	0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x0d, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0d, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x1b, 0x0a, 0x19, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x64, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
-	0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x1b,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x05,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x14, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x36, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x74,
-	0x68, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x52, 0x0d, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x22, 0x59, 0x0a, 0x18, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
-	0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0xcc, 0x01, 0x0a, 0x15, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x13, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
-	0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x11, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74,
-	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x75,
-	0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x75, 0x73, 0x68, 0x22, 0x2c,
-	0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x22, 0x13, 0x0a, 0x11,
-	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x35, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x22, 0x91, 0x02, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x0e,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x79, 0x0a,
-	0x1e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x50,
-	0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x1a, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73,
-	0x50, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x1a, 0x4d, 0x0a, 0x1f, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x50, 0x65,
-	0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd8, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
-	0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x74, 0x72, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x0d, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x22, 0x4f, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x61, 0x69,
-	0x6c, 0x65, 0x64, 0x22, 0x72, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x19, 0x0a, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x5f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x50, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x12, 0x17,
-	0x0a, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x22, 0x5a, 0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x46,
-	0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75,
-	0x72, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66,
-	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61,
-	0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x4c, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x22, 0xbc, 0x05, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x09,
-	0x66, 0x61, 0x69, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x46, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
-	0x66, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65,
-	0x65, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65,
-	0x12, 0x21, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x55, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78,
-	0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x28,
-	0x0a, 0x10, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e,
-	0x69, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e,
-	0x69, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x75, 0x74, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x74, 0x63, 0x12, 0x26, 0x0a, 0x0f,
-	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x74, 0x63, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x55, 0x74, 0x63, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x74, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x74, 0x63,
-	0x12, 0x26, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x75, 0x74, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x74, 0x63, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x74, 0x63, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x74, 0x63,
-	0x22, 0x96, 0x01, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73,
-	0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x61,
-	0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x36, 0x0a, 0x0a, 0x54, 0x78, 0x4f,
-	0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x2a, 0x3b, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x4c, 0x55, 0x47, 0x47, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x00,
-	0x12, 0x0c, 0x0a, 0x08, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0e,
-	0x0a, 0x0a, 0x55, 0x4e, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x5d,
-	0x0a, 0x0b, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a,
-	0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
-	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x43,
-	0x45, 0x50, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54,
-	0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x04,
-	0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x32, 0x88, 0x0a,
-	0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x0d, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x4c, 0x69, 0x73,
-	0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x19,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46,
-	0x65, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x65,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x49, 0x0a, 0x11, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12, 0x43, 0x6c,
-	0x61, 0x69, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x12, 0x1a, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x43,
-	0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0f, 0x43, 0x6c, 0x61, 0x69,
-	0x6d, 0x46, 0x65, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x17, 0x2e, 0x43, 0x6c,
-	0x61, 0x69, 0x6d, 0x46, 0x65, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x46, 0x65, 0x65, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a,
-	0x0a, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x10, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x12, 0x13, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a,
-	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x57, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x12,
-	0x21, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x14, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x46, 0x65, 0x65, 0x12, 0x1c, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x19, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1c, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0e, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x4c,
-	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x43, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x46, 0x65, 0x65, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
-	0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x74,
-	0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65, 0x6c,
-	0x6f, 0x61, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x31, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x11, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x12, 0x12, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x64, 0x65, 0x78, 0x2d, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x2f, 0x74, 0x64, 0x65, 0x78, 0x2d, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f,
-	0x61, 0x70, 0x69, 0x2d, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-var (
-	file_operator_proto_rawDescOnce sync.Once
-	file_operator_proto_rawDescData = file_operator_proto_rawDesc
-)
+func (x *RotateBlindingKeyRequest) Reset() {
+	*x = RotateBlindingKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_operator_proto_rawDescGZIP() []byte {
-	file_operator_proto_rawDescOnce.Do(func() {
-		file_operator_proto_rawDescData = protoimpl.X.CompressGZIP(file_operator_proto_rawDescData)
-	})
-	return file_operator_proto_rawDescData
+func (x *RotateBlindingKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_operator_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
-var file_operator_proto_goTypes = []interface{}{
-	(StrategyType)(0),                        // 0: StrategyType
-	(TradeStatus)(0),                         // 1: TradeStatus
-	(*DropMarketRequest)(nil),                // 2: DropMarketRequest
-	(*DropMarketReply)(nil),                  // 3: DropMarketReply
-	(*ListUtxosRequest)(nil),                 // 4: ListUtxosRequest
-	(*ListUtxosReply)(nil),                   // 5: ListUtxosReply
-	(*UtxoInfoList)(nil),                     // 6: UtxoInfoList
-	(*UtxoInfo)(nil),                         // 7: UtxoInfo
-	(*ReloadUtxosRequest)(nil),               // 8: ReloadUtxosRequest
-	(*ReloadUtxosReply)(nil),                 // 9: ReloadUtxosReply
-	(*DepositMarketRequest)(nil),             // 10: DepositMarketRequest
-	(*DepositMarketReply)(nil),               // 11: DepositMarketReply
-	(*ListDepositMarketRequest)(nil),         // 12: ListDepositMarketRequest
-	(*ListDepositMarketReply)(nil),           // 13: ListDepositMarketReply
-	(*DepositFeeAccountRequest)(nil),         // 14: DepositFeeAccountRequest
-	(*DepositFeeAccountReply)(nil),           // 15: DepositFeeAccountReply
-	(*BalanceFeeAccountRequest)(nil),         // 16: BalanceFeeAccountRequest
-	(*BalanceFeeAccountReply)(nil),           // 17: BalanceFeeAccountReply
-	(*ListMarketRequest)(nil),                // 18: ListMarketRequest
-	(*ListMarketReply)(nil),                  // 19: ListMarketReply
-	(*ClaimMarketDepositRequest)(nil),        // 20: ClaimMarketDepositRequest
-	(*ClaimMarketDepositReply)(nil),          // 21: ClaimMarketDepositReply
-	(*ClaimFeeDepositRequest)(nil),           // 22: ClaimFeeDepositRequest
-	(*ClaimFeeDepositReply)(nil),             // 23: ClaimFeeDepositReply
-	(*OpenMarketRequest)(nil),                // 24: OpenMarketRequest
-	(*OpenMarketReply)(nil),                  // 25: OpenMarketReply
-	(*CloseMarketRequest)(nil),               // 26: CloseMarketRequest
-	(*CloseMarketReply)(nil),                 // 27: CloseMarketReply
-	(*UpdateMarketStrategyRequest)(nil),      // 28: UpdateMarketStrategyRequest
-	(*UpdateMarketStrategyReply)(nil),        // 29: UpdateMarketStrategyReply
-	(*UpdateMarketPercentageFeeRequest)(nil), // 30: UpdateMarketPercentageFeeRequest
-	(*UpdateMarketFixedFeeRequest)(nil),      // 31: UpdateMarketFixedFeeRequest
-	(*UpdateMarketFeeReply)(nil),             // 32: UpdateMarketFeeReply
-	(*UpdateMarketPriceRequest)(nil),         // 33: UpdateMarketPriceRequest
-	(*UpdateMarketPriceReply)(nil),           // 34: UpdateMarketPriceReply
-	(*WithdrawMarketRequest)(nil),            // 35: WithdrawMarketRequest
-	(*WithdrawMarketReply)(nil),              // 36: WithdrawMarketReply
-	(*ListTradesRequest)(nil),                // 37: ListTradesRequest
-	(*ListTradesReply)(nil),                  // 38: ListTradesReply
-	(*ReportMarketFeeRequest)(nil),           // 39: ReportMarketFeeRequest
-	(*ReportMarketFeeReply)(nil),             // 40: ReportMarketFeeReply
-	(*MarketInfo)(nil),                       // 41: MarketInfo
-	(*TradeStatusInfo)(nil),                  // 42: TradeStatusInfo
-	(*SwapInfo)(nil),                         // 43: SwapInfo
-	(*SwapFailInfo)(nil),                     // 44: SwapFailInfo
-	(*TradePrice)(nil),                       // 45: TradePrice
-	(*TradeInfo)(nil),                        // 46: TradeInfo
-	(*FeeInfo)(nil),                          // 47: FeeInfo
-	(*TxOutpoint)(nil),                       // 48: TxOutpoint
-	nil,                                      // 49: ListUtxosReply.InfoPerAccountEntry
-	nil,                                      // 50: ReportMarketFeeReply.TotalCollectedFeesPerAssetEntry
-	(*types.Market)(nil),                     // 51: Market
-	(*types.AddressWithBlindingKey)(nil),     // 52: AddressWithBlindingKey
-	(*types.Fixed)(nil),                      // 53: Fixed
-	(*types.MarketWithFee)(nil),              // 54: MarketWithFee
-	(*types.Price)(nil),                      // 55: Price
-	(*types.Balance)(nil),                    // 56: Balance
-	(*types.Fee)(nil),                        // 57: Fee
+func (*RotateBlindingKeyRequest) ProtoMessage() {}
+
+func (x *RotateBlindingKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_operator_proto_depIdxs = []int32{
-	49, // 0: ListUtxosReply.info_per_account:type_name -> ListUtxosReply.InfoPerAccountEntry
-	7,  // 1: UtxoInfoList.unspents:type_name -> UtxoInfo
-	7,  // 2: UtxoInfoList.spents:type_name -> UtxoInfo
-	7,  // 3: UtxoInfoList.locks:type_name -> UtxoInfo
-	48, // 4: UtxoInfo.outpoint:type_name -> TxOutpoint
-	51, // 5: DepositMarketRequest.market:type_name -> Market
-	51, // 6: ListDepositMarketRequest.market:type_name -> Market
-	52, // 7: DepositFeeAccountReply.address_with_blinding_key:type_name -> AddressWithBlindingKey
-	41, // 8: ListMarketReply.markets:type_name -> MarketInfo
-	51, // 9: ClaimMarketDepositRequest.market:type_name -> Market
-	48, // 10: ClaimMarketDepositRequest.outpoints:type_name -> TxOutpoint
-	48, // 11: ClaimFeeDepositRequest.outpoints:type_name -> TxOutpoint
-	51, // 12: OpenMarketRequest.market:type_name -> Market
-	51, // 13: CloseMarketRequest.market:type_name -> Market
-	51, // 14: UpdateMarketStrategyRequest.market:type_name -> Market
-	0,  // 15: UpdateMarketStrategyRequest.strategy_type:type_name -> StrategyType
-	51, // 16: UpdateMarketPercentageFeeRequest.market:type_name -> Market
-	51, // 17: UpdateMarketFixedFeeRequest.market:type_name -> Market
-	53, // 18: UpdateMarketFixedFeeRequest.fixed:type_name -> Fixed
-	54, // 19: UpdateMarketFeeReply.market_with_fee:type_name -> MarketWithFee
-	51, // 20: UpdateMarketPriceRequest.market:type_name -> Market
-	55, // 21: UpdateMarketPriceRequest.price:type_name -> Price
-	51, // 22: WithdrawMarketRequest.market:type_name -> Market
-	56, // 23: WithdrawMarketRequest.balance_to_withdraw:type_name -> Balance
-	46, // 24: ListTradesReply.trades:type_name -> TradeInfo
-	51, // 25: ReportMarketFeeRequest.market:type_name -> Market
-	47, // 26: ReportMarketFeeReply.collected_fees:type_name -> FeeInfo
-	50, // 27: ReportMarketFeeReply.total_collected_fees_per_asset:type_name -> ReportMarketFeeReply.TotalCollectedFeesPerAssetEntry
-	51, // 28: MarketInfo.market:type_name -> Market
-	57, // 29: MarketInfo.fee:type_name -> Fee
-	0,  // 30: MarketInfo.strategy_type:type_name -> StrategyType
-	55, // 31: MarketInfo.price:type_name -> Price
-	1,  // 32: TradeStatusInfo.status:type_name -> TradeStatus
-	42, // 33: TradeInfo.status:type_name -> TradeStatusInfo
-	43, // 34: TradeInfo.swap_info:type_name -> SwapInfo
-	44, // 35: TradeInfo.fail_info:type_name -> SwapFailInfo
-	54, // 36: TradeInfo.market_with_fee:type_name -> MarketWithFee
-	45, // 37: TradeInfo.price:type_name -> TradePrice
-	6,  // 38: ListUtxosReply.InfoPerAccountEntry.value:type_name -> UtxoInfoList
-	10, // 39: Operator.DepositMarket:input_type -> DepositMarketRequest
-	12, // 40: Operator.ListDepositMarket:input_type -> ListDepositMarketRequest
-	14, // 41: Operator.DepositFeeAccount:input_type -> DepositFeeAccountRequest
-	16, // 42: Operator.BalanceFeeAccount:input_type -> BalanceFeeAccountRequest
-	20, // 43: Operator.ClaimMarketDeposit:input_type -> ClaimMarketDepositRequest
-	22, // 44: Operator.ClaimFeeDeposit:input_type -> ClaimFeeDepositRequest
-	24, // 45: Operator.OpenMarket:input_type -> OpenMarketRequest
-	26, // 46: Operator.CloseMarket:input_type -> CloseMarketRequest
-	18, // 47: Operator.ListMarket:input_type -> ListMarketRequest
-	30, // 48: Operator.UpdateMarketPercentageFee:input_type -> UpdateMarketPercentageFeeRequest
-	31, // 49: Operator.UpdateMarketFixedFee:input_type -> UpdateMarketFixedFeeRequest
-	33, // 50: Operator.UpdateMarketPrice:input_type -> UpdateMarketPriceRequest
-	28, // 51: Operator.UpdateMarketStrategy:input_type -> UpdateMarketStrategyRequest
-	35, // 52: Operator.WithdrawMarket:input_type -> WithdrawMarketRequest
-	37, // 53: Operator.ListTrades:input_type -> ListTradesRequest
-	39, // 54: Operator.ReportMarketFee:input_type -> ReportMarketFeeRequest
-	8,  // 55: Operator.ReloadUtxos:input_type -> ReloadUtxosRequest
-	4,  // 56: Operator.ListUtxos:input_type -> ListUtxosRequest
-	2,  // 57: Operator.DropMarket:input_type -> DropMarketRequest
-	11, // 58: Operator.DepositMarket:output_type -> DepositMarketReply
-	13, // 59: Operator.ListDepositMarket:output_type -> ListDepositMarketReply
-	15, // 60: Operator.DepositFeeAccount:output_type -> DepositFeeAccountReply
-	17, // 61: Operator.BalanceFeeAccount:output_type -> BalanceFeeAccountReply
-	21, // 62: Operator.ClaimMarketDeposit:output_type -> ClaimMarketDepositReply
-	23, // 63: Operator.ClaimFeeDeposit:output_type -> ClaimFeeDepositReply
-	25, // 64: Operator.OpenMarket:output_type -> OpenMarketReply
-	27, // 65: Operator.CloseMarket:output_type -> CloseMarketReply
-	19, // 66: Operator.ListMarket:output_type -> ListMarketReply
-	32, // 67: Operator.UpdateMarketPercentageFee:output_type -> UpdateMarketFeeReply
-	32, // 68: Operator.UpdateMarketFixedFee:output_type -> UpdateMarketFeeReply
-	34, // 69: Operator.UpdateMarketPrice:output_type -> UpdateMarketPriceReply
-	29, // 70: Operator.UpdateMarketStrategy:output_type -> UpdateMarketStrategyReply
-	36, // 71: Operator.WithdrawMarket:output_type -> WithdrawMarketReply
-	38, // 72: Operator.ListTrades:output_type -> ListTradesReply
-	40, // 73: Operator.ReportMarketFee:output_type -> ReportMarketFeeReply
-	9,  // 74: Operator.ReloadUtxos:output_type -> ReloadUtxosReply
-	5,  // 75: Operator.ListUtxos:output_type -> ListUtxosReply
-	3,  // 76: Operator.DropMarket:output_type -> DropMarketReply
-	58, // [58:77] is the sub-list for method output_type
-	39, // [39:58] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+
+// Deprecated: Use RotateBlindingKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateBlindingKeyRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{52}
 }
 
-func init() { file_operator_proto_init() }
-func file_operator_proto_init() {
-	if File_operator_proto != nil {
-		return
+func (x *RotateBlindingKeyRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_operator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropMarketRequest); i {
+	return ""
+}
+
+type RotateBlindingKeyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address replacing the rotated one, with its blinding key
+	AddressWithBlindingKey *types.AddressWithBlindingKey `protobuf:"bytes,1,opt,name=address_with_blinding_key,json=addressWithBlindingKey,proto3" json:"address_with_blinding_key,omitempty"`
+}
+
+func (x *RotateBlindingKeyReply) Reset() {
+	*x = RotateBlindingKeyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateBlindingKeyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateBlindingKeyReply) ProtoMessage() {}
+
+func (x *RotateBlindingKeyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateBlindingKeyReply.ProtoReflect.Descriptor instead.
+func (*RotateBlindingKeyReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RotateBlindingKeyReply) GetAddressWithBlindingKey() *types.AddressWithBlindingKey {
+	if x != nil {
+		return x.AddressWithBlindingKey
+	}
+	return nil
+}
+
+type ClaimMarketDepositRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market    *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Outpoints []*TxOutpoint `protobuf:"bytes,2,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	// Optional label of the liquidity provider the deposits belong to
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *ClaimMarketDepositRequest) Reset() {
+	*x = ClaimMarketDepositRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimMarketDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMarketDepositRequest) ProtoMessage() {}
+
+func (x *ClaimMarketDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMarketDepositRequest.ProtoReflect.Descriptor instead.
+func (*ClaimMarketDepositRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ClaimMarketDepositRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *ClaimMarketDepositRequest) GetOutpoints() []*TxOutpoint {
+	if x != nil {
+		return x.Outpoints
+	}
+	return nil
+}
+
+func (x *ClaimMarketDepositRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type ClaimMarketDepositReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClaimMarketDepositReply) Reset() {
+	*x = ClaimMarketDepositReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimMarketDepositReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMarketDepositReply) ProtoMessage() {}
+
+func (x *ClaimMarketDepositReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMarketDepositReply.ProtoReflect.Descriptor instead.
+func (*ClaimMarketDepositReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{55}
+}
+
+type BalanceByLabelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *BalanceByLabelRequest) Reset() {
+	*x = BalanceByLabelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceByLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceByLabelRequest) ProtoMessage() {}
+
+func (x *BalanceByLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceByLabelRequest.ProtoReflect.Descriptor instead.
+func (*BalanceByLabelRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BalanceByLabelRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type BalanceByLabelReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The share of the market balance of every label. Unlabeled deposits are
+	// grouped under the empty label.
+	Balance map[string]*types.Balance `protobuf:"bytes,1,rep,name=balance,proto3" json:"balance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BalanceByLabelReply) Reset() {
+	*x = BalanceByLabelReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceByLabelReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceByLabelReply) ProtoMessage() {}
+
+func (x *BalanceByLabelReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceByLabelReply.ProtoReflect.Descriptor instead.
+func (*BalanceByLabelReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BalanceByLabelReply) GetBalance() map[string]*types.Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type FundMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Market to be funded
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Amounts of base and quote asset to request from the faucet
+	Amounts *types.Balance `protobuf:"bytes,2,opt,name=amounts,proto3" json:"amounts,omitempty"`
+}
+
+func (x *FundMarketRequest) Reset() {
+	*x = FundMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FundMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundMarketRequest) ProtoMessage() {}
+
+func (x *FundMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundMarketRequest.ProtoReflect.Descriptor instead.
+func (*FundMarketRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FundMarketRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *FundMarketRequest) GetAmounts() *types.Balance {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+type FundMarketReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FundMarketReply) Reset() {
+	*x = FundMarketReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FundMarketReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundMarketReply) ProtoMessage() {}
+
+func (x *FundMarketReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundMarketReply.ProtoReflect.Descriptor instead.
+func (*FundMarketReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{59}
+}
+
+type ClaimFeeDepositRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outpoints []*TxOutpoint `protobuf:"bytes,1,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+}
+
+func (x *ClaimFeeDepositRequest) Reset() {
+	*x = ClaimFeeDepositRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimFeeDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimFeeDepositRequest) ProtoMessage() {}
+
+func (x *ClaimFeeDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimFeeDepositRequest.ProtoReflect.Descriptor instead.
+func (*ClaimFeeDepositRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ClaimFeeDepositRequest) GetOutpoints() []*TxOutpoint {
+	if x != nil {
+		return x.Outpoints
+	}
+	return nil
+}
+
+type ClaimFeeDepositReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ClaimFeeDepositReply) Reset() {
+	*x = ClaimFeeDepositReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimFeeDepositReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimFeeDepositReply) ProtoMessage() {}
+
+func (x *ClaimFeeDepositReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimFeeDepositReply.ProtoReflect.Descriptor instead.
+func (*ClaimFeeDepositReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{61}
+}
+
+type ImportUtxoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountIndex uint64      `protobuf:"varint,1,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+	Outpoint     *TxOutpoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	BlindingKey  string      `protobuf:"bytes,3,opt,name=blinding_key,json=blindingKey,proto3" json:"blinding_key,omitempty"` // Hex encoded private blinding key
+}
+
+func (x *ImportUtxoRequest) Reset() {
+	*x = ImportUtxoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUtxoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUtxoRequest) ProtoMessage() {}
+
+func (x *ImportUtxoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUtxoRequest.ProtoReflect.Descriptor instead.
+func (*ImportUtxoRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ImportUtxoRequest) GetAccountIndex() uint64 {
+	if x != nil {
+		return x.AccountIndex
+	}
+	return 0
+}
+
+func (x *ImportUtxoRequest) GetOutpoint() *TxOutpoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+func (x *ImportUtxoRequest) GetBlindingKey() string {
+	if x != nil {
+		return x.BlindingKey
+	}
+	return ""
+}
+
+type ImportUtxoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ImportUtxoReply) Reset() {
+	*x = ImportUtxoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUtxoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUtxoReply) ProtoMessage() {}
+
+func (x *ImportUtxoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUtxoReply.ProtoReflect.Descriptor instead.
+func (*ImportUtxoReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{63}
+}
+
+type OpenMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"` // Market to be made tradable
+}
+
+func (x *OpenMarketRequest) Reset() {
+	*x = OpenMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenMarketRequest) ProtoMessage() {}
+
+func (x *OpenMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenMarketRequest.ProtoReflect.Descriptor instead.
+func (*OpenMarketRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *OpenMarketRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type OpenMarketReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OpenMarketReply) Reset() {
+	*x = OpenMarketReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenMarketReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenMarketReply) ProtoMessage() {}
+
+func (x *OpenMarketReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenMarketReply.ProtoReflect.Descriptor instead.
+func (*OpenMarketReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{65}
+}
+
+type CloseMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"` // Market to be made NOT tradable
+}
+
+func (x *CloseMarketRequest) Reset() {
+	*x = CloseMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseMarketRequest) ProtoMessage() {}
+
+func (x *CloseMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseMarketRequest.ProtoReflect.Descriptor instead.
+func (*CloseMarketRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CloseMarketRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type CloseMarketReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CloseMarketReply) Reset() {
+	*x = CloseMarketReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseMarketReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseMarketReply) ProtoMessage() {}
+
+func (x *CloseMarketReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseMarketReply.ProtoReflect.Descriptor instead.
+func (*CloseMarketReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{67}
+}
+
+type PauseMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"` // Market to be paused
+}
+
+func (x *PauseMarketRequest) Reset() {
+	*x = PauseMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseMarketRequest) ProtoMessage() {}
+
+func (x *PauseMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseMarketRequest.ProtoReflect.Descriptor instead.
+func (*PauseMarketRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PauseMarketRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type PauseMarketReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PauseMarketReply) Reset() {
+	*x = PauseMarketReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseMarketReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseMarketReply) ProtoMessage() {}
+
+func (x *PauseMarketReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseMarketReply.ProtoReflect.Descriptor instead.
+func (*PauseMarketReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{69}
+}
+
+type ResumeMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"` // Market to be resumed
+}
+
+func (x *ResumeMarketRequest) Reset() {
+	*x = ResumeMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeMarketRequest) ProtoMessage() {}
+
+func (x *ResumeMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeMarketRequest.ProtoReflect.Descriptor instead.
+func (*ResumeMarketRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ResumeMarketRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type ResumeMarketReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResumeMarketReply) Reset() {
+	*x = ResumeMarketReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeMarketReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeMarketReply) ProtoMessage() {}
+
+func (x *ResumeMarketReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeMarketReply.ProtoReflect.Descriptor instead.
+func (*ResumeMarketReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{71}
+}
+
+type UpdateMarketStrategyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Market to change the strategy of
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Market making strategy to use
+	StrategyType StrategyType `protobuf:"varint,2,opt,name=strategy_type,json=strategyType,proto3,enum=StrategyType" json:"strategy_type,omitempty"`
+	//
+	//JSON string representing additional metadata relative to the chosen strategy.
+	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *UpdateMarketStrategyRequest) Reset() {
+	*x = UpdateMarketStrategyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketStrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketStrategyRequest) ProtoMessage() {}
+
+func (x *UpdateMarketStrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketStrategyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMarketStrategyRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpdateMarketStrategyRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *UpdateMarketStrategyRequest) GetStrategyType() StrategyType {
+	if x != nil {
+		return x.StrategyType
+	}
+	return StrategyType_PLUGGABLE
+}
+
+func (x *UpdateMarketStrategyRequest) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+type UpdateMarketStrategyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateMarketStrategyReply) Reset() {
+	*x = UpdateMarketStrategyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketStrategyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketStrategyReply) ProtoMessage() {}
+
+func (x *UpdateMarketStrategyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketStrategyReply.ProtoReflect.Descriptor instead.
+func (*UpdateMarketStrategyReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{73}
+}
+
+type SwitchMarketStrategyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Market to switch the strategy of
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Market making strategy to switch to
+	StrategyType StrategyType `protobuf:"varint,2,opt,name=strategy_type,json=strategyType,proto3,enum=StrategyType" json:"strategy_type,omitempty"`
+	// Starting price of a PLUGGABLE strategy. Must be empty for a BALANCED one.
+	Price *types.Price `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *SwitchMarketStrategyRequest) Reset() {
+	*x = SwitchMarketStrategyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchMarketStrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchMarketStrategyRequest) ProtoMessage() {}
+
+func (x *SwitchMarketStrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchMarketStrategyRequest.ProtoReflect.Descriptor instead.
+func (*SwitchMarketStrategyRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SwitchMarketStrategyRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *SwitchMarketStrategyRequest) GetStrategyType() StrategyType {
+	if x != nil {
+		return x.StrategyType
+	}
+	return StrategyType_PLUGGABLE
+}
+
+func (x *SwitchMarketStrategyRequest) GetPrice() *types.Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type SwitchMarketStrategyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SwitchMarketStrategyReply) Reset() {
+	*x = SwitchMarketStrategyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchMarketStrategyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchMarketStrategyReply) ProtoMessage() {}
+
+func (x *SwitchMarketStrategyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchMarketStrategyReply.ProtoReflect.Descriptor instead.
+func (*SwitchMarketStrategyReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{75}
+}
+
+type UpdateMarketPercentageFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market     *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	BasisPoint int64         `protobuf:"varint,2,opt,name=basis_point,json=basisPoint,proto3" json:"basis_point,omitempty"`
+}
+
+func (x *UpdateMarketPercentageFeeRequest) Reset() {
+	*x = UpdateMarketPercentageFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketPercentageFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketPercentageFeeRequest) ProtoMessage() {}
+
+func (x *UpdateMarketPercentageFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketPercentageFeeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMarketPercentageFeeRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpdateMarketPercentageFeeRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *UpdateMarketPercentageFeeRequest) GetBasisPoint() int64 {
+	if x != nil {
+		return x.BasisPoint
+	}
+	return 0
+}
+
+type UpdateMarketFixedFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Fixed  *types.Fixed  `protobuf:"bytes,2,opt,name=fixed,proto3" json:"fixed,omitempty"`
+}
+
+func (x *UpdateMarketFixedFeeRequest) Reset() {
+	*x = UpdateMarketFixedFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketFixedFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketFixedFeeRequest) ProtoMessage() {}
+
+func (x *UpdateMarketFixedFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketFixedFeeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMarketFixedFeeRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UpdateMarketFixedFeeRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *UpdateMarketFixedFeeRequest) GetFixed() *types.Fixed {
+	if x != nil {
+		return x.Fixed
+	}
+	return nil
+}
+
+type UpdateMarketFeeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketWithFee *types.MarketWithFee `protobuf:"bytes,1,opt,name=market_with_fee,json=marketWithFee,proto3" json:"market_with_fee,omitempty"`
+}
+
+func (x *UpdateMarketFeeReply) Reset() {
+	*x = UpdateMarketFeeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketFeeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketFeeReply) ProtoMessage() {}
+
+func (x *UpdateMarketFeeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketFeeReply.ProtoReflect.Descriptor instead.
+func (*UpdateMarketFeeReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpdateMarketFeeReply) GetMarketWithFee() *types.MarketWithFee {
+	if x != nil {
+		return x.MarketWithFee
+	}
+	return nil
+}
+
+type UpdateMarketFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market     *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	BasisPoint int64         `protobuf:"varint,2,opt,name=basis_point,json=basisPoint,proto3" json:"basis_point,omitempty"`
+	// The Unix timestamp since when the fee is effective, 0 means now
+	EffectiveAt uint64 `protobuf:"varint,3,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+}
+
+func (x *UpdateMarketFeeRequest) Reset() {
+	*x = UpdateMarketFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketFeeRequest) ProtoMessage() {}
+
+func (x *UpdateMarketFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketFeeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMarketFeeRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateMarketFeeRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *UpdateMarketFeeRequest) GetBasisPoint() int64 {
+	if x != nil {
+		return x.BasisPoint
+	}
+	return 0
+}
+
+func (x *UpdateMarketFeeRequest) GetEffectiveAt() uint64 {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return 0
+}
+
+type MarketFeeHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *MarketFeeHistoryRequest) Reset() {
+	*x = MarketFeeHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketFeeHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketFeeHistoryRequest) ProtoMessage() {}
+
+func (x *MarketFeeHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketFeeHistoryRequest.ProtoReflect.Descriptor instead.
+func (*MarketFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *MarketFeeHistoryRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type MarketFeeHistoryReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*FeeChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *MarketFeeHistoryReply) Reset() {
+	*x = MarketFeeHistoryReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketFeeHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketFeeHistoryReply) ProtoMessage() {}
+
+func (x *MarketFeeHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketFeeHistoryReply.ProtoReflect.Descriptor instead.
+func (*MarketFeeHistoryReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *MarketFeeHistoryReply) GetChanges() []*FeeChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type FeeChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BasisPoint int64 `protobuf:"varint,1,opt,name=basis_point,json=basisPoint,proto3" json:"basis_point,omitempty"`
+	// The Unix timestamp since when the fee is effective
+	EffectiveAt uint64 `protobuf:"varint,2,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	// The Unix timestamp of the request of the change
+	CreatedAt uint64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Whether the change is not yet effective
+	Pending bool `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *FeeChange) Reset() {
+	*x = FeeChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeChange) ProtoMessage() {}
+
+func (x *FeeChange) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeChange.ProtoReflect.Descriptor instead.
+func (*FeeChange) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *FeeChange) GetBasisPoint() int64 {
+	if x != nil {
+		return x.BasisPoint
+	}
+	return 0
+}
+
+func (x *FeeChange) GetEffectiveAt() uint64 {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return 0
+}
+
+func (x *FeeChange) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *FeeChange) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+type FeeTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The min volume, in satoshis of base asset, of the settled trades
+	MinVolume uint64 `protobuf:"varint,1,opt,name=min_volume,json=minVolume,proto3" json:"min_volume,omitempty"`
+	// The percentage fee, in basis point, of the tier
+	BasisPoint int64 `protobuf:"varint,2,opt,name=basis_point,json=basisPoint,proto3" json:"basis_point,omitempty"`
+}
+
+func (x *FeeTier) Reset() {
+	*x = FeeTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeTier) ProtoMessage() {}
+
+func (x *FeeTier) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeTier.ProtoReflect.Descriptor instead.
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *FeeTier) GetMinVolume() uint64 {
+	if x != nil {
+		return x.MinVolume
+	}
+	return 0
+}
+
+func (x *FeeTier) GetBasisPoint() int64 {
+	if x != nil {
+		return x.BasisPoint
+	}
+	return 0
+}
+
+type UpdateMarketFeeTiersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market   *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	FeeTiers []*FeeTier    `protobuf:"bytes,2,rep,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers,omitempty"`
+}
+
+func (x *UpdateMarketFeeTiersRequest) Reset() {
+	*x = UpdateMarketFeeTiersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketFeeTiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketFeeTiersRequest) ProtoMessage() {}
+
+func (x *UpdateMarketFeeTiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketFeeTiersRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMarketFeeTiersRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdateMarketFeeTiersRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *UpdateMarketFeeTiersRequest) GetFeeTiers() []*FeeTier {
+	if x != nil {
+		return x.FeeTiers
+	}
+	return nil
+}
+
+type UpdateMarketFeeTiersReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateMarketFeeTiersReply) Reset() {
+	*x = UpdateMarketFeeTiersReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketFeeTiersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketFeeTiersReply) ProtoMessage() {}
+
+func (x *UpdateMarketFeeTiersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketFeeTiersReply.ProtoReflect.Descriptor instead.
+func (*UpdateMarketFeeTiersReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{85}
+}
+
+type UpdateMarketTradeLimitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// The min amount of a trade, 0 means unbounded
+	MinTradeAmount uint64 `protobuf:"varint,2,opt,name=min_trade_amount,json=minTradeAmount,proto3" json:"min_trade_amount,omitempty"`
+	// The max amount of a trade, 0 means unbounded
+	MaxTradeAmount uint64 `protobuf:"varint,3,opt,name=max_trade_amount,json=maxTradeAmount,proto3" json:"max_trade_amount,omitempty"`
+}
+
+func (x *UpdateMarketTradeLimitsRequest) Reset() {
+	*x = UpdateMarketTradeLimitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketTradeLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketTradeLimitsRequest) ProtoMessage() {}
+
+func (x *UpdateMarketTradeLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketTradeLimitsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMarketTradeLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateMarketTradeLimitsRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *UpdateMarketTradeLimitsRequest) GetMinTradeAmount() uint64 {
+	if x != nil {
+		return x.MinTradeAmount
+	}
+	return 0
+}
+
+func (x *UpdateMarketTradeLimitsRequest) GetMaxTradeAmount() uint64 {
+	if x != nil {
+		return x.MaxTradeAmount
+	}
+	return 0
+}
+
+type UpdateMarketTradeLimitsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateMarketTradeLimitsReply) Reset() {
+	*x = UpdateMarketTradeLimitsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketTradeLimitsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketTradeLimitsReply) ProtoMessage() {}
+
+func (x *UpdateMarketTradeLimitsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketTradeLimitsReply.ProtoReflect.Descriptor instead.
+func (*UpdateMarketTradeLimitsReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{87}
+}
+
+type UpdateMarketPricePrecisionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// The number of decimal places of the prices, 0 means no rounding
+	Precision uint32 `protobuf:"varint,2,opt,name=precision,proto3" json:"precision,omitempty"`
+}
+
+func (x *UpdateMarketPricePrecisionRequest) Reset() {
+	*x = UpdateMarketPricePrecisionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketPricePrecisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketPricePrecisionRequest) ProtoMessage() {}
+
+func (x *UpdateMarketPricePrecisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketPricePrecisionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMarketPricePrecisionRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UpdateMarketPricePrecisionRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *UpdateMarketPricePrecisionRequest) GetPrecision() uint32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+type UpdateMarketPricePrecisionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateMarketPricePrecisionReply) Reset() {
+	*x = UpdateMarketPricePrecisionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketPricePrecisionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketPricePrecisionReply) ProtoMessage() {}
+
+func (x *UpdateMarketPricePrecisionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketPricePrecisionReply.ProtoReflect.Descriptor instead.
+func (*UpdateMarketPricePrecisionReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{89}
+}
+
+type UpdateMarketSettlementTimeoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// The timeout in seconds since acceptance, 0 means no deadline
+	Timeout uint64 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *UpdateMarketSettlementTimeoutRequest) Reset() {
+	*x = UpdateMarketSettlementTimeoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketSettlementTimeoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketSettlementTimeoutRequest) ProtoMessage() {}
+
+func (x *UpdateMarketSettlementTimeoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketSettlementTimeoutRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMarketSettlementTimeoutRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *UpdateMarketSettlementTimeoutRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *UpdateMarketSettlementTimeoutRequest) GetTimeout() uint64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type UpdateMarketSettlementTimeoutReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateMarketSettlementTimeoutReply) Reset() {
+	*x = UpdateMarketSettlementTimeoutReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketSettlementTimeoutReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketSettlementTimeoutReply) ProtoMessage() {}
+
+func (x *UpdateMarketSettlementTimeoutReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketSettlementTimeoutReply.ProtoReflect.Descriptor instead.
+func (*UpdateMarketSettlementTimeoutReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{91}
+}
+
+type UpdateMarketPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Market to update the price of
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Price to be updated
+	Price *types.Price `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *UpdateMarketPriceRequest) Reset() {
+	*x = UpdateMarketPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketPriceRequest) ProtoMessage() {}
+
+func (x *UpdateMarketPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketPriceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMarketPriceRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UpdateMarketPriceRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *UpdateMarketPriceRequest) GetPrice() *types.Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type UpdateMarketPriceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateMarketPriceReply) Reset() {
+	*x = UpdateMarketPriceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketPriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketPriceReply) ProtoMessage() {}
+
+func (x *UpdateMarketPriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketPriceReply.ProtoReflect.Descriptor instead.
+func (*UpdateMarketPriceReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{93}
+}
+
+type WithdrawMarketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Market from which funds need to be withdrawn
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Amount for base and quote asset to be withdrawn
+	BalanceToWithdraw *types.Balance `protobuf:"bytes,2,opt,name=balance_to_withdraw,json=balanceToWithdraw,proto3" json:"balance_to_withdraw,omitempty"`
+	// The number of millisatoshis per byte that should be
+	// used when crafting this transaction.
+	MillisatPerByte int64 `protobuf:"varint,3,opt,name=millisat_per_byte,json=millisatPerByte,proto3" json:"millisat_per_byte,omitempty"`
+	// The address to send to the funds
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// Optional: if true the transaction will be pushed to the network
+	Push bool `protobuf:"varint,5,opt,name=push,proto3" json:"push,omitempty"`
+	// Optional: the minimum balance of base and quote asset that must be left
+	// in the market after the withdrawal
+	MinReserve *types.Balance `protobuf:"bytes,6,opt,name=min_reserve,json=minReserve,proto3" json:"min_reserve,omitempty"`
+	// Optional: the alternative to balance_to_withdraw expressed in the display
+	// units of the assets, converted to satoshi with their precision
+	BalanceToWithdrawDisplay *DisplayBalance `protobuf:"bytes,7,opt,name=balance_to_withdraw_display,json=balanceToWithdrawDisplay,proto3" json:"balance_to_withdraw_display,omitempty"`
+}
+
+func (x *WithdrawMarketRequest) Reset() {
+	*x = WithdrawMarketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawMarketRequest) ProtoMessage() {}
+
+func (x *WithdrawMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawMarketRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawMarketRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *WithdrawMarketRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *WithdrawMarketRequest) GetBalanceToWithdraw() *types.Balance {
+	if x != nil {
+		return x.BalanceToWithdraw
+	}
+	return nil
+}
+
+func (x *WithdrawMarketRequest) GetMillisatPerByte() int64 {
+	if x != nil {
+		return x.MillisatPerByte
+	}
+	return 0
+}
+
+func (x *WithdrawMarketRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WithdrawMarketRequest) GetPush() bool {
+	if x != nil {
+		return x.Push
+	}
+	return false
+}
+
+func (x *WithdrawMarketRequest) GetMinReserve() *types.Balance {
+	if x != nil {
+		return x.MinReserve
+	}
+	return nil
+}
+
+func (x *WithdrawMarketRequest) GetBalanceToWithdrawDisplay() *DisplayBalance {
+	if x != nil {
+		return x.BalanceToWithdrawDisplay
+	}
+	return nil
+}
+
+type WithdrawMarketReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serialized transaction
+	RawTx []byte `protobuf:"bytes,1,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+}
+
+func (x *WithdrawMarketReply) Reset() {
+	*x = WithdrawMarketReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawMarketReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawMarketReply) ProtoMessage() {}
+
+func (x *WithdrawMarketReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawMarketReply.ProtoReflect.Descriptor instead.
+func (*WithdrawMarketReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *WithdrawMarketReply) GetRawTx() []byte {
+	if x != nil {
+		return x.RawTx
+	}
+	return nil
+}
+
+// A balance in the display units of the assets, as decimal strings like "1.5"
+type DisplayBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseAmount  string `protobuf:"bytes,1,opt,name=base_amount,json=baseAmount,proto3" json:"base_amount,omitempty"`
+	QuoteAmount string `protobuf:"bytes,2,opt,name=quote_amount,json=quoteAmount,proto3" json:"quote_amount,omitempty"`
+}
+
+func (x *DisplayBalance) Reset() {
+	*x = DisplayBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisplayBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplayBalance) ProtoMessage() {}
+
+func (x *DisplayBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplayBalance.ProtoReflect.Descriptor instead.
+func (*DisplayBalance) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DisplayBalance) GetBaseAmount() string {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return ""
+}
+
+func (x *DisplayBalance) GetQuoteAmount() string {
+	if x != nil {
+		return x.QuoteAmount
+	}
+	return ""
+}
+
+type EstimateWithdrawFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The withdrawal to estimate, its push field is ignored
+	Withdrawal *WithdrawMarketRequest `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal,omitempty"`
+}
+
+func (x *EstimateWithdrawFeeRequest) Reset() {
+	*x = EstimateWithdrawFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateWithdrawFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateWithdrawFeeRequest) ProtoMessage() {}
+
+func (x *EstimateWithdrawFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateWithdrawFeeRequest.ProtoReflect.Descriptor instead.
+func (*EstimateWithdrawFeeRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *EstimateWithdrawFeeRequest) GetWithdrawal() *WithdrawMarketRequest {
+	if x != nil {
+		return x.Withdrawal
+	}
+	return nil
+}
+
+type EstimateWithdrawFeeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The virtual size of the signed transaction
+	Vsize uint64 `protobuf:"varint,1,opt,name=vsize,proto3" json:"vsize,omitempty"`
+	// The network fee amount paid by the transaction
+	FeeAmount uint64 `protobuf:"varint,2,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+}
+
+func (x *EstimateWithdrawFeeReply) Reset() {
+	*x = EstimateWithdrawFeeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateWithdrawFeeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateWithdrawFeeReply) ProtoMessage() {}
+
+func (x *EstimateWithdrawFeeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateWithdrawFeeReply.ProtoReflect.Descriptor instead.
+func (*EstimateWithdrawFeeReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *EstimateWithdrawFeeReply) GetVsize() uint64 {
+	if x != nil {
+		return x.Vsize
+	}
+	return 0
+}
+
+func (x *EstimateWithdrawFeeReply) GetFeeAmount() uint64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+type WithdrawMultipleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The withdrawals, at most one per market. The push field is ignored since
+	// the transaction is always broadcasted, while the highest fee rate is used.
+	Withdrawals []*WithdrawMarketRequest `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+}
+
+func (x *WithdrawMultipleRequest) Reset() {
+	*x = WithdrawMultipleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawMultipleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawMultipleRequest) ProtoMessage() {}
+
+func (x *WithdrawMultipleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawMultipleRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawMultipleRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *WithdrawMultipleRequest) GetWithdrawals() []*WithdrawMarketRequest {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
+type WithdrawMultipleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hash of the broadcasted transaction
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *WithdrawMultipleReply) Reset() {
+	*x = WithdrawMultipleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithdrawMultipleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawMultipleReply) ProtoMessage() {}
+
+func (x *WithdrawMultipleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawMultipleReply.ProtoReflect.Descriptor instead.
+func (*WithdrawMultipleReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *WithdrawMultipleReply) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type BumpWithdrawFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hash of the withdrawal transaction to replace
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// The number of millisatoshis per byte to use for the replacement
+	// transaction. It must be greater than the one of the replaced transaction.
+	MillisatPerByte int64 `protobuf:"varint,2,opt,name=millisat_per_byte,json=millisatPerByte,proto3" json:"millisat_per_byte,omitempty"`
+}
+
+func (x *BumpWithdrawFeeRequest) Reset() {
+	*x = BumpWithdrawFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpWithdrawFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpWithdrawFeeRequest) ProtoMessage() {}
+
+func (x *BumpWithdrawFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpWithdrawFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpWithdrawFeeRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *BumpWithdrawFeeRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *BumpWithdrawFeeRequest) GetMillisatPerByte() int64 {
+	if x != nil {
+		return x.MillisatPerByte
+	}
+	return 0
+}
+
+type BumpWithdrawFeeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hash of the replacement transaction
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *BumpWithdrawFeeReply) Reset() {
+	*x = BumpWithdrawFeeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpWithdrawFeeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpWithdrawFeeReply) ProtoMessage() {}
+
+func (x *BumpWithdrawFeeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpWithdrawFeeReply.ProtoReflect.Descriptor instead.
+func (*BumpWithdrawFeeReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *BumpWithdrawFeeReply) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type ListTradesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTradesRequest) Reset() {
+	*x = ListTradesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradesRequest) ProtoMessage() {}
+
+func (x *ListTradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradesRequest.ProtoReflect.Descriptor instead.
+func (*ListTradesRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{103}
+}
+
+type ListTradesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trades []*TradeInfo `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
+}
+
+func (x *ListTradesReply) Reset() {
+	*x = ListTradesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTradesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradesReply) ProtoMessage() {}
+
+func (x *ListTradesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradesReply.ProtoReflect.Descriptor instead.
+func (*ListTradesReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListTradesReply) GetTrades() []*TradeInfo {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+type GetTradeByTxidRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *GetTradeByTxidRequest) Reset() {
+	*x = GetTradeByTxidRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTradeByTxidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeByTxidRequest) ProtoMessage() {}
+
+func (x *GetTradeByTxidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeByTxidRequest.ProtoReflect.Descriptor instead.
+func (*GetTradeByTxidRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetTradeByTxidRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type GetTradeByTxidReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trade *TradeInfo `protobuf:"bytes,1,opt,name=trade,proto3" json:"trade,omitempty"`
+}
+
+func (x *GetTradeByTxidReply) Reset() {
+	*x = GetTradeByTxidReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTradeByTxidReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeByTxidReply) ProtoMessage() {}
+
+func (x *GetTradeByTxidReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeByTxidReply.ProtoReflect.Descriptor instead.
+func (*GetTradeByTxidReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetTradeByTxidReply) GetTrade() *TradeInfo {
+	if x != nil {
+		return x.Trade
+	}
+	return nil
+}
+
+type CancelTradeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeId string `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+}
+
+func (x *CancelTradeRequest) Reset() {
+	*x = CancelTradeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelTradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTradeRequest) ProtoMessage() {}
+
+func (x *CancelTradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTradeRequest.ProtoReflect.Descriptor instead.
+func (*CancelTradeRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *CancelTradeRequest) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+type CancelTradeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelTradeReply) Reset() {
+	*x = CancelTradeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelTradeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTradeReply) ProtoMessage() {}
+
+func (x *CancelTradeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTradeReply.ProtoReflect.Descriptor instead.
+func (*CancelTradeReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{108}
+}
+
+type TradeAuditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeId string `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+}
+
+func (x *TradeAuditRequest) Reset() {
+	*x = TradeAuditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeAuditRequest) ProtoMessage() {}
+
+func (x *TradeAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeAuditRequest.ProtoReflect.Descriptor instead.
+func (*TradeAuditRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *TradeAuditRequest) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+type TradeAuditReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeId        string `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Txid           string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	SettleTimeUnix uint64 `protobuf:"varint,3,opt,name=settle_time_unix,json=settleTimeUnix,proto3" json:"settle_time_unix,omitempty"`
+	// Unspents of the market and of the fee account spent by the transaction.
+	MarketInputs []*AuditOutpoint `protobuf:"bytes,4,rep,name=market_inputs,json=marketInputs,proto3" json:"market_inputs,omitempty"`
+	FeeInputs    []*AuditOutpoint `protobuf:"bytes,5,rep,name=fee_inputs,json=feeInputs,proto3" json:"fee_inputs,omitempty"`
+	// Output receiving the asset sent by the trader to the market.
+	MarketOutput *AuditOutpoint `protobuf:"bytes,6,opt,name=market_output,json=marketOutput,proto3" json:"market_output,omitempty"`
+	// Changes returned to the market and to the fee account.
+	ChangeOutputs []*AuditOutpoint `protobuf:"bytes,7,rep,name=change_outputs,json=changeOutputs,proto3" json:"change_outputs,omitempty"`
+	// Output receiving the asset sent by the market to the trader.
+	CounterpartyOutput *AuditOutpoint `protobuf:"bytes,8,opt,name=counterparty_output,json=counterpartyOutput,proto3" json:"counterparty_output,omitempty"`
+	CreatedAt          uint64         `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *TradeAuditReply) Reset() {
+	*x = TradeAuditReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeAuditReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeAuditReply) ProtoMessage() {}
+
+func (x *TradeAuditReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeAuditReply.ProtoReflect.Descriptor instead.
+func (*TradeAuditReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *TradeAuditReply) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *TradeAuditReply) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *TradeAuditReply) GetSettleTimeUnix() uint64 {
+	if x != nil {
+		return x.SettleTimeUnix
+	}
+	return 0
+}
+
+func (x *TradeAuditReply) GetMarketInputs() []*AuditOutpoint {
+	if x != nil {
+		return x.MarketInputs
+	}
+	return nil
+}
+
+func (x *TradeAuditReply) GetFeeInputs() []*AuditOutpoint {
+	if x != nil {
+		return x.FeeInputs
+	}
+	return nil
+}
+
+func (x *TradeAuditReply) GetMarketOutput() *AuditOutpoint {
+	if x != nil {
+		return x.MarketOutput
+	}
+	return nil
+}
+
+func (x *TradeAuditReply) GetChangeOutputs() []*AuditOutpoint {
+	if x != nil {
+		return x.ChangeOutputs
+	}
+	return nil
+}
+
+func (x *TradeAuditReply) GetCounterpartyOutput() *AuditOutpoint {
+	if x != nil {
+		return x.CounterpartyOutput
+	}
+	return nil
+}
+
+func (x *TradeAuditReply) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// AuditOutpoint is an input or output of the transaction of a trade. The
+// account index is -1 for those not owned by the daemon.
+type AuditOutpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid         string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Vout         uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	AccountIndex int32  `protobuf:"varint,3,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+	Asset        string `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount       uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Script       string `protobuf:"bytes,6,opt,name=script,proto3" json:"script,omitempty"`
+}
+
+func (x *AuditOutpoint) Reset() {
+	*x = AuditOutpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditOutpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditOutpoint) ProtoMessage() {}
+
+func (x *AuditOutpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditOutpoint.ProtoReflect.Descriptor instead.
+func (*AuditOutpoint) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *AuditOutpoint) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *AuditOutpoint) GetVout() uint32 {
+	if x != nil {
+		return x.Vout
+	}
+	return 0
+}
+
+func (x *AuditOutpoint) GetAccountIndex() int32 {
+	if x != nil {
+		return x.AccountIndex
+	}
+	return 0
+}
+
+func (x *AuditOutpoint) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *AuditOutpoint) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AuditOutpoint) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+type ExportTradesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start and end of the time window as Unix timestamps, both included.
+	From   uint64       `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     uint64       `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Format ExportFormat `protobuf:"varint,3,opt,name=format,proto3,enum=ExportFormat" json:"format,omitempty"`
+}
+
+func (x *ExportTradesRequest) Reset() {
+	*x = ExportTradesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTradesRequest) ProtoMessage() {}
+
+func (x *ExportTradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTradesRequest.ProtoReflect.Descriptor instead.
+func (*ExportTradesRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ExportTradesRequest) GetFrom() uint64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ExportTradesRequest) GetTo() uint64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *ExportTradesRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_CSV
+}
+
+type ExportTradesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The next chunk of the encoded export
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ExportTradesReply) Reset() {
+	*x = ExportTradesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTradesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTradesReply) ProtoMessage() {}
+
+func (x *ExportTradesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTradesReply.ProtoReflect.Descriptor instead.
+func (*ExportTradesReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ExportTradesReply) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type SubscribeTradesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional: if set, only the trades of this market are streamed
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Optional: the trades with a lower status are not streamed
+	MinStatus TradeStatus `protobuf:"varint,2,opt,name=min_status,json=minStatus,proto3,enum=TradeStatus" json:"min_status,omitempty"`
+	// Optional: the number of seconds between heartbeats, between 1 and 3600.
+	// Defaults to 30
+	HeartbeatInterval uint64 `protobuf:"varint,3,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
+}
+
+func (x *SubscribeTradesRequest) Reset() {
+	*x = SubscribeTradesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeTradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeTradesRequest) ProtoMessage() {}
+
+func (x *SubscribeTradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeTradesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeTradesRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SubscribeTradesRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *SubscribeTradesRequest) GetMinStatus() TradeStatus {
+	if x != nil {
+		return x.MinStatus
+	}
+	return TradeStatus_UNDEFINED
+}
+
+func (x *SubscribeTradesRequest) GetHeartbeatInterval() uint64 {
+	if x != nil {
+		return x.HeartbeatInterval
+	}
+	return 0
+}
+
+type SubscribeTradesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The info about the updated trade, not set for heartbeats
+	Trade *TradeInfo `protobuf:"bytes,1,opt,name=trade,proto3" json:"trade,omitempty"`
+	// Whether the message is a heartbeat
+	Heartbeat bool `protobuf:"varint,2,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+}
+
+func (x *SubscribeTradesReply) Reset() {
+	*x = SubscribeTradesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeTradesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeTradesReply) ProtoMessage() {}
+
+func (x *SubscribeTradesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeTradesReply.ProtoReflect.Descriptor instead.
+func (*SubscribeTradesReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *SubscribeTradesReply) GetTrade() *TradeInfo {
+	if x != nil {
+		return x.Trade
+	}
+	return nil
+}
+
+func (x *SubscribeTradesReply) GetHeartbeat() bool {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return false
+}
+
+type ReportMarketFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"` // Market to be updated
+}
+
+func (x *ReportMarketFeeRequest) Reset() {
+	*x = ReportMarketFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportMarketFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportMarketFeeRequest) ProtoMessage() {}
+
+func (x *ReportMarketFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportMarketFeeRequest.ProtoReflect.Descriptor instead.
+func (*ReportMarketFeeRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ReportMarketFeeRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+type ReportMarketFeeRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Start and end of the time window as Unix timestamps, both included.
+	From uint64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   uint64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *ReportMarketFeeRangeRequest) Reset() {
+	*x = ReportMarketFeeRangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportMarketFeeRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportMarketFeeRangeRequest) ProtoMessage() {}
+
+func (x *ReportMarketFeeRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportMarketFeeRangeRequest.ProtoReflect.Descriptor instead.
+func (*ReportMarketFeeRangeRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ReportMarketFeeRangeRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *ReportMarketFeeRangeRequest) GetFrom() uint64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ReportMarketFeeRangeRequest) GetTo() uint64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type ReportMarketFeeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of the all unique fee being collected on each swap.
+	CollectedFees []*FeeInfo `protobuf:"bytes,1,rep,name=collected_fees,json=collectedFees,proto3" json:"collected_fees,omitempty"`
+	// Map of aggreagated fee count grouped by asset. There should be one unique
+	// entry for each asset and the amount should be the aggregated total.
+	TotalCollectedFeesPerAsset map[string]int64 `protobuf:"bytes,2,rep,name=total_collected_fees_per_asset,json=totalCollectedFeesPerAsset,proto3" json:"total_collected_fees_per_asset,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Map of the aggregated fiat value of the collected fees grouped by asset.
+	// It's empty if no fiat price source is configured.
+	TotalCollectedFeesFiat map[string]float32 `protobuf:"bytes,3,rep,name=total_collected_fees_fiat,json=totalCollectedFeesFiat,proto3" json:"total_collected_fees_fiat,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	// The fiat currency fiat values are expressed in.
+	FiatCurrency string `protobuf:"bytes,4,opt,name=fiat_currency,json=fiatCurrency,proto3" json:"fiat_currency,omitempty"`
+}
+
+func (x *ReportMarketFeeReply) Reset() {
+	*x = ReportMarketFeeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportMarketFeeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportMarketFeeReply) ProtoMessage() {}
+
+func (x *ReportMarketFeeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportMarketFeeReply.ProtoReflect.Descriptor instead.
+func (*ReportMarketFeeReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ReportMarketFeeReply) GetCollectedFees() []*FeeInfo {
+	if x != nil {
+		return x.CollectedFees
+	}
+	return nil
+}
+
+func (x *ReportMarketFeeReply) GetTotalCollectedFeesPerAsset() map[string]int64 {
+	if x != nil {
+		return x.TotalCollectedFeesPerAsset
+	}
+	return nil
+}
+
+func (x *ReportMarketFeeReply) GetTotalCollectedFeesFiat() map[string]float32 {
+	if x != nil {
+		return x.TotalCollectedFeesFiat
+	}
+	return nil
+}
+
+func (x *ReportMarketFeeReply) GetFiatCurrency() string {
+	if x != nil {
+		return x.FiatCurrency
+	}
+	return ""
+}
+
+type MarketInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market       *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Fee          *types.Fee    `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	Tradable     bool          `protobuf:"varint,3,opt,name=tradable,proto3" json:"tradable,omitempty"`
+	StrategyType StrategyType  `protobuf:"varint,4,opt,name=strategy_type,json=strategyType,proto3,enum=StrategyType" json:"strategy_type,omitempty"`
+	AccountIndex uint64        `protobuf:"varint,5,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+	Price        *types.Price  `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	// Whether the market is rejecting new trades while tradable
+	Paused bool `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+	// The number of decimal places the prices are rounded to, 0 means none
+	PricePrecision uint32 `protobuf:"varint,8,opt,name=price_precision,json=pricePrecision,proto3" json:"price_precision,omitempty"`
+	// Metadata of the assets of the market, not set if they can't be resolved
+	BaseAssetInfo  *AssetInfo `protobuf:"bytes,9,opt,name=base_asset_info,json=baseAssetInfo,proto3" json:"base_asset_info,omitempty"`
+	QuoteAssetInfo *AssetInfo `protobuf:"bytes,10,opt,name=quote_asset_info,json=quoteAssetInfo,proto3" json:"quote_asset_info,omitempty"`
+	// Seconds since acceptance within which trades must be settled, 0 means none
+	SettlementTimeout uint64 `protobuf:"varint,11,opt,name=settlement_timeout,json=settlementTimeout,proto3" json:"settlement_timeout,omitempty"`
+	// The fees charged to traders by volume, sorted by min volume
+	FeeTiers []*FeeTier `protobuf:"bytes,12,rep,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers,omitempty"`
+}
+
+func (x *MarketInfo) Reset() {
+	*x = MarketInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketInfo) ProtoMessage() {}
+
+func (x *MarketInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketInfo.ProtoReflect.Descriptor instead.
+func (*MarketInfo) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *MarketInfo) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *MarketInfo) GetFee() *types.Fee {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *MarketInfo) GetTradable() bool {
+	if x != nil {
+		return x.Tradable
+	}
+	return false
+}
+
+func (x *MarketInfo) GetStrategyType() StrategyType {
+	if x != nil {
+		return x.StrategyType
+	}
+	return StrategyType_PLUGGABLE
+}
+
+func (x *MarketInfo) GetAccountIndex() uint64 {
+	if x != nil {
+		return x.AccountIndex
+	}
+	return 0
+}
+
+func (x *MarketInfo) GetPrice() *types.Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *MarketInfo) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *MarketInfo) GetPricePrecision() uint32 {
+	if x != nil {
+		return x.PricePrecision
+	}
+	return 0
+}
+
+func (x *MarketInfo) GetBaseAssetInfo() *AssetInfo {
+	if x != nil {
+		return x.BaseAssetInfo
+	}
+	return nil
+}
+
+func (x *MarketInfo) GetQuoteAssetInfo() *AssetInfo {
+	if x != nil {
+		return x.QuoteAssetInfo
+	}
+	return nil
+}
+
+func (x *MarketInfo) GetSettlementTimeout() uint64 {
+	if x != nil {
+		return x.SettlementTimeout
+	}
+	return 0
+}
+
+func (x *MarketInfo) GetFeeTiers() []*FeeTier {
+	if x != nil {
+		return x.FeeTiers
+	}
+	return nil
+}
+
+type AssetInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset     string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Ticker    string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Precision uint32 `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
+	// Whether the asset is found in the asset registry
+	Registered bool `protobuf:"varint,5,opt,name=registered,proto3" json:"registered,omitempty"`
+}
+
+func (x *AssetInfo) Reset() {
+	*x = AssetInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetInfo) ProtoMessage() {}
+
+func (x *AssetInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetInfo.ProtoReflect.Descriptor instead.
+func (*AssetInfo) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *AssetInfo) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *AssetInfo) GetTicker() string {
+	if x != nil {
+		return x.Ticker
+	}
+	return ""
+}
+
+func (x *AssetInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssetInfo) GetPrecision() uint32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+func (x *AssetInfo) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
+type BlindingData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Index of the input or output in the PSET
+	Index         uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Asset         string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	AssetBlinder  []byte `protobuf:"bytes,4,opt,name=asset_blinder,json=assetBlinder,proto3" json:"asset_blinder,omitempty"`
+	AmountBlinder []byte `protobuf:"bytes,5,opt,name=amount_blinder,json=amountBlinder,proto3" json:"amount_blinder,omitempty"`
+}
+
+func (x *BlindingData) Reset() {
+	*x = BlindingData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlindingData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlindingData) ProtoMessage() {}
+
+func (x *BlindingData) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlindingData.ProtoReflect.Descriptor instead.
+func (*BlindingData) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *BlindingData) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BlindingData) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *BlindingData) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BlindingData) GetAssetBlinder() []byte {
+	if x != nil {
+		return x.AssetBlinder
+	}
+	return nil
+}
+
+func (x *BlindingData) GetAmountBlinder() []byte {
+	if x != nil {
+		return x.AmountBlinder
+	}
+	return nil
+}
+
+type TradeStatusInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status TradeStatus `protobuf:"varint,1,opt,name=status,proto3,enum=TradeStatus" json:"status,omitempty"`
+	Failed bool        `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *TradeStatusInfo) Reset() {
+	*x = TradeStatusInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeStatusInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeStatusInfo) ProtoMessage() {}
+
+func (x *TradeStatusInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeStatusInfo.ProtoReflect.Descriptor instead.
+func (*TradeStatusInfo) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *TradeStatusInfo) GetStatus() TradeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TradeStatus_UNDEFINED
+}
+
+func (x *TradeStatusInfo) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+type SwapInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The proposer's quantity
+	AmountP uint64 `protobuf:"varint,1,opt,name=amount_p,json=amountP,proto3" json:"amount_p,omitempty"`
+	// The proposer's asset hash
+	AssetP string `protobuf:"bytes,2,opt,name=asset_p,json=assetP,proto3" json:"asset_p,omitempty"`
+	// The responder's quantity
+	AmountR uint64 `protobuf:"varint,3,opt,name=amount_r,json=amountR,proto3" json:"amount_r,omitempty"`
+	// The responder's asset hash
+	AssetR string `protobuf:"bytes,4,opt,name=asset_r,json=assetR,proto3" json:"asset_r,omitempty"`
+	// The hex encoded value and asset commitments of the settlement tx output
+	// receiving the proposer's quantity. Set only for settled trades.
+	ValueCommitmentP string `protobuf:"bytes,5,opt,name=value_commitment_p,json=valueCommitmentP,proto3" json:"value_commitment_p,omitempty"`
+	AssetCommitmentP string `protobuf:"bytes,6,opt,name=asset_commitment_p,json=assetCommitmentP,proto3" json:"asset_commitment_p,omitempty"`
+	// The hex encoded value and asset commitments of the settlement tx output
+	// receiving the responder's quantity. Set only for settled trades.
+	ValueCommitmentR string `protobuf:"bytes,7,opt,name=value_commitment_r,json=valueCommitmentR,proto3" json:"value_commitment_r,omitempty"`
+	AssetCommitmentR string `protobuf:"bytes,8,opt,name=asset_commitment_r,json=assetCommitmentR,proto3" json:"asset_commitment_r,omitempty"`
+}
+
+func (x *SwapInfo) Reset() {
+	*x = SwapInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapInfo) ProtoMessage() {}
+
+func (x *SwapInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapInfo.ProtoReflect.Descriptor instead.
+func (*SwapInfo) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *SwapInfo) GetAmountP() uint64 {
+	if x != nil {
+		return x.AmountP
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetAssetP() string {
+	if x != nil {
+		return x.AssetP
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetAmountR() uint64 {
+	if x != nil {
+		return x.AmountR
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetAssetR() string {
+	if x != nil {
+		return x.AssetR
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetValueCommitmentP() string {
+	if x != nil {
+		return x.ValueCommitmentP
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetAssetCommitmentP() string {
+	if x != nil {
+		return x.AssetCommitmentP
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetValueCommitmentR() string {
+	if x != nil {
+		return x.ValueCommitmentR
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetAssetCommitmentR() string {
+	if x != nil {
+		return x.AssetCommitmentR
+	}
+	return ""
+}
+
+type SwapFailInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FailureCode    uint32 `protobuf:"varint,1,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
+	FailureMessage string `protobuf:"bytes,2,opt,name=failure_message,json=failureMessage,proto3" json:"failure_message,omitempty"`
+}
+
+func (x *SwapFailInfo) Reset() {
+	*x = SwapFailInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapFailInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapFailInfo) ProtoMessage() {}
+
+func (x *SwapFailInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapFailInfo.ProtoReflect.Descriptor instead.
+func (*SwapFailInfo) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SwapFailInfo) GetFailureCode() uint32 {
+	if x != nil {
+		return x.FailureCode
+	}
+	return 0
+}
+
+func (x *SwapFailInfo) GetFailureMessage() string {
+	if x != nil {
+		return x.FailureMessage
+	}
+	return ""
+}
+
+type TradePrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BasePrice  float64 `protobuf:"fixed64,1,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	QuotePrice float64 `protobuf:"fixed64,2,opt,name=quote_price,json=quotePrice,proto3" json:"quote_price,omitempty"`
+}
+
+func (x *TradePrice) Reset() {
+	*x = TradePrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradePrice) ProtoMessage() {}
+
+func (x *TradePrice) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradePrice.ProtoReflect.Descriptor instead.
+func (*TradePrice) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *TradePrice) GetBasePrice() float64 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+func (x *TradePrice) GetQuotePrice() float64 {
+	if x != nil {
+		return x.QuotePrice
+	}
+	return 0
+}
+
+type TradeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the trade
+	TradeId string `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	// The info about the status of the trade
+	Status *TradeStatusInfo `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// The info about the swap
+	SwapInfo *SwapInfo `protobuf:"bytes,3,opt,name=swap_info,json=swapInfo,proto3" json:"swap_info,omitempty"`
+	// The info of an eventually failed swap
+	FailInfo *SwapFailInfo `protobuf:"bytes,4,opt,name=fail_info,json=failInfo,proto3" json:"fail_info,omitempty"`
+	// The collected fee on the current swap
+	MarketWithFee *types.MarketWithFee `protobuf:"bytes,5,opt,name=market_with_fee,json=marketWithFee,proto3" json:"market_with_fee,omitempty"`
+	// The prices of the trade at request time
+	Price *TradePrice `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	// The eventual tx url with blinders in case the trade is settled
+	TxUrl string `protobuf:"bytes,7,opt,name=tx_url,json=txUrl,proto3" json:"tx_url,omitempty"`
+	// SwapRequest unix timestamp
+	RequestTimeUnix uint64 `protobuf:"varint,8,opt,name=request_time_unix,json=requestTimeUnix,proto3" json:"request_time_unix,omitempty"`
+	// SwapAccpet unix timestamp
+	AcceptTimeUnix uint64 `protobuf:"varint,9,opt,name=accept_time_unix,json=acceptTimeUnix,proto3" json:"accept_time_unix,omitempty"`
+	// SwapComplete unix timestamp
+	CompleteTimeUnix uint64 `protobuf:"varint,10,opt,name=complete_time_unix,json=completeTimeUnix,proto3" json:"complete_time_unix,omitempty"`
+	// Settlement unix timestamp
+	SettleTimeUnix uint64 `protobuf:"varint,11,opt,name=settle_time_unix,json=settleTimeUnix,proto3" json:"settle_time_unix,omitempty"`
+	// Expiration unix timestamp
+	ExpiryTimeUnix uint64 `protobuf:"varint,12,opt,name=expiry_time_unix,json=expiryTimeUnix,proto3" json:"expiry_time_unix,omitempty"`
+	// SwapRequest UTC timestamp
+	RequestTimeUtc string `protobuf:"bytes,13,opt,name=request_time_utc,json=requestTimeUtc,proto3" json:"request_time_utc,omitempty"`
+	// SwapAccept UTC timestamp
+	AcceptTimeUtc string `protobuf:"bytes,14,opt,name=accept_time_utc,json=acceptTimeUtc,proto3" json:"accept_time_utc,omitempty"`
+	// SwapComplete UTC timestamp
+	CompleteTimeUtc string `protobuf:"bytes,15,opt,name=complete_time_utc,json=completeTimeUtc,proto3" json:"complete_time_utc,omitempty"`
+	// Settlement UTC timestamp
+	SettleTimeUtc string `protobuf:"bytes,16,opt,name=settle_time_utc,json=settleTimeUtc,proto3" json:"settle_time_utc,omitempty"`
+	// Expiration UTC timestamp
+	ExpiryTimeUtc string `protobuf:"bytes,17,opt,name=expiry_time_utc,json=expiryTimeUtc,proto3" json:"expiry_time_utc,omitempty"`
+}
+
+func (x *TradeInfo) Reset() {
+	*x = TradeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeInfo) ProtoMessage() {}
+
+func (x *TradeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeInfo.ProtoReflect.Descriptor instead.
+func (*TradeInfo) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *TradeInfo) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *TradeInfo) GetStatus() *TradeStatusInfo {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *TradeInfo) GetSwapInfo() *SwapInfo {
+	if x != nil {
+		return x.SwapInfo
+	}
+	return nil
+}
+
+func (x *TradeInfo) GetFailInfo() *SwapFailInfo {
+	if x != nil {
+		return x.FailInfo
+	}
+	return nil
+}
+
+func (x *TradeInfo) GetMarketWithFee() *types.MarketWithFee {
+	if x != nil {
+		return x.MarketWithFee
+	}
+	return nil
+}
+
+func (x *TradeInfo) GetPrice() *TradePrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *TradeInfo) GetTxUrl() string {
+	if x != nil {
+		return x.TxUrl
+	}
+	return ""
+}
+
+func (x *TradeInfo) GetRequestTimeUnix() uint64 {
+	if x != nil {
+		return x.RequestTimeUnix
+	}
+	return 0
+}
+
+func (x *TradeInfo) GetAcceptTimeUnix() uint64 {
+	if x != nil {
+		return x.AcceptTimeUnix
+	}
+	return 0
+}
+
+func (x *TradeInfo) GetCompleteTimeUnix() uint64 {
+	if x != nil {
+		return x.CompleteTimeUnix
+	}
+	return 0
+}
+
+func (x *TradeInfo) GetSettleTimeUnix() uint64 {
+	if x != nil {
+		return x.SettleTimeUnix
+	}
+	return 0
+}
+
+func (x *TradeInfo) GetExpiryTimeUnix() uint64 {
+	if x != nil {
+		return x.ExpiryTimeUnix
+	}
+	return 0
+}
+
+func (x *TradeInfo) GetRequestTimeUtc() string {
+	if x != nil {
+		return x.RequestTimeUtc
+	}
+	return ""
+}
+
+func (x *TradeInfo) GetAcceptTimeUtc() string {
+	if x != nil {
+		return x.AcceptTimeUtc
+	}
+	return ""
+}
+
+func (x *TradeInfo) GetCompleteTimeUtc() string {
+	if x != nil {
+		return x.CompleteTimeUtc
+	}
+	return ""
+}
+
+func (x *TradeInfo) GetSettleTimeUtc() string {
+	if x != nil {
+		return x.SettleTimeUtc
+	}
+	return ""
+}
+
+func (x *TradeInfo) GetExpiryTimeUtc() string {
+	if x != nil {
+		return x.ExpiryTimeUtc
+	}
+	return ""
+}
+
+type VerifyFeeLedgerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether to record in the ledger the fees of the settled trades that are
+	// missing from it.
+	Repair bool `protobuf:"varint,1,opt,name=repair,proto3" json:"repair,omitempty"`
+}
+
+func (x *VerifyFeeLedgerRequest) Reset() {
+	*x = VerifyFeeLedgerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyFeeLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyFeeLedgerRequest) ProtoMessage() {}
+
+func (x *VerifyFeeLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyFeeLedgerRequest.ProtoReflect.Descriptor instead.
+func (*VerifyFeeLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *VerifyFeeLedgerRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+type VerifyFeeLedgerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discrepancies []*FeeLedgerDiscrepancy `protobuf:"bytes,1,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+}
+
+func (x *VerifyFeeLedgerReply) Reset() {
+	*x = VerifyFeeLedgerReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyFeeLedgerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyFeeLedgerReply) ProtoMessage() {}
+
+func (x *VerifyFeeLedgerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyFeeLedgerReply.ProtoReflect.Descriptor instead.
+func (*VerifyFeeLedgerReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *VerifyFeeLedgerReply) GetDiscrepancies() []*FeeLedgerDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+type ProveReservesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Nonce chosen by the auditor, included in every signed message so that the
+	// proof can't be replayed or made before the request
+	Nonce string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *ProveReservesRequest) Reset() {
+	*x = ProveReservesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProveReservesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProveReservesRequest) ProtoMessage() {}
+
+func (x *ProveReservesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProveReservesRequest.ProtoReflect.Descriptor instead.
+func (*ProveReservesRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ProveReservesRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type ProveReservesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix timestamp of the proof, included in every signed message
+	Timestamp uint64             `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Accounts  []*AccountReserves `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// The nonce of the request, included in every signed message
+	Nonce string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *ProveReservesReply) Reset() {
+	*x = ProveReservesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProveReservesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProveReservesReply) ProtoMessage() {}
+
+func (x *ProveReservesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProveReservesReply.ProtoReflect.Descriptor instead.
+func (*ProveReservesReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ProveReservesReply) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ProveReservesReply) GetAccounts() []*AccountReserves {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ProveReservesReply) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type AccountReserves struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountIndex uint64             `protobuf:"varint,1,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+	Outpoints    []*OutpointReserve `protobuf:"bytes,2,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+}
+
+func (x *AccountReserves) Reset() {
+	*x = AccountReserves{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountReserves) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountReserves) ProtoMessage() {}
+
+func (x *AccountReserves) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountReserves.ProtoReflect.Descriptor instead.
+func (*AccountReserves) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *AccountReserves) GetAccountIndex() uint64 {
+	if x != nil {
+		return x.AccountIndex
+	}
+	return 0
+}
+
+func (x *AccountReserves) GetOutpoints() []*OutpointReserve {
+	if x != nil {
+		return x.Outpoints
+	}
+	return nil
+}
+
+// An owned unspent with its unblinded asset and amount. The signature is the
+// DER encoded ECDSA signature of the sha256 hash of the string
+// "tdex proof of reserves <nonce> <timestamp> <txid>:<vout> <asset> <amount>
+// <asset_blinder> <amount_blinder>" made with the key of the given pubkey.
+type OutpointReserve struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid          string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Vout          uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Asset         string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	AssetBlinder  string `protobuf:"bytes,5,opt,name=asset_blinder,json=assetBlinder,proto3" json:"asset_blinder,omitempty"`
+	AmountBlinder string `protobuf:"bytes,6,opt,name=amount_blinder,json=amountBlinder,proto3" json:"amount_blinder,omitempty"`
+	Script        string `protobuf:"bytes,7,opt,name=script,proto3" json:"script,omitempty"`
+	Pubkey        string `protobuf:"bytes,8,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature     string `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *OutpointReserve) Reset() {
+	*x = OutpointReserve{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutpointReserve) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutpointReserve) ProtoMessage() {}
+
+func (x *OutpointReserve) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutpointReserve.ProtoReflect.Descriptor instead.
+func (*OutpointReserve) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *OutpointReserve) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *OutpointReserve) GetVout() uint32 {
+	if x != nil {
+		return x.Vout
+	}
+	return 0
+}
+
+func (x *OutpointReserve) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *OutpointReserve) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OutpointReserve) GetAssetBlinder() string {
+	if x != nil {
+		return x.AssetBlinder
+	}
+	return ""
+}
+
+func (x *OutpointReserve) GetAmountBlinder() string {
+	if x != nil {
+		return x.AmountBlinder
+	}
+	return ""
+}
+
+func (x *OutpointReserve) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *OutpointReserve) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *OutpointReserve) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type TradeVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Market *types.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// Start and end of the time window as Unix timestamps, both included.
+	From uint64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   uint64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *TradeVolumeRequest) Reset() {
+	*x = TradeVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeVolumeRequest) ProtoMessage() {}
+
+func (x *TradeVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeVolumeRequest.ProtoReflect.Descriptor instead.
+func (*TradeVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *TradeVolumeRequest) GetMarket() *types.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *TradeVolumeRequest) GetFrom() uint64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *TradeVolumeRequest) GetTo() uint64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type TradeVolumeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseVolume  uint64 `protobuf:"varint,1,opt,name=base_volume,json=baseVolume,proto3" json:"base_volume,omitempty"`
+	QuoteVolume uint64 `protobuf:"varint,2,opt,name=quote_volume,json=quoteVolume,proto3" json:"quote_volume,omitempty"`
+	// Number of trades settled within the time window.
+	TradeCount uint64 `protobuf:"varint,3,opt,name=trade_count,json=tradeCount,proto3" json:"trade_count,omitempty"`
+}
+
+func (x *TradeVolumeReply) Reset() {
+	*x = TradeVolumeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeVolumeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeVolumeReply) ProtoMessage() {}
+
+func (x *TradeVolumeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeVolumeReply.ProtoReflect.Descriptor instead.
+func (*TradeVolumeReply) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *TradeVolumeReply) GetBaseVolume() uint64 {
+	if x != nil {
+		return x.BaseVolume
+	}
+	return 0
+}
+
+func (x *TradeVolumeReply) GetQuoteVolume() uint64 {
+	if x != nil {
+		return x.QuoteVolume
+	}
+	return 0
+}
+
+func (x *TradeVolumeReply) GetTradeCount() uint64 {
+	if x != nil {
+		return x.TradeCount
+	}
+	return 0
+}
+
+type FeeLedgerDiscrepancy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeId string `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	// The quote asset of the market of the trade.
+	MarketQuoteAsset string `protobuf:"bytes,2,opt,name=market_quote_asset,json=marketQuoteAsset,proto3" json:"market_quote_asset,omitempty"`
+	Reason           string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Whether the missing fee has been recorded in the ledger.
+	Repaired bool `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
+}
+
+func (x *FeeLedgerDiscrepancy) Reset() {
+	*x = FeeLedgerDiscrepancy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeLedgerDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeLedgerDiscrepancy) ProtoMessage() {}
+
+func (x *FeeLedgerDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeLedgerDiscrepancy.ProtoReflect.Descriptor instead.
+func (*FeeLedgerDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *FeeLedgerDiscrepancy) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *FeeLedgerDiscrepancy) GetMarketQuoteAsset() string {
+	if x != nil {
+		return x.MarketQuoteAsset
+	}
+	return ""
+}
+
+func (x *FeeLedgerDiscrepancy) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FeeLedgerDiscrepancy) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+type FeeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeId    string `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	BasisPoint int64  `protobuf:"varint,2,opt,name=basis_point,json=basisPoint,proto3" json:"basis_point,omitempty"`
+	Asset      string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Total fee, the sum of basis_point_amount and fixed_amount.
+	Amount      uint64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	MarketPrice float32 `protobuf:"fixed32,5,opt,name=market_price,json=marketPrice,proto3" json:"market_price,omitempty"`
+	// Fiat value of the fee at the time it was collected, if available.
+	FiatValue float32 `protobuf:"fixed32,6,opt,name=fiat_value,json=fiatValue,proto3" json:"fiat_value,omitempty"`
+	// Part of the fee charged as percentage of the traded amount.
+	BasisPointAmount uint64 `protobuf:"varint,7,opt,name=basis_point_amount,json=basisPointAmount,proto3" json:"basis_point_amount,omitempty"`
+	// Part of the fee charged as the market's fixed fee.
+	FixedAmount uint64 `protobuf:"varint,8,opt,name=fixed_amount,json=fixedAmount,proto3" json:"fixed_amount,omitempty"`
+}
+
+func (x *FeeInfo) Reset() {
+	*x = FeeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeInfo) ProtoMessage() {}
+
+func (x *FeeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeInfo.ProtoReflect.Descriptor instead.
+func (*FeeInfo) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *FeeInfo) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *FeeInfo) GetBasisPoint() int64 {
+	if x != nil {
+		return x.BasisPoint
+	}
+	return 0
+}
+
+func (x *FeeInfo) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FeeInfo) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FeeInfo) GetMarketPrice() float32 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *FeeInfo) GetFiatValue() float32 {
+	if x != nil {
+		return x.FiatValue
+	}
+	return 0
+}
+
+func (x *FeeInfo) GetBasisPointAmount() uint64 {
+	if x != nil {
+		return x.BasisPointAmount
+	}
+	return 0
+}
+
+func (x *FeeInfo) GetFixedAmount() uint64 {
+	if x != nil {
+		return x.FixedAmount
+	}
+	return 0
+}
+
+type TxOutpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash  string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Index int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *TxOutpoint) Reset() {
+	*x = TxOutpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_operator_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxOutpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxOutpoint) ProtoMessage() {}
+
+func (x *TxOutpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_operator_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxOutpoint.ProtoReflect.Descriptor instead.
+func (*TxOutpoint) Descriptor() ([]byte, []int) {
+	return file_operator_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *TxOutpoint) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *TxOutpoint) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+var File_operator_proto protoreflect.FileDescriptor
+
+var file_operator_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x38, 0x0a,
+	0x11, 0x44, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x72, 0x6f, 0x70, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3d, 0x0a, 0x12, 0x45, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x45, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3d, 0x0a,
+	0x12, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x12, 0x0a, 0x10,
+	0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x1c, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32,
+	0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x34, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4c, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74,
+	0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4d, 0x0a,
+	0x10, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74,
+	0x78, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x69, 0x6e,
+	0x66, 0x6f, 0x50, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x50, 0x0a, 0x13,
+	0x49, 0x6e, 0x66, 0x6f, 0x50, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9b,
+	0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x46, 0x6f, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x69, 0x6e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x3d, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66,
+	0x6f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x18, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1f, 0x0a,
+	0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x55,
+	0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x41,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x47, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a,
+	0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0e, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x27, 0x0a,
+	0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75,
+	0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x79, 0x0a, 0x0c,
+	0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08,
+	0x75, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x6e, 0x73, 0x70, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x73, 0x70, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x08, 0x55, 0x74, 0x78, 0x6f,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x6f,
+	0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a,
+	0x10, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x3b, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x62,
+	0x0a, 0x12, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x75, 0x6e,
+	0x73, 0x70, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x70, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x41, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x84, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x31, 0x0a, 0x0f, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x61, 0x64, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x55, 0x74,
+	0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x73,
+	0x54, 0x6f, 0x41, 0x64, 0x64, 0x12, 0x35, 0x0a, 0x11, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74,
+	0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x75, 0x6e, 0x73,
+	0x70, 0x65, 0x6e, 0x74, 0x73, 0x54, 0x6f, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x22, 0x10, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xee,
+	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e,
+	0x69, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x70, 0x74, 0x69,
+	0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x75, 0x6d,
+	0x5f, 0x6f, 0x66, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x22,
+	0x61, 0x0a, 0x14, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f,
+	0x6f, 0x66, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x22, 0x32, 0x0a, 0x12, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e,
+	0x75, 0x6d, 0x4f, 0x66, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x6c, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x52, 0x0a, 0x19, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x52, 0x16, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68,
+	0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x22, 0x3b, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x32, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x44, 0x0a, 0x18,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f,
+	0x6f, 0x66, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x22, 0x6c, 0x0a, 0x16, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x65, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x52, 0x0a, 0x19,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x62, 0x6c, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x42, 0x6c, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x16, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79,
+	0x22, 0x1a, 0x0a, 0x18, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x16,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x22, 0x15, 0x0a, 0x13, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x4d, 0x0a, 0x0c, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2f,
+	0x0a, 0x13, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x75, 0x6e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x69, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x4f, 0x0a, 0x1a, 0x43, 0x6f,
+	0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x22, 0x48, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x38, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x22,
+	0x2b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x33, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x1e, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x22, 0xfc, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73, 0x65, 0x74,
+	0x12, 0x5e, 0x0a, 0x13, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x6c, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x61, 0x0a, 0x14, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x6c,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x12, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b,
+	0x65, 0x79, 0x73, 0x1a, 0x44, 0x0a, 0x16, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x6c, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x17, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x66, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x42, 0x6c, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x27, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x58, 0x50, 0x75, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x18, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x77, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x22, 0x56, 0x0a, 0x10,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x58, 0x50, 0x75, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x78, 0x70, 0x75, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x78, 0x70, 0x75, 0x62, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x62,
+	0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4b, 0x65, 0x79, 0x22, 0x34, 0x0a, 0x18, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x6c, 0x0a, 0x16, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x52, 0x0a, 0x19, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x77, 0x69, 0x74, 0x68, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79,
+	0x52, 0x16, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x57, 0x69, 0x74, 0x68, 0x42, 0x6c, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x22, 0x7d, 0x0a, 0x19, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x78, 0x4f, 0x75,
+	0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x38, 0x0a, 0x15, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x98, 0x01, 0x0a,
+	0x13, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42,
+	0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x1a, 0x44, 0x0a, 0x0c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x58, 0x0a, 0x11, 0x46, 0x75, 0x6e, 0x64, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x22, 0x0a,
+	0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x22, 0x11, 0x0a, 0x0f, 0x46, 0x75, 0x6e, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x43, 0x0a, 0x16, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x46, 0x65, 0x65,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09,
+	0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x46, 0x65, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x74, 0x78, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x08,
+	0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x34, 0x0a, 0x11, 0x4f,
+	0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x22, 0x11, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x35, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x35, 0x0a, 0x12, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x36, 0x0a, 0x13, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0d, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x1b, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x90, 0x01, 0x0a, 0x1b, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x64, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61,
+	0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x1b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x36, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65, 0x22, 0x7d, 0x0a, 0x16, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x69, 0x73,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x74, 0x22, 0x3a, 0x0a, 0x17, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x46, 0x65, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x22, 0x3d, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x09, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x49,
+	0x0a, 0x07, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e,
+	0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d,
+	0x69, 0x6e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x69,
+	0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62,
+	0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x65, 0x0a, 0x1b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x66, 0x65, 0x65,
+	0x5f, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x46,
+	0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x52, 0x08, 0x66, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73,
+	0x22, 0x1b, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x95, 0x01,
+	0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x69, 0x6e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d,
+	0x61, 0x78, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x62, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x21, 0x0a, 0x1f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x72,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x61, 0x0a, 0x24,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22,
+	0x24, 0x0a, 0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x59, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x22, 0x18, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xc7, 0x02, 0x0a, 0x15, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x13, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x74, 0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12,
+	0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x75, 0x73, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x75, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x0b, 0x6d, 0x69, 0x6e,
+	0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x12, 0x4e, 0x0a, 0x1b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x74, 0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x44, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x18, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x54, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x22, 0x2c, 0x0a, 0x13, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x72,
+	0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x61, 0x77,
+	0x54, 0x78, 0x22, 0x54, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x1a, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x22, 0x4f,
+	0x0a, 0x18, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x53, 0x0a, 0x17, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x77, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x73, 0x22, 0x2b, 0x0a, 0x15, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69,
+	0x64, 0x22, 0x58, 0x0a, 0x16, 0x42, 0x75, 0x6d, 0x70, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x42,
+	0x75, 0x6d, 0x70, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x22, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x42,
+	0x79, 0x54, 0x78, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64,
+	0x22, 0x37, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x42, 0x79, 0x54, 0x78,
+	0x69, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x22, 0x2f, 0x0a, 0x12, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2e,
+	0x0a, 0x11, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x22, 0x9a,
+	0x03, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x33, 0x0a, 0x0d, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73,
+	0x12, 0x2d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x66, 0x65, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12,
+	0x33, 0x0a, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4f, 0x75,
+	0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0d, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x12, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x0d,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x76, 0x6f, 0x75, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x22, 0x60, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x25, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x22, 0x29, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x95, 0x01,
+	0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x6d, 0x69, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x09, 0x6d, 0x69, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x56, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a,
+	0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x22, 0x39, 0x0a,
+	0x16, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x62, 0x0a, 0x1b, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xef, 0x03, 0x0a,
+	0x14, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x79, 0x0a, 0x1e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x50, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x50, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x6c, 0x0a, 0x19, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x46, 0x69,
+	0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x46, 0x69, 0x61, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x61, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x1a, 0x4d, 0x0a, 0x1f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x50, 0x65, 0x72, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x49, 0x0a, 0x1b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x46, 0x69, 0x61, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd9,
+	0x03, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a,
+	0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x46, 0x65,
+	0x65, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x72, 0x61, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x32, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0f, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0d, 0x62, 0x61, 0x73, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34,
+	0x0a, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72,
+	0x52, 0x08, 0x66, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x09, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70,
+	0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x42, 0x6c, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x4f, 0x0a, 0x0f, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0xaa, 0x02, 0x0a, 0x08, 0x53,
+	0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x50, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x12,
+	0x2c, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x12, 0x2c, 0x0a,
+	0x12, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x12, 0x2c, 0x0a, 0x12, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x22, 0x5a, 0x0a, 0x0c, 0x53, 0x77, 0x61, 0x70, 0x46,
+	0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x4c, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x22, 0xbc, 0x05, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x09,
+	0x66, 0x61, 0x69, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x46, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x66, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65,
+	0x65, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x65, 0x65,
+	0x12, 0x21, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x55, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78,
+	0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x28,
+	0x0a, 0x10, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e,
+	0x69, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e,
+	0x69, 0x78, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x75, 0x74, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x74, 0x63, 0x12, 0x26, 0x0a, 0x0f,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x74, 0x63, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x55, 0x74, 0x63, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x74, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x74, 0x63,
+	0x12, 0x26, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x75, 0x74, 0x63, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x74, 0x63, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x74, 0x63, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x74, 0x63,
+	0x22, 0x30, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x65, 0x65, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x22, 0x53, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x65, 0x65, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x46, 0x65, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x44, 0x69, 0x73,
+	0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65,
+	0x70, 0x61, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x76, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x08, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x52, 0x08, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x66, 0x0a,
+	0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x76, 0x6f, 0x75,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x42, 0x6c, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62,
+	0x6c, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x12, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x07, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x74, 0x6f, 0x22, 0x77, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62,
+	0x61, 0x73, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x93, 0x01,
+	0x0a, 0x14, 0x46, 0x65, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x72,
+	0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49,
+	0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x65, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61,
+	0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x69, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x09, 0x66, 0x69, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x62,
+	0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x0a,
+	0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x2a, 0x3b, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x4c, 0x55, 0x47, 0x47, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x4e, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x44, 0x10,
+	0x02, 0x2a, 0x21, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53,
+	0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x6b, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43,
+	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x54,
+	0x54, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10,
+	0x06, 0x32, 0xcd, 0x20, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2b,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0d, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x19, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x49, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x65, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46,
+	0x65, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x19, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x55, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x1a, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x2e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x42, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x79, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0f, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x46, 0x65, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x17, 0x2e, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x46, 0x65, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x46, 0x65, 0x65,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x0a, 0x46, 0x75, 0x6e, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x46,
+	0x75, 0x6e, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x74,
+	0x78, 0x6f, 0x12, 0x12, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55,
+	0x74, 0x78, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x4f, 0x70,
+	0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12,
+	0x13, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x12, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x58, 0x50, 0x75, 0x62, 0x12, 0x13, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x58, 0x50,
+	0x75, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x58, 0x50, 0x75, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49,
+	0x0a, 0x11, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x12, 0x19, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x19, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x12, 0x21, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x4d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x46, 0x65, 0x65, 0x12, 0x1c, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x46, 0x65,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x46, 0x65, 0x65, 0x12, 0x17, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x10, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x46, 0x65, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x52,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65,
+	0x65, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x5b, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1f, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x64, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x25, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1c, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x14, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1c, 0x2e, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65,
+	0x12, 0x1b, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x10, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x18,
+	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0f, 0x42, 0x75, 0x6d, 0x70, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x46, 0x65, 0x65, 0x12, 0x17, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x42, 0x75, 0x6d, 0x70, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x42, 0x79, 0x54, 0x78, 0x69, 0x64, 0x12,
+	0x16, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x42, 0x79, 0x54, 0x78, 0x69, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x42, 0x79, 0x54, 0x78, 0x69, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x13,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x12, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c,
+	0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x14,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0f,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12,
+	0x17, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x12, 0x17, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x1c, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46,
+	0x65, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x46, 0x65, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x46, 0x65, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x46, 0x65, 0x65, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0d,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x12, 0x15, 0x2e,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x13, 0x2e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x74,
+	0x78, 0x6f, 0x73, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x74, 0x78, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x15,
+	0x2e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13,
+	0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x1b, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x31, 0x0a,
+	0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x11, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x46, 0x6f, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74,
+	0x78, 0x6f, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73,
+	0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55,
+	0x74, 0x78, 0x6f, 0x73, 0x12, 0x17, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x44, 0x72, 0x6f, 0x70,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a,
+	0x0b, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x12, 0x13, 0x2e, 0x45,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x55, 0x74, 0x78, 0x6f, 0x12, 0x13, 0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x55,
+	0x74, 0x78, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x49, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x74, 0x64, 0x65, 0x78, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x74, 0x64, 0x65,
+	0x78, 0x2d, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x73, 0x70, 0x65,
+	0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_operator_proto_rawDescOnce sync.Once
+	file_operator_proto_rawDescData = file_operator_proto_rawDesc
+)
+
+func file_operator_proto_rawDescGZIP() []byte {
+	file_operator_proto_rawDescOnce.Do(func() {
+		file_operator_proto_rawDescData = protoimpl.X.CompressGZIP(file_operator_proto_rawDescData)
+	})
+	return file_operator_proto_rawDescData
+}
+
+var file_operator_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 145)
+var file_operator_proto_goTypes = []interface{}{
+	(StrategyType)(0),                            // 0: StrategyType
+	(ExportFormat)(0),                            // 1: ExportFormat
+	(TradeStatus)(0),                             // 2: TradeStatus
+	(*DropMarketRequest)(nil),                    // 3: DropMarketRequest
+	(*DropMarketReply)(nil),                      // 4: DropMarketReply
+	(*ExcludeUtxoRequest)(nil),                   // 5: ExcludeUtxoRequest
+	(*ExcludeUtxoReply)(nil),                     // 6: ExcludeUtxoReply
+	(*IncludeUtxoRequest)(nil),                   // 7: IncludeUtxoRequest
+	(*IncludeUtxoReply)(nil),                     // 8: IncludeUtxoReply
+	(*ExportMarketsConfigRequest)(nil),           // 9: ExportMarketsConfigRequest
+	(*ExportMarketsConfigReply)(nil),             // 10: ExportMarketsConfigReply
+	(*ImportMarketsConfigRequest)(nil),           // 11: ImportMarketsConfigRequest
+	(*ImportMarketsConfigReply)(nil),             // 12: ImportMarketsConfigReply
+	(*ListUtxosRequest)(nil),                     // 13: ListUtxosRequest
+	(*ListUtxosReply)(nil),                       // 14: ListUtxosReply
+	(*ListUtxosForAccountRequest)(nil),           // 15: ListUtxosForAccountRequest
+	(*ListUtxosForAccountReply)(nil),             // 16: ListUtxosForAccountReply
+	(*ListLockedUtxosRequest)(nil),               // 17: ListLockedUtxosRequest
+	(*ListLockedUtxosReply)(nil),                 // 18: ListLockedUtxosReply
+	(*ListPendingDepositsRequest)(nil),           // 19: ListPendingDepositsRequest
+	(*ListPendingDepositsReply)(nil),             // 20: ListPendingDepositsReply
+	(*PendingDeposit)(nil),                       // 21: PendingDeposit
+	(*UtxoInfoList)(nil),                         // 22: UtxoInfoList
+	(*UtxoInfo)(nil),                             // 23: UtxoInfo
+	(*ReloadUtxosRequest)(nil),                   // 24: ReloadUtxosRequest
+	(*ReloadUtxosReply)(nil),                     // 25: ReloadUtxosReply
+	(*RescanAccountRequest)(nil),                 // 26: RescanAccountRequest
+	(*RescanAccountReply)(nil),                   // 27: RescanAccountReply
+	(*RescanAccountDryRunRequest)(nil),           // 28: RescanAccountDryRunRequest
+	(*RescanAccountDryRunReply)(nil),             // 29: RescanAccountDryRunReply
+	(*GetInfoRequest)(nil),                       // 30: GetInfoRequest
+	(*GetInfoReply)(nil),                         // 31: GetInfoReply
+	(*DepositMarketRequest)(nil),                 // 32: DepositMarketRequest
+	(*DepositMarketReply)(nil),                   // 33: DepositMarketReply
+	(*GetDepositAddressRequest)(nil),             // 34: GetDepositAddressRequest
+	(*GetDepositAddressReply)(nil),               // 35: GetDepositAddressReply
+	(*ListDepositMarketRequest)(nil),             // 36: ListDepositMarketRequest
+	(*ListDepositMarketReply)(nil),               // 37: ListDepositMarketReply
+	(*DepositFeeAccountRequest)(nil),             // 38: DepositFeeAccountRequest
+	(*DepositFeeAccountReply)(nil),               // 39: DepositFeeAccountReply
+	(*BalanceFeeAccountRequest)(nil),             // 40: BalanceFeeAccountRequest
+	(*BalanceFeeAccountReply)(nil),               // 41: BalanceFeeAccountReply
+	(*TotalBalanceRequest)(nil),                  // 42: TotalBalanceRequest
+	(*TotalBalanceReply)(nil),                    // 43: TotalBalanceReply
+	(*AssetBalanceInfo)(nil),                     // 44: AssetBalanceInfo
+	(*ConsolidateFeeAccountRequest)(nil),         // 45: ConsolidateFeeAccountRequest
+	(*ConsolidateFeeAccountReply)(nil),           // 46: ConsolidateFeeAccountReply
+	(*ListMarketRequest)(nil),                    // 47: ListMarketRequest
+	(*ListMarketReply)(nil),                      // 48: ListMarketReply
+	(*GetAssetInfoRequest)(nil),                  // 49: GetAssetInfoRequest
+	(*GetAssetInfoReply)(nil),                    // 50: GetAssetInfoReply
+	(*GetBlindingDataRequest)(nil),               // 51: GetBlindingDataRequest
+	(*GetBlindingDataReply)(nil),                 // 52: GetBlindingDataReply
+	(*AccountXPubRequest)(nil),                   // 53: AccountXPubRequest
+	(*AccountXPubReply)(nil),                     // 54: AccountXPubReply
+	(*RotateBlindingKeyRequest)(nil),             // 55: RotateBlindingKeyRequest
+	(*RotateBlindingKeyReply)(nil),               // 56: RotateBlindingKeyReply
+	(*ClaimMarketDepositRequest)(nil),            // 57: ClaimMarketDepositRequest
+	(*ClaimMarketDepositReply)(nil),              // 58: ClaimMarketDepositReply
+	(*BalanceByLabelRequest)(nil),                // 59: BalanceByLabelRequest
+	(*BalanceByLabelReply)(nil),                  // 60: BalanceByLabelReply
+	(*FundMarketRequest)(nil),                    // 61: FundMarketRequest
+	(*FundMarketReply)(nil),                      // 62: FundMarketReply
+	(*ClaimFeeDepositRequest)(nil),               // 63: ClaimFeeDepositRequest
+	(*ClaimFeeDepositReply)(nil),                 // 64: ClaimFeeDepositReply
+	(*ImportUtxoRequest)(nil),                    // 65: ImportUtxoRequest
+	(*ImportUtxoReply)(nil),                      // 66: ImportUtxoReply
+	(*OpenMarketRequest)(nil),                    // 67: OpenMarketRequest
+	(*OpenMarketReply)(nil),                      // 68: OpenMarketReply
+	(*CloseMarketRequest)(nil),                   // 69: CloseMarketRequest
+	(*CloseMarketReply)(nil),                     // 70: CloseMarketReply
+	(*PauseMarketRequest)(nil),                   // 71: PauseMarketRequest
+	(*PauseMarketReply)(nil),                     // 72: PauseMarketReply
+	(*ResumeMarketRequest)(nil),                  // 73: ResumeMarketRequest
+	(*ResumeMarketReply)(nil),                    // 74: ResumeMarketReply
+	(*UpdateMarketStrategyRequest)(nil),          // 75: UpdateMarketStrategyRequest
+	(*UpdateMarketStrategyReply)(nil),            // 76: UpdateMarketStrategyReply
+	(*SwitchMarketStrategyRequest)(nil),          // 77: SwitchMarketStrategyRequest
+	(*SwitchMarketStrategyReply)(nil),            // 78: SwitchMarketStrategyReply
+	(*UpdateMarketPercentageFeeRequest)(nil),     // 79: UpdateMarketPercentageFeeRequest
+	(*UpdateMarketFixedFeeRequest)(nil),          // 80: UpdateMarketFixedFeeRequest
+	(*UpdateMarketFeeReply)(nil),                 // 81: UpdateMarketFeeReply
+	(*UpdateMarketFeeRequest)(nil),               // 82: UpdateMarketFeeRequest
+	(*MarketFeeHistoryRequest)(nil),              // 83: MarketFeeHistoryRequest
+	(*MarketFeeHistoryReply)(nil),                // 84: MarketFeeHistoryReply
+	(*FeeChange)(nil),                            // 85: FeeChange
+	(*FeeTier)(nil),                              // 86: FeeTier
+	(*UpdateMarketFeeTiersRequest)(nil),          // 87: UpdateMarketFeeTiersRequest
+	(*UpdateMarketFeeTiersReply)(nil),            // 88: UpdateMarketFeeTiersReply
+	(*UpdateMarketTradeLimitsRequest)(nil),       // 89: UpdateMarketTradeLimitsRequest
+	(*UpdateMarketTradeLimitsReply)(nil),         // 90: UpdateMarketTradeLimitsReply
+	(*UpdateMarketPricePrecisionRequest)(nil),    // 91: UpdateMarketPricePrecisionRequest
+	(*UpdateMarketPricePrecisionReply)(nil),      // 92: UpdateMarketPricePrecisionReply
+	(*UpdateMarketSettlementTimeoutRequest)(nil), // 93: UpdateMarketSettlementTimeoutRequest
+	(*UpdateMarketSettlementTimeoutReply)(nil),   // 94: UpdateMarketSettlementTimeoutReply
+	(*UpdateMarketPriceRequest)(nil),             // 95: UpdateMarketPriceRequest
+	(*UpdateMarketPriceReply)(nil),               // 96: UpdateMarketPriceReply
+	(*WithdrawMarketRequest)(nil),                // 97: WithdrawMarketRequest
+	(*WithdrawMarketReply)(nil),                  // 98: WithdrawMarketReply
+	(*DisplayBalance)(nil),                       // 99: DisplayBalance
+	(*EstimateWithdrawFeeRequest)(nil),           // 100: EstimateWithdrawFeeRequest
+	(*EstimateWithdrawFeeReply)(nil),             // 101: EstimateWithdrawFeeReply
+	(*WithdrawMultipleRequest)(nil),              // 102: WithdrawMultipleRequest
+	(*WithdrawMultipleReply)(nil),                // 103: WithdrawMultipleReply
+	(*BumpWithdrawFeeRequest)(nil),               // 104: BumpWithdrawFeeRequest
+	(*BumpWithdrawFeeReply)(nil),                 // 105: BumpWithdrawFeeReply
+	(*ListTradesRequest)(nil),                    // 106: ListTradesRequest
+	(*ListTradesReply)(nil),                      // 107: ListTradesReply
+	(*GetTradeByTxidRequest)(nil),                // 108: GetTradeByTxidRequest
+	(*GetTradeByTxidReply)(nil),                  // 109: GetTradeByTxidReply
+	(*CancelTradeRequest)(nil),                   // 110: CancelTradeRequest
+	(*CancelTradeReply)(nil),                     // 111: CancelTradeReply
+	(*TradeAuditRequest)(nil),                    // 112: TradeAuditRequest
+	(*TradeAuditReply)(nil),                      // 113: TradeAuditReply
+	(*AuditOutpoint)(nil),                        // 114: AuditOutpoint
+	(*ExportTradesRequest)(nil),                  // 115: ExportTradesRequest
+	(*ExportTradesReply)(nil),                    // 116: ExportTradesReply
+	(*SubscribeTradesRequest)(nil),               // 117: SubscribeTradesRequest
+	(*SubscribeTradesReply)(nil),                 // 118: SubscribeTradesReply
+	(*ReportMarketFeeRequest)(nil),               // 119: ReportMarketFeeRequest
+	(*ReportMarketFeeRangeRequest)(nil),          // 120: ReportMarketFeeRangeRequest
+	(*ReportMarketFeeReply)(nil),                 // 121: ReportMarketFeeReply
+	(*MarketInfo)(nil),                           // 122: MarketInfo
+	(*AssetInfo)(nil),                            // 123: AssetInfo
+	(*BlindingData)(nil),                         // 124: BlindingData
+	(*TradeStatusInfo)(nil),                      // 125: TradeStatusInfo
+	(*SwapInfo)(nil),                             // 126: SwapInfo
+	(*SwapFailInfo)(nil),                         // 127: SwapFailInfo
+	(*TradePrice)(nil),                           // 128: TradePrice
+	(*TradeInfo)(nil),                            // 129: TradeInfo
+	(*VerifyFeeLedgerRequest)(nil),               // 130: VerifyFeeLedgerRequest
+	(*VerifyFeeLedgerReply)(nil),                 // 131: VerifyFeeLedgerReply
+	(*ProveReservesRequest)(nil),                 // 132: ProveReservesRequest
+	(*ProveReservesReply)(nil),                   // 133: ProveReservesReply
+	(*AccountReserves)(nil),                      // 134: AccountReserves
+	(*OutpointReserve)(nil),                      // 135: OutpointReserve
+	(*TradeVolumeRequest)(nil),                   // 136: TradeVolumeRequest
+	(*TradeVolumeReply)(nil),                     // 137: TradeVolumeReply
+	(*FeeLedgerDiscrepancy)(nil),                 // 138: FeeLedgerDiscrepancy
+	(*FeeInfo)(nil),                              // 139: FeeInfo
+	(*TxOutpoint)(nil),                           // 140: TxOutpoint
+	nil,                                          // 141: ListUtxosReply.InfoPerAccountEntry
+	nil,                                          // 142: TotalBalanceReply.BalanceEntry
+	nil,                                          // 143: GetBlindingDataRequest.InputBlindingKeysEntry
+	nil,                                          // 144: GetBlindingDataRequest.OutputBlindingKeysEntry
+	nil,                                          // 145: BalanceByLabelReply.BalanceEntry
+	nil,                                          // 146: ReportMarketFeeReply.TotalCollectedFeesPerAssetEntry
+	nil,                                          // 147: ReportMarketFeeReply.TotalCollectedFeesFiatEntry
+	(*types.Market)(nil),                         // 148: Market
+	(*types.AddressWithBlindingKey)(nil),         // 149: AddressWithBlindingKey
+	(*types.Balance)(nil),                        // 150: Balance
+	(*types.Price)(nil),                          // 151: Price
+	(*types.Fixed)(nil),                          // 152: Fixed
+	(*types.MarketWithFee)(nil),                  // 153: MarketWithFee
+	(*types.Fee)(nil),                            // 154: Fee
+}
+var file_operator_proto_depIdxs = []int32{
+	140, // 0: ExcludeUtxoRequest.outpoint:type_name -> TxOutpoint
+	140, // 1: IncludeUtxoRequest.outpoint:type_name -> TxOutpoint
+	148, // 2: ImportMarketsConfigReply.created_markets:type_name -> Market
+	141, // 3: ListUtxosReply.info_per_account:type_name -> ListUtxosReply.InfoPerAccountEntry
+	22,  // 4: ListUtxosForAccountReply.info:type_name -> UtxoInfoList
+	23,  // 5: ListLockedUtxosReply.locks:type_name -> UtxoInfo
+	21,  // 6: ListPendingDepositsReply.deposits:type_name -> PendingDeposit
+	140, // 7: PendingDeposit.outpoint:type_name -> TxOutpoint
+	23,  // 8: UtxoInfoList.unspents:type_name -> UtxoInfo
+	23,  // 9: UtxoInfoList.spents:type_name -> UtxoInfo
+	23,  // 10: UtxoInfoList.locks:type_name -> UtxoInfo
+	140, // 11: UtxoInfo.outpoint:type_name -> TxOutpoint
+	23,  // 12: RescanAccountDryRunReply.unspents_to_add:type_name -> UtxoInfo
+	23,  // 13: RescanAccountDryRunReply.unspents_to_spend:type_name -> UtxoInfo
+	148, // 14: DepositMarketRequest.market:type_name -> Market
+	148, // 15: GetDepositAddressRequest.market:type_name -> Market
+	149, // 16: GetDepositAddressReply.address_with_blinding_key:type_name -> AddressWithBlindingKey
+	148, // 17: ListDepositMarketRequest.market:type_name -> Market
+	149, // 18: DepositFeeAccountReply.address_with_blinding_key:type_name -> AddressWithBlindingKey
+	142, // 19: TotalBalanceReply.balance:type_name -> TotalBalanceReply.BalanceEntry
+	122, // 20: ListMarketReply.markets:type_name -> MarketInfo
+	123, // 21: GetAssetInfoReply.info:type_name -> AssetInfo
+	143, // 22: GetBlindingDataRequest.input_blinding_keys:type_name -> GetBlindingDataRequest.InputBlindingKeysEntry
+	144, // 23: GetBlindingDataRequest.output_blinding_keys:type_name -> GetBlindingDataRequest.OutputBlindingKeysEntry
+	124, // 24: GetBlindingDataReply.inputs:type_name -> BlindingData
+	124, // 25: GetBlindingDataReply.outputs:type_name -> BlindingData
+	149, // 26: RotateBlindingKeyReply.address_with_blinding_key:type_name -> AddressWithBlindingKey
+	148, // 27: ClaimMarketDepositRequest.market:type_name -> Market
+	140, // 28: ClaimMarketDepositRequest.outpoints:type_name -> TxOutpoint
+	148, // 29: BalanceByLabelRequest.market:type_name -> Market
+	145, // 30: BalanceByLabelReply.balance:type_name -> BalanceByLabelReply.BalanceEntry
+	148, // 31: FundMarketRequest.market:type_name -> Market
+	150, // 32: FundMarketRequest.amounts:type_name -> Balance
+	140, // 33: ClaimFeeDepositRequest.outpoints:type_name -> TxOutpoint
+	140, // 34: ImportUtxoRequest.outpoint:type_name -> TxOutpoint
+	148, // 35: OpenMarketRequest.market:type_name -> Market
+	148, // 36: CloseMarketRequest.market:type_name -> Market
+	148, // 37: PauseMarketRequest.market:type_name -> Market
+	148, // 38: ResumeMarketRequest.market:type_name -> Market
+	148, // 39: UpdateMarketStrategyRequest.market:type_name -> Market
+	0,   // 40: UpdateMarketStrategyRequest.strategy_type:type_name -> StrategyType
+	148, // 41: SwitchMarketStrategyRequest.market:type_name -> Market
+	0,   // 42: SwitchMarketStrategyRequest.strategy_type:type_name -> StrategyType
+	151, // 43: SwitchMarketStrategyRequest.price:type_name -> Price
+	148, // 44: UpdateMarketPercentageFeeRequest.market:type_name -> Market
+	148, // 45: UpdateMarketFixedFeeRequest.market:type_name -> Market
+	152, // 46: UpdateMarketFixedFeeRequest.fixed:type_name -> Fixed
+	153, // 47: UpdateMarketFeeReply.market_with_fee:type_name -> MarketWithFee
+	148, // 48: UpdateMarketFeeRequest.market:type_name -> Market
+	148, // 49: MarketFeeHistoryRequest.market:type_name -> Market
+	85,  // 50: MarketFeeHistoryReply.changes:type_name -> FeeChange
+	148, // 51: UpdateMarketFeeTiersRequest.market:type_name -> Market
+	86,  // 52: UpdateMarketFeeTiersRequest.fee_tiers:type_name -> FeeTier
+	148, // 53: UpdateMarketTradeLimitsRequest.market:type_name -> Market
+	148, // 54: UpdateMarketPricePrecisionRequest.market:type_name -> Market
+	148, // 55: UpdateMarketSettlementTimeoutRequest.market:type_name -> Market
+	148, // 56: UpdateMarketPriceRequest.market:type_name -> Market
+	151, // 57: UpdateMarketPriceRequest.price:type_name -> Price
+	148, // 58: WithdrawMarketRequest.market:type_name -> Market
+	150, // 59: WithdrawMarketRequest.balance_to_withdraw:type_name -> Balance
+	150, // 60: WithdrawMarketRequest.min_reserve:type_name -> Balance
+	99,  // 61: WithdrawMarketRequest.balance_to_withdraw_display:type_name -> DisplayBalance
+	97,  // 62: EstimateWithdrawFeeRequest.withdrawal:type_name -> WithdrawMarketRequest
+	97,  // 63: WithdrawMultipleRequest.withdrawals:type_name -> WithdrawMarketRequest
+	129, // 64: ListTradesReply.trades:type_name -> TradeInfo
+	129, // 65: GetTradeByTxidReply.trade:type_name -> TradeInfo
+	114, // 66: TradeAuditReply.market_inputs:type_name -> AuditOutpoint
+	114, // 67: TradeAuditReply.fee_inputs:type_name -> AuditOutpoint
+	114, // 68: TradeAuditReply.market_output:type_name -> AuditOutpoint
+	114, // 69: TradeAuditReply.change_outputs:type_name -> AuditOutpoint
+	114, // 70: TradeAuditReply.counterparty_output:type_name -> AuditOutpoint
+	1,   // 71: ExportTradesRequest.format:type_name -> ExportFormat
+	148, // 72: SubscribeTradesRequest.market:type_name -> Market
+	2,   // 73: SubscribeTradesRequest.min_status:type_name -> TradeStatus
+	129, // 74: SubscribeTradesReply.trade:type_name -> TradeInfo
+	148, // 75: ReportMarketFeeRequest.market:type_name -> Market
+	148, // 76: ReportMarketFeeRangeRequest.market:type_name -> Market
+	139, // 77: ReportMarketFeeReply.collected_fees:type_name -> FeeInfo
+	146, // 78: ReportMarketFeeReply.total_collected_fees_per_asset:type_name -> ReportMarketFeeReply.TotalCollectedFeesPerAssetEntry
+	147, // 79: ReportMarketFeeReply.total_collected_fees_fiat:type_name -> ReportMarketFeeReply.TotalCollectedFeesFiatEntry
+	148, // 80: MarketInfo.market:type_name -> Market
+	154, // 81: MarketInfo.fee:type_name -> Fee
+	0,   // 82: MarketInfo.strategy_type:type_name -> StrategyType
+	151, // 83: MarketInfo.price:type_name -> Price
+	123, // 84: MarketInfo.base_asset_info:type_name -> AssetInfo
+	123, // 85: MarketInfo.quote_asset_info:type_name -> AssetInfo
+	86,  // 86: MarketInfo.fee_tiers:type_name -> FeeTier
+	2,   // 87: TradeStatusInfo.status:type_name -> TradeStatus
+	125, // 88: TradeInfo.status:type_name -> TradeStatusInfo
+	126, // 89: TradeInfo.swap_info:type_name -> SwapInfo
+	127, // 90: TradeInfo.fail_info:type_name -> SwapFailInfo
+	153, // 91: TradeInfo.market_with_fee:type_name -> MarketWithFee
+	128, // 92: TradeInfo.price:type_name -> TradePrice
+	138, // 93: VerifyFeeLedgerReply.discrepancies:type_name -> FeeLedgerDiscrepancy
+	134, // 94: ProveReservesReply.accounts:type_name -> AccountReserves
+	135, // 95: AccountReserves.outpoints:type_name -> OutpointReserve
+	148, // 96: TradeVolumeRequest.market:type_name -> Market
+	22,  // 97: ListUtxosReply.InfoPerAccountEntry.value:type_name -> UtxoInfoList
+	44,  // 98: TotalBalanceReply.BalanceEntry.value:type_name -> AssetBalanceInfo
+	150, // 99: BalanceByLabelReply.BalanceEntry.value:type_name -> Balance
+	30,  // 100: Operator.GetInfo:input_type -> GetInfoRequest
+	32,  // 101: Operator.DepositMarket:input_type -> DepositMarketRequest
+	34,  // 102: Operator.GetDepositAddress:input_type -> GetDepositAddressRequest
+	36,  // 103: Operator.ListDepositMarket:input_type -> ListDepositMarketRequest
+	38,  // 104: Operator.DepositFeeAccount:input_type -> DepositFeeAccountRequest
+	40,  // 105: Operator.BalanceFeeAccount:input_type -> BalanceFeeAccountRequest
+	42,  // 106: Operator.TotalBalance:input_type -> TotalBalanceRequest
+	45,  // 107: Operator.ConsolidateFeeAccount:input_type -> ConsolidateFeeAccountRequest
+	57,  // 108: Operator.ClaimMarketDeposit:input_type -> ClaimMarketDepositRequest
+	59,  // 109: Operator.BalanceByLabel:input_type -> BalanceByLabelRequest
+	63,  // 110: Operator.ClaimFeeDeposit:input_type -> ClaimFeeDepositRequest
+	61,  // 111: Operator.FundMarket:input_type -> FundMarketRequest
+	65,  // 112: Operator.ImportUtxo:input_type -> ImportUtxoRequest
+	67,  // 113: Operator.OpenMarket:input_type -> OpenMarketRequest
+	69,  // 114: Operator.CloseMarket:input_type -> CloseMarketRequest
+	71,  // 115: Operator.PauseMarket:input_type -> PauseMarketRequest
+	73,  // 116: Operator.ResumeMarket:input_type -> ResumeMarketRequest
+	47,  // 117: Operator.ListMarket:input_type -> ListMarketRequest
+	49,  // 118: Operator.GetAssetInfo:input_type -> GetAssetInfoRequest
+	51,  // 119: Operator.GetBlindingData:input_type -> GetBlindingDataRequest
+	53,  // 120: Operator.AccountXPub:input_type -> AccountXPubRequest
+	55,  // 121: Operator.RotateBlindingKey:input_type -> RotateBlindingKeyRequest
+	79,  // 122: Operator.UpdateMarketPercentageFee:input_type -> UpdateMarketPercentageFeeRequest
+	80,  // 123: Operator.UpdateMarketFixedFee:input_type -> UpdateMarketFixedFeeRequest
+	82,  // 124: Operator.UpdateMarketFee:input_type -> UpdateMarketFeeRequest
+	83,  // 125: Operator.MarketFeeHistory:input_type -> MarketFeeHistoryRequest
+	87,  // 126: Operator.UpdateMarketFeeTiers:input_type -> UpdateMarketFeeTiersRequest
+	89,  // 127: Operator.UpdateMarketTradeLimits:input_type -> UpdateMarketTradeLimitsRequest
+	91,  // 128: Operator.UpdateMarketPricePrecision:input_type -> UpdateMarketPricePrecisionRequest
+	93,  // 129: Operator.UpdateMarketSettlementTimeout:input_type -> UpdateMarketSettlementTimeoutRequest
+	95,  // 130: Operator.UpdateMarketPrice:input_type -> UpdateMarketPriceRequest
+	75,  // 131: Operator.UpdateMarketStrategy:input_type -> UpdateMarketStrategyRequest
+	77,  // 132: Operator.SwitchMarketStrategy:input_type -> SwitchMarketStrategyRequest
+	97,  // 133: Operator.WithdrawMarket:input_type -> WithdrawMarketRequest
+	100, // 134: Operator.EstimateWithdrawFee:input_type -> EstimateWithdrawFeeRequest
+	102, // 135: Operator.WithdrawMultiple:input_type -> WithdrawMultipleRequest
+	104, // 136: Operator.BumpWithdrawFee:input_type -> BumpWithdrawFeeRequest
+	106, // 137: Operator.ListTrades:input_type -> ListTradesRequest
+	108, // 138: Operator.GetTradeByTxid:input_type -> GetTradeByTxidRequest
+	110, // 139: Operator.CancelTrade:input_type -> CancelTradeRequest
+	112, // 140: Operator.TradeAudit:input_type -> TradeAuditRequest
+	115, // 141: Operator.ExportTrades:input_type -> ExportTradesRequest
+	117, // 142: Operator.SubscribeTrades:input_type -> SubscribeTradesRequest
+	119, // 143: Operator.ReportMarketFee:input_type -> ReportMarketFeeRequest
+	120, // 144: Operator.ReportMarketFeeRange:input_type -> ReportMarketFeeRangeRequest
+	130, // 145: Operator.VerifyFeeLedger:input_type -> VerifyFeeLedgerRequest
+	132, // 146: Operator.ProveReserves:input_type -> ProveReservesRequest
+	136, // 147: Operator.TradeVolume:input_type -> TradeVolumeRequest
+	24,  // 148: Operator.ReloadUtxos:input_type -> ReloadUtxosRequest
+	26,  // 149: Operator.RescanAccount:input_type -> RescanAccountRequest
+	28,  // 150: Operator.RescanAccountDryRun:input_type -> RescanAccountDryRunRequest
+	13,  // 151: Operator.ListUtxos:input_type -> ListUtxosRequest
+	15,  // 152: Operator.ListUtxosForAccount:input_type -> ListUtxosForAccountRequest
+	17,  // 153: Operator.ListLockedUtxos:input_type -> ListLockedUtxosRequest
+	19,  // 154: Operator.ListPendingDeposits:input_type -> ListPendingDepositsRequest
+	3,   // 155: Operator.DropMarket:input_type -> DropMarketRequest
+	5,   // 156: Operator.ExcludeUtxo:input_type -> ExcludeUtxoRequest
+	7,   // 157: Operator.IncludeUtxo:input_type -> IncludeUtxoRequest
+	9,   // 158: Operator.ExportMarketsConfig:input_type -> ExportMarketsConfigRequest
+	11,  // 159: Operator.ImportMarketsConfig:input_type -> ImportMarketsConfigRequest
+	31,  // 160: Operator.GetInfo:output_type -> GetInfoReply
+	33,  // 161: Operator.DepositMarket:output_type -> DepositMarketReply
+	35,  // 162: Operator.GetDepositAddress:output_type -> GetDepositAddressReply
+	37,  // 163: Operator.ListDepositMarket:output_type -> ListDepositMarketReply
+	39,  // 164: Operator.DepositFeeAccount:output_type -> DepositFeeAccountReply
+	41,  // 165: Operator.BalanceFeeAccount:output_type -> BalanceFeeAccountReply
+	43,  // 166: Operator.TotalBalance:output_type -> TotalBalanceReply
+	46,  // 167: Operator.ConsolidateFeeAccount:output_type -> ConsolidateFeeAccountReply
+	58,  // 168: Operator.ClaimMarketDeposit:output_type -> ClaimMarketDepositReply
+	60,  // 169: Operator.BalanceByLabel:output_type -> BalanceByLabelReply
+	64,  // 170: Operator.ClaimFeeDeposit:output_type -> ClaimFeeDepositReply
+	62,  // 171: Operator.FundMarket:output_type -> FundMarketReply
+	66,  // 172: Operator.ImportUtxo:output_type -> ImportUtxoReply
+	68,  // 173: Operator.OpenMarket:output_type -> OpenMarketReply
+	70,  // 174: Operator.CloseMarket:output_type -> CloseMarketReply
+	72,  // 175: Operator.PauseMarket:output_type -> PauseMarketReply
+	74,  // 176: Operator.ResumeMarket:output_type -> ResumeMarketReply
+	48,  // 177: Operator.ListMarket:output_type -> ListMarketReply
+	50,  // 178: Operator.GetAssetInfo:output_type -> GetAssetInfoReply
+	52,  // 179: Operator.GetBlindingData:output_type -> GetBlindingDataReply
+	54,  // 180: Operator.AccountXPub:output_type -> AccountXPubReply
+	56,  // 181: Operator.RotateBlindingKey:output_type -> RotateBlindingKeyReply
+	81,  // 182: Operator.UpdateMarketPercentageFee:output_type -> UpdateMarketFeeReply
+	81,  // 183: Operator.UpdateMarketFixedFee:output_type -> UpdateMarketFeeReply
+	81,  // 184: Operator.UpdateMarketFee:output_type -> UpdateMarketFeeReply
+	84,  // 185: Operator.MarketFeeHistory:output_type -> MarketFeeHistoryReply
+	88,  // 186: Operator.UpdateMarketFeeTiers:output_type -> UpdateMarketFeeTiersReply
+	90,  // 187: Operator.UpdateMarketTradeLimits:output_type -> UpdateMarketTradeLimitsReply
+	92,  // 188: Operator.UpdateMarketPricePrecision:output_type -> UpdateMarketPricePrecisionReply
+	94,  // 189: Operator.UpdateMarketSettlementTimeout:output_type -> UpdateMarketSettlementTimeoutReply
+	96,  // 190: Operator.UpdateMarketPrice:output_type -> UpdateMarketPriceReply
+	76,  // 191: Operator.UpdateMarketStrategy:output_type -> UpdateMarketStrategyReply
+	78,  // 192: Operator.SwitchMarketStrategy:output_type -> SwitchMarketStrategyReply
+	98,  // 193: Operator.WithdrawMarket:output_type -> WithdrawMarketReply
+	101, // 194: Operator.EstimateWithdrawFee:output_type -> EstimateWithdrawFeeReply
+	103, // 195: Operator.WithdrawMultiple:output_type -> WithdrawMultipleReply
+	105, // 196: Operator.BumpWithdrawFee:output_type -> BumpWithdrawFeeReply
+	107, // 197: Operator.ListTrades:output_type -> ListTradesReply
+	109, // 198: Operator.GetTradeByTxid:output_type -> GetTradeByTxidReply
+	111, // 199: Operator.CancelTrade:output_type -> CancelTradeReply
+	113, // 200: Operator.TradeAudit:output_type -> TradeAuditReply
+	116, // 201: Operator.ExportTrades:output_type -> ExportTradesReply
+	118, // 202: Operator.SubscribeTrades:output_type -> SubscribeTradesReply
+	121, // 203: Operator.ReportMarketFee:output_type -> ReportMarketFeeReply
+	121, // 204: Operator.ReportMarketFeeRange:output_type -> ReportMarketFeeReply
+	131, // 205: Operator.VerifyFeeLedger:output_type -> VerifyFeeLedgerReply
+	133, // 206: Operator.ProveReserves:output_type -> ProveReservesReply
+	137, // 207: Operator.TradeVolume:output_type -> TradeVolumeReply
+	25,  // 208: Operator.ReloadUtxos:output_type -> ReloadUtxosReply
+	27,  // 209: Operator.RescanAccount:output_type -> RescanAccountReply
+	29,  // 210: Operator.RescanAccountDryRun:output_type -> RescanAccountDryRunReply
+	14,  // 211: Operator.ListUtxos:output_type -> ListUtxosReply
+	16,  // 212: Operator.ListUtxosForAccount:output_type -> ListUtxosForAccountReply
+	18,  // 213: Operator.ListLockedUtxos:output_type -> ListLockedUtxosReply
+	20,  // 214: Operator.ListPendingDeposits:output_type -> ListPendingDepositsReply
+	4,   // 215: Operator.DropMarket:output_type -> DropMarketReply
+	6,   // 216: Operator.ExcludeUtxo:output_type -> ExcludeUtxoReply
+	8,   // 217: Operator.IncludeUtxo:output_type -> IncludeUtxoReply
+	10,  // 218: Operator.ExportMarketsConfig:output_type -> ExportMarketsConfigReply
+	12,  // 219: Operator.ImportMarketsConfig:output_type -> ImportMarketsConfigReply
+	160, // [160:220] is the sub-list for method output_type
+	100, // [100:160] is the sub-list for method input_type
+	100, // [100:100] is the sub-list for extension type_name
+	100, // [100:100] is the sub-list for extension extendee
+	0,   // [0:100] is the sub-list for field type_name
+}
+
+func init() { file_operator_proto_init() }
+func file_operator_proto_init() {
+	if File_operator_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_operator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropMarketReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExcludeUtxoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExcludeUtxoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncludeUtxoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncludeUtxoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportMarketsConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportMarketsConfigReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportMarketsConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportMarketsConfigReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUtxosReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUtxosForAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUtxosForAccountReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLockedUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLockedUtxosReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingDepositsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingDepositsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingDeposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoInfoList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadUtxosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadUtxosReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanAccountReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanAccountDryRunRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanAccountDryRunReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositMarketReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDepositAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDepositAddressReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDepositMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDepositMarketReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositFeeAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositFeeAccountReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceFeeAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceFeeAccountReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TotalBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TotalBalanceReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetBalanceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsolidateFeeAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsolidateFeeAccountReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMarketReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAssetInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAssetInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlindingDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlindingDataReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountXPubRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountXPubReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateBlindingKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateBlindingKeyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimMarketDepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimMarketDepositReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceByLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceByLabelReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FundMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FundMarketReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimFeeDepositRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimFeeDepositReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUtxoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUtxoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenMarketReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseMarketReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseMarketReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeMarketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeMarketReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketStrategyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketStrategyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchMarketStrategyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchMarketStrategyReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3192,8 +10312,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropMarketReply); i {
+		file_operator_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketPercentageFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3204,8 +10324,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUtxosRequest); i {
+		file_operator_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketFixedFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3216,8 +10336,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUtxosReply); i {
+		file_operator_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketFeeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3228,8 +10348,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UtxoInfoList); i {
+		file_operator_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3240,8 +10360,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UtxoInfo); i {
+		file_operator_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketFeeHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3252,8 +10372,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReloadUtxosRequest); i {
+		file_operator_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketFeeHistoryReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3264,8 +10384,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReloadUtxosReply); i {
+		file_operator_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3276,8 +10396,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositMarketRequest); i {
+		file_operator_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeTier); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3288,8 +10408,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositMarketReply); i {
+		file_operator_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketFeeTiersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3300,8 +10420,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDepositMarketRequest); i {
+		file_operator_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketFeeTiersReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3312,8 +10432,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDepositMarketReply); i {
+		file_operator_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketTradeLimitsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3324,8 +10444,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositFeeAccountRequest); i {
+		file_operator_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketTradeLimitsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3336,8 +10456,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositFeeAccountReply); i {
+		file_operator_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketPricePrecisionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3348,8 +10468,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BalanceFeeAccountRequest); i {
+		file_operator_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketPricePrecisionReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3360,8 +10480,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BalanceFeeAccountReply); i {
+		file_operator_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketSettlementTimeoutRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3372,8 +10492,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMarketRequest); i {
+		file_operator_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketSettlementTimeoutReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3384,8 +10504,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMarketReply); i {
+		file_operator_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketPriceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3396,8 +10516,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimMarketDepositRequest); i {
+		file_operator_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMarketPriceReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3408,8 +10528,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimMarketDepositReply); i {
+		file_operator_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawMarketRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3420,8 +10540,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimFeeDepositRequest); i {
+		file_operator_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawMarketReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3432,8 +10552,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimFeeDepositReply); i {
+		file_operator_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisplayBalance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3444,8 +10564,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenMarketRequest); i {
+		file_operator_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateWithdrawFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3456,8 +10576,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenMarketReply); i {
+		file_operator_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateWithdrawFeeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3468,8 +10588,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseMarketRequest); i {
+		file_operator_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawMultipleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3480,8 +10600,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseMarketReply); i {
+		file_operator_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithdrawMultipleReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3492,8 +10612,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMarketStrategyRequest); i {
+		file_operator_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpWithdrawFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3504,8 +10624,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMarketStrategyReply); i {
+		file_operator_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpWithdrawFeeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3516,8 +10636,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMarketPercentageFeeRequest); i {
+		file_operator_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTradesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3528,8 +10648,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMarketFixedFeeRequest); i {
+		file_operator_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTradesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3540,8 +10660,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMarketFeeReply); i {
+		file_operator_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTradeByTxidRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3552,8 +10672,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMarketPriceRequest); i {
+		file_operator_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTradeByTxidReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3564,8 +10684,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMarketPriceReply); i {
+		file_operator_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTradeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3576,8 +10696,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawMarketRequest); i {
+		file_operator_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTradeReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3588,8 +10708,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawMarketReply); i {
+		file_operator_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeAuditRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3600,8 +10720,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTradesRequest); i {
+		file_operator_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeAuditReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3612,8 +10732,8 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTradesReply); i {
+		file_operator_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditOutpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3624,7 +10744,55 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_operator_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTradesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTradesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeTradesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeTradesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportMarketFeeRequest); i {
 			case 0:
 				return &v.state
@@ -3636,7 +10804,19 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_operator_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportMarketFeeRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReportMarketFeeReply); i {
 			case 0:
 				return &v.state
@@ -3648,7 +10828,7 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_operator_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarketInfo); i {
 			case 0:
 				return &v.state
@@ -3660,7 +10840,31 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_operator_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlindingData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TradeStatusInfo); i {
 			case 0:
 				return &v.state
@@ -3672,7 +10876,7 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_operator_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SwapInfo); i {
 			case 0:
 				return &v.state
@@ -3684,7 +10888,7 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_operator_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SwapFailInfo); i {
 			case 0:
 				return &v.state
@@ -3696,7 +10900,7 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_operator_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TradePrice); i {
 			case 0:
 				return &v.state
@@ -3708,7 +10912,7 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_operator_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TradeInfo); i {
 			case 0:
 				return &v.state
@@ -3720,7 +10924,115 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_operator_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyFeeLedgerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyFeeLedgerReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveReservesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveReservesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountReserves); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutpointReserve); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeVolumeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeLedgerDiscrepancy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_operator_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FeeInfo); i {
 			case 0:
 				return &v.state
@@ -3732,7 +11044,7 @@ func file_operator_proto_init() {
 				return nil
 			}
 		}
-		file_operator_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_operator_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxOutpoint); i {
 			case 0:
 				return &v.state
@@ -3750,8 +11062,8 @@ func file_operator_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_operator_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   49,
+			NumEnums:      3,
+			NumMessages:   145,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
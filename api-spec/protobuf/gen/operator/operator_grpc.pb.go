@@ -17,9 +17,15 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OperatorClient interface {
+	// Returns the version and network of the daemon, the time it was started at
+	// and the number of configured markets.
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoReply, error)
 	// Returns a new derived address for the given market.
 	// If market field is empty, a new Market is created and MUST be initialized.
 	DepositMarket(ctx context.Context, in *DepositMarketRequest, opts ...grpc.CallOption) (*DepositMarketReply, error)
+	// Returns new derived addresses, with their blinding keys, from the account
+	// of the given existing market.
+	GetDepositAddress(ctx context.Context, in *GetDepositAddressRequest, opts ...grpc.CallOption) (*GetDepositAddressReply, error)
 	// Returns the list of previously generated addresses for the given market.
 	ListDepositMarket(ctx context.Context, in *ListDepositMarketRequest, opts ...grpc.CallOption) (*ListDepositMarketReply, error)
 	// Returns a new derived address from the fee account.
@@ -27,20 +33,62 @@ type OperatorClient interface {
 	DepositFeeAccount(ctx context.Context, in *DepositFeeAccountRequest, opts ...grpc.CallOption) (*DepositFeeAccountReply, error)
 	// Returns the aggregated balance of LBTC held in the fee account.
 	BalanceFeeAccount(ctx context.Context, in *BalanceFeeAccountRequest, opts ...grpc.CallOption) (*BalanceFeeAccountReply, error)
+	// Returns the balance of the fee account and of all the markets, aggregated
+	// by asset.
+	TotalBalance(ctx context.Context, in *TotalBalanceRequest, opts ...grpc.CallOption) (*TotalBalanceReply, error)
+	// Spends many small LBTC utxos of the fee account into a single one, to
+	// keep the transactions paying network fees small.
+	ConsolidateFeeAccount(ctx context.Context, in *ConsolidateFeeAccountRequest, opts ...grpc.CallOption) (*ConsolidateFeeAccountReply, error)
 	// Operator can provide transaction(s) outpoints of deposits made to fund a new market.
 	// The transaction must be visible and confirmed, otherwise an error will be returned,
 	// inviting the operator to retry again
 	ClaimMarketDeposit(ctx context.Context, in *ClaimMarketDepositRequest, opts ...grpc.CallOption) (*ClaimMarketDepositReply, error)
+	// Returns the share of the balance of a market that belongs to every label
+	// given to its deposits, in proportion to the deposited amounts.
+	BalanceByLabel(ctx context.Context, in *BalanceByLabelRequest, opts ...grpc.CallOption) (*BalanceByLabelReply, error)
 	// Operator can provide transaction(s) outpoints of deposits made to fund the fee account.
 	// The transaction must be visible and confirmed, otherwise an error will be returned,
 	// inviting the operator to retry again
 	ClaimFeeDeposit(ctx context.Context, in *ClaimFeeDepositRequest, opts ...grpc.CallOption) (*ClaimFeeDepositReply, error)
+	// Requests the given amounts of base and quote asset from the faucet and
+	// sends them to new addresses of the given market, which is created and
+	// funded if it doesn't exist yet. Available on regtest and testnet only.
+	FundMarket(ctx context.Context, in *FundMarketRequest, opts ...grpc.CallOption) (*FundMarketReply, error)
+	// Operator can import a confirmed output sent to one of the addresses of a
+	// market account, by providing the blinding key to unblind it with.
+	ImportUtxo(ctx context.Context, in *ImportUtxoRequest, opts ...grpc.CallOption) (*ImportUtxoReply, error)
 	// Makes the given market tradable
 	OpenMarket(ctx context.Context, in *OpenMarketRequest, opts ...grpc.CallOption) (*OpenMarketReply, error)
 	// Makes the given market NOT tradabale
 	CloseMarket(ctx context.Context, in *CloseMarketRequest, opts ...grpc.CallOption) (*CloseMarketReply, error)
+	// Makes the given tradable market reject new trades, while those already
+	// accepted can still be completed and settled
+	PauseMarket(ctx context.Context, in *PauseMarketRequest, opts ...grpc.CallOption) (*PauseMarketReply, error)
+	// Makes the given paused market accept new trades again
+	ResumeMarket(ctx context.Context, in *ResumeMarketRequest, opts ...grpc.CallOption) (*ResumeMarketReply, error)
 	// Get extended details for each markets either open, closed or to be funded.
+	// If a base asset is given, only the funded markets with such base asset are
+	// returned.
 	ListMarket(ctx context.Context, in *ListMarketRequest, opts ...grpc.CallOption) (*ListMarketReply, error)
+	// Returns ticker, name and precision of the given asset, as published in the
+	// Liquid asset registry. Assets not found in the registry are returned as
+	// unregistered.
+	GetAssetInfo(ctx context.Context, in *GetAssetInfoRequest, opts ...grpc.CallOption) (*GetAssetInfoReply, error)
+	// Reveals asset, amount and blinders of the inputs and outputs of the given
+	// PSET that can be unblinded with the given keys. Counterparties and
+	// auditors can use it to verify the blinders of a proposed trade before
+	// signing it.
+	GetBlindingData(ctx context.Context, in *GetBlindingDataRequest, opts ...grpc.CallOption) (*GetBlindingDataReply, error)
+	// Returns the extended public key of the given wallet account and,
+	// optionally, the master blinding key of the wallet. These let a separate
+	// watch-only tool derive the addresses of the account and unblind its
+	// deposits, but not spend them. The wallet must be unlocked.
+	AccountXPub(ctx context.Context, in *AccountXPubRequest, opts ...grpc.CallOption) (*AccountXPubReply, error)
+	// Replaces an address that never received funds with a new one of the same
+	// account, so that the blinding key of the former, if leaked, doesn't
+	// reveal future deposits. SLIP77 blinding keys are bound to the scripts of
+	// the addresses, hence the new address.
+	RotateBlindingKey(ctx context.Context, in *RotateBlindingKeyRequest, opts ...grpc.CallOption) (*RotateBlindingKeyReply, error)
 	// Changes the Liquidity Provider percentage fee for the given market. It
 	// should be express in basis point. To change the fee on each swap from
 	// (current) 0.25% to 1% you need to pass down 100 The Market MUST be closed
@@ -52,25 +100,117 @@ type OperatorClient interface {
 	// To remove a non-null fixed fee, it's enough to set the fields of the
 	// request to 0.
 	UpdateMarketFixedFee(ctx context.Context, in *UpdateMarketFixedFeeRequest, opts ...grpc.CallOption) (*UpdateMarketFeeReply, error)
+	// Schedules a change of the Liquidity Provider percentage fee for the given
+	// market, effective since the given time. Changes effective since now or
+	// earlier are applied immediately, therefore the Market MUST be closed,
+	// while future ones can be scheduled at any time. Trades always keep the
+	// fee quoted at proposal time.
+	UpdateMarketFee(ctx context.Context, in *UpdateMarketFeeRequest, opts ...grpc.CallOption) (*UpdateMarketFeeReply, error)
+	// Returns the history of the changes of the percentage fee of the given
+	// market, either applied or scheduled.
+	MarketFeeHistory(ctx context.Context, in *MarketFeeHistoryRequest, opts ...grpc.CallOption) (*MarketFeeHistoryReply, error)
+	// Replaces the fee tiers of the given market. Traders authenticated with a
+	// named access token whose volume of settled trades with the market
+	// reaches the min volume of a tier are charged its percentage fee in place
+	// of that of the market. The Market MUST be closed before doing this
+	// change. An empty list removes the tiers.
+	UpdateMarketFeeTiers(ctx context.Context, in *UpdateMarketFeeTiersRequest, opts ...grpc.CallOption) (*UpdateMarketFeeTiersReply, error)
+	// Changes the min and max amount that the proposer of a trade can send for
+	// the given market. The Market MUST be closed before doing this change.
+	UpdateMarketTradeLimits(ctx context.Context, in *UpdateMarketTradeLimitsRequest, opts ...grpc.CallOption) (*UpdateMarketTradeLimitsReply, error)
+	// Changes the number of decimal places the prices of the given market are
+	// rounded to (half-even) when quoted. The Market MUST be closed before doing
+	// this change.
+	UpdateMarketPricePrecision(ctx context.Context, in *UpdateMarketPricePrecisionRequest, opts ...grpc.CallOption) (*UpdateMarketPricePrecisionReply, error)
+	// Changes the time within which the trades of the given market must be
+	// completed once accepted, otherwise they're failed and the funds they
+	// locked are released. It applies only to trades accepted after the change.
+	UpdateMarketSettlementTimeout(ctx context.Context, in *UpdateMarketSettlementTimeoutRequest, opts ...grpc.CallOption) (*UpdateMarketSettlementTimeoutReply, error)
 	// Manually updates the price for the given market
 	UpdateMarketPrice(ctx context.Context, in *UpdateMarketPriceRequest, opts ...grpc.CallOption) (*UpdateMarketPriceReply, error)
 	// Updates the current market making strategy, either using an automated
 	// market making formula or a pluggable price feed
 	UpdateMarketStrategy(ctx context.Context, in *UpdateMarketStrategyRequest, opts ...grpc.CallOption) (*UpdateMarketStrategyReply, error)
+	// Switches the strategy of a market without requiring it to be closed. The
+	// trades already accepted keep the price they were quoted with
+	SwitchMarketStrategy(ctx context.Context, in *SwitchMarketStrategyRequest, opts ...grpc.CallOption) (*SwitchMarketStrategyReply, error)
 	// WithdrawMarket allows the operator to withdraw to external wallet funds
 	// from a specific market. The Market MUST be closed before doing this change.
 	WithdrawMarket(ctx context.Context, in *WithdrawMarketRequest, opts ...grpc.CallOption) (*WithdrawMarketReply, error)
+	// Returns the size and the network fees of the transaction that
+	// WithdrawMarket would make for the given withdrawal, without making it
+	EstimateWithdrawFee(ctx context.Context, in *EstimateWithdrawFeeRequest, opts ...grpc.CallOption) (*EstimateWithdrawFeeReply, error)
+	// WithdrawMultiple withdraws funds from many markets with a single
+	// transaction, paying network fees only once. Nothing is withdrawn if any
+	// of the markets can't afford its withdrawal.
+	WithdrawMultiple(ctx context.Context, in *WithdrawMultipleRequest, opts ...grpc.CallOption) (*WithdrawMultipleReply, error)
+	// BumpWithdrawFee replaces a still unconfirmed withdrawal transaction with
+	// one spending the same market funds and paying a higher network fee rate.
+	BumpWithdrawFee(ctx context.Context, in *BumpWithdrawFeeRequest, opts ...grpc.CallOption) (*BumpWithdrawFeeReply, error)
 	// Returs all the trades processed by the daemon (during process, compelted and rejected)
 	ListTrades(ctx context.Context, in *ListTradesRequest, opts ...grpc.CallOption) (*ListTradesReply, error)
+	// GetTradeByTxid returns the trade settled by the transaction with the
+	// given hash.
+	GetTradeByTxid(ctx context.Context, in *GetTradeByTxidRequest, opts ...grpc.CallOption) (*GetTradeByTxidReply, error)
+	// CancelTrade cancels a proposed or accepted trade, unlocking the unspents
+	// it reserved without waiting for it to expire. Any later attempt to
+	// complete it is rejected. Completed and settled trades can't be canceled.
+	CancelTrade(ctx context.Context, in *CancelTradeRequest, opts ...grpc.CallOption) (*CancelTradeReply, error)
+	// TradeAudit returns the provenance of the inputs and outputs of the
+	// transaction that settled the given trade, recorded at settlement time.
+	TradeAudit(ctx context.Context, in *TradeAuditRequest, opts ...grpc.CallOption) (*TradeAuditReply, error)
+	// ExportTrades streams, in chunks, the CSV or JSON encoded export of the
+	// trades requested within a time window.
+	ExportTrades(ctx context.Context, in *ExportTradesRequest, opts ...grpc.CallOption) (Operator_ExportTradesClient, error)
+	// SubscribeTrades streams the trades in-flight at subscription time and
+	// then their status changes as they happen. Heartbeats are sent
+	// periodically to keep idle connections open. The stream ends if the
+	// subscriber can't keep up with the updates, it must subscribe again then.
+	SubscribeTrades(ctx context.Context, in *SubscribeTradesRequest, opts ...grpc.CallOption) (Operator_SubscribeTradesClient, error)
 	// Displays a report on how much the given market is collecting in Liquidity
 	// Provider fees
 	ReportMarketFee(ctx context.Context, in *ReportMarketFeeRequest, opts ...grpc.CallOption) (*ReportMarketFeeReply, error)
+	// Like ReportMarketFee, but only for the trades settled within a time window
+	ReportMarketFeeRange(ctx context.Context, in *ReportMarketFeeRangeRequest, opts ...grpc.CallOption) (*ReportMarketFeeReply, error)
+	// Cross-checks the ledger of collected fees against the settled trades and
+	// reports the discrepancies found
+	VerifyFeeLedger(ctx context.Context, in *VerifyFeeLedgerRequest, opts ...grpc.CallOption) (*VerifyFeeLedgerReply, error)
+	// Returns a proof of the ownership of the unspents of every account, made
+	// of a signature of each unspent with the key controlling it
+	ProveReserves(ctx context.Context, in *ProveReservesRequest, opts ...grpc.CallOption) (*ProveReservesReply, error)
+	// Returns the volume traded by the given market with the trades settled
+	// within a time window
+	TradeVolume(ctx context.Context, in *TradeVolumeRequest, opts ...grpc.CallOption) (*TradeVolumeReply, error)
 	// Triggers reloading of unspents for stored addresses from blockchain
 	ReloadUtxos(ctx context.Context, in *ReloadUtxosRequest, opts ...grpc.CallOption) (*ReloadUtxosReply, error)
+	// Re-derives the addresses of an account up to the gap limit and reconciles
+	// its utxo set with the blockchain
+	RescanAccount(ctx context.Context, in *RescanAccountRequest, opts ...grpc.CallOption) (*RescanAccountReply, error)
+	// Returns the unspents that RescanAccount would add to and mark as spent in
+	// the utxo set of an account, without changing anything
+	RescanAccountDryRun(ctx context.Context, in *RescanAccountDryRunRequest, opts ...grpc.CallOption) (*RescanAccountDryRunReply, error)
 	// Returns all the unspents and locks
 	ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosReply, error)
+	// Returns the unspents, spents and locks of an account, optionally filtered
+	// by asset, min value and confirmation status
+	ListUtxosForAccount(ctx context.Context, in *ListUtxosForAccountRequest, opts ...grpc.CallOption) (*ListUtxosForAccountReply, error)
+	// Returns the locked utxos along with the trade holding the lock
+	ListLockedUtxos(ctx context.Context, in *ListLockedUtxosRequest, opts ...grpc.CallOption) (*ListLockedUtxosReply, error)
+	// Returns the unconfirmed utxos received by an account, apart from those of
+	// its trades, that don't count for its confirmed balance yet
+	ListPendingDeposits(ctx context.Context, in *ListPendingDepositsRequest, opts ...grpc.CallOption) (*ListPendingDepositsReply, error)
 	// Deletes Market based on account_index
 	DropMarket(ctx context.Context, in *DropMarketRequest, opts ...grpc.CallOption) (*DropMarketReply, error)
+	// Excludes the given utxo from the coin selection of trades and withdrawals.
+	// The utxo still counts for the balance of its account.
+	ExcludeUtxo(ctx context.Context, in *ExcludeUtxoRequest, opts ...grpc.CallOption) (*ExcludeUtxoReply, error)
+	// Makes the given excluded utxo selectable again for trades and withdrawals.
+	IncludeUtxo(ctx context.Context, in *IncludeUtxoRequest, opts ...grpc.CallOption) (*IncludeUtxoReply, error)
+	// Returns the portable JSON configuration of all the markets, without funds
+	ExportMarketsConfig(ctx context.Context, in *ExportMarketsConfigRequest, opts ...grpc.CallOption) (*ExportMarketsConfigReply, error)
+	// Creates the markets of the given JSON configuration, as returned by
+	// ExportMarketsConfig, skipping those already existing
+	ImportMarketsConfig(ctx context.Context, in *ImportMarketsConfigRequest, opts ...grpc.CallOption) (*ImportMarketsConfigReply, error)
 }
 
 type operatorClient struct {
@@ -81,6 +221,15 @@ func NewOperatorClient(cc grpc.ClientConnInterface) OperatorClient {
 	return &operatorClient{cc}
 }
 
+func (c *operatorClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoReply, error) {
+	out := new(GetInfoReply)
+	err := c.cc.Invoke(ctx, "/Operator/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) DepositMarket(ctx context.Context, in *DepositMarketRequest, opts ...grpc.CallOption) (*DepositMarketReply, error) {
 	out := new(DepositMarketReply)
 	err := c.cc.Invoke(ctx, "/Operator/DepositMarket", in, out, opts...)
@@ -90,6 +239,15 @@ func (c *operatorClient) DepositMarket(ctx context.Context, in *DepositMarketReq
 	return out, nil
 }
 
+func (c *operatorClient) GetDepositAddress(ctx context.Context, in *GetDepositAddressRequest, opts ...grpc.CallOption) (*GetDepositAddressReply, error) {
+	out := new(GetDepositAddressReply)
+	err := c.cc.Invoke(ctx, "/Operator/GetDepositAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) ListDepositMarket(ctx context.Context, in *ListDepositMarketRequest, opts ...grpc.CallOption) (*ListDepositMarketReply, error) {
 	out := new(ListDepositMarketReply)
 	err := c.cc.Invoke(ctx, "/Operator/ListDepositMarket", in, out, opts...)
@@ -117,6 +275,24 @@ func (c *operatorClient) BalanceFeeAccount(ctx context.Context, in *BalanceFeeAc
 	return out, nil
 }
 
+func (c *operatorClient) TotalBalance(ctx context.Context, in *TotalBalanceRequest, opts ...grpc.CallOption) (*TotalBalanceReply, error) {
+	out := new(TotalBalanceReply)
+	err := c.cc.Invoke(ctx, "/Operator/TotalBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) ConsolidateFeeAccount(ctx context.Context, in *ConsolidateFeeAccountRequest, opts ...grpc.CallOption) (*ConsolidateFeeAccountReply, error) {
+	out := new(ConsolidateFeeAccountReply)
+	err := c.cc.Invoke(ctx, "/Operator/ConsolidateFeeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) ClaimMarketDeposit(ctx context.Context, in *ClaimMarketDepositRequest, opts ...grpc.CallOption) (*ClaimMarketDepositReply, error) {
 	out := new(ClaimMarketDepositReply)
 	err := c.cc.Invoke(ctx, "/Operator/ClaimMarketDeposit", in, out, opts...)
@@ -126,6 +302,15 @@ func (c *operatorClient) ClaimMarketDeposit(ctx context.Context, in *ClaimMarket
 	return out, nil
 }
 
+func (c *operatorClient) BalanceByLabel(ctx context.Context, in *BalanceByLabelRequest, opts ...grpc.CallOption) (*BalanceByLabelReply, error) {
+	out := new(BalanceByLabelReply)
+	err := c.cc.Invoke(ctx, "/Operator/BalanceByLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) ClaimFeeDeposit(ctx context.Context, in *ClaimFeeDepositRequest, opts ...grpc.CallOption) (*ClaimFeeDepositReply, error) {
 	out := new(ClaimFeeDepositReply)
 	err := c.cc.Invoke(ctx, "/Operator/ClaimFeeDeposit", in, out, opts...)
@@ -135,6 +320,24 @@ func (c *operatorClient) ClaimFeeDeposit(ctx context.Context, in *ClaimFeeDeposi
 	return out, nil
 }
 
+func (c *operatorClient) FundMarket(ctx context.Context, in *FundMarketRequest, opts ...grpc.CallOption) (*FundMarketReply, error) {
+	out := new(FundMarketReply)
+	err := c.cc.Invoke(ctx, "/Operator/FundMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) ImportUtxo(ctx context.Context, in *ImportUtxoRequest, opts ...grpc.CallOption) (*ImportUtxoReply, error) {
+	out := new(ImportUtxoReply)
+	err := c.cc.Invoke(ctx, "/Operator/ImportUtxo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) OpenMarket(ctx context.Context, in *OpenMarketRequest, opts ...grpc.CallOption) (*OpenMarketReply, error) {
 	out := new(OpenMarketReply)
 	err := c.cc.Invoke(ctx, "/Operator/OpenMarket", in, out, opts...)
@@ -153,6 +356,24 @@ func (c *operatorClient) CloseMarket(ctx context.Context, in *CloseMarketRequest
 	return out, nil
 }
 
+func (c *operatorClient) PauseMarket(ctx context.Context, in *PauseMarketRequest, opts ...grpc.CallOption) (*PauseMarketReply, error) {
+	out := new(PauseMarketReply)
+	err := c.cc.Invoke(ctx, "/Operator/PauseMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) ResumeMarket(ctx context.Context, in *ResumeMarketRequest, opts ...grpc.CallOption) (*ResumeMarketReply, error) {
+	out := new(ResumeMarketReply)
+	err := c.cc.Invoke(ctx, "/Operator/ResumeMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) ListMarket(ctx context.Context, in *ListMarketRequest, opts ...grpc.CallOption) (*ListMarketReply, error) {
 	out := new(ListMarketReply)
 	err := c.cc.Invoke(ctx, "/Operator/ListMarket", in, out, opts...)
@@ -162,6 +383,42 @@ func (c *operatorClient) ListMarket(ctx context.Context, in *ListMarketRequest,
 	return out, nil
 }
 
+func (c *operatorClient) GetAssetInfo(ctx context.Context, in *GetAssetInfoRequest, opts ...grpc.CallOption) (*GetAssetInfoReply, error) {
+	out := new(GetAssetInfoReply)
+	err := c.cc.Invoke(ctx, "/Operator/GetAssetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) GetBlindingData(ctx context.Context, in *GetBlindingDataRequest, opts ...grpc.CallOption) (*GetBlindingDataReply, error) {
+	out := new(GetBlindingDataReply)
+	err := c.cc.Invoke(ctx, "/Operator/GetBlindingData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) AccountXPub(ctx context.Context, in *AccountXPubRequest, opts ...grpc.CallOption) (*AccountXPubReply, error) {
+	out := new(AccountXPubReply)
+	err := c.cc.Invoke(ctx, "/Operator/AccountXPub", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) RotateBlindingKey(ctx context.Context, in *RotateBlindingKeyRequest, opts ...grpc.CallOption) (*RotateBlindingKeyReply, error) {
+	out := new(RotateBlindingKeyReply)
+	err := c.cc.Invoke(ctx, "/Operator/RotateBlindingKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) UpdateMarketPercentageFee(ctx context.Context, in *UpdateMarketPercentageFeeRequest, opts ...grpc.CallOption) (*UpdateMarketFeeReply, error) {
 	out := new(UpdateMarketFeeReply)
 	err := c.cc.Invoke(ctx, "/Operator/UpdateMarketPercentageFee", in, out, opts...)
@@ -180,6 +437,60 @@ func (c *operatorClient) UpdateMarketFixedFee(ctx context.Context, in *UpdateMar
 	return out, nil
 }
 
+func (c *operatorClient) UpdateMarketFee(ctx context.Context, in *UpdateMarketFeeRequest, opts ...grpc.CallOption) (*UpdateMarketFeeReply, error) {
+	out := new(UpdateMarketFeeReply)
+	err := c.cc.Invoke(ctx, "/Operator/UpdateMarketFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) MarketFeeHistory(ctx context.Context, in *MarketFeeHistoryRequest, opts ...grpc.CallOption) (*MarketFeeHistoryReply, error) {
+	out := new(MarketFeeHistoryReply)
+	err := c.cc.Invoke(ctx, "/Operator/MarketFeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) UpdateMarketFeeTiers(ctx context.Context, in *UpdateMarketFeeTiersRequest, opts ...grpc.CallOption) (*UpdateMarketFeeTiersReply, error) {
+	out := new(UpdateMarketFeeTiersReply)
+	err := c.cc.Invoke(ctx, "/Operator/UpdateMarketFeeTiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) UpdateMarketTradeLimits(ctx context.Context, in *UpdateMarketTradeLimitsRequest, opts ...grpc.CallOption) (*UpdateMarketTradeLimitsReply, error) {
+	out := new(UpdateMarketTradeLimitsReply)
+	err := c.cc.Invoke(ctx, "/Operator/UpdateMarketTradeLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) UpdateMarketPricePrecision(ctx context.Context, in *UpdateMarketPricePrecisionRequest, opts ...grpc.CallOption) (*UpdateMarketPricePrecisionReply, error) {
+	out := new(UpdateMarketPricePrecisionReply)
+	err := c.cc.Invoke(ctx, "/Operator/UpdateMarketPricePrecision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) UpdateMarketSettlementTimeout(ctx context.Context, in *UpdateMarketSettlementTimeoutRequest, opts ...grpc.CallOption) (*UpdateMarketSettlementTimeoutReply, error) {
+	out := new(UpdateMarketSettlementTimeoutReply)
+	err := c.cc.Invoke(ctx, "/Operator/UpdateMarketSettlementTimeout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) UpdateMarketPrice(ctx context.Context, in *UpdateMarketPriceRequest, opts ...grpc.CallOption) (*UpdateMarketPriceReply, error) {
 	out := new(UpdateMarketPriceReply)
 	err := c.cc.Invoke(ctx, "/Operator/UpdateMarketPrice", in, out, opts...)
@@ -198,6 +509,15 @@ func (c *operatorClient) UpdateMarketStrategy(ctx context.Context, in *UpdateMar
 	return out, nil
 }
 
+func (c *operatorClient) SwitchMarketStrategy(ctx context.Context, in *SwitchMarketStrategyRequest, opts ...grpc.CallOption) (*SwitchMarketStrategyReply, error) {
+	out := new(SwitchMarketStrategyReply)
+	err := c.cc.Invoke(ctx, "/Operator/SwitchMarketStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) WithdrawMarket(ctx context.Context, in *WithdrawMarketRequest, opts ...grpc.CallOption) (*WithdrawMarketReply, error) {
 	out := new(WithdrawMarketReply)
 	err := c.cc.Invoke(ctx, "/Operator/WithdrawMarket", in, out, opts...)
@@ -207,6 +527,33 @@ func (c *operatorClient) WithdrawMarket(ctx context.Context, in *WithdrawMarketR
 	return out, nil
 }
 
+func (c *operatorClient) EstimateWithdrawFee(ctx context.Context, in *EstimateWithdrawFeeRequest, opts ...grpc.CallOption) (*EstimateWithdrawFeeReply, error) {
+	out := new(EstimateWithdrawFeeReply)
+	err := c.cc.Invoke(ctx, "/Operator/EstimateWithdrawFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) WithdrawMultiple(ctx context.Context, in *WithdrawMultipleRequest, opts ...grpc.CallOption) (*WithdrawMultipleReply, error) {
+	out := new(WithdrawMultipleReply)
+	err := c.cc.Invoke(ctx, "/Operator/WithdrawMultiple", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) BumpWithdrawFee(ctx context.Context, in *BumpWithdrawFeeRequest, opts ...grpc.CallOption) (*BumpWithdrawFeeReply, error) {
+	out := new(BumpWithdrawFeeReply)
+	err := c.cc.Invoke(ctx, "/Operator/BumpWithdrawFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) ListTrades(ctx context.Context, in *ListTradesRequest, opts ...grpc.CallOption) (*ListTradesReply, error) {
 	out := new(ListTradesReply)
 	err := c.cc.Invoke(ctx, "/Operator/ListTrades", in, out, opts...)
@@ -216,6 +563,97 @@ func (c *operatorClient) ListTrades(ctx context.Context, in *ListTradesRequest,
 	return out, nil
 }
 
+func (c *operatorClient) GetTradeByTxid(ctx context.Context, in *GetTradeByTxidRequest, opts ...grpc.CallOption) (*GetTradeByTxidReply, error) {
+	out := new(GetTradeByTxidReply)
+	err := c.cc.Invoke(ctx, "/Operator/GetTradeByTxid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) CancelTrade(ctx context.Context, in *CancelTradeRequest, opts ...grpc.CallOption) (*CancelTradeReply, error) {
+	out := new(CancelTradeReply)
+	err := c.cc.Invoke(ctx, "/Operator/CancelTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) TradeAudit(ctx context.Context, in *TradeAuditRequest, opts ...grpc.CallOption) (*TradeAuditReply, error) {
+	out := new(TradeAuditReply)
+	err := c.cc.Invoke(ctx, "/Operator/TradeAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) ExportTrades(ctx context.Context, in *ExportTradesRequest, opts ...grpc.CallOption) (Operator_ExportTradesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Operator_serviceDesc.Streams[0], "/Operator/ExportTrades", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operatorExportTradesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Operator_ExportTradesClient interface {
+	Recv() (*ExportTradesReply, error)
+	grpc.ClientStream
+}
+
+type operatorExportTradesClient struct {
+	grpc.ClientStream
+}
+
+func (x *operatorExportTradesClient) Recv() (*ExportTradesReply, error) {
+	m := new(ExportTradesReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *operatorClient) SubscribeTrades(ctx context.Context, in *SubscribeTradesRequest, opts ...grpc.CallOption) (Operator_SubscribeTradesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Operator_serviceDesc.Streams[1], "/Operator/SubscribeTrades", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operatorSubscribeTradesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Operator_SubscribeTradesClient interface {
+	Recv() (*SubscribeTradesReply, error)
+	grpc.ClientStream
+}
+
+type operatorSubscribeTradesClient struct {
+	grpc.ClientStream
+}
+
+func (x *operatorSubscribeTradesClient) Recv() (*SubscribeTradesReply, error) {
+	m := new(SubscribeTradesReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *operatorClient) ReportMarketFee(ctx context.Context, in *ReportMarketFeeRequest, opts ...grpc.CallOption) (*ReportMarketFeeReply, error) {
 	out := new(ReportMarketFeeReply)
 	err := c.cc.Invoke(ctx, "/Operator/ReportMarketFee", in, out, opts...)
@@ -225,6 +663,42 @@ func (c *operatorClient) ReportMarketFee(ctx context.Context, in *ReportMarketFe
 	return out, nil
 }
 
+func (c *operatorClient) ReportMarketFeeRange(ctx context.Context, in *ReportMarketFeeRangeRequest, opts ...grpc.CallOption) (*ReportMarketFeeReply, error) {
+	out := new(ReportMarketFeeReply)
+	err := c.cc.Invoke(ctx, "/Operator/ReportMarketFeeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) VerifyFeeLedger(ctx context.Context, in *VerifyFeeLedgerRequest, opts ...grpc.CallOption) (*VerifyFeeLedgerReply, error) {
+	out := new(VerifyFeeLedgerReply)
+	err := c.cc.Invoke(ctx, "/Operator/VerifyFeeLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) ProveReserves(ctx context.Context, in *ProveReservesRequest, opts ...grpc.CallOption) (*ProveReservesReply, error) {
+	out := new(ProveReservesReply)
+	err := c.cc.Invoke(ctx, "/Operator/ProveReserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) TradeVolume(ctx context.Context, in *TradeVolumeRequest, opts ...grpc.CallOption) (*TradeVolumeReply, error) {
+	out := new(TradeVolumeReply)
+	err := c.cc.Invoke(ctx, "/Operator/TradeVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) ReloadUtxos(ctx context.Context, in *ReloadUtxosRequest, opts ...grpc.CallOption) (*ReloadUtxosReply, error) {
 	out := new(ReloadUtxosReply)
 	err := c.cc.Invoke(ctx, "/Operator/ReloadUtxos", in, out, opts...)
@@ -234,6 +708,24 @@ func (c *operatorClient) ReloadUtxos(ctx context.Context, in *ReloadUtxosRequest
 	return out, nil
 }
 
+func (c *operatorClient) RescanAccount(ctx context.Context, in *RescanAccountRequest, opts ...grpc.CallOption) (*RescanAccountReply, error) {
+	out := new(RescanAccountReply)
+	err := c.cc.Invoke(ctx, "/Operator/RescanAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) RescanAccountDryRun(ctx context.Context, in *RescanAccountDryRunRequest, opts ...grpc.CallOption) (*RescanAccountDryRunReply, error) {
+	out := new(RescanAccountDryRunReply)
+	err := c.cc.Invoke(ctx, "/Operator/RescanAccountDryRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosReply, error) {
 	out := new(ListUtxosReply)
 	err := c.cc.Invoke(ctx, "/Operator/ListUtxos", in, out, opts...)
@@ -243,6 +735,33 @@ func (c *operatorClient) ListUtxos(ctx context.Context, in *ListUtxosRequest, op
 	return out, nil
 }
 
+func (c *operatorClient) ListUtxosForAccount(ctx context.Context, in *ListUtxosForAccountRequest, opts ...grpc.CallOption) (*ListUtxosForAccountReply, error) {
+	out := new(ListUtxosForAccountReply)
+	err := c.cc.Invoke(ctx, "/Operator/ListUtxosForAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) ListLockedUtxos(ctx context.Context, in *ListLockedUtxosRequest, opts ...grpc.CallOption) (*ListLockedUtxosReply, error) {
+	out := new(ListLockedUtxosReply)
+	err := c.cc.Invoke(ctx, "/Operator/ListLockedUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) ListPendingDeposits(ctx context.Context, in *ListPendingDepositsRequest, opts ...grpc.CallOption) (*ListPendingDepositsReply, error) {
+	out := new(ListPendingDepositsReply)
+	err := c.cc.Invoke(ctx, "/Operator/ListPendingDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorClient) DropMarket(ctx context.Context, in *DropMarketRequest, opts ...grpc.CallOption) (*DropMarketReply, error) {
 	out := new(DropMarketReply)
 	err := c.cc.Invoke(ctx, "/Operator/DropMarket", in, out, opts...)
@@ -252,34 +771,118 @@ func (c *operatorClient) DropMarket(ctx context.Context, in *DropMarketRequest,
 	return out, nil
 }
 
-// OperatorServer is the server API for Operator service.
-// All implementations must embed UnimplementedOperatorServer
-// for forward compatibility
-type OperatorServer interface {
-	// Returns a new derived address for the given market.
-	// If market field is empty, a new Market is created and MUST be initialized.
-	DepositMarket(context.Context, *DepositMarketRequest) (*DepositMarketReply, error)
-	// Returns the list of previously generated addresses for the given market.
-	ListDepositMarket(context.Context, *ListDepositMarketRequest) (*ListDepositMarketReply, error)
-	// Returns a new derived address from the fee account.
+func (c *operatorClient) ExcludeUtxo(ctx context.Context, in *ExcludeUtxoRequest, opts ...grpc.CallOption) (*ExcludeUtxoReply, error) {
+	out := new(ExcludeUtxoReply)
+	err := c.cc.Invoke(ctx, "/Operator/ExcludeUtxo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) IncludeUtxo(ctx context.Context, in *IncludeUtxoRequest, opts ...grpc.CallOption) (*IncludeUtxoReply, error) {
+	out := new(IncludeUtxoReply)
+	err := c.cc.Invoke(ctx, "/Operator/IncludeUtxo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) ExportMarketsConfig(ctx context.Context, in *ExportMarketsConfigRequest, opts ...grpc.CallOption) (*ExportMarketsConfigReply, error) {
+	out := new(ExportMarketsConfigReply)
+	err := c.cc.Invoke(ctx, "/Operator/ExportMarketsConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorClient) ImportMarketsConfig(ctx context.Context, in *ImportMarketsConfigRequest, opts ...grpc.CallOption) (*ImportMarketsConfigReply, error) {
+	out := new(ImportMarketsConfigReply)
+	err := c.cc.Invoke(ctx, "/Operator/ImportMarketsConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OperatorServer is the server API for Operator service.
+// All implementations must embed UnimplementedOperatorServer
+// for forward compatibility
+type OperatorServer interface {
+	// Returns the version and network of the daemon, the time it was started at
+	// and the number of configured markets.
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoReply, error)
+	// Returns a new derived address for the given market.
+	// If market field is empty, a new Market is created and MUST be initialized.
+	DepositMarket(context.Context, *DepositMarketRequest) (*DepositMarketReply, error)
+	// Returns new derived addresses, with their blinding keys, from the account
+	// of the given existing market.
+	GetDepositAddress(context.Context, *GetDepositAddressRequest) (*GetDepositAddressReply, error)
+	// Returns the list of previously generated addresses for the given market.
+	ListDepositMarket(context.Context, *ListDepositMarketRequest) (*ListDepositMarketReply, error)
+	// Returns a new derived address from the fee account.
 	// This is only used to deposit some LBTC to subsidize blockchain fees.
 	DepositFeeAccount(context.Context, *DepositFeeAccountRequest) (*DepositFeeAccountReply, error)
 	// Returns the aggregated balance of LBTC held in the fee account.
 	BalanceFeeAccount(context.Context, *BalanceFeeAccountRequest) (*BalanceFeeAccountReply, error)
+	// Returns the balance of the fee account and of all the markets, aggregated
+	// by asset.
+	TotalBalance(context.Context, *TotalBalanceRequest) (*TotalBalanceReply, error)
+	// Spends many small LBTC utxos of the fee account into a single one, to
+	// keep the transactions paying network fees small.
+	ConsolidateFeeAccount(context.Context, *ConsolidateFeeAccountRequest) (*ConsolidateFeeAccountReply, error)
 	// Operator can provide transaction(s) outpoints of deposits made to fund a new market.
 	// The transaction must be visible and confirmed, otherwise an error will be returned,
 	// inviting the operator to retry again
 	ClaimMarketDeposit(context.Context, *ClaimMarketDepositRequest) (*ClaimMarketDepositReply, error)
+	// Returns the share of the balance of a market that belongs to every label
+	// given to its deposits, in proportion to the deposited amounts.
+	BalanceByLabel(context.Context, *BalanceByLabelRequest) (*BalanceByLabelReply, error)
 	// Operator can provide transaction(s) outpoints of deposits made to fund the fee account.
 	// The transaction must be visible and confirmed, otherwise an error will be returned,
 	// inviting the operator to retry again
 	ClaimFeeDeposit(context.Context, *ClaimFeeDepositRequest) (*ClaimFeeDepositReply, error)
+	// Requests the given amounts of base and quote asset from the faucet and
+	// sends them to new addresses of the given market, which is created and
+	// funded if it doesn't exist yet. Available on regtest and testnet only.
+	FundMarket(context.Context, *FundMarketRequest) (*FundMarketReply, error)
+	// Operator can import a confirmed output sent to one of the addresses of a
+	// market account, by providing the blinding key to unblind it with.
+	ImportUtxo(context.Context, *ImportUtxoRequest) (*ImportUtxoReply, error)
 	// Makes the given market tradable
 	OpenMarket(context.Context, *OpenMarketRequest) (*OpenMarketReply, error)
 	// Makes the given market NOT tradabale
 	CloseMarket(context.Context, *CloseMarketRequest) (*CloseMarketReply, error)
+	// Makes the given tradable market reject new trades, while those already
+	// accepted can still be completed and settled
+	PauseMarket(context.Context, *PauseMarketRequest) (*PauseMarketReply, error)
+	// Makes the given paused market accept new trades again
+	ResumeMarket(context.Context, *ResumeMarketRequest) (*ResumeMarketReply, error)
 	// Get extended details for each markets either open, closed or to be funded.
+	// If a base asset is given, only the funded markets with such base asset are
+	// returned.
 	ListMarket(context.Context, *ListMarketRequest) (*ListMarketReply, error)
+	// Returns ticker, name and precision of the given asset, as published in the
+	// Liquid asset registry. Assets not found in the registry are returned as
+	// unregistered.
+	GetAssetInfo(context.Context, *GetAssetInfoRequest) (*GetAssetInfoReply, error)
+	// Reveals asset, amount and blinders of the inputs and outputs of the given
+	// PSET that can be unblinded with the given keys. Counterparties and
+	// auditors can use it to verify the blinders of a proposed trade before
+	// signing it.
+	GetBlindingData(context.Context, *GetBlindingDataRequest) (*GetBlindingDataReply, error)
+	// Returns the extended public key of the given wallet account and,
+	// optionally, the master blinding key of the wallet. These let a separate
+	// watch-only tool derive the addresses of the account and unblind its
+	// deposits, but not spend them. The wallet must be unlocked.
+	AccountXPub(context.Context, *AccountXPubRequest) (*AccountXPubReply, error)
+	// Replaces an address that never received funds with a new one of the same
+	// account, so that the blinding key of the former, if leaked, doesn't
+	// reveal future deposits. SLIP77 blinding keys are bound to the scripts of
+	// the addresses, hence the new address.
+	RotateBlindingKey(context.Context, *RotateBlindingKeyRequest) (*RotateBlindingKeyReply, error)
 	// Changes the Liquidity Provider percentage fee for the given market. It
 	// should be express in basis point. To change the fee on each swap from
 	// (current) 0.25% to 1% you need to pass down 100 The Market MUST be closed
@@ -291,25 +894,117 @@ type OperatorServer interface {
 	// To remove a non-null fixed fee, it's enough to set the fields of the
 	// request to 0.
 	UpdateMarketFixedFee(context.Context, *UpdateMarketFixedFeeRequest) (*UpdateMarketFeeReply, error)
+	// Schedules a change of the Liquidity Provider percentage fee for the given
+	// market, effective since the given time. Changes effective since now or
+	// earlier are applied immediately, therefore the Market MUST be closed,
+	// while future ones can be scheduled at any time. Trades always keep the
+	// fee quoted at proposal time.
+	UpdateMarketFee(context.Context, *UpdateMarketFeeRequest) (*UpdateMarketFeeReply, error)
+	// Returns the history of the changes of the percentage fee of the given
+	// market, either applied or scheduled.
+	MarketFeeHistory(context.Context, *MarketFeeHistoryRequest) (*MarketFeeHistoryReply, error)
+	// Replaces the fee tiers of the given market. Traders authenticated with a
+	// named access token whose volume of settled trades with the market
+	// reaches the min volume of a tier are charged its percentage fee in place
+	// of that of the market. The Market MUST be closed before doing this
+	// change. An empty list removes the tiers.
+	UpdateMarketFeeTiers(context.Context, *UpdateMarketFeeTiersRequest) (*UpdateMarketFeeTiersReply, error)
+	// Changes the min and max amount that the proposer of a trade can send for
+	// the given market. The Market MUST be closed before doing this change.
+	UpdateMarketTradeLimits(context.Context, *UpdateMarketTradeLimitsRequest) (*UpdateMarketTradeLimitsReply, error)
+	// Changes the number of decimal places the prices of the given market are
+	// rounded to (half-even) when quoted. The Market MUST be closed before doing
+	// this change.
+	UpdateMarketPricePrecision(context.Context, *UpdateMarketPricePrecisionRequest) (*UpdateMarketPricePrecisionReply, error)
+	// Changes the time within which the trades of the given market must be
+	// completed once accepted, otherwise they're failed and the funds they
+	// locked are released. It applies only to trades accepted after the change.
+	UpdateMarketSettlementTimeout(context.Context, *UpdateMarketSettlementTimeoutRequest) (*UpdateMarketSettlementTimeoutReply, error)
 	// Manually updates the price for the given market
 	UpdateMarketPrice(context.Context, *UpdateMarketPriceRequest) (*UpdateMarketPriceReply, error)
 	// Updates the current market making strategy, either using an automated
 	// market making formula or a pluggable price feed
 	UpdateMarketStrategy(context.Context, *UpdateMarketStrategyRequest) (*UpdateMarketStrategyReply, error)
+	// Switches the strategy of a market without requiring it to be closed. The
+	// trades already accepted keep the price they were quoted with
+	SwitchMarketStrategy(context.Context, *SwitchMarketStrategyRequest) (*SwitchMarketStrategyReply, error)
 	// WithdrawMarket allows the operator to withdraw to external wallet funds
 	// from a specific market. The Market MUST be closed before doing this change.
 	WithdrawMarket(context.Context, *WithdrawMarketRequest) (*WithdrawMarketReply, error)
+	// Returns the size and the network fees of the transaction that
+	// WithdrawMarket would make for the given withdrawal, without making it
+	EstimateWithdrawFee(context.Context, *EstimateWithdrawFeeRequest) (*EstimateWithdrawFeeReply, error)
+	// WithdrawMultiple withdraws funds from many markets with a single
+	// transaction, paying network fees only once. Nothing is withdrawn if any
+	// of the markets can't afford its withdrawal.
+	WithdrawMultiple(context.Context, *WithdrawMultipleRequest) (*WithdrawMultipleReply, error)
+	// BumpWithdrawFee replaces a still unconfirmed withdrawal transaction with
+	// one spending the same market funds and paying a higher network fee rate.
+	BumpWithdrawFee(context.Context, *BumpWithdrawFeeRequest) (*BumpWithdrawFeeReply, error)
 	// Returs all the trades processed by the daemon (during process, compelted and rejected)
 	ListTrades(context.Context, *ListTradesRequest) (*ListTradesReply, error)
+	// GetTradeByTxid returns the trade settled by the transaction with the
+	// given hash.
+	GetTradeByTxid(context.Context, *GetTradeByTxidRequest) (*GetTradeByTxidReply, error)
+	// CancelTrade cancels a proposed or accepted trade, unlocking the unspents
+	// it reserved without waiting for it to expire. Any later attempt to
+	// complete it is rejected. Completed and settled trades can't be canceled.
+	CancelTrade(context.Context, *CancelTradeRequest) (*CancelTradeReply, error)
+	// TradeAudit returns the provenance of the inputs and outputs of the
+	// transaction that settled the given trade, recorded at settlement time.
+	TradeAudit(context.Context, *TradeAuditRequest) (*TradeAuditReply, error)
+	// ExportTrades streams, in chunks, the CSV or JSON encoded export of the
+	// trades requested within a time window.
+	ExportTrades(*ExportTradesRequest, Operator_ExportTradesServer) error
+	// SubscribeTrades streams the trades in-flight at subscription time and
+	// then their status changes as they happen. Heartbeats are sent
+	// periodically to keep idle connections open. The stream ends if the
+	// subscriber can't keep up with the updates, it must subscribe again then.
+	SubscribeTrades(*SubscribeTradesRequest, Operator_SubscribeTradesServer) error
 	// Displays a report on how much the given market is collecting in Liquidity
 	// Provider fees
 	ReportMarketFee(context.Context, *ReportMarketFeeRequest) (*ReportMarketFeeReply, error)
+	// Like ReportMarketFee, but only for the trades settled within a time window
+	ReportMarketFeeRange(context.Context, *ReportMarketFeeRangeRequest) (*ReportMarketFeeReply, error)
+	// Cross-checks the ledger of collected fees against the settled trades and
+	// reports the discrepancies found
+	VerifyFeeLedger(context.Context, *VerifyFeeLedgerRequest) (*VerifyFeeLedgerReply, error)
+	// Returns a proof of the ownership of the unspents of every account, made
+	// of a signature of each unspent with the key controlling it
+	ProveReserves(context.Context, *ProveReservesRequest) (*ProveReservesReply, error)
+	// Returns the volume traded by the given market with the trades settled
+	// within a time window
+	TradeVolume(context.Context, *TradeVolumeRequest) (*TradeVolumeReply, error)
 	// Triggers reloading of unspents for stored addresses from blockchain
 	ReloadUtxos(context.Context, *ReloadUtxosRequest) (*ReloadUtxosReply, error)
+	// Re-derives the addresses of an account up to the gap limit and reconciles
+	// its utxo set with the blockchain
+	RescanAccount(context.Context, *RescanAccountRequest) (*RescanAccountReply, error)
+	// Returns the unspents that RescanAccount would add to and mark as spent in
+	// the utxo set of an account, without changing anything
+	RescanAccountDryRun(context.Context, *RescanAccountDryRunRequest) (*RescanAccountDryRunReply, error)
 	// Returns all the unspents and locks
 	ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosReply, error)
+	// Returns the unspents, spents and locks of an account, optionally filtered
+	// by asset, min value and confirmation status
+	ListUtxosForAccount(context.Context, *ListUtxosForAccountRequest) (*ListUtxosForAccountReply, error)
+	// Returns the locked utxos along with the trade holding the lock
+	ListLockedUtxos(context.Context, *ListLockedUtxosRequest) (*ListLockedUtxosReply, error)
+	// Returns the unconfirmed utxos received by an account, apart from those of
+	// its trades, that don't count for its confirmed balance yet
+	ListPendingDeposits(context.Context, *ListPendingDepositsRequest) (*ListPendingDepositsReply, error)
 	// Deletes Market based on account_index
 	DropMarket(context.Context, *DropMarketRequest) (*DropMarketReply, error)
+	// Excludes the given utxo from the coin selection of trades and withdrawals.
+	// The utxo still counts for the balance of its account.
+	ExcludeUtxo(context.Context, *ExcludeUtxoRequest) (*ExcludeUtxoReply, error)
+	// Makes the given excluded utxo selectable again for trades and withdrawals.
+	IncludeUtxo(context.Context, *IncludeUtxoRequest) (*IncludeUtxoReply, error)
+	// Returns the portable JSON configuration of all the markets, without funds
+	ExportMarketsConfig(context.Context, *ExportMarketsConfigRequest) (*ExportMarketsConfigReply, error)
+	// Creates the markets of the given JSON configuration, as returned by
+	// ExportMarketsConfig, skipping those already existing
+	ImportMarketsConfig(context.Context, *ImportMarketsConfigRequest) (*ImportMarketsConfigReply, error)
 	mustEmbedUnimplementedOperatorServer()
 }
 
@@ -317,9 +1012,15 @@ type OperatorServer interface {
 type UnimplementedOperatorServer struct {
 }
 
+func (UnimplementedOperatorServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
 func (UnimplementedOperatorServer) DepositMarket(context.Context, *DepositMarketRequest) (*DepositMarketReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositMarket not implemented")
 }
+func (UnimplementedOperatorServer) GetDepositAddress(context.Context, *GetDepositAddressRequest) (*GetDepositAddressReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositAddress not implemented")
+}
 func (UnimplementedOperatorServer) ListDepositMarket(context.Context, *ListDepositMarketRequest) (*ListDepositMarketReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDepositMarket not implemented")
 }
@@ -329,402 +1030,1263 @@ func (UnimplementedOperatorServer) DepositFeeAccount(context.Context, *DepositFe
 func (UnimplementedOperatorServer) BalanceFeeAccount(context.Context, *BalanceFeeAccountRequest) (*BalanceFeeAccountReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BalanceFeeAccount not implemented")
 }
+func (UnimplementedOperatorServer) TotalBalance(context.Context, *TotalBalanceRequest) (*TotalBalanceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalBalance not implemented")
+}
+func (UnimplementedOperatorServer) ConsolidateFeeAccount(context.Context, *ConsolidateFeeAccountRequest) (*ConsolidateFeeAccountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateFeeAccount not implemented")
+}
 func (UnimplementedOperatorServer) ClaimMarketDeposit(context.Context, *ClaimMarketDepositRequest) (*ClaimMarketDepositReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimMarketDeposit not implemented")
 }
+func (UnimplementedOperatorServer) BalanceByLabel(context.Context, *BalanceByLabelRequest) (*BalanceByLabelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceByLabel not implemented")
+}
 func (UnimplementedOperatorServer) ClaimFeeDeposit(context.Context, *ClaimFeeDepositRequest) (*ClaimFeeDepositReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimFeeDeposit not implemented")
 }
+func (UnimplementedOperatorServer) FundMarket(context.Context, *FundMarketRequest) (*FundMarketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundMarket not implemented")
+}
+func (UnimplementedOperatorServer) ImportUtxo(context.Context, *ImportUtxoRequest) (*ImportUtxoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportUtxo not implemented")
+}
 func (UnimplementedOperatorServer) OpenMarket(context.Context, *OpenMarketRequest) (*OpenMarketReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenMarket not implemented")
 }
 func (UnimplementedOperatorServer) CloseMarket(context.Context, *CloseMarketRequest) (*CloseMarketReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseMarket not implemented")
 }
+func (UnimplementedOperatorServer) PauseMarket(context.Context, *PauseMarketRequest) (*PauseMarketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseMarket not implemented")
+}
+func (UnimplementedOperatorServer) ResumeMarket(context.Context, *ResumeMarketRequest) (*ResumeMarketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeMarket not implemented")
+}
 func (UnimplementedOperatorServer) ListMarket(context.Context, *ListMarketRequest) (*ListMarketReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMarket not implemented")
 }
+func (UnimplementedOperatorServer) GetAssetInfo(context.Context, *GetAssetInfoRequest) (*GetAssetInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetInfo not implemented")
+}
+func (UnimplementedOperatorServer) GetBlindingData(context.Context, *GetBlindingDataRequest) (*GetBlindingDataReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlindingData not implemented")
+}
+func (UnimplementedOperatorServer) AccountXPub(context.Context, *AccountXPubRequest) (*AccountXPubReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountXPub not implemented")
+}
+func (UnimplementedOperatorServer) RotateBlindingKey(context.Context, *RotateBlindingKeyRequest) (*RotateBlindingKeyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateBlindingKey not implemented")
+}
 func (UnimplementedOperatorServer) UpdateMarketPercentageFee(context.Context, *UpdateMarketPercentageFeeRequest) (*UpdateMarketFeeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketPercentageFee not implemented")
 }
 func (UnimplementedOperatorServer) UpdateMarketFixedFee(context.Context, *UpdateMarketFixedFeeRequest) (*UpdateMarketFeeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketFixedFee not implemented")
 }
+func (UnimplementedOperatorServer) UpdateMarketFee(context.Context, *UpdateMarketFeeRequest) (*UpdateMarketFeeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketFee not implemented")
+}
+func (UnimplementedOperatorServer) MarketFeeHistory(context.Context, *MarketFeeHistoryRequest) (*MarketFeeHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketFeeHistory not implemented")
+}
+func (UnimplementedOperatorServer) UpdateMarketFeeTiers(context.Context, *UpdateMarketFeeTiersRequest) (*UpdateMarketFeeTiersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketFeeTiers not implemented")
+}
+func (UnimplementedOperatorServer) UpdateMarketTradeLimits(context.Context, *UpdateMarketTradeLimitsRequest) (*UpdateMarketTradeLimitsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketTradeLimits not implemented")
+}
+func (UnimplementedOperatorServer) UpdateMarketPricePrecision(context.Context, *UpdateMarketPricePrecisionRequest) (*UpdateMarketPricePrecisionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketPricePrecision not implemented")
+}
+func (UnimplementedOperatorServer) UpdateMarketSettlementTimeout(context.Context, *UpdateMarketSettlementTimeoutRequest) (*UpdateMarketSettlementTimeoutReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketSettlementTimeout not implemented")
+}
 func (UnimplementedOperatorServer) UpdateMarketPrice(context.Context, *UpdateMarketPriceRequest) (*UpdateMarketPriceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketPrice not implemented")
 }
 func (UnimplementedOperatorServer) UpdateMarketStrategy(context.Context, *UpdateMarketStrategyRequest) (*UpdateMarketStrategyReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketStrategy not implemented")
 }
+func (UnimplementedOperatorServer) SwitchMarketStrategy(context.Context, *SwitchMarketStrategyRequest) (*SwitchMarketStrategyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchMarketStrategy not implemented")
+}
 func (UnimplementedOperatorServer) WithdrawMarket(context.Context, *WithdrawMarketRequest) (*WithdrawMarketReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawMarket not implemented")
 }
+func (UnimplementedOperatorServer) EstimateWithdrawFee(context.Context, *EstimateWithdrawFeeRequest) (*EstimateWithdrawFeeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateWithdrawFee not implemented")
+}
+func (UnimplementedOperatorServer) WithdrawMultiple(context.Context, *WithdrawMultipleRequest) (*WithdrawMultipleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawMultiple not implemented")
+}
+func (UnimplementedOperatorServer) BumpWithdrawFee(context.Context, *BumpWithdrawFeeRequest) (*BumpWithdrawFeeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpWithdrawFee not implemented")
+}
 func (UnimplementedOperatorServer) ListTrades(context.Context, *ListTradesRequest) (*ListTradesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTrades not implemented")
 }
+func (UnimplementedOperatorServer) GetTradeByTxid(context.Context, *GetTradeByTxidRequest) (*GetTradeByTxidReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTradeByTxid not implemented")
+}
+func (UnimplementedOperatorServer) CancelTrade(context.Context, *CancelTradeRequest) (*CancelTradeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTrade not implemented")
+}
+func (UnimplementedOperatorServer) TradeAudit(context.Context, *TradeAuditRequest) (*TradeAuditReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradeAudit not implemented")
+}
+func (UnimplementedOperatorServer) ExportTrades(*ExportTradesRequest, Operator_ExportTradesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTrades not implemented")
+}
+func (UnimplementedOperatorServer) SubscribeTrades(*SubscribeTradesRequest, Operator_SubscribeTradesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTrades not implemented")
+}
 func (UnimplementedOperatorServer) ReportMarketFee(context.Context, *ReportMarketFeeRequest) (*ReportMarketFeeReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportMarketFee not implemented")
 }
+func (UnimplementedOperatorServer) ReportMarketFeeRange(context.Context, *ReportMarketFeeRangeRequest) (*ReportMarketFeeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMarketFeeRange not implemented")
+}
+func (UnimplementedOperatorServer) VerifyFeeLedger(context.Context, *VerifyFeeLedgerRequest) (*VerifyFeeLedgerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyFeeLedger not implemented")
+}
+func (UnimplementedOperatorServer) ProveReserves(context.Context, *ProveReservesRequest) (*ProveReservesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProveReserves not implemented")
+}
+func (UnimplementedOperatorServer) TradeVolume(context.Context, *TradeVolumeRequest) (*TradeVolumeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradeVolume not implemented")
+}
 func (UnimplementedOperatorServer) ReloadUtxos(context.Context, *ReloadUtxosRequest) (*ReloadUtxosReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReloadUtxos not implemented")
 }
+func (UnimplementedOperatorServer) RescanAccount(context.Context, *RescanAccountRequest) (*RescanAccountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescanAccount not implemented")
+}
+func (UnimplementedOperatorServer) RescanAccountDryRun(context.Context, *RescanAccountDryRunRequest) (*RescanAccountDryRunReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescanAccountDryRun not implemented")
+}
 func (UnimplementedOperatorServer) ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUtxos not implemented")
 }
+func (UnimplementedOperatorServer) ListUtxosForAccount(context.Context, *ListUtxosForAccountRequest) (*ListUtxosForAccountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUtxosForAccount not implemented")
+}
+func (UnimplementedOperatorServer) ListLockedUtxos(context.Context, *ListLockedUtxosRequest) (*ListLockedUtxosReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLockedUtxos not implemented")
+}
+func (UnimplementedOperatorServer) ListPendingDeposits(context.Context, *ListPendingDepositsRequest) (*ListPendingDepositsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingDeposits not implemented")
+}
 func (UnimplementedOperatorServer) DropMarket(context.Context, *DropMarketRequest) (*DropMarketReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropMarket not implemented")
 }
-func (UnimplementedOperatorServer) mustEmbedUnimplementedOperatorServer() {}
+func (UnimplementedOperatorServer) ExcludeUtxo(context.Context, *ExcludeUtxoRequest) (*ExcludeUtxoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExcludeUtxo not implemented")
+}
+func (UnimplementedOperatorServer) IncludeUtxo(context.Context, *IncludeUtxoRequest) (*IncludeUtxoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncludeUtxo not implemented")
+}
+func (UnimplementedOperatorServer) ExportMarketsConfig(context.Context, *ExportMarketsConfigRequest) (*ExportMarketsConfigReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMarketsConfig not implemented")
+}
+func (UnimplementedOperatorServer) ImportMarketsConfig(context.Context, *ImportMarketsConfigRequest) (*ImportMarketsConfigReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMarketsConfig not implemented")
+}
+func (UnimplementedOperatorServer) mustEmbedUnimplementedOperatorServer() {}
+
+// UnsafeOperatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OperatorServer will
+// result in compilation errors.
+type UnsafeOperatorServer interface {
+	mustEmbedUnimplementedOperatorServer()
+}
+
+func RegisterOperatorServer(s grpc.ServiceRegistrar, srv OperatorServer) {
+	s.RegisterService(&_Operator_serviceDesc, srv)
+}
+
+func _Operator_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_DepositMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DepositMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/DepositMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DepositMarket(ctx, req.(*DepositMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_GetDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).GetDepositAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/GetDepositAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).GetDepositAddress(ctx, req.(*GetDepositAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_ListDepositMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDepositMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).ListDepositMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/ListDepositMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).ListDepositMarket(ctx, req.(*ListDepositMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_DepositFeeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositFeeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).DepositFeeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/DepositFeeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).DepositFeeAccount(ctx, req.(*DepositFeeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_BalanceFeeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceFeeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).BalanceFeeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/BalanceFeeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).BalanceFeeAccount(ctx, req.(*BalanceFeeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_TotalBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).TotalBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/TotalBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).TotalBalance(ctx, req.(*TotalBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_ConsolidateFeeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateFeeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).ConsolidateFeeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/ConsolidateFeeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).ConsolidateFeeAccount(ctx, req.(*ConsolidateFeeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_ClaimMarketDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimMarketDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).ClaimMarketDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/ClaimMarketDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).ClaimMarketDeposit(ctx, req.(*ClaimMarketDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_BalanceByLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceByLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).BalanceByLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/BalanceByLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).BalanceByLabel(ctx, req.(*BalanceByLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_ClaimFeeDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimFeeDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).ClaimFeeDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/ClaimFeeDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).ClaimFeeDeposit(ctx, req.(*ClaimFeeDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_FundMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).FundMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/FundMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).FundMarket(ctx, req.(*FundMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_ImportUtxo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportUtxoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).ImportUtxo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/ImportUtxo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).ImportUtxo(ctx, req.(*ImportUtxoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_OpenMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).OpenMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/OpenMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).OpenMarket(ctx, req.(*OpenMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_CloseMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).CloseMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/CloseMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).CloseMarket(ctx, req.(*CloseMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_PauseMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).PauseMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/PauseMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).PauseMarket(ctx, req.(*PauseMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_ResumeMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).ResumeMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/ResumeMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).ResumeMarket(ctx, req.(*ResumeMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_ListMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).ListMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/ListMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).ListMarket(ctx, req.(*ListMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_GetAssetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).GetAssetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/GetAssetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).GetAssetInfo(ctx, req.(*GetAssetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_GetBlindingData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlindingDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).GetBlindingData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/GetBlindingData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).GetBlindingData(ctx, req.(*GetBlindingDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_AccountXPub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountXPubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).AccountXPub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/AccountXPub",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).AccountXPub(ctx, req.(*AccountXPubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_RotateBlindingKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateBlindingKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).RotateBlindingKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/RotateBlindingKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).RotateBlindingKey(ctx, req.(*RotateBlindingKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_UpdateMarketPercentageFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMarketPercentageFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).UpdateMarketPercentageFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/UpdateMarketPercentageFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).UpdateMarketPercentageFee(ctx, req.(*UpdateMarketPercentageFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_UpdateMarketFixedFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMarketFixedFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).UpdateMarketFixedFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/UpdateMarketFixedFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).UpdateMarketFixedFee(ctx, req.(*UpdateMarketFixedFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_UpdateMarketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMarketFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).UpdateMarketFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/UpdateMarketFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).UpdateMarketFee(ctx, req.(*UpdateMarketFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_MarketFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).MarketFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/MarketFeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).MarketFeeHistory(ctx, req.(*MarketFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_UpdateMarketFeeTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMarketFeeTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).UpdateMarketFeeTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/UpdateMarketFeeTiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).UpdateMarketFeeTiers(ctx, req.(*UpdateMarketFeeTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_UpdateMarketTradeLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMarketTradeLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).UpdateMarketTradeLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/UpdateMarketTradeLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).UpdateMarketTradeLimits(ctx, req.(*UpdateMarketTradeLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_UpdateMarketPricePrecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMarketPricePrecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).UpdateMarketPricePrecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/UpdateMarketPricePrecision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).UpdateMarketPricePrecision(ctx, req.(*UpdateMarketPricePrecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_UpdateMarketSettlementTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMarketSettlementTimeoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).UpdateMarketSettlementTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/UpdateMarketSettlementTimeout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).UpdateMarketSettlementTimeout(ctx, req.(*UpdateMarketSettlementTimeoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_UpdateMarketPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMarketPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).UpdateMarketPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/UpdateMarketPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).UpdateMarketPrice(ctx, req.(*UpdateMarketPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_UpdateMarketStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMarketStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).UpdateMarketStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/UpdateMarketStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).UpdateMarketStrategy(ctx, req.(*UpdateMarketStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_SwitchMarketStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchMarketStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).SwitchMarketStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/SwitchMarketStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).SwitchMarketStrategy(ctx, req.(*SwitchMarketStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_WithdrawMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).WithdrawMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/WithdrawMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).WithdrawMarket(ctx, req.(*WithdrawMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_EstimateWithdrawFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateWithdrawFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).EstimateWithdrawFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/EstimateWithdrawFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).EstimateWithdrawFee(ctx, req.(*EstimateWithdrawFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_WithdrawMultiple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawMultipleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).WithdrawMultiple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/WithdrawMultiple",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).WithdrawMultiple(ctx, req.(*WithdrawMultipleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Operator_BumpWithdrawFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpWithdrawFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).BumpWithdrawFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/BumpWithdrawFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).BumpWithdrawFee(ctx, req.(*BumpWithdrawFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// UnsafeOperatorServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to OperatorServer will
-// result in compilation errors.
-type UnsafeOperatorServer interface {
-	mustEmbedUnimplementedOperatorServer()
+func _Operator_ListTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).ListTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/ListTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).ListTrades(ctx, req.(*ListTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterOperatorServer(s grpc.ServiceRegistrar, srv OperatorServer) {
-	s.RegisterService(&_Operator_serviceDesc, srv)
+func _Operator_GetTradeByTxid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradeByTxidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServer).GetTradeByTxid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Operator/GetTradeByTxid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServer).GetTradeByTxid(ctx, req.(*GetTradeByTxidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_DepositMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DepositMarketRequest)
+func _Operator_CancelTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTradeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).DepositMarket(ctx, in)
+		return srv.(OperatorServer).CancelTrade(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/DepositMarket",
+		FullMethod: "/Operator/CancelTrade",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).DepositMarket(ctx, req.(*DepositMarketRequest))
+		return srv.(OperatorServer).CancelTrade(ctx, req.(*CancelTradeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_ListDepositMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListDepositMarketRequest)
+func _Operator_TradeAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeAuditRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).ListDepositMarket(ctx, in)
+		return srv.(OperatorServer).TradeAudit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/ListDepositMarket",
+		FullMethod: "/Operator/TradeAudit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).ListDepositMarket(ctx, req.(*ListDepositMarketRequest))
+		return srv.(OperatorServer).TradeAudit(ctx, req.(*TradeAuditRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_DepositFeeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DepositFeeAccountRequest)
+func _Operator_ExportTrades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTradesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperatorServer).ExportTrades(m, &operatorExportTradesServer{stream})
+}
+
+type Operator_ExportTradesServer interface {
+	Send(*ExportTradesReply) error
+	grpc.ServerStream
+}
+
+type operatorExportTradesServer struct {
+	grpc.ServerStream
+}
+
+func (x *operatorExportTradesServer) Send(m *ExportTradesReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Operator_SubscribeTrades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTradesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperatorServer).SubscribeTrades(m, &operatorSubscribeTradesServer{stream})
+}
+
+type Operator_SubscribeTradesServer interface {
+	Send(*SubscribeTradesReply) error
+	grpc.ServerStream
+}
+
+type operatorSubscribeTradesServer struct {
+	grpc.ServerStream
+}
+
+func (x *operatorSubscribeTradesServer) Send(m *SubscribeTradesReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Operator_ReportMarketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportMarketFeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).DepositFeeAccount(ctx, in)
+		return srv.(OperatorServer).ReportMarketFee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/DepositFeeAccount",
+		FullMethod: "/Operator/ReportMarketFee",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).DepositFeeAccount(ctx, req.(*DepositFeeAccountRequest))
+		return srv.(OperatorServer).ReportMarketFee(ctx, req.(*ReportMarketFeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_BalanceFeeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BalanceFeeAccountRequest)
+func _Operator_ReportMarketFeeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportMarketFeeRangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).BalanceFeeAccount(ctx, in)
+		return srv.(OperatorServer).ReportMarketFeeRange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/BalanceFeeAccount",
+		FullMethod: "/Operator/ReportMarketFeeRange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).BalanceFeeAccount(ctx, req.(*BalanceFeeAccountRequest))
+		return srv.(OperatorServer).ReportMarketFeeRange(ctx, req.(*ReportMarketFeeRangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_ClaimMarketDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClaimMarketDepositRequest)
+func _Operator_VerifyFeeLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyFeeLedgerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).ClaimMarketDeposit(ctx, in)
+		return srv.(OperatorServer).VerifyFeeLedger(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/ClaimMarketDeposit",
+		FullMethod: "/Operator/VerifyFeeLedger",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).ClaimMarketDeposit(ctx, req.(*ClaimMarketDepositRequest))
+		return srv.(OperatorServer).VerifyFeeLedger(ctx, req.(*VerifyFeeLedgerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_ClaimFeeDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClaimFeeDepositRequest)
+func _Operator_ProveReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProveReservesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).ClaimFeeDeposit(ctx, in)
+		return srv.(OperatorServer).ProveReserves(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/ClaimFeeDeposit",
+		FullMethod: "/Operator/ProveReserves",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).ClaimFeeDeposit(ctx, req.(*ClaimFeeDepositRequest))
+		return srv.(OperatorServer).ProveReserves(ctx, req.(*ProveReservesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_OpenMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OpenMarketRequest)
+func _Operator_TradeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).OpenMarket(ctx, in)
+		return srv.(OperatorServer).TradeVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/OpenMarket",
+		FullMethod: "/Operator/TradeVolume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).OpenMarket(ctx, req.(*OpenMarketRequest))
+		return srv.(OperatorServer).TradeVolume(ctx, req.(*TradeVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_CloseMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CloseMarketRequest)
+func _Operator_ReloadUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadUtxosRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).CloseMarket(ctx, in)
+		return srv.(OperatorServer).ReloadUtxos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/CloseMarket",
+		FullMethod: "/Operator/ReloadUtxos",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).CloseMarket(ctx, req.(*CloseMarketRequest))
+		return srv.(OperatorServer).ReloadUtxos(ctx, req.(*ReloadUtxosRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_ListMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMarketRequest)
+func _Operator_RescanAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescanAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).ListMarket(ctx, in)
+		return srv.(OperatorServer).RescanAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/ListMarket",
+		FullMethod: "/Operator/RescanAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).ListMarket(ctx, req.(*ListMarketRequest))
+		return srv.(OperatorServer).RescanAccount(ctx, req.(*RescanAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_UpdateMarketPercentageFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMarketPercentageFeeRequest)
+func _Operator_RescanAccountDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescanAccountDryRunRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).UpdateMarketPercentageFee(ctx, in)
+		return srv.(OperatorServer).RescanAccountDryRun(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/UpdateMarketPercentageFee",
+		FullMethod: "/Operator/RescanAccountDryRun",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).UpdateMarketPercentageFee(ctx, req.(*UpdateMarketPercentageFeeRequest))
+		return srv.(OperatorServer).RescanAccountDryRun(ctx, req.(*RescanAccountDryRunRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_UpdateMarketFixedFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMarketFixedFeeRequest)
+func _Operator_ListUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUtxosRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).UpdateMarketFixedFee(ctx, in)
+		return srv.(OperatorServer).ListUtxos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/UpdateMarketFixedFee",
+		FullMethod: "/Operator/ListUtxos",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).UpdateMarketFixedFee(ctx, req.(*UpdateMarketFixedFeeRequest))
+		return srv.(OperatorServer).ListUtxos(ctx, req.(*ListUtxosRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_UpdateMarketPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMarketPriceRequest)
+func _Operator_ListUtxosForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUtxosForAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).UpdateMarketPrice(ctx, in)
+		return srv.(OperatorServer).ListUtxosForAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/UpdateMarketPrice",
+		FullMethod: "/Operator/ListUtxosForAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).UpdateMarketPrice(ctx, req.(*UpdateMarketPriceRequest))
+		return srv.(OperatorServer).ListUtxosForAccount(ctx, req.(*ListUtxosForAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_UpdateMarketStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMarketStrategyRequest)
+func _Operator_ListLockedUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLockedUtxosRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).UpdateMarketStrategy(ctx, in)
+		return srv.(OperatorServer).ListLockedUtxos(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/UpdateMarketStrategy",
+		FullMethod: "/Operator/ListLockedUtxos",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).UpdateMarketStrategy(ctx, req.(*UpdateMarketStrategyRequest))
+		return srv.(OperatorServer).ListLockedUtxos(ctx, req.(*ListLockedUtxosRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_WithdrawMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawMarketRequest)
+func _Operator_ListPendingDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingDepositsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).WithdrawMarket(ctx, in)
+		return srv.(OperatorServer).ListPendingDeposits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/WithdrawMarket",
+		FullMethod: "/Operator/ListPendingDeposits",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).WithdrawMarket(ctx, req.(*WithdrawMarketRequest))
+		return srv.(OperatorServer).ListPendingDeposits(ctx, req.(*ListPendingDepositsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_ListTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTradesRequest)
+func _Operator_DropMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropMarketRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).ListTrades(ctx, in)
+		return srv.(OperatorServer).DropMarket(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/ListTrades",
+		FullMethod: "/Operator/DropMarket",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).ListTrades(ctx, req.(*ListTradesRequest))
+		return srv.(OperatorServer).DropMarket(ctx, req.(*DropMarketRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_ReportMarketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReportMarketFeeRequest)
+func _Operator_ExcludeUtxo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExcludeUtxoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).ReportMarketFee(ctx, in)
+		return srv.(OperatorServer).ExcludeUtxo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/ReportMarketFee",
+		FullMethod: "/Operator/ExcludeUtxo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).ReportMarketFee(ctx, req.(*ReportMarketFeeRequest))
+		return srv.(OperatorServer).ExcludeUtxo(ctx, req.(*ExcludeUtxoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_ReloadUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReloadUtxosRequest)
+func _Operator_IncludeUtxo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncludeUtxoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).ReloadUtxos(ctx, in)
+		return srv.(OperatorServer).IncludeUtxo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/ReloadUtxos",
+		FullMethod: "/Operator/IncludeUtxo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).ReloadUtxos(ctx, req.(*ReloadUtxosRequest))
+		return srv.(OperatorServer).IncludeUtxo(ctx, req.(*IncludeUtxoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_ListUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUtxosRequest)
+func _Operator_ExportMarketsConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMarketsConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).ListUtxos(ctx, in)
+		return srv.(OperatorServer).ExportMarketsConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/ListUtxos",
+		FullMethod: "/Operator/ExportMarketsConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).ListUtxos(ctx, req.(*ListUtxosRequest))
+		return srv.(OperatorServer).ExportMarketsConfig(ctx, req.(*ExportMarketsConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Operator_DropMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropMarketRequest)
+func _Operator_ImportMarketsConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMarketsConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OperatorServer).DropMarket(ctx, in)
+		return srv.(OperatorServer).ImportMarketsConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Operator/DropMarket",
+		FullMethod: "/Operator/ImportMarketsConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OperatorServer).DropMarket(ctx, req.(*DropMarketRequest))
+		return srv.(OperatorServer).ImportMarketsConfig(ctx, req.(*ImportMarketsConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -733,10 +2295,18 @@ var _Operator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "Operator",
 	HandlerType: (*OperatorServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetInfo",
+			Handler:    _Operator_GetInfo_Handler,
+		},
 		{
 			MethodName: "DepositMarket",
 			Handler:    _Operator_DepositMarket_Handler,
 		},
+		{
+			MethodName: "GetDepositAddress",
+			Handler:    _Operator_GetDepositAddress_Handler,
+		},
 		{
 			MethodName: "ListDepositMarket",
 			Handler:    _Operator_ListDepositMarket_Handler,
@@ -749,14 +2319,34 @@ var _Operator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BalanceFeeAccount",
 			Handler:    _Operator_BalanceFeeAccount_Handler,
 		},
+		{
+			MethodName: "TotalBalance",
+			Handler:    _Operator_TotalBalance_Handler,
+		},
+		{
+			MethodName: "ConsolidateFeeAccount",
+			Handler:    _Operator_ConsolidateFeeAccount_Handler,
+		},
 		{
 			MethodName: "ClaimMarketDeposit",
 			Handler:    _Operator_ClaimMarketDeposit_Handler,
 		},
+		{
+			MethodName: "BalanceByLabel",
+			Handler:    _Operator_BalanceByLabel_Handler,
+		},
 		{
 			MethodName: "ClaimFeeDeposit",
 			Handler:    _Operator_ClaimFeeDeposit_Handler,
 		},
+		{
+			MethodName: "FundMarket",
+			Handler:    _Operator_FundMarket_Handler,
+		},
+		{
+			MethodName: "ImportUtxo",
+			Handler:    _Operator_ImportUtxo_Handler,
+		},
 		{
 			MethodName: "OpenMarket",
 			Handler:    _Operator_OpenMarket_Handler,
@@ -765,10 +2355,34 @@ var _Operator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CloseMarket",
 			Handler:    _Operator_CloseMarket_Handler,
 		},
+		{
+			MethodName: "PauseMarket",
+			Handler:    _Operator_PauseMarket_Handler,
+		},
+		{
+			MethodName: "ResumeMarket",
+			Handler:    _Operator_ResumeMarket_Handler,
+		},
 		{
 			MethodName: "ListMarket",
 			Handler:    _Operator_ListMarket_Handler,
 		},
+		{
+			MethodName: "GetAssetInfo",
+			Handler:    _Operator_GetAssetInfo_Handler,
+		},
+		{
+			MethodName: "GetBlindingData",
+			Handler:    _Operator_GetBlindingData_Handler,
+		},
+		{
+			MethodName: "AccountXPub",
+			Handler:    _Operator_AccountXPub_Handler,
+		},
+		{
+			MethodName: "RotateBlindingKey",
+			Handler:    _Operator_RotateBlindingKey_Handler,
+		},
 		{
 			MethodName: "UpdateMarketPercentageFee",
 			Handler:    _Operator_UpdateMarketPercentageFee_Handler,
@@ -777,6 +2391,30 @@ var _Operator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMarketFixedFee",
 			Handler:    _Operator_UpdateMarketFixedFee_Handler,
 		},
+		{
+			MethodName: "UpdateMarketFee",
+			Handler:    _Operator_UpdateMarketFee_Handler,
+		},
+		{
+			MethodName: "MarketFeeHistory",
+			Handler:    _Operator_MarketFeeHistory_Handler,
+		},
+		{
+			MethodName: "UpdateMarketFeeTiers",
+			Handler:    _Operator_UpdateMarketFeeTiers_Handler,
+		},
+		{
+			MethodName: "UpdateMarketTradeLimits",
+			Handler:    _Operator_UpdateMarketTradeLimits_Handler,
+		},
+		{
+			MethodName: "UpdateMarketPricePrecision",
+			Handler:    _Operator_UpdateMarketPricePrecision_Handler,
+		},
+		{
+			MethodName: "UpdateMarketSettlementTimeout",
+			Handler:    _Operator_UpdateMarketSettlementTimeout_Handler,
+		},
 		{
 			MethodName: "UpdateMarketPrice",
 			Handler:    _Operator_UpdateMarketPrice_Handler,
@@ -785,31 +2423,122 @@ var _Operator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMarketStrategy",
 			Handler:    _Operator_UpdateMarketStrategy_Handler,
 		},
+		{
+			MethodName: "SwitchMarketStrategy",
+			Handler:    _Operator_SwitchMarketStrategy_Handler,
+		},
 		{
 			MethodName: "WithdrawMarket",
 			Handler:    _Operator_WithdrawMarket_Handler,
 		},
+		{
+			MethodName: "EstimateWithdrawFee",
+			Handler:    _Operator_EstimateWithdrawFee_Handler,
+		},
+		{
+			MethodName: "WithdrawMultiple",
+			Handler:    _Operator_WithdrawMultiple_Handler,
+		},
+		{
+			MethodName: "BumpWithdrawFee",
+			Handler:    _Operator_BumpWithdrawFee_Handler,
+		},
 		{
 			MethodName: "ListTrades",
 			Handler:    _Operator_ListTrades_Handler,
 		},
+		{
+			MethodName: "GetTradeByTxid",
+			Handler:    _Operator_GetTradeByTxid_Handler,
+		},
+		{
+			MethodName: "CancelTrade",
+			Handler:    _Operator_CancelTrade_Handler,
+		},
+		{
+			MethodName: "TradeAudit",
+			Handler:    _Operator_TradeAudit_Handler,
+		},
 		{
 			MethodName: "ReportMarketFee",
 			Handler:    _Operator_ReportMarketFee_Handler,
 		},
+		{
+			MethodName: "ReportMarketFeeRange",
+			Handler:    _Operator_ReportMarketFeeRange_Handler,
+		},
+		{
+			MethodName: "VerifyFeeLedger",
+			Handler:    _Operator_VerifyFeeLedger_Handler,
+		},
+		{
+			MethodName: "ProveReserves",
+			Handler:    _Operator_ProveReserves_Handler,
+		},
+		{
+			MethodName: "TradeVolume",
+			Handler:    _Operator_TradeVolume_Handler,
+		},
 		{
 			MethodName: "ReloadUtxos",
 			Handler:    _Operator_ReloadUtxos_Handler,
 		},
+		{
+			MethodName: "RescanAccount",
+			Handler:    _Operator_RescanAccount_Handler,
+		},
+		{
+			MethodName: "RescanAccountDryRun",
+			Handler:    _Operator_RescanAccountDryRun_Handler,
+		},
 		{
 			MethodName: "ListUtxos",
 			Handler:    _Operator_ListUtxos_Handler,
 		},
+		{
+			MethodName: "ListUtxosForAccount",
+			Handler:    _Operator_ListUtxosForAccount_Handler,
+		},
+		{
+			MethodName: "ListLockedUtxos",
+			Handler:    _Operator_ListLockedUtxos_Handler,
+		},
+		{
+			MethodName: "ListPendingDeposits",
+			Handler:    _Operator_ListPendingDeposits_Handler,
+		},
 		{
 			MethodName: "DropMarket",
 			Handler:    _Operator_DropMarket_Handler,
 		},
+		{
+			MethodName: "ExcludeUtxo",
+			Handler:    _Operator_ExcludeUtxo_Handler,
+		},
+		{
+			MethodName: "IncludeUtxo",
+			Handler:    _Operator_IncludeUtxo_Handler,
+		},
+		{
+			MethodName: "ExportMarketsConfig",
+			Handler:    _Operator_ExportMarketsConfig_Handler,
+		},
+		{
+			MethodName: "ImportMarketsConfig",
+			Handler:    _Operator_ImportMarketsConfig_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportTrades",
+			Handler:       _Operator_ExportTrades_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTrades",
+			Handler:       _Operator_SubscribeTrades_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "operator.proto",
 }
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	"github.com/urfave/cli/v2"
+)
+
+var accountxpub = cli.Command{
+	Name:  "accountxpub",
+	Usage: "get the extended public key of a wallet account for watch-only monitoring",
+	Flags: []cli.Flag{
+		&cli.Uint64Flag{
+			Name:     "account_index",
+			Usage:    "the index of the account to get the xpub for",
+			Required: true,
+		},
+		&cli.BoolFlag{
+			Name:  "with_master_blinding_key",
+			Usage: "get also the master blinding key to unblind the deposits",
+		},
+	},
+	Action: accountXPubAction,
+}
+
+func accountXPubAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.AccountXPub(
+		context.Background(), &pboperator.AccountXPubRequest{
+			AccountIndex:          ctx.Uint64("account_index"),
+			WithMasterBlindingKey: ctx.Bool("with_master_blinding_key"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
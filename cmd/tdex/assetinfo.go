@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	"github.com/urfave/cli/v2"
+)
+
+var assetinfo = cli.Command{
+	Name:  "assetinfo",
+	Usage: "show ticker, name and precision of an asset",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "asset",
+			Usage:    "the hash of the asset",
+			Required: true,
+		},
+	},
+	Action: assetInfo,
+}
+
+func assetInfo(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetAssetInfo(
+		context.Background(), &pboperator.GetAssetInfoRequest{
+			Asset: ctx.String("asset"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
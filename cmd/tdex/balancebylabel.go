@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	pbtypes "github.com/tdex-network/tdex-protobuf/generated/go/types"
+	"github.com/urfave/cli/v2"
+)
+
+var balancebylabel = cli.Command{
+	Name:   "balancebylabel",
+	Usage:  "get the share of the current market balance of every deposit label",
+	Action: balanceByLabelAction,
+}
+
+func balanceByLabelAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	baseAsset, quoteAsset, err := getMarketFromState()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.BalanceByLabel(
+		context.Background(), &pboperator.BalanceByLabelRequest{
+			Market: &pbtypes.Market{
+				BaseAsset:  baseAsset,
+				QuoteAsset: quoteAsset,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	"github.com/urfave/cli/v2"
+)
+
+var canceltrade = cli.Command{
+	Name:  "canceltrade",
+	Usage: "cancel a proposed or accepted trade and unlock its unspents",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "trade_id",
+			Usage:    "the id of the trade to cancel",
+			Required: true,
+		},
+	},
+	Action: cancelTrade,
+}
+
+func cancelTrade(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if _, err := client.CancelTrade(
+		context.Background(), &pboperator.CancelTradeRequest{
+			TradeId: ctx.String("trade_id"),
+		},
+	); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println("trade is canceled, any attempt to complete it will be rejected")
+	return nil
+}
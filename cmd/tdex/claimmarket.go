@@ -18,6 +18,10 @@ var claimmarket = cli.Command{
 			Name:  "outpoints",
 			Usage: "list of outpoints referring to utxos [{\"hash\": <string>, \"index\": <number>}]",
 		},
+		&cli.StringFlag{
+			Name:  "label",
+			Usage: "optional label of the liquidity provider the deposits belong to",
+		},
 	},
 	Action: claimMarketAction,
 }
@@ -46,6 +50,7 @@ func claimMarketAction(ctx *cli.Context) error {
 				QuoteAsset: quoteAsset,
 			},
 			Outpoints: outpoints,
+			Label:     ctx.String("label"),
 		},
 	); err != nil {
 		return err
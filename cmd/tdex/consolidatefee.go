@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	"github.com/urfave/cli/v2"
+)
+
+var consolidatefee = cli.Command{
+	Name:  "consolidatefee",
+	Usage: "spend many small utxos of the fee account into a single one",
+	Flags: []cli.Flag{
+		&cli.Uint64Flag{
+			Name:  "max_inputs",
+			Usage: "the max number of utxos to spend, starting from the smallest",
+			Value: 50,
+		},
+		&cli.Int64Flag{
+			Name:  "millisat_per_byte",
+			Usage: "the mSat/byte to pay for the transaction",
+			Value: 100,
+		},
+	},
+	Action: consolidateFeeAction,
+}
+
+func consolidateFeeAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ConsolidateFeeAccount(
+		context.Background(), &pboperator.ConsolidateFeeAccountRequest{
+			MaxInputs:       ctx.Uint64("max_inputs"),
+			MillisatPerByte: ctx.Int64("millisat_per_byte"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
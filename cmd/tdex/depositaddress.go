@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	pbtypes "github.com/tdex-network/tdex-protobuf/generated/go/types"
+
+	"github.com/urfave/cli/v2"
+)
+
+var depositaddress = cli.Command{
+	Name:  "depositaddress",
+	Usage: "get new deposit addresses, with their blinding keys, for the current market",
+	Flags: []cli.Flag{
+		&cli.IntFlag{
+			Name:  "num_of_addresses",
+			Usage: "the number of addresses to retrieve",
+		},
+	},
+	Action: depositAddressAction,
+}
+
+func depositAddressAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	baseAsset, quoteAsset, err := getMarketFromState()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetDepositAddress(
+		context.Background(), &pboperator.GetDepositAddressRequest{
+			Market: &pbtypes.Market{
+				BaseAsset:  baseAsset,
+				QuoteAsset: quoteAsset,
+			},
+			NumOfAddresses: ctx.Int64("num_of_addresses"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
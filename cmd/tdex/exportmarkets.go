@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+
+	"github.com/urfave/cli/v2"
+)
+
+var exportmarkets = cli.Command{
+	Name:  "exportmarkets",
+	Usage: "export the configuration of all markets, without funds, in JSON format",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "out",
+			Usage: "the file to write the configuration to, instead of printing it",
+		},
+	},
+	Action: exportMarketsAction,
+}
+
+func exportMarketsAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ExportMarketsConfig(
+		context.Background(), &pboperator.ExportMarketsConfigRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	out := ctx.String("out")
+	if out == "" {
+		fmt.Println(resp.GetConfig())
+		return nil
+	}
+
+	if err := ioutil.WriteFile(out, []byte(resp.GetConfig()), 0600); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println("markets configuration exported to " + out)
+	return nil
+}
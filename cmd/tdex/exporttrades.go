@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+
+	"github.com/urfave/cli/v2"
+)
+
+var exporttrades = cli.Command{
+	Name:  "exporttrades",
+	Usage: "export the trades requested within a time window in CSV or JSON format",
+	Flags: []cli.Flag{
+		&cli.Uint64Flag{
+			Name:  "from",
+			Usage: "the start of the time window as Unix timestamp",
+		},
+		&cli.Uint64Flag{
+			Name:  "to",
+			Usage: "the end of the time window as Unix timestamp, unbounded if not set",
+			Value: math.MaxInt64,
+		},
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "the format of the export, either csv or json",
+			Value: "csv",
+		},
+		&cli.StringFlag{
+			Name:  "out",
+			Usage: "the file to write the export to, instead of printing it",
+		},
+	},
+	Action: exportTradesAction,
+}
+
+func exportTradesAction(ctx *cli.Context) error {
+	format, ok := pboperator.ExportFormat_value[strings.ToUpper(ctx.String("format"))]
+	if !ok {
+		return fmt.Errorf("unknown format %s, must be either csv or json", ctx.String("format"))
+	}
+
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.ExportTrades(
+		context.Background(), &pboperator.ExportTradesRequest{
+			From:   ctx.Uint64("from"),
+			To:     ctx.Uint64("to"),
+			Format: pboperator.ExportFormat(format),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	out := ctx.String("out")
+	if out != "" {
+		f, err := os.OpenFile(out, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	for {
+		reply, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(reply.GetChunk()); err != nil {
+			return err
+		}
+	}
+
+	if out != "" {
+		fmt.Println()
+		fmt.Println("trades exported to " + out)
+	}
+	return nil
+}
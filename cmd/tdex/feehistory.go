@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	pbtypes "github.com/tdex-network/tdex-protobuf/generated/go/types"
+
+	"github.com/urfave/cli/v2"
+)
+
+var feehistory = cli.Command{
+	Name:   "feehistory",
+	Usage:  "list the applied and scheduled changes of the market percentage fee",
+	Action: feeHistoryAction,
+}
+
+func feeHistoryAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	baseAsset, quoteAsset, err := getMarketFromState()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.MarketFeeHistory(
+		context.Background(), &pboperator.MarketFeeHistoryRequest{
+			Market: &pbtypes.Market{
+				BaseAsset:  baseAsset,
+				QuoteAsset: quoteAsset,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	pbtypes "github.com/tdex-network/tdex-protobuf/generated/go/types"
+
+	"github.com/urfave/cli/v2"
+)
+
+var fundmarket = cli.Command{
+	Name:  "fundmarket",
+	Usage: "fund a market with coins from the faucet (regtest only)",
+	Flags: []cli.Flag{
+		&cli.Uint64Flag{
+			Name:  "base_amount",
+			Usage: "the amount in satoshi of base asset to request",
+		},
+		&cli.Uint64Flag{
+			Name:  "quote_amount",
+			Usage: "the amount in satoshi of quote asset to request",
+		},
+	},
+	Action: fundMarketAction,
+}
+
+func fundMarketAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	baseAsset, quoteAsset, err := getMarketFromState()
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.FundMarket(
+		context.Background(), &pboperator.FundMarketRequest{
+			Market: &pbtypes.Market{
+				BaseAsset:  baseAsset,
+				QuoteAsset: quoteAsset,
+			},
+			Amounts: &pbtypes.Balance{
+				BaseAmount:  ctx.Uint64("base_amount"),
+				QuoteAmount: ctx.Uint64("quote_amount"),
+			},
+		},
+	); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println("market is funded")
+
+	return nil
+}
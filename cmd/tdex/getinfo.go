@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	"github.com/urfave/cli/v2"
+)
+
+var getinfo = cli.Command{
+	Name:   "getinfo",
+	Usage:  "show version, network and uptime of the daemon",
+	Action: getInfo,
+}
+
+func getInfo(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetInfo(
+		context.Background(), &pboperator.GetInfoRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+
+	"github.com/urfave/cli/v2"
+)
+
+var importmarkets = cli.Command{
+	Name:  "importmarkets",
+	Usage: "create the markets of a configuration exported with exportmarkets, skipping existing ones",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "file",
+			Usage:    "the file containing the JSON configuration of the markets",
+			Required: true,
+		},
+	},
+	Action: importMarketsAction,
+}
+
+func importMarketsAction(ctx *cli.Context) error {
+	config, err := ioutil.ReadFile(ctx.String("file"))
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ImportMarketsConfig(
+		context.Background(), &pboperator.ImportMarketsConfigRequest{
+			Config: string(config),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+
+	"github.com/urfave/cli/v2"
+)
+
+var importutxo = cli.Command{
+	Name:  "importutxo",
+	Usage: "import an utxo sent to a market address, unblinding it with the given key",
+	Flags: []cli.Flag{
+		&cli.Uint64Flag{
+			Name:     "account_index",
+			Usage:    "the index of the market account the utxo belongs to",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "txid",
+			Usage:    "the hash of the transaction containing the utxo",
+			Required: true,
+		},
+		&cli.IntFlag{
+			Name:  "vout",
+			Usage: "the index of the utxo in the transaction outputs",
+		},
+		&cli.StringFlag{
+			Name:     "blinding_key",
+			Usage:    "the hex encoded private blinding key of the utxo",
+			Required: true,
+		},
+	},
+	Action: importUtxoAction,
+}
+
+func importUtxoAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if _, err := client.ImportUtxo(
+		context.Background(), &pboperator.ImportUtxoRequest{
+			AccountIndex: ctx.Uint64("account_index"),
+			Outpoint: &pboperator.TxOutpoint{
+				Hash:  ctx.String("txid"),
+				Index: int32(ctx.Int("vout")),
+			},
+			BlindingKey: ctx.String("blinding_key"),
+		},
+	); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println("utxo imported")
+
+	return nil
+}
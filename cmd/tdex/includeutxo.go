@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+
+	"github.com/urfave/cli/v2"
+)
+
+var includeutxo = cli.Command{
+	Name:  "includeutxo",
+	Usage: "make an excluded utxo selectable again for trades and withdrawals",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "txid",
+			Usage:    "the hash of the transaction containing the utxo",
+			Required: true,
+		},
+		&cli.IntFlag{
+			Name:  "vout",
+			Usage: "the index of the utxo in the transaction outputs",
+		},
+	},
+	Action: includeUtxoAction,
+}
+
+func includeUtxoAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if _, err := client.IncludeUtxo(
+		context.Background(), &pboperator.IncludeUtxoRequest{
+			Outpoint: &pboperator.TxOutpoint{
+				Hash:  ctx.String("txid"),
+				Index: int32(ctx.Int("vout")),
+			},
+		},
+	); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println("utxo included")
+
+	return nil
+}
@@ -9,8 +9,18 @@ import (
 )
 
 var listmarket = cli.Command{
-	Name:   "listmarket",
-	Usage:  "list all created markets",
+	Name:  "listmarket",
+	Usage: "list all created markets",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "base_asset",
+			Usage: "list only the markets with this base asset",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "list only the markets with this asset, either as base or quote",
+		},
+	},
 	Action: listMarketAction,
 }
 
@@ -22,7 +32,10 @@ func listMarketAction(ctx *cli.Context) error {
 	defer cleanup()
 
 	resp, err := client.ListMarket(
-		context.Background(), &pboperator.ListMarketRequest{},
+		context.Background(), &pboperator.ListMarketRequest{
+			BaseAsset: ctx.String("base_asset"),
+			Asset:     ctx.String("asset"),
+		},
 	)
 	if err != nil {
 		return err
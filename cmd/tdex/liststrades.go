@@ -9,8 +9,14 @@ import (
 )
 
 var listtrades = cli.Command{
-	Name:   "listtrades",
-	Usage:  "list all processed trades",
+	Name:  "listtrades",
+	Usage: "list all processed trades, or the one settled by the given txid",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "txid",
+			Usage: "the hash of the transaction that settled the trade",
+		},
+	},
 	Action: listTradesAction,
 }
 
@@ -21,6 +27,18 @@ func listTradesAction(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
+	if txid := ctx.String("txid"); txid != "" {
+		resp, err := client.GetTradeByTxid(
+			context.Background(), &pboperator.GetTradeByTxidRequest{Txid: txid},
+		)
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+		return nil
+	}
+
 	resp, err := client.ListTrades(
 		context.Background(), &pboperator.ListTradesRequest{},
 	)
@@ -2,14 +2,41 @@ package main
 
 import (
 	"context"
+	"errors"
 
 	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
 	"github.com/urfave/cli/v2"
 )
 
 var listutxos = cli.Command{
-	Name:   "listutxos",
-	Usage:  "list all utxos",
+	Name:  "listutxos",
+	Usage: "list all utxos, or only those of an account if specified",
+	Flags: []cli.Flag{
+		&cli.Uint64Flag{
+			Name:  "account_index",
+			Usage: "the index of the account to list the utxos for",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "list only the utxos of this asset (requires account_index)",
+		},
+		&cli.Uint64Flag{
+			Name:  "min_value",
+			Usage: "list only the utxos with at least this value (requires account_index)",
+		},
+		&cli.BoolFlag{
+			Name:  "confirmed_only",
+			Usage: "list only the confirmed utxos (requires account_index)",
+		},
+		&cli.BoolFlag{
+			Name:  "locked",
+			Usage: "list only the locked utxos along with the trade locking them",
+		},
+		&cli.BoolFlag{
+			Name:  "pending",
+			Usage: "list only the unconfirmed deposits of the account (requires account_index)",
+		},
+	},
 	Action: listUtxos,
 }
 
@@ -20,6 +47,52 @@ func listUtxos(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
+	if ctx.Bool("locked") {
+		resp, err := client.ListLockedUtxos(
+			context.Background(), &pboperator.ListLockedUtxosRequest{},
+		)
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+		return nil
+	}
+
+	if ctx.Bool("pending") {
+		if !ctx.IsSet("account_index") {
+			return errors.New("pending requires account_index")
+		}
+		resp, err := client.ListPendingDeposits(
+			context.Background(), &pboperator.ListPendingDepositsRequest{
+				AccountIndex: ctx.Uint64("account_index"),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+		return nil
+	}
+
+	if ctx.IsSet("account_index") {
+		resp, err := client.ListUtxosForAccount(
+			context.Background(), &pboperator.ListUtxosForAccountRequest{
+				AccountIndex:  ctx.Uint64("account_index"),
+				Asset:         ctx.String("asset"),
+				MinValue:      ctx.Uint64("min_value"),
+				ConfirmedOnly: ctx.Bool("confirmed_only"),
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+		return nil
+	}
+
 	resp, err := client.ListUtxos(
 		context.Background(), &pboperator.ListUtxosRequest{},
 	)
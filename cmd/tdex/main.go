@@ -57,21 +57,45 @@ func main() {
 		&unlockwallet,
 		&depositfee,
 		&depositmarket,
+		&depositaddress,
 		&claimfee,
 		&claimmarket,
+		&balancebylabel,
+		&fundmarket,
+		&importutxo,
+		&excludeutxo,
+		&includeutxo,
 		&fragmentfee,
+		&consolidatefee,
 		&fragmentmarket,
 		&listmarket,
 		&listtrades,
 		&openmarket,
 		&closemarket,
+		&pausemarket,
+		&resumemarket,
 		&dropmarket,
+		&exportmarkets,
+		&exporttrades,
+		&importmarkets,
 		&updatestrategy,
+		&switchstrategy,
 		&updateprice,
 		&updatePercentagefee,
 		&updateFixedfee,
+		&updateFeeTiers,
+		&feehistory,
 		&listutxos,
+		&accountxpub,
+		&rotateblindingkey,
+		&totalbalance,
 		&reloadtxos,
+		&verifyfeeledger,
+		&provereserves,
+		&tradeaudit,
+		&canceltrade,
+		&assetinfo,
+		&getinfo,
 	)
 
 	err := app.Run(os.Args)
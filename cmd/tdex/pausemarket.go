@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	pbtypes "github.com/tdex-network/tdex-protobuf/generated/go/types"
+
+	"github.com/urfave/cli/v2"
+)
+
+var pausemarket = cli.Command{
+	Name:   "pause",
+	Usage:  "make a market reject new trades while completing those in-flight",
+	Action: pauseMarketAction,
+}
+
+func pauseMarketAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	baseAsset, quoteAsset, err := getMarketFromState()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.PauseMarket(
+		context.Background(), &pboperator.PauseMarketRequest{
+			Market: &pbtypes.Market{
+				BaseAsset:  baseAsset,
+				QuoteAsset: quoteAsset,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println("market is paused, in-flight trades can still be completed")
+	return nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	"github.com/urfave/cli/v2"
+)
+
+var provereserves = cli.Command{
+	Name:  "provereserves",
+	Usage: "sign every owned utxo to prove the custody of the funds",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "nonce",
+			Usage: "the nonce chosen by the auditor to include in the signed messages",
+		},
+	},
+	Action: proveReserves,
+}
+
+func proveReserves(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ProveReserves(
+		context.Background(), &pboperator.ProveReservesRequest{
+			Nonce: ctx.String("nonce"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
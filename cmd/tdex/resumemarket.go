@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	pbtypes "github.com/tdex-network/tdex-protobuf/generated/go/types"
+
+	"github.com/urfave/cli/v2"
+)
+
+var resumemarket = cli.Command{
+	Name:   "resume",
+	Usage:  "make a paused market accept new trades again",
+	Action: resumeMarketAction,
+}
+
+func resumeMarketAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	baseAsset, quoteAsset, err := getMarketFromState()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ResumeMarket(
+		context.Background(), &pboperator.ResumeMarketRequest{
+			Market: &pbtypes.Market{
+				BaseAsset:  baseAsset,
+				QuoteAsset: quoteAsset,
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println("market is accepting new trades again")
+	return nil
+}
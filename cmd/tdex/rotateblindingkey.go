@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	"github.com/urfave/cli/v2"
+)
+
+var rotateblindingkey = cli.Command{
+	Name:  "rotateblindingkey",
+	Usage: "replace an address never funded with a new one with unrelated blinding key",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "address",
+			Usage:    "the address to rotate",
+			Required: true,
+		},
+	},
+	Action: rotateBlindingKeyAction,
+}
+
+func rotateBlindingKeyAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.RotateBlindingKey(
+		context.Background(), &pboperator.RotateBlindingKeyRequest{
+			Address: ctx.String("address"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
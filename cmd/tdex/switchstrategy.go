@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	pbtypes "github.com/tdex-network/tdex-protobuf/generated/go/types"
+
+	"github.com/urfave/cli/v2"
+)
+
+var switchstrategy = cli.Command{
+	Name:  "switchstrategy",
+	Usage: "switches the market making strategy without closing the market",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "pluggable",
+			Usage: "switch to the pluggable strategy, starting from the given prices",
+			Value: false,
+		},
+		&cli.Float64Flag{
+			Name:  "base_price",
+			Usage: "the starting base price of the pluggable strategy",
+		},
+		&cli.Float64Flag{
+			Name:  "quote_price",
+			Usage: "the starting quote price of the pluggable strategy",
+		},
+	},
+	Action: switchStrategyAction,
+}
+
+func switchStrategyAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	baseAsset, quoteAsset, err := getMarketFromState()
+	if err != nil {
+		return err
+	}
+
+	strategy := pboperator.StrategyType_BALANCED
+	var price *pbtypes.Price
+	if ctx.Bool("pluggable") {
+		strategy = pboperator.StrategyType_PLUGGABLE
+		price = &pbtypes.Price{
+			BasePrice:  float32(ctx.Float64("base_price")),
+			QuotePrice: float32(ctx.Float64("quote_price")),
+		}
+	}
+
+	_, err = client.SwitchMarketStrategy(
+		context.Background(), &pboperator.SwitchMarketStrategyRequest{
+			Market: &pbtypes.Market{
+				BaseAsset:  baseAsset,
+				QuoteAsset: quoteAsset,
+			},
+			StrategyType: strategy,
+			Price:        price,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println("strategy has been switched")
+	return nil
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	"github.com/urfave/cli/v2"
+)
+
+var totalbalance = cli.Command{
+	Name:   "totalbalance",
+	Usage:  "get the balance of the fee account and of all markets by asset",
+	Action: totalBalanceAction,
+}
+
+func totalBalanceAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.TotalBalance(
+		context.Background(), &pboperator.TotalBalanceRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	"github.com/urfave/cli/v2"
+)
+
+var tradeaudit = cli.Command{
+	Name:  "tradeaudit",
+	Usage: "show the inputs and outputs of the transaction of a settled trade",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "trade_id",
+			Usage:    "the id of the settled trade",
+			Required: true,
+		},
+	},
+	Action: tradeAudit,
+}
+
+func tradeAudit(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.TradeAudit(
+		context.Background(), &pboperator.TradeAuditRequest{
+			TradeId: ctx.String("trade_id"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
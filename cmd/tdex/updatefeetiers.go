@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	pbtypes "github.com/tdex-network/tdex-protobuf/generated/go/types"
+
+	"github.com/urfave/cli/v2"
+)
+
+var updateFeeTiers = cli.Command{
+	Name:  "feetiers",
+	Usage: "updates the current market fees charged to traders by volume",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "tiers",
+			Usage: "list of fee tiers [{\"min_volume\": <number>, \"basis_point\": <number>}], empty to remove them",
+			Value: "[]",
+		},
+	},
+	Action: updateFeeTiersAction,
+}
+
+func updateFeeTiersAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	baseAsset, quoteAsset, err := getMarketFromState()
+	if err != nil {
+		return err
+	}
+
+	tiers, err := parseFeeTiers(ctx.String("tiers"))
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.UpdateMarketFeeTiers(
+		context.Background(), &pboperator.UpdateMarketFeeTiersRequest{
+			Market: &pbtypes.Market{
+				BaseAsset:  baseAsset,
+				QuoteAsset: quoteAsset,
+			},
+			FeeTiers: tiers,
+		},
+	); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println("market fee tiers have been updated")
+	return nil
+}
+
+func parseFeeTiers(str string) ([]*pboperator.FeeTier, error) {
+	var tiers []*pboperator.FeeTier
+	if err := json.Unmarshal([]byte(str), &tiers); err != nil {
+		return nil, errors.New("unable to parse provided fee tiers")
+	}
+	return tiers, nil
+}
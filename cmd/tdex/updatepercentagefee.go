@@ -18,6 +18,10 @@ var updatePercentagefee = cli.Command{
 			Name:  "basis_point",
 			Usage: "set the fee basis point",
 		},
+		&cli.Uint64Flag{
+			Name:  "effective_at",
+			Usage: "schedule the fee to be effective since this unix timestamp",
+		},
 	},
 	Action: updatePercentageFeeAction,
 }
@@ -35,6 +39,25 @@ func updatePercentageFeeAction(ctx *cli.Context) error {
 	}
 
 	basisPoint := ctx.Int64("basis_point")
+	if ctx.IsSet("effective_at") {
+		if _, err := client.UpdateMarketFee(
+			context.Background(), &pboperator.UpdateMarketFeeRequest{
+				Market: &pbtypes.Market{
+					BaseAsset:  baseAsset,
+					QuoteAsset: quoteAsset,
+				},
+				BasisPoint:  basisPoint,
+				EffectiveAt: ctx.Uint64("effective_at"),
+			},
+		); err != nil {
+			return err
+		}
+
+		fmt.Println()
+		fmt.Println("market fee change has been scheduled")
+		return nil
+	}
+
 	req := &pboperator.UpdateMarketPercentageFeeRequest{
 		Market: &pbtypes.Market{
 			BaseAsset:  baseAsset,
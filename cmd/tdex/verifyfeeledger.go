@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+
+	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
+	"github.com/urfave/cli/v2"
+)
+
+var verifyfeeledger = cli.Command{
+	Name:  "verifyfeeledger",
+	Usage: "cross-check the ledger of collected fees against the settled trades",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "repair",
+			Usage: "record the fees of settled trades missing from the ledger",
+		},
+	},
+	Action: verifyFeeLedger,
+}
+
+func verifyFeeLedger(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.VerifyFeeLedger(
+		context.Background(), &pboperator.VerifyFeeLedgerRequest{
+			Repair: ctx.Bool("repair"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
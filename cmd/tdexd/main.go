@@ -24,14 +24,17 @@ import (
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/soheilhy/cmux"
 	"github.com/tdex-network/tdex-daemon/internal/core/application"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 	grpchandler "github.com/tdex-network/tdex-daemon/internal/interfaces/grpc/handler"
 	"github.com/tdex-network/tdex-daemon/internal/interfaces/grpc/interceptor"
+	"github.com/tdex-network/tdex-daemon/internal/interfaces/metrics"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/tdex-network/tdex-daemon/config"
 	"github.com/tdex-network/tdex-daemon/pkg/crawler"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	pboperator "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/operator"
 	pbwallet "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/wallet"
@@ -40,9 +43,22 @@ import (
 	_ "net/http/pprof"
 )
 
+// build info, injected at link time
+var (
+	version = "dev"
+	commit  = "none"
+	date    = "unknown"
+)
+
 func main() {
 	log.SetLevel(log.Level(config.GetInt(config.LogLevelKey)))
 
+	application.BuildInfo = application.Build{
+		Version: version,
+		Commit:  commit,
+		Date:    date,
+	}
+
 	//http://localhost:8024/debug/pprof/
 	if config.GetBool(config.EnableProfilerKey) {
 		runtime.SetBlockProfileRate(1)
@@ -54,11 +70,28 @@ func main() {
 		}()
 	}
 
+	walletOpts := domain.WalletOptions{
+		BaseDerivationPath: config.GetString(config.BaseDerivationPathKey),
+		GapLimit:           config.GetInt(config.AddressesGapLimitKey),
+		DepositAddressType: config.GetString(config.DepositAddressTypeKey),
+	}
+
 	dbDir := filepath.Join(config.GetString(config.DataDirPathKey), "db")
-	repoManager, err := dbbadger.NewRepoManager(dbDir, log.New())
+	var dbEncryptionKey []byte
+	if config.IsSet(config.DbEncryptionPassphraseKey) {
+		key, err := dbbadger.DeriveEncryptionKey(
+			dbDir, config.GetString(config.DbEncryptionPassphraseKey),
+		)
+		if err != nil {
+			log.WithError(err).Panic("error while deriving db encryption key")
+		}
+		dbEncryptionKey = key
+	}
+	repoManager, err := dbbadger.NewRepoManager(dbDir, log.New(), dbEncryptionKey)
 	if err != nil {
 		log.WithError(err).Panic("error while opening db")
 	}
+	repoManager = application.WithBalanceCache(repoManager)
 
 	marketsFee := int64(config.GetFloat(config.DefaultFeeKey) * 100)
 	marketsBaseAsset := config.GetString(config.BaseAssetKey)
@@ -67,12 +100,46 @@ func main() {
 	pricesSlippagePercentage := decimal.NewFromFloat(config.GetFloat(config.PriceSlippageKey))
 	network := config.GetNetwork()
 	feeThreshold := uint64(config.GetInt(config.FeeAccountBalanceThresholdKey))
+	minConfirmations := uint64(config.GetInt(config.MinConfirmationsKey))
+	dustThreshold := uint64(config.GetInt(config.DustThresholdKey))
+	antiFeeSniping := config.GetBool(config.AntiFeeSnipingKey)
 
 	explorerSvc, err := config.GetExplorer()
 	if err != nil {
 		log.WithError(err).Panic("error while setting up explorer service")
 	}
 
+	var metricsExporter *metrics.Exporter
+	if config.IsSet(config.MetricsListeningPortKey) {
+		metricsExporter = metrics.NewExporter()
+		explorerSvc = metricsExporter.WrapExplorer(explorerSvc)
+	}
+
+	webhookSvc, err := config.GetWebhook()
+	if err != nil {
+		log.WithError(err).Panic("error while setting up webhook service")
+	}
+
+	coinSelector, err := config.GetCoinSelector()
+	if err != nil {
+		log.WithError(err).Panic("error while setting up coin selector")
+	}
+
+	fiatPriceSvc, err := config.GetFiatPriceSource()
+	if err != nil {
+		log.WithError(err).Panic("error while setting up fiat price source")
+	}
+
+	assetRegistrySvc, err := config.GetAssetRegistry()
+	if err != nil {
+		log.WithError(err).Panic("error while setting up asset registry")
+	}
+
+	faucetSvc, err := config.GetFaucet()
+	if err != nil {
+		log.WithError(err).Panic("error while setting up faucet")
+	}
+
 	crawlerSvc := crawler.NewService(crawler.Opts{
 		ExplorerSvc:        explorerSvc,
 		ErrorHandler:       func(err error) { log.Warn(err) },
@@ -80,51 +147,111 @@ func main() {
 		ExplorerLimit:      config.GetInt(config.CrawlLimitKey),
 		ExplorerTokenBurst: config.GetInt(config.CrawlTokenBurst),
 	})
+	tradeFeed := application.NewTradeFeed()
+	peerVolumes := application.NewPeerVolumeCache()
 	blockchainListener := application.NewBlockchainListener(
 		crawlerSvc,
 		repoManager,
+		webhookSvc,
+		tradeFeed,
+		peerVolumes,
 		marketsBaseAsset,
 		network,
 	)
 
+	// PSETs are signed with the keys of the internal wallet, since no external
+	// signer is configured.
+	var signer application.Signer
+
 	traderSvc := application.NewTradeService(
 		repoManager,
 		explorerSvc,
 		blockchainListener,
+		webhookSvc,
+		tradeFeed,
+		peerVolumes,
+		signer,
 		marketsBaseAsset,
 		tradesExpiryDurationInSeconds,
 		pricesSlippagePercentage,
+		uint64(config.GetInt(config.MaxSlippageBasisPointsKey)),
+		minConfirmations,
+		coinSelector,
+		dustThreshold,
+		antiFeeSniping,
+		config.GetBool(config.AutoTopUpFeeAccountKey),
+		application.RateLimits{
+			ProposalsPerMarket: config.GetInt(config.MaxProposalsPerMarketKey),
+			ProposalsPerPeer:   config.GetInt(config.MaxProposalsPerPeerKey),
+		},
 		network,
 	)
 	operatorSvc := application.NewOperatorService(
 		repoManager,
 		explorerSvc,
 		blockchainListener,
+		tradeFeed,
+		signer,
+		fiatPriceSvc,
+		assetRegistrySvc,
+		faucetSvc,
 		marketsBaseAsset,
 		marketsFee,
 		network,
 		feeThreshold,
+		application.FeeRateFloor{
+			MinMilliSatPerByte: config.GetInt(config.WithdrawalMinFeeRateKey),
+			ClampUp:            config.GetBool(config.WithdrawalClampFeeRateKey),
+		},
+		dustThreshold,
+		antiFeeSniping,
 	)
+	if priceBand := config.GetInt(config.PriceBandBasisPointsKey); priceBand > 0 {
+		traderSvc.SetPriceOracle(
+			application.NewFiatPriceOracle(fiatPriceSvc), uint64(priceBand),
+		)
+	}
+	operatorSvc.SetMaxTxInputs(config.GetInt(config.MaxTxInputsKey))
+	if peerFees := config.GetPeerFees(); len(peerFees) > 0 {
+		traderSvc.SetPeerFees(peerFees)
+	}
+	if psetLogSize := config.GetInt(config.PsetLogSizeKey); psetLogSize > 0 {
+		psetLog, err := application.NewFilePsetLog(
+			filepath.Join(config.GetString(config.DataDirPathKey), "psets"),
+			psetLogSize,
+			config.GetBool(config.PsetLogBlindingKeysKey),
+		)
+		if err != nil {
+			log.WithError(err).Panic("error while setting up pset log")
+		}
+		traderSvc.SetPsetLog(psetLog)
+	}
 	walletSvc, err := application.NewWalletService(
 		repoManager,
 		explorerSvc,
 		blockchainListener,
+		signer,
 		withElementsSvc,
 		network,
+		walletOpts,
 		marketsFee,
 		marketsBaseAsset,
+		minConfirmations,
+		dustThreshold,
 	)
 	if err != nil {
 		log.WithError(err).Panic("error while setting up wallet service")
 	}
+	healthSvc := application.NewHealthService(repoManager, explorerSvc)
 
 	// Ports
 	traderAddress := fmt.Sprintf(":%+v", config.GetInt(config.TraderListeningPortKey))
 	operatorAddress := fmt.Sprintf(":%+v", config.GetInt(config.OperatorListeningPortKey))
 	// Grpc Server
+	traderAuthTokens := config.GetTraderAuthTokens()
 	traderGrpcServer := grpc.NewServer(
-		interceptor.UnaryInterceptor(),
-		interceptor.StreamInterceptor(),
+		interceptor.TraderUnaryInterceptor(traderAuthTokens),
+		interceptor.TraderStreamInterceptor(traderAuthTokens),
 	)
 	operatorGrpcServer := grpc.NewServer(
 		interceptor.UnaryInterceptor(),
@@ -133,15 +260,19 @@ func main() {
 
 	traderHandler := grpchandler.NewTraderHandler(traderSvc)
 	walletHandler := grpchandler.NewWalletHandler(walletSvc)
-	operatorHandler := grpchandler.NewOperatorHandler(operatorSvc)
+	operatorHandler := grpchandler.NewOperatorHandler(operatorSvc, traderSvc)
+	healthHandler := grpchandler.NewHealthHandler(healthSvc)
 
 	// Register proto implementations on Trader interface
 	pbtrader.RegisterTradeServer(traderGrpcServer, traderHandler)
 	// Register proto implementations on Operator interface
 	pboperator.RegisterOperatorServer(operatorGrpcServer, operatorHandler)
 	pbwallet.RegisterWalletServer(operatorGrpcServer, walletHandler)
+	// Register the standard gRPC health protocol on both interfaces
+	healthpb.RegisterHealthServer(traderGrpcServer, healthHandler)
+	healthpb.RegisterHealthServer(operatorGrpcServer, healthHandler)
 
-	log.Info("starting daemon")
+	log.Infof("starting daemon version %s, commit %s", version, commit)
 
 	var cancelStats context.CancelFunc
 	if log.GetLevel() >= log.DebugLevel {
@@ -155,14 +286,84 @@ func main() {
 		)
 	}
 
-	defer stop(
-		repoManager,
-		blockchainListener,
-		traderGrpcServer,
-		operatorGrpcServer,
-		cancelStats,
+	reaperCtx, cancelReaper := context.WithCancel(context.Background())
+	traderSvc.StartTradeReaper(
+		reaperCtx,
+		config.GetDuration(config.TradeReaperIntervalKey)*time.Second,
+		config.GetDuration(config.TradeExpiryGracePeriodKey)*time.Second,
+	)
+	traderSvc.StartLockReaper(
+		reaperCtx,
+		config.GetDuration(config.TradeReaperIntervalKey)*time.Second,
+		config.GetDuration(config.UnspentLockTTLKey)*time.Second,
 	)
 
+	reorgWatcherCtx, cancelReorgWatcher := context.WithCancel(
+		context.Background(),
+	)
+	traderSvc.StartReorgWatcher(
+		reorgWatcherCtx,
+		config.GetDuration(config.ReorgWatcherIntervalKey)*time.Second,
+		uint64(config.GetInt(config.ReorgDepthKey)),
+	)
+
+	feeSweepCtx, cancelFeeSweep := context.WithCancel(context.Background())
+	if addr := config.GetString(config.FeeSweepAddressKey); addr != "" {
+		operatorSvc.StartFeeSweep(
+			feeSweepCtx,
+			config.GetDuration(config.FeeSweepIntervalKey)*time.Second,
+			application.FeeSweep{
+				Address:         addr,
+				Threshold:       uint64(config.GetInt(config.FeeSweepThresholdKey)),
+				MilliSatPerByte: int64(config.GetInt(config.FeeSweepFeeRateKey)),
+			},
+		)
+	}
+
+	metricsCtx, cancelMetrics := context.WithCancel(context.Background())
+	var metricsServer *http.Server
+	if metricsExporter != nil {
+		if err := metricsExporter.Start(metricsCtx, operatorSvc, traderSvc); err != nil {
+			log.WithError(err).Panic("error while setting up metrics exporter")
+		}
+		metricsAddress := fmt.Sprintf(":%+v", config.GetInt(config.MetricsListeningPortKey))
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metricsExporter.Handler())
+		metricsServer = &http.Server{Addr: metricsAddress, Handler: mux}
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil &&
+				err != http.ErrServerClosed {
+				log.WithError(err).Warn("metrics endpoint stopped")
+			}
+		}()
+		log.Info("metrics endpoint is listening on " + metricsAddress)
+	}
+
+	srv := &server{
+		repoManager:        repoManager,
+		blockchainListener: blockchainListener,
+		traderSvc:          traderSvc,
+		traderServer:       traderGrpcServer,
+		operatorServer:     operatorGrpcServer,
+		cancelStats:        cancelStats,
+		cancelReaper:       cancelReaper,
+		cancelReorgWatcher: cancelReorgWatcher,
+		cancelFeeSweep:     cancelFeeSweep,
+		cancelMetrics:      cancelMetrics,
+		metricsServer:      metricsServer,
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(
+			context.Background(),
+			config.GetDuration(config.ShutdownTimeoutKey)*time.Second,
+		)
+		defer cancel()
+
+		if err := srv.Shutdown(ctx); err != nil {
+			log.WithError(err).Warn("daemon stopped with trades still in flight")
+		}
+	}()
+
 	// Serve grpc and grpc-web multiplexed on the same port
 	if err := serveMux(traderAddress, true, traderGrpcServer); err != nil {
 		log.WithError(err).Panic("error listening on trader interface")
@@ -181,35 +382,91 @@ func main() {
 	log.Info("shutting down daemon")
 }
 
-func stop(
-	repoManager ports.RepoManager,
-	blockchainListener application.BlockchainListener,
-	traderServer *grpc.Server,
-	operatorServer *grpc.Server,
-	cancelStats context.CancelFunc,
-) {
+// server holds the services and the interfaces of the running daemon that
+// must be stopped on shutdown.
+type server struct {
+	repoManager        ports.RepoManager
+	blockchainListener application.BlockchainListener
+	traderSvc          application.TradeService
+	traderServer       *grpc.Server
+	operatorServer     *grpc.Server
+	cancelStats        context.CancelFunc
+	cancelReaper       context.CancelFunc
+	cancelReorgWatcher context.CancelFunc
+	cancelFeeSweep     context.CancelFunc
+	cancelMetrics      context.CancelFunc
+	// metricsServer serves the metrics endpoint, nil if metrics are disabled.
+	metricsServer *http.Server
+}
+
+// Shutdown stops accepting new swap proposals and waits for the accepted
+// trades to be completed before stopping the interfaces and the background
+// services, and closing the db. The trades not completed when ctx is done
+// are abandoned and the error of ctx is returned, but the daemon is stopped
+// anyway.
+func (s *server) Shutdown(ctx context.Context) error {
 	if log.GetLevel() >= log.DebugLevel {
-		cancelStats()
+		s.cancelStats()
 		time.Sleep(1 * time.Second)
 		log.Debug("cancel printing statistics")
 	}
 
-	operatorServer.Stop()
+	// the trader interface is still needed to complete the accepted trades.
+	drainErr := s.traderSvc.Shutdown(ctx)
+	log.Debug("drained in-flight trades")
+
+	stopGrpcServer(ctx, s.operatorServer)
 	log.Debug("disabled operator interface")
 
-	traderServer.Stop()
+	stopGrpcServer(ctx, s.traderServer)
 	log.Debug("disabled trader interface")
 
-	blockchainListener.StopObservation()
+	s.cancelReaper()
+	log.Debug("stopped trade and lock reapers")
+
+	s.cancelReorgWatcher()
+	log.Debug("stopped reorg watcher")
+
+	s.cancelFeeSweep()
+	log.Debug("stopped fee sweep")
+
+	s.cancelMetrics()
+	log.Debug("stopped metrics exporter")
+
+	if s.metricsServer != nil {
+		if err := s.metricsServer.Shutdown(ctx); err != nil {
+			s.metricsServer.Close()
+		}
+		log.Debug("disabled metrics endpoint")
+	}
+
+	s.blockchainListener.StopObservation()
 	// give the crawler the time to terminate
 	time.Sleep(
 		time.Duration(config.GetInt(config.CrawlIntervalKey)) * time.Millisecond,
 	)
 
-	repoManager.Close()
+	s.repoManager.Close()
 	log.Debug("closed connection with database")
 
 	log.Debug("exiting")
+	return drainErr
+}
+
+// stopGrpcServer waits for the pending requests of the given server to be
+// served, or for ctx to be done, before closing all its connections.
+func stopGrpcServer(ctx context.Context, grpcServer *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
 }
 
 func serveMux(address string, withSsl bool, grpcServer *grpc.Server) error {
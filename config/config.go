@@ -1,21 +1,30 @@
 package config
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/btcsuite/btcutil"
+	"github.com/tdex-network/tdex-daemon/pkg/assetregistry"
 	"github.com/tdex-network/tdex-daemon/pkg/explorer"
 	"github.com/tdex-network/tdex-daemon/pkg/explorer/elements"
 	"github.com/tdex-network/tdex-daemon/pkg/explorer/esplora"
+	"github.com/tdex-network/tdex-daemon/pkg/fiatprice"
+	"github.com/tdex-network/tdex-daemon/pkg/wallet"
+	"github.com/tdex-network/tdex-daemon/pkg/webhook"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	"github.com/vulpemventures/go-elements/address"
 	"github.com/vulpemventures/go-elements/network"
 )
 
@@ -24,7 +33,8 @@ const (
 	TraderListeningPortKey = "TRADER_LISTENING_PORT"
 	// OperatorListeningPortKey is the port where the gRPC Operator interface will listen on
 	OperatorListeningPortKey = "OPERATOR_LISTENING_PORT"
-	// ExplorerEndpointKey is the endpoint where the Electrs (for Liquid) REST API is listening
+	// ExplorerEndpointKey is the endpoint where the Electrs (for Liquid) REST API is listening.
+	// It can be a comma separated list of endpoints in order of priority
 	ExplorerEndpointKey = "EXPLORER_ENDPOINT"
 	// ExplorerRequestTimeoutKey are the milliseconds to wait for HTTP responses before timeouts
 	ExplorerRequestTimeoutKey = "EXPLORER_REQUEST_TIMEOUT"
@@ -32,10 +42,15 @@ const (
 	DataDirPathKey = "DATA_DIR_PATH"
 	// LogLevelKey are the different logging levels. For reference on the values https://godoc.org/github.com/sirupsen/logrus#Level
 	LogLevelKey = "LOG_LEVEL"
-	// DefaultFeeKey is the default swap fee when creating a market
+	// DefaultFeeKey is the default swap fee when creating a market. It can be
+	// set to 0 for markets that charge only fixed fees, or no fees at all
 	DefaultFeeKey = "DEFAULT_FEE"
 	// NetworkKey is the network to use. Either "liquid" or "regtest"
 	NetworkKey = "NETWORK"
+	// NetworkParamsPathKey is the path of a JSON file with the params of a
+	// custom Elements chain to use instead of the one selected with NetworkKey.
+	// The base asset defaults to the asset id of the custom chain
+	NetworkParamsPathKey = "NETWORK_PARAMS_PATH"
 	// BaseAssetKey is the default asset hash to be used as base asset for all markets. Default is LBTC
 	BaseAssetKey = "BASE_ASSET"
 	// CrawlIntervalKey is the interval in milliseconds to be used when watching the blockchain via the explorer
@@ -44,8 +59,24 @@ const (
 	FeeAccountBalanceThresholdKey = "FEE_ACCOUNT_BALANCE_THRESHOLD"
 	// TradeExpiryTimeKey is the duration in seconds of lock on unspents we reserve for accpeted trades, before eventually double spending it
 	TradeExpiryTimeKey = "TRADE_EXPIRY_TIME"
+	// MinTradeExpiryTimeKey is the min duration in seconds accepted for the
+	// lock of the unspents of accepted trades. Shorter ones would force
+	// settlement in fewer blocks than required to be safe
+	MinTradeExpiryTimeKey = "MIN_TRADE_EXPIRY_TIME"
+	// MaxTradeExpiryTimeKey is the max duration in seconds accepted for the
+	// lock of the unspents of accepted trades
+	MaxTradeExpiryTimeKey = "MAX_TRADE_EXPIRY_TIME"
 	// PriceSlippageKey is the percentage of the slipage for accepting trades compared to current spot price
 	PriceSlippageKey = "PRICE_SLIPPAGE"
+	// MaxSlippageBasisPointsKey is the max deviation, in basis points, of the
+	// price implied by a swap request from the current spot price of the market
+	// for accepting the trade. The implied price includes the market fees.
+	// The check is disabled if set to 0
+	MaxSlippageBasisPointsKey = "MAX_SLIPPAGE_BASIS_POINTS"
+	// MinConfirmationsKey is the number of confirmations an utxo must have to be
+	// used for trading. Utxos with less confirmations are counted as unconfirmed
+	// balance
+	MinConfirmationsKey = "MIN_CONFIRMATIONS"
 	// SSLCertPathKey is the path to the SSL certificate
 	SSLCertPathKey = "SSL_CERT"
 	// SSLKeyPathKey is the path to the SSL private key
@@ -68,10 +99,160 @@ const (
 	// CrawlTokenBurst represents number of bursts tokens permitted from
 	//crawler to explorer
 	CrawlTokenBurst = "CRAWL_TOKEN"
+	// WebhookEndpointKey is the url where notifications about trade status
+	// changes are POSTed to. Notifications are disabled if not set
+	WebhookEndpointKey = "WEBHOOK_ENDPOINT"
+	// WebhookSecretKey is the secret used to sign notifications, so that the
+	// receiver can verify their authenticity via the X-Tdex-Signature header
+	WebhookSecretKey = "WEBHOOK_SECRET"
+	// WebhookMaxAttemptsKey is the max number of delivery attempts of a
+	// notification, retried with exponential backoff
+	WebhookMaxAttemptsKey = "WEBHOOK_MAX_ATTEMPTS"
+	// CoinSelectionStrategyKey is the coin selection algorithm used when
+	// filling trade proposals, one of largest_first, smallest_first and
+	// branch_and_bound. The built-in one is used if not set
+	CoinSelectionStrategyKey = "COIN_SELECTION_STRATEGY"
+	// MaxTxInputsKey is the max number of unspents of an account that coin
+	// selection combines in the transactions of trades and withdrawals. Zero
+	// means no limit
+	MaxTxInputsKey = "MAX_TX_INPUTS"
+	// ExplorerProxyKey is the address of the SOCKS5 proxy, like the Tor one, in
+	// the form host:port, through which all connections to the explorer are
+	// routed. It is required to use .onion explorer endpoints
+	ExplorerProxyKey = "EXPLORER_PROXY"
+	// ExplorerStreamEndpointKey is the endpoint of a Server-Sent Events stream
+	// of new transactions, in the Esplora JSON format, used to detect address
+	// activity in real time instead of polling the explorer. Addresses are
+	// polled whenever the stream is unavailable
+	ExplorerStreamEndpointKey = "EXPLORER_STREAM_ENDPOINT"
+	// ExplorerMaxRetriesKey is the number of times a read request to the
+	// explorer is retried if failed at transport level or with a 5xx response
+	ExplorerMaxRetriesKey = "EXPLORER_MAX_RETRIES"
+	// ExplorerRetryBackoffKey are the milliseconds to wait before the first
+	// retry of a request to the explorer, doubled at every further one
+	ExplorerRetryBackoffKey = "EXPLORER_RETRY_BACKOFF"
+	// FiatPriceEndpointKey is the url of the rate API used to value collected
+	// fees in fiat. Fiat values are not reported if not set
+	FiatPriceEndpointKey = "FIAT_PRICE_ENDPOINT"
+	// FiatCurrencyKey is the code of the fiat currency returned by the rate API
+	FiatCurrencyKey = "FIAT_CURRENCY"
+	// AssetRegistryEndpointKey is the url of the Liquid asset registry used to
+	// resolve ticker, name and precision of the assets of the markets. Only
+	// L-BTC metadata are resolved if not set
+	AssetRegistryEndpointKey = "ASSET_REGISTRY_ENDPOINT"
+	// AssetRegistryCacheTTLKey is the time in seconds the metadata of an asset
+	// are cached for before being fetched again from the registry
+	AssetRegistryCacheTTLKey = "ASSET_REGISTRY_CACHE_TTL"
+	// TradeReaperIntervalKey is the interval in seconds between the checks for
+	// trades not completed in time, whose unspents are unlocked
+	TradeReaperIntervalKey = "TRADE_REAPER_INTERVAL"
+	// TradeExpiryGracePeriodKey is the time in seconds a trade is given past
+	// its expiration time before unlocking its unspents
+	TradeExpiryGracePeriodKey = "TRADE_EXPIRY_GRACE_PERIOD"
+	// UnspentLockTTLKey is the time in seconds after which the locks on
+	// unspents not held by any pending trade are released
+	UnspentLockTTLKey = "UNSPENT_LOCK_TTL"
+	// AutoTopUpFeeAccountKey makes the markets with L-BTC base asset pay for the
+	// network fees of their trades whenever the fee account runs low, out of
+	// the L-BTC they buy with their quote asset. Trades where the market sells
+	// L-BTC are refused in that case
+	AutoTopUpFeeAccountKey = "AUTO_TOP_UP_FEE_ACCOUNT"
+	// MetricsListeningPortKey is the port where the HTTP /metrics endpoint for
+	// Prometheus will listen on. Metrics are disabled if not set
+	MetricsListeningPortKey = "METRICS_LISTENING_PORT"
+	// BaseDerivationPathKey is the absolute BIP32 path, like m/84'/0', from
+	// which the accounts of the wallet are derived. It can be set to restore
+	// wallets created by other tools with non-standard paths. It can't change
+	// once the wallet has derived some addresses
+	BaseDerivationPathKey = "BASE_DERIVATION_PATH"
+	// DepositAddressTypeKey is the type of the deposit addresses of the
+	// accounts, either native segwit (p2wpkh) or nested segwit (p2sh-p2wpkh)
+	// for wallets not supporting the former. Change addresses are always
+	// native segwit
+	DepositAddressTypeKey = "DEPOSIT_ADDRESS_TYPE"
+	// AddressesGapLimitKey is the number of consecutive unused addresses after
+	// which the discovery of an account stops when restoring or rescanning it
+	AddressesGapLimitKey = "ADDRESSES_GAP_LIMIT"
+	// WithdrawalMinFeeRateKey is the min fee rate, in millisatoshi per byte,
+	// accepted for market withdrawals. Defaults to the Liquid min relay fee
+	WithdrawalMinFeeRateKey = "WITHDRAWAL_MIN_MILLISAT_PER_BYTE"
+	// WithdrawalClampFeeRateKey makes withdrawals requested with a fee rate
+	// below the min one be made at the min rate instead of being rejected
+	WithdrawalClampFeeRateKey = "WITHDRAWAL_CLAMP_FEE_RATE"
+	// MaxProposalsPerMarketKey is the max number of swap proposals accepted
+	// per minute for every market. There's no limit if set to zero
+	MaxProposalsPerMarketKey = "MAX_PROPOSALS_PER_MARKET"
+	// MaxProposalsPerPeerKey is the max number of swap proposals accepted per
+	// minute from every trader, identified by its network address. There's no
+	// limit if set to zero
+	MaxProposalsPerPeerKey = "MAX_PROPOSALS_PER_PEER"
+	// PeerFeesKey is a comma separated list of <peer>=<basis_point> entries
+	// with the fee charged to the swaps of the given trader in place of the one
+	// of the market, like alice=10. Traders are identified by the name of their
+	// access token, see TraderAuthTokensKey, therefore the fees are charged
+	// only if the trader interface is restricted
+	PeerFeesKey = "PEER_FEES"
+	// TraderAuthTokensKey is a comma separated list of the access tokens the
+	// traders must present, as x-tdex-auth-token gRPC metadata, to be served
+	// by the trader interface, for private or permissioned markets. A token
+	// can be prefixed with the name of the trader it's been issued to, like
+	// alice:<token>, so that the fee tiers and the fees by peer apply to its
+	// swaps. The interface is public if not set
+	TraderAuthTokensKey = "TRADER_AUTH_TOKENS"
+	// DbEncryptionPassphraseKey is the passphrase the key used to encrypt the
+	// db at rest is derived from. The db is stored in plaintext if not set
+	DbEncryptionPassphraseKey = "DB_ENCRYPTION_PASSPHRASE"
+	// DustThresholdKey is the min amount, in satoshi, of the change outputs
+	// of swaps and withdrawals. Coins are selected to avoid lower changes, or
+	// L-BTC ones are added to the network fees. Disabled if set to zero
+	DustThresholdKey = "DUST_THRESHOLD"
+	// FaucetEndpointKey is the url of the esplora faucet, like the nigiri one,
+	// the coins for funding markets with the FundMarket RPC are requested to.
+	// It's available on regtest only and disabled if not set
+	FaucetEndpointKey = "FAUCET_ENDPOINT"
+	// ShutdownTimeoutKey is the max time in seconds the daemon waits, when
+	// stopped, for the accepted trades to be completed before exiting
+	ShutdownTimeoutKey = "SHUTDOWN_TIMEOUT"
+	// PriceBandBasisPointsKey is the max deviation, in basis points, of the
+	// price of markets, whatever their strategy, from the reference one, given
+	// by the fiat prices of their assets, for accepting trades. It requires a
+	// fiat price endpoint and it's disabled if set to 0
+	PriceBandBasisPointsKey = "PRICE_BAND_BASIS_POINTS"
+	// PsetLogSizeKey is the max number of trades whose swap PSETs are stored
+	// in the psets folder of the datadir for post-mortem analysis, the oldest
+	// ones are removed first. It's disabled if set to 0
+	PsetLogSizeKey = "PSET_LOG_SIZE"
+	// PsetLogBlindingKeysKey enables storing the blinding keys given with the
+	// logged swap PSETs, they're redacted otherwise
+	PsetLogBlindingKeysKey = "PSET_LOG_BLINDING_KEYS"
+	// ReorgWatcherIntervalKey is the interval in seconds between the checks for
+	// chain reorgs affecting the confirmed unspents
+	ReorgWatcherIntervalKey = "REORG_WATCHER_INTERVAL"
+	// ReorgDepthKey is the number of most recent blocks checked for reorgs,
+	// unspents confirmed in older blocks are considered final
+	ReorgDepthKey = "REORG_DEPTH"
+	// FeeSweepAddressKey is the confidential address, like that of a treasury
+	// or a burn one, the L-BTC balance of the fee account exceeding the sweep
+	// threshold is periodically sent to. Sweeps are disabled if not set
+	FeeSweepAddressKey = "FEE_SWEEP_ADDRESS"
+	// FeeSweepThresholdKey is the balance, in satoshi, left in the fee account
+	// after every sweep. It can't be lower than the fee account balance
+	// threshold
+	FeeSweepThresholdKey = "FEE_SWEEP_THRESHOLD"
+	// FeeSweepIntervalKey is the interval in seconds between sweeps
+	FeeSweepIntervalKey = "FEE_SWEEP_INTERVAL"
+	// FeeSweepFeeRateKey is the fee rate, in millisatoshi per byte, of the
+	// sweep transactions. Defaults to the withdrawal min fee rate if not set
+	FeeSweepFeeRateKey = "FEE_SWEEP_MILLISAT_PER_BYTE"
+	// AntiFeeSnipingKey makes the swaps and withdrawals of the daemon be locked
+	// to the current block height, like most wallets do to discourage fee
+	// sniping
+	AntiFeeSnipingKey = "ANTI_FEE_SNIPING"
 )
 
 var vip *viper.Viper
 var defaultDataDir = btcutil.AppDataDir("tdex-daemon", false)
+var customNetwork *network.Network
 
 func init() {
 	vip = viper.New()
@@ -82,6 +263,8 @@ func init() {
 	vip.SetDefault(OperatorListeningPortKey, 9000)
 	vip.SetDefault(ExplorerEndpointKey, "https://blockstream.info/liquid/api")
 	vip.SetDefault(ExplorerRequestTimeoutKey, 15000)
+	vip.SetDefault(ExplorerMaxRetriesKey, 0)
+	vip.SetDefault(ExplorerRetryBackoffKey, 500)
 	vip.SetDefault(LogLevelKey, 4)
 	vip.SetDefault(DefaultFeeKey, 0.25)
 	vip.SetDefault(CrawlIntervalKey, 5000)
@@ -89,12 +272,41 @@ func init() {
 	vip.SetDefault(NetworkKey, network.Liquid.Name)
 	vip.SetDefault(BaseAssetKey, network.Liquid.AssetID)
 	vip.SetDefault(TradeExpiryTimeKey, 120)
+	vip.SetDefault(MaxTxInputsKey, 0)
+	vip.SetDefault(MinTradeExpiryTimeKey, 60)
+	vip.SetDefault(MaxTradeExpiryTimeKey, 3600)
+	vip.SetDefault(TradeReaperIntervalKey, 60)
+	vip.SetDefault(TradeExpiryGracePeriodKey, 60)
+	vip.SetDefault(UnspentLockTTLKey, 600)
+	vip.SetDefault(ShutdownTimeoutKey, 30)
+	vip.SetDefault(PriceBandBasisPointsKey, 0)
+	vip.SetDefault(PsetLogSizeKey, 0)
+	vip.SetDefault(PsetLogBlindingKeysKey, false)
+	vip.SetDefault(ReorgWatcherIntervalKey, 60)
+	vip.SetDefault(ReorgDepthKey, 10)
+	vip.SetDefault(AntiFeeSnipingKey, false)
+	vip.SetDefault(FeeSweepThresholdKey, 100000)
+	vip.SetDefault(FeeSweepIntervalKey, 86400)
+	vip.SetDefault(FeeSweepFeeRateKey, 0)
 	vip.SetDefault(DataDirPathKey, defaultDataDir)
 	vip.SetDefault(PriceSlippageKey, 0.05)
+	vip.SetDefault(MaxSlippageBasisPointsKey, 0)
+	vip.SetDefault(MinConfirmationsKey, 1)
 	vip.SetDefault(EnableProfilerKey, false)
 	vip.SetDefault(StatsIntervalKey, 600)
 	vip.SetDefault(CrawlLimitKey, 10)
 	vip.SetDefault(CrawlTokenBurst, 1)
+	vip.SetDefault(WebhookMaxAttemptsKey, 5)
+	vip.SetDefault(AutoTopUpFeeAccountKey, false)
+	vip.SetDefault(BaseDerivationPathKey, wallet.DefaultBaseDerivationPath.String())
+	vip.SetDefault(DepositAddressTypeKey, wallet.NativeSegwitAddress)
+	vip.SetDefault(AddressesGapLimitKey, wallet.DefaultGapLimit)
+	vip.SetDefault(MaxProposalsPerMarketKey, 0)
+	vip.SetDefault(MaxProposalsPerPeerKey, 0)
+	vip.SetDefault(AssetRegistryCacheTTLKey, 3600)
+	vip.SetDefault(WithdrawalMinFeeRateKey, 100)
+	vip.SetDefault(WithdrawalClampFeeRateKey, false)
+	vip.SetDefault(DustThresholdKey, 0)
 
 	validate()
 
@@ -139,6 +351,9 @@ func GetBool(key string) bool {
 
 //GetNetwork ...
 func GetNetwork() *network.Network {
+	if customNetwork != nil {
+		return customNetwork
+	}
 	if vip.GetString(NetworkKey) == network.Regtest.Name {
 		return &network.Regtest
 	}
@@ -147,17 +362,208 @@ func GetNetwork() *network.Network {
 
 //GetExplorer ...
 func GetExplorer() (explorer.Service, error) {
+	svc, err := getExplorer()
+	if err != nil {
+		return nil, err
+	}
+	// retrying a broadcast, for example after a timeout, must not fail if the
+	// first attempt actually reached the network.
+	return explorer.NewIdempotentBroadcaster(
+		svc, explorer.DefaultBroadcastWindow,
+	), nil
+}
+
+func getExplorer() (explorer.Service, error) {
+	opts := getExplorerHTTPOptions()
+
 	if rpcEndpoint := GetString(ElementsRPCEndpointKey); rpcEndpoint != "" {
 		var rescanTime interface{}
 		if vip.IsSet(ElementsStartRescanTimestampKey) {
 			rescanTime = vip.GetInt(ElementsStartRescanTimestampKey)
 		}
-		return elements.NewService(rpcEndpoint, rescanTime)
+		return elements.NewService(rpcEndpoint, rescanTime, opts...)
 	}
 
-	endpoint := GetString(ExplorerEndpointKey)
+	endpoints := getExplorerEndpoints()
 	reqTimeout := GetInt(ExplorerRequestTimeoutKey)
-	return esplora.NewService(endpoint, reqTimeout)
+	if len(endpoints) == 1 {
+		return esplora.NewService(endpoints[0], reqTimeout, opts...)
+	}
+
+	services := make([]explorer.Service, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		svc, err := esplora.NewService(endpoint, reqTimeout, opts...)
+		if err != nil {
+			log.WithError(err).Warnf("skipping explorer endpoint %s", endpoint)
+			continue
+		}
+		services = append(services, svc)
+	}
+	return explorer.NewMultiExplorer(services, explorer.RetryPolicy{})
+}
+
+// GetCoinSelector returns the coin selector used when filling trade
+// proposals, or nil if no strategy is configured
+func GetCoinSelector() (wallet.CoinSelector, error) {
+	var selector wallet.CoinSelector
+	if strategy := GetString(CoinSelectionStrategyKey); strategy != "" {
+		var err error
+		if selector, err = wallet.NewCoinSelector(strategy); err != nil {
+			return nil, err
+		}
+	}
+	if maxInputs := GetInt(MaxTxInputsKey); maxInputs > 0 {
+		return wallet.NewMaxInputsCoinSelector(selector, maxInputs), nil
+	}
+	return selector, nil
+}
+
+// GetWebhook returns the webhook service used to notify trade status changes,
+// or nil if no endpoint is configured
+func GetWebhook() (webhook.Service, error) {
+	endpoint := GetString(WebhookEndpointKey)
+	if endpoint == "" {
+		return nil, nil
+	}
+	return webhook.NewService(webhook.Opts{
+		Endpoint:    endpoint,
+		Secret:      GetString(WebhookSecretKey),
+		MaxAttempts: GetInt(WebhookMaxAttemptsKey),
+	})
+}
+
+// GetFiatPriceSource returns the service used to value collected fees in
+// fiat, or nil if no endpoint is configured
+func GetFiatPriceSource() (fiatprice.Service, error) {
+	endpoint := GetString(FiatPriceEndpointKey)
+	if endpoint == "" {
+		return nil, nil
+	}
+	return fiatprice.NewService(fiatprice.Opts{
+		Endpoint: endpoint,
+		Currency: GetString(FiatCurrencyKey),
+	})
+}
+
+// GetAssetRegistry returns the service used to resolve the metadata of the
+// assets of the markets, or nil if no endpoint is configured
+func GetAssetRegistry() (assetregistry.Service, error) {
+	endpoint := GetString(AssetRegistryEndpointKey)
+	if endpoint == "" {
+		return nil, nil
+	}
+	return assetregistry.NewService(assetregistry.Opts{
+		Endpoint: endpoint,
+		CacheTTL: time.Duration(GetInt(AssetRegistryCacheTTLKey)) * time.Second,
+	})
+}
+
+// GetFaucet returns the faucet used for funding markets, or nil if no
+// endpoint is configured
+func GetFaucet() (explorer.Service, error) {
+	endpoint := GetString(FaucetEndpointKey)
+	if endpoint == "" {
+		return nil, nil
+	}
+	return esplora.NewService(endpoint, GetInt(ExplorerRequestTimeoutKey))
+}
+
+func getExplorerHTTPOptions() []explorer.HTTPOption {
+	opts := make([]explorer.HTTPOption, 0)
+	if proxy := GetString(ExplorerProxyKey); proxy != "" {
+		opts = append(opts, explorer.WithProxy(proxy))
+	}
+	if endpoint := GetString(ExplorerStreamEndpointKey); endpoint != "" {
+		opts = append(opts, explorer.WithActivityStream(endpoint))
+	}
+	if maxRetries := GetInt(ExplorerMaxRetriesKey); maxRetries > 0 {
+		opts = append(opts, explorer.WithRetries(
+			maxRetries,
+			time.Duration(GetInt(ExplorerRetryBackoffKey))*time.Millisecond,
+		))
+	}
+	return opts
+}
+
+// GetPeerFees returns the basis point fees of the traders that override those
+// of the markets, by name of their access token.
+func GetPeerFees() map[string]int64 {
+	fees, _ := parsePeerFees(GetString(PeerFeesKey))
+	return fees
+}
+
+// GetTraderAuthTokens returns the access tokens of the traders allowed to use
+// the trader interface, along with the name of the trader each one has been
+// issued to, if any. It's empty if the interface is public.
+func GetTraderAuthTokens() map[string]string {
+	tokens, _ := parseTraderAuthTokens(GetString(TraderAuthTokensKey))
+	return tokens
+}
+
+func parseTraderAuthTokens(str string) (map[string]string, error) {
+	tokens := make(map[string]string)
+	for _, entry := range strings.Split(str, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		var peer, token string
+		if i := strings.Index(entry, ":"); i >= 0 {
+			peer = strings.TrimSpace(entry[:i])
+			token = strings.TrimSpace(entry[i+1:])
+			if peer == "" {
+				return nil, fmt.Errorf("malformed entry %s", entry)
+			}
+		} else {
+			token = entry
+		}
+		if token == "" {
+			return nil, fmt.Errorf("missing token of peer %s", peer)
+		}
+		if _, ok := tokens[token]; ok {
+			return nil, fmt.Errorf("duplicated token of peer %s", peer)
+		}
+		tokens[token] = peer
+	}
+	return tokens, nil
+}
+
+func hasAuthToken(tokens map[string]string, peer string) bool {
+	for _, p := range tokens {
+		if p == peer {
+			return true
+		}
+	}
+	return false
+}
+
+func parsePeerFees(str string) (map[string]int64, error) {
+	fees := make(map[string]int64)
+	for _, entry := range strings.Split(str, ",") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		i := strings.LastIndex(entry, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("malformed entry %s", entry)
+		}
+		peer := strings.TrimSpace(entry[:i])
+		fee, err := strconv.ParseInt(strings.TrimSpace(entry[i+1:]), 10, 64)
+		if err != nil || fee < 0 || fee > 9999 {
+			return nil, fmt.Errorf("fee of peer %s must be in range [0, 9999]", peer)
+		}
+		fees[peer] = fee
+	}
+	return fees, nil
+}
+
+func getExplorerEndpoints() []string {
+	endpoints := make([]string, 0)
+	for _, endpoint := range strings.Split(GetString(ExplorerEndpointKey), ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
 }
 
 // Set a value for the given key
@@ -188,7 +594,16 @@ func validate() {
 	if err := validateDefaultFee(vip.GetFloat64(DefaultFeeKey)); err != nil {
 		log.WithError(err).Panic("default fee is not valid")
 	}
-	if err := validateDefaultNetwork(vip.GetString(NetworkKey)); err != nil {
+	if path := vip.GetString(NetworkParamsPathKey); path != "" {
+		net, err := loadNetworkParams(path)
+		if err != nil {
+			log.WithError(err).Panic("custom network params are not valid")
+		}
+		customNetwork = net
+		if vip.GetString(BaseAssetKey) == network.Liquid.AssetID {
+			vip.Set(BaseAssetKey, net.AssetID)
+		}
+	} else if err := validateDefaultNetwork(vip.GetString(NetworkKey)); err != nil {
 		log.WithError(err).Panic("default network is not valid")
 	}
 	certPath, keyPath := vip.GetString(SSLCertPathKey), vip.GetString(SSLKeyPathKey)
@@ -196,10 +611,18 @@ func validate() {
 		log.Fatalln("SSL requires both key and certificate when enabled")
 	}
 
+	httpConfig, err := explorer.NewHTTPConfig(getExplorerHTTPOptions()...)
+	if err != nil {
+		log.WithError(err).Panic("explorer proxy is not valid")
+	}
+
 	if rpcEndpoint := vip.GetString(ElementsRPCEndpointKey); rpcEndpoint != "" {
 		if err := validateEndpoint(rpcEndpoint); err != nil {
 			log.WithError(err).Panic("Elements RPC endpoint is not a valid url")
 		}
+		if err := httpConfig.ValidateEndpoint(rpcEndpoint); err != nil {
+			log.WithError(err).Panic("Elements RPC endpoint is not reachable")
+		}
 		// ElementsStartRescanTimestamp can assume the 0 value that means scanning
 		// the entire blockchain. This wil be used only in regtest mode
 		if vip.IsSet(ElementsStartRescanTimestampKey) {
@@ -211,15 +634,186 @@ func validate() {
 			}
 		}
 	} else {
-		if err := validateEndpoint(vip.GetString(ExplorerEndpointKey)); err != nil {
-			log.WithError(err).Panic("explorer endpoint is not a valid url")
+		endpoints := getExplorerEndpoints()
+		if len(endpoints) <= 0 {
+			log.Panic("at least one explorer endpoint is required")
+		}
+		for _, endpoint := range endpoints {
+			if err := validateEndpoint(endpoint); err != nil {
+				log.WithError(err).Panic("explorer endpoint is not a valid url")
+			}
+			if err := httpConfig.ValidateEndpoint(endpoint); err != nil {
+				log.WithError(err).Panic("explorer endpoint is not reachable")
+			}
 		}
 	}
+
+	if vip.GetString(WebhookEndpointKey) != "" {
+		if err := validateEndpoint(vip.GetString(WebhookEndpointKey)); err != nil {
+			log.WithError(err).Panic("webhook endpoint is not a valid url")
+		}
+		if vip.GetInt(WebhookMaxAttemptsKey) <= 0 {
+			log.Panic("webhook max attempts must be a positive number")
+		}
+	}
+
+	if vip.GetInt(ExplorerMaxRetriesKey) < 0 {
+		log.Panic("explorer max retries must not be a negative number")
+	}
+	if vip.GetInt(ExplorerRetryBackoffKey) < 0 {
+		log.Panic("explorer retry backoff must not be a negative number")
+	}
+
+	minExpiry, maxExpiry :=
+		vip.GetInt(MinTradeExpiryTimeKey), vip.GetInt(MaxTradeExpiryTimeKey)
+	if minExpiry <= 0 {
+		log.Panic("min trade expiry time must be a positive number")
+	}
+	if maxExpiry < minExpiry {
+		log.Panic("max trade expiry time must not be lower than min one")
+	}
+	if expiry := vip.GetInt(TradeExpiryTimeKey); expiry < minExpiry || expiry > maxExpiry {
+		log.Panicf(
+			"trade expiry time must be within %d and %d seconds", minExpiry, maxExpiry,
+		)
+	}
+
+	if bp := vip.GetInt(MaxSlippageBasisPointsKey); bp < 0 || bp >= 10000 {
+		log.Panic("max slippage basis points must be >= 0 and < 10000")
+	}
+	if vip.GetInt(MinConfirmationsKey) <= 0 {
+		log.Panic("min confirmations must be a positive number")
+	}
+
+	if vip.GetInt(WithdrawalMinFeeRateKey) <= 0 {
+		log.Panic("withdrawal min fee rate must be a positive number")
+	}
+
+	if vip.GetInt(MaxProposalsPerMarketKey) < 0 {
+		log.Panic("max proposals per market must not be a negative number")
+	}
+	if vip.GetInt(MaxProposalsPerPeerKey) < 0 {
+		log.Panic("max proposals per peer must not be a negative number")
+	}
+	if _, err := parsePeerFees(vip.GetString(PeerFeesKey)); err != nil {
+		log.WithError(err).Panic("invalid peer fees")
+	}
+	authTokens, err := parseTraderAuthTokens(vip.GetString(TraderAuthTokensKey))
+	if err != nil {
+		log.WithError(err).Panic("invalid trader auth tokens")
+	}
+	peerFees, _ := parsePeerFees(vip.GetString(PeerFeesKey))
+	for peer := range peerFees {
+		if !hasAuthToken(authTokens, peer) {
+			log.Panicf("peer %s with designated fee has no auth token", peer)
+		}
+	}
+
+	if vip.GetInt(DustThresholdKey) < 0 {
+		log.Panic("dust threshold must not be a negative number")
+	}
+
+	if endpoint := vip.GetString(FaucetEndpointKey); endpoint != "" {
+		if GetNetwork().Name == network.Liquid.Name {
+			log.Panic("faucet is not available on mainnet")
+		}
+		if err := validateEndpoint(endpoint); err != nil {
+			log.WithError(err).Panic("faucet endpoint is not a valid url")
+		}
+	}
+
+	if vip.GetInt(TradeReaperIntervalKey) <= 0 {
+		log.Panic("trade reaper interval must be a positive number")
+	}
+	if vip.GetInt(TradeExpiryGracePeriodKey) < 0 {
+		log.Panic("trade expiry grace period must not be a negative number")
+	}
+	if vip.GetInt(UnspentLockTTLKey) <= 0 {
+		log.Panic("unspent lock ttl must be a positive number")
+	}
+	if vip.GetInt(ShutdownTimeoutKey) <= 0 {
+		log.Panic("shutdown timeout must be a positive number")
+	}
+
+	if vip.GetInt(PriceBandBasisPointsKey) < 0 {
+		log.Panic("price band basis points must not be a negative number")
+	}
+	if vip.GetInt(PriceBandBasisPointsKey) > 0 &&
+		vip.GetString(FiatPriceEndpointKey) == "" {
+		log.Panic("price band requires a fiat price endpoint")
+	}
+
+	if vip.GetInt(PsetLogSizeKey) < 0 {
+		log.Panic("pset log size must not be a negative number")
+	}
+
+	if addr := vip.GetString(FeeSweepAddressKey); addr != "" {
+		if ok, err := address.IsConfidential(addr); err != nil || !ok {
+			log.Panic("fee sweep address must be a valid confidential address")
+		}
+		if vip.GetInt(FeeSweepThresholdKey) < vip.GetInt(FeeAccountBalanceThresholdKey) {
+			log.Panic(
+				"fee sweep threshold must not be lower than fee account balance threshold",
+			)
+		}
+		if vip.GetInt(FeeSweepIntervalKey) <= 0 {
+			log.Panic("fee sweep interval must be a positive number")
+		}
+		if vip.GetInt(FeeSweepFeeRateKey) < 0 {
+			log.Panic("fee sweep fee rate must not be a negative number")
+		}
+	}
+
+	if vip.GetInt(ReorgWatcherIntervalKey) <= 0 {
+		log.Panic("reorg watcher interval must be a positive number")
+	}
+	if vip.GetInt(ReorgDepthKey) <= 0 {
+		log.Panic("reorg depth must be a positive number")
+	}
+
+	if vip.GetString(FiatPriceEndpointKey) != "" {
+		if err := validateEndpoint(vip.GetString(FiatPriceEndpointKey)); err != nil {
+			log.WithError(err).Panic("fiat price endpoint is not a valid url")
+		}
+	}
+
+	if vip.GetString(AssetRegistryEndpointKey) != "" {
+		if err := validateEndpoint(vip.GetString(AssetRegistryEndpointKey)); err != nil {
+			log.WithError(err).Panic("asset registry endpoint is not a valid url")
+		}
+		if vip.GetInt(AssetRegistryCacheTTLKey) <= 0 {
+			log.Panic("asset registry cache ttl must be a positive number")
+		}
+	}
+
+	if strategy := vip.GetString(CoinSelectionStrategyKey); strategy != "" {
+		if _, err := wallet.NewCoinSelector(strategy); err != nil {
+			log.WithError(err).Panic("invalid coin selection strategy")
+		}
+	}
+	if vip.GetInt(MaxTxInputsKey) < 0 {
+		log.Panic("max tx inputs must not be a negative number")
+	}
+
+	if _, err := wallet.ParseDerivationPath(
+		vip.GetString(BaseDerivationPathKey),
+	); err != nil {
+		log.WithError(err).Panic("base derivation path is not valid")
+	}
+	if vip.GetInt(AddressesGapLimitKey) <= 0 {
+		log.Panic("addresses gap limit must be a positive number")
+	}
+	if !wallet.IsValidAddressType(vip.GetString(DepositAddressTypeKey)) {
+		log.Panicf(
+			"deposit address type must be either %s or %s",
+			wallet.NativeSegwitAddress, wallet.NestedSegwitAddress,
+		)
+	}
 }
 
 func validateDefaultFee(fee float64) error {
-	if fee < 0.01 || fee > 99 {
-		return errors.New("percentage of the fee on each swap must be > 0.01 and < 99")
+	if fee < 0 || fee > 99 {
+		return errors.New("percentage of the fee on each swap must be >= 0 and < 99")
 	}
 
 	return nil
@@ -236,6 +830,78 @@ func validateDefaultNetwork(net string) error {
 	return nil
 }
 
+// networkParams is the JSON format of the params of a custom Elements chain,
+// where the magics of the extended keys are hex encoded.
+type networkParams struct {
+	Name         string `json:"name"`
+	Bech32       string `json:"bech32"`
+	Blech32      string `json:"blech32"`
+	HDPublicKey  string `json:"hd_public_key"`
+	HDPrivateKey string `json:"hd_private_key"`
+	PubKeyHash   byte   `json:"pubkey_hash"`
+	ScriptHash   byte   `json:"script_hash"`
+	Wif          byte   `json:"wif"`
+	Confidential byte   `json:"confidential"`
+	AssetID      string `json:"asset_id"`
+}
+
+// loadNetworkParams returns the custom network defined in the JSON file at
+// the given path.
+func loadNetworkParams(path string) (*network.Network, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var params networkParams
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil, err
+	}
+
+	if params.Name == "" {
+		return nil, errors.New("name must not be empty")
+	}
+	if params.Name == network.Liquid.Name || params.Name == network.Regtest.Name {
+		return nil, fmt.Errorf("name %s is reserved", params.Name)
+	}
+	if params.Bech32 == "" || params.Blech32 == "" {
+		return nil, errors.New("bech32 and blech32 prefixes must not be empty")
+	}
+	if asset, err := hex.DecodeString(params.AssetID); err != nil || len(asset) != 32 {
+		return nil, errors.New("asset id must be a 32-byte hex string")
+	}
+	hdPublicKey, err := decodeKeyMagic(params.HDPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("hd public key: %w", err)
+	}
+	hdPrivateKey, err := decodeKeyMagic(params.HDPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("hd private key: %w", err)
+	}
+
+	return &network.Network{
+		Name:         params.Name,
+		Bech32:       params.Bech32,
+		Blech32:      params.Blech32,
+		HDPublicKey:  hdPublicKey,
+		HDPrivateKey: hdPrivateKey,
+		PubKeyHash:   params.PubKeyHash,
+		ScriptHash:   params.ScriptHash,
+		Wif:          params.Wif,
+		Confidential: params.Confidential,
+		AssetID:      params.AssetID,
+	}, nil
+}
+
+func decodeKeyMagic(str string) ([4]byte, error) {
+	var magic [4]byte
+	buf, err := hex.DecodeString(str)
+	if err != nil || len(buf) != len(magic) {
+		return magic, errors.New("magic must be a 4-byte hex string")
+	}
+	copy(magic[:], buf)
+	return magic, nil
+}
+
 // validatePath empirically checks if the given path is correct by creating
 // the folder if not existing. If path is null or invalid, an error is returned
 func validatePath(path string) error {
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"sort"
+	"sync"
+)
+
+// accountLocks serializes the coin selections made concurrently over the
+// same wallet accounts, so that an unspent is never selected by a swap
+// proposal before another has locked it. Accounts are few, therefore their
+// locks are never dropped.
+type accountLocks struct {
+	locks map[int]*sync.Mutex
+	lock  *sync.Mutex
+}
+
+func newAccountLocks() *accountLocks {
+	return &accountLocks{
+		locks: make(map[int]*sync.Mutex),
+		lock:  &sync.Mutex{},
+	}
+}
+
+// acquire blocks until the locks of all the given accounts are available and
+// returns the function to release them. Locks are always acquired in
+// ascending account order, so that callers sharing some of the accounts
+// can't deadlock.
+func (l *accountLocks) acquire(accountIndexes ...int) func() {
+	indexes := make([]int, 0, len(accountIndexes))
+	seen := make(map[int]bool, len(accountIndexes))
+	for _, i := range accountIndexes {
+		if !seen[i] {
+			seen[i] = true
+			indexes = append(indexes, i)
+		}
+	}
+	sort.Ints(indexes)
+
+	mus := make([]*sync.Mutex, 0, len(indexes))
+	l.lock.Lock()
+	for _, i := range indexes {
+		mu, ok := l.locks[i]
+		if !ok {
+			mu = &sync.Mutex{}
+			l.locks[i] = mu
+		}
+		mus = append(mus, mu)
+	}
+	l.lock.Unlock()
+
+	for _, mu := range mus {
+		mu.Lock()
+	}
+	return func() {
+		for i := len(mus) - 1; i >= 0; i-- {
+			mus[i].Unlock()
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package application
+
+import (
+	"context"
+	"math"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+// AccountXPub returns the extended public key of the wallet account with the
+// given index, for external watch-only tools to derive its addresses and
+// monitor the deposits without holding any spending key.
+func (o *operatorService) AccountXPub(
+	ctx context.Context,
+	accountIndex uint64,
+) (string, error) {
+	if accountIndex > math.MaxInt32 {
+		return "", domain.ErrVaultAccountNotFound
+	}
+
+	vault, err := o.repoManager.VaultRepository().GetOrCreateVault(ctx, nil, "", nil)
+	if err != nil {
+		return "", err
+	}
+	return vault.AccountXPub(int(accountIndex))
+}
+
+// MasterBlindingKey returns the master blinding key of the wallet, that
+// watch-only tools need in order to unblind the outputs of the addresses
+// derived from the extended public keys of the accounts.
+func (o *operatorService) MasterBlindingKey(ctx context.Context) (string, error) {
+	vault, err := o.repoManager.VaultRepository().GetOrCreateVault(ctx, nil, "", nil)
+	if err != nil {
+		return "", err
+	}
+	return vault.MasterBlindingKey()
+}
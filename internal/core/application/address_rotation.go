@@ -0,0 +1,64 @@
+package application
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+// RotateBlindingKey replaces the given address, if it has never received
+// funds, with a new one of the same account and returns it along with its
+// blinding key.
+// Blinding keys are derived from the output script of the addresses as
+// defined by SLIP77, so that the wallet can always be restored from its
+// mnemonic. Therefore, the blinding key of an address can't be changed and
+// rotating it means handing out the next address of the account, whose
+// script, and hence blinding key, is unrelated to that of the given one.
+// The rotated address is still watched, so nothing sent to it is lost.
+func (o *operatorService) RotateBlindingKey(
+	ctx context.Context,
+	address string,
+) (*AddressAndBlindingKey, error) {
+	var info *domain.AddressInfo
+	if _, err := o.repoManager.RunTransaction(
+		ctx,
+		false,
+		func(ctx context.Context) (interface{}, error) {
+			if err := o.repoManager.VaultRepository().UpdateVault(
+				ctx,
+				func(v *domain.Vault) (*domain.Vault, error) {
+					_, accountIndex, err := v.AccountByAddress(address)
+					if err != nil {
+						return nil, ErrAddressNotOwned
+					}
+
+					unspents, err := o.repoManager.UnspentRepository().
+						GetAllUnspentsForAddresses(ctx, []string{address})
+					if err != nil {
+						return nil, err
+					}
+					if len(unspents) > 0 {
+						return nil, ErrAddressAlreadyUsed
+					}
+
+					info, err = v.DeriveNextExternalAddressForAccount(accountIndex)
+					if err != nil {
+						return nil, err
+					}
+					return v, nil
+				},
+			); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &AddressAndBlindingKey{
+		Address:     info.Address,
+		BlindingKey: hex.EncodeToString(info.BlindingKey),
+	}, nil
+}
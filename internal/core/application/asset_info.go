@@ -0,0 +1,118 @@
+package application
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+// AssetInfo returns the ticker, name and precision of the given asset.
+// The metadata of L-BTC are known in advance, while those of any other asset
+// are resolved from the asset registry, if configured. Assets not found in the
+// registry are returned as unregistered rather than with an error.
+func (o *operatorService) AssetInfo(
+	ctx context.Context,
+	assetHash string,
+) (*AssetInfo, error) {
+	if err := validateAssetString(assetHash); err != nil {
+		return nil, err
+	}
+	return o.getAssetInfo(assetHash)
+}
+
+// resolveAssetInfo is the best-effort variant of AssetInfo used when listing
+// markets, where an unreachable registry must not prevent the listing.
+func (o *operatorService) resolveAssetInfo(assetHash string) *AssetInfo {
+	info, err := o.getAssetInfo(assetHash)
+	if err != nil {
+		if err != ErrAssetRegistryNotConfigured {
+			log.WithError(err).Debugf(
+				"unable to resolve metadata of asset %s", assetHash,
+			)
+		}
+		return nil
+	}
+	return info
+}
+
+func (o *operatorService) getAssetInfo(assetHash string) (*AssetInfo, error) {
+	if assetHash == o.network.AssetID {
+		return &AssetInfo{
+			AssetHash:  assetHash,
+			Ticker:     "L-BTC",
+			Name:       "Liquid Bitcoin",
+			Precision:  8,
+			Registered: true,
+		}, nil
+	}
+	if o.assetRegistrySvc == nil {
+		return nil, ErrAssetRegistryNotConfigured
+	}
+
+	metadata, err := o.assetRegistrySvc.AssetInfo(assetHash)
+	if err != nil {
+		return nil, err
+	}
+	info := AssetInfo(metadata)
+	return &info, nil
+}
+
+// resolveWithdrawalAmounts returns the given withdrawal with the balance to
+// withdraw in satoshi, converted with the precision of the assets if given in
+// display units.
+func (o *operatorService) resolveWithdrawalAmounts(
+	req WithdrawMarketReq,
+) (WithdrawMarketReq, error) {
+	if req.DisplayBalanceToWithdraw == nil {
+		return req, nil
+	}
+	if req.BalanceToWithdraw.BaseAmount > 0 ||
+		req.BalanceToWithdraw.QuoteAmount > 0 {
+		return req, ErrAmbiguousWithdrawal
+	}
+
+	baseAmount, err := o.toSatoshis(
+		req.BaseAsset, req.DisplayBalanceToWithdraw.BaseAmount,
+	)
+	if err != nil {
+		return req, err
+	}
+	quoteAmount, err := o.toSatoshis(
+		req.QuoteAsset, req.DisplayBalanceToWithdraw.QuoteAmount,
+	)
+	if err != nil {
+		return req, err
+	}
+
+	req.BalanceToWithdraw = Balance{
+		BaseAmount:  baseAmount,
+		QuoteAmount: quoteAmount,
+	}
+	req.DisplayBalanceToWithdraw = nil
+	return req, nil
+}
+
+// toSatoshis converts the given amount of asset from display units to
+// satoshi. The precision of the asset is resolved only for non-zero amounts.
+func (o *operatorService) toSatoshis(
+	assetHash string,
+	amount decimal.Decimal,
+) (uint64, error) {
+	if amount.IsZero() {
+		return 0, nil
+	}
+	if amount.IsNegative() {
+		return 0, ErrInvalidDisplayAmount
+	}
+
+	info, err := o.getAssetInfo(assetHash)
+	if err != nil {
+		return 0, err
+	}
+	satoshis := amount.Shift(int32(info.Precision))
+	if !satoshis.Equal(satoshis.Truncate(0)) {
+		return 0, ErrInvalidDisplayAmount
+	}
+	return uint64(satoshis.IntPart()), nil
+}
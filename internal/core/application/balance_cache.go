@@ -0,0 +1,244 @@
+package application
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+// WithBalanceCache returns the given RepoManager along with an in-memory cache
+// of the balances of the market accounts, so that they're not computed again
+// from the unspents at every price or balance request.
+// The balances of an account are invalidated whenever any of its unspents is
+// added, spent, locked, unlocked, confirmed or unconfirmed through the returned
+// RepoManager, therefore all services must be created with it for the cache to
+// be consistent.
+func WithBalanceCache(repoManager ports.RepoManager) ports.RepoManager {
+	return &balanceCachedRepoManager{
+		RepoManager: repoManager,
+		unspentRepository: &balanceCachedUnspentRepository{
+			UnspentRepository: repoManager.UnspentRepository(),
+			cache:             newBalanceCache(),
+		},
+	}
+}
+
+type balanceCachedRepoManager struct {
+	ports.RepoManager
+	unspentRepository *balanceCachedUnspentRepository
+}
+
+func (r *balanceCachedRepoManager) UnspentRepository() domain.UnspentRepository {
+	return r.unspentRepository
+}
+
+// getBalanceCache returns the balance cache of the given RepoManager, if it
+// has one.
+func getBalanceCache(repoManager ports.RepoManager) *balanceCache {
+	if r, ok := repoManager.(*balanceCachedRepoManager); ok {
+		return r.unspentRepository.cache
+	}
+	return nil
+}
+
+// balanceCachedUnspentRepository invalidates the cached balances of the
+// addresses of the unspents changed by any of its write operations.
+type balanceCachedUnspentRepository struct {
+	domain.UnspentRepository
+	cache *balanceCache
+}
+
+func (r *balanceCachedUnspentRepository) AddUnspents(
+	ctx context.Context,
+	unspents []domain.Unspent,
+) error {
+	defer func() {
+		addresses := make([]string, 0, len(unspents))
+		for _, u := range unspents {
+			addresses = append(addresses, u.Address)
+		}
+		r.cache.invalidate(addresses)
+	}()
+	return r.UnspentRepository.AddUnspents(ctx, unspents)
+}
+
+func (r *balanceCachedUnspentRepository) SpendUnspents(
+	ctx context.Context,
+	unspentKeys []domain.UnspentKey,
+) (int, error) {
+	defer r.invalidateKeys(ctx, unspentKeys)
+	return r.UnspentRepository.SpendUnspents(ctx, unspentKeys)
+}
+
+func (r *balanceCachedUnspentRepository) ConfirmUnspents(
+	ctx context.Context,
+	unspentKeys []domain.UnspentKey,
+	blockHeight uint64,
+	blockHash string,
+) (int, error) {
+	defer r.invalidateKeys(ctx, unspentKeys)
+	return r.UnspentRepository.ConfirmUnspents(
+		ctx, unspentKeys, blockHeight, blockHash,
+	)
+}
+
+func (r *balanceCachedUnspentRepository) UnconfirmUnspents(
+	ctx context.Context,
+	unspentKeys []domain.UnspentKey,
+) (int, error) {
+	defer r.invalidateKeys(ctx, unspentKeys)
+	return r.UnspentRepository.UnconfirmUnspents(ctx, unspentKeys)
+}
+
+func (r *balanceCachedUnspentRepository) LockUnspents(
+	ctx context.Context,
+	unspentKeys []domain.UnspentKey,
+	tradeID uuid.UUID,
+) (int, error) {
+	defer r.invalidateKeys(ctx, unspentKeys)
+	return r.UnspentRepository.LockUnspents(ctx, unspentKeys, tradeID)
+}
+
+func (r *balanceCachedUnspentRepository) UnlockUnspents(
+	ctx context.Context,
+	unspentKeys []domain.UnspentKey,
+) (int, error) {
+	defer r.invalidateKeys(ctx, unspentKeys)
+	return r.UnspentRepository.UnlockUnspents(ctx, unspentKeys)
+}
+
+// invalidateKeys invalidates the balances of the addresses of the unspents
+// with the given keys, or all of them if any can't be retrieved.
+func (r *balanceCachedUnspentRepository) invalidateKeys(
+	ctx context.Context,
+	unspentKeys []domain.UnspentKey,
+) {
+	addresses := make([]string, 0, len(unspentKeys))
+	for _, key := range unspentKeys {
+		u, err := r.UnspentRepository.GetUnspentWithKey(ctx, key)
+		if err != nil || u == nil {
+			r.cache.invalidateAll()
+			return
+		}
+		addresses = append(addresses, u.Address)
+	}
+	r.cache.invalidate(addresses)
+}
+
+type balanceCacheKey struct {
+	accountIndex int
+	withLocked   bool
+}
+
+type cachedBalances struct {
+	balances map[string]uint64
+	// addresses and tipHeight are those the balances are computed for. The
+	// balances are not valid anymore if any address is derived for the account
+	// or if the tip changes, since more unspents might be deep enough.
+	addresses map[string]bool
+	tipHeight uint64
+}
+
+// balanceCache holds the balances by asset of the market accounts. It's safe
+// for concurrent use.
+type balanceCache struct {
+	lock    *sync.RWMutex
+	entries map[balanceCacheKey]cachedBalances
+	// version is increased at every invalidation and prevents caching the
+	// balances computed from the unspents read before it.
+	version uint64
+}
+
+func newBalanceCache() *balanceCache {
+	return &balanceCache{
+		lock:    &sync.RWMutex{},
+		entries: make(map[balanceCacheKey]cachedBalances),
+	}
+}
+
+// get returns the cached balances for the given key, if they're still valid
+// for the given addresses and tip height, along with the current version of
+// the cache to use for caching them otherwise.
+func (c *balanceCache) get(
+	key balanceCacheKey,
+	addresses []string,
+	tipHeight uint64,
+) (map[string]uint64, uint64, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	entry, ok := c.entries[key]
+	if !ok || entry.tipHeight != tipHeight ||
+		len(entry.addresses) != len(addresses) {
+		return nil, c.version, false
+	}
+	for _, addr := range addresses {
+		if !entry.addresses[addr] {
+			return nil, c.version, false
+		}
+	}
+	return copyBalances(entry.balances), c.version, true
+}
+
+// set caches the given balances, unless the cache was invalidated since the
+// given version.
+func (c *balanceCache) set(
+	key balanceCacheKey,
+	version uint64,
+	addresses []string,
+	tipHeight uint64,
+	balances map[string]uint64,
+) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.version != version {
+		return
+	}
+
+	addressSet := make(map[string]bool, len(addresses))
+	for _, addr := range addresses {
+		addressSet[addr] = true
+	}
+	c.entries[key] = cachedBalances{
+		balances:  copyBalances(balances),
+		addresses: addressSet,
+		tipHeight: tipHeight,
+	}
+}
+
+// invalidate drops the balances of the accounts owning any of the given
+// addresses.
+func (c *balanceCache) invalidate(addresses []string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.version++
+	for key, entry := range c.entries {
+		for _, addr := range addresses {
+			if entry.addresses[addr] {
+				delete(c.entries, key)
+				break
+			}
+		}
+	}
+}
+
+func (c *balanceCache) invalidateAll() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.version++
+	c.entries = make(map[balanceCacheKey]cachedBalances)
+}
+
+func copyBalances(balances map[string]uint64) map[string]uint64 {
+	balancesCopy := make(map[string]uint64, len(balances))
+	for asset, amount := range balances {
+		balancesCopy[asset] = amount
+	}
+	return balancesCopy
+}
@@ -0,0 +1,13 @@
+package application
+
+// GetBlindingData reveals asset, amount and blinders of the inputs and
+// outputs of the given PSET that can be unblinded with the given keys, mapped
+// by script. The returned data are mapped by input and output index.
+// Since it doesn't access any account of the daemon, it can be used to verify
+// the blinders of the PSET of any proposed trade before signing it.
+func (o *operatorService) GetBlindingData(
+	psetBase64 string,
+	inKeys, outKeys map[string][]byte,
+) (in, out map[int]BlindingData, err error) {
+	return TransactionManager.ExtractBlindingData(psetBase64, inKeys, outKeys)
+}
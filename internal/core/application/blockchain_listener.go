@@ -5,12 +5,12 @@ import (
 	"sync"
 	"time"
 
-	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 	"github.com/tdex-network/tdex-daemon/pkg/crawler"
 	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+	"github.com/tdex-network/tdex-daemon/pkg/webhook"
 	"github.com/vulpemventures/go-elements/network"
 )
 
@@ -32,10 +32,13 @@ type blockchainListener struct {
 	crawlerSvc         crawler.Service
 	explorerSvc        explorer.Service
 	repoManager        ports.RepoManager
+	webhookSvc         webhook.Service
+	tradeFeed          *TradeFeed
 	started            bool
 	pendingObservables []crawler.Observable
 	marketBaseAsset    string
 	network            *network.Network
+	peerVolumes        *PeerVolumeCache
 
 	mutex *sync.RWMutex
 }
@@ -44,12 +47,18 @@ type blockchainListener struct {
 func NewBlockchainListener(
 	crawlerSvc crawler.Service,
 	repoManager ports.RepoManager,
+	webhookSvc webhook.Service,
+	tradeFeed *TradeFeed,
+	peerVolumes *PeerVolumeCache,
 	marketBaseAsset string,
 	net *network.Network,
 ) BlockchainListener {
 	return newBlockchainListener(
 		crawlerSvc,
 		repoManager,
+		webhookSvc,
+		tradeFeed,
+		peerVolumes,
 		marketBaseAsset,
 		net,
 	)
@@ -58,16 +67,22 @@ func NewBlockchainListener(
 func newBlockchainListener(
 	crawlerSvc crawler.Service,
 	repoManager ports.RepoManager,
+	webhookSvc webhook.Service,
+	tradeFeed *TradeFeed,
+	peerVolumes *PeerVolumeCache,
 	marketBaseAsset string,
 	net *network.Network,
 ) *blockchainListener {
 	return &blockchainListener{
 		crawlerSvc:         crawlerSvc,
 		repoManager:        repoManager,
+		webhookSvc:         webhookSvc,
+		tradeFeed:          tradeFeed,
 		mutex:              &sync.RWMutex{},
 		pendingObservables: make([]crawler.Observable, 0),
 		marketBaseAsset:    marketBaseAsset,
 		network:            net,
+		peerVolumes:        peerVolumes,
 	}
 }
 
@@ -162,12 +177,18 @@ func (b *blockchainListener) listenToEventChannel() {
 				log.Warnf("unable to find trade with id %s: %v", e.TxID, err)
 				break
 			}
+			if trade == nil {
+				log.Warnf("unable to find trade with txid %s", e.TxID)
+				break
+			}
 
-			if err := b.settleTrade(&trade.ID, e); err != nil {
+			if err := b.settleTrade(trade, e); err != nil {
 				log.Warnf("trying to settle trade with id %s: %v", trade.ID, err)
 				break
 			}
-			if err := b.confirmOrAddUnspents(e.TxHex, e.TxID, trade.MarketQuoteAsset); err != nil {
+			if err := b.confirmOrAddUnspents(
+				e.TxHex, e.TxID, trade.MarketQuoteAsset, e.BlockHeight, e.BlockHash,
+			); err != nil {
 				log.Warnf("trying to confirm or add unspents: %v", err)
 				break
 			}
@@ -190,26 +211,61 @@ func (b *blockchainListener) startPendingObservables() {
 	b.pendingObservables = nil
 }
 
-func (b *blockchainListener) settleTrade(tradeID *uuid.UUID, event crawler.TransactionEvent) error {
-	if err := b.repoManager.TradeRepository().UpdateTrade(
+func (b *blockchainListener) settleTrade(trade *domain.Trade, event crawler.TransactionEvent) error {
+	// a trade is settled even if its audit record can't be made.
+	audit, err := newTradeAudit(
+		context.Background(), b.repoManager, b.network, trade, event.TxHex,
+	)
+	if err != nil {
+		log.WithError(err).Warnf(
+			"unable to make audit record of trade with id %s", trade.ID,
+		)
+	}
+
+	var settledTrade *domain.Trade
+	// the fee collected with the trade is recorded in the same transaction
+	// that settles it, so that the ledger never misses nor duplicates a fee.
+	if _, err := b.repoManager.RunTransaction(
 		context.Background(),
-		tradeID,
-		func(t *domain.Trade) (*domain.Trade, error) {
-			mustAddTxHex := t.IsAccepted()
-			if _, err := t.Settle(uint64(event.BlockTime)); err != nil {
+		!readOnlyTx,
+		func(ctx context.Context) (interface{}, error) {
+			if err := b.repoManager.TradeRepository().UpdateTrade(
+				ctx,
+				&trade.ID,
+				func(t *domain.Trade) (*domain.Trade, error) {
+					mustAddTxHex := t.IsAccepted()
+					if _, err := t.Settle(uint64(event.BlockTime)); err != nil {
+						return nil, err
+					}
+					if mustAddTxHex {
+						t.TxHex = event.TxHex
+					}
+					t.Audit = audit
+					settledTrade = t
+
+					return t, nil
+				},
+			); err != nil {
 				return nil, err
 			}
-			if mustAddTxHex {
-				t.TxHex = event.TxHex
-			}
 
-			return t, nil
+			fee := collectedFeeFromTrade(settledTrade, b.marketBaseAsset)
+			if err := b.repoManager.FeeRepository().AddCollectedFee(
+				ctx, fee,
+			); err != nil {
+				return nil, err
+			}
+			return nil, nil
 		},
 	); err != nil {
 		return err
 	}
 
-	log.Infof("trade with id %s settled", tradeID)
+	log.Infof("trade with id %s settled", trade.ID)
+	b.peerVolumes.updateTrade(settledTrade, b.marketBaseAsset)
+	notifyTradeStatus(
+		b.webhookSvc, b.tradeFeed, settledTrade, b.marketBaseAsset, b.network.Name,
+	)
 	return nil
 }
 
@@ -217,6 +273,8 @@ func (b *blockchainListener) confirmOrAddUnspents(
 	txHex string,
 	txID string,
 	mktAsset string,
+	blockHeight uint64,
+	blockHash string,
 ) error {
 	ctx := context.Background()
 	_, accountIndex, err := b.repoManager.MarketRepository().GetMarketByAsset(ctx, mktAsset)
@@ -249,7 +307,9 @@ func (b *blockchainListener) confirmOrAddUnspents(
 		for i, u := range unspentsToAdd {
 			unspentKeys[i] = u.Key()
 		}
-		count, err := b.repoManager.UnspentRepository().ConfirmUnspents(ctx, unspentKeys)
+		count, err := b.repoManager.UnspentRepository().ConfirmUnspents(
+			ctx, unspentKeys, blockHeight, blockHash,
+		)
 		if err != nil {
 			return err
 		}
@@ -260,7 +320,7 @@ func (b *blockchainListener) confirmOrAddUnspents(
 	go func() {
 		// these unspents must be inserted already confirmed.
 		for i := range unspentsToAdd {
-			unspentsToAdd[i].Confirmed = true
+			unspentsToAdd[i].Confirm(blockHeight, blockHash)
 		}
 		addUnspentsAsync(b.repoManager.UnspentRepository(), unspentsToAdd)
 		spendUnspentsAsync(b.repoManager.UnspentRepository(), unspentsToSpend)
@@ -0,0 +1,31 @@
+package application
+
+import (
+	"context"
+	"time"
+)
+
+// BuildInfo describes the build of the running daemon. It's meant to be set
+// at startup with the values injected at link time.
+var BuildInfo = Build{
+	Version: "dev",
+	Commit:  "none",
+	Date:    "unknown",
+}
+
+// GetInfo returns the build and network of the daemon, along with the time it
+// was started at and the number of markets it holds.
+func (o *operatorService) GetInfo(ctx context.Context) (*DaemonInfo, error) {
+	markets, err := o.repoManager.MarketRepository().GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DaemonInfo{
+		Build:         BuildInfo,
+		Network:       o.network.Name,
+		StartTimeUnix: uint64(o.startTime.Unix()),
+		Uptime:        time.Since(o.startTime),
+		NumOfMarkets:  len(markets),
+	}, nil
+}
@@ -0,0 +1,68 @@
+package application
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+// GetDepositAddress derives count new external addresses of the account of
+// the given existing market and returns them along with their blinding keys.
+// Unlike DepositMarket, addresses are derived and persisted within the same
+// transaction, therefore concurrent calls never return the same address.
+func (o *operatorService) GetDepositAddress(
+	ctx context.Context,
+	market Market,
+	count int,
+) ([]AddressAndBlindingKey, error) {
+	if err := validateMarketRequest(market, o.marketBaseAsset); err != nil {
+		return nil, err
+	}
+	if count <= 0 {
+		count = 1
+	}
+
+	_, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if accountIndex < 0 {
+		return nil, ErrMarketNotExist
+	}
+
+	list := make([]AddressAndBlindingKey, 0, count)
+	if _, err := o.repoManager.RunTransaction(
+		ctx,
+		false,
+		func(ctx context.Context) (interface{}, error) {
+			list = list[:0]
+			if err := o.repoManager.VaultRepository().UpdateVault(
+				ctx,
+				func(v *domain.Vault) (*domain.Vault, error) {
+					for i := 0; i < count; i++ {
+						info, err := v.DeriveNextExternalAddressForAccount(accountIndex)
+						if err != nil {
+							return nil, err
+						}
+						list = append(list, AddressAndBlindingKey{
+							Address:     info.Address,
+							BlindingKey: hex.EncodeToString(info.BlindingKey),
+						})
+					}
+					return v, nil
+				},
+			); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -1,12 +1,33 @@
 package application
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/tdex-network/tdex-daemon/pkg/wallet"
+)
 
 var (
 	// ErrFeeAccountNotFunded ...
 	ErrFeeAccountNotFunded = errors.New("fee account not funded")
+	// ErrTooManyInputs is returned when covering the amount of a trade or a
+	// withdrawal requires more unspents than allowed.
+	ErrTooManyInputs = wallet.ErrTooManyInputs
+	// ErrPsetNotBalanced is returned if the amounts of the inputs of a
+	// transaction to be signed don't match those of the outputs plus fees.
+	ErrPsetNotBalanced = errors.New("pset inputs and outputs are not balanced")
+	// ErrFeeTopUpNotSupported is returned if the fee account can't pay for the
+	// network fees of a trade and the market can't either, because it doesn't
+	// get any L-BTC from the trade to convert its quote asset into.
+	ErrFeeTopUpNotSupported = errors.New("network fees can be paid by the market only out of the L-BTC it buys with the trade")
+	// ErrFeeTopUpTooLow is returned if the L-BTC the market buys with a trade
+	// doesn't cover the network fees of the trade.
+	ErrFeeTopUpTooLow = errors.New("L-BTC bought by the market doesn't cover the network fees")
 	// ErrUnknownStrategy ...
 	ErrUnknownStrategy = errors.New("strategy not supported")
+	// ErrInvalidMarketsConfig ...
+	ErrInvalidMarketsConfig = errors.New("invalid markets config")
+	// ErrInvalidStrategyParams ...
+	ErrInvalidStrategyParams = errors.New("params not supported by the strategy")
 	// ErrTxNotConfirmed ...
 	ErrTxNotConfirmed = errors.New("transaction not confirmed")
 	// ErrMarketNotExist ...
@@ -19,7 +40,138 @@ var (
 	ErrInvalidOutpoint = errors.New("outpoint refers to inexistent tx output")
 	// ErrInvalidOutpoints ...
 	ErrInvalidOutpoints = errors.New("all outpoints must be funded for the same account")
+	// ErrAddressNotConfidential ...
+	ErrAddressNotConfidential = errors.New("address must be confidential")
+	// ErrInvalidPage ...
+	ErrInvalidPage = errors.New("page number must not be negative and page size must be a positive number")
+	// ErrInvalidTimeRange ...
+	ErrInvalidTimeRange = errors.New("start time must not be greater than end time")
+	// ErrInvalidInterval ...
+	ErrInvalidInterval = errors.New("interval must be at least one second")
+	// ErrWithdrawBelowReserve ...
+	ErrWithdrawBelowReserve = errors.New("withdrawal would leave the market balance below the minimum reserve")
+	// ErrNoWithdrawals ...
+	ErrNoWithdrawals = errors.New("at least one withdrawal is required")
+	// ErrEmptyWithdrawal ...
+	ErrEmptyWithdrawal = errors.New("balance to withdraw must not be zero")
+	// ErrDuplicatedWithdrawal ...
+	ErrDuplicatedWithdrawal = errors.New("each market can be withdrawn only once per transaction")
+	// ErrMarketInsufficientBalance ...
+	ErrMarketInsufficientBalance = errors.New(
+		"market balance is not enough for the trade",
+	)
+	// ErrPriceOutOfBand ...
+	ErrPriceOutOfBand = errors.New("market price too far from reference price")
+	// ErrPriceBandUnavailable ...
+	ErrPriceBandUnavailable = errors.New(
+		"market price can't be checked against reference price",
+	)
+	// ErrMarketUtxosNotSelectable ...
+	ErrMarketUtxosNotSelectable = errors.New(
+		"market balance is enough for the trade, but not that of its currently " +
+			"selectable utxos, which might be locked by pending trades, excluded " +
+			"from coin selection or too fragmented to fit in a single transaction",
+	)
+	// ErrSwapRequestOutputNotFound ...
+	ErrSwapRequestOutputNotFound = errors.New(
+		"swap request transaction has no output paying the amount of asset to " +
+			"receive",
+	)
+	// ErrAmbiguousWithdrawal is returned when the balance to withdraw is given
+	// both in satoshi and in display units.
+	ErrAmbiguousWithdrawal = errors.New(
+		"balance to withdraw must be given either in satoshi or in display units",
+	)
+	// ErrInvalidDisplayAmount ...
+	ErrInvalidDisplayAmount = errors.New(
+		"display amount must not be negative nor have more decimal places " +
+			"than the asset precision",
+	)
+	// ErrWithdrawInsufficientBalance ...
+	ErrWithdrawInsufficientBalance = errors.New("market balance is not enough for the withdrawal")
+	// ErrWithdrawalNotFound ...
+	ErrWithdrawalNotFound = errors.New("withdrawal not found or already replaced")
+	// ErrWithdrawalConfirmed ...
+	ErrWithdrawalConfirmed = errors.New("withdrawal transaction is already confirmed")
+	// ErrFeeRateBelowFloor is returned when requesting a withdrawal with a
+	// fee rate lower than the configured floor, that would never confirm.
+	ErrFeeRateBelowFloor = errors.New("fee rate is below the min one accepted for withdrawals")
+	// ErrFeeRateNotIncreased ...
+	ErrFeeRateNotIncreased = errors.New("new fee rate must be greater than the one of the transaction to replace")
+	// ErrTooManySnapshots ...
+	ErrTooManySnapshots = errors.New("too many snapshots requested, either narrow the time range or increase the interval")
+	// ErrMissingBlindingKey ...
+	ErrMissingBlindingKey = errors.New("blinding key must not be empty")
+	// ErrAddressNotOwned ...
+	ErrAddressNotOwned = errors.New("address does not belong to any account of the wallet")
+	// ErrAddressAlreadyUsed ...
+	ErrAddressAlreadyUsed = errors.New("address has already received funds")
+	// ErrUtxoNotOwned ...
+	ErrUtxoNotOwned = errors.New("utxo does not belong to any address of the account")
+	// ErrUtxoAlreadyExists ...
+	ErrUtxoAlreadyExists = errors.New("utxo already exists")
+	// ErrUtxoNotFound ...
+	ErrUtxoNotFound = errors.New("utxo not found")
+	// ErrInvalidMaxInputs ...
+	ErrInvalidMaxInputs = errors.New("max number of inputs to consolidate must be at least 2")
+	// ErrNothingToConsolidate ...
+	ErrNothingToConsolidate = errors.New("fee account has not enough coins worth consolidating")
+	// ErrNothingToSweep ...
+	ErrNothingToSweep = errors.New("fee account balance is not above the sweep threshold")
+	// ErrInvalidReserveProof is returned when verifying a proof of reserves
+	// with an invalid signature or a key not controlling the proven unspent.
+	ErrInvalidReserveProof = errors.New("invalid proof of reserves")
+	// ErrInvalidTxid ...
+	ErrInvalidTxid = errors.New("txid must be a 32-byte hex string")
+	// ErrTradeNotFound ...
+	ErrTradeNotFound = errors.New("trade not found")
+	// ErrAssetNotInMarket is returned when quoting a trade for an asset that
+	// is neither the base nor the quote one of the market.
+	ErrAssetNotInMarket = errors.New(
+		"given asset must be either the base or the quote asset of the market",
+	)
+	// ErrInvalidTradeID ...
+	ErrInvalidTradeID = errors.New("trade id must be a valid uuid")
+	// ErrTradeNotAudited is returned when querying the audit record of a trade
+	// that is not settled yet, or that was settled before audits were recorded.
+	ErrTradeNotAudited = errors.New("trade has no audit record")
+	// ErrAssetRegistryNotConfigured is returned when resolving the metadata of
+	// an asset other than L-BTC without an asset registry.
+	ErrAssetRegistryNotConfigured = errors.New("asset registry is not configured")
+	// ErrFaucetNotAllowed is returned when funding a market with the faucet on
+	// mainnet.
+	ErrFaucetNotAllowed = errors.New("faucet is not available on mainnet")
+	// ErrFaucetNotConfigured ...
+	ErrFaucetNotConfigured = errors.New("faucet is not configured")
+	// ErrInvalidFaucetAmounts is returned when funding a market with the faucet
+	// without amounts, or a new market without both base and quote amounts.
+	ErrInvalidFaucetAmounts = errors.New(
+		"base and quote amounts must be greater than zero",
+	)
+	// ErrFaucetTimeout ...
+	ErrFaucetTimeout = errors.New("timeout while waiting for faucet unspents")
+	// ErrPsetLogDisabled is returned when querying the PSETs of a trade if
+	// they're not logged.
+	ErrPsetLogDisabled = errors.New("pset log is not enabled")
+	// ErrInvalidPsetLogSize ...
+	ErrInvalidPsetLogSize = errors.New(
+		"max number of trades of pset log must be greater than zero",
+	)
+	// ErrUnknownExportFormat ...
+	ErrUnknownExportFormat = errors.New("export format not supported")
+	// ErrStoreClosed ...
+	ErrStoreClosed = errors.New("domain store is closed")
+	// ErrExplorerUnreachable ...
+	ErrExplorerUnreachable = errors.New("explorer is unreachable")
 	// ErrServiceUnavailable is the error returned by the trade service in case of
 	// internal errors
 	ErrServiceUnavailable = errors.New("service is unavailable, try again later")
+	// ErrMixedMarketTrades is returned when simulating a strategy against
+	// trades of different markets.
+	ErrMixedMarketTrades = errors.New("trades to simulate must be of the same market")
+	// ErrSimulationBalanceMismatch is returned when the initial balance of a
+	// simulation can't afford the trades as they actually happened.
+	ErrSimulationBalanceMismatch = errors.New(
+		"initial balance is not enough for replaying the actual trades",
+	)
 )
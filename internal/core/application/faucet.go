@@ -0,0 +1,186 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+	"github.com/tdex-network/tdex-daemon/pkg/mathutil"
+	"github.com/vulpemventures/go-elements/network"
+)
+
+// Faucet sends coins of the given asset to an address. The amount is expressed
+// in BTC, ie. in units of 10^8 sats, like for the faucet endpoint of esplora.
+// If given to the operator service, it's used by FundMarket to request the
+// coins for funding a market. It's meant for regtest and testnet setups only.
+type Faucet interface {
+	Faucet(address string, amount float64, asset string) (txid string, err error)
+}
+
+const (
+	// faucetPollInterval is the interval between two lookups of the unspents
+	// sent by the faucet.
+	faucetPollInterval = 2 * time.Second
+	// faucetTimeout is the max time to wait for the unspents sent by the faucet
+	// to appear.
+	faucetTimeout = 2 * time.Minute
+)
+
+// FundMarket requests the given amounts of base and quote asset from the
+// faucet and sends them to freshly derived addresses of the market, which is
+// created if it doesn't exist yet. It returns once all the coins are added to
+// the utxo set of the market, therefore a new market becomes funded.
+func (o *operatorService) FundMarket(
+	ctx context.Context,
+	market Market,
+	amounts Balance,
+) error {
+	if o.network.Name == network.Liquid.Name {
+		return ErrFaucetNotAllowed
+	}
+	if o.faucet == nil {
+		return ErrFaucetNotConfigured
+	}
+	if err := validateMarketRequest(market, o.marketBaseAsset); err != nil {
+		return err
+	}
+	if amounts.BaseAmount == 0 && amounts.QuoteAmount == 0 {
+		return ErrInvalidFaucetAmounts
+	}
+
+	mkt, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx, market.QuoteAsset,
+	)
+	if err != nil {
+		return err
+	}
+	// a new market is funded only with both assets.
+	if mkt == nil {
+		if amounts.BaseAmount == 0 || amounts.QuoteAmount == 0 {
+			return ErrInvalidFaucetAmounts
+		}
+		_, latestAccountIndex, err := o.repoManager.MarketRepository().
+			GetLatestMarket(ctx)
+		if err != nil {
+			return err
+		}
+		accountIndex = latestAccountIndex + 1
+	}
+
+	assets := make([]string, 0, 2)
+	amountByAsset := make(map[string]uint64)
+	if amounts.BaseAmount > 0 {
+		assets = append(assets, market.BaseAsset)
+		amountByAsset[market.BaseAsset] = amounts.BaseAmount
+	}
+	if amounts.QuoteAmount > 0 {
+		assets = append(assets, market.QuoteAsset)
+		amountByAsset[market.QuoteAsset] = amounts.QuoteAmount
+	}
+
+	var info domain.AddressesInfo
+	if _, err := o.repoManager.RunTransaction(
+		ctx,
+		false,
+		func(ctx context.Context) (interface{}, error) {
+			if _, err := o.repoManager.MarketRepository().GetOrCreateMarket(
+				ctx,
+				&domain.Market{
+					AccountIndex: accountIndex,
+					Fee:          o.marketFee,
+				},
+			); err != nil {
+				return nil, err
+			}
+
+			if err := o.repoManager.VaultRepository().UpdateVault(
+				ctx,
+				func(v *domain.Vault) (*domain.Vault, error) {
+					for range assets {
+						i, err := v.DeriveNextExternalAddressForAccount(accountIndex)
+						if err != nil {
+							return nil, err
+						}
+						info = append(info, *i)
+					}
+					return v, nil
+				},
+			); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		},
+	); err != nil {
+		return err
+	}
+
+	txids := make(map[string]bool)
+	for i, asset := range assets {
+		// the faucet sends the native asset if none is specified.
+		faucetAsset := asset
+		if asset == o.network.AssetID {
+			faucetAsset = ""
+		}
+		txid, err := o.faucet.Faucet(
+			info[i].Address,
+			float64(amountByAsset[asset])/float64(mathutil.BigOne),
+			faucetAsset,
+		)
+		if err != nil {
+			return err
+		}
+		txids[txid] = true
+	}
+
+	unspents, err := o.waitForUnspents(ctx, info, txids)
+	if err != nil {
+		return err
+	}
+
+	if mkt == nil {
+		if err := o.fundMarket(accountIndex, unspents); err != nil {
+			return err
+		}
+		log.Infof("funded market with account %d", accountIndex)
+	}
+
+	go startObserveUnconfirmedUnspents(o.blockchainListener, unspents)
+	return addUnspents(o.repoManager.UnspentRepository(), unspents)
+}
+
+// waitForUnspents polls the explorer until the unspents of the given addresses
+// include an output of each of the given txs, and returns them.
+func (o *operatorService) waitForUnspents(
+	ctx context.Context,
+	info domain.AddressesInfo,
+	txids map[string]bool,
+) ([]domain.Unspent, error) {
+	timeout := time.After(faucetTimeout)
+	for {
+		unspents, err := fetchUnspents(
+			explorer.WithContext(ctx, o.explorerSvc), info,
+		)
+		if err != nil {
+			return nil, err
+		}
+		found := make(map[string]bool)
+		for _, u := range unspents {
+			if txids[u.TxID] {
+				found[u.TxID] = true
+			}
+		}
+		if len(found) == len(txids) {
+			return unspents, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timeout:
+			return nil, ErrFaucetTimeout
+		case <-time.After(faucetPollInterval):
+		}
+	}
+}
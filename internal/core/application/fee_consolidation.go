@@ -0,0 +1,277 @@
+package application
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/pkg/bufferutil"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+	"github.com/tdex-network/tdex-daemon/pkg/transactionutil"
+	"github.com/tdex-network/tdex-daemon/pkg/wallet"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/pset"
+	"github.com/vulpemventures/go-elements/transaction"
+)
+
+// minConsolidationInputs is the min number of coins that makes worth
+// spending them into a single one.
+const minConsolidationInputs = 2
+
+// ConsolidateFeeAccount sweeps up to maxInputs of the smallest LBTC coins of
+// the fee account into a single output to a new internal address of the same
+// account. Locked coins are never spent, as well as those whose value doesn't
+// cover the network fees for spending them at the given fee rate.
+// It returns the hash of the broadcasted transaction and the number of coins
+// consolidated.
+func (o *operatorService) ConsolidateFeeAccount(
+	ctx context.Context,
+	maxInputs int,
+	milliSatPerByte int64,
+) (string, int, error) {
+	if maxInputs < minConsolidationInputs {
+		return "", 0, ErrInvalidMaxInputs
+	}
+
+	feeRate := int(milliSatPerByte)
+	if feeRate < domain.MinMilliSatPerByte {
+		feeRate = domain.MinMilliSatPerByte
+	}
+
+	feeUnspents, err := o.getAllUnspentsForAccount(ctx, domain.FeeAccount)
+	if err != nil {
+		return "", 0, err
+	}
+
+	unspents := selectUnspentsToConsolidate(
+		feeUnspents, o.network.AssetID, maxInputs, feeRate,
+	)
+	if len(unspents) < minConsolidationInputs {
+		return "", 0, ErrNothingToConsolidate
+	}
+
+	var txHex string
+	if err := o.repoManager.VaultRepository().UpdateVault(
+		ctx,
+		func(v *domain.Vault) (*domain.Vault, error) {
+			mnemonic, err := v.GetMnemonicSafe()
+			if err != nil {
+				return nil, err
+			}
+			feeAccount, err := v.AccountByIndex(domain.FeeAccount)
+			if err != nil {
+				return nil, err
+			}
+			info, err := v.DeriveNextInternalAddressForAccount(domain.FeeAccount)
+			if err != nil {
+				return nil, err
+			}
+
+			_txHex, err := consolidateUnspents(consolidateUnspentsOpts{
+				mnemonic:           mnemonic,
+				walletOpts:         v.WalletOpts,
+				unspents:           unspents,
+				address:            info.Address,
+				changePath:         feeAccount.DerivationPathByScript[info.Script],
+				inputPathsByScript: feeAccount.DerivationPathByScript,
+				milliSatPerByte:    feeRate,
+				network:            o.network,
+				signer:             o.signer,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := o.explorerSvc.BroadcastTransaction(_txHex); err != nil {
+				return nil, err
+			}
+
+			txHex = _txHex
+			return v, nil
+		},
+	); err != nil {
+		return "", 0, err
+	}
+
+	go extractUnspentsFromTxAndUpdateUtxoSet(
+		o.repoManager.UnspentRepository(),
+		o.repoManager.VaultRepository(),
+		o.network,
+		txHex,
+		domain.FeeAccount,
+	)
+
+	tx, _ := transaction.NewTxFromHex(txHex)
+	return tx.TxHash().String(), len(unspents), nil
+}
+
+// selectUnspentsToConsolidate returns at most maxInputs of the given coins of
+// the given asset, from the smallest one, skipping those that are not worth
+// more than the cost of spending them.
+func selectUnspentsToConsolidate(
+	unspents []explorer.Utxo,
+	asset string,
+	maxInputs, milliSatPerByte int,
+) []explorer.Utxo {
+	inputCost := consolidationFee(1, milliSatPerByte) -
+		consolidationFee(0, milliSatPerByte)
+
+	selected := make([]explorer.Utxo, 0)
+	for _, u := range unspents {
+		if u.Asset() == asset && u.Value() > inputCost {
+			selected = append(selected, u)
+		}
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].Value() < selected[j].Value()
+	})
+
+	if len(selected) > maxInputs {
+		selected = selected[:maxInputs]
+	}
+	return selected
+}
+
+// consolidationFee estimates the network fee amount of a transaction spending
+// the given number of coins into a single output.
+func consolidationFee(numInputs, milliSatPerByte int) uint64 {
+	inScriptTypes := make([]int, 0, numInputs)
+	for i := 0; i < numInputs; i++ {
+		inScriptTypes = append(inScriptTypes, wallet.P2WPKH)
+	}
+	txSize := wallet.EstimateTxSize(
+		inScriptTypes, nil, nil, []int{wallet.P2WPKH}, nil,
+	)
+	return uint64(float64(txSize) * float64(milliSatPerByte) / 1000)
+}
+
+type consolidateUnspentsOpts struct {
+	mnemonic           []string
+	walletOpts         domain.WalletOptions
+	unspents           []explorer.Utxo
+	address            string
+	changePath         string
+	inputPathsByScript map[string]string
+	milliSatPerByte    int
+	network            *network.Network
+	signer             Signer
+}
+
+// consolidateUnspents returns a signed transaction spending all the given
+// coins into a single output to the given confidential address, net of
+// network fees.
+func consolidateUnspents(opts consolidateUnspentsOpts) (string, error) {
+	w, err := opts.walletOpts.NewWallet(opts.mnemonic)
+	if err != nil {
+		return "", err
+	}
+
+	totalAmount := uint64(0)
+	for _, u := range opts.unspents {
+		totalAmount += u.Value()
+	}
+	feeAmount := consolidationFee(len(opts.unspents), opts.milliSatPerByte)
+	if totalAmount <= feeAmount {
+		return "", ErrNothingToConsolidate
+	}
+
+	script, blindingKey, err := parseConfidentialAddress(opts.address, opts.network)
+	if err != nil {
+		return "", err
+	}
+	lbtcAsset := opts.network.AssetID
+	asset, _ := bufferutil.AssetHashToBytes(lbtcAsset)
+	value, _ := bufferutil.ValueToBytes(totalAmount)
+	output := transaction.NewTxOutput(asset, value, script)
+
+	newPset, err := w.CreateTx()
+	if err != nil {
+		return "", err
+	}
+
+	// the output is worth all the coins, so that no change is added to the
+	// transaction, and the network fees are subtracted from it afterwards.
+	updateResult, err := w.UpdateTx(wallet.UpdateTxOpts{
+		PsetBase64:         newPset,
+		Unspents:           opts.unspents,
+		Outputs:            []*transaction.TxOutput{output},
+		ChangePathsByAsset: map[string]string{lbtcAsset: opts.changePath},
+		MilliSatsPerBytes:  opts.milliSatPerByte,
+		Network:            opts.network,
+		CoinSelector:       spendAllSelector{},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	psetBase64, err := subtractFeeFromOutput(updateResult.PsetBase64, feeAmount)
+	if err != nil {
+		return "", err
+	}
+
+	blindedPset, err := w.BlindTransactionWithKeys(wallet.BlindTransactionWithKeysOpts{
+		PsetBase64:         psetBase64,
+		OutputBlindingKeys: [][]byte{blindingKey},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	blindedPlusFees, err := w.UpdateTx(wallet.UpdateTxOpts{
+		PsetBase64: blindedPset,
+		Outputs:    transactionutil.NewFeeOutput(feeAmount, opts.network),
+		Network:    opts.network,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signer := newSigner(opts.signer, w, opts.inputPathsByScript)
+	signedPset, err := signer.Sign(blindedPlusFees.PsetBase64)
+	if err != nil {
+		return "", err
+	}
+
+	txHex, _, err := wallet.FinalizeAndExtractTransaction(
+		wallet.FinalizeAndExtractTransactionOpts{
+			PsetBase64: signedPset,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return txHex, nil
+}
+
+// subtractFeeFromOutput returns the given unblinded pset with the value of
+// its first output reduced by the given fee amount.
+func subtractFeeFromOutput(psetBase64 string, feeAmount uint64) (string, error) {
+	ptx, err := pset.NewPsetFromBase64(psetBase64)
+	if err != nil {
+		return "", err
+	}
+	out := ptx.UnsignedTx.Outputs[0]
+	out.Value, _ = bufferutil.ValueToBytes(
+		bufferutil.ValueFromBytes(out.Value) - feeAmount,
+	)
+	return ptx.ToBase64()
+}
+
+// spendAllSelector is a wallet.CoinSelector that always selects all the given
+// coins, as long as they cover the target amount.
+type spendAllSelector struct{}
+
+func (spendAllSelector) Select(
+	utxos []explorer.Utxo,
+	target uint64,
+) ([]explorer.Utxo, uint64, error) {
+	total := uint64(0)
+	for _, u := range utxos {
+		total += u.Value()
+	}
+	if total < target {
+		return nil, 0, wallet.ErrInsufficientFunds
+	}
+	return utxos, total, nil
+}
@@ -0,0 +1,285 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/pkg/mathutil"
+)
+
+// collectedFeeFromTrade returns the fee ledger entry for the given settled
+// trade.
+func collectedFeeFromTrade(
+	trade *domain.Trade,
+	marketBaseAsset string,
+) domain.CollectedFee {
+	swapRequest := trade.SwapRequestMessage()
+	feeAsset := tradeFeeAsset(trade, swapRequest)
+
+	marketPrice := trade.MarketPrice.QuotePrice
+	fixedFee := uint64(trade.MarketFixedQuoteFee)
+	if feeAsset == marketBaseAsset {
+		marketPrice = trade.MarketPrice.BasePrice
+		fixedFee = uint64(trade.MarketFixedBaseFee)
+	}
+
+	isFeeOnAmountP := feeAsset == swapRequest.GetAssetP()
+	amount := swapRequest.GetAmountR()
+	if isFeeOnAmountP {
+		amount = swapRequest.GetAmountP()
+	}
+	basisPointAmount, fixedAmount := chargedFee(
+		amount, isFeeOnAmountP, uint64(trade.MarketFee), fixedFee,
+	)
+	baseVolume, quoteVolume := tradeVolume(swapRequest, marketBaseAsset)
+
+	return domain.CollectedFee{
+		TradeID:          trade.ID,
+		MarketQuoteAsset: trade.MarketQuoteAsset,
+		BasisPoint:       trade.MarketFee,
+		Asset:            feeAsset,
+		Amount:           basisPointAmount + fixedAmount,
+		BasisPointAmount: basisPointAmount,
+		FixedAmount:      fixedAmount,
+		BaseVolume:       baseVolume,
+		QuoteVolume:      quoteVolume,
+		MarketPrice:      marketPrice,
+		CollectionTime:   trade.SettlementTime,
+	}
+}
+
+// tradeFeeAsset returns the asset the fee of the given trade is charged on.
+// Trades proposed before it was recorded are considered to be charged on the
+// asset sent by the trader.
+func tradeFeeAsset(trade *domain.Trade, swapRequest domain.SwapRequest) string {
+	if trade.FeeAsset != "" {
+		return trade.FeeAsset
+	}
+	return swapRequest.GetAssetP()
+}
+
+// chargedFee returns the percentage and fixed fee charged on the given amount
+// of a trade. The market adds the fee on top of the amount to be sent by the
+// trader (amount P), or subtracts it from the one to be received (amount R),
+// depending on which one it computes from the other given by the trader.
+func chargedFee(
+	amount uint64,
+	isFeeOnAmountP bool,
+	basisPoint, fixedFee uint64,
+) (basisPointAmount, fixedAmount uint64) {
+	if !isFeeOnAmountP {
+		_, basisPointAmount = mathutil.PlusFee(amount+fixedFee, basisPoint)
+		return basisPointAmount, fixedFee
+	}
+
+	fixedAmount = fixedFee
+	if amount < fixedAmount {
+		fixedAmount = amount
+	}
+	_, basisPointAmount = mathutil.LessFee(amount-fixedAmount, basisPoint)
+	return basisPointAmount, fixedAmount
+}
+
+// tradeVolume returns the amounts of base and quote asset exchanged with the
+// given swap request.
+func tradeVolume(
+	swapRequest domain.SwapRequest,
+	marketBaseAsset string,
+) (baseVolume, quoteVolume uint64) {
+	if swapRequest.GetAssetP() == marketBaseAsset {
+		return swapRequest.GetAmountP(), swapRequest.GetAmountR()
+	}
+	return swapRequest.GetAmountR(), swapRequest.GetAmountP()
+}
+
+// TradeVolume returns the volume traded by the given market with the trades
+// settled between from and to, both included. The volume is read from the fee
+// ledger, that records the amounts exchanged by each trade when it settles.
+func (o *operatorService) TradeVolume(
+	ctx context.Context,
+	market Market,
+	from, to uint64,
+) (*VolumeReport, error) {
+	if err := validateMarketRequest(market, o.marketBaseAsset); err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, ErrInvalidTimeRange
+	}
+
+	m, _, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx, market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, ErrMarketNotExist
+	}
+
+	fees, err := o.repoManager.FeeRepository().GetCollectedFeesByMarketInTimeRange(
+		ctx, market.QuoteAsset, from, to,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &VolumeReport{TradeCount: len(fees)}
+	var legacyFees []domain.CollectedFee
+	for _, fee := range fees {
+		// entries recorded before volumes were tracked.
+		if fee.BaseVolume == 0 && fee.QuoteVolume == 0 {
+			legacyFees = append(legacyFees, fee)
+			continue
+		}
+		report.BaseVolume += fee.BaseVolume
+		report.QuoteVolume += fee.QuoteVolume
+	}
+	if len(legacyFees) <= 0 {
+		return report, nil
+	}
+
+	trades, err := o.repoManager.TradeRepository().GetCompletedTradesByMarket(
+		ctx, market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tradesByID := make(map[string]*domain.Trade)
+	for _, trade := range trades {
+		tradesByID[trade.ID.String()] = trade
+	}
+	for _, fee := range legacyFees {
+		trade, ok := tradesByID[fee.TradeID.String()]
+		if !ok {
+			continue
+		}
+		baseVolume, quoteVolume := tradeVolume(
+			trade.SwapRequestMessage(), o.marketBaseAsset,
+		)
+		report.BaseVolume += baseVolume
+		report.QuoteVolume += quoteVolume
+	}
+
+	return report, nil
+}
+
+// backfillFeeLedger adds to the fee ledger the fees of the settled trades of
+// the given market that are missing from it, like those settled before the
+// ledger was introduced, so that reports are never made of a partial ledger.
+func (o *operatorService) backfillFeeLedger(
+	ctx context.Context,
+	marketQuoteAsset string,
+) error {
+	trades, err := o.repoManager.TradeRepository().GetCompletedTradesByMarket(
+		ctx, marketQuoteAsset,
+	)
+	if err != nil {
+		return err
+	}
+	fees, err := o.repoManager.FeeRepository().GetCollectedFeesByMarket(
+		ctx, marketQuoteAsset,
+	)
+	if err != nil {
+		return err
+	}
+
+	recordedFees := make(map[string]struct{})
+	for _, fee := range fees {
+		recordedFees[fee.TradeID.String()] = struct{}{}
+	}
+
+	for _, trade := range trades {
+		if trade.Status.Code != domain.Settled {
+			continue
+		}
+		if _, ok := recordedFees[trade.ID.String()]; ok {
+			continue
+		}
+		if err := o.repoManager.FeeRepository().AddCollectedFee(
+			ctx, collectedFeeFromTrade(trade, o.marketBaseAsset),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *operatorService) VerifyFeeLedger(
+	ctx context.Context,
+	repair bool,
+) ([]FeeLedgerDiscrepancy, error) {
+	trades, err := o.repoManager.TradeRepository().GetAllTrades(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fees, err := o.repoManager.FeeRepository().GetAllCollectedFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recordedFees := make(map[string]domain.CollectedFee)
+	for _, fee := range fees {
+		recordedFees[fee.TradeID.String()] = fee
+	}
+
+	discrepancies := make([]FeeLedgerDiscrepancy, 0)
+	for _, trade := range trades {
+		if trade.Status.Code != domain.Settled {
+			continue
+		}
+
+		tradeID := trade.ID.String()
+		expectedFee := collectedFeeFromTrade(trade, o.marketBaseAsset)
+		recordedFee, ok := recordedFees[tradeID]
+		delete(recordedFees, tradeID)
+
+		if !ok {
+			discrepancy := FeeLedgerDiscrepancy{
+				TradeID:          tradeID,
+				MarketQuoteAsset: trade.MarketQuoteAsset,
+				Reason:           FeeMissingFromLedger,
+			}
+			if repair {
+				if err := o.repoManager.FeeRepository().AddCollectedFee(
+					ctx, expectedFee,
+				); err != nil {
+					return nil, err
+				}
+				discrepancy.Repaired = true
+			}
+			discrepancies = append(discrepancies, discrepancy)
+			continue
+		}
+
+		if recordedFee.MarketQuoteAsset != expectedFee.MarketQuoteAsset ||
+			recordedFee.Asset != expectedFee.Asset ||
+			recordedFee.Amount != expectedFee.Amount {
+			discrepancies = append(discrepancies, FeeLedgerDiscrepancy{
+				TradeID:          tradeID,
+				MarketQuoteAsset: trade.MarketQuoteAsset,
+				Reason: fmt.Sprintf(
+					"recorded fee of %d %s does not match the expected %d %s",
+					recordedFee.Amount, recordedFee.Asset,
+					expectedFee.Amount, expectedFee.Asset,
+				),
+			})
+		}
+	}
+
+	// fees left are those recorded for trades that are not settled or that do
+	// not exist at all.
+	for _, fee := range fees {
+		if _, ok := recordedFees[fee.TradeID.String()]; !ok {
+			continue
+		}
+		discrepancies = append(discrepancies, FeeLedgerDiscrepancy{
+			TradeID:          fee.TradeID.String(),
+			MarketQuoteAsset: fee.MarketQuoteAsset,
+			Reason:           FeeWithoutSettledTrade,
+		})
+	}
+
+	return discrepancies, nil
+}
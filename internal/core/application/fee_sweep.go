@@ -0,0 +1,162 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+	"github.com/vulpemventures/go-elements/transaction"
+)
+
+// FeeSweep defines the periodic sweep of the fee account, that sends the
+// L-BTC balance exceeding a threshold to a treasury or burn address.
+type FeeSweep struct {
+	// Address is the confidential address the swept funds are sent to.
+	Address string
+	// Threshold is the balance, in satoshi, left in the fee account.
+	Threshold uint64
+	// MilliSatPerByte is the fee rate of the sweep transactions, subject to
+	// the same limits of the withdrawals.
+	MilliSatPerByte int64
+}
+
+// StartFeeSweep sweeps the fee account at every interval, until ctx is done.
+func (o *operatorService) StartFeeSweep(
+	ctx context.Context,
+	interval time.Duration,
+	sweep FeeSweep,
+) {
+	ticker := time.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				txid, err := o.SweepFeeAccount(ctx, sweep)
+				if err != nil {
+					if err == ErrNothingToSweep {
+						log.Debug("fee account balance below sweep threshold")
+						continue
+					}
+					log.WithError(err).Warn("unable to sweep fee account")
+					continue
+				}
+				log.Infof("swept fee account with tx %s", txid)
+			}
+		}
+	}()
+}
+
+// SweepFeeAccount sends the L-BTC balance of the fee account exceeding the
+// threshold of the given sweep to its address. The transaction is made like
+// for market withdrawals: the coins selected for the sweep output, the
+// largest ones, receive the change back, while the remaining ones pay for the
+// network fees, so that the fee account is left with the threshold net of
+// fees. Locked coins are never spent.
+// It returns the hash of the broadcasted transaction.
+func (o *operatorService) SweepFeeAccount(
+	ctx context.Context,
+	sweep FeeSweep,
+) (string, error) {
+	milliSatPerByte, err := o.withdrawalFeeRate.apply(int(sweep.MilliSatPerByte))
+	if err != nil {
+		return "", err
+	}
+
+	unspents, err := o.getAllUnspentsForAccount(ctx, domain.FeeAccount)
+	if err != nil {
+		return "", err
+	}
+
+	lbtcAsset := o.network.AssetID
+	balance := uint64(0)
+	for _, u := range unspents {
+		if u.Asset() == lbtcAsset {
+			balance += u.Value()
+		}
+	}
+	if balance <= sweep.Threshold {
+		return "", ErrNothingToSweep
+	}
+	amount := balance - sweep.Threshold
+
+	outputs, outputsBlindingKeys, err := parseRequestOutputs([]TxOut{{
+		Asset:   lbtcAsset,
+		Value:   int64(amount),
+		Address: sweep.Address,
+	}}, o.network)
+	if err != nil {
+		return "", err
+	}
+
+	sweptUnspents, feeUnspents := splitUnspentsToSweep(unspents, lbtcAsset, amount)
+	if len(feeUnspents) <= 0 {
+		return "", fmt.Errorf(
+			"%w: no coins of the fee account left for paying network fees",
+			ErrWithdrawInsufficientBalance,
+		)
+	}
+
+	txHex, err := o.sendWithdrawal(
+		ctx,
+		[]withdrawalLeg{{
+			accountIndex:        domain.FeeAccount,
+			unspents:            sweptUnspents,
+			outputs:             outputs,
+			outputsBlindingKeys: outputsBlindingKeys,
+		}},
+		feeUnspents,
+		milliSatPerByte,
+		true,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	go extractUnspentsFromTxAndUpdateUtxoSet(
+		o.repoManager.UnspentRepository(),
+		o.repoManager.VaultRepository(),
+		o.network,
+		txHex,
+		domain.FeeAccount,
+	)
+
+	tx, _ := transaction.NewTxFromHex(txHex)
+	return tx.TxHash().String(), nil
+}
+
+// splitUnspentsToSweep returns the largest coins of the given asset that
+// cover the given amount, and all the other coins of the asset. The two sets
+// are disjoint so that the latter can pay for the network fees of the
+// transaction spending the former.
+func splitUnspentsToSweep(
+	unspents []explorer.Utxo,
+	asset string,
+	amount uint64,
+) (swept, rest []explorer.Utxo) {
+	coins := make([]explorer.Utxo, 0, len(unspents))
+	for _, u := range unspents {
+		if u.Asset() == asset {
+			coins = append(coins, u)
+		}
+	}
+	sort.SliceStable(coins, func(i, j int) bool {
+		return coins[i].Value() > coins[j].Value()
+	})
+
+	total := uint64(0)
+	for i, u := range coins {
+		if total >= amount {
+			return coins[:i], coins[i:]
+		}
+		total += u.Value()
+	}
+	return coins, nil
+}
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+// marketForPeer returns the given market as priced for the peer of the given
+// context. The fee designated for the peer, if any, takes precedence over
+// that of the tier reached by its volume. Like for fees by peer, the returned
+// market may be a copy that must not be persisted.
+func (t *tradeService) marketForPeer(
+	ctx context.Context,
+	mkt *domain.Market,
+) *domain.Market {
+	if m := t.peerFees.forMarket(ctx, mkt); m != mkt {
+		return m
+	}
+
+	// tiers are reached only by authenticated peers, since the volume of an
+	// anonymous one would be shared by all those with the same address.
+	peer := authenticatedPeerFromContext(ctx)
+	if peer == "" || len(mkt.FeeTiers) <= 0 {
+		return mkt
+	}
+
+	volume, err := t.peerVolume(ctx, mkt, peer)
+	if err != nil {
+		log.WithError(err).Warnf(
+			"unable to compute volume of peer %s, charging market fee", peer,
+		)
+		return mkt
+	}
+	fee, ok := mkt.FeeTierForVolume(volume)
+	if !ok {
+		return mkt
+	}
+
+	m := *mkt
+	m.Fee = fee
+	m.FeeHistory = nil
+	return &m
+}
+
+// peerVolume returns the amount of base asset exchanged by the given
+// authenticated peer with the trades settled with the given market.
+func (t *tradeService) peerVolume(
+	ctx context.Context,
+	mkt *domain.Market,
+	peer string,
+) (uint64, error) {
+	return t.peerVolumes.volume(ctx, t.repoManager, mkt, peer)
+}
@@ -0,0 +1,57 @@
+package application
+
+import (
+	"sync"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+// fillAddresses are the output and change addresses of a market account,
+// and the change address of the fee account, used to fill a swap proposal.
+type fillAddresses struct {
+	out       *domain.AddressInfo
+	change    *domain.AddressInfo
+	feeChange *domain.AddressInfo
+}
+
+// fillAddressPool keeps the addresses derived for the fills of those swap
+// proposals that have been rejected, so that they're handed to the next fills
+// of the same market rather than being left unused. The pool is not
+// persisted, therefore those still unused when the daemon stops leave a gap
+// in the derivation indexes of the accounts.
+type fillAddressPool struct {
+	addresses map[int][]fillAddresses
+	lock      *sync.Mutex
+}
+
+func newFillAddressPool() *fillAddressPool {
+	return &fillAddressPool{
+		addresses: make(map[int][]fillAddresses),
+		lock:      &sync.Mutex{},
+	}
+}
+
+// get removes and returns the addresses least recently put back for the
+// given market account, if any.
+func (p *fillAddressPool) get(marketAccountIndex int) (fillAddresses, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	unused := p.addresses[marketAccountIndex]
+	if len(unused) <= 0 {
+		return fillAddresses{}, false
+	}
+	p.addresses[marketAccountIndex] = unused[1:]
+	return unused[0], true
+}
+
+// put gives back the addresses of a rejected fill of the given market
+// account, to be reused by the next ones.
+func (p *fillAddressPool) put(marketAccountIndex int, addresses fillAddresses) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.addresses[marketAccountIndex] = append(
+		p.addresses[marketAccountIndex], addresses,
+	)
+}
@@ -0,0 +1,64 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+)
+
+// HealthService tells whether the daemon is ready to serve trades.
+type HealthService interface {
+	// CheckReadiness returns nil if the daemon can serve trades, otherwise the
+	// reason why it can't: the domain store is closed, the wallet is not yet
+	// initialized or unlocked, or the explorer is unreachable.
+	CheckReadiness(ctx context.Context) error
+}
+
+type healthService struct {
+	repoManager     ports.RepoManager
+	explorerService explorer.Service
+}
+
+// NewHealthService returns a new HealthService instance
+func NewHealthService(
+	repoManager ports.RepoManager,
+	explorerSvc explorer.Service,
+) HealthService {
+	return &healthService{
+		repoManager:     repoManager,
+		explorerService: explorerSvc,
+	}
+}
+
+func (h *healthService) CheckReadiness(ctx context.Context) error {
+	if h.repoManager.IsClosed() {
+		return ErrStoreClosed
+	}
+
+	vault, err := h.repoManager.RunTransaction(
+		ctx,
+		true,
+		func(ctx context.Context) (interface{}, error) {
+			return h.repoManager.VaultRepository().GetOrCreateVault(
+				ctx, nil, "", nil,
+			)
+		},
+	)
+	if err != nil {
+		if err == domain.ErrVaultNullMnemonicOrPassphrase {
+			return ErrWalletNotInitialized
+		}
+		return err
+	}
+	if vault.(*domain.Vault).IsLocked() {
+		return domain.ErrVaultMustBeUnlocked
+	}
+
+	if _, err := h.explorerService.GetBlockHeight(); err != nil {
+		return fmt.Errorf("%w: %s", ErrExplorerUnreachable, err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package application_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/application"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+func TestCheckReadiness(t *testing.T) {
+	repoManager, explorerSvc, _ := newServices()
+	healthSvc := application.NewHealthService(repoManager, explorerSvc)
+
+	t.Run("wallet not initialized", func(t *testing.T) {
+		err := healthSvc.CheckReadiness(ctx)
+		require.EqualError(t, err, application.ErrWalletNotInitialized.Error())
+	})
+
+	v, err := repoManager.VaultRepository().GetOrCreateVault(
+		ctx, mnemonic, passphrase, regtest,
+	)
+	require.NoError(t, err)
+
+	t.Run("wallet locked", func(t *testing.T) {
+		v.Lock()
+		defer v.Unlock(passphrase)
+
+		err := healthSvc.CheckReadiness(ctx)
+		require.EqualError(t, err, domain.ErrVaultMustBeUnlocked.Error())
+	})
+
+	t.Run("explorer unreachable", func(t *testing.T) {
+		explorerSvc.(*mockExplorer).On("GetBlockHeight").
+			Return(nil, errors.New("connection refused")).Once()
+
+		err := healthSvc.CheckReadiness(ctx)
+		require.True(t, errors.Is(err, application.ErrExplorerUnreachable))
+	})
+
+	t.Run("ready", func(t *testing.T) {
+		explorerSvc.(*mockExplorer).On("GetBlockHeight").Return(100, nil).Once()
+
+		err := healthSvc.CheckReadiness(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("store closed", func(t *testing.T) {
+		repoManager.Close()
+
+		err := healthSvc.CheckReadiness(ctx)
+		require.EqualError(t, err, application.ErrStoreClosed.Error())
+	})
+}
@@ -0,0 +1,91 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+func (t *tradeService) StartLockReaper(
+	ctx context.Context,
+	interval, ttl time.Duration,
+) {
+	ticker := time.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+
+		t.releaseOrphanedLocks(ttl)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				t.releaseOrphanedLocks(ttl)
+			}
+		}
+	}()
+}
+
+// releaseOrphanedLocks unlocks the unspents locked since longer than the given
+// TTL that are not reserved by any pending trade. These are left behind if the
+// daemon stops between locking the unspents of a trade and persisting it, or
+// if unlocking them failed once the trade came to an end.
+func (t *tradeService) releaseOrphanedLocks(ttl time.Duration) {
+	ctx := context.Background()
+	now := time.Now()
+
+	unspents := t.repoManager.UnspentRepository().GetAllUnspents(ctx)
+	expiredLocks := make([]domain.Unspent, 0)
+	for _, u := range unspents {
+		if !u.IsSpent() && u.IsLockExpired(now, ttl) {
+			expiredLocks = append(expiredLocks, u)
+		}
+	}
+	if len(expiredLocks) <= 0 {
+		return
+	}
+
+	trades, err := t.repoManager.TradeRepository().GetAllTrades(ctx)
+	if err != nil {
+		log.WithError(err).Warn("unable to fetch trades to release locks")
+		return
+	}
+	pendingTrades := make(map[string]bool)
+	for _, trade := range trades {
+		if isTradeHoldingLocks(trade) {
+			pendingTrades[trade.ID.String()] = true
+		}
+	}
+
+	orphanedKeys := make([]domain.UnspentKey, 0, len(expiredLocks))
+	for _, u := range expiredLocks {
+		if u.LockedBy != nil && pendingTrades[u.LockedBy.String()] {
+			continue
+		}
+		orphanedKeys = append(orphanedKeys, u.Key())
+	}
+	if len(orphanedKeys) <= 0 {
+		return
+	}
+
+	count, err := t.repoManager.UnspentRepository().UnlockUnspents(
+		ctx, orphanedKeys,
+	)
+	if err != nil {
+		log.WithError(err).Warn("unable to release orphaned locks")
+		return
+	}
+
+	log.Infof("released %d orphaned locks", count)
+}
+
+// isTradeHoldingLocks returns whether the unspents locked by the given trade
+// are still reserved for it. Those of accepted trades are unlocked by the
+// trade reaper once expired, even if failed to complete, while those of
+// completed ones are spent once settled.
+func isTradeHoldingLocks(trade *domain.Trade) bool {
+	return trade.IsAccepted() || (trade.IsCompleted() && !trade.IsRejected())
+}
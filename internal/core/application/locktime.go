@@ -0,0 +1,52 @@
+package application
+
+import (
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+	"github.com/vulpemventures/go-elements/pset"
+)
+
+// lockTimeSequence is the max sequence number that an input can have for the
+// locktime of its transaction to be enforced.
+const lockTimeSequence = 0xfffffffe
+
+// antiFeeSnipingLockTime returns the current block height, that the
+// transactions of the daemon are locked to if anti fee sniping is enabled, or
+// zero otherwise. Locking the swaps and withdrawals of the daemon to the
+// current tip makes them be mined only on top of it, discouraging miners from
+// reorganizing the chain for their fees.
+func antiFeeSnipingLockTime(
+	enabled bool,
+	explorerSvc explorer.Service,
+) (uint32, error) {
+	if !enabled {
+		return 0, nil
+	}
+	height, err := explorerSvc.GetBlockHeight()
+	if err != nil {
+		return 0, err
+	}
+	return uint32(height), nil
+}
+
+// setLockTime sets the locktime of the given unsigned partial transaction and
+// lowers the sequence of its final inputs, starting from firstInput, so that
+// it's enforced. Inputs signaling replaceability are left untouched since
+// their sequence already enables the locktime, and so are those preceding
+// firstInput, like the ones of the trader in a swap.
+func setLockTime(
+	psetBase64 string,
+	lockTime uint32,
+	firstInput int,
+) (string, error) {
+	ptx, err := pset.NewPsetFromBase64(psetBase64)
+	if err != nil {
+		return "", err
+	}
+	ptx.UnsignedTx.Locktime = lockTime
+	for _, in := range ptx.UnsignedTx.Inputs[firstInput:] {
+		if in.Sequence > lockTimeSequence {
+			in.Sequence = lockTimeSequence
+		}
+	}
+	return ptx.ToBase64()
+}
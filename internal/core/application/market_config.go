@@ -0,0 +1,247 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+const marketsConfigVersion = 1
+
+// MarketsConfig is the portable configuration of the markets of a daemon, as
+// exported by ExportMarketsConfig. It doesn't include anything about funds.
+type MarketsConfig struct {
+	Version int            `json:"version"`
+	Markets []MarketConfig `json:"markets"`
+}
+
+// MarketConfig is the configuration of a single market. The account index is
+// kept so that a daemon restored from the same mnemonic finds the funds of the
+// market under the same account. Price is set only for pluggable markets.
+type MarketConfig struct {
+	AccountIndex  int                 `json:"account_index"`
+	BaseAsset     string              `json:"base_asset"`
+	QuoteAsset    string              `json:"quote_asset"`
+	BasisPoint    int64               `json:"basis_point"`
+	FixedBaseFee  int64               `json:"fixed_base_fee"`
+	FixedQuoteFee int64               `json:"fixed_quote_fee"`
+	StrategyType  domain.StrategyType `json:"strategy_type"`
+	Tradable      bool                `json:"tradable"`
+	Price         *MarketConfigPrice  `json:"price,omitempty"`
+}
+
+// MarketConfigPrice is the price of a pluggable market.
+type MarketConfigPrice struct {
+	BasePrice  decimal.Decimal `json:"base_price"`
+	QuotePrice decimal.Decimal `json:"quote_price"`
+}
+
+func (c MarketConfig) validate(marketBaseAsset string) error {
+	if c.AccountIndex < domain.MarketAccountStart {
+		return fmt.Errorf("account index must be at least %d", domain.MarketAccountStart)
+	}
+	if err := validateMarketRequest(Market{
+		BaseAsset:  c.BaseAsset,
+		QuoteAsset: c.QuoteAsset,
+	}, marketBaseAsset); err != nil {
+		return err
+	}
+	m, err := domain.NewMarket(c.AccountIndex, c.BasisPoint)
+	if err != nil {
+		return err
+	}
+	m.BaseAsset, m.QuoteAsset = c.BaseAsset, c.QuoteAsset
+	if err := m.ChangeFixedFee(c.FixedBaseFee, c.FixedQuoteFee); err != nil {
+		return err
+	}
+
+	switch c.StrategyType {
+	case domain.StrategyTypePluggable:
+		// a closed pluggable market can be left without price.
+		if c.Price == nil {
+			if c.Tradable {
+				return domain.ErrMarketNotPriced
+			}
+			return nil
+		}
+		if err := validateAmount(c.Price.BasePrice); err != nil {
+			return domain.ErrMarketInvalidBasePrice
+		}
+		if err := validateAmount(c.Price.QuotePrice); err != nil {
+			return domain.ErrMarketInvalidQuotePrice
+		}
+	case domain.StrategyTypeBalanced:
+		if c.Price != nil {
+			return ErrInvalidStrategyParams
+		}
+	default:
+		return ErrUnknownStrategy
+	}
+	return nil
+}
+
+// ExportMarketsConfig returns the JSON configuration of all the funded
+// markets, that is those whose assets are known, to be imported into another
+// daemon with ImportMarketsConfig.
+func (o *operatorService) ExportMarketsConfig(
+	ctx context.Context,
+) ([]byte, error) {
+	markets, err := o.repoManager.MarketRepository().GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	config := MarketsConfig{
+		Version: marketsConfigVersion,
+		Markets: make([]MarketConfig, 0, len(markets)),
+	}
+	for _, m := range markets {
+		if !m.IsFunded() {
+			continue
+		}
+		mktConfig := MarketConfig{
+			AccountIndex:  m.AccountIndex,
+			BaseAsset:     m.BaseAsset,
+			QuoteAsset:    m.QuoteAsset,
+			BasisPoint:    m.ActiveFee(),
+			FixedBaseFee:  m.FixedFee.BaseFee,
+			FixedQuoteFee: m.FixedFee.QuoteFee,
+			StrategyType:  domain.StrategyType(m.Strategy.Type),
+			Tradable:      m.IsTradable(),
+		}
+		if m.IsStrategyPluggable() && m.IsStrategyPluggableInitialized() {
+			mktConfig.Price = &MarketConfigPrice{
+				BasePrice:  m.BaseAssetPrice(),
+				QuotePrice: m.QuoteAssetPrice(),
+			}
+		}
+		config.Markets = append(config.Markets, mktConfig)
+	}
+
+	return json.MarshalIndent(config, "", "  ")
+}
+
+// ImportMarketsConfig creates the markets of the given JSON configuration, as
+// returned by ExportMarketsConfig, and returns those created. Markets whose
+// account or quote asset is already in use are skipped. The whole config is
+// validated before creating any market.
+func (o *operatorService) ImportMarketsConfig(
+	ctx context.Context,
+	data []byte,
+) ([]Market, error) {
+	var config MarketsConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMarketsConfig, err)
+	}
+	if config.Version != marketsConfigVersion {
+		return nil, fmt.Errorf(
+			"%w: unsupported version %d", ErrInvalidMarketsConfig, config.Version,
+		)
+	}
+
+	accounts := make(map[int]bool)
+	quoteAssets := make(map[string]bool)
+	for i, c := range config.Markets {
+		if err := c.validate(o.marketBaseAsset); err != nil {
+			return nil, fmt.Errorf(
+				"%w: market %d: %v", ErrInvalidMarketsConfig, i, err,
+			)
+		}
+		if accounts[c.AccountIndex] || quoteAssets[c.QuoteAsset] {
+			return nil, fmt.Errorf(
+				"%w: market %d: duplicated account index or quote asset",
+				ErrInvalidMarketsConfig, i,
+			)
+		}
+		accounts[c.AccountIndex] = true
+		quoteAssets[c.QuoteAsset] = true
+	}
+
+	marketRepo := o.repoManager.MarketRepository()
+	created := make([]Market, 0, len(config.Markets))
+	for _, c := range config.Markets {
+		m, err := marketRepo.GetMarketByAccount(ctx, c.AccountIndex)
+		if err != nil {
+			return created, err
+		}
+		_, accountIndex, err := marketRepo.GetMarketByAsset(ctx, c.QuoteAsset)
+		if err != nil {
+			return created, err
+		}
+		if m != nil || accountIndex >= 0 {
+			log.Infof(
+				"skipped import of existing market with quote asset %s",
+				c.QuoteAsset,
+			)
+			continue
+		}
+
+		if err := o.importMarket(ctx, c); err != nil {
+			return created, fmt.Errorf(
+				"unable to import market with quote asset %s: %w", c.QuoteAsset, err,
+			)
+		}
+		log.Infof("imported market with quote asset %s", c.QuoteAsset)
+		created = append(created, Market{
+			BaseAsset:  c.BaseAsset,
+			QuoteAsset: c.QuoteAsset,
+		})
+	}
+
+	return created, nil
+}
+
+// importMarket creates the market of the given config. Prices are stored
+// once the market has its assets and, only then, it's opened if required.
+func (o *operatorService) importMarket(
+	ctx context.Context,
+	config MarketConfig,
+) error {
+	marketRepo := o.repoManager.MarketRepository()
+	if _, err := marketRepo.GetOrCreateMarket(ctx, &domain.Market{
+		AccountIndex: config.AccountIndex,
+		Fee:          config.BasisPoint,
+	}); err != nil {
+		return err
+	}
+
+	if err := marketRepo.UpdateMarket(
+		ctx,
+		config.AccountIndex,
+		func(m *domain.Market) (*domain.Market, error) {
+			m.BaseAsset = config.BaseAsset
+			m.QuoteAsset = config.QuoteAsset
+			if err := m.ChangeFixedFee(
+				config.FixedBaseFee, config.FixedQuoteFee,
+			); err != nil {
+				return nil, err
+			}
+			if config.StrategyType == domain.StrategyTypePluggable {
+				if err := m.MakeStrategyPluggable(); err != nil {
+					return nil, err
+				}
+			}
+			return m, nil
+		},
+	); err != nil {
+		return err
+	}
+
+	if config.Price != nil {
+		if err := marketRepo.UpdatePrices(ctx, config.AccountIndex, domain.Prices{
+			BasePrice:  config.Price.BasePrice,
+			QuotePrice: config.Price.QuotePrice,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if config.Tradable {
+		return marketRepo.OpenMarket(ctx, config.QuoteAsset)
+	}
+	return nil
+}
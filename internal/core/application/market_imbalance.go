@@ -0,0 +1,114 @@
+package application
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+// targetBaseWeight is the share of the value of a market that is expected to
+// be held in base asset, matching the 50/50 weights of the balanced reserves
+// formula.
+var targetBaseWeight = decimal.NewFromFloat(0.5)
+
+// MarketImbalance returns how far the available balances of the given market
+// deviate from the target ratio of its strategy, valued at the current spot
+// price, along with the trade, made at spot price, that would restore it.
+// Markets priced by a formula applied to their reserves are always balanced
+// by definition, while those priced by the operator or by a custom strategy
+// drift as their price moves.
+func (t *tradeService) MarketImbalance(
+	ctx context.Context,
+	market Market,
+) (*Imbalance, error) {
+	if err := validateMarketRequest(market, t.marketBaseAsset); err != nil {
+		return nil, err
+	}
+
+	mkt, accountIndex, err := t.repoManager.MarketRepository().GetMarketByAsset(
+		ctx, market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if accountIndex < 0 {
+		return nil, ErrMarketNotExist
+	}
+
+	_, unspents, err := t.getInfoAndUnspentsForAccount(ctx, accountIndex)
+	if err != nil {
+		log.Debugf("error while retrieving unspents: %s", err)
+		return nil, ErrServiceUnavailable
+	}
+	balances := getBalanceByAsset(unspents)
+	balance := Balance{
+		BaseAmount:  balances[mkt.BaseAsset],
+		QuoteAmount: balances[mkt.QuoteAsset],
+	}
+	if balance.BaseAmount == 0 && balance.QuoteAmount == 0 {
+		return nil, domain.ErrMarketNotFunded
+	}
+
+	price, err := t.pricingStrategies.forMarket(mkt).SpotPrice(
+		balance.BaseAmount, balance.QuoteAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !price.QuotePrice.IsPositive() {
+		return nil, domain.ErrMarketNotPriced
+	}
+
+	return marketImbalance(mkt, balance, price), nil
+}
+
+// marketImbalance compares the value of the base balance, expressed in quote
+// asset at the given price, against the total value of the market.
+// The restoring trade moves half of the difference between the values of the
+// two sides from the overweight to the underweight one.
+func marketImbalance(
+	market *domain.Market,
+	balance Balance,
+	price Price,
+) *Imbalance {
+	baseAmount := decimal.NewFromInt(int64(balance.BaseAmount))
+	quoteAmount := decimal.NewFromInt(int64(balance.QuoteAmount))
+
+	baseValue := baseAmount.Mul(price.QuotePrice)
+	totalValue := baseValue.Add(quoteAmount)
+	baseWeight := baseValue.Div(totalValue)
+
+	imbalance := &Imbalance{
+		Balance:    balance,
+		Price:      price,
+		BaseWeight: baseWeight,
+		Deviation:  baseWeight.Sub(targetBaseWeight),
+	}
+
+	quoteDelta := baseValue.Sub(quoteAmount).Div(decimal.NewFromInt(2))
+	baseDelta := quoteDelta.Div(price.QuotePrice)
+	quoteToMove := uint64(quoteDelta.Abs().IntPart())
+	baseToMove := uint64(baseDelta.Abs().IntPart())
+	if quoteToMove == 0 || baseToMove == 0 {
+		return imbalance
+	}
+
+	if quoteDelta.IsPositive() {
+		imbalance.Rebalance = Rebalance{
+			SellAsset:  market.BaseAsset,
+			SellAmount: baseToMove,
+			BuyAsset:   market.QuoteAsset,
+			BuyAmount:  quoteToMove,
+		}
+	} else {
+		imbalance.Rebalance = Rebalance{
+			SellAsset:  market.QuoteAsset,
+			SellAmount: quoteToMove,
+			BuyAsset:   market.BaseAsset,
+			BuyAmount:  baseToMove,
+		}
+	}
+	return imbalance
+}
@@ -1,13 +1,19 @@
 package application_test
 
 import (
+	"encoding/hex"
+	"math"
 	"strings"
 	"sync"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/tdex-network/tdex-daemon/internal/core/application"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/pkg/assetregistry"
+	"github.com/tdex-network/tdex-daemon/pkg/bufferutil"
 	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer/esplora"
 	"github.com/vulpemventures/go-elements/address"
 	"github.com/vulpemventures/go-elements/network"
 	"github.com/vulpemventures/go-elements/transaction"
@@ -58,6 +64,21 @@ func (m *mockTradeManager) FillProposal(
 	m.counter++
 	args := m.Called(opts)
 
+	var res *application.FillProposalResult
+	switch a := args.Get(0).(type) {
+	case *application.FillProposalResult:
+		res = a
+	case func(application.FillProposalOpts) *application.FillProposalResult:
+		res = a(opts)
+	}
+	return res, args.Error(1)
+}
+
+func (m *mockTradeManager) PreviewProposal(
+	opts application.FillProposalOpts,
+) (*application.FillProposalResult, error) {
+	args := m.Called(opts)
+
 	var res *application.FillProposalResult
 	if a := args.Get(0); a != nil {
 		res = a.(*application.FillProposalResult)
@@ -104,6 +125,107 @@ func (m *mockTransactionManager) ExtractBlindingData(
 	return res, res1, args.Error(2)
 }
 
+// utxoSetTransactionManager extracts from a transaction the keys of all its
+// inputs and the outputs owned by the wallet, without unblinding them, so that
+// tests can check how the utxo set is updated.
+type utxoSetTransactionManager struct {
+	application.TransactionHandler
+}
+
+func (m utxoSetTransactionManager) ExtractUnspents(
+	txhex string,
+	infoByScript map[string]domain.AddressInfo,
+	net *network.Network,
+) ([]domain.Unspent, []domain.UnspentKey, error) {
+	tx, err := transaction.NewTxFromHex(txhex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unspentsToSpend := make([]domain.UnspentKey, 0, len(tx.Inputs))
+	for _, in := range tx.Inputs {
+		unspentsToSpend = append(unspentsToSpend, domain.UnspentKey{
+			TxID: bufferutil.TxIDFromBytes(in.Hash),
+			VOut: in.Index,
+		})
+	}
+
+	unspentsToAdd := make([]domain.Unspent, 0)
+	for i, out := range tx.Outputs {
+		info, ok := infoByScript[hex.EncodeToString(out.Script)]
+		if !ok {
+			continue
+		}
+		unspentsToAdd = append(unspentsToAdd, domain.Unspent{
+			TxID:         tx.TxHash().String(),
+			VOut:         uint32(i),
+			AssetHash:    marketBaseAsset,
+			ScriptPubKey: out.Script,
+			Address:      info.Address,
+		})
+	}
+	return unspentsToAdd, unspentsToSpend, nil
+}
+
+// **** FiatPriceSource ****
+
+type mockFiatPriceSource struct {
+	mock.Mock
+}
+
+func (m *mockFiatPriceSource) Currency() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *mockFiatPriceSource) GetPrice(
+	asset string,
+	timestamp uint64,
+) (decimal.Decimal, error) {
+	args := m.Called(asset, timestamp)
+
+	var res decimal.Decimal
+	if a := args.Get(0); a != nil {
+		res = a.(decimal.Decimal)
+	}
+	return res, args.Error(1)
+}
+
+// **** AssetRegistry ****
+
+type mockAssetRegistry struct {
+	mock.Mock
+}
+
+func (m *mockAssetRegistry) AssetInfo(
+	assetHash string,
+) (assetregistry.AssetMetadata, error) {
+	args := m.Called(assetHash)
+
+	var res assetregistry.AssetMetadata
+	if a := args.Get(0); a != nil {
+		res = a.(assetregistry.AssetMetadata)
+	}
+	return res, args.Error(1)
+}
+
+// **** Signer ****
+
+// mockSigner is an external signer that records the PSETs it's asked to sign
+// and fails if err is defined, or returns them untouched otherwise.
+type mockSigner struct {
+	err   error
+	psets []string
+}
+
+func (m *mockSigner) Sign(psetBase64 string) (string, error) {
+	m.psets = append(m.psets, psetBase64)
+	if m.err != nil {
+		return "", m.err
+	}
+	return psetBase64, nil
+}
+
 // **** Explorer ****
 
 type mockExplorer struct {
@@ -233,6 +355,72 @@ func (m *mockExplorer) GetBlockHeight() (int, error) {
 	return res, args.Error(1)
 }
 
+func (m *mockExplorer) GetBlockHash(height uint64) (string, error) {
+	args := m.Called(height)
+
+	var res string
+	if a := args.Get(0); a != nil {
+		res = a.(string)
+	}
+	return res, args.Error(1)
+}
+
+// **** Faucet ****
+
+// mockFaucet is an explorer whose unspents are those sent by its faucet.
+type mockFaucet struct {
+	*mockExplorer
+	lock  *sync.Mutex
+	utxos []explorer.Utxo
+}
+
+func newMockFaucet(explorerSvc *mockExplorer) *mockFaucet {
+	return &mockFaucet{explorerSvc, &sync.Mutex{}, nil}
+}
+
+func (m *mockFaucet) Faucet(
+	addr string,
+	amount float64,
+	asset string,
+) (string, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	script, err := address.ToOutputScript(addr)
+	if err != nil {
+		return "", err
+	}
+	if asset == "" {
+		asset = network.Regtest.AssetID
+	}
+	txid := randomHex(32)
+	m.utxos = append(m.utxos, esplora.NewUnconfidentialWitnessUtxo(
+		txid, 0, uint64(math.Round(amount*math.Pow10(8))), asset, script,
+	))
+	return txid, nil
+}
+
+func (m *mockFaucet) GetUnspentsForAddresses(
+	addresses []string,
+	blindKeys [][]byte,
+) ([]explorer.Utxo, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	scripts := make(map[string]bool)
+	for _, addr := range addresses {
+		script, _ := address.ToOutputScript(addr)
+		scripts[hex.EncodeToString(script)] = true
+	}
+	utxos := make([]explorer.Utxo, 0)
+	for _, u := range m.utxos {
+		if scripts[hex.EncodeToString(u.Script())] {
+			utxos = append(utxos, u)
+		}
+	}
+	return utxos, nil
+}
+
 // **** Explorer's Transaction ****
 
 type mockTransaction struct {
@@ -527,6 +715,35 @@ func (m *mockSwapRequest) GetOutputBlindingKey() map[string][]byte {
 	return nil
 }
 
+// swapRequestStub is a mocked SwapRequest with the given assets, amounts and
+// output blinding keys.
+type swapRequestStub struct {
+	*mockSwapRequest
+	assetP, assetR     string
+	amountP, amountR   uint64
+	outputBlindingKeys map[string][]byte
+}
+
+func (s swapRequestStub) GetAssetP() string {
+	return s.assetP
+}
+
+func (s swapRequestStub) GetAmountP() uint64 {
+	return s.amountP
+}
+
+func (s swapRequestStub) GetAssetR() string {
+	return s.assetR
+}
+
+func (s swapRequestStub) GetAmountR() uint64 {
+	return s.amountR
+}
+
+func (s swapRequestStub) GetOutputBlindingKey() map[string][]byte {
+	return s.outputBlindingKeys
+}
+
 // **** SwapAccept ****
 
 type mockSwapAccept struct {
@@ -5,19 +5,26 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/shopspring/decimal"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/tdex-network/tdex-daemon/pkg/assetregistry"
 	"github.com/tdex-network/tdex-daemon/pkg/bufferutil"
 	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+	"github.com/tdex-network/tdex-daemon/pkg/fiatprice"
 	"github.com/tdex-network/tdex-daemon/pkg/mathutil"
+	"github.com/tdex-network/tdex-daemon/pkg/wallet"
 	"github.com/vulpemventures/go-elements/elementsutil"
 	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/transaction"
 )
 
 const (
@@ -25,6 +32,10 @@ const (
 	feeDeposit
 )
 
+// maxBalanceSnapshots is the max number of snapshots returned by a single
+// MarketBalanceHistory request.
+const maxBalanceSnapshots = 10000
+
 // OperatorService defines the methods of the application layer for the operator service.
 type OperatorService interface {
 	DepositMarket(
@@ -37,6 +48,13 @@ type OperatorService interface {
 		ctx context.Context,
 		numOfAddresses int,
 	) ([]AddressAndBlindingKey, error)
+	// GetDepositAddress returns count new addresses, along with their
+	// blinding keys, for funding the given existing market.
+	GetDepositAddress(
+		ctx context.Context,
+		market Market,
+		count int,
+	) ([]AddressAndBlindingKey, error)
 	OpenMarket(
 		ctx context.Context,
 		baseAsset string,
@@ -47,6 +65,16 @@ type OperatorService interface {
 		baseAsset string,
 		quoteAsset string,
 	) error
+	// PauseMarket makes a tradable market reject new trades, while letting
+	// those in-flight complete. ResumeMarket reverts it.
+	PauseMarket(
+		ctx context.Context,
+		market Market,
+	) error
+	ResumeMarket(
+		ctx context.Context,
+		market Market,
+	) error
 	UpdateMarketPercentageFee(
 		ctx context.Context,
 		req MarketWithFee,
@@ -55,6 +83,36 @@ type OperatorService interface {
 		ctx context.Context,
 		req MarketWithFee,
 	) (*MarketWithFee, error)
+	// UpdateMarketFee schedules a change of the basis point fee of a market,
+	// effective since the given time.
+	UpdateMarketFee(
+		ctx context.Context,
+		market Market,
+		fee Fee,
+		effectiveAt uint64,
+	) (*MarketWithFee, error)
+	MarketFeeHistory(
+		ctx context.Context,
+		market Market,
+	) ([]FeeChange, error)
+	// UpdateMarketFeeTiers replaces the fees charged by the given market to
+	// the peers by volume of their settled trades.
+	UpdateMarketFeeTiers(
+		ctx context.Context,
+		req MarketFeeTiers,
+	) error
+	UpdateMarketTradeLimits(
+		ctx context.Context,
+		req MarketTradeLimits,
+	) error
+	UpdateMarketPricePrecision(
+		ctx context.Context,
+		req MarketPricePrecision,
+	) error
+	UpdateMarketSettlementTimeout(
+		ctx context.Context,
+		req MarketSettlementTimeout,
+	) error
 	UpdateMarketPrice(
 		ctx context.Context,
 		req MarketWithPrice,
@@ -63,13 +121,57 @@ type OperatorService interface {
 		ctx context.Context,
 		req MarketStrategy,
 	) error
+	// SetStrategy switches the pricing strategy of the given market, even if
+	// it's open, without affecting the trades already accepted.
+	SetStrategy(
+		ctx context.Context,
+		market Market,
+		strategy domain.StrategyType,
+		params StrategyParams,
+	) error
 	ListTrades(
 		ctx context.Context,
 	) ([]TradeInfo, error)
+	GetTradeByTxid(
+		ctx context.Context,
+		txid string,
+	) (*TradeInfo, error)
+	TradeAudit(
+		ctx context.Context,
+		tradeID string,
+	) (*TradeAuditRecord, error)
+	ExportTrades(
+		ctx context.Context,
+		from, to uint64,
+		format ExportFormat,
+		w io.Writer,
+	) error
+	ListTradesForMarket(
+		ctx context.Context,
+		market Market,
+		page Page,
+		onlyCompleted bool,
+	) ([]TradeInfo, int, error)
+	MarketBalanceHistory(
+		ctx context.Context,
+		market Market,
+		from, to uint64,
+		interval time.Duration,
+	) ([]BalanceSnapshot, error)
+	SubscribeTrades(
+		ctx context.Context,
+		filter TradeFilter,
+	) ([]TradeInfo, <-chan TradeInfo, error)
 	ListMarketExternalAddresses(
 		ctx context.Context,
 		req Market,
 	) ([]string, error)
+	// EstimateWithdrawFee returns the virtual size and the network fee amount
+	// of the given withdrawal, without making it.
+	EstimateWithdrawFee(
+		ctx context.Context,
+		req WithdrawMarketReq,
+	) (int, uint64, error)
 	WithdrawMarketFunds(
 		ctx context.Context,
 		req WithdrawMarketReq,
@@ -77,10 +179,35 @@ type OperatorService interface {
 		[]byte,
 		error,
 	)
+	WithdrawMultiple(
+		ctx context.Context,
+		reqs []WithdrawMarketReq,
+	) (string, error)
+	BumpWithdrawFee(
+		ctx context.Context,
+		txid string,
+		newMilliSatPerByte int64,
+	) (string, error)
 	FeeAccountBalance(ctx context.Context) (
 		int64,
 		error,
 	)
+	TotalBalance(ctx context.Context) (map[string]BalanceInfo, error)
+	// ConsolidateFeeAccount spends many small coins of the fee account into a
+	// single one, returning the txid and the number of coins spent.
+	ConsolidateFeeAccount(
+		ctx context.Context,
+		maxInputs int,
+		milliSatPerByte int64,
+	) (string, int, error)
+	// SweepFeeAccount sends the balance of the fee account exceeding the
+	// threshold of the given sweep to its address, returning the txid.
+	SweepFeeAccount(ctx context.Context, sweep FeeSweep) (string, error)
+	// StartFeeSweep periodically sweeps the fee account until ctx is done.
+	StartFeeSweep(ctx context.Context, interval time.Duration, sweep FeeSweep)
+	// SetMaxTxInputs caps the number of unspents of each account that coin
+	// selection combines in withdrawals. Zero removes the cap.
+	SetMaxTxInputs(maxInputs int)
 	ClaimMarketDeposit(
 		ctx context.Context,
 		market Market,
@@ -90,26 +217,145 @@ type OperatorService interface {
 		ctx context.Context,
 		outpoints []TxOutpoint,
 	) error
+	// LabelMarketDeposit associates the given deposits of a market with the
+	// label of the liquidity provider they belong to.
+	LabelMarketDeposit(
+		ctx context.Context,
+		market Market,
+		outpoints []TxOutpoint,
+		label string,
+	) error
+	// BalanceByLabel returns the share of the balance of the given market that
+	// belongs to every label.
+	BalanceByLabel(
+		ctx context.Context,
+		market Market,
+	) (map[string]Balance, error)
+	// FundMarket requests coins for the given market from the faucet, on
+	// regtest and testnet only.
+	FundMarket(
+		ctx context.Context,
+		market Market,
+		amounts Balance,
+	) error
+	// ImportUtxo adds to the given market account an output sent to one of its
+	// addresses, unblinded with the provided key instead of the one derived by
+	// the daemon.
+	ImportUtxo(
+		ctx context.Context,
+		accountIndex int,
+		outpoint TxOutpoint,
+		blindingKey []byte,
+	) error
+	GetInfo(ctx context.Context) (*DaemonInfo, error)
 	ListMarket(
 		ctx context.Context,
 	) ([]MarketInfo, error)
+	AssetInfo(
+		ctx context.Context,
+		assetHash string,
+	) (*AssetInfo, error)
+	GetBlindingData(
+		psetBase64 string,
+		inKeys, outKeys map[string][]byte,
+	) (in, out map[int]BlindingData, err error)
+	AccountXPub(ctx context.Context, accountIndex uint64) (string, error)
+	MasterBlindingKey(ctx context.Context) (string, error)
+	RotateBlindingKey(
+		ctx context.Context,
+		address string,
+	) (*AddressAndBlindingKey, error)
+	MarketsByBaseAsset(
+		ctx context.Context,
+		baseAsset string,
+	) ([]MarketInfo, error)
+	MarketsContainingAsset(
+		ctx context.Context,
+		asset string,
+	) ([]MarketInfo, error)
 	GetCollectedMarketFee(
 		ctx context.Context,
 		market Market,
 	) (*ReportMarketFee, error)
+	// ReportMarketFeeRange is like GetCollectedMarketFee, but only reports the
+	// fees of the trades settled between from and to, both included.
+	ReportMarketFeeRange(
+		ctx context.Context,
+		market Market,
+		from, to uint64,
+	) (*ReportMarketFee, error)
+	TradeVolume(
+		ctx context.Context,
+		market Market,
+		from, to uint64,
+	) (*VolumeReport, error)
+	// VerifyFeeLedger cross-checks the fee ledger against the settled trades
+	// and, if repair is true, records the fees missing from the ledger.
+	VerifyFeeLedger(
+		ctx context.Context,
+		repair bool,
+	) ([]FeeLedgerDiscrepancy, error)
 	ListUtxos(ctx context.Context) (map[uint64]UtxoInfoList, error)
+	// ProveReserves signs, for every account, each of its unspents with the
+	// key that controls it, over a message that includes the given nonce. The
+	// wallet must be unlocked.
+	ProveReserves(ctx context.Context, nonce string) (*ReserveProof, error)
+	ListLockedUtxos(ctx context.Context) ([]UtxoInfo, error)
+	ListUtxosForAccount(
+		ctx context.Context,
+		accountIndex uint64,
+		filter UtxoFilter,
+	) (*UtxoInfoList, error)
+	// ListPendingDeposits returns the unconfirmed utxos received by the given
+	// account, excluded from its confirmed balance.
+	ListPendingDeposits(
+		ctx context.Context,
+		accountIndex uint64,
+	) ([]PendingDeposit, error)
 	ReloadUtxos(ctx context.Context) error
+	RescanAccount(
+		ctx context.Context,
+		accountIndex int,
+	) (*RescanAccountResult, error)
+	// RescanAccountDryRun reports the changes that RescanAccount would make to
+	// the utxo set of the given account, without applying them.
+	RescanAccountDryRun(
+		ctx context.Context,
+		accountIndex int,
+	) (*RescanDiff, error)
 	DropMarket(ctx context.Context, accountIndex int) error
+	// ExportMarketsConfig returns the portable JSON configuration of all the
+	// markets, without funds.
+	ExportMarketsConfig(ctx context.Context) ([]byte, error)
+	// ImportMarketsConfig creates the markets of the given JSON configuration
+	// that don't exist yet, and returns them.
+	ImportMarketsConfig(ctx context.Context, data []byte) ([]Market, error)
+	// ExcludeUtxo prevents the given utxo from being selected to fund trades
+	// and withdrawals. It still counts for the balance of its account.
+	ExcludeUtxo(ctx context.Context, outpoint TxOutpoint) error
+	// IncludeUtxo makes the given excluded utxo selectable again.
+	IncludeUtxo(ctx context.Context, outpoint TxOutpoint) error
 }
 
 type operatorService struct {
 	repoManager                ports.RepoManager
 	explorerSvc                explorer.Service
 	blockchainListener         BlockchainListener
+	tradeFeed                  *TradeFeed
+	signer                     Signer
+	fiatPriceSvc               fiatprice.Service
+	assetRegistrySvc           assetregistry.Service
+	faucet                     Faucet
 	marketBaseAsset            string
 	marketFee                  int64
 	network                    *network.Network
 	feeAccountBalanceThreshold uint64
+	withdrawalFeeRate          FeeRateFloor
+	dustThreshold              uint64
+	antiFeeSniping             bool
+	withdrawals                *withdrawals
+	coinSelector               wallet.CoinSelector
+	startTime                  time.Time
 }
 
 // NewOperatorService is a constructor function for OperatorService.
@@ -117,20 +363,49 @@ func NewOperatorService(
 	repoManager ports.RepoManager,
 	explorerSvc explorer.Service,
 	bcListener BlockchainListener,
+	tradeFeed *TradeFeed,
+	signer Signer,
+	fiatPriceSvc fiatprice.Service,
+	assetRegistrySvc assetregistry.Service,
+	faucet Faucet,
 	marketBaseAsset string,
 	marketFee int64,
 	net *network.Network,
 	feeAccountBalanceThreshold uint64,
+	withdrawalFeeRate FeeRateFloor,
+	dustThreshold uint64,
+	antiFeeSniping bool,
 ) OperatorService {
+	if withdrawalFeeRate.MinMilliSatPerByte <= 0 {
+		withdrawalFeeRate.MinMilliSatPerByte = domain.MinMilliSatPerByte
+	}
 	return &operatorService{
 		repoManager:                repoManager,
 		explorerSvc:                explorerSvc,
 		blockchainListener:         bcListener,
+		tradeFeed:                  tradeFeed,
+		signer:                     signer,
+		fiatPriceSvc:               fiatPriceSvc,
+		assetRegistrySvc:           assetRegistrySvc,
+		faucet:                     faucet,
 		marketBaseAsset:            marketBaseAsset,
 		marketFee:                  marketFee,
 		network:                    net,
 		feeAccountBalanceThreshold: feeAccountBalanceThreshold,
+		withdrawalFeeRate:          withdrawalFeeRate,
+		dustThreshold:              dustThreshold,
+		antiFeeSniping:             antiFeeSniping,
+		withdrawals:                newWithdrawals(),
+		startTime:                  time.Now(),
+	}
+}
+
+func (o *operatorService) SetMaxTxInputs(maxInputs int) {
+	if maxInputs <= 0 {
+		o.coinSelector = nil
+		return
 	}
+	o.coinSelector = wallet.NewMaxInputsCoinSelector(nil, maxInputs)
 }
 
 func (o *operatorService) DepositMarket(
@@ -353,6 +628,52 @@ func (o *operatorService) CloseMarket(
 	return nil
 }
 
+func (o *operatorService) PauseMarket(
+	ctx context.Context,
+	market Market,
+) error {
+	return o.updateMarketPause(ctx, market, (*domain.Market).Pause)
+}
+
+func (o *operatorService) ResumeMarket(
+	ctx context.Context,
+	market Market,
+) error {
+	return o.updateMarketPause(ctx, market, (*domain.Market).Resume)
+}
+
+func (o *operatorService) updateMarketPause(
+	ctx context.Context,
+	market Market,
+	updateFn func(m *domain.Market) error,
+) error {
+	if err := validateMarketRequest(market, o.marketBaseAsset); err != nil {
+		return err
+	}
+
+	_, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		return err
+	}
+	if accountIndex < 0 {
+		return ErrMarketNotExist
+	}
+
+	return o.repoManager.MarketRepository().UpdateMarket(
+		ctx,
+		accountIndex,
+		func(m *domain.Market) (*domain.Market, error) {
+			if err := updateFn(m); err != nil {
+				return nil, err
+			}
+			return m, nil
+		},
+	)
+}
+
 // UpdateMarketPercentageFee changes the Liquidity Provider fee for the given market.
 // MUST be expressed as basis point.
 // Eg. To change the fee on each swap from 0.25% to 1% you need to pass down 100
@@ -404,7 +725,7 @@ func (o *operatorService) UpdateMarketPercentageFee(
 			QuoteAsset: mkt.QuoteAsset,
 		},
 		Fee: Fee{
-			BasisPoint:    mkt.Fee,
+			BasisPoint:    mkt.ActiveFee(),
 			FixedBaseFee:  mkt.FixedFee.BaseFee,
 			FixedQuoteFee: mkt.FixedFee.QuoteFee,
 		},
@@ -460,39 +781,111 @@ func (o *operatorService) UpdateMarketFixedFee(
 			QuoteAsset: mkt.QuoteAsset,
 		},
 		Fee: Fee{
-			BasisPoint:    mkt.Fee,
+			BasisPoint:    mkt.ActiveFee(),
 			FixedBaseFee:  mkt.FixedFee.BaseFee,
 			FixedQuoteFee: mkt.FixedFee.QuoteFee,
 		},
 	}, nil
 }
 
-// UpdateMarketPrice rpc updates the price for the given market
-func (o *operatorService) UpdateMarketPrice(
+// UpdateMarketFee makes the basis point of the given fee effective for the
+// market since the given time. Fixed fees are not considered, since they can
+// be changed only with UpdateMarketFixedFee.
+func (o *operatorService) UpdateMarketFee(
 	ctx context.Context,
-	req MarketWithPrice,
-) error {
-	if err := validateAssetString(req.BaseAsset); err != nil {
-		return domain.ErrMarketInvalidBaseAsset
+	market Market,
+	fee Fee,
+	effectiveAt uint64,
+) (*MarketWithFee, error) {
+	if err := validateMarketRequest(market, o.marketBaseAsset); err != nil {
+		return nil, err
 	}
 
-	if err := validateAssetString(req.QuoteAsset); err != nil {
-		return domain.ErrMarketInvalidQuoteAsset
+	_, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if accountIndex < 0 {
+		return nil, ErrMarketNotExist
 	}
 
-	if req.BaseAsset != o.marketBaseAsset {
-		return domain.ErrMarketInvalidBaseAsset
+	var mkt *domain.Market
+	if err := o.repoManager.MarketRepository().UpdateMarket(
+		ctx,
+		accountIndex,
+		func(m *domain.Market) (*domain.Market, error) {
+			if err := m.ScheduleFeeChange(fee.BasisPoint, effectiveAt); err != nil {
+				return nil, err
+			}
+			mkt = m
+			return m, nil
+		},
+	); err != nil {
+		return nil, err
 	}
 
-	if err := validateAmount(req.Price.BasePrice); err != nil {
-		return domain.ErrMarketInvalidBasePrice
+	return &MarketWithFee{
+		Market: Market{
+			BaseAsset:  mkt.BaseAsset,
+			QuoteAsset: mkt.QuoteAsset,
+		},
+		Fee: Fee{
+			BasisPoint:    mkt.ActiveFee(),
+			FixedBaseFee:  mkt.FixedFee.BaseFee,
+			FixedQuoteFee: mkt.FixedFee.QuoteFee,
+		},
+	}, nil
+}
+
+// MarketFeeHistory returns the changes of the basis point fee of the given
+// market, either applied or scheduled, sorted by effective time.
+func (o *operatorService) MarketFeeHistory(
+	ctx context.Context,
+	market Market,
+) ([]FeeChange, error) {
+	if err := validateMarketRequest(market, o.marketBaseAsset); err != nil {
+		return nil, err
 	}
 
-	if err := validateAmount(req.Price.QuotePrice); err != nil {
-		return domain.ErrMarketInvalidQuotePrice
+	mkt, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if accountIndex < 0 {
+		return nil, ErrMarketNotExist
 	}
 
-	_, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+	now := uint64(time.Now().Unix())
+	history := make([]FeeChange, 0, len(mkt.FeeHistory))
+	for _, change := range mkt.FeeHistory {
+		history = append(history, FeeChange{
+			BasisPoint:  change.BasisPoint,
+			EffectiveAt: change.EffectiveAt,
+			CreatedAt:   change.CreatedAt,
+			Pending:     change.EffectiveAt > now,
+		})
+	}
+	return history, nil
+}
+
+// UpdateMarketFeeTiers changes the fee tiers of the given market. Peers
+// whose settled volume reaches a tier are charged its fee, in place of the
+// basis point one of the market.
+func (o *operatorService) UpdateMarketFeeTiers(
+	ctx context.Context,
+	req MarketFeeTiers,
+) error {
+	if err := validateMarketRequest(req.Market, o.marketBaseAsset); err != nil {
+		return err
+	}
+
+	mkt, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
 		ctx,
 		req.QuoteAsset,
 	)
@@ -503,415 +896,1926 @@ func (o *operatorService) UpdateMarketPrice(
 		return ErrMarketNotExist
 	}
 
-	// Updates the base price and the quote price
-	return o.repoManager.MarketRepository().UpdatePrices(
+	tiers := make([]domain.FeeTier, 0, len(req.Tiers))
+	for _, tier := range req.Tiers {
+		tiers = append(tiers, domain.FeeTier{
+			MinVolume:  tier.MinVolume,
+			BasisPoint: tier.BasisPoint,
+		})
+	}
+	if err := mkt.ChangeFeeTiers(tiers); err != nil {
+		return err
+	}
+
+	return o.repoManager.MarketRepository().UpdateMarket(
 		ctx,
 		accountIndex,
-		domain.Prices{
-			BasePrice:  req.Price.BasePrice,
-			QuotePrice: req.Price.QuotePrice,
+		func(_ *domain.Market) (*domain.Market, error) {
+			return mkt, nil
 		},
 	)
 }
 
-// UpdateMarketStrategy changes the current market making strategy,
-// either using an automated market making formula or a pluggable price feed
-func (o *operatorService) UpdateMarketStrategy(
+// UpdateMarketTradeLimits changes the min and max amount that the proposer
+// of a trade can send for the given market.
+func (o *operatorService) UpdateMarketTradeLimits(
 	ctx context.Context,
-	req MarketStrategy,
+	req MarketTradeLimits,
 ) error {
-	if err := validateAssetString(req.Market.BaseAsset); err != nil {
-		return domain.ErrMarketInvalidBaseAsset
-	}
-
-	if err := validateAssetString(req.Market.QuoteAsset); err != nil {
-		return domain.ErrMarketInvalidQuoteAsset
-	}
-
-	if req.BaseAsset != o.marketBaseAsset {
-		return ErrMarketNotExist
+	if err := validateMarketRequest(req.Market, o.marketBaseAsset); err != nil {
+		return err
 	}
 
-	_, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+	mkt, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
 		ctx,
 		req.QuoteAsset,
 	)
 	if err != nil {
 		return err
 	}
-
 	if accountIndex < 0 {
 		return ErrMarketNotExist
 	}
 
-	requestStrategy := req.Strategy
+	if err := mkt.ChangeTradeLimits(
+		req.MinTradeAmount,
+		req.MaxTradeAmount,
+	); err != nil {
+		return err
+	}
 
 	return o.repoManager.MarketRepository().UpdateMarket(
 		ctx,
 		accountIndex,
-		func(m *domain.Market) (*domain.Market, error) {
-			switch requestStrategy {
-			case domain.StrategyTypePluggable:
-				if err := m.MakeStrategyPluggable(); err != nil {
-					return nil, err
-				}
-
-			case domain.StrategyTypeBalanced:
-				if err := m.MakeStrategyBalanced(); err != nil {
-					return nil, err
-				}
-
-			default:
-				return nil, ErrUnknownStrategy
-			}
-
-			return m, nil
+		func(_ *domain.Market) (*domain.Market, error) {
+			return mkt, nil
 		},
 	)
 }
 
-// ListTrades returns the list of all trads processed by the daemon
-func (o *operatorService) ListTrades(
+// UpdateMarketPricePrecision changes the number of decimal places the prices
+// of the given market are rounded to when quoted to traders.
+func (o *operatorService) UpdateMarketPricePrecision(
 	ctx context.Context,
-) ([]TradeInfo, error) {
-	trades, err := o.repoManager.TradeRepository().GetAllTrades(ctx)
-	if err != nil {
-		return nil, err
+	req MarketPricePrecision,
+) error {
+	if err := validateMarketRequest(req.Market, o.marketBaseAsset); err != nil {
+		return err
 	}
 
-	return tradesToTradeInfo(trades, o.marketBaseAsset, o.network.Name), nil
-}
+	mkt, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		req.QuoteAsset,
+	)
+	if err != nil {
+		return err
+	}
+	if accountIndex < 0 {
+		return ErrMarketNotExist
+	}
 
-func (o *operatorService) ListMarketExternalAddresses(
+	if err := mkt.ChangePricePrecision(req.Precision); err != nil {
+		return err
+	}
+
+	return o.repoManager.MarketRepository().UpdateMarket(
+		ctx,
+		accountIndex,
+		func(_ *domain.Market) (*domain.Market, error) {
+			return mkt, nil
+		},
+	)
+}
+
+// UpdateMarketSettlementTimeout changes the time within which the trades of
+// the given market must be completed once accepted. It applies only to the
+// trades accepted from now on.
+func (o *operatorService) UpdateMarketSettlementTimeout(
 	ctx context.Context,
-	req Market,
-) ([]string, error) {
+	req MarketSettlementTimeout,
+) error {
+	if err := validateMarketRequest(req.Market, o.marketBaseAsset); err != nil {
+		return err
+	}
+
+	mkt, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		req.QuoteAsset,
+	)
+	if err != nil {
+		return err
+	}
+	if accountIndex < 0 {
+		return ErrMarketNotExist
+	}
+
+	if err := mkt.ChangeSettlementTimeout(req.Timeout); err != nil {
+		return err
+	}
+
+	return o.repoManager.MarketRepository().UpdateMarket(
+		ctx,
+		accountIndex,
+		func(_ *domain.Market) (*domain.Market, error) {
+			return mkt, nil
+		},
+	)
+}
+
+// UpdateMarketPrice rpc updates the price for the given market
+func (o *operatorService) UpdateMarketPrice(
+	ctx context.Context,
+	req MarketWithPrice,
+) error {
 	if err := validateAssetString(req.BaseAsset); err != nil {
-		return nil, domain.ErrMarketInvalidBaseAsset
+		return domain.ErrMarketInvalidBaseAsset
 	}
 
 	if err := validateAssetString(req.QuoteAsset); err != nil {
-		return nil, domain.ErrMarketInvalidQuoteAsset
+		return domain.ErrMarketInvalidQuoteAsset
 	}
 
 	if req.BaseAsset != o.marketBaseAsset {
-		return nil, domain.ErrMarketInvalidBaseAsset
+		return domain.ErrMarketInvalidBaseAsset
 	}
 
-	_, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(ctx, req.QuoteAsset)
+	if err := validateAmount(req.Price.BasePrice); err != nil {
+		return domain.ErrMarketInvalidBasePrice
+	}
+
+	if err := validateAmount(req.Price.QuotePrice); err != nil {
+		return domain.ErrMarketInvalidQuotePrice
+	}
+
+	_, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		req.QuoteAsset,
+	)
+	if err != nil {
+		return err
+	}
+	if accountIndex < 0 {
+		return ErrMarketNotExist
+	}
+
+	// Updates the base price and the quote price
+	return o.repoManager.MarketRepository().UpdatePrices(
+		ctx,
+		accountIndex,
+		domain.Prices{
+			BasePrice:  req.Price.BasePrice,
+			QuotePrice: req.Price.QuotePrice,
+		},
+	)
+}
+
+// UpdateMarketStrategy changes the current market making strategy,
+// either using an automated market making formula or a pluggable price feed
+func (o *operatorService) UpdateMarketStrategy(
+	ctx context.Context,
+	req MarketStrategy,
+) error {
+	if err := validateAssetString(req.Market.BaseAsset); err != nil {
+		return domain.ErrMarketInvalidBaseAsset
+	}
+
+	if err := validateAssetString(req.Market.QuoteAsset); err != nil {
+		return domain.ErrMarketInvalidQuoteAsset
+	}
+
+	if req.BaseAsset != o.marketBaseAsset {
+		return ErrMarketNotExist
+	}
+
+	_, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		req.QuoteAsset,
+	)
+	if err != nil {
+		return err
+	}
+
+	if accountIndex < 0 {
+		return ErrMarketNotExist
+	}
+
+	requestStrategy := req.Strategy
+
+	return o.repoManager.MarketRepository().UpdateMarket(
+		ctx,
+		accountIndex,
+		func(m *domain.Market) (*domain.Market, error) {
+			switch requestStrategy {
+			case domain.StrategyTypePluggable:
+				if err := m.MakeStrategyPluggable(); err != nil {
+					return nil, err
+				}
+
+			case domain.StrategyTypeBalanced:
+				if err := m.MakeStrategyBalanced(); err != nil {
+					return nil, err
+				}
+
+			default:
+				return nil, ErrUnknownStrategy
+			}
+
+			return m, nil
+		},
+	)
+}
+
+// SetStrategy switches the market making strategy of a live market, without
+// requiring to close it, once the given params are validated for the new
+// strategy. The balances of the market are left untouched and the approved
+// trades keep the price they were quoted with, since their amounts are
+// already fixed. A balanced strategy requires the market to have balance of
+// both its assets.
+func (o *operatorService) SetStrategy(
+	ctx context.Context,
+	market Market,
+	strategy domain.StrategyType,
+	params StrategyParams,
+) error {
+	if err := validateMarketRequest(market, o.marketBaseAsset); err != nil {
+		return err
+	}
+
+	switch strategy {
+	case domain.StrategyTypePluggable:
+		if err := validateAmount(params.Price.BasePrice); err != nil {
+			return domain.ErrMarketInvalidBasePrice
+		}
+		if err := validateAmount(params.Price.QuotePrice); err != nil {
+			return domain.ErrMarketInvalidQuotePrice
+		}
+	case domain.StrategyTypeBalanced:
+		if !params.Price.BasePrice.IsZero() || !params.Price.QuotePrice.IsZero() {
+			return ErrInvalidStrategyParams
+		}
+	default:
+		return ErrUnknownStrategy
+	}
+
+	_, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		return err
+	}
+	if accountIndex < 0 {
+		return ErrMarketNotExist
+	}
+
+	if strategy == domain.StrategyTypeBalanced {
+		info, err := o.repoManager.VaultRepository().
+			GetAllDerivedAddressesInfoForAccount(ctx, accountIndex)
+		if err != nil {
+			return err
+		}
+		for _, asset := range []string{market.BaseAsset, market.QuoteAsset} {
+			balance, err := o.repoManager.UnspentRepository().GetBalance(
+				ctx, info.Addresses(), asset,
+			)
+			if err != nil {
+				return err
+			}
+			if balance == 0 {
+				return domain.ErrMarketNotFunded
+			}
+		}
+	}
+
+	prices := domain.Prices{
+		BasePrice:  params.Price.BasePrice,
+		QuotePrice: params.Price.QuotePrice,
+	}
+	// The strategy and the prices of the market are switched in the same
+	// transaction so that a failure can't leave the market with only one of
+	// them changed. Since prices are stored apart from the market, they're
+	// committed in their own transaction nested in that of the market, which is
+	// therefore discarded if they can't be updated.
+	if _, err := o.repoManager.RunTransaction(
+		ctx,
+		false,
+		func(ctx context.Context) (interface{}, error) {
+			if err := o.repoManager.MarketRepository().UpdateMarket(
+				ctx,
+				accountIndex,
+				func(m *domain.Market) (*domain.Market, error) {
+					if strategy == domain.StrategyTypeBalanced {
+						m.SwitchStrategyBalanced()
+						return m, nil
+					}
+
+					if err := m.SwitchStrategyPluggable(prices); err != nil {
+						return nil, err
+					}
+					return m, nil
+				},
+			); err != nil {
+				return nil, err
+			}
+
+			// prices are not used by the formula of a balanced strategy.
+			if strategy != domain.StrategyTypePluggable {
+				return nil, nil
+			}
+			return o.repoManager.RunPricesTransaction(
+				ctx,
+				false,
+				func(ctx context.Context) (interface{}, error) {
+					return nil, o.repoManager.MarketRepository().UpdatePrices(
+						ctx, accountIndex, prices,
+					)
+				},
+			)
+		},
+	); err != nil {
+		return err
+	}
+
+	log.Infof(
+		"switched strategy of market with quote asset %s to type %d",
+		market.QuoteAsset, strategy,
+	)
+	return nil
+}
+
+// ListTrades returns the list of all trads processed by the daemon
+func (o *operatorService) ListTrades(
+	ctx context.Context,
+) ([]TradeInfo, error) {
+	trades, err := o.repoManager.TradeRepository().GetAllTrades(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tradesToTradeInfo(trades, o.marketBaseAsset, o.network.Name), nil
+}
+
+// GetTradeByTxid returns the trade settled, or to be settled, by the
+// transaction with the given hash.
+func (o *operatorService) GetTradeByTxid(
+	ctx context.Context,
+	txid string,
+) (*TradeInfo, error) {
+	if err := validateTxid(txid); err != nil {
+		return nil, err
+	}
+
+	trade, err := o.repoManager.TradeRepository().GetTradeByTxID(ctx, txid)
 	if err != nil {
 		return nil, err
 	}
+	if trade == nil {
+		return nil, ErrTradeNotFound
+	}
+
+	info := tradesToTradeInfo(
+		[]*domain.Trade{trade}, o.marketBaseAsset, o.network.Name,
+	)
+	if len(info) <= 0 {
+		return nil, ErrTradeNotFound
+	}
+	return &info[0], nil
+}
+
+// SubscribeTrades returns the in-flight trades matching the given filter,
+// sorted by request time, along with a channel where all their later status
+// changes are sent. The subscription lasts until the given context is done,
+// then the channel is closed.
+func (o *operatorService) SubscribeTrades(
+	ctx context.Context,
+	filter TradeFilter,
+) ([]TradeInfo, <-chan TradeInfo, error) {
+	filterByMarket := filter.Market != Market{}
+	if filterByMarket {
+		if err := validateMarketRequest(filter.Market, o.marketBaseAsset); err != nil {
+			return nil, nil, err
+		}
+
+		m, _, err := o.repoManager.MarketRepository().GetMarketByAsset(
+			ctx,
+			filter.Market.QuoteAsset,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		if m == nil {
+			return nil, nil, ErrMarketNotExist
+		}
+	}
+
+	// subscribe before fetching the in-flight trades so that no update
+	// happening in the meanwhile is missed.
+	id, chInfo := o.tradeFeed.subscribe(filter)
+
+	var trades []*domain.Trade
+	var err error
+	if filterByMarket {
+		trades, err = o.repoManager.TradeRepository().GetAllTradesByMarket(
+			ctx,
+			filter.Market.QuoteAsset,
+		)
+	} else {
+		trades, err = o.repoManager.TradeRepository().GetAllTrades(ctx)
+	}
+	if err != nil {
+		o.tradeFeed.unsubscribe(id)
+		return nil, nil, err
+	}
+
+	inFlightTrades := make([]TradeInfo, 0)
+	for _, info := range tradesToTradeInfo(trades, o.marketBaseAsset, o.network.Name) {
+		isInFlight := !info.Status.Failed && info.Status.Code < domain.Settled
+		if isInFlight && filter.match(info) {
+			inFlightTrades = append(inFlightTrades, info)
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		o.tradeFeed.unsubscribe(id)
+	}()
+
+	return inFlightTrades, chInfo, nil
+}
+
+// ListTradesForMarket returns the requested page of the list of trades of
+// the given market, sorted from the most recent one, along with the total
+// number of trades. If onlyCompleted is true, trades that never reached the
+// Accepted status are filtered out.
+func (o *operatorService) ListTradesForMarket(
+	ctx context.Context,
+	market Market,
+	page Page,
+	onlyCompleted bool,
+) ([]TradeInfo, int, error) {
+	if err := validateMarketRequest(market, o.marketBaseAsset); err != nil {
+		return nil, 0, err
+	}
+	if page.Number < 0 || page.Size <= 0 {
+		return nil, 0, ErrInvalidPage
+	}
+
+	m, _, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	if m == nil {
+		return nil, 0, ErrMarketNotExist
+	}
+
+	trades, err := o.repoManager.TradeRepository().GetAllTradesByMarket(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if onlyCompleted {
+		filtered := make([]*domain.Trade, 0, len(trades))
+		for _, trade := range trades {
+			if !trade.IsEmpty() && !trade.IsProposal() {
+				filtered = append(filtered, trade)
+			}
+		}
+		trades = filtered
+	}
+
+	tradeInfo := tradesToTradeInfo(trades, o.marketBaseAsset, o.network.Name)
+	sort.SliceStable(tradeInfo, func(i, j int) bool {
+		return tradeInfo[i].RequestTimeUnix > tradeInfo[j].RequestTimeUnix
+	})
+
+	total := len(tradeInfo)
+	start := page.Number * page.Size
+	if start >= total {
+		return []TradeInfo{}, total, nil
+	}
+	end := start + page.Size
+	if end > total {
+		end = total
+	}
+
+	return tradeInfo[start:end], total, nil
+}
+
+// MarketBalanceHistory returns the balance of the given market at every
+// interval boundary of the given time range, both expressed in Unix seconds.
+// Snapshots are derived by replaying, in chronological order, the confirmed
+// deposits to the market's receiving addresses and the settled trades.
+// Withdrawals are not tracked, therefore snapshots following one overstate
+// the actual balance of the market.
+func (o *operatorService) MarketBalanceHistory(
+	ctx context.Context,
+	market Market,
+	from, to uint64,
+	interval time.Duration,
+) ([]BalanceSnapshot, error) {
+	if err := validateMarketRequest(market, o.marketBaseAsset); err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, ErrInvalidTimeRange
+	}
+	if interval < time.Second {
+		return nil, ErrInvalidInterval
+	}
+	step := uint64(interval / time.Second)
+	if (to-from)/step >= maxBalanceSnapshots {
+		return nil, ErrTooManySnapshots
+	}
+
+	m, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, ErrMarketNotExist
+	}
+
+	events, err := o.getMarketBalanceEvents(ctx, m, accountIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]BalanceSnapshot, 0, (to-from)/step+1)
+	var baseAmount, quoteAmount int64
+	i := 0
+	for timestamp := from; timestamp <= to; timestamp += step {
+		for ; i < len(events) && events[i].timestamp <= timestamp; i++ {
+			baseAmount += events[i].baseAmount
+			quoteAmount += events[i].quoteAmount
+		}
+		snapshots = append(snapshots, BalanceSnapshot{
+			BaseAmount:  nonNegative(baseAmount),
+			QuoteAmount: nonNegative(quoteAmount),
+			Timestamp:   timestamp,
+		})
+	}
+
+	return snapshots, nil
+}
+
+// balanceEvent is a change to the balance of a market happened at a certain
+// time, either because of a deposit or a trade.
+type balanceEvent struct {
+	timestamp   uint64
+	baseAmount  int64
+	quoteAmount int64
+}
+
+// getMarketBalanceEvents returns the list of deposits and settled trades of
+// the given market sorted by time.
+func (o *operatorService) getMarketBalanceEvents(
+	ctx context.Context,
+	market *domain.Market,
+	accountIndex int,
+) ([]balanceEvent, error) {
+	trades, err := o.repoManager.TradeRepository().GetCompletedTradesByMarket(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]balanceEvent, 0)
+	tradeTxIDs := make(map[string]bool)
+	for _, trade := range trades {
+		tradeTxIDs[trade.TxID] = true
+		if !trade.IsSettled() {
+			continue
+		}
+
+		// the market receives the amount P and sends the amount R of the swap.
+		req := trade.SwapRequestMessage()
+		event := balanceEvent{timestamp: trade.SettlementTime}
+		for asset, amount := range map[string]int64{
+			req.GetAssetP(): int64(req.GetAmountP()),
+			req.GetAssetR(): -int64(req.GetAmountR()),
+		} {
+			if asset == market.BaseAsset {
+				event.baseAmount += amount
+			} else {
+				event.quoteAmount += amount
+			}
+		}
+		events = append(events, event)
+	}
+
+	info, err := o.repoManager.VaultRepository().
+		GetAllDerivedExternalAddressesInfoForAccount(ctx, accountIndex)
+	if err != nil {
+		return nil, err
+	}
+	unspents, err := o.repoManager.UnspentRepository().
+		GetAllUnspentsForAddresses(ctx, info.Addresses())
+	if err != nil {
+		return nil, err
+	}
+
+	blockTimeByTxID := make(map[string]uint64)
+	for _, u := range unspents {
+		if !u.Confirmed || tradeTxIDs[u.TxID] {
+			continue
+		}
+		if u.AssetHash != market.BaseAsset && u.AssetHash != market.QuoteAsset {
+			continue
+		}
+
+		blockTime, ok := blockTimeByTxID[u.TxID]
+		if !ok {
+			status, err := explorer.WithContext(ctx, o.explorerSvc).
+				GetTransactionStatus(u.TxID)
+			if err != nil {
+				return nil, err
+			}
+			blockTime = parseBlockTime(status["block_time"])
+			blockTimeByTxID[u.TxID] = blockTime
+		}
+
+		event := balanceEvent{timestamp: blockTime}
+		if u.AssetHash == market.BaseAsset {
+			event.baseAmount = int64(u.Value)
+		} else {
+			event.quoteAmount = int64(u.Value)
+		}
+		events = append(events, event)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].timestamp < events[j].timestamp
+	})
+	return events, nil
+}
+
+func parseBlockTime(blockTime interface{}) uint64 {
+	switch t := blockTime.(type) {
+	case float64:
+		return uint64(t)
+	case int:
+		return uint64(t)
+	case int64:
+		return uint64(t)
+	case uint64:
+		return t
+	default:
+		return 0
+	}
+}
+
+func nonNegative(amount int64) uint64 {
+	if amount < 0 {
+		return 0
+	}
+	return uint64(amount)
+}
+
+func (o *operatorService) ListMarketExternalAddresses(
+	ctx context.Context,
+	req Market,
+) ([]string, error) {
+	if err := validateAssetString(req.BaseAsset); err != nil {
+		return nil, domain.ErrMarketInvalidBaseAsset
+	}
+
+	if err := validateAssetString(req.QuoteAsset); err != nil {
+		return nil, domain.ErrMarketInvalidQuoteAsset
+	}
+
+	if req.BaseAsset != o.marketBaseAsset {
+		return nil, domain.ErrMarketInvalidBaseAsset
+	}
+
+	_, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(ctx, req.QuoteAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	if accountIndex < 0 {
+		return nil, ErrMarketNotExist
+	}
+
+	allInfo, err := o.repoManager.VaultRepository().GetAllDerivedExternalAddressesInfoForAccount(
+		ctx,
+		accountIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return allInfo.Addresses(), nil
+}
+
+// ListMarket a set of informations about all the markets.
+func (o *operatorService) ListMarket(
+	ctx context.Context,
+) ([]MarketInfo, error) {
+	markets, err := o.repoManager.MarketRepository().GetAllMarkets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	marketInfo := make([]MarketInfo, 0, len(markets))
+	for _, market := range markets {
+		marketInfo = append(marketInfo, o.marketToMarketInfo(market))
+	}
+
+	return marketInfo, nil
+}
+
+func (o *operatorService) marketToMarketInfo(market domain.Market) MarketInfo {
+	var feeTiers []FeeTier
+	for _, tier := range market.FeeTiers {
+		feeTiers = append(feeTiers, FeeTier{
+			MinVolume:  tier.MinVolume,
+			BasisPoint: tier.BasisPoint,
+		})
+	}
+
+	return MarketInfo{
+		AccountIndex: uint64(market.AccountIndex),
+		Market: Market{
+			BaseAsset:  market.BaseAsset,
+			QuoteAsset: market.QuoteAsset,
+		},
+		Tradable:          market.Tradable,
+		Paused:            market.Paused,
+		StrategyType:      market.Strategy.Type,
+		Price:             market.Price,
+		PricePrecision:    market.PricePrecision,
+		SettlementTimeout: market.SettlementTimeout,
+		FeeTiers:          feeTiers,
+		Fee: Fee{
+			BasisPoint:    market.ActiveFee(),
+			FixedBaseFee:  market.FixedFee.BaseFee,
+			FixedQuoteFee: market.FixedFee.QuoteFee,
+		},
+		BaseAssetInfo:  o.resolveAssetInfo(market.BaseAsset),
+		QuoteAssetInfo: o.resolveAssetInfo(market.QuoteAsset),
+	}
+}
+
+// MarketsByBaseAsset returns the info of all the funded markets pairing the
+// given base asset with any quote asset.
+func (o *operatorService) MarketsByBaseAsset(
+	ctx context.Context,
+	baseAsset string,
+) ([]MarketInfo, error) {
+	if err := validateAssetString(baseAsset); err != nil {
+		return nil, domain.ErrMarketInvalidBaseAsset
+	}
+
+	markets, err := o.ListMarket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	marketInfo := make([]MarketInfo, 0, len(markets))
+	for _, info := range markets {
+		if info.Market.BaseAsset == baseAsset {
+			marketInfo = append(marketInfo, info)
+		}
+	}
+	return marketInfo, nil
+}
+
+// MarketsContainingAsset returns the info of all the markets with the given
+// asset as either base or quote asset, sorted by account index.
+func (o *operatorService) MarketsContainingAsset(
+	ctx context.Context,
+	asset string,
+) ([]MarketInfo, error) {
+	if err := validateAssetString(asset); err != nil {
+		return nil, err
+	}
+
+	markets, err := o.repoManager.MarketRepository().GetMarketsByAsset(ctx, asset)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(markets, func(i, j int) bool {
+		return markets[i].AccountIndex < markets[j].AccountIndex
+	})
+
+	marketInfo := make([]MarketInfo, 0, len(markets))
+	for _, market := range markets {
+		marketInfo = append(marketInfo, o.marketToMarketInfo(market))
+	}
+	return marketInfo, nil
+}
+
+func (o *operatorService) GetCollectedMarketFee(
+	ctx context.Context,
+	market Market,
+) (*ReportMarketFee, error) {
+	m, _, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if m == nil {
+		return nil, ErrMarketNotExist
+	}
+
+	if err := o.backfillFeeLedger(ctx, market.QuoteAsset); err != nil {
+		return nil, err
+	}
+
+	collectedFees, err := o.repoManager.FeeRepository().GetCollectedFeesByMarket(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.newReportMarketFee(collectedFees)
+}
+
+// ReportMarketFeeRange returns the report of the fees collected by the given
+// market with the trades settled between from and to, both included.
+func (o *operatorService) ReportMarketFeeRange(
+	ctx context.Context,
+	market Market,
+	from, to uint64,
+) (*ReportMarketFee, error) {
+	if err := validateMarketRequest(market, o.marketBaseAsset); err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, ErrInvalidTimeRange
+	}
+
+	m, _, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx, market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, ErrMarketNotExist
+	}
+
+	if err := o.backfillFeeLedger(ctx, market.QuoteAsset); err != nil {
+		return nil, err
+	}
+
+	collectedFees, err := o.repoManager.FeeRepository().GetCollectedFeesByMarketInTimeRange(
+		ctx, market.QuoteAsset, from, to,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.newReportMarketFee(collectedFees)
+}
+
+// newReportMarketFee returns the report of the given collected fees, along
+// with their totals grouped by asset.
+func (o *operatorService) newReportMarketFee(
+	collectedFees []domain.CollectedFee,
+) (*ReportMarketFee, error) {
+	fees := make([]FeeInfo, 0, len(collectedFees))
+	total := make(map[string]int64)
+	var totalFiat map[string]decimal.Decimal
+	if o.fiatPriceSvc != nil {
+		totalFiat = make(map[string]decimal.Decimal)
+	}
+	for _, collectedFee := range collectedFees {
+		fee := FeeInfo{
+			TradeID:          collectedFee.TradeID.String(),
+			BasisPoint:       collectedFee.BasisPoint,
+			Asset:            collectedFee.Asset,
+			Amount:           collectedFee.Amount,
+			BasisPointAmount: collectedFee.BasisPointAmount,
+			FixedAmount:      collectedFee.FixedAmount,
+			MarketPrice:      collectedFee.MarketPrice,
+		}
+		// entries recorded before the fee was broken down only account for the
+		// percentage fee.
+		if fee.BasisPointAmount+fee.FixedAmount == 0 {
+			fee.BasisPointAmount = fee.Amount
+		}
+
+		// the fee is valued at the fiat price of the asset at the time the
+		// trade settled.
+		if o.fiatPriceSvc != nil {
+			fiatPrice, err := o.fiatPriceSvc.GetPrice(
+				fee.Asset, collectedFee.CollectionTime,
+			)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to get fiat price of asset %s for trade %s: %w",
+					fee.Asset, fee.TradeID, err,
+				)
+			}
+			fiatValue := decimal.NewFromInt(int64(fee.Amount)).
+				Div(mathutil.BigOneDecimal).
+				Mul(fiatPrice)
+			fee.FiatValue = &fiatValue
+			totalFiat[fee.Asset] = totalFiat[fee.Asset].Add(fiatValue)
+		}
+
+		fees = append(fees, fee)
+		total[fee.Asset] += int64(fee.Amount)
+	}
+
+	report := &ReportMarketFee{
+		CollectedFees:              fees,
+		TotalCollectedFeesPerAsset: total,
+		TotalCollectedFeesFiat:     totalFiat,
+	}
+	if o.fiatPriceSvc != nil {
+		report.FiatCurrency = o.fiatPriceSvc.Currency()
+	}
+	return report, nil
+}
+
+func (o *operatorService) WithdrawMarketFunds(
+	ctx context.Context,
+	req WithdrawMarketReq,
+) ([]byte, error) {
+	leg, feeUnspents, milliSatPerByte, err := o.prepareWithdrawal(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	txHex, err := o.sendWithdrawal(
+		ctx, []withdrawalLeg{*leg}, feeUnspents, milliSatPerByte, req.Push,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	legs := []withdrawalLeg{*leg}
+	// only broadcasted withdrawals can be replaced with BumpWithdrawFee.
+	if req.Push {
+		tx, _ := transaction.NewTxFromHex(txHex)
+		o.withdrawals.add(
+			tx.TxHash().String(),
+			newWithdrawal(txHex, legs, feeUnspents, milliSatPerByte),
+		)
+	}
+	o.updateUtxoSetWithWithdrawal(txHex, legs)
+
+	rawTx, _ := hex.DecodeString(txHex)
+	return rawTx, nil
+}
+
+// EstimateWithdrawFee returns the virtual size of the transaction that
+// WithdrawMarketFunds would make for the given request, and the network fee
+// amount it would pay. The coins are selected exactly like for a real
+// withdrawal, but nothing is signed, broadcasted or persisted, not even the
+// change addresses. Errors like insufficient funds for paying the fees are
+// therefore returned without spending anything.
+func (o *operatorService) EstimateWithdrawFee(
+	ctx context.Context,
+	req WithdrawMarketReq,
+) (int, uint64, error) {
+	leg, feeUnspents, milliSatPerByte, err := o.prepareWithdrawal(ctx, req)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	vault, err := o.repoManager.VaultRepository().GetOrCreateVault(ctx, nil, "", nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	mnemonic, err := vault.GetMnemonicSafe()
+	if err != nil {
+		return 0, 0, err
+	}
+	marketAccount, err := vault.AccountByIndex(leg.accountIndex)
+	if err != nil {
+		return 0, 0, err
+	}
+	feeAccount, err := vault.AccountByIndex(domain.FeeAccount)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// the changes are sent to the script of one of the coins of the same
+	// account, rather than to new internal addresses, since the size of the
+	// transaction doesn't depend on it.
+	changePath := marketAccount.DerivationPathByScript[hex.EncodeToString(leg.unspents[0].Script())]
+	changePathsByAsset := map[string]string{}
+	for _, asset := range getAssetsOfOutputs(leg.outputs) {
+		changePathsByAsset[asset] = changePath
+	}
+	feeChangePath := feeAccount.DerivationPathByScript[hex.EncodeToString(feeUnspents[0].Script())]
+
+	return estimateSendToMany(sendToManyOpts{
+		mnemonic:              mnemonic,
+		walletOpts:            vault.WalletOpts,
+		unspents:              leg.unspents,
+		feeUnspents:           feeUnspents,
+		outputs:               leg.outputs,
+		outputsBlindingKeys:   leg.outputsBlindingKeys,
+		changePathsByAsset:    changePathsByAsset,
+		feeChangePathByAsset:  map[string]string{o.network.AssetID: feeChangePath},
+		inputPathsByScript:    marketAccount.DerivationPathByScript,
+		feeInputPathsByScript: feeAccount.DerivationPathByScript,
+		milliSatPerByte:       milliSatPerByte,
+		network:               o.network,
+		replaceable:           true,
+		dustThreshold:         o.dustThreshold,
+		coinSelector:          o.coinSelector,
+	})
+}
+
+// prepareWithdrawal validates the given withdrawal request and returns the
+// withdrawal leg of the market, along with the unspents of the fee account and
+// the fee rate to pay network fees with.
+func (o *operatorService) prepareWithdrawal(
+	ctx context.Context,
+	req WithdrawMarketReq,
+) (*withdrawalLeg, []explorer.Utxo, int, error) {
+	if req.BaseAsset != o.marketBaseAsset {
+		return nil, nil, 0, domain.ErrMarketInvalidBaseAsset
+	}
+	req, err := o.resolveWithdrawalAmounts(req)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	market, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		req.QuoteAsset,
+	)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	if accountIndex < 0 {
+		return nil, nil, 0, ErrMarketNotExist
+	}
+
+	if err := o.checkMarketReserve(ctx, market, req); err != nil {
+		return nil, nil, 0, err
+	}
+
+	outputs, outputsBlindingKeys, err := parseRequestOutputs(withdrawalOutputs(req), o.network)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	marketUnspents, err := o.getAllUnspentsForAccount(ctx, market.AccountIndex)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if len(marketUnspents) <= 0 {
+		return nil, nil, 0, ErrWalletNotFunded
+	}
+
+	feeUnspents, err := o.getAllUnspentsForAccount(ctx, domain.FeeAccount)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if len(feeUnspents) <= 0 {
+		return nil, nil, 0, ErrWalletNotFunded
+	}
+
+	milliSatPerByte, err := o.withdrawalFeeRate.apply(int(req.MillisatPerByte))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	leg := &withdrawalLeg{
+		accountIndex:        market.AccountIndex,
+		unspents:            marketUnspents,
+		outputs:             outputs,
+		outputsBlindingKeys: outputsBlindingKeys,
+	}
+	return leg, feeUnspents, milliSatPerByte, nil
+}
+
+// WithdrawMultiple sends the funds requested from several markets with a
+// single transaction, so that network fees are paid only once by the fee
+// account. The coins of each market fund only its own outputs and receive the
+// changes back. The highest fee rate among the requests is used and the
+// transaction is always broadcasted and can be replaced with BumpWithdrawFee
+// like a single market withdrawal.
+// Nothing is sent if any of the markets can't afford its withdrawal.
+func (o *operatorService) WithdrawMultiple(
+	ctx context.Context,
+	reqs []WithdrawMarketReq,
+) (string, error) {
+	if len(reqs) <= 0 {
+		return "", ErrNoWithdrawals
+	}
+
+	withdrawnMarkets := make(map[string]bool)
+	resolvedReqs := make([]WithdrawMarketReq, 0, len(reqs))
+	for _, req := range reqs {
+		if req.BaseAsset != o.marketBaseAsset {
+			return "", domain.ErrMarketInvalidBaseAsset
+		}
+		if withdrawnMarkets[req.QuoteAsset] {
+			return "", ErrDuplicatedWithdrawal
+		}
+		withdrawnMarkets[req.QuoteAsset] = true
+
+		req, err := o.resolveWithdrawalAmounts(req)
+		if err != nil {
+			return "", err
+		}
+		resolvedReqs = append(resolvedReqs, req)
+	}
+	reqs = resolvedReqs
+
+	legs := make([]withdrawalLeg, 0, len(reqs))
+	milliSatPerByte := o.withdrawalFeeRate.MinMilliSatPerByte
+	for _, req := range reqs {
+		feeRate, err := o.withdrawalFeeRate.apply(int(req.MillisatPerByte))
+		if err != nil {
+			return "", err
+		}
+		if feeRate > milliSatPerByte {
+			milliSatPerByte = feeRate
+		}
+
+		market, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+			ctx,
+			req.QuoteAsset,
+		)
+		if err != nil {
+			return "", err
+		}
+		if accountIndex < 0 {
+			return "", ErrMarketNotExist
+		}
+
+		if err := o.checkMarketReserve(ctx, market, req); err != nil {
+			return "", err
+		}
+
+		outs := withdrawalOutputs(req)
+		if len(outs) <= 0 {
+			return "", ErrEmptyWithdrawal
+		}
+
+		marketUnspents, err := o.getAllUnspentsForAccount(ctx, market.AccountIndex)
+		if err != nil {
+			return "", err
+		}
+		balances := make(map[string]uint64)
+		for _, u := range marketUnspents {
+			balances[u.Asset()] += u.Value()
+		}
+		for _, out := range outs {
+			if balance := balances[out.Asset]; balance < uint64(out.Value) {
+				return "", fmt.Errorf(
+					"%w: market %s has %d of asset %s, %d requested",
+					ErrWithdrawInsufficientBalance,
+					req.QuoteAsset, balance, out.Asset, out.Value,
+				)
+			}
+		}
+
+		outputs, outputsBlindingKeys, err := parseRequestOutputs(outs, o.network)
+		if err != nil {
+			return "", err
+		}
+
+		legs = append(legs, withdrawalLeg{
+			accountIndex:        market.AccountIndex,
+			unspents:            marketUnspents,
+			outputs:             outputs,
+			outputsBlindingKeys: outputsBlindingKeys,
+		})
+	}
+
+	feeUnspents, err := o.getAllUnspentsForAccount(ctx, domain.FeeAccount)
+	if err != nil {
+		return "", err
+	}
+	if len(feeUnspents) <= 0 {
+		return "", ErrWalletNotFunded
+	}
+
+	txHex, err := o.sendWithdrawal(ctx, legs, feeUnspents, milliSatPerByte, true)
+	if err != nil {
+		return "", err
+	}
+
+	tx, _ := transaction.NewTxFromHex(txHex)
+	txid := tx.TxHash().String()
+	o.withdrawals.add(
+		txid, newWithdrawal(txHex, legs, feeUnspents, milliSatPerByte),
+	)
+	o.updateUtxoSetWithWithdrawal(txHex, legs)
+
+	return txid, nil
+}
+
+// withdrawalOutputs returns the outputs paying the balance to withdraw to the
+// address of the given request.
+func withdrawalOutputs(req WithdrawMarketReq) []TxOut {
+	outs := make([]TxOut, 0)
+	if req.BalanceToWithdraw.BaseAmount > 0 {
+		outs = append(outs, TxOut{
+			Asset:   req.BaseAsset,
+			Value:   int64(req.BalanceToWithdraw.BaseAmount),
+			Address: req.Address,
+		})
+	}
+	if req.BalanceToWithdraw.QuoteAmount > 0 {
+		outs = append(outs, TxOut{
+			Asset:   req.QuoteAsset,
+			Value:   int64(req.BalanceToWithdraw.QuoteAmount),
+			Address: req.Address,
+		})
+	}
+	return outs
+}
+
+// BumpWithdrawFee replaces a still unconfirmed withdrawal of one or more
+// markets with a new transaction that spends the same market coins and pays a
+// higher network fee rate. Additional fee account coins are selected if needed.
+// The replacement is broadcasted and its txid returned.
+func (o *operatorService) BumpWithdrawFee(
+	ctx context.Context,
+	txid string,
+	newMilliSatPerByte int64,
+) (string, error) {
+	wd, ok := o.withdrawals.get(txid)
+	if !ok {
+		return "", ErrWithdrawalNotFound
+	}
+	if int(newMilliSatPerByte) <= wd.milliSatPerByte {
+		return "", ErrFeeRateNotIncreased
+	}
+
+	confirmed, err := explorer.WithContext(ctx, o.explorerSvc).
+		IsTransactionConfirmed(txid)
+	if err != nil {
+		return "", err
+	}
+	if confirmed {
+		o.withdrawals.remove(txid)
+		return "", ErrWithdrawalConfirmed
+	}
+
+	feeUnspents, err := o.getAllUnspentsForAccount(ctx, domain.FeeAccount)
+	if err != nil {
+		return "", err
+	}
+	// the fee change of the replaced tx can't fund its replacement.
+	feeUnspents = mergeUnspents(wd.feeUnspents, unspentsNotFromTx(feeUnspents, txid))
+
+	txHex, err := o.sendWithdrawal(
+		ctx, wd.legs, feeUnspents, int(newMilliSatPerByte), true,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	tx, _ := transaction.NewTxFromHex(txHex)
+	newTxid := tx.TxHash().String()
+
+	// the outputs of the replaced tx won't ever exist, therefore they must be
+	// removed from the utxo set, if already added.
+	replacedTx, _ := transaction.NewTxFromHex(wd.txHex)
+	replacedUnspents := make([]domain.UnspentKey, 0, len(replacedTx.Outputs))
+	for i := range replacedTx.Outputs {
+		replacedUnspents = append(replacedUnspents, domain.UnspentKey{
+			TxID: txid,
+			VOut: uint32(i),
+		})
+	}
+	spendUnspentsAsync(o.repoManager.UnspentRepository(), replacedUnspents)
+
+	o.withdrawals.remove(txid)
+	o.withdrawals.add(
+		newTxid,
+		newWithdrawal(txHex, wd.legs, feeUnspents, int(newMilliSatPerByte)),
+	)
+	o.updateUtxoSetWithWithdrawal(txHex, wd.legs)
+
+	return newTxid, nil
+}
+
+// updateUtxoSetWithWithdrawal adds the changes of the given withdrawal tx to
+// the utxo set and marks the coins it spends as spent, for every market
+// account involved, without waiting for the crawler to notice the tx.
+func (o *operatorService) updateUtxoSetWithWithdrawal(
+	txHex string,
+	legs []withdrawalLeg,
+) {
+	go func() {
+		for _, leg := range legs {
+			extractUnspentsFromTxAndUpdateUtxoSet(
+				o.repoManager.UnspentRepository(),
+				o.repoManager.VaultRepository(),
+				o.network,
+				txHex,
+				leg.accountIndex,
+			)
+		}
+	}()
+}
+
+// withdrawalLeg is the part of a withdrawal funded by the coins of a single
+// market account.
+type withdrawalLeg struct {
+	accountIndex        int
+	unspents            []explorer.Utxo
+	outputs             []*transaction.TxOutput
+	outputsBlindingKeys [][]byte
+}
+
+// sendWithdrawal derives new change addresses for the market accounts of the
+// given legs and the fee one, and returns the signed replaceable transaction
+// that sends the outputs of all legs. The transaction is also broadcasted if
+// push is true.
+func (o *operatorService) sendWithdrawal(
+	ctx context.Context,
+	legs []withdrawalLeg,
+	feeUnspents []explorer.Utxo,
+	milliSatPerByte int,
+	push bool,
+) (string, error) {
+	lockTime, err := antiFeeSnipingLockTime(o.antiFeeSniping, o.explorerSvc)
+	if err != nil {
+		return "", err
+	}
+
+	var txHex string
+
+	if err := o.repoManager.VaultRepository().UpdateVault(
+		ctx,
+		func(v *domain.Vault) (*domain.Vault, error) {
+			mnemonic, err := v.GetMnemonicSafe()
+			if err != nil {
+				return nil, err
+			}
+			feeAccount, err := v.AccountByIndex(domain.FeeAccount)
+			if err != nil {
+				return nil, err
+			}
+
+			txLegs := make([]sendToManyLeg, 0, len(legs))
+			for _, leg := range legs {
+				marketAccount, err := v.AccountByIndex(leg.accountIndex)
+				if err != nil {
+					return nil, err
+				}
+
+				changePathsByAsset := map[string]string{}
+				for _, asset := range getAssetsOfOutputs(leg.outputs) {
+					info, err := v.DeriveNextInternalAddressForAccount(leg.accountIndex)
+					if err != nil {
+						return nil, err
+					}
+
+					derivationPath := marketAccount.DerivationPathByScript[info.Script]
+					changePathsByAsset[asset] = derivationPath
+				}
+
+				txLegs = append(txLegs, sendToManyLeg{
+					unspents:            leg.unspents,
+					outputs:             leg.outputs,
+					outputsBlindingKeys: leg.outputsBlindingKeys,
+					changePathsByAsset:  changePathsByAsset,
+					inputPathsByScript:  marketAccount.DerivationPathByScript,
+				})
+			}
+
+			feeChangePathByAsset := map[string]string{}
+			feeInfo, err := v.DeriveNextInternalAddressForAccount(domain.FeeAccount)
+			if err != nil {
+				return nil, err
+			}
+			feeChangePathByAsset[o.network.AssetID] =
+				feeAccount.DerivationPathByScript[feeInfo.Script]
+
+			_txHex, err := sendToMany(sendToManyOpts{
+				mnemonic:              mnemonic,
+				walletOpts:            v.WalletOpts,
+				unspents:              txLegs[0].unspents,
+				feeUnspents:           feeUnspents,
+				outputs:               txLegs[0].outputs,
+				outputsBlindingKeys:   txLegs[0].outputsBlindingKeys,
+				changePathsByAsset:    txLegs[0].changePathsByAsset,
+				feeChangePathByAsset:  feeChangePathByAsset,
+				inputPathsByScript:    txLegs[0].inputPathsByScript,
+				feeInputPathsByScript: feeAccount.DerivationPathByScript,
+				milliSatPerByte:       milliSatPerByte,
+				network:               o.network,
+				replaceable:           true,
+				otherLegs:             txLegs[1:],
+				signer:                o.signer,
+				dustThreshold:         o.dustThreshold,
+				lockTime:              lockTime,
+				coinSelector:          o.coinSelector,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if push {
+				if _, err := o.explorerSvc.BroadcastTransaction(_txHex); err != nil {
+					return nil, err
+				}
+			}
+
+			txHex = _txHex
+
+			return v, nil
+		},
+	); err != nil {
+		return "", err
+	}
+
+	return txHex, nil
+}
+
+// checkMarketReserve makes sure that the market's unlocked balance, net of the
+// requested withdrawal, does not drop below the given minimum reserve for
+// either asset.
+func (o *operatorService) checkMarketReserve(
+	ctx context.Context,
+	market *domain.Market,
+	req WithdrawMarketReq,
+) error {
+	if req.MinReserve.BaseAmount == 0 && req.MinReserve.QuoteAmount == 0 {
+		return nil
+	}
+
+	info, err := o.repoManager.VaultRepository().GetAllDerivedAddressesInfoForAccount(
+		ctx,
+		market.AccountIndex,
+	)
+	if err != nil {
+		return err
+	}
+	addresses := info.Addresses()
+
+	for _, r := range []struct {
+		asset   string
+		amount  uint64
+		reserve uint64
+		name    string
+	}{
+		{
+			market.BaseAsset,
+			req.BalanceToWithdraw.BaseAmount,
+			req.MinReserve.BaseAmount,
+			"base",
+		},
+		{
+			market.QuoteAsset,
+			req.BalanceToWithdraw.QuoteAmount,
+			req.MinReserve.QuoteAmount,
+			"quote",
+		},
+	} {
+		if r.reserve == 0 {
+			continue
+		}
+
+		balance, err := o.repoManager.UnspentRepository().GetUnlockedBalance(
+			ctx,
+			addresses,
+			r.asset,
+		)
+		if err != nil {
+			return err
+		}
+
+		if r.amount > balance || balance-r.amount < r.reserve {
+			var remaining uint64
+			if r.amount < balance {
+				remaining = balance - r.amount
+			}
+			return fmt.Errorf(
+				"%w: %s asset balance would be %d, min reserve is %d",
+				ErrWithdrawBelowReserve, r.name, remaining, r.reserve,
+			)
+		}
+	}
 
-	if accountIndex < 0 {
-		return nil, ErrMarketNotExist
-	}
+	return nil
+}
 
-	allInfo, err := o.repoManager.VaultRepository().GetAllDerivedExternalAddressesInfoForAccount(
+func (o *operatorService) FeeAccountBalance(ctx context.Context) (
+	int64,
+	error,
+) {
+	info, err := o.repoManager.VaultRepository().GetAllDerivedAddressesInfoForAccount(
 		ctx,
-		accountIndex,
+		domain.FeeAccount,
 	)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return allInfo.Addresses(), nil
+	baseAssetAmount, err := o.repoManager.UnspentRepository().GetBalance(
+		ctx,
+		info.Addresses(),
+		o.marketBaseAsset,
+	)
+	if err != nil {
+		return -1, err
+	}
+	return int64(baseAssetAmount), nil
 }
 
-// ListMarket a set of informations about all the markets.
-func (o *operatorService) ListMarket(
+// TotalBalance returns the balance of the fee account and of all the markets,
+// aggregated by asset. Locked unspents are included, while unconfirmed ones
+// are counted only in the unconfirmed and total balances.
+func (o *operatorService) TotalBalance(
 	ctx context.Context,
-) ([]MarketInfo, error) {
+) (map[string]BalanceInfo, error) {
 	markets, err := o.repoManager.MarketRepository().GetAllMarkets(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	marketInfo := make([]MarketInfo, 0, len(markets))
-	for _, market := range markets {
-		marketInfo = append(marketInfo, MarketInfo{
-			AccountIndex: uint64(market.AccountIndex),
-			Market: Market{
-				BaseAsset:  market.BaseAsset,
-				QuoteAsset: market.QuoteAsset,
-			},
-			Tradable:     market.Tradable,
-			StrategyType: market.Strategy.Type,
-			Price:        market.Price,
-			Fee: Fee{
-				BasisPoint:    market.Fee,
-				FixedBaseFee:  market.FixedFee.BaseFee,
-				FixedQuoteFee: market.FixedFee.QuoteFee,
-			},
-		})
+	accounts := make([]int, 0, len(markets)+1)
+	accounts = append(accounts, domain.FeeAccount)
+	for _, m := range markets {
+		accounts = append(accounts, m.AccountIndex)
 	}
 
-	return marketInfo, nil
-}
+	addresses := make([]string, 0)
+	for _, account := range accounts {
+		info, err := o.repoManager.VaultRepository().
+			GetAllDerivedAddressesInfoForAccount(ctx, account)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, info.Addresses()...)
+	}
 
-func (o *operatorService) GetCollectedMarketFee(
-	ctx context.Context,
-	market Market,
-) (*ReportMarketFee, error) {
-	m, _, err := o.repoManager.MarketRepository().GetMarketByAsset(
+	// unconfirmed unspents are returned only along with the spent ones, which
+	// are skipped here.
+	unspents, err := o.repoManager.UnspentRepository().GetAllUnspentsForAddresses(
 		ctx,
-		market.QuoteAsset,
+		addresses,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	if m == nil {
-		return nil, ErrMarketNotExist
+	balances := make(map[string]BalanceInfo)
+	for _, u := range unspents {
+		if u.IsSpent() {
+			continue
+		}
+		balance := balances[u.AssetHash]
+		balance.TotalBalance += u.Value
+		if u.IsConfirmed() {
+			balance.ConfirmedBalance += u.Value
+		} else {
+			balance.UnconfirmedBalance += u.Value
+		}
+		balances[u.AssetHash] = balance
 	}
+	return balances, nil
+}
 
-	trades, err := o.repoManager.TradeRepository().GetCompletedTradesByMarket(
-		ctx,
-		market.QuoteAsset,
-	)
+func (o *operatorService) ListUtxos(
+	ctx context.Context,
+) (map[uint64]UtxoInfoList, error) {
+	utxoInfoPerAccount := make(map[uint64]UtxoInfoList)
+
+	unspents := o.repoManager.UnspentRepository().GetAllUnspents(ctx)
+	for _, u := range unspents {
+		_, accountIndex, err := o.repoManager.VaultRepository().GetAccountByAddress(
+			ctx,
+			u.Address,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		utxoInfo := utxoInfoPerAccount[uint64(accountIndex)]
+		utxoInfo.add(u)
+		utxoInfoPerAccount[uint64(accountIndex)] = utxoInfo
+	}
+
+	return utxoInfoPerAccount, nil
+}
+
+// ListLockedUtxos returns the locked utxos of all accounts along with the
+// trade holding the lock and the time it was acquired, that is when the trade
+// was accepted.
+func (o *operatorService) ListLockedUtxos(
+	ctx context.Context,
+) ([]UtxoInfo, error) {
+	trades, err := o.repoManager.TradeRepository().GetAllTrades(ctx)
 	if err != nil {
 		return nil, err
 	}
-
-	fees := make([]FeeInfo, 0, len(trades))
-	total := make(map[string]int64)
+	tradesByID := make(map[string]*domain.Trade, len(trades))
 	for _, trade := range trades {
-		feeBasisPoint := trade.MarketFee
-		swapRequest := trade.SwapRequestMessage()
-		feeAsset := swapRequest.GetAssetP()
-		amountP := swapRequest.GetAmountP()
-		_, feeAmount := mathutil.LessFee(amountP, uint64(feeBasisPoint))
-
-		marketPrice := trade.MarketPrice.QuotePrice
-		if feeAsset == m.BaseAsset {
-			marketPrice = trade.MarketPrice.BasePrice
-		}
-
-		fees = append(fees, FeeInfo{
-			TradeID:     trade.ID.String(),
-			BasisPoint:  feeBasisPoint,
-			Asset:       feeAsset,
-			Amount:      feeAmount,
-			MarketPrice: marketPrice,
-		})
+		tradesByID[trade.ID.String()] = trade
+	}
+
+	locks := make([]UtxoInfo, 0)
+	unspents := o.repoManager.UnspentRepository().GetAllUnspents(ctx)
+	for _, u := range unspents {
+		if u.IsSpent() || !u.IsLocked() {
+			continue
+		}
 
-		total[feeAsset] += int64(feeAmount)
+		info := newUtxoInfo(u)
+		if trade, ok := tradesByID[info.TradeID]; ok {
+			info.LockTimestamp = trade.SwapAccept.Timestamp
+		}
+		locks = append(locks, info)
 	}
 
-	return &ReportMarketFee{
-		CollectedFees:              fees,
-		TotalCollectedFeesPerAsset: total,
-	}, nil
+	return locks, nil
 }
 
-func (o *operatorService) WithdrawMarketFunds(
+// ListUtxosForAccount returns the unspents, spents and locks of the given
+// account that match the filter.
+func (o *operatorService) ListUtxosForAccount(
 	ctx context.Context,
-	req WithdrawMarketReq,
-) ([]byte, error) {
-	if req.BaseAsset != o.marketBaseAsset {
-		return nil, domain.ErrMarketInvalidBaseAsset
-	}
-
-	market, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
-		ctx,
-		req.QuoteAsset,
+	accountIndex uint64,
+	filter UtxoFilter,
+) (*UtxoInfoList, error) {
+	vault, err := o.repoManager.VaultRepository().GetOrCreateVault(
+		ctx, nil, "", nil,
 	)
 	if err != nil {
 		return nil, err
 	}
-
-	if accountIndex < 0 {
-		return nil, ErrMarketNotExist
+	if _, err := vault.AccountByIndex(int(accountIndex)); err != nil {
+		return nil, err
 	}
 
-	outs := make([]TxOut, 0)
-	if req.BalanceToWithdraw.BaseAmount > 0 {
-		outs = append(outs, TxOut{
-			Asset:   req.BaseAsset,
-			Value:   int64(req.BalanceToWithdraw.BaseAmount),
-			Address: req.Address,
-		})
-	}
-	if req.BalanceToWithdraw.QuoteAmount > 0 {
-		outs = append(outs, TxOut{
-			Asset:   req.QuoteAsset,
-			Value:   int64(req.BalanceToWithdraw.QuoteAmount),
-			Address: req.Address,
-		})
-	}
-	outputs, outputsBlindingKeys, err := parseRequestOutputs(outs)
+	info, err := vault.AllDerivedAddressesInfoForAccount(int(accountIndex))
 	if err != nil {
 		return nil, err
 	}
-
-	marketUnspents, err := o.getAllUnspentsForAccount(ctx, market.AccountIndex)
+	unspents, err := o.repoManager.UnspentRepository().GetAllUnspentsForAddresses(
+		ctx,
+		info.Addresses(),
+	)
 	if err != nil {
 		return nil, err
 	}
-	if len(marketUnspents) <= 0 {
-		return nil, ErrWalletNotFunded
+
+	utxoInfo := &UtxoInfoList{}
+	for _, u := range unspents {
+		if filter.match(u) {
+			utxoInfo.add(u)
+		}
 	}
+	return utxoInfo, nil
+}
 
-	feeUnspents, err := o.getAllUnspentsForAccount(ctx, domain.FeeAccount)
+// ReloadUtxos triggers reloading of unspents for stored addresses from blockchain
+func (o *operatorService) ReloadUtxos(ctx context.Context) error {
+	//get all addresses
+	vault, err := o.repoManager.VaultRepository().GetOrCreateVault(
+		ctx,
+		nil,
+		"",
+		nil,
+	)
 	if err != nil {
-		return nil, err
-	}
-	if len(feeUnspents) <= 0 {
-		return nil, ErrWalletNotFunded
+		return err
 	}
 
-	var txHex string
+	addressesInfo := vault.AllDerivedAddressesInfo()
+	return fetchAndAddUnspents(
+		o.explorerSvc,
+		o.repoManager.UnspentRepository(),
+		o.blockchainListener,
+		addressesInfo,
+	)
+}
+
+// RescanAccount re-derives the addresses of the given account up to the gap
+// limit and reconciles the stored utxo set with what's found on-chain: missing
+// unspents are added while those no longer unspent are marked as spent.
+func (o *operatorService) RescanAccount(
+	ctx context.Context,
+	accountIndex int,
+) (*RescanAccountResult, error) {
+	_, lastUsedIndex, err := o.discoverAccountForRescan(ctx, accountIndex)
+	if err != nil {
+		return nil, err
+	}
 
+	// derive those addresses that have been used but are not yet known by the
+	// vault, for example because the daemon crashed before persisting them.
+	var info domain.AddressesInfo
 	if err := o.repoManager.VaultRepository().UpdateVault(
 		ctx,
 		func(v *domain.Vault) (*domain.Vault, error) {
-			mnemonic, err := v.GetMnemonicSafe()
-			if err != nil {
-				return nil, err
-			}
-			marketAccount, err := v.AccountByIndex(market.AccountIndex)
+			account, err := v.AccountByIndex(accountIndex)
 			if err != nil {
 				return nil, err
 			}
-			feeAccount, err := v.AccountByIndex(domain.FeeAccount)
-			if err != nil {
-				return nil, err
-			}
-
-			changePathsByAsset := map[string]string{}
-			feeChangePathByAsset := map[string]string{}
-			for _, asset := range getAssetsOfOutputs(outputs) {
-				info, err := v.DeriveNextInternalAddressForAccount(market.AccountIndex)
-				if err != nil {
-					return nil, err
+			if lastUsedIndex != nil {
+				for account.LastExternalIndex <= lastUsedIndex.external {
+					if _, err := v.DeriveNextExternalAddressForAccount(
+						accountIndex,
+					); err != nil {
+						return nil, err
+					}
+				}
+				for account.LastInternalIndex <= lastUsedIndex.internal {
+					if _, err := v.DeriveNextInternalAddressForAccount(
+						accountIndex,
+					); err != nil {
+						return nil, err
+					}
 				}
-
-				derivationPath := marketAccount.DerivationPathByScript[info.Script]
-				changePathsByAsset[asset] = derivationPath
-			}
-
-			feeInfo, err := v.DeriveNextInternalAddressForAccount(domain.FeeAccount)
-			if err != nil {
-				return nil, err
 			}
-			feeChangePathByAsset[o.network.AssetID] =
-				feeAccount.DerivationPathByScript[feeInfo.Script]
 
-			_txHex, err := sendToMany(sendToManyOpts{
-				mnemonic:              mnemonic,
-				unspents:              marketUnspents,
-				feeUnspents:           feeUnspents,
-				outputs:               outputs,
-				outputsBlindingKeys:   outputsBlindingKeys,
-				changePathsByAsset:    changePathsByAsset,
-				feeChangePathByAsset:  feeChangePathByAsset,
-				inputPathsByScript:    marketAccount.DerivationPathByScript,
-				feeInputPathsByScript: feeAccount.DerivationPathByScript,
-				milliSatPerByte:       int(req.MillisatPerByte),
-				network:               o.network,
-			})
+			info, err = v.AllDerivedAddressesInfoForAccount(accountIndex)
 			if err != nil {
 				return nil, err
 			}
-
-			if req.Push {
-				if _, err := o.explorerSvc.BroadcastTransaction(_txHex); err != nil {
-					return nil, err
-				}
-			}
-
-			txHex = _txHex
-
 			return v, nil
 		},
 	); err != nil {
 		return nil, err
 	}
 
-	go extractUnspentsFromTxAndUpdateUtxoSet(
-		o.repoManager.UnspentRepository(),
-		o.repoManager.VaultRepository(),
-		o.network,
-		txHex,
-		market.AccountIndex,
+	unspentsToAdd, unspentsToSpend, err := o.diffAccountUnspents(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+
+	unspentRepo := o.repoManager.UnspentRepository()
+	if len(unspentsToAdd) > 0 {
+		if err := unspentRepo.AddUnspents(ctx, unspentsToAdd); err != nil {
+			return nil, err
+		}
+		go startObserveUnconfirmedUnspents(o.blockchainListener, unspentsToAdd)
+	}
+	spentCount := 0
+	if len(unspentsToSpend) > 0 {
+		keys := make([]domain.UnspentKey, 0, len(unspentsToSpend))
+		for _, u := range unspentsToSpend {
+			keys = append(keys, u.Key())
+		}
+		if spentCount, err = unspentRepo.SpendUnspents(ctx, keys); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Debugf(
+		"rescan of account %d: added %d unspents, spent %d unspents",
+		accountIndex, len(unspentsToAdd), spentCount,
 	)
 
-	rawTx, _ := hex.DecodeString(txHex)
-	return rawTx, nil
+	return &RescanAccountResult{
+		AddedUnspents: len(unspentsToAdd),
+		SpentUnspents: spentCount,
+	}, nil
 }
 
-func (o *operatorService) FeeAccountBalance(ctx context.Context) (
-	int64,
-	error,
-) {
-	info, err := o.repoManager.VaultRepository().GetAllDerivedAddressesInfoForAccount(
-		ctx,
-		domain.FeeAccount,
+// RescanAccountDryRun returns the unspents that RescanAccount would add to
+// and mark as spent in the utxo set of the given account, without changing
+// either this or the addresses derived by the vault.
+func (o *operatorService) RescanAccountDryRun(
+	ctx context.Context,
+	accountIndex int,
+) (*RescanDiff, error) {
+	vault, lastUsedIndex, err := o.discoverAccountForRescan(ctx, accountIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	// the addresses are derived on a copy of the account, so that the vault
+	// is left untouched.
+	account, err := vault.AccountByIndex(accountIndex)
+	if err != nil {
+		return nil, err
+	}
+	accountCopy := *account
+	if lastUsedIndex != nil {
+		if accountCopy.LastExternalIndex <= lastUsedIndex.external {
+			accountCopy.LastExternalIndex = lastUsedIndex.external + 1
+		}
+		if accountCopy.LastInternalIndex <= lastUsedIndex.internal {
+			accountCopy.LastInternalIndex = lastUsedIndex.internal + 1
+		}
+	}
+	vaultCopy := *vault
+	vaultCopy.Accounts = map[int]*domain.Account{accountIndex: &accountCopy}
+	info, err := vaultCopy.AllDerivedAddressesInfoForAccount(accountIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	unspentsToAdd, unspentsToSpend, err := o.diffAccountUnspents(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &RescanDiff{
+		UnspentsToAdd:   make([]UtxoInfo, 0, len(unspentsToAdd)),
+		UnspentsToSpend: make([]UtxoInfo, 0, len(unspentsToSpend)),
+	}
+	for _, u := range unspentsToAdd {
+		diff.UnspentsToAdd = appendUtxoInfo(diff.UnspentsToAdd, u)
+	}
+	for _, u := range unspentsToSpend {
+		diff.UnspentsToSpend = appendUtxoInfo(diff.UnspentsToSpend, u)
+	}
+	return diff, nil
+}
+
+// discoverAccountForRescan returns the vault, after making sure that the
+// given account exists, along with the indexes of the last addresses of the
+// account used on-chain.
+func (o *operatorService) discoverAccountForRescan(
+	ctx context.Context,
+	accountIndex int,
+) (*domain.Vault, *accountLastDerivedIndex, error) {
+	if accountIndex < 0 {
+		return nil, nil, domain.ErrInvalidAccount
+	}
+
+	vault, err := o.repoManager.VaultRepository().GetOrCreateVault(
+		ctx, nil, "", nil,
 	)
 	if err != nil {
-		return 0, err
+		return nil, nil, err
+	}
+	if _, err := vault.AccountByIndex(accountIndex); err != nil {
+		return nil, nil, err
+	}
+	mnemonic, err := vault.GetMnemonicSafe()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	baseAssetAmount, err := o.repoManager.UnspentRepository().GetBalance(
-		ctx,
-		info.Addresses(),
-		o.marketBaseAsset,
-	)
+	ww, err := vault.WalletOpts.NewWallet(mnemonic)
 	if err != nil {
-		return -1, err
+		return nil, nil, err
 	}
-	return int64(baseAssetAmount), nil
+	lastUsedIndex := discoverAccount(
+		ww, vault.WalletOpts, accountIndex, o.explorerSvc, vault.Network,
+	)
+	return vault, lastUsedIndex, nil
 }
 
-func (o *operatorService) ListUtxos(
+// diffAccountUnspents compares the unspents of the given addresses found
+// on-chain with the stored ones, and returns those missing from the utxo set
+// and those stored as unspent but no longer found on-chain.
+func (o *operatorService) diffAccountUnspents(
 	ctx context.Context,
-) (map[uint64]UtxoInfoList, error) {
-	utxoInfoPerAccount := make(map[uint64]UtxoInfoList)
+	info domain.AddressesInfo,
+) ([]domain.Unspent, []domain.Unspent, error) {
+	onchainUnspents, err := fetchUnspents(
+		explorer.WithContext(ctx, o.explorerSvc), info,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	storedUnspents, err := o.repoManager.UnspentRepository().
+		GetAllUnspentsForAddresses(ctx, info.Addresses())
+	if err != nil {
+		return nil, nil, err
+	}
 
-	unspents := o.repoManager.UnspentRepository().GetAllUnspents(ctx)
-	for _, u := range unspents {
-		_, accountIndex, err := o.repoManager.VaultRepository().GetAccountByAddress(
-			ctx,
-			u.Address,
-		)
-		if err != nil {
-			return nil, err
-		}
+	onchainKeys := make(map[domain.UnspentKey]bool)
+	for _, u := range onchainUnspents {
+		onchainKeys[u.Key()] = true
+	}
+	storedKeys := make(map[domain.UnspentKey]bool)
+	for _, u := range storedUnspents {
+		storedKeys[u.Key()] = true
+	}
 
-		utxoInfo := utxoInfoPerAccount[uint64(accountIndex)]
-		if u.Spent {
-			utxoInfo.Spents = appendUtxoInfo(utxoInfo.Spents, u)
-		} else if u.Locked {
-			utxoInfo.Locks = appendUtxoInfo(utxoInfo.Locks, u)
-		} else {
-			utxoInfo.Unspents = appendUtxoInfo(utxoInfo.Unspents, u)
+	unspentsToAdd := make([]domain.Unspent, 0)
+	for _, u := range onchainUnspents {
+		if !storedKeys[u.Key()] {
+			unspentsToAdd = append(unspentsToAdd, u)
 		}
-		utxoInfoPerAccount[uint64(accountIndex)] = utxoInfo
 	}
-
-	return utxoInfoPerAccount, nil
-}
-
-// ReloadUtxos triggers reloading of unspents for stored addresses from blockchain
-func (o *operatorService) ReloadUtxos(ctx context.Context) error {
-	//get all addresses
-	vault, err := o.repoManager.VaultRepository().GetOrCreateVault(
-		ctx,
-		nil,
-		"",
-		nil,
-	)
-	if err != nil {
-		return err
+	unspentsToSpend := make([]domain.Unspent, 0)
+	for _, u := range storedUnspents {
+		if !u.IsSpent() && !onchainKeys[u.Key()] {
+			unspentsToSpend = append(unspentsToSpend, u)
+		}
 	}
-
-	addressesInfo := vault.AllDerivedAddressesInfo()
-	return fetchAndAddUnspents(
-		o.explorerSvc,
-		o.repoManager.UnspentRepository(),
-		o.blockchainListener,
-		addressesInfo,
-	)
+	return unspentsToAdd, unspentsToSpend, nil
 }
 
 // ClaimMarketDeposit method add unspents to the market
@@ -990,6 +2894,292 @@ func (o *operatorService) ClaimFeeDeposit(
 	return o.claimDeposit(ctx, infoPerAccount, outpoints, feeDeposit)
 }
 
+// LabelMarketDeposit labels the given outpoints, that must be known unspents
+// sent to the receiving addresses of the market.
+func (o *operatorService) LabelMarketDeposit(
+	ctx context.Context,
+	marketReq Market,
+	outpoints []TxOutpoint,
+	label string,
+) error {
+	if err := validateMarketRequest(marketReq, o.marketBaseAsset); err != nil {
+		return err
+	}
+
+	market, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx, marketReq.QuoteAsset,
+	)
+	if err != nil {
+		return err
+	}
+	if accountIndex < 0 {
+		return ErrMarketNotExist
+	}
+
+	info, err := o.repoManager.VaultRepository().
+		GetAllDerivedExternalAddressesInfoForAccount(ctx, accountIndex)
+	if err != nil {
+		return err
+	}
+	addresses := make(map[string]bool)
+	for _, addr := range info.Addresses() {
+		addresses[addr] = true
+	}
+
+	for _, outpoint := range outpoints {
+		u, err := o.repoManager.UnspentRepository().GetUnspentWithKey(
+			ctx,
+			domain.UnspentKey{TxID: outpoint.Hash, VOut: uint32(outpoint.Index)},
+		)
+		if err != nil {
+			return err
+		}
+		if u == nil || !addresses[u.Address] {
+			return ErrUtxoNotFound
+		}
+
+		if err := market.LabelDeposit(domain.LabeledDeposit{
+			TxID:  u.TxID,
+			VOut:  u.VOut,
+			Asset: u.AssetHash,
+			Value: u.Value,
+			Label: label,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return o.repoManager.MarketRepository().UpdateMarket(
+		ctx,
+		accountIndex,
+		func(_ *domain.Market) (*domain.Market, error) {
+			return market, nil
+		},
+	)
+}
+
+// BalanceByLabel splits the current balance of the given market, asset by
+// asset, in proportion to the confirmed deposits of every label. Deposits
+// not labeled are grouped under the empty label. Shares are rounded down,
+// therefore their sum might be slightly lower than the market balance.
+func (o *operatorService) BalanceByLabel(
+	ctx context.Context,
+	marketReq Market,
+) (map[string]Balance, error) {
+	if err := validateMarketRequest(marketReq, o.marketBaseAsset); err != nil {
+		return nil, err
+	}
+
+	market, accountIndex, err := o.repoManager.MarketRepository().GetMarketByAsset(
+		ctx, marketReq.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if accountIndex < 0 {
+		return nil, ErrMarketNotExist
+	}
+
+	info, err := o.repoManager.VaultRepository().
+		GetAllDerivedAddressesInfoForAccount(ctx, accountIndex)
+	if err != nil {
+		return nil, err
+	}
+	balance := make(map[string]uint64)
+	for _, asset := range []string{market.BaseAsset, market.QuoteAsset} {
+		amount, err := o.repoManager.UnspentRepository().GetBalance(
+			ctx, info.Addresses(), asset,
+		)
+		if err != nil {
+			return nil, err
+		}
+		balance[asset] = amount
+	}
+
+	deposits, err := o.getMarketDeposits(ctx, market, accountIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	labelByKey := make(map[domain.UnspentKey]string)
+	for _, d := range market.LabeledDeposits {
+		labelByKey[domain.UnspentKey{TxID: d.TxID, VOut: d.VOut}] = d.Label
+	}
+	depositsByLabel := make(map[string]map[string]uint64)
+	totalDeposits := make(map[string]uint64)
+	for _, u := range deposits {
+		label := labelByKey[u.Key()]
+		if _, ok := depositsByLabel[label]; !ok {
+			depositsByLabel[label] = make(map[string]uint64)
+		}
+		depositsByLabel[label][u.AssetHash] += u.Value
+		totalDeposits[u.AssetHash] += u.Value
+	}
+
+	share := func(asset string, amount uint64) uint64 {
+		if totalDeposits[asset] == 0 {
+			return 0
+		}
+		return uint64(
+			decimal.NewFromInt(int64(balance[asset])).
+				Mul(decimal.NewFromInt(int64(amount))).
+				Div(decimal.NewFromInt(int64(totalDeposits[asset]))).
+				IntPart(),
+		)
+	}
+
+	balanceByLabel := make(map[string]Balance)
+	for label, amounts := range depositsByLabel {
+		balanceByLabel[label] = Balance{
+			BaseAmount:  share(market.BaseAsset, amounts[market.BaseAsset]),
+			QuoteAmount: share(market.QuoteAsset, amounts[market.QuoteAsset]),
+		}
+	}
+	return balanceByLabel, nil
+}
+
+// getMarketDeposits returns the confirmed unspents of the market assets sent
+// to the receiving addresses of the given market, excluding those resulting
+// from its trades. Spent ones are included.
+func (o *operatorService) getMarketDeposits(
+	ctx context.Context,
+	market *domain.Market,
+	accountIndex int,
+) ([]domain.Unspent, error) {
+	trades, err := o.repoManager.TradeRepository().GetCompletedTradesByMarket(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tradeTxIDs := make(map[string]bool)
+	for _, trade := range trades {
+		tradeTxIDs[trade.TxID] = true
+	}
+
+	info, err := o.repoManager.VaultRepository().
+		GetAllDerivedExternalAddressesInfoForAccount(ctx, accountIndex)
+	if err != nil {
+		return nil, err
+	}
+	unspents, err := o.repoManager.UnspentRepository().
+		GetAllUnspentsForAddresses(ctx, info.Addresses())
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make([]domain.Unspent, 0, len(unspents))
+	for _, u := range unspents {
+		if !u.Confirmed || tradeTxIDs[u.TxID] {
+			continue
+		}
+		if u.AssetHash != market.BaseAsset && u.AssetHash != market.QuoteAsset {
+			continue
+		}
+		deposits = append(deposits, u)
+	}
+	return deposits, nil
+}
+
+func (o *operatorService) ImportUtxo(
+	ctx context.Context,
+	accountIndex int,
+	outpoint TxOutpoint,
+	blindingKey []byte,
+) error {
+	if accountIndex < domain.MarketAccountStart {
+		return ErrMarketNotExist
+	}
+	if len(blindingKey) <= 0 {
+		return ErrMissingBlindingKey
+	}
+
+	market, err := o.repoManager.MarketRepository().GetMarketByAccount(
+		ctx, accountIndex,
+	)
+	if err != nil {
+		return err
+	}
+	if market == nil {
+		return ErrMarketNotExist
+	}
+
+	info, err := o.repoManager.VaultRepository().GetAllDerivedAddressesInfoForAccount(
+		ctx, accountIndex,
+	)
+	if err != nil {
+		return err
+	}
+	infoByScript := groupAddressesInfoByScript(info)
+
+	unspentRepo := o.repoManager.UnspentRepository()
+	key := domain.UnspentKey{TxID: outpoint.Hash, VOut: uint32(outpoint.Index)}
+	if u, _ := unspentRepo.GetUnspentWithKey(ctx, key); u != nil {
+		return ErrUtxoAlreadyExists
+	}
+
+	blockHeight, blockHash, err := getTxBlock(
+		explorer.WithContext(ctx, o.explorerSvc), outpoint.Hash,
+	)
+	if err != nil {
+		return err
+	}
+
+	tx, err := explorer.WithContext(ctx, o.explorerSvc).
+		GetTransaction(outpoint.Hash)
+	if err != nil {
+		return err
+	}
+	if outpoint.Index < 0 || len(tx.Outputs()) <= outpoint.Index {
+		return ErrInvalidOutpoint
+	}
+
+	txOut := tx.Outputs()[outpoint.Index]
+	addrInfo, ok := infoByScript[hex.EncodeToString(txOut.Script)]
+	if !ok {
+		return ErrUtxoNotOwned
+	}
+
+	unconfidential, ok := BlinderManager.UnblindOutput(txOut, blindingKey)
+	if !ok {
+		return errors.New("unable to unblind output")
+	}
+	unspent := unspentFromTxOutput(
+		outpoint, txOut, unconfidential, addrInfo.Address, blockHeight, blockHash,
+	)
+
+	// the imported output might be the last one missing to fund the market.
+	if !market.IsFunded() {
+		unspents, err := unspentRepo.GetUnspentsForAddresses(
+			ctx, info.Addresses(),
+		)
+		if err != nil {
+			return err
+		}
+		if err := o.fundMarket(
+			accountIndex, append(unspents, unspent),
+		); err != nil {
+			if err != domain.ErrMarketMissingBaseAsset &&
+				err != domain.ErrMarketMissingQuoteAsset {
+				return err
+			}
+		} else {
+			log.Infof("funded market with account %d", accountIndex)
+		}
+	}
+
+	if err := unspentRepo.AddUnspents(ctx, []domain.Unspent{unspent}); err != nil {
+		return err
+	}
+
+	log.Infof(
+		"imported utxo %s:%d into market with account %d",
+		outpoint.Hash, outpoint.Index, accountIndex,
+	)
+	return nil
+}
+
 func (o *operatorService) getNonFundedMarkets(ctx context.Context) ([]domain.Market, error) {
 	markets, err := o.repoManager.MarketRepository().GetAllMarkets(ctx)
 	if err != nil {
@@ -1028,15 +3218,15 @@ func (o *operatorService) claimDeposit(
 	counter := make(map[int]int)
 	unspents := make([]domain.Unspent, len(outpoints), len(outpoints))
 	for i, v := range outpoints {
-		confirmed, err := o.explorerSvc.IsTransactionConfirmed(v.Hash)
+		blockHeight, blockHash, err := getTxBlock(
+			explorer.WithContext(ctx, o.explorerSvc), v.Hash,
+		)
 		if err != nil {
 			return err
 		}
-		if !confirmed {
-			return ErrTxNotConfirmed
-		}
 
-		tx, err := o.explorerSvc.GetTransaction(v.Hash)
+		tx, err := explorer.WithContext(ctx, o.explorerSvc).
+			GetTransaction(v.Hash)
 		if err != nil {
 			return err
 		}
@@ -1058,22 +3248,9 @@ func (o *operatorService) claimDeposit(
 				return errors.New("unable to unblind output")
 			}
 
-			unspents[i] = domain.Unspent{
-				TxID:            v.Hash,
-				VOut:            uint32(v.Index),
-				Value:           unconfidential.Value,
-				AssetHash:       unconfidential.AssetHash,
-				ValueCommitment: bufferutil.CommitmentFromBytes(txOut.Value),
-				AssetCommitment: bufferutil.CommitmentFromBytes(txOut.Asset),
-				ValueBlinder:    unconfidential.ValueBlinder,
-				AssetBlinder:    unconfidential.AssetBlinder,
-				ScriptPubKey:    txOut.Script,
-				Nonce:           txOut.Nonce,
-				RangeProof:      make([]byte, 1),
-				SurjectionProof: make([]byte, 1),
-				Address:         info.Address,
-				Confirmed:       true,
-			}
+			unspents[i] = unspentFromTxOutput(
+				v, txOut, unconfidential, info.Address, blockHeight, blockHash,
+			)
 		}
 	}
 
@@ -1104,6 +3281,55 @@ func (o *operatorService) claimDeposit(
 	return ErrInvalidOutpoints
 }
 
+// unspentFromTxOutput returns the unspent for the given unblinded tx output,
+// confirmed in the block at the given height. Proofs are not stored since
+// they're not needed for spending it.
+func unspentFromTxOutput(
+	outpoint TxOutpoint,
+	txOut *transaction.TxOutput,
+	unconfidential UnblindedResult,
+	address string,
+	blockHeight uint64,
+	blockHash string,
+) domain.Unspent {
+	return domain.Unspent{
+		TxID:            outpoint.Hash,
+		VOut:            uint32(outpoint.Index),
+		Value:           unconfidential.Value,
+		AssetHash:       unconfidential.AssetHash,
+		ValueCommitment: bufferutil.CommitmentFromBytes(txOut.Value),
+		AssetCommitment: bufferutil.CommitmentFromBytes(txOut.Asset),
+		ValueBlinder:    unconfidential.ValueBlinder,
+		AssetBlinder:    unconfidential.AssetBlinder,
+		ScriptPubKey:    txOut.Script,
+		Nonce:           txOut.Nonce,
+		RangeProof:      make([]byte, 1),
+		SurjectionProof: make([]byte, 1),
+		Address:         address,
+		Confirmed:       true,
+		BlockHeight:     blockHeight,
+		BlockHash:       blockHash,
+	}
+}
+
+// getTxBlock returns the height and the hash of the block including the given
+// tx, or ErrTxNotConfirmed if not yet confirmed.
+func getTxBlock(
+	explorerSvc explorer.Service,
+	txid string,
+) (uint64, string, error) {
+	status, err := explorerSvc.GetTransactionStatus(txid)
+	if err != nil {
+		return 0, "", err
+	}
+	if confirmed, _ := status["confirmed"].(bool); !confirmed {
+		return 0, "", ErrTxNotConfirmed
+	}
+	blockHeight, _ := status["block_height"].(float64)
+	blockHash, _ := status["block_hash"].(string)
+	return uint64(blockHeight), blockHash, nil
+}
+
 func (o *operatorService) fundMarket(
 	accountIndex int,
 	unspents []domain.Unspent,
@@ -1167,6 +3393,7 @@ func (o *operatorService) getAllUnspentsForAccount(
 	if err != nil {
 		return nil, err
 	}
+	unspents = selectableUnspents(unspents)
 
 	utxos := make([]explorer.Utxo, 0, len(unspents))
 	for _, u := range unspents {
@@ -1248,7 +3475,9 @@ func tradeToTradeInfo(
 				QuoteAsset: trade.MarketQuoteAsset,
 			},
 			Fee{
-				BasisPoint: trade.MarketFee,
+				BasisPoint:    trade.MarketFee,
+				FixedBaseFee:  trade.MarketFixedBaseFee,
+				FixedQuoteFee: trade.MarketFixedQuoteFee,
 			},
 		},
 		Price:            Price(trade.MarketPrice),
@@ -1259,12 +3488,14 @@ func tradeToTradeInfo(
 		ExpiryTimeUnix:   trade.ExpiryTime,
 	}
 
-	if req := trade.SwapRequestMessage(); req != nil {
+	req := trade.SwapRequestMessage()
+	if req != nil {
 		info.SwapInfo = SwapInfo{
-			AssetP:  req.GetAssetP(),
-			AmountP: req.GetAmountP(),
-			AssetR:  req.GetAssetR(),
-			AmountR: req.GetAmountR(),
+			AssetP:   req.GetAssetP(),
+			AmountP:  req.GetAmountP(),
+			AssetR:   req.GetAssetR(),
+			AmountR:  req.GetAmountR(),
+			FeeAsset: tradeFeeAsset(trade, req),
 		}
 	}
 
@@ -1293,8 +3524,17 @@ func tradeToTradeInfo(
 		// remove trailing comma
 		blinded = strings.Trim(blinded, ",")
 
+		var traderOutputKeys map[string][]byte
+		if req != nil {
+			traderOutputKeys = req.GetOutputBlindingKey()
+		}
+		addSwapCommitments(
+			&info.SwapInfo, trade.TxHex, outBlindingData, traderOutputKeys,
+		)
+
+		// regtest and custom chains are expected to run a local explorer.
 		baseURL := "https://blockstream.info/liquid/tx"
-		if net == network.Regtest.Name {
+		if net != network.Liquid.Name {
 			baseURL = "http://localhost:3001/tx"
 		}
 		info.TxURL = fmt.Sprintf("%s/%s#blinded=%s", baseURL, trade.TxID, blinded)
@@ -1304,6 +3544,43 @@ func tradeToTradeInfo(
 	return
 }
 
+// addSwapCommitments sets the commitments of the outputs of the given
+// settlement tx that, according to the revealed blinding data, receive the
+// amounts of the swap. Since a change output might have the same asset and
+// amount, the one receiving the proposed amount is that of the provider,
+// while the one receiving the requested amount is that of the trader, whose
+// outputs are those with the given blinding keys.
+func addSwapCommitments(
+	info *SwapInfo,
+	txHex string,
+	outBlindingData map[int]BlindingData,
+	traderOutputKeys map[string][]byte,
+) {
+	tx, err := transaction.NewTxFromHex(txHex)
+	if err != nil {
+		return
+	}
+
+	for i, out := range tx.Outputs {
+		data, ok := outBlindingData[i]
+		if !ok {
+			continue
+		}
+		_, isTraderOutput := traderOutputKeys[hex.EncodeToString(out.Script)]
+
+		switch {
+		case info.ValueCommitmentP == "" && !isTraderOutput &&
+			data.Asset == info.AssetP && data.Amount == info.AmountP:
+			info.ValueCommitmentP = hex.EncodeToString(out.Value)
+			info.AssetCommitmentP = hex.EncodeToString(out.Asset)
+		case info.ValueCommitmentR == "" && isTraderOutput &&
+			data.Asset == info.AssetR && data.Amount == info.AmountR:
+			info.ValueCommitmentR = hex.EncodeToString(out.Value)
+			info.AssetCommitmentR = hex.EncodeToString(out.Asset)
+		}
+	}
+}
+
 func validateMarketRequest(marketReq Market, baseAsset string) error {
 	if err := validateAssetString(marketReq.BaseAsset); err != nil {
 		return err
@@ -1330,14 +3607,23 @@ func groupAddressesInfoByScript(info domain.AddressesInfo) map[string]domain.Add
 }
 
 func appendUtxoInfo(list []UtxoInfo, unspent domain.Unspent) []UtxoInfo {
-	return append(list, UtxoInfo{
+	return append(list, newUtxoInfo(unspent))
+}
+
+func newUtxoInfo(unspent domain.Unspent) UtxoInfo {
+	info := UtxoInfo{
 		Outpoint: &TxOutpoint{
 			Hash:  unspent.TxID,
 			Index: int(unspent.VOut),
 		},
-		Value: unspent.Value,
-		Asset: unspent.AssetHash,
-	})
+		Value:    unspent.Value,
+		Asset:    unspent.AssetHash,
+		Excluded: unspent.IsExcluded(),
+	}
+	if unspent.IsLocked() && unspent.LockedBy != nil {
+		info.TradeID = unspent.LockedBy.String()
+	}
+	return info
 }
 
 func (o *operatorService) DropMarket(
@@ -1,17 +1,33 @@
 package application_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/csv"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/tdex-network/tdex-daemon/internal/core/application"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/tdex-network/tdex-daemon/pkg/assetregistry"
+	"github.com/tdex-network/tdex-daemon/pkg/bufferutil"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+	"github.com/tdex-network/tdex-daemon/pkg/fiatprice"
 	"github.com/tdex-network/tdex-daemon/pkg/transactionutil"
 	"github.com/tdex-network/tdex-daemon/pkg/wallet"
+	"github.com/vulpemventures/go-elements/address"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/pset"
+	"github.com/vulpemventures/go-elements/transaction"
 )
 
 var (
@@ -114,7 +130,3411 @@ func TestAccountManagement(t *testing.T) {
 	require.Len(t, markets, 0)
 }
 
+func TestGetInfo(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	buildInfo := application.BuildInfo
+	application.BuildInfo = application.Build{
+		Version: "v0.1.0",
+		Commit:  "abcdef",
+		Date:    "2021-01-01",
+	}
+	t.Cleanup(func() { application.BuildInfo = buildInfo })
+
+	startTime := time.Now().Unix()
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	info, err := operatorSvc.GetInfo(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "v0.1.0", info.Version)
+	require.Equal(t, "abcdef", info.Commit)
+	require.Equal(t, "2021-01-01", info.Date)
+	require.Equal(t, regtest.Name, info.Network)
+	require.GreaterOrEqual(t, int64(info.StartTimeUnix), startTime)
+	require.GreaterOrEqual(t, info.Uptime, time.Duration(0))
+	require.Equal(t, 1, info.NumOfMarkets)
+}
+
+func TestMarketsByBaseAsset(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	markets, err := operatorSvc.MarketsByBaseAsset(ctx, marketBaseAsset)
+	require.NoError(t, err)
+	require.Len(t, markets, 1)
+	require.Equal(t, marketQuoteAsset, markets[0].Market.QuoteAsset)
+	require.True(t, markets[0].Tradable)
+
+	markets, err = operatorSvc.MarketsByBaseAsset(ctx, randomHex(32))
+	require.NoError(t, err)
+	require.Empty(t, markets)
+
+	_, err = operatorSvc.MarketsByBaseAsset(ctx, "invalidasset")
+	require.EqualError(t, err, domain.ErrMarketInvalidBaseAsset.Error())
+}
+
+func TestMarketsContainingAsset(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	for _, asset := range []string{marketBaseAsset, marketQuoteAsset} {
+		markets, err := operatorSvc.MarketsContainingAsset(ctx, asset)
+		require.NoError(t, err)
+		require.Len(t, markets, 1)
+		require.Equal(t, marketBaseAsset, markets[0].Market.BaseAsset)
+		require.Equal(t, marketQuoteAsset, markets[0].Market.QuoteAsset)
+	}
+
+	markets, err := operatorSvc.MarketsContainingAsset(ctx, randomHex(32))
+	require.NoError(t, err)
+	require.Empty(t, markets)
+
+	_, err = operatorSvc.MarketsContainingAsset(ctx, "invalidasset")
+	require.Error(t, err)
+}
+
+func TestAssetInfo(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	unregisteredAsset := randomHex(32)
+	registry := &mockAssetRegistry{}
+	registry.On("AssetInfo", marketQuoteAsset).Return(assetregistry.AssetMetadata{
+		AssetHash:  marketQuoteAsset,
+		Ticker:     "USDt",
+		Name:       "Tether USD",
+		Precision:  8,
+		Registered: true,
+	}, nil)
+	registry.On("AssetInfo", unregisteredAsset).Return(assetregistry.AssetMetadata{
+		AssetHash: unregisteredAsset,
+		Precision: assetregistry.DefaultPrecision,
+	}, nil)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{assetRegistrySvc: registry},
+	)
+
+	info, err := operatorSvc.AssetInfo(ctx, marketBaseAsset)
+	require.NoError(t, err)
+	require.Equal(t, "L-BTC", info.Ticker)
+	registry.AssertNotCalled(t, "AssetInfo", marketBaseAsset)
+
+	info, err = operatorSvc.AssetInfo(ctx, marketQuoteAsset)
+	require.NoError(t, err)
+	require.True(t, info.Registered)
+	require.Equal(t, "USDt", info.Ticker)
+
+	info, err = operatorSvc.AssetInfo(ctx, unregisteredAsset)
+	require.NoError(t, err)
+	require.False(t, info.Registered)
+	require.Equal(t, assetregistry.DefaultPrecision, info.Precision)
+
+	_, err = operatorSvc.AssetInfo(ctx, "invalidasset")
+	require.Error(t, err)
+
+	markets, err := operatorSvc.ListMarket(ctx)
+	require.NoError(t, err)
+	require.Len(t, markets, 1)
+	require.NotNil(t, markets[0].BaseAssetInfo)
+	require.Equal(t, "L-BTC", markets[0].BaseAssetInfo.Ticker)
+	require.NotNil(t, markets[0].QuoteAssetInfo)
+	require.Equal(t, "Tether USD", markets[0].QuoteAssetInfo.Name)
+
+	// without a registry only the metadata of L-BTC are resolved.
+	operatorSvc = buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	_, err = operatorSvc.AssetInfo(ctx, marketQuoteAsset)
+	require.EqualError(t, err, application.ErrAssetRegistryNotConfigured.Error())
+
+	markets, err = operatorSvc.ListMarket(ctx)
+	require.NoError(t, err)
+	require.Len(t, markets, 1)
+	require.NotNil(t, markets[0].BaseAssetInfo)
+	require.Nil(t, markets[0].QuoteAssetInfo)
+}
+
+func TestFailingListTradesForMarket(t *testing.T) {
+	operatorSvc, err := newOperatorService()
+	require.NoError(t, err)
+
+	mkt := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	tests := []application.Page{
+		{Number: -1, Size: 10},
+		{Number: 0, Size: 0},
+	}
+
+	for _, page := range tests {
+		_, _, err := operatorSvc.ListTradesForMarket(ctx, mkt, page, false)
+		require.EqualError(t, err, application.ErrInvalidPage.Error())
+	}
+
+	_, _, err = operatorSvc.ListTradesForMarket(
+		ctx, mkt, application.Page{Number: 0, Size: 10}, false,
+	)
+	require.EqualError(t, err, application.ErrMarketNotExist.Error())
+}
+
+func TestFailingMarketBalanceHistory(t *testing.T) {
+	operatorSvc, err := newOperatorService()
+	require.NoError(t, err)
+
+	mkt := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	tests := []struct {
+		from, to uint64
+		interval time.Duration
+		err      error
+	}{
+		{
+			from:     100,
+			to:       10,
+			interval: time.Second,
+			err:      application.ErrInvalidTimeRange,
+		},
+		{
+			from:     0,
+			to:       100,
+			interval: time.Millisecond,
+			err:      application.ErrInvalidInterval,
+		},
+		{
+			from:     0,
+			to:       100000,
+			interval: time.Second,
+			err:      application.ErrTooManySnapshots,
+		},
+		{
+			from:     0,
+			to:       100,
+			interval: time.Second,
+			err:      application.ErrMarketNotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := operatorSvc.MarketBalanceHistory(
+			ctx, mkt, tt.from, tt.to, tt.interval,
+		)
+		require.EqualError(t, err, tt.err.Error())
+	}
+}
+
+func TestSubscribeTrades(t *testing.T) {
+	operatorSvc, err := newOperatorService()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(ctx)
+	trades, chInfo, err := operatorSvc.SubscribeTrades(
+		ctx, application.TradeFilter{MinStatus: domain.Accepted},
+	)
+	require.NoError(t, err)
+	require.Len(t, trades, 0)
+
+	cancel()
+	select {
+	case _, ok := <-chInfo:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("subscription channel not closed after context cancellation")
+	}
+}
+
+func TestSubscribeTradesUpdates(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	mockedTradeManager := newMockedTradeManager()
+	mockedTradeManager.
+		On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
+		Return(&application.FillProposalResult{
+			PsetBase64:       randomBase64(),
+			SelectedUnspents: randomSelection(unspents, mockedTradeManager.counter),
+		}, nil)
+	application.TradeManager = mockedTradeManager
+
+	tradeFeed := application.NewTradeFeed()
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{tradeFeed: tradeFeed},
+	)
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{tradeFeed: tradeFeed},
+	)
+
+	mkt := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	_, chAll, err := operatorSvc.SubscribeTrades(
+		ctx, application.TradeFilter{Market: mkt},
+	)
+	require.NoError(t, err)
+	_, chCompleted, err := operatorSvc.SubscribeTrades(
+		ctx, application.TradeFilter{MinStatus: domain.Completed},
+	)
+	require.NoError(t, err)
+
+	marketOrder(t, tradeSvc, mkt, application.TradeBuy, 0.1, marketBaseAsset)
+
+	receive := func(chInfo <-chan application.TradeInfo) application.TradeInfo {
+		select {
+		case info, ok := <-chInfo:
+			require.True(t, ok)
+			return info
+		case <-time.After(time.Second):
+			t.Fatal("trade update not received")
+		}
+		return application.TradeInfo{}
+	}
+
+	accepted := receive(chAll)
+	require.Equal(t, domain.Accepted, accepted.Status.Code)
+	require.Equal(t, marketQuoteAsset, accepted.MarketWithFee.QuoteAsset)
+	completed := receive(chAll)
+	require.Equal(t, accepted.ID, completed.ID)
+	require.Equal(t, domain.Completed, completed.Status.Code)
+
+	// the subscriber not interested in accepted trades receives only the
+	// completed one.
+	completed = receive(chCompleted)
+	require.Equal(t, accepted.ID, completed.ID)
+	require.Equal(t, domain.Completed, completed.Status.Code)
+	select {
+	case info := <-chCompleted:
+		t.Fatalf("unexpected update of trade with status %d", info.Status.Code)
+	default:
+	}
+}
+
+func TestFailingSubscribeTrades(t *testing.T) {
+	operatorSvc, err := newOperatorService()
+	require.NoError(t, err)
+
+	tests := []struct {
+		market application.Market
+		err    error
+	}{
+		{
+			market: application.Market{
+				BaseAsset:  randomHex(32),
+				QuoteAsset: marketQuoteAsset,
+			},
+			err: domain.ErrMarketInvalidBaseAsset,
+		},
+		{
+			market: application.Market{
+				BaseAsset:  marketBaseAsset,
+				QuoteAsset: marketQuoteAsset,
+			},
+			err: application.ErrMarketNotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		_, _, err := operatorSvc.SubscribeTrades(
+			ctx, application.TradeFilter{Market: tt.market},
+		)
+		require.EqualError(t, err, tt.err.Error())
+	}
+}
+
+func TestFailingWithdrawMarketFundsBelowReserve(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	mkt := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	unspentsBefore, err := repoManager.UnspentRepository().GetAvailableUnspents(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		balanceToWithdraw application.Balance
+		minReserve        application.Balance
+	}{
+		{
+			balanceToWithdraw: application.Balance{BaseAmount: 90000000},
+			minReserve:        application.Balance{BaseAmount: 20000000},
+		},
+		{
+			balanceToWithdraw: application.Balance{QuoteAmount: 2000000000000},
+			minReserve:        application.Balance{QuoteAmount: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := operatorSvc.WithdrawMarketFunds(ctx, application.WithdrawMarketReq{
+			Market:            mkt,
+			BalanceToWithdraw: tt.balanceToWithdraw,
+			MinReserve:        tt.minReserve,
+		})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, application.ErrWithdrawBelowReserve))
+	}
+
+	unspentsAfter, err := repoManager.UnspentRepository().GetAvailableUnspents(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(unspentsBefore), len(unspentsAfter))
+}
+
+func TestFailingWithdrawMarketFundsInDisplayUnits(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	mkt := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	tests := []struct {
+		name              string
+		balanceToWithdraw application.Balance
+		displayBalance    application.DisplayBalance
+		expectedError     error
+	}{
+		{
+			// 0.9 L-BTC are converted to 90000000 sats, that would leave less
+			// than the min reserve.
+			name:           "converted_amount",
+			displayBalance: application.DisplayBalance{BaseAmount: decimal.NewFromFloat(0.9)},
+			expectedError:  application.ErrWithdrawBelowReserve,
+		},
+		{
+			name:              "ambiguous_amount",
+			balanceToWithdraw: application.Balance{BaseAmount: 90000000},
+			displayBalance:    application.DisplayBalance{BaseAmount: decimal.NewFromFloat(0.9)},
+			expectedError:     application.ErrAmbiguousWithdrawal,
+		},
+		{
+			name:           "too_many_decimal_places",
+			displayBalance: application.DisplayBalance{BaseAmount: decimal.RequireFromString("0.000000001")},
+			expectedError:  application.ErrInvalidDisplayAmount,
+		},
+		{
+			name:           "negative_amount",
+			displayBalance: application.DisplayBalance{BaseAmount: decimal.NewFromFloat(-0.9)},
+			expectedError:  application.ErrInvalidDisplayAmount,
+		},
+		{
+			// the precision of assets other than L-BTC is unknown without a
+			// registry.
+			name:           "unknown_precision",
+			displayBalance: application.DisplayBalance{QuoteAmount: decimal.NewFromInt(1)},
+			expectedError:  application.ErrAssetRegistryNotConfigured,
+		},
+	}
+
+	for _, tt := range tests {
+		displayBalance := tt.displayBalance
+		_, err := operatorSvc.WithdrawMarketFunds(ctx, application.WithdrawMarketReq{
+			Market:                   mkt,
+			BalanceToWithdraw:        tt.balanceToWithdraw,
+			DisplayBalanceToWithdraw: &displayBalance,
+			MinReserve:               application.Balance{BaseAmount: 20000000},
+		})
+		require.Error(t, err, tt.name)
+		require.True(t, errors.Is(err, tt.expectedError), tt.name)
+	}
+}
+
+func TestEstimateWithdrawFee(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	replaceWithUnconfidentialFunds(t, repoManager, unspents)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	addresses, err := operatorSvc.ListMarketExternalAddresses(ctx, application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	})
+	require.NoError(t, err)
+	unspentsBefore, err := repoManager.UnspentRepository().GetAvailableUnspents(ctx)
+	require.NoError(t, err)
+
+	req := application.WithdrawMarketReq{
+		Market: application.Market{
+			BaseAsset:  marketBaseAsset,
+			QuoteAsset: marketQuoteAsset,
+		},
+		BalanceToWithdraw: application.Balance{BaseAmount: 1000000},
+		MillisatPerByte:   100,
+		Address:           addresses[0],
+	}
+	vsize, feeAmount, err := operatorSvc.EstimateWithdrawFee(ctx, req)
+	require.NoError(t, err)
+	require.Greater(t, vsize, 0)
+	require.GreaterOrEqual(t, feeAmount, uint64(vsize)*100/1000)
+
+	// the estimation is deterministic and doubles with the fee rate.
+	sameVsize, sameFeeAmount, err := operatorSvc.EstimateWithdrawFee(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, vsize, sameVsize)
+	require.Equal(t, feeAmount, sameFeeAmount)
+
+	req.MillisatPerByte = 200
+	_, doubleFeeAmount, err := operatorSvc.EstimateWithdrawFee(ctx, req)
+	require.NoError(t, err)
+	require.InDelta(t, 2*feeAmount, doubleFeeAmount, 1)
+
+	// fee account can't afford the network fees.
+	req.MillisatPerByte = 100000000
+	_, _, err = operatorSvc.EstimateWithdrawFee(ctx, req)
+	require.Error(t, err)
+
+	unspentsAfter, err := repoManager.UnspentRepository().GetAvailableUnspents(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(unspentsBefore), len(unspentsAfter))
+}
+
+func TestFundMarket(t *testing.T) {
+	repoManager, explorerSvc, bcListener := newServices()
+	_, err := repoManager.VaultRepository().GetOrCreateVault(
+		ctx, mnemonic, passphrase, regtest,
+	)
+	require.NoError(t, err)
+
+	faucet := newMockFaucet(explorerSvc.(*mockExplorer))
+	operatorSvc := buildOperatorService(
+		repoManager, faucet, bcListener,
+		operatorServiceOpts{feeAccountBalanceThreshold: feeBalanceThreshold},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	amounts := application.Balance{
+		BaseAmount:  100000000,
+		QuoteAmount: 5000000000,
+	}
+
+	err = operatorSvc.FundMarket(ctx, market, amounts)
+	require.EqualError(t, err, application.ErrFaucetNotConfigured.Error())
+
+	operatorSvc = buildOperatorService(
+		repoManager, faucet, bcListener,
+		operatorServiceOpts{
+			faucet:                     faucet,
+			feeAccountBalanceThreshold: feeBalanceThreshold,
+		},
+	)
+
+	err = operatorSvc.FundMarket(
+		ctx, market, application.Balance{BaseAmount: amounts.BaseAmount},
+	)
+	require.EqualError(t, err, application.ErrInvalidFaucetAmounts.Error())
+
+	err = operatorSvc.FundMarket(ctx, market, amounts)
+	require.NoError(t, err)
+
+	markets, err := operatorSvc.ListMarket(ctx)
+	require.NoError(t, err)
+	require.Len(t, markets, 1)
+	require.Equal(t, market, markets[0].Market)
+
+	accountIndex := int(markets[0].AccountIndex)
+	info, err := repoManager.VaultRepository().
+		GetAllDerivedAddressesInfoForAccount(ctx, accountIndex)
+	require.NoError(t, err)
+	require.Len(t, info, 2)
+
+	// an existing market can be funded with any of its assets.
+	err = operatorSvc.FundMarket(
+		ctx, market, application.Balance{QuoteAmount: amounts.QuoteAmount},
+	)
+	require.NoError(t, err)
+
+	info, err = repoManager.VaultRepository().
+		GetAllDerivedAddressesInfoForAccount(ctx, accountIndex)
+	require.NoError(t, err)
+	require.Len(t, info, 3)
+
+	unspents, err := repoManager.UnspentRepository().GetAllUnspentsForAddresses(
+		ctx, info.Addresses(),
+	)
+	require.NoError(t, err)
+	require.Len(t, unspents, 3)
+
+	balances := make(map[string]uint64)
+	for _, u := range unspents {
+		balances[u.AssetHash] += u.Value
+	}
+	require.Equal(t, amounts.BaseAmount, balances[marketBaseAsset])
+	require.Equal(t, 2*amounts.QuoteAmount, balances[marketQuoteAsset])
+
+	mainnetOperatorSvc := buildOperatorService(
+		repoManager, faucet, bcListener,
+		operatorServiceOpts{
+			faucet:                     faucet,
+			network:                    &network.Liquid,
+			feeAccountBalanceThreshold: feeBalanceThreshold,
+		},
+	)
+	err = mainnetOperatorSvc.FundMarket(ctx, market, amounts)
+	require.EqualError(t, err, application.ErrFaucetNotAllowed.Error())
+}
+
+func TestGetBlindingData(t *testing.T) {
+	operatorSvc, err := newOperatorService()
+	require.NoError(t, err)
+
+	psetBase64 := randomBase64()
+	inKeys := map[string][]byte{randomHex(22): randomBytes(32)}
+	outKeys := map[string][]byte{randomHex(22): randomBytes(32)}
+	inData := map[int]application.BlindingData{
+		0: {
+			Asset:         marketQuoteAsset,
+			Amount:        100000,
+			AssetBlinder:  randomBytes(32),
+			AmountBlinder: randomBytes(32),
+		},
+	}
+	outData := map[int]application.BlindingData{
+		1: {
+			Asset:         marketBaseAsset,
+			Amount:        2000,
+			AssetBlinder:  randomBytes(32),
+			AmountBlinder: randomBytes(32),
+		},
+	}
+
+	txManager := application.TransactionManager
+	t.Cleanup(func() { application.TransactionManager = txManager })
+	mockedTransactionManager := &mockTransactionManager{}
+	mockedTransactionManager.
+		On("ExtractBlindingData", psetBase64, inKeys, outKeys).
+		Return(inData, outData, nil)
+	mockedTransactionManager.
+		On("ExtractBlindingData", psetBase64, inKeys, map[string][]byte(nil)).
+		Return(nil, nil, wallet.ErrMissingInBlindingKey)
+	application.TransactionManager = mockedTransactionManager
+
+	in, out, err := operatorSvc.GetBlindingData(psetBase64, inKeys, outKeys)
+	require.NoError(t, err)
+	require.Equal(t, inData, in)
+	require.Equal(t, outData, out)
+
+	_, _, err = operatorSvc.GetBlindingData(psetBase64, inKeys, nil)
+	require.EqualError(t, err, wallet.ErrMissingInBlindingKey.Error())
+}
+
+func TestListTradesSwapCommitments(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	amountP, amountR := uint64(100000), uint64(20000000)
+	traderScript, traderChangeScript := randomBytes(22), randomBytes(22)
+	swapRequest := swapRequestStub{
+		mockSwapRequest: newMockedSwapRequest(),
+		assetP:          marketBaseAsset,
+		amountP:         amountP,
+		assetR:          marketQuoteAsset,
+		amountR:         amountR,
+		outputBlindingKeys: map[string][]byte{
+			hex.EncodeToString(traderScript):       randomBytes(32),
+			hex.EncodeToString(traderChangeScript): randomBytes(32),
+		},
+	}
+
+	swapParser := domain.SwapParserManager
+	t.Cleanup(func() { domain.SwapParserManager = swapParser })
+	mockedSwapParser := &mockSwapParser{}
+	mockedSwapParser.
+		On("DeserializeRequest", mock.Anything).Return(swapRequest, nil)
+	mockedSwapParser.
+		On("DeserializeAccept", mock.Anything).Return(newMockedSwapAccept(), nil)
+	domain.SwapParserManager = mockedSwapParser
+
+	// the change of the trader has the same asset and amount of the output
+	// receiving the proposed amount, and comes before it.
+	outputs := []struct {
+		script []byte
+		asset  string
+		amount uint64
+	}{
+		{traderScript, marketQuoteAsset, amountR},
+		{traderChangeScript, marketBaseAsset, amountP},
+		{randomBytes(22), marketBaseAsset, amountP},
+		{randomBytes(22), marketQuoteAsset, amountR},
+	}
+	tx := transaction.NewTx(2)
+	outData := make(map[int]application.BlindingData)
+	for i, out := range outputs {
+		assetCommitment := append([]byte{10}, randomBytes(32)...)
+		valueCommitment := append([]byte{8}, randomBytes(32)...)
+		tx.AddOutput(
+			transaction.NewTxOutput(assetCommitment, valueCommitment, out.script),
+		)
+		outData[i] = application.BlindingData{
+			Asset:         out.asset,
+			Amount:        out.amount,
+			AssetBlinder:  randomBytes(32),
+			AmountBlinder: randomBytes(32),
+		}
+	}
+	txHex, err := tx.ToHex()
+	require.NoError(t, err)
+
+	txManager := application.TransactionManager
+	t.Cleanup(func() { application.TransactionManager = txManager })
+	mockedTransactionManager := &mockTransactionManager{}
+	mockedTransactionManager.
+		On("ExtractBlindingData", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, outData, nil)
+	application.TransactionManager = mockedTransactionManager
+
+	tradeID := uuid.New()
+	_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &tradeID)
+	require.NoError(t, err)
+	err = repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&tradeID,
+		func(tr *domain.Trade) (*domain.Trade, error) {
+			tr.MarketQuoteAsset = marketQuoteAsset
+			tr.Status = domain.SettledStatus
+			tr.SwapRequest = domain.Swap{ID: randomId(), Message: randomBytes(100)}
+			tr.SwapAccept = domain.Swap{ID: randomId(), Message: randomBytes(100)}
+			tr.PsetBase64 = randomBase64()
+			tr.TxID = tx.TxHash().String()
+			tr.TxHex = txHex
+			return tr, nil
+		},
+	)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+	trades, err := operatorSvc.ListTrades(ctx)
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+
+	swapInfo := trades[0].SwapInfo
+	require.Equal(t, hex.EncodeToString(tx.Outputs[2].Value), swapInfo.ValueCommitmentP)
+	require.Equal(t, hex.EncodeToString(tx.Outputs[2].Asset), swapInfo.AssetCommitmentP)
+	require.Equal(t, hex.EncodeToString(tx.Outputs[0].Value), swapInfo.ValueCommitmentR)
+	require.Equal(t, hex.EncodeToString(tx.Outputs[0].Asset), swapInfo.AssetCommitmentR)
+}
+
+func TestWithdrawMarketFundsWithExternalSigner(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+	replaceWithUnconfidentialFunds(t, repoManager, unspents)
+
+	signer := &mockSigner{err: errors.New("signing rejected")}
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{signer: signer},
+	)
+
+	addresses, err := operatorSvc.ListMarketExternalAddresses(ctx, application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	})
+	require.NoError(t, err)
+	unspentsBefore, err := repoManager.UnspentRepository().GetAvailableUnspents(ctx)
+	require.NoError(t, err)
+
+	_, err = operatorSvc.WithdrawMarketFunds(ctx, application.WithdrawMarketReq{
+		Market: application.Market{
+			BaseAsset:  marketBaseAsset,
+			QuoteAsset: marketQuoteAsset,
+		},
+		BalanceToWithdraw: application.Balance{BaseAmount: 1000000},
+		MillisatPerByte:   100,
+		Address:           addresses[0],
+		Push:              true,
+	})
+	require.EqualError(t, err, signer.err.Error())
+	require.Len(t, signer.psets, 1)
+
+	ptx, err := pset.NewPsetFromBase64(signer.psets[0])
+	require.NoError(t, err)
+	for _, in := range ptx.Inputs {
+		require.Empty(t, in.PartialSigs)
+	}
+
+	unspentsAfter, err := repoManager.UnspentRepository().GetAvailableUnspents(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(unspentsBefore), len(unspentsAfter))
+}
+
+func TestWithdrawMarketFundsWithDustThreshold(t *testing.T) {
+	tests := []struct {
+		dustThreshold uint64
+		expectedOuts  int
+	}{
+		// out + market change + fee change + fee
+		{0, 4},
+		// the fee change left by a coin of the fee account is dust and is added
+		// to the fees, since 2 coins wouldn't leave any change
+		{9900, 3},
+	}
+
+	for _, tt := range tests {
+		repoManager, explorerSvc, bcListener, unspents, err :=
+			newServicesWithFundedMarket(marketFee, false)
+		require.NoError(t, err)
+		replaceWithUnconfidentialFunds(t, repoManager, unspents)
+
+		// the signer is used to catch the transaction without broadcasting it
+		signer := &mockSigner{err: errors.New("signing rejected")}
+		operatorSvc := buildOperatorService(
+			repoManager, explorerSvc, bcListener,
+			operatorServiceOpts{
+				signer:        signer,
+				dustThreshold: tt.dustThreshold,
+			},
+		)
+
+		addresses, err := operatorSvc.ListMarketExternalAddresses(
+			ctx, application.Market{
+				BaseAsset:  marketBaseAsset,
+				QuoteAsset: marketQuoteAsset,
+			},
+		)
+		require.NoError(t, err)
+
+		_, err = operatorSvc.WithdrawMarketFunds(ctx, application.WithdrawMarketReq{
+			Market: application.Market{
+				BaseAsset:  marketBaseAsset,
+				QuoteAsset: marketQuoteAsset,
+			},
+			BalanceToWithdraw: application.Balance{BaseAmount: 1000000},
+			MillisatPerByte:   100,
+			Address:           addresses[0],
+		})
+		require.EqualError(t, err, signer.err.Error())
+		require.Len(t, signer.psets, 1)
+
+		ptx, err := pset.NewPsetFromBase64(signer.psets[0])
+		require.NoError(t, err)
+		require.Len(t, ptx.UnsignedTx.Outputs, tt.expectedOuts)
+
+		if tt.dustThreshold > 0 {
+			fee := ptx.UnsignedTx.Outputs[len(ptx.UnsignedTx.Outputs)-1]
+			require.Empty(t, fee.Script)
+			require.Equal(t, uint64(5000), bufferutil.ValueFromBytes(fee.Value))
+		}
+	}
+}
+
+func TestWithdrawMarketFundsWithMaxTxInputs(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxTxInputs int
+		err         error
+	}{
+		// the fees require both the coins of the fee account.
+		{"unlimited", 0, nil},
+		{"too_many_inputs", 1, application.ErrTooManyInputs},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoManager, explorerSvc, bcListener, unspents, err :=
+				newServicesWithFundedMarket(marketFee, false)
+			require.NoError(t, err)
+			replaceWithUnconfidentialFunds(t, repoManager, unspents)
+
+			// the signer is used to catch the transaction without broadcasting it
+			signer := &mockSigner{err: errors.New("signing rejected")}
+
+			operatorSvc := buildOperatorService(
+				repoManager, explorerSvc, bcListener,
+				operatorServiceOpts{signer: signer},
+			)
+			operatorSvc.SetMaxTxInputs(tt.maxTxInputs)
+
+			addresses, err := operatorSvc.ListMarketExternalAddresses(
+				ctx, application.Market{
+					BaseAsset:  marketBaseAsset,
+					QuoteAsset: marketQuoteAsset,
+				},
+			)
+			require.NoError(t, err)
+
+			_, err = operatorSvc.WithdrawMarketFunds(ctx, application.WithdrawMarketReq{
+				Market: application.Market{
+					BaseAsset:  marketBaseAsset,
+					QuoteAsset: marketQuoteAsset,
+				},
+				BalanceToWithdraw: application.Balance{BaseAmount: 1000000},
+				MillisatPerByte:   2000,
+				Address:           addresses[0],
+			})
+			if tt.err != nil {
+				require.EqualError(t, err, tt.err.Error())
+				require.Empty(t, signer.psets)
+				return
+			}
+
+			require.EqualError(t, err, signer.err.Error())
+			require.Len(t, signer.psets, 1)
+			ptx, err := pset.NewPsetFromBase64(signer.psets[0])
+			require.NoError(t, err)
+			require.Len(t, ptx.Inputs, 3)
+		})
+	}
+}
+
+func TestWithdrawMarketFundsWithAntiFeeSniping(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+	replaceWithUnconfidentialFunds(t, repoManager, unspents)
+
+	blockHeight := 1200
+	explorerSvc.(*mockExplorer).On("GetBlockHeight").Return(blockHeight, nil)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{antiFeeSniping: true},
+	)
+
+	addresses, err := operatorSvc.ListMarketExternalAddresses(
+		ctx, application.Market{
+			BaseAsset:  marketBaseAsset,
+			QuoteAsset: marketQuoteAsset,
+		},
+	)
+	require.NoError(t, err)
+
+	rawTx, err := operatorSvc.WithdrawMarketFunds(ctx, application.WithdrawMarketReq{
+		Market: application.Market{
+			BaseAsset:  marketBaseAsset,
+			QuoteAsset: marketQuoteAsset,
+		},
+		BalanceToWithdraw: application.Balance{BaseAmount: 1000000},
+		MillisatPerByte:   100,
+		Address:           addresses[0],
+	})
+	require.NoError(t, err)
+
+	// the locktime is enforced and the tx is still signed and finalized.
+	tx, err := transaction.NewTxFromHex(hex.EncodeToString(rawTx))
+	require.NoError(t, err)
+	require.Equal(t, uint32(blockHeight), tx.Locktime)
+	for _, in := range tx.Inputs {
+		require.Less(t, in.Sequence, uint32(0xffffffff))
+		require.NotEmpty(t, in.Witness)
+	}
+}
+
+func TestWithdrawFeeRateFloor(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+	replaceWithUnconfidentialFunds(t, repoManager, unspents)
+
+	newOperatorSvc := func(clampUp bool) application.OperatorService {
+		return buildOperatorService(
+			repoManager, explorerSvc, bcListener,
+			operatorServiceOpts{
+				withdrawalFeeRate: application.FeeRateFloor{
+					MinMilliSatPerByte: 200,
+					ClampUp:            clampUp,
+				},
+			},
+		)
+	}
+	operatorSvc := newOperatorSvc(false)
+
+	addresses, err := operatorSvc.ListMarketExternalAddresses(ctx, application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	})
+	require.NoError(t, err)
+
+	req := application.WithdrawMarketReq{
+		Market: application.Market{
+			BaseAsset:  marketBaseAsset,
+			QuoteAsset: marketQuoteAsset,
+		},
+		BalanceToWithdraw: application.Balance{BaseAmount: 1000000},
+		MillisatPerByte:   200,
+		Address:           addresses[0],
+	}
+	_, minFeeAmount, err := operatorSvc.EstimateWithdrawFee(ctx, req)
+	require.NoError(t, err)
+
+	req.MillisatPerByte = 150
+	_, _, err = operatorSvc.EstimateWithdrawFee(ctx, req)
+	require.True(t, errors.Is(err, application.ErrFeeRateBelowFloor))
+	_, err = operatorSvc.WithdrawMarketFunds(ctx, req)
+	require.True(t, errors.Is(err, application.ErrFeeRateBelowFloor))
+	_, err = operatorSvc.WithdrawMultiple(ctx, []application.WithdrawMarketReq{req})
+	require.True(t, errors.Is(err, application.ErrFeeRateBelowFloor))
+
+	// requests with no fee rate are made at the min one.
+	req.MillisatPerByte = 0
+	_, feeAmount, err := operatorSvc.EstimateWithdrawFee(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, minFeeAmount, feeAmount)
+
+	req.MillisatPerByte = 150
+	_, feeAmount, err = newOperatorSvc(true).EstimateWithdrawFee(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, minFeeAmount, feeAmount)
+}
+
+func TestFailingWithdrawMultiple(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	mkt := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	unspentsBefore, err := repoManager.UnspentRepository().GetAvailableUnspents(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		reqs []application.WithdrawMarketReq
+		err  error
+	}{
+		{
+			name: "no_withdrawals",
+			reqs: nil,
+			err:  application.ErrNoWithdrawals,
+		},
+		{
+			name: "invalid_base_asset",
+			reqs: []application.WithdrawMarketReq{
+				{
+					Market: application.Market{
+						BaseAsset:  randomHex(32),
+						QuoteAsset: marketQuoteAsset,
+					},
+					BalanceToWithdraw: application.Balance{BaseAmount: 1000},
+				},
+			},
+			err: domain.ErrMarketInvalidBaseAsset,
+		},
+		{
+			name: "empty_withdrawal",
+			reqs: []application.WithdrawMarketReq{{Market: mkt}},
+			err:  application.ErrEmptyWithdrawal,
+		},
+		{
+			name: "duplicated_market",
+			reqs: []application.WithdrawMarketReq{
+				{
+					Market:            mkt,
+					BalanceToWithdraw: application.Balance{BaseAmount: 1000},
+				},
+				{
+					Market:            mkt,
+					BalanceToWithdraw: application.Balance{QuoteAmount: 1000},
+				},
+			},
+			err: application.ErrDuplicatedWithdrawal,
+		},
+		{
+			name: "insufficient_balance",
+			reqs: []application.WithdrawMarketReq{
+				{
+					Market:            mkt,
+					BalanceToWithdraw: application.Balance{BaseAmount: 200000000},
+				},
+			},
+			err: application.ErrWithdrawInsufficientBalance,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := operatorSvc.WithdrawMultiple(ctx, tt.reqs)
+			require.Error(t, err)
+			require.True(t, errors.Is(err, tt.err))
+		})
+	}
+
+	unspentsAfter, err := repoManager.UnspentRepository().GetAvailableUnspents(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(unspentsBefore), len(unspentsAfter))
+}
+
+func TestGetCollectedMarketFeeWithFiatValues(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	settlementTime := uint64(time.Now().Unix())
+	for i := 0; i < 2; i++ {
+		tradeID := uuid.New()
+		_, err := repoManager.TradeRepository().GetOrCreateTrade(ctx, &tradeID)
+		require.NoError(t, err)
+
+		err = repoManager.TradeRepository().UpdateTrade(
+			ctx,
+			&tradeID,
+			func(tr *domain.Trade) (*domain.Trade, error) {
+				tr.MarketQuoteAsset = marketQuoteAsset
+				tr.MarketFee = marketFee
+				tr.Status = domain.SettledStatus
+				tr.SwapRequest = domain.Swap{Message: randomBytes(100)}
+				tr.SettlementTime = settlementTime
+				return tr, nil
+			},
+		)
+		require.NoError(t, err)
+
+		feeAsset := marketBaseAsset
+		if i > 0 {
+			feeAsset = marketQuoteAsset
+		}
+		err = repoManager.FeeRepository().AddCollectedFee(ctx, domain.CollectedFee{
+			TradeID:          tradeID,
+			MarketQuoteAsset: marketQuoteAsset,
+			BasisPoint:       marketFee,
+			Asset:            feeAsset,
+			Amount:           uint64(1000 * (i + 1)),
+			CollectionTime:   settlementTime,
+		})
+		require.NoError(t, err)
+	}
+
+	fiatPrice := decimal.NewFromInt(30000)
+	fiatPriceSvc := &mockFiatPriceSource{}
+	fiatPriceSvc.On("Currency").Return("EUR")
+	fiatPriceSvc.
+		On("GetPrice", mock.AnythingOfType("string"), settlementTime).
+		Return(fiatPrice, nil)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{fiatPriceSvc: fiatPriceSvc},
+	)
+
+	report, err := operatorSvc.GetCollectedMarketFee(ctx, application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	})
+	require.NoError(t, err)
+	require.Len(t, report.CollectedFees, 2)
+	require.Equal(t, "EUR", report.FiatCurrency)
+
+	for _, fee := range report.CollectedFees {
+		expectedFiatValue := decimal.NewFromInt(int64(fee.Amount)).
+			Div(decimal.NewFromInt(100000000)).
+			Mul(fiatPrice)
+		require.NotNil(t, fee.FiatValue)
+		require.True(t, expectedFiatValue.Equal(*fee.FiatValue))
+		require.True(
+			t, expectedFiatValue.Equal(report.TotalCollectedFeesFiat[fee.Asset]),
+		)
+	}
+}
+
+func TestVerifyFeeLedger(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	// the fee of the first trade is missing from the ledger, that of the
+	// second one doesn't match the trade, while the last fee is recorded for
+	// a trade that is not settled.
+	tradeStatuses := []domain.Status{
+		domain.SettledStatus, domain.SettledStatus, domain.CompletedStatus,
+	}
+	tradeIDs := make([]uuid.UUID, 0, len(tradeStatuses))
+	for _, status := range tradeStatuses {
+		tradeID := uuid.New()
+		_, err := repoManager.TradeRepository().GetOrCreateTrade(ctx, &tradeID)
+		require.NoError(t, err)
+
+		status := status
+		err = repoManager.TradeRepository().UpdateTrade(
+			ctx,
+			&tradeID,
+			func(tr *domain.Trade) (*domain.Trade, error) {
+				tr.MarketQuoteAsset = marketQuoteAsset
+				tr.MarketFee = marketFee
+				tr.Status = status
+				tr.SwapRequest = domain.Swap{Message: randomBytes(100)}
+				return tr, nil
+			},
+		)
+		require.NoError(t, err)
+		tradeIDs = append(tradeIDs, tradeID)
+	}
+
+	for _, tradeID := range tradeIDs[1:] {
+		err := repoManager.FeeRepository().AddCollectedFee(ctx, domain.CollectedFee{
+			TradeID:          tradeID,
+			MarketQuoteAsset: marketQuoteAsset,
+			Asset:            marketQuoteAsset,
+			Amount:           1,
+		})
+		require.NoError(t, err)
+	}
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	discrepancies, err := operatorSvc.VerifyFeeLedger(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, discrepancies, 3)
+
+	reasons := make(map[string]string)
+	for _, d := range discrepancies {
+		require.False(t, d.Repaired)
+		reasons[d.TradeID] = d.Reason
+	}
+	require.Equal(t, application.FeeMissingFromLedger, reasons[tradeIDs[0].String()])
+	require.NotEmpty(t, reasons[tradeIDs[1].String()])
+	require.Equal(t, application.FeeWithoutSettledTrade, reasons[tradeIDs[2].String()])
+
+	discrepancies, err = operatorSvc.VerifyFeeLedger(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, discrepancies, 3)
+
+	report, err := operatorSvc.GetCollectedMarketFee(ctx, application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	})
+	require.NoError(t, err)
+	require.Len(t, report.CollectedFees, 3)
+
+	// the repaired fee is not missing anymore, while mismatching fees are never
+	// overwritten since the ledger is append-only.
+	discrepancies, err = operatorSvc.VerifyFeeLedger(ctx, true)
+	require.NoError(t, err)
+	for _, d := range discrepancies {
+		require.NotEqual(t, application.FeeMissingFromLedger, d.Reason)
+	}
+}
+
+func TestCollectedFeeBreakdown(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	fixedBaseFee, fixedQuoteFee := int64(100), int64(20000)
+	tradeID := uuid.New()
+	_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &tradeID)
+	require.NoError(t, err)
+	err = repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&tradeID,
+		func(tr *domain.Trade) (*domain.Trade, error) {
+			tr.MarketQuoteAsset = marketQuoteAsset
+			tr.MarketFee = marketFee
+			tr.MarketFixedBaseFee = fixedBaseFee
+			tr.MarketFixedQuoteFee = fixedQuoteFee
+			tr.Status = domain.SettledStatus
+			tr.SwapRequest = domain.Swap{Message: randomBytes(100)}
+			return tr, nil
+		},
+	)
+	require.NoError(t, err)
+
+	// a fee recorded before the breakdown was introduced.
+	legacyTradeID := uuid.New()
+	err = repoManager.FeeRepository().AddCollectedFee(ctx, domain.CollectedFee{
+		TradeID:          legacyTradeID,
+		MarketQuoteAsset: marketQuoteAsset,
+		Asset:            marketQuoteAsset,
+		Amount:           1000,
+	})
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	_, err = operatorSvc.VerifyFeeLedger(ctx, true)
+	require.NoError(t, err)
+
+	report, err := operatorSvc.GetCollectedMarketFee(ctx, application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	})
+	require.NoError(t, err)
+	require.Len(t, report.CollectedFees, 2)
+
+	for _, fee := range report.CollectedFees {
+		require.Equal(t, fee.Amount, fee.BasisPointAmount+fee.FixedAmount)
+		if fee.TradeID == legacyTradeID.String() {
+			require.Zero(t, fee.FixedAmount)
+			continue
+		}
+
+		expectedFixedAmount := uint64(fixedQuoteFee)
+		if fee.Asset == marketBaseAsset {
+			expectedFixedAmount = uint64(fixedBaseFee)
+		}
+		require.Equal(t, expectedFixedAmount, fee.FixedAmount)
+		require.NotZero(t, fee.BasisPointAmount)
+	}
+}
+
+func TestCollectedMarketFeeBackfill(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	swapParser := domain.SwapParserManager
+	t.Cleanup(func() { domain.SwapParserManager = swapParser })
+	mockedSwapParser := &mockSwapParser{}
+	mockedSwapParser.On("DeserializeRequest", mock.Anything).Return(
+		swapRequestStub{
+			mockSwapRequest: newMockedSwapRequest(),
+			assetP:          marketBaseAsset,
+			amountP:         100000,
+			assetR:          marketQuoteAsset,
+			amountR:         20000000,
+		}, nil,
+	)
+	domain.SwapParserManager = mockedSwapParser
+
+	// trades settled before the fee ledger was introduced.
+	tradeIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	for i, tradeID := range tradeIDs {
+		tradeID := tradeID
+		settlementTime := uint64(10 * (i + 1))
+		_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &tradeID)
+		require.NoError(t, err)
+		err = repoManager.TradeRepository().UpdateTrade(
+			ctx,
+			&tradeID,
+			func(tr *domain.Trade) (*domain.Trade, error) {
+				tr.MarketQuoteAsset = marketQuoteAsset
+				tr.MarketFee = marketFee
+				tr.Status = domain.SettledStatus
+				tr.SettlementTime = settlementTime
+				tr.SwapRequest = domain.Swap{Message: randomBytes(100)}
+				return tr, nil
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	report, err := operatorSvc.ReportMarketFeeRange(ctx, market, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, report.CollectedFees, 1)
+	require.Equal(t, tradeIDs[0].String(), report.CollectedFees[0].TradeID)
+
+	report, err = operatorSvc.GetCollectedMarketFee(ctx, market)
+	require.NoError(t, err)
+	require.Len(t, report.CollectedFees, 2)
+
+	fees, err := repoManager.FeeRepository().GetCollectedFeesByMarket(
+		ctx, marketQuoteAsset,
+	)
+	require.NoError(t, err)
+	require.Len(t, fees, 2)
+
+	discrepancies, err := operatorSvc.VerifyFeeLedger(ctx, false)
+	require.NoError(t, err)
+	require.Empty(t, discrepancies)
+}
+
+func TestCollectedFeeAsset(t *testing.T) {
+	fixedBaseFee, fixedQuoteFee := uint64(100), uint64(20000)
+	amountP, amountR := uint64(100000), uint64(20000000)
+	swapRequest := swapRequestStub{
+		mockSwapRequest: newMockedSwapRequest(),
+		assetP:          marketBaseAsset,
+		amountP:         amountP,
+		assetR:          marketQuoteAsset,
+		amountR:         amountR,
+	}
+
+	tests := []struct {
+		name                     string
+		feeAsset                 string
+		expectedAsset            string
+		expectedFixedAmount      uint64
+		expectedBasisPointAmount uint64
+	}{
+		{
+			name:                     "fee_on_amount_p",
+			feeAsset:                 marketBaseAsset,
+			expectedAsset:            marketBaseAsset,
+			expectedFixedAmount:      fixedBaseFee,
+			expectedBasisPointAmount: (amountP - fixedBaseFee) * uint64(marketFee) / 10000,
+		},
+		{
+			name:                     "fee_on_amount_r",
+			feeAsset:                 marketQuoteAsset,
+			expectedAsset:            marketQuoteAsset,
+			expectedFixedAmount:      fixedQuoteFee,
+			expectedBasisPointAmount: (amountR + fixedQuoteFee) * uint64(marketFee) / 10000,
+		},
+		{
+			name:                     "legacy_trade",
+			expectedAsset:            marketBaseAsset,
+			expectedFixedAmount:      fixedBaseFee,
+			expectedBasisPointAmount: (amountP - fixedBaseFee) * uint64(marketFee) / 10000,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			repoManager, explorerSvc, bcListener, _, err :=
+				newServicesWithFundedMarket(marketFee, false)
+			require.NoError(t, err)
+
+			swapParser := domain.SwapParserManager
+			t.Cleanup(func() { domain.SwapParserManager = swapParser })
+			mockedSwapParser := &mockSwapParser{}
+			mockedSwapParser.
+				On("DeserializeRequest", mock.Anything).Return(swapRequest, nil)
+			domain.SwapParserManager = mockedSwapParser
+
+			tradeID := uuid.New()
+			_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &tradeID)
+			require.NoError(t, err)
+			err = repoManager.TradeRepository().UpdateTrade(
+				ctx,
+				&tradeID,
+				func(tr *domain.Trade) (*domain.Trade, error) {
+					tr.MarketQuoteAsset = marketQuoteAsset
+					tr.MarketFee = marketFee
+					tr.MarketFixedBaseFee = int64(fixedBaseFee)
+					tr.MarketFixedQuoteFee = int64(fixedQuoteFee)
+					tr.FeeAsset = tt.feeAsset
+					tr.Status = domain.SettledStatus
+					tr.SwapRequest = domain.Swap{Message: randomBytes(100)}
+					return tr, nil
+				},
+			)
+			require.NoError(t, err)
+
+			operatorSvc := buildOperatorService(
+				repoManager, explorerSvc, bcListener,
+				operatorServiceOpts{},
+			)
+
+			_, err = operatorSvc.VerifyFeeLedger(ctx, true)
+			require.NoError(t, err)
+
+			report, err := operatorSvc.GetCollectedMarketFee(ctx, application.Market{
+				BaseAsset:  marketBaseAsset,
+				QuoteAsset: marketQuoteAsset,
+			})
+			require.NoError(t, err)
+			require.Len(t, report.CollectedFees, 1)
+
+			fee := report.CollectedFees[0]
+			require.Equal(t, tt.expectedAsset, fee.Asset)
+			require.Equal(t, tt.expectedFixedAmount, fee.FixedAmount)
+			require.Equal(t, tt.expectedBasisPointAmount, fee.BasisPointAmount)
+			require.Equal(t, fee.FixedAmount+fee.BasisPointAmount, fee.Amount)
+		})
+	}
+}
+
+func TestTradeVolume(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	// a trade settled before volumes were recorded in the fee ledger.
+	legacyTradeID := uuid.New()
+	_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &legacyTradeID)
+	require.NoError(t, err)
+	err = repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&legacyTradeID,
+		func(tr *domain.Trade) (*domain.Trade, error) {
+			tr.MarketQuoteAsset = marketQuoteAsset
+			tr.Status = domain.SettledStatus
+			tr.SwapRequest = domain.Swap{Message: randomBytes(100)}
+			return tr, nil
+		},
+	)
+	require.NoError(t, err)
+
+	fees := []domain.CollectedFee{
+		{
+			TradeID:          uuid.New(),
+			MarketQuoteAsset: marketQuoteAsset,
+			BaseVolume:       100000,
+			QuoteVolume:      5000000,
+			CollectionTime:   10,
+		},
+		{
+			TradeID:          uuid.New(),
+			MarketQuoteAsset: marketQuoteAsset,
+			BaseVolume:       200000,
+			QuoteVolume:      10000000,
+			CollectionTime:   20,
+		},
+		{
+			TradeID:          uuid.New(),
+			MarketQuoteAsset: randomHex(32),
+			BaseVolume:       300000,
+			QuoteVolume:      15000000,
+			CollectionTime:   20,
+		},
+		{
+			TradeID:          legacyTradeID,
+			MarketQuoteAsset: marketQuoteAsset,
+			Amount:           1000,
+			CollectionTime:   30,
+		},
+	}
+	for _, fee := range fees {
+		err := repoManager.FeeRepository().AddCollectedFee(ctx, fee)
+		require.NoError(t, err)
+	}
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	report, err := operatorSvc.TradeVolume(ctx, market, 10, 20)
+	require.NoError(t, err)
+	require.Equal(t, 2, report.TradeCount)
+	require.Equal(t, uint64(300000), report.BaseVolume)
+	require.Equal(t, uint64(15000000), report.QuoteVolume)
+
+	report, err = operatorSvc.TradeVolume(ctx, market, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 3, report.TradeCount)
+	// the volume of the legacy trade is taken from its swap request.
+	require.Greater(t, report.BaseVolume, uint64(300000))
+	require.Greater(t, report.QuoteVolume, uint64(15000000))
+
+	report, err = operatorSvc.TradeVolume(ctx, market, 40, 100)
+	require.NoError(t, err)
+	require.Zero(t, report.TradeCount)
+	require.Zero(t, report.BaseVolume)
+
+	t.Run("failing", func(t *testing.T) {
+		_, err := operatorSvc.TradeVolume(ctx, market, 20, 10)
+		require.EqualError(t, err, application.ErrInvalidTimeRange.Error())
+
+		_, err = operatorSvc.TradeVolume(ctx, application.Market{
+			BaseAsset:  marketBaseAsset,
+			QuoteAsset: randomHex(32),
+		}, 10, 20)
+		require.EqualError(t, err, application.ErrMarketNotExist.Error())
+	})
+}
+
+func TestReportMarketFeeRange(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	altAsset := randomHex(32)
+	fees := []domain.CollectedFee{
+		{
+			TradeID:          uuid.New(),
+			MarketQuoteAsset: marketQuoteAsset,
+			Asset:            marketBaseAsset,
+			Amount:           1000,
+			CollectionTime:   10,
+		},
+		{
+			TradeID:          uuid.New(),
+			MarketQuoteAsset: marketQuoteAsset,
+			Asset:            marketQuoteAsset,
+			Amount:           2000,
+			CollectionTime:   20,
+		},
+		{
+			TradeID:          uuid.New(),
+			MarketQuoteAsset: marketQuoteAsset,
+			Asset:            marketBaseAsset,
+			Amount:           3000,
+			CollectionTime:   30,
+		},
+		{
+			TradeID:          uuid.New(),
+			MarketQuoteAsset: altAsset,
+			Asset:            marketBaseAsset,
+			Amount:           4000,
+			CollectionTime:   20,
+		},
+	}
+	for _, fee := range fees {
+		err := repoManager.FeeRepository().AddCollectedFee(ctx, fee)
+		require.NoError(t, err)
+	}
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	tests := []struct {
+		name          string
+		from, to      uint64
+		expectedFees  int
+		expectedTotal map[string]int64
+	}{
+		{
+			name:         "whole history",
+			from:         0,
+			to:           100,
+			expectedFees: 3,
+			expectedTotal: map[string]int64{
+				marketBaseAsset:  4000,
+				marketQuoteAsset: 2000,
+			},
+		},
+		{
+			name:         "bounds included",
+			from:         10,
+			to:           20,
+			expectedFees: 2,
+			expectedTotal: map[string]int64{
+				marketBaseAsset:  1000,
+				marketQuoteAsset: 2000,
+			},
+		},
+		{
+			name:          "empty window",
+			from:          40,
+			to:            100,
+			expectedFees:  0,
+			expectedTotal: map[string]int64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := operatorSvc.ReportMarketFeeRange(ctx, market, tt.from, tt.to)
+			require.NoError(t, err)
+			require.Len(t, report.CollectedFees, tt.expectedFees)
+			require.Equal(t, tt.expectedTotal, report.TotalCollectedFeesPerAsset)
+		})
+	}
+
+	t.Run("failing", func(t *testing.T) {
+		_, err := operatorSvc.ReportMarketFeeRange(ctx, market, 20, 10)
+		require.EqualError(t, err, application.ErrInvalidTimeRange.Error())
+
+		_, err = operatorSvc.ReportMarketFeeRange(ctx, application.Market{
+			BaseAsset:  marketBaseAsset,
+			QuoteAsset: altAsset,
+		}, 10, 20)
+		require.EqualError(t, err, application.ErrMarketNotExist.Error())
+	})
+}
+
+func TestGetTradeByTxid(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	txid := hex.EncodeToString(randomBytes(32))
+	tradeID := uuid.New()
+	_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &tradeID)
+	require.NoError(t, err)
+	err = repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&tradeID,
+		func(tr *domain.Trade) (*domain.Trade, error) {
+			tr.MarketQuoteAsset = marketQuoteAsset
+			tr.MarketFee = marketFee
+			tr.Status = domain.CompletedStatus
+			tr.SwapRequest = domain.Swap{Message: randomBytes(100)}
+			tr.TxID = txid
+			return tr, nil
+		},
+	)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	trade, err := operatorSvc.GetTradeByTxid(ctx, txid)
+	require.NoError(t, err)
+	require.Equal(t, tradeID.String(), trade.ID)
+	require.Equal(t, marketQuoteAsset, trade.MarketWithFee.QuoteAsset)
+
+	_, err = operatorSvc.GetTradeByTxid(ctx, hex.EncodeToString(randomBytes(32)))
+	require.ErrorIs(t, err, application.ErrTradeNotFound)
+
+	_, err = operatorSvc.GetTradeByTxid(ctx, "invalidtxid")
+	require.ErrorIs(t, err, application.ErrInvalidTxid)
+}
+
+func TestExportTrades(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	trades := []struct {
+		status    domain.Status
+		timestamp uint64
+	}{
+		{domain.ProposalStatus, 10},
+		{domain.CompletedStatus, 20},
+		{domain.CompletedStatus, 40},
+	}
+	tradeIDs := make([]string, 0, len(trades))
+	for _, tt := range trades {
+		tradeID := uuid.New()
+		_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &tradeID)
+		require.NoError(t, err)
+		err = repoManager.TradeRepository().UpdateTrade(
+			ctx,
+			&tradeID,
+			func(tr *domain.Trade) (*domain.Trade, error) {
+				tr.MarketQuoteAsset = marketQuoteAsset
+				tr.MarketFee = marketFee
+				tr.Status = tt.status
+				tr.SwapRequest = domain.Swap{
+					Message:   randomBytes(100),
+					Timestamp: tt.timestamp,
+				}
+				return tr, nil
+			},
+		)
+		require.NoError(t, err)
+		tradeIDs = append(tradeIDs, tradeID.String())
+	}
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	export := &bytes.Buffer{}
+	err = operatorSvc.ExportTrades(
+		ctx, 5, 30, application.ExportFormatJSON, export,
+	)
+	require.NoError(t, err)
+
+	records := make([]application.TradeExportRecord, 0)
+	err = json.Unmarshal(export.Bytes(), &records)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, tradeIDs[0], records[0].ID)
+	require.Equal(t, "PROPOSAL", records[0].Status)
+	require.Empty(t, records[0].FeeAsset)
+	require.Equal(t, tradeIDs[1], records[1].ID)
+	require.Equal(t, "COMPLETED", records[1].Status)
+	require.Equal(t, uint64(20), records[1].RequestTimeUnix)
+	require.NotEmpty(t, records[1].FeeAsset)
+
+	export.Reset()
+	err = operatorSvc.ExportTrades(
+		ctx, 30, 40, application.ExportFormatCSV, export,
+	)
+	require.NoError(t, err)
+
+	rows, err := csv.NewReader(export).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, "id", rows[0][0])
+	require.Equal(t, tradeIDs[2], rows[1][0])
+
+	export.Reset()
+	err = operatorSvc.ExportTrades(
+		ctx, 50, 60, application.ExportFormatJSON, export,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "[]", export.String())
+
+	err = operatorSvc.ExportTrades(
+		ctx, 30, 20, application.ExportFormatCSV, export,
+	)
+	require.ErrorIs(t, err, application.ErrInvalidTimeRange)
+
+	err = operatorSvc.ExportTrades(
+		ctx, 20, 30, application.ExportFormat(-1), export,
+	)
+	require.ErrorIs(t, err, application.ErrUnknownExportFormat)
+}
+
+func TestTradeAudit(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	txid := randomHex(32)
+	audit := &domain.TradeAudit{
+		MarketInputs: []domain.TradeAuditOutpoint{
+			{
+				TxID:         randomHex(32),
+				AccountIndex: domain.MarketAccountStart,
+				Asset:        marketQuoteAsset,
+				Amount:       100000,
+			},
+		},
+		FeeInputs: []domain.TradeAuditOutpoint{
+			{
+				TxID:         randomHex(32),
+				AccountIndex: domain.FeeAccount,
+				Asset:        marketBaseAsset,
+				Amount:       5000,
+			},
+		},
+		MarketOutput: &domain.TradeAuditOutpoint{
+			TxID:         txid,
+			AccountIndex: domain.MarketAccountStart,
+			Asset:        marketBaseAsset,
+			Amount:       25000,
+		},
+		ChangeOutputs: []domain.TradeAuditOutpoint{
+			{
+				TxID:         txid,
+				VOut:         1,
+				AccountIndex: domain.FeeAccount,
+				Asset:        marketBaseAsset,
+				Amount:       4500,
+			},
+		},
+		CounterpartyOutput: &domain.TradeAuditOutpoint{
+			TxID:         txid,
+			VOut:         2,
+			AccountIndex: -1,
+			Asset:        marketQuoteAsset,
+			Amount:       100000,
+		},
+		CreatedAt: uint64(time.Now().Unix()),
+	}
+
+	auditedTradeID, notAuditedTradeID := uuid.New(), uuid.New()
+	for _, tradeID := range []uuid.UUID{auditedTradeID, notAuditedTradeID} {
+		tradeID := tradeID
+		_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &tradeID)
+		require.NoError(t, err)
+		err = repoManager.TradeRepository().UpdateTrade(
+			ctx,
+			&tradeID,
+			func(tr *domain.Trade) (*domain.Trade, error) {
+				tr.MarketQuoteAsset = marketQuoteAsset
+				tr.Status = domain.SettledStatus
+				tr.TxID = txid
+				if tradeID == auditedTradeID {
+					tr.Audit = audit
+				}
+				return tr, nil
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	record, err := operatorSvc.TradeAudit(ctx, auditedTradeID.String())
+	require.NoError(t, err)
+	require.Equal(t, auditedTradeID.String(), record.TradeID)
+	require.Equal(t, txid, record.TxID)
+	require.Len(t, record.MarketInputs, 1)
+	require.Len(t, record.FeeInputs, 1)
+	require.Len(t, record.ChangeOutputs, 1)
+	require.NotNil(t, record.MarketOutput)
+	require.NotNil(t, record.CounterpartyOutput)
+	require.Equal(t, -1, record.CounterpartyOutput.AccountIndex)
+	require.Equal(t, audit.CreatedAt, record.CreatedAt)
+
+	_, err = operatorSvc.TradeAudit(ctx, notAuditedTradeID.String())
+	require.ErrorIs(t, err, application.ErrTradeNotAudited)
+
+	_, err = operatorSvc.TradeAudit(ctx, uuid.New().String())
+	require.ErrorIs(t, err, application.ErrTradeNotFound)
+
+	_, err = operatorSvc.TradeAudit(ctx, "invalidtradeid")
+	require.ErrorIs(t, err, application.ErrInvalidTradeID)
+}
+
+func TestImportUtxo(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	info, err := repoManager.VaultRepository().
+		GetAllDerivedAddressesInfoForAccount(ctx, domain.MarketAccountStart)
+	require.NoError(t, err)
+	feeInfo, err := repoManager.VaultRepository().
+		GetAllDerivedAddressesInfoForAccount(ctx, domain.FeeAccount)
+	require.NoError(t, err)
+
+	ownedTxid, foreignTxid := randomHex(32), randomHex(32)
+	explorerSvc.(*mockExplorer).On("GetTransaction", ownedTxid).
+		Return(randomTxs(info[0].Address)[0], nil)
+	explorerSvc.(*mockExplorer).On("GetTransaction", foreignTxid).
+		Return(randomTxs(feeInfo[0].Address)[0], nil)
+	explorerSvc.(*mockExplorer).
+		On("IsTransactionConfirmed", mock.AnythingOfType("string")).
+		Return(true, nil)
+
+	blindingKey := randomBytes(32)
+	mockedBlinderManager := &mockBlinderManager{}
+	mockedBlinderManager.
+		On("UnblindOutput", mock.AnythingOfType("*transaction.TxOutput"), blindingKey).
+		Return(application.UnblindedResult(&transactionutil.UnblindedResult{
+			AssetHash:    marketBaseAsset,
+			Value:        randomValue(),
+			AssetBlinder: randomBytes(32),
+			ValueBlinder: randomBytes(32),
+		}), true)
+	prevBlinderManager := application.BlinderManager
+	application.BlinderManager = mockedBlinderManager
+	defer func() { application.BlinderManager = prevBlinderManager }()
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	outpoint := application.TxOutpoint{Hash: ownedTxid, Index: 0}
+	err = operatorSvc.ImportUtxo(
+		ctx, domain.MarketAccountStart, outpoint, blindingKey,
+	)
+	require.NoError(t, err)
+
+	unspent, err := repoManager.UnspentRepository().GetUnspentWithKey(
+		ctx, domain.UnspentKey{TxID: ownedTxid, VOut: 0},
+	)
+	require.NoError(t, err)
+	require.NotNil(t, unspent)
+	require.Equal(t, info[0].Address, unspent.Address)
+	require.Equal(t, marketBaseAsset, unspent.AssetHash)
+	require.Len(
+		t, repoManager.UnspentRepository().GetAllUnspents(ctx), len(unspents)+1,
+	)
+
+	t.Run("failing", func(t *testing.T) {
+		tests := []struct {
+			name         string
+			accountIndex int
+			outpoint     application.TxOutpoint
+			blindingKey  []byte
+			err          error
+		}{
+			{
+				name:         "fee_account",
+				accountIndex: domain.FeeAccount,
+				outpoint:     application.TxOutpoint{Hash: ownedTxid, Index: 1},
+				blindingKey:  blindingKey,
+				err:          application.ErrMarketNotExist,
+			},
+			{
+				name:         "unknown_market",
+				accountIndex: domain.MarketAccountStart + 10,
+				outpoint:     application.TxOutpoint{Hash: ownedTxid, Index: 1},
+				blindingKey:  blindingKey,
+				err:          application.ErrMarketNotExist,
+			},
+			{
+				name:         "missing_blinding_key",
+				accountIndex: domain.MarketAccountStart,
+				outpoint:     application.TxOutpoint{Hash: ownedTxid, Index: 1},
+				err:          application.ErrMissingBlindingKey,
+			},
+			{
+				name:         "already_imported",
+				accountIndex: domain.MarketAccountStart,
+				outpoint:     outpoint,
+				blindingKey:  blindingKey,
+				err:          application.ErrUtxoAlreadyExists,
+			},
+			{
+				name:         "invalid_outpoint",
+				accountIndex: domain.MarketAccountStart,
+				outpoint:     application.TxOutpoint{Hash: ownedTxid, Index: 10},
+				blindingKey:  blindingKey,
+				err:          application.ErrInvalidOutpoint,
+			},
+			{
+				name:         "not_owned",
+				accountIndex: domain.MarketAccountStart,
+				outpoint:     application.TxOutpoint{Hash: foreignTxid, Index: 0},
+				blindingKey:  blindingKey,
+				err:          application.ErrUtxoNotOwned,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := operatorSvc.ImportUtxo(
+					ctx, tt.accountIndex, tt.outpoint, tt.blindingKey,
+				)
+				require.EqualError(t, err, tt.err.Error())
+			})
+		}
+	})
+}
+
+func TestListLockedUtxos(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	acceptTime := uint64(time.Now().Unix())
+	tradeID := uuid.New()
+	_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &tradeID)
+	require.NoError(t, err)
+	err = repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&tradeID,
+		func(tr *domain.Trade) (*domain.Trade, error) {
+			tr.MarketQuoteAsset = marketQuoteAsset
+			tr.Status = domain.AcceptedStatus
+			tr.SwapAccept = domain.Swap{Timestamp: acceptTime}
+			return tr, nil
+		},
+	)
+	require.NoError(t, err)
+
+	// the last unspent is locked by a trade that doesn't exist.
+	mktUnspents := unspents[len(tradeFeeOutpoints):]
+	lockedUnspents := mktUnspents[:3]
+	unknownTradeID := uuid.New()
+	unspentRepo := repoManager.UnspentRepository()
+	_, err = unspentRepo.LockUnspents(
+		ctx,
+		[]domain.UnspentKey{lockedUnspents[0].Key(), lockedUnspents[1].Key()},
+		tradeID,
+	)
+	require.NoError(t, err)
+	_, err = unspentRepo.LockUnspents(
+		ctx, []domain.UnspentKey{lockedUnspents[2].Key()}, unknownTradeID,
+	)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	locks, err := operatorSvc.ListLockedUtxos(ctx)
+	require.NoError(t, err)
+	require.Len(t, locks, len(lockedUnspents))
+
+	locksByTxID := make(map[string]application.UtxoInfo)
+	for _, l := range locks {
+		locksByTxID[l.Outpoint.Hash] = l
+	}
+	for _, u := range lockedUnspents[:2] {
+		lock := locksByTxID[u.TxID]
+		require.Equal(t, tradeID.String(), lock.TradeID)
+		require.Equal(t, acceptTime, lock.LockTimestamp)
+	}
+	lock := locksByTxID[lockedUnspents[2].TxID]
+	require.Equal(t, unknownTradeID.String(), lock.TradeID)
+	require.Zero(t, lock.LockTimestamp)
+}
+
+func TestListUtxosForAccount(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	// market unspents are half of base asset and half of quote asset.
+	mktUnspents := unspents[len(tradeFeeOutpoints):]
+	lockedUnspent := mktUnspents[0]
+	spentUnspent := mktUnspents[len(mktUnspents)-1]
+	unconfirmedUnspent := lockedUnspent
+	unconfirmedUnspent.TxID = randomHex(32)
+	unconfirmedUnspent.Value = 1000
+	unconfirmedUnspent.Confirmed = false
+
+	unspentRepo := repoManager.UnspentRepository()
+	_, err = unspentRepo.LockUnspents(
+		ctx, []domain.UnspentKey{lockedUnspent.Key()}, uuid.New(),
+	)
+	require.NoError(t, err)
+	_, err = unspentRepo.SpendUnspents(
+		ctx, []domain.UnspentKey{spentUnspent.Key()},
+	)
+	require.NoError(t, err)
+	err = unspentRepo.AddUnspents(ctx, []domain.Unspent{unconfirmedUnspent})
+	require.NoError(t, err)
+
+	numOfUnspentsPerAsset := len(mktUnspents) / 2
+	tests := []struct {
+		name             string
+		filter           application.UtxoFilter
+		expectedUnspents int
+		expectedSpents   int
+		expectedLocks    int
+	}{
+		{
+			name:             "no filter",
+			expectedUnspents: 2*numOfUnspentsPerAsset - 1,
+			expectedSpents:   1,
+			expectedLocks:    1,
+		},
+		{
+			name:             "by asset",
+			filter:           application.UtxoFilter{Asset: marketQuoteAsset},
+			expectedUnspents: numOfUnspentsPerAsset - 1,
+			expectedSpents:   1,
+			expectedLocks:    0,
+		},
+		{
+			name:             "by min value",
+			filter:           application.UtxoFilter{MinValue: 5000000},
+			expectedUnspents: 2*numOfUnspentsPerAsset - 2,
+			expectedSpents:   1,
+			expectedLocks:    1,
+		},
+		{
+			name:             "confirmed only",
+			filter:           application.UtxoFilter{ConfirmedOnly: true},
+			expectedUnspents: 2*numOfUnspentsPerAsset - 2,
+			expectedSpents:   1,
+			expectedLocks:    1,
+		},
+		{
+			name: "by asset, min value and confirmed only",
+			filter: application.UtxoFilter{
+				Asset:         marketBaseAsset,
+				MinValue:      1000,
+				ConfirmedOnly: true,
+			},
+			expectedUnspents: numOfUnspentsPerAsset - 1,
+			expectedSpents:   0,
+			expectedLocks:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utxos, err := operatorSvc.ListUtxosForAccount(
+				ctx, domain.MarketAccountStart, tt.filter,
+			)
+			require.NoError(t, err)
+			require.Len(t, utxos.Unspents, tt.expectedUnspents)
+			require.Len(t, utxos.Spents, tt.expectedSpents)
+			require.Len(t, utxos.Locks, tt.expectedLocks)
+		})
+	}
+
+	_, err = operatorSvc.ListUtxosForAccount(
+		ctx, domain.MarketAccountStart+10, application.UtxoFilter{},
+	)
+	require.Error(t, err)
+}
+
+func TestTotalBalance(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	// both the fee account and the market hold the base asset, so its balance
+	// includes the unspents of both.
+	expected := make(map[string]application.BalanceInfo)
+	for _, u := range unspents {
+		b := expected[u.AssetHash]
+		b.TotalBalance += u.Value
+		b.ConfirmedBalance += u.Value
+		expected[u.AssetHash] = b
+	}
+	require.Len(t, expected, 2)
+
+	mktUnspents := unspents[len(tradeFeeOutpoints):]
+	spentUnspent := mktUnspents[len(mktUnspents)-1]
+	unconfirmedUnspent := mktUnspents[0]
+
+	unspentRepo := repoManager.UnspentRepository()
+	_, err = unspentRepo.SpendUnspents(
+		ctx, []domain.UnspentKey{spentUnspent.Key()},
+	)
+	require.NoError(t, err)
+	_, err = unspentRepo.UnconfirmUnspents(
+		ctx, []domain.UnspentKey{unconfirmedUnspent.Key()},
+	)
+	require.NoError(t, err)
+
+	b := expected[spentUnspent.AssetHash]
+	b.TotalBalance -= spentUnspent.Value
+	b.ConfirmedBalance -= spentUnspent.Value
+	expected[spentUnspent.AssetHash] = b
+	b = expected[unconfirmedUnspent.AssetHash]
+	b.ConfirmedBalance -= unconfirmedUnspent.Value
+	b.UnconfirmedBalance += unconfirmedUnspent.Value
+	expected[unconfirmedUnspent.AssetHash] = b
+
+	balances, err := operatorSvc.TotalBalance(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, balances)
+}
+
+func TestBalanceByLabel(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	mktUnspents := unspents[len(tradeFeeOutpoints):]
+	var totalBase, totalQuote uint64
+	for _, u := range mktUnspents {
+		if u.AssetHash == marketBaseAsset {
+			totalBase += u.Value
+		} else {
+			totalQuote += u.Value
+		}
+	}
+	labeledBase := mktUnspents[0]
+	labeledQuote := mktUnspents[len(mktUnspents)-1]
+	unlabeledBase := mktUnspents[1]
+	require.Equal(t, marketBaseAsset, unlabeledBase.AssetHash)
+
+	err = operatorSvc.LabelMarketDeposit(ctx, market, []application.TxOutpoint{
+		{Hash: labeledBase.TxID, Index: int(labeledBase.VOut)},
+		{Hash: labeledQuote.TxID, Index: int(labeledQuote.VOut)},
+	}, "alice")
+	require.NoError(t, err)
+
+	balances, err := operatorSvc.BalanceByLabel(ctx, market)
+	require.NoError(t, err)
+	require.Equal(t, map[string]application.Balance{
+		"alice": {
+			BaseAmount:  labeledBase.Value,
+			QuoteAmount: labeledQuote.Value,
+		},
+		"": {
+			BaseAmount:  totalBase - labeledBase.Value,
+			QuoteAmount: totalQuote - labeledQuote.Value,
+		},
+	}, balances)
+
+	// the market balance decreases, while the deposits don't, so the shares
+	// of the labels decrease proportionally.
+	_, err = repoManager.UnspentRepository().SpendUnspents(
+		ctx, []domain.UnspentKey{unlabeledBase.Key()},
+	)
+	require.NoError(t, err)
+	baseBalance := totalBase - unlabeledBase.Value
+
+	balances, err = operatorSvc.BalanceByLabel(ctx, market)
+	require.NoError(t, err)
+	require.Equal(t, map[string]application.Balance{
+		"alice": {
+			BaseAmount:  baseBalance * labeledBase.Value / totalBase,
+			QuoteAmount: labeledQuote.Value,
+		},
+		"": {
+			BaseAmount:  baseBalance * (totalBase - labeledBase.Value) / totalBase,
+			QuoteAmount: totalQuote - labeledQuote.Value,
+		},
+	}, balances)
+
+	t.Run("failing", func(t *testing.T) {
+		feeUnspent := unspents[0]
+		err := operatorSvc.LabelMarketDeposit(ctx, market, []application.TxOutpoint{
+			{Hash: feeUnspent.TxID, Index: int(feeUnspent.VOut)},
+		}, "alice")
+		require.EqualError(t, err, application.ErrUtxoNotFound.Error())
+
+		err = operatorSvc.LabelMarketDeposit(ctx, market, []application.TxOutpoint{
+			{Hash: labeledBase.TxID, Index: int(labeledBase.VOut)},
+		}, "")
+		require.EqualError(t, err, domain.ErrEmptyDepositLabel.Error())
+	})
+}
+
+func TestExcludeUtxo(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+	replaceWithUnconfidentialFunds(t, repoManager, unspents)
+
+	// the signer is used to catch the transaction without broadcasting it
+	signer := &mockSigner{err: errors.New("signing rejected")}
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{signer: signer},
+	)
+
+	mkt := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	addresses, err := operatorSvc.ListMarketExternalAddresses(ctx, mkt)
+	require.NoError(t, err)
+	withdrawReq := application.WithdrawMarketReq{
+		Market:            mkt,
+		BalanceToWithdraw: application.Balance{BaseAmount: 1000000},
+		MillisatPerByte:   100,
+		Address:           addresses[0],
+	}
+
+	unspentRepo := repoManager.UnspentRepository()
+	getAccountUnspents := func(accountIndex int) []domain.Unspent {
+		info, err := repoManager.VaultRepository().
+			GetAllDerivedAddressesInfoForAccount(ctx, accountIndex)
+		require.NoError(t, err)
+		unspents, err := unspentRepo.GetAvailableUnspentsForAddresses(
+			ctx, info.Addresses(),
+		)
+		require.NoError(t, err)
+		return unspents
+	}
+	feeUnspents := getAccountUnspents(domain.FeeAccount)
+	require.Len(t, feeUnspents, 2)
+	mktUnspents := getAccountUnspents(domain.MarketAccountStart)
+	require.Len(t, mktUnspents, 1)
+
+	excludedFeeUnspent := feeUnspents[0]
+	err = operatorSvc.ExcludeUtxo(ctx, application.TxOutpoint{
+		Hash:  excludedFeeUnspent.TxID,
+		Index: int(excludedFeeUnspent.VOut),
+	})
+	require.NoError(t, err)
+
+	_, err = operatorSvc.WithdrawMarketFunds(ctx, withdrawReq)
+	require.EqualError(t, err, signer.err.Error())
+	require.Len(t, signer.psets, 1)
+
+	ptx, err := pset.NewPsetFromBase64(signer.psets[0])
+	require.NoError(t, err)
+	for _, in := range ptx.UnsignedTx.Inputs {
+		require.False(t, excludedFeeUnspent.IsKeyEqual(domain.UnspentKey{
+			TxID: bufferutil.TxIDFromBytes(in.Hash),
+			VOut: in.Index,
+		}))
+	}
+
+	mktOutpoint := application.TxOutpoint{
+		Hash:  mktUnspents[0].TxID,
+		Index: int(mktUnspents[0].VOut),
+	}
+	err = operatorSvc.ExcludeUtxo(ctx, mktOutpoint)
+	require.NoError(t, err)
+
+	_, err = operatorSvc.WithdrawMarketFunds(ctx, withdrawReq)
+	require.Error(t, err)
+	require.NotEqual(t, signer.err.Error(), err.Error())
+	require.Len(t, signer.psets, 1)
+
+	// excluded utxos are still listed among the unspents, with a flag.
+	utxos, err := operatorSvc.ListUtxosForAccount(
+		ctx, domain.MarketAccountStart, application.UtxoFilter{},
+	)
+	require.NoError(t, err)
+	require.Len(t, utxos.Unspents, 1)
+	require.True(t, utxos.Unspents[0].Excluded)
+
+	err = operatorSvc.IncludeUtxo(ctx, mktOutpoint)
+	require.NoError(t, err)
+
+	utxos, err = operatorSvc.ListUtxosForAccount(
+		ctx, domain.MarketAccountStart, application.UtxoFilter{},
+	)
+	require.NoError(t, err)
+	require.Len(t, utxos.Unspents, 1)
+	require.False(t, utxos.Unspents[0].Excluded)
+}
+
+func TestFailingExcludeUtxo(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	tests := []struct {
+		name          string
+		outpoint      application.TxOutpoint
+		expectedError error
+	}{
+		{
+			name:          "invalid txid",
+			outpoint:      application.TxOutpoint{Hash: "invalid"},
+			expectedError: application.ErrInvalidTxid,
+		},
+		{
+			name:          "invalid index",
+			outpoint:      application.TxOutpoint{Hash: randomHex(32), Index: -1},
+			expectedError: application.ErrInvalidOutpoint,
+		},
+		{
+			name:          "unknown utxo",
+			outpoint:      application.TxOutpoint{Hash: randomHex(32)},
+			expectedError: application.ErrUtxoNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := operatorSvc.ExcludeUtxo(ctx, tt.outpoint)
+			require.EqualError(t, err, tt.expectedError.Error())
+
+			err = operatorSvc.IncludeUtxo(ctx, tt.outpoint)
+			require.EqualError(t, err, tt.expectedError.Error())
+		})
+	}
+}
+
+func TestSetStrategy(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+
+	mkt := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	balancedPrice, err := tradeSvc.GetMarketPrice(
+		ctx, mkt, application.TradeSell, 10000, marketBaseAsset,
+	)
+	require.NoError(t, err)
+
+	// the market is switched while open, and the new strategy is used for
+	// pricing right away.
+	price := application.Price{
+		BasePrice:  decimal.NewFromInt(40000),
+		QuotePrice: decimal.NewFromFloat(0.000025),
+	}
+	err = operatorSvc.SetStrategy(
+		ctx, mkt, domain.StrategyTypePluggable,
+		application.StrategyParams{Price: price},
+	)
+	require.NoError(t, err)
+
+	m, _, err := repoManager.MarketRepository().GetMarketByAsset(
+		ctx, marketQuoteAsset,
+	)
+	require.NoError(t, err)
+	require.True(t, m.IsTradable())
+	require.True(t, m.IsStrategyPluggable())
+
+	pluggablePrice, err := tradeSvc.GetMarketPrice(
+		ctx, mkt, application.TradeSell, 10000, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.True(t, price.BasePrice.Equal(pluggablePrice.Price.BasePrice))
+	require.True(t, price.QuotePrice.Equal(pluggablePrice.Price.QuotePrice))
+	require.Equal(t, balancedPrice.Balance, pluggablePrice.Balance)
+
+	err = operatorSvc.SetStrategy(
+		ctx, mkt, domain.StrategyTypeBalanced, application.StrategyParams{},
+	)
+	require.NoError(t, err)
+
+	preview, err := tradeSvc.GetMarketPrice(
+		ctx, mkt, application.TradeSell, 10000, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.True(t, balancedPrice.Price.BasePrice.Equal(preview.Price.BasePrice))
+
+	t.Run("failing", func(t *testing.T) {
+		tests := []struct {
+			name          string
+			market        application.Market
+			strategy      domain.StrategyType
+			params        application.StrategyParams
+			expectedError error
+		}{
+			{
+				name:          "unknown strategy",
+				market:        mkt,
+				strategy:      domain.StrategyTypeUnbalanced,
+				expectedError: application.ErrUnknownStrategy,
+			},
+			{
+				name:          "pluggable without price",
+				market:        mkt,
+				strategy:      domain.StrategyTypePluggable,
+				expectedError: domain.ErrMarketInvalidBasePrice,
+			},
+			{
+				name:          "balanced with price",
+				market:        mkt,
+				strategy:      domain.StrategyTypeBalanced,
+				params:        application.StrategyParams{Price: price},
+				expectedError: application.ErrInvalidStrategyParams,
+			},
+			{
+				name: "unknown market",
+				market: application.Market{
+					BaseAsset:  marketBaseAsset,
+					QuoteAsset: randomHex(32),
+				},
+				strategy:      domain.StrategyTypeBalanced,
+				expectedError: application.ErrMarketNotExist,
+			},
+		}
+
+		for _, tt := range tests {
+			err := operatorSvc.SetStrategy(ctx, tt.market, tt.strategy, tt.params)
+			require.EqualError(t, err, tt.expectedError.Error(), tt.name)
+		}
+
+		m, _, err := repoManager.MarketRepository().GetMarketByAsset(
+			ctx, marketQuoteAsset,
+		)
+		require.NoError(t, err)
+		require.False(t, m.IsStrategyPluggable())
+	})
+}
+
+func TestExportImportMarketsConfig(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	mkt := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	err = operatorSvc.SetStrategy(
+		ctx, mkt, domain.StrategyTypePluggable,
+		application.StrategyParams{Price: application.Price{
+			BasePrice:  decimal.NewFromInt(40000),
+			QuotePrice: decimal.NewFromFloat(0.000025),
+		}},
+	)
+	require.NoError(t, err)
+
+	config, err := operatorSvc.ExportMarketsConfig(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, config)
+
+	// the config is imported into a fresh daemon without funds.
+	newRepoManager, newExplorerSvc, newBcListener := newServices()
+	newOperatorSvc := buildOperatorService(
+		newRepoManager, newExplorerSvc, newBcListener,
+		operatorServiceOpts{},
+	)
+
+	created, err := newOperatorSvc.ImportMarketsConfig(ctx, config)
+	require.NoError(t, err)
+	require.Equal(t, []application.Market{mkt}, created)
+
+	m, accountIndex, err := newRepoManager.MarketRepository().GetMarketByAsset(
+		ctx, marketQuoteAsset,
+	)
+	require.NoError(t, err)
+	require.Equal(t, domain.MarketAccountStart, accountIndex)
+	require.Equal(t, int64(marketFee), m.Fee)
+	require.True(t, m.IsTradable())
+	require.True(t, m.IsStrategyPluggable())
+	require.True(t, decimal.NewFromInt(40000).Equal(m.BaseAssetPrice()))
+
+	// importing again the same config skips the existing markets.
+	created, err = newOperatorSvc.ImportMarketsConfig(ctx, config)
+	require.NoError(t, err)
+	require.Empty(t, created)
+
+	t.Run("failing", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			config string
+		}{
+			{
+				name:   "invalid json",
+				config: "{",
+			},
+			{
+				name:   "unsupported version",
+				config: `{"version":2,"markets":[]}`,
+			},
+			{
+				name: "invalid fee",
+				config: fmt.Sprintf(
+					`{"version":1,"markets":[{"account_index":6,"base_asset":"%s",`+
+						`"quote_asset":"%s","basis_point":10000,"strategy_type":1}]}`,
+					marketBaseAsset, randomHex(32),
+				),
+			},
+			{
+				name: "pluggable tradable market without price",
+				config: fmt.Sprintf(
+					`{"version":1,"markets":[{"account_index":6,"base_asset":"%s",`+
+						`"quote_asset":"%s","basis_point":25,"strategy_type":0,`+
+						`"tradable":true}]}`,
+					marketBaseAsset, randomHex(32),
+				),
+			},
+		}
+
+		for _, tt := range tests {
+			_, err := newOperatorSvc.ImportMarketsConfig(ctx, []byte(tt.config))
+			require.ErrorIs(t, err, application.ErrInvalidMarketsConfig, tt.name)
+		}
+	})
+}
+
+func TestUpdateMarketFee(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	mkt := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	effectiveAt := uint64(time.Now().Add(time.Hour).Unix())
+
+	// a future change can be scheduled while the market is open, and it
+	// doesn't affect the current fee.
+	res, err := operatorSvc.UpdateMarketFee(
+		ctx, mkt, application.Fee{BasisPoint: 50}, effectiveAt,
+	)
+	require.NoError(t, err)
+	require.Equal(t, marketFee, res.BasisPoint)
+
+	history, err := operatorSvc.MarketFeeHistory(ctx, mkt)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, int64(50), history[0].BasisPoint)
+	require.Equal(t, effectiveAt, history[0].EffectiveAt)
+	require.True(t, history[0].Pending)
+
+	// immediate changes still require the market to be closed.
+	_, err = operatorSvc.UpdateMarketFee(
+		ctx, mkt, application.Fee{BasisPoint: 50}, 0,
+	)
+	require.EqualError(t, err, domain.ErrMarketMustBeClosed.Error())
+
+	_, err = operatorSvc.MarketFeeHistory(ctx, application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: randomHex(32),
+	})
+	require.EqualError(t, err, application.ErrMarketNotExist.Error())
+}
+
+func TestRotateBlindingKey(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	var unusedInfo *domain.AddressInfo
+	err = repoManager.VaultRepository().UpdateVault(
+		ctx,
+		func(v *domain.Vault) (*domain.Vault, error) {
+			unusedInfo, err = v.DeriveNextExternalAddressForAccount(domain.FeeAccount)
+			if err != nil {
+				return nil, err
+			}
+			return v, nil
+		},
+	)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	rotated, err := operatorSvc.RotateBlindingKey(ctx, unusedInfo.Address)
+	require.NoError(t, err)
+	require.NotEqual(t, unusedInfo.Address, rotated.Address)
+	require.NotEqual(t, hex.EncodeToString(unusedInfo.BlindingKey), rotated.BlindingKey)
+
+	v, err := repoManager.VaultRepository().GetOrCreateVault(ctx, nil, "", nil)
+	require.NoError(t, err)
+	_, accountIndex, err := v.AccountByAddress(rotated.Address)
+	require.NoError(t, err)
+	require.Equal(t, domain.FeeAccount, accountIndex)
+
+	_, err = operatorSvc.RotateBlindingKey(ctx, unspents[0].Address)
+	require.EqualError(t, err, application.ErrAddressAlreadyUsed.Error())
+
+	_, err = operatorSvc.RotateBlindingKey(ctx, "notanaddress")
+	require.EqualError(t, err, application.ErrAddressNotOwned.Error())
+}
+
+func TestGetDepositAddress(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	first, err := operatorSvc.GetDepositAddress(ctx, market, 2)
+	require.NoError(t, err)
+	require.Len(t, first, 2)
+	second, err := operatorSvc.GetDepositAddress(ctx, market, 0)
+	require.NoError(t, err)
+	require.Len(t, second, 1)
+
+	v, err := repoManager.VaultRepository().GetOrCreateVault(ctx, nil, "", nil)
+	require.NoError(t, err)
+	addresses := make(map[string]bool)
+	for _, a := range append(first, second...) {
+		require.NotEmpty(t, a.BlindingKey)
+		require.False(t, addresses[a.Address])
+		addresses[a.Address] = true
+
+		_, accountIndex, err := v.AccountByAddress(a.Address)
+		require.NoError(t, err)
+		require.Equal(t, domain.MarketAccountStart, accountIndex)
+	}
+
+	_, err = operatorSvc.GetDepositAddress(ctx, application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: randomHex(32),
+	}, 1)
+	require.EqualError(t, err, application.ErrMarketNotExist.Error())
+}
+
+func TestListPendingDeposits(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	var info *domain.AddressInfo
+	err = repoManager.VaultRepository().UpdateVault(
+		ctx,
+		func(v *domain.Vault) (*domain.Vault, error) {
+			info, err = v.DeriveNextExternalAddressForAccount(domain.MarketAccountStart)
+			if err != nil {
+				return nil, err
+			}
+			return v, nil
+		},
+	)
+	require.NoError(t, err)
+
+	newUnspent := func() domain.Unspent {
+		return domain.Unspent{
+			TxID:      randomHex(32),
+			Value:     1000,
+			AssetHash: marketBaseAsset,
+			Address:   info.Address,
+		}
+	}
+	mempoolUnspent := newUnspent()
+	// confirmed since the utxo set was synced.
+	minedUnspent := newUnspent()
+	tradeUnspent := newUnspent()
+	err = repoManager.UnspentRepository().AddUnspents(
+		ctx, []domain.Unspent{mempoolUnspent, minedUnspent, tradeUnspent},
+	)
+	require.NoError(t, err)
+
+	trade := domain.Trade{
+		ID:     uuid.New(),
+		Status: domain.CompletedStatus,
+		TxID:   tradeUnspent.TxID,
+	}
+	_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &trade.ID)
+	require.NoError(t, err)
+	err = repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&trade.ID,
+		func(_ *domain.Trade) (*domain.Trade, error) { return &trade, nil },
+	)
+	require.NoError(t, err)
+
+	mockedExplorer := explorerSvc.(*mockExplorer)
+	// drop the catch-all stubs of the funded market reporting any tx as
+	// confirmed.
+	mockedExplorer.ExpectedCalls = nil
+	mockedExplorer.On("GetBlockHeight").Return(105, nil)
+	mockedExplorer.On("GetTransactionStatus", mempoolUnspent.TxID).
+		Return(map[string]interface{}{"confirmed": false}, nil)
+	mockedExplorer.On("GetTransactionStatus", minedUnspent.TxID).
+		Return(map[string]interface{}{
+			"confirmed":    true,
+			"block_height": float64(103),
+			"block_hash":   "block103",
+		}, nil)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	deposits, err := operatorSvc.ListPendingDeposits(
+		ctx, uint64(domain.MarketAccountStart),
+	)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+
+	require.Equal(t, mempoolUnspent.TxID, deposits[0].Outpoint.Hash)
+	require.Equal(t, info.Address, deposits[0].Address)
+	require.True(t, deposits[0].Pending)
+	require.Zero(t, deposits[0].Confirmations)
+
+	require.Equal(t, minedUnspent.TxID, deposits[1].Outpoint.Hash)
+	require.False(t, deposits[1].Pending)
+	require.Equal(t, uint64(3), deposits[1].Confirmations)
+
+	_, err = operatorSvc.ListPendingDeposits(ctx, 100)
+	require.EqualError(t, err, domain.ErrVaultAccountNotFound.Error())
+}
+
+func TestFailingBumpWithdrawFee(t *testing.T) {
+	operatorSvc, err := newOperatorService()
+	require.NoError(t, err)
+
+	_, err = operatorSvc.BumpWithdrawFee(ctx, randomHex(32), 200)
+	require.EqualError(t, err, application.ErrWithdrawalNotFound.Error())
+}
+
+func TestBumpWithdrawFee(t *testing.T) {
+	txManager := application.TransactionManager
+	application.TransactionManager = utxoSetTransactionManager{txManager}
+	t.Cleanup(func() { application.TransactionManager = txManager })
+
+	newOperatorSvc := func(t *testing.T) (
+		application.OperatorService, ports.RepoManager, string,
+	) {
+		repoManager, explorerSvc, bcListener, unspents, err :=
+			newServicesWithFundedMarket(marketFee, false)
+		require.NoError(t, err)
+		replaceWithUnconfidentialFunds(t, repoManager, unspents)
+		explorerSvc.(*mockExplorer).On("BroadcastTransaction", mock.Anything).
+			Return(randomHex(32), nil)
+		explorerSvc.(*mockExplorer).On("IsTransactionConfirmed", mock.Anything).
+			Return(false, nil)
+
+		operatorSvc := buildOperatorService(
+			repoManager, explorerSvc, bcListener,
+			operatorServiceOpts{},
+		)
+		addresses, err := operatorSvc.ListMarketExternalAddresses(
+			ctx, application.Market{
+				BaseAsset:  marketBaseAsset,
+				QuoteAsset: marketQuoteAsset,
+			},
+		)
+		require.NoError(t, err)
+		return operatorSvc, repoManager, addresses[0]
+	}
+	withdraw := func(
+		t *testing.T, operatorSvc application.OperatorService, addr string,
+		push bool,
+	) *transaction.Transaction {
+		rawTx, err := operatorSvc.WithdrawMarketFunds(ctx, application.WithdrawMarketReq{
+			Market: application.Market{
+				BaseAsset:  marketBaseAsset,
+				QuoteAsset: marketQuoteAsset,
+			},
+			BalanceToWithdraw: application.Balance{BaseAmount: 1000000},
+			MillisatPerByte:   100,
+			Address:           addr,
+			Push:              push,
+		})
+		require.NoError(t, err)
+		tx, err := transaction.NewTxFromHex(hex.EncodeToString(rawTx))
+		require.NoError(t, err)
+		return tx
+	}
+	// availableUnspents waits for the utxo set to be updated and returns its
+	// unspent coins, either confirmed or not, indexed by outpoint.
+	availableUnspents := func(
+		t *testing.T, repoManager ports.RepoManager,
+	) map[domain.UnspentKey]domain.Unspent {
+		time.Sleep(100 * time.Millisecond)
+
+		unspentsByKey := make(map[domain.UnspentKey]domain.Unspent)
+		for _, u := range repoManager.UnspentRepository().GetAllUnspents(ctx) {
+			if !u.Spent {
+				unspentsByKey[u.Key()] = u
+			}
+		}
+		return unspentsByKey
+	}
+	// requireUtxoSetUpdated checks that the coins spent by the given tx are not
+	// spendable anymore and that its outputs owned by the wallet, including
+	// the withdrawn one sent to the market itself, are.
+	requireUtxoSetUpdated := func(
+		t *testing.T, repoManager ports.RepoManager, tx *transaction.Transaction,
+	) {
+		unspents := availableUnspents(t, repoManager)
+		for _, in := range tx.Inputs {
+			key := domain.UnspentKey{
+				TxID: bufferutil.TxIDFromBytes(in.Hash),
+				VOut: in.Index,
+			}
+			require.NotContains(t, unspents, key)
+		}
+		txid := tx.TxHash().String()
+		for i := range tx.Outputs[:len(tx.Outputs)-1] {
+			key := domain.UnspentKey{TxID: txid, VOut: uint32(i)}
+			require.Contains(t, unspents, key)
+		}
+	}
+
+	t.Run("pushed", func(t *testing.T) {
+		operatorSvc, repoManager, addr := newOperatorSvc(t)
+
+		tx := withdraw(t, operatorSvc, addr, true)
+		requireUtxoSetUpdated(t, repoManager, tx)
+
+		txid := tx.TxHash().String()
+		newTxid, err := operatorSvc.BumpWithdrawFee(ctx, txid, 200)
+		require.NoError(t, err)
+		require.NotEqual(t, txid, newTxid)
+
+		// the outputs of the replaced tx are removed from the utxo set, while
+		// the coins of the market and those of the fee account it spent stay
+		// unspendable.
+		unspents := availableUnspents(t, repoManager)
+		newTxUnspents := 0
+		for key := range unspents {
+			require.NotEqual(t, txid, key.TxID)
+			if key.TxID == newTxid {
+				newTxUnspents++
+			}
+		}
+		require.NotZero(t, newTxUnspents)
+		for _, in := range tx.Inputs {
+			key := domain.UnspentKey{
+				TxID: bufferutil.TxIDFromBytes(in.Hash),
+				VOut: in.Index,
+			}
+			require.NotContains(t, unspents, key)
+		}
+
+		_, err = operatorSvc.BumpWithdrawFee(ctx, txid, 300)
+		require.EqualError(t, err, application.ErrWithdrawalNotFound.Error())
+	})
+
+	t.Run("multiple", func(t *testing.T) {
+		operatorSvc, repoManager, addr := newOperatorSvc(t)
+
+		txid, err := operatorSvc.WithdrawMultiple(ctx, []application.WithdrawMarketReq{
+			{
+				Market: application.Market{
+					BaseAsset:  marketBaseAsset,
+					QuoteAsset: marketQuoteAsset,
+				},
+				BalanceToWithdraw: application.Balance{BaseAmount: 1000000},
+				MillisatPerByte:   100,
+				Address:           addr,
+			},
+		})
+		require.NoError(t, err)
+
+		unspents := availableUnspents(t, repoManager)
+		require.Contains(t, unspents, domain.UnspentKey{TxID: txid, VOut: 0})
+
+		newTxid, err := operatorSvc.BumpWithdrawFee(ctx, txid, 200)
+		require.NoError(t, err)
+
+		unspents = availableUnspents(t, repoManager)
+		require.NotContains(t, unspents, domain.UnspentKey{TxID: txid, VOut: 0})
+		require.Contains(t, unspents, domain.UnspentKey{TxID: newTxid, VOut: 0})
+	})
+
+	t.Run("not_pushed", func(t *testing.T) {
+		operatorSvc, repoManager, addr := newOperatorSvc(t)
+
+		tx := withdraw(t, operatorSvc, addr, false)
+		requireUtxoSetUpdated(t, repoManager, tx)
+
+		_, err := operatorSvc.BumpWithdrawFee(ctx, tx.TxHash().String(), 200)
+		require.EqualError(t, err, application.ErrWithdrawalNotFound.Error())
+	})
+}
+
+func TestConsolidateFeeAccount(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+	replaceWithUnconfidentialFunds(t, repoManager, unspents)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	txid, count, err := operatorSvc.ConsolidateFeeAccount(ctx, 10, 100)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	var txHex string
+	for _, call := range explorerSvc.(*mockExplorer).Calls {
+		if call.Method == "BroadcastTransaction" {
+			txHex = call.Arguments.String(0)
+		}
+	}
+	tx, err := transaction.NewTxFromHex(txHex)
+	require.NoError(t, err)
+	require.Equal(t, txid, tx.TxHash().String())
+	require.Len(t, tx.Inputs, 2)
+	// the consolidated output, net of the network fees, and the fee output.
+	require.Len(t, tx.Outputs, 2)
+	require.True(t, tx.Outputs[0].IsConfidential())
+	require.Empty(t, tx.Outputs[1].Script)
+	require.Greater(t, bufferutil.ValueFromBytes(tx.Outputs[1].Value), uint64(0))
+}
+
+func TestFailingConsolidateFeeAccount(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	tests := []struct {
+		name            string
+		maxInputs       int
+		milliSatPerByte int64
+		err             error
+	}{
+		{
+			name:            "invalid_max_inputs",
+			maxInputs:       1,
+			milliSatPerByte: 100,
+			err:             application.ErrInvalidMaxInputs,
+		},
+		{
+			name:            "dust_only",
+			maxInputs:       len(tradeFeeOutpoints),
+			milliSatPerByte: 100000,
+			err:             application.ErrNothingToConsolidate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := operatorSvc.ConsolidateFeeAccount(
+				ctx, tt.maxInputs, tt.milliSatPerByte,
+			)
+			require.EqualError(t, err, tt.err.Error())
+		})
+	}
+}
+
+func TestSweepFeeAccount(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+	replaceWithUnconfidentialFunds(t, repoManager, unspents)
+
+	// the signer is used to catch the transaction without broadcasting it
+	signer := &mockSigner{err: errors.New("signing rejected")}
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{signer: signer},
+	)
+
+	addresses, err := operatorSvc.ListMarketExternalAddresses(ctx, application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	})
+	require.NoError(t, err)
+	sweepScript, err := address.ToOutputScript(addresses[0])
+	require.NoError(t, err)
+
+	info, err := repoManager.VaultRepository().
+		GetAllDerivedAddressesInfoForAccount(ctx, domain.FeeAccount)
+	require.NoError(t, err)
+	feeUnspents, err := repoManager.UnspentRepository().
+		GetAvailableUnspentsForAddresses(ctx, info.Addresses())
+	require.NoError(t, err)
+	require.Len(t, feeUnspents, 2)
+
+	// one of the two 5000 sats coins funds the sweep of 4000 sats, the other
+	// pays for the network fees.
+	_, err = operatorSvc.SweepFeeAccount(ctx, application.FeeSweep{
+		Address:         addresses[0],
+		Threshold:       6000,
+		MilliSatPerByte: 100,
+	})
+	require.EqualError(t, err, signer.err.Error())
+	require.Len(t, signer.psets, 1)
+
+	ptx, err := pset.NewPsetFromBase64(signer.psets[0])
+	require.NoError(t, err)
+	require.Len(t, ptx.UnsignedTx.Inputs, len(feeUnspents))
+	for _, in := range ptx.UnsignedTx.Inputs {
+		key := domain.UnspentKey{
+			TxID: bufferutil.TxIDFromBytes(in.Hash),
+			VOut: in.Index,
+		}
+		require.True(t,
+			feeUnspents[0].IsKeyEqual(key) || feeUnspents[1].IsKeyEqual(key),
+		)
+	}
+	require.Equal(t, sweepScript, ptx.UnsignedTx.Outputs[0].Script)
+}
+
+func TestFailingSweepFeeAccount(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+	replaceWithUnconfidentialFunds(t, repoManager, unspents)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{
+			withdrawalFeeRate: application.FeeRateFloor{MinMilliSatPerByte: 100},
+		},
+	)
+
+	addresses, err := operatorSvc.ListMarketExternalAddresses(ctx, application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		sweep application.FeeSweep
+		err   error
+	}{
+		{
+			name: "balance_below_threshold",
+			sweep: application.FeeSweep{
+				Address:   addresses[0],
+				Threshold: 10000,
+			},
+			err: application.ErrNothingToSweep,
+		},
+		{
+			name: "fee_rate_below_floor",
+			sweep: application.FeeSweep{
+				Address:         addresses[0],
+				Threshold:       6000,
+				MilliSatPerByte: 50,
+			},
+			err: application.ErrFeeRateBelowFloor,
+		},
+		{
+			name: "no_coins_left_for_fees",
+			sweep: application.FeeSweep{
+				Address:   addresses[0],
+				Threshold: 4000,
+			},
+			err: application.ErrWithdrawInsufficientBalance,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := operatorSvc.SweepFeeAccount(ctx, tt.sweep)
+			require.True(t, errors.Is(err, tt.err), err)
+		})
+	}
+}
+
+func TestProveReserves(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	proof, err := operatorSvc.ProveReserves(ctx, "auditor-nonce")
+	require.NoError(t, err)
+	require.Equal(t, "auditor-nonce", proof.Nonce)
+	require.NoError(t, proof.Verify())
+
+	count := 0
+	for _, account := range proof.Accounts {
+		count += len(account.Outpoints)
+	}
+	require.Equal(t, len(unspents), count)
+
+	proof.Nonce = "another-nonce"
+	require.ErrorIs(t, proof.Verify(), application.ErrInvalidReserveProof)
+	proof.Nonce = "auditor-nonce"
+
+	account := proof.Accounts[len(proof.Accounts)-1]
+	account.Outpoints[0].Amount++
+	require.ErrorIs(t, proof.Verify(), application.ErrInvalidReserveProof)
+}
+
+func TestRescanAccountDryRun(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+
+	// on-chain, the last unspent of the market has been spent and a new one
+	// has been received.
+	mktUnspents := unspents[len(tradeFeeOutpoints):]
+	spentUnspent := mktUnspents[len(mktUnspents)-1]
+	newUnspent := mktUnspents[0]
+	newUnspent.TxID = randomHex(32)
+	newUnspent.Value = 1000
+	onchainUtxos := make([]explorer.Utxo, 0, len(mktUnspents))
+	for _, u := range mktUnspents[:len(mktUnspents)-1] {
+		onchainUtxos = append(onchainUtxos, u.ToUtxo())
+	}
+	onchainUtxos = append(onchainUtxos, newUnspent.ToUtxo())
+
+	mockedExplorer := explorerSvc.(*mockExplorer)
+	mockedExplorer.On("GetTransactionsForAddress", mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mockedExplorer.On("GetUnspentsForAddresses", mock.Anything, mock.Anything).
+		Return(onchainUtxos, nil)
+
+	diff, err := operatorSvc.RescanAccountDryRun(ctx, domain.MarketAccountStart)
+	require.NoError(t, err)
+	require.Len(t, diff.UnspentsToAdd, 1)
+	require.Equal(t, newUnspent.TxID, diff.UnspentsToAdd[0].Outpoint.Hash)
+	require.Equal(t, newUnspent.Value, diff.UnspentsToAdd[0].Value)
+	require.Len(t, diff.UnspentsToSpend, 1)
+	require.Equal(t, spentUnspent.TxID, diff.UnspentsToSpend[0].Outpoint.Hash)
+	require.Equal(t, spentUnspent.Value, diff.UnspentsToSpend[0].Value)
+
+	// nothing has changed.
+	unspentRepo := repoManager.UnspentRepository()
+	u, err := unspentRepo.GetUnspentWithKey(ctx, newUnspent.Key())
+	require.NoError(t, err)
+	require.Nil(t, u)
+	u, err = unspentRepo.GetUnspentWithKey(ctx, spentUnspent.Key())
+	require.NoError(t, err)
+	require.False(t, u.IsSpent())
+
+	_, err = operatorSvc.RescanAccountDryRun(ctx, domain.MarketAccountStart+10)
+	require.EqualError(t, err, domain.ErrVaultAccountNotFound.Error())
+}
+
+func TestFailingRescanAccount(t *testing.T) {
+	operatorSvc, err := newOperatorService()
+	require.NoError(t, err)
+
+	tests := []struct {
+		accountIndex int
+		err          error
+	}{
+		{
+			accountIndex: -1,
+			err:          domain.ErrInvalidAccount,
+		},
+		{
+			accountIndex: domain.MarketAccountStart + 10,
+			err:          domain.ErrVaultAccountNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := operatorSvc.RescanAccount(ctx, tt.accountIndex)
+		require.EqualError(t, err, tt.err.Error())
+	}
+}
+
 // newOperatorService returns a new service with brand new and unlocked wallet.
+// replaceWithUnconfidentialFunds replaces the funds of the fixture, whose
+// commitments are random, with unconfidential ones that can be added to a
+// transaction.
+func replaceWithUnconfidentialFunds(
+	t *testing.T, repoManager ports.RepoManager, unspents []domain.Unspent,
+) {
+	keys := make([]domain.UnspentKey, 0, len(unspents))
+	for _, u := range unspents {
+		keys = append(keys, u.Key())
+	}
+	_, err := repoManager.UnspentRepository().SpendUnspents(ctx, keys)
+	require.NoError(t, err)
+
+	newUnspents := make([]domain.Unspent, 0)
+	for accountIndex, values := range map[int][]uint64{
+		domain.MarketAccountStart: {100000000},
+		domain.FeeAccount:         {5000, 5000},
+	} {
+		info, err := repoManager.VaultRepository().
+			GetAllDerivedAddressesInfoForAccount(ctx, accountIndex)
+		require.NoError(t, err)
+		script, _ := hex.DecodeString(info[0].Script)
+		for _, value := range values {
+			newUnspents = append(newUnspents, domain.Unspent{
+				TxID:         randomHex(32),
+				Value:        value,
+				AssetHash:    marketBaseAsset,
+				ScriptPubKey: script,
+				Address:      info[0].Address,
+				Confirmed:    true,
+			})
+		}
+	}
+	err = repoManager.UnspentRepository().AddUnspents(ctx, newUnspents)
+	require.NoError(t, err)
+}
+
+// operatorServiceOpts are the dependencies of the operator services built by
+// buildOperatorService that tests might want to override. Those left zero are
+// either disabled or replaced by the defaults of the tests.
+type operatorServiceOpts struct {
+	tradeFeed                  *application.TradeFeed
+	signer                     application.Signer
+	fiatPriceSvc               fiatprice.Service
+	assetRegistrySvc           assetregistry.Service
+	faucet                     application.Faucet
+	network                    *network.Network
+	feeAccountBalanceThreshold uint64
+	withdrawalFeeRate          application.FeeRateFloor
+	dustThreshold              uint64
+	antiFeeSniping             bool
+}
+
+// buildOperatorService returns an operator service for the given services
+// with the market fee of the tests. Unless given, it has its own trade feed
+// and runs on regtest.
+func buildOperatorService(
+	repoManager ports.RepoManager,
+	explorerSvc explorer.Service,
+	bcListener application.BlockchainListener,
+	opts operatorServiceOpts,
+) application.OperatorService {
+	if opts.tradeFeed == nil {
+		opts.tradeFeed = application.NewTradeFeed()
+	}
+	if opts.network == nil {
+		opts.network = regtest
+	}
+	return application.NewOperatorService(
+		repoManager,
+		explorerSvc,
+		bcListener,
+		opts.tradeFeed,
+		opts.signer,
+		opts.fiatPriceSvc,
+		opts.assetRegistrySvc,
+		opts.faucet,
+		marketBaseAsset,
+		marketFee,
+		opts.network,
+		opts.feeAccountBalanceThreshold,
+		opts.withdrawalFeeRate,
+		opts.dustThreshold,
+		opts.antiFeeSniping,
+	)
+}
+
 func newOperatorService() (application.OperatorService, error) {
 	repoManager, explorerSvc, bcListener := newServices()
 
@@ -147,14 +3567,12 @@ func newOperatorService() (application.OperatorService, error) {
 	explorerSvc.(*mockExplorer).
 		On("IsTransactionConfirmed", mock.AnythingOfType("string")).
 		Return(true, nil)
+	explorerSvc.(*mockExplorer).
+		On("GetTransactionStatus", mock.AnythingOfType("string")).
+		Return(map[string]interface{}{"confirmed": true}, nil)
 
-	return application.NewOperatorService(
-		repoManager,
-		explorerSvc,
-		bcListener,
-		marketBaseAsset,
-		marketFee,
-		regtest,
-		feeBalanceThreshold,
+	return buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{feeAccountBalanceThreshold: feeBalanceThreshold},
 	), nil
 }
@@ -0,0 +1,67 @@
+package application
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+// peerFees holds the basis point fees charged to designated peers, like
+// trusted market makers, in place of those of the markets. Peers are
+// identified by the name they've been authenticated with, see
+// WithAuthenticatedPeer, never by their network address that could be shared
+// by unrelated traders.
+type peerFees struct {
+	lock *sync.RWMutex
+	fees map[string]int64
+}
+
+func newPeerFees() *peerFees {
+	return &peerFees{
+		lock: &sync.RWMutex{},
+		fees: make(map[string]int64),
+	}
+}
+
+func (p *peerFees) set(fees map[string]int64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.fees = make(map[string]int64, len(fees))
+	for peer, fee := range fees {
+		p.fees[peer] = fee
+	}
+}
+
+// forMarket returns the given market as priced for the peer of the given
+// context. That's a copy of the market charging the fee of the peer, if it
+// has one, or the market itself otherwise. The copy must not be persisted.
+func (p *peerFees) forMarket(
+	ctx context.Context,
+	mkt *domain.Market,
+) *domain.Market {
+	peer := authenticatedPeerFromContext(ctx)
+	if peer == "" {
+		return mkt
+	}
+
+	p.lock.RLock()
+	fee, ok := p.fees[peer]
+	p.lock.RUnlock()
+	if !ok {
+		return mkt
+	}
+
+	m := *mkt
+	m.Fee = fee
+	m.FeeHistory = nil
+	return &m
+}
+
+// SetPeerFees makes the service charge the given basis point fees, whatever
+// the market, to the swaps of the related authenticated peers, replacing any
+// previous one. Other peers are charged the fee of the market.
+func (t *tradeService) SetPeerFees(fees map[string]int64) {
+	t.peerFees.set(fees)
+}
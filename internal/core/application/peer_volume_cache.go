@@ -0,0 +1,142 @@
+package application
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+// PeerVolumeCache keeps the amount of base asset exchanged by every
+// authenticated peer with the trades settled with each market, so that the
+// fee tier reached by a peer is found without scanning all the trades of the
+// market at every request. The volumes of a market are loaded from the trades
+// of the repository the first time they're requested, and are then kept up to
+// date by the services sharing the cache as trades settle, are reorged or
+// fail. A nil cache loads the volumes from the repository at every request.
+type PeerVolumeCache struct {
+	lock    *sync.Mutex
+	markets map[string]*marketPeerVolumes
+}
+
+type marketPeerVolumes struct {
+	volumes map[string]uint64
+	// trades are the volumes of those the peer volumes are made of, so that a
+	// trade settled again after a reorg is not counted twice, and that of a
+	// trade no longer settled can be subtracted.
+	trades map[uuid.UUID]uint64
+}
+
+// NewPeerVolumeCache returns a new empty PeerVolumeCache.
+func NewPeerVolumeCache() *PeerVolumeCache {
+	return &PeerVolumeCache{
+		lock:    &sync.Mutex{},
+		markets: make(map[string]*marketPeerVolumes),
+	}
+}
+
+// volume returns the volume of the given peer with the given market, loading
+// those of all peers from the settled trades of the market if not cached yet.
+func (c *PeerVolumeCache) volume(
+	ctx context.Context,
+	repoManager ports.RepoManager,
+	mkt *domain.Market,
+	peer string,
+) (uint64, error) {
+	if c == nil {
+		volumes, err := loadMarketPeerVolumes(ctx, repoManager, mkt)
+		if err != nil {
+			return 0, err
+		}
+		return volumes.volumes[peer], nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	volumes, ok := c.markets[mkt.QuoteAsset]
+	if !ok {
+		var err error
+		volumes, err = loadMarketPeerVolumes(ctx, repoManager, mkt)
+		if err != nil {
+			return 0, err
+		}
+		c.markets[mkt.QuoteAsset] = volumes
+	}
+	return volumes.volumes[peer], nil
+}
+
+// updateTrade adds the volume of the given trade to that of its peer if it's
+// settled, or subtracts it if it was counted but the trade has been reorged
+// or has failed since then. The trade is ignored if the volumes of its
+// market are not cached yet, since it is going to be loaded from the
+// repository along with the others.
+func (c *PeerVolumeCache) updateTrade(
+	trade *domain.Trade,
+	marketBaseAsset string,
+) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if volumes, ok := c.markets[trade.MarketQuoteAsset]; ok {
+		volumes.update(trade, marketBaseAsset)
+	}
+}
+
+func loadMarketPeerVolumes(
+	ctx context.Context,
+	repoManager ports.RepoManager,
+	mkt *domain.Market,
+) (*marketPeerVolumes, error) {
+	trades, err := repoManager.TradeRepository().GetCompletedTradesByMarket(
+		ctx, mkt.QuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := &marketPeerVolumes{
+		volumes: make(map[string]uint64),
+		trades:  make(map[uuid.UUID]uint64),
+	}
+	for _, trade := range trades {
+		volumes.update(trade, mkt.BaseAsset)
+	}
+	return volumes, nil
+}
+
+func (v *marketPeerVolumes) update(trade *domain.Trade, marketBaseAsset string) {
+	volume, counted := v.trades[trade.ID]
+	if !isTradeForPeerVolume(trade) {
+		if counted {
+			v.volumes[trade.Peer] -= volume
+			delete(v.trades, trade.ID)
+		}
+		return
+	}
+	if counted {
+		return
+	}
+
+	swapRequest := trade.SwapRequestMessage()
+	if swapRequest == nil {
+		return
+	}
+	baseVolume, _ := tradeVolume(swapRequest, marketBaseAsset)
+	v.volumes[trade.Peer] += baseVolume
+	v.trades[trade.ID] = baseVolume
+}
+
+// isTradeForPeerVolume returns whether the given trade counts for the volume
+// of its peer, that's if it has been proposed by an authenticated peer and is
+// currently settled in the best chain.
+func isTradeForPeerVolume(trade *domain.Trade) bool {
+	return trade.Peer != "" && trade.IsSettled() &&
+		!trade.IsReorged() && !trade.IsRejected()
+}
@@ -0,0 +1,103 @@
+package application
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+)
+
+// ListPendingDeposits returns the unconfirmed utxos received by the external
+// addresses of the given account, apart from those of its trades. Since the
+// stored utxo set may lag behind the blockchain, the status of their txs is
+// checked with the explorer, so that those confirmed in the meantime are
+// returned as no longer pending, along with their number of confirmations.
+// Pending deposits don't count for the confirmed balance of the account.
+func (o *operatorService) ListPendingDeposits(
+	ctx context.Context,
+	accountIndex uint64,
+) ([]PendingDeposit, error) {
+	vault, err := o.repoManager.VaultRepository().GetOrCreateVault(
+		ctx, nil, "", nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := vault.AccountByIndex(int(accountIndex)); err != nil {
+		return nil, err
+	}
+
+	info, err := o.repoManager.VaultRepository().
+		GetAllDerivedExternalAddressesInfoForAccount(ctx, int(accountIndex))
+	if err != nil {
+		return nil, err
+	}
+	unspents, err := o.repoManager.UnspentRepository().
+		GetAllUnspentsForAddresses(ctx, info.Addresses())
+	if err != nil {
+		return nil, err
+	}
+
+	trades, err := o.repoManager.TradeRepository().GetAllTrades(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tradeTxIDs := make(map[string]bool, len(trades))
+	for _, trade := range trades {
+		tradeTxIDs[trade.TxID] = true
+	}
+
+	deposits := make([]PendingDeposit, 0)
+	heightByTxID := make(map[string]uint64)
+	var tipHeight uint64
+	for _, u := range unspents {
+		if u.IsSpent() || u.IsConfirmed() || tradeTxIDs[u.TxID] {
+			continue
+		}
+
+		blockHeight, ok := heightByTxID[u.TxID]
+		if !ok {
+			height, _, err := getTxBlock(
+				explorer.WithContext(ctx, o.explorerSvc), u.TxID,
+			)
+			if err != nil && err != ErrTxNotConfirmed {
+				return nil, err
+			}
+			blockHeight = height
+			heightByTxID[u.TxID] = blockHeight
+		}
+
+		deposit := PendingDeposit{
+			UtxoInfo: newUtxoInfo(u),
+			Address:  u.Address,
+			Pending:  blockHeight == 0,
+		}
+		if !deposit.Pending {
+			if tipHeight == 0 {
+				height, err := explorer.WithContext(ctx, o.explorerSvc).
+					GetBlockHeight()
+				if err != nil {
+					return nil, err
+				}
+				tipHeight = uint64(height)
+			}
+			if tipHeight >= blockHeight {
+				deposit.Confirmations = tipHeight - blockHeight + 1
+			}
+		}
+		deposits = append(deposits, deposit)
+	}
+
+	// pending deposits first, then by outpoint.
+	sort.Slice(deposits, func(i, j int) bool {
+		a, b := deposits[i], deposits[j]
+		if a.Pending != b.Pending {
+			return a.Pending
+		}
+		if a.Outpoint.Hash != b.Outpoint.Hash {
+			return a.Outpoint.Hash < b.Outpoint.Hash
+		}
+		return a.Outpoint.Index < b.Outpoint.Index
+	})
+	return deposits, nil
+}
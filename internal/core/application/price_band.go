@@ -0,0 +1,131 @@
+package application
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/pkg/fiatprice"
+)
+
+// PriceOracle is an external source of fair prices for the markets, used to
+// bound their prices whatever their strategy. It can be bound to the markets
+// at runtime with TradeService's SetPriceOracle.
+type PriceOracle interface {
+	// ReferencePrice returns the amount of quote asset for 1 unit of base asset
+	// of the given market.
+	ReferencePrice(market Market) (decimal.Decimal, error)
+}
+
+// fiatPriceOracle derives the reference price of a market from the fiat
+// prices of its assets.
+type fiatPriceOracle struct {
+	fiatPriceSvc fiatprice.Service
+}
+
+// NewFiatPriceOracle returns a PriceOracle whose reference price of a market
+// is the ratio between the current fiat prices of its base and quote assets.
+func NewFiatPriceOracle(fiatPriceSvc fiatprice.Service) PriceOracle {
+	return fiatPriceOracle{fiatPriceSvc}
+}
+
+func (o fiatPriceOracle) ReferencePrice(market Market) (decimal.Decimal, error) {
+	now := uint64(time.Now().Unix())
+	basePrice, err := o.fiatPriceSvc.GetPrice(market.BaseAsset, now)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	quotePrice, err := o.fiatPriceSvc.GetPrice(market.QuoteAsset, now)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if !quotePrice.IsPositive() {
+		return decimal.Zero, fiatprice.ErrInvalidPrice
+	}
+	return basePrice.Div(quotePrice), nil
+}
+
+// priceBand holds the oracle bound to the markets along with the max
+// deviation, in basis points, of their prices from the reference ones.
+type priceBand struct {
+	oracle       PriceOracle
+	maxDeviation uint64
+	lock         *sync.RWMutex
+}
+
+func newPriceBand() *priceBand {
+	return &priceBand{lock: &sync.RWMutex{}}
+}
+
+func (b *priceBand) set(oracle PriceOracle, maxDeviation uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.oracle = oracle
+	b.maxDeviation = maxDeviation
+}
+
+// validate returns an error if the spot price of the given market, whatever
+// its strategy, deviates from the reference price of the oracle by more than
+// the max deviation, or if any of the two prices is not available. The
+// returned errors are meant to be sent to traders, therefore the prices are
+// only logged. The check is disabled if no oracle is bound.
+func (b *priceBand) validate(
+	market *domain.Market,
+	strategy PricingStrategy,
+	unspents []domain.Unspent,
+) error {
+	b.lock.RLock()
+	oracle, maxDeviation := b.oracle, b.maxDeviation
+	b.lock.RUnlock()
+
+	if oracle == nil {
+		return nil
+	}
+
+	balances := getBalanceByAsset(unspents)
+	spotPrice, err := strategy.SpotPrice(
+		balances[market.BaseAsset], balances[market.QuoteAsset],
+	)
+	if err != nil {
+		log.WithError(err).Warnf(
+			"price band: unable to get spot price of market %s", market.QuoteAsset,
+		)
+		return ErrPriceBandUnavailable
+	}
+
+	referencePrice, err := oracle.ReferencePrice(Market{
+		BaseAsset:  market.BaseAsset,
+		QuoteAsset: market.QuoteAsset,
+	})
+	if err == nil && !referencePrice.IsPositive() {
+		err = errors.New("reference price must be a positive number")
+	}
+	if err != nil {
+		log.WithError(err).Warnf(
+			"price band: unable to get reference price of market %s",
+			market.QuoteAsset,
+		)
+		return ErrPriceBandUnavailable
+	}
+
+	deviation := spotPrice.QuotePrice.Sub(referencePrice).Abs().
+		Div(referencePrice).
+		Mul(decimal.NewFromInt(10000))
+	if deviation.GreaterThan(decimal.NewFromInt(int64(maxDeviation))) {
+		log.Warnf(
+			"price band: spot price %s of market %s deviates from reference "+
+				"price %s by %s basis points, more than the allowed %d",
+			spotPrice.QuotePrice.StringFixed(8),
+			market.QuoteAsset,
+			referencePrice.StringFixed(8),
+			deviation.StringFixed(0),
+			maxDeviation,
+		)
+		return ErrPriceOutOfBand
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package application
+
+import (
+	"sync"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	mm "github.com/tdex-network/tdex-daemon/pkg/marketmaking"
+)
+
+// PricingStrategy defines the interface to implement for pricing the trades
+// of a market. Custom strategies, like one backed by an external oracle price
+// feed, can be bound to a market at runtime with TradeService's
+// SetMarketStrategy.
+type PricingStrategy interface {
+	// SpotPrice returns the current price of the market given its balances.
+	// The quote price is the amount of quote asset for 1 unit of base asset,
+	// while the base price is its inverse.
+	SpotPrice(baseBalance, quoteBalance uint64) (Price, error)
+}
+
+// newPricingStrategy returns the built-in strategy the given market is
+// currently configured with.
+func newPricingStrategy(market *domain.Market) PricingStrategy {
+	if market.IsStrategyPluggable() {
+		return pluggableStrategy{market}
+	}
+	return formulaStrategy{market.Strategy.Formula()}
+}
+
+// pluggableStrategy prices the trades of a market at the price set by the
+// operator, either manually or via a price feed plugin.
+type pluggableStrategy struct {
+	market *domain.Market
+}
+
+func (s pluggableStrategy) SpotPrice(_, _ uint64) (Price, error) {
+	return Price{
+		BasePrice:  s.market.BaseAssetPrice(),
+		QuotePrice: s.market.QuoteAssetPrice(),
+	}, nil
+}
+
+// formulaStrategy prices the trades of a market by applying an automated
+// market making formula to its reserves.
+type formulaStrategy struct {
+	formula mm.MakingFormula
+}
+
+func (s formulaStrategy) SpotPrice(baseBalance, quoteBalance uint64) (Price, error) {
+	price, err := priceFromBalances(s.formula, baseBalance, quoteBalance)
+	if err != nil {
+		return Price{}, err
+	}
+	return *price, nil
+}
+
+// pricingStrategies holds the custom strategies bound to markets, indexed
+// by quote asset.
+type pricingStrategies struct {
+	strategies map[string]PricingStrategy
+	lock       *sync.RWMutex
+}
+
+func newPricingStrategies() *pricingStrategies {
+	return &pricingStrategies{
+		strategies: make(map[string]PricingStrategy),
+		lock:       &sync.RWMutex{},
+	}
+}
+
+func (p *pricingStrategies) set(quoteAsset string, strategy PricingStrategy) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if strategy == nil {
+		delete(p.strategies, quoteAsset)
+		return
+	}
+	p.strategies[quoteAsset] = strategy
+}
+
+// forMarket returns the custom strategy bound to the given market, if any, or
+// its built-in one otherwise.
+func (p *pricingStrategies) forMarket(market *domain.Market) PricingStrategy {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if strategy, ok := p.strategies[market.QuoteAsset]; ok {
+		return strategy
+	}
+	return newPricingStrategy(market)
+}
@@ -0,0 +1,279 @@
+package application
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// SwapPsetRequest, SwapPsetAccept and SwapPsetComplete are the swap
+	// messages a SwapPset can come from.
+	SwapPsetRequest  = "request"
+	SwapPsetAccept   = "accept"
+	SwapPsetComplete = "complete"
+
+	// redactedBlindingKey replaces the blinding keys of the logged PSETs unless
+	// they're explicitly kept.
+	redactedBlindingKey = "redacted"
+	psetLogFileExt      = ".json"
+)
+
+// SwapPset is the PSET of a swap message of a trade, along with the blinding
+// keys, in hex format and indexed by script, given with it. The transaction of
+// a complete message can be also the final one in hex format.
+type SwapPset struct {
+	Swap               string            `json:"swap"`
+	PsetBase64         string            `json:"pset_base64"`
+	InputBlindingKeys  map[string]string `json:"input_blinding_keys,omitempty"`
+	OutputBlindingKeys map[string]string `json:"output_blinding_keys,omitempty"`
+	Timestamp          uint64            `json:"timestamp"`
+}
+
+// PsetLog keeps the PSETs of the swap messages of every trade for replaying
+// failed swaps. It can be bound to the trade service at runtime with
+// SetPsetLog.
+type PsetLog interface {
+	// Add appends the given PSET to those of the trade with the given id.
+	Add(tradeID string, pset SwapPset) error
+	// Get returns the PSETs of the trade with the given id, sorted by time.
+	Get(tradeID string) ([]SwapPset, error)
+}
+
+// filePsetLog is a PsetLog that stores the PSETs of every trade in a file of
+// the given directory. Once the max number of trades is reached, the file of
+// the oldest one is removed to make room for a new trade.
+type filePsetLog struct {
+	dir              string
+	maxTrades        int
+	withBlindingKeys bool
+
+	lock     *sync.Mutex
+	tradeIDs []string
+}
+
+// NewFilePsetLog returns a PsetLog persisting the PSETs of at most maxTrades
+// trades into dir, which is created if it doesn't exist. Trades already logged
+// in dir from previous runs are kept. Blinding keys are redacted unless
+// withBlindingKeys is true.
+func NewFilePsetLog(
+	dir string,
+	maxTrades int,
+	withBlindingKeys bool,
+) (PsetLog, error) {
+	if maxTrades <= 0 {
+		return nil, ErrInvalidPsetLogSize
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+	tradeIDs := make([]string, 0, len(files))
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasSuffix(name, psetLogFileExt) {
+			continue
+		}
+		tradeIDs = append(tradeIDs, strings.TrimSuffix(name, psetLogFileExt))
+	}
+
+	l := &filePsetLog{
+		dir:              dir,
+		maxTrades:        maxTrades,
+		withBlindingKeys: withBlindingKeys,
+		lock:             &sync.Mutex{},
+		tradeIDs:         tradeIDs,
+	}
+	if err := l.rotate(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *filePsetLog) Add(tradeID string, pset SwapPset) error {
+	if err := validateTradeID(tradeID); err != nil {
+		return err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	psets, err := l.read(tradeID)
+	if err != nil {
+		return err
+	}
+	isNew := psets == nil
+
+	if !l.withBlindingKeys {
+		pset.InputBlindingKeys = redactBlindingKeys(pset.InputBlindingKeys)
+		pset.OutputBlindingKeys = redactBlindingKeys(pset.OutputBlindingKeys)
+	}
+	buf, err := json.Marshal(append(psets, pset))
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(l.path(tradeID), buf, 0600); err != nil {
+		return err
+	}
+
+	if isNew {
+		l.tradeIDs = append(l.tradeIDs, tradeID)
+		return l.rotate()
+	}
+	return nil
+}
+
+func (l *filePsetLog) Get(tradeID string) ([]SwapPset, error) {
+	if err := validateTradeID(tradeID); err != nil {
+		return nil, err
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	psets, err := l.read(tradeID)
+	if err != nil {
+		return nil, err
+	}
+	if psets == nil {
+		return nil, ErrTradeNotFound
+	}
+	return psets, nil
+}
+
+// read returns the PSETs logged for the given trade, or nil if none.
+func (l *filePsetLog) read(tradeID string) ([]SwapPset, error) {
+	buf, err := ioutil.ReadFile(l.path(tradeID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var psets []SwapPset
+	if err := json.Unmarshal(buf, &psets); err != nil {
+		return nil, err
+	}
+	return psets, nil
+}
+
+// rotate removes the files of the oldest trades in excess.
+func (l *filePsetLog) rotate() error {
+	for len(l.tradeIDs) > l.maxTrades {
+		if err := os.Remove(l.path(l.tradeIDs[0])); err != nil &&
+			!os.IsNotExist(err) {
+			return err
+		}
+		l.tradeIDs = l.tradeIDs[1:]
+	}
+	return nil
+}
+
+func (l *filePsetLog) path(tradeID string) string {
+	return filepath.Join(l.dir, tradeID+psetLogFileExt)
+}
+
+// SetPsetLog binds the given log to the service, so that the PSETs of the
+// request, accept and complete messages of every trade are added to it, even
+// for rejected or failed swaps. Binding a nil log disables logging.
+func (t *tradeService) SetPsetLog(psetLog PsetLog) {
+	t.psetLog.set(psetLog)
+}
+
+// GetTradePsets returns the PSETs logged for the trade with the given id.
+func (t *tradeService) GetTradePsets(tradeID string) ([]SwapPset, error) {
+	psetLog := t.psetLog.get()
+	if psetLog == nil {
+		return nil, ErrPsetLogDisabled
+	}
+	return psetLog.Get(tradeID)
+}
+
+// tradePsetLog holds the PsetLog bound to the trade service, if any.
+type tradePsetLog struct {
+	psetLog PsetLog
+	lock    *sync.RWMutex
+}
+
+func newTradePsetLog() *tradePsetLog {
+	return &tradePsetLog{lock: &sync.RWMutex{}}
+}
+
+func (l *tradePsetLog) set(psetLog PsetLog) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.psetLog = psetLog
+}
+
+func (l *tradePsetLog) get() PsetLog {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	return l.psetLog
+}
+
+// add logs the given PSET, if a PsetLog is bound. Failures are only reported
+// since they must not affect the trade.
+func (l *tradePsetLog) add(tradeID string, pset SwapPset) {
+	psetLog := l.get()
+	if psetLog == nil || len(pset.PsetBase64) <= 0 {
+		return
+	}
+	if err := psetLog.Add(tradeID, pset); err != nil {
+		log.WithError(err).Warnf(
+			"unable to log %s pset of trade with id %s", pset.Swap, tradeID,
+		)
+	}
+}
+
+func newSwapPset(
+	swap, psetBase64 string,
+	inBlindingKeys, outBlindingKeys map[string][]byte,
+) SwapPset {
+	return SwapPset{
+		Swap:               swap,
+		PsetBase64:         psetBase64,
+		InputBlindingKeys:  encodeBlindingKeys(inBlindingKeys),
+		OutputBlindingKeys: encodeBlindingKeys(outBlindingKeys),
+		Timestamp:          uint64(time.Now().Unix()),
+	}
+}
+
+func encodeBlindingKeys(keys map[string][]byte) map[string]string {
+	if len(keys) <= 0 {
+		return nil
+	}
+	encoded := make(map[string]string, len(keys))
+	for script, key := range keys {
+		encoded[script] = hex.EncodeToString(key)
+	}
+	return encoded
+}
+
+func redactBlindingKeys(keys map[string]string) map[string]string {
+	if len(keys) <= 0 {
+		return nil
+	}
+	redacted := make(map[string]string, len(keys))
+	for script := range keys {
+		redacted[script] = redactedBlindingKey
+	}
+	return redacted
+}
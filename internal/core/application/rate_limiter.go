@@ -0,0 +1,184 @@
+package application
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// RateLimitScopeMarket and RateLimitScopePeer are the scopes of the token
+	// buckets listed by TradeService.RateLimiterState.
+	RateLimitScopeMarket = "market"
+	RateLimitScopePeer   = "peer"
+)
+
+type peerContextKey struct{}
+
+// WithPeer returns a copy of the given context carrying the identity of the
+// trader, like its network address, that's used to rate limit its proposals.
+func WithPeer(ctx context.Context, peer string) context.Context {
+	return context.WithValue(ctx, peerContextKey{}, peer)
+}
+
+func peerFromContext(ctx context.Context) string {
+	peer, _ := ctx.Value(peerContextKey{}).(string)
+	return peer
+}
+
+type authenticatedPeerContextKey struct{}
+
+// WithAuthenticatedPeer returns a copy of the given context carrying the name
+// of the trader that has been authenticated with its access token. Unlike the
+// network address, this is not shared by unrelated traders, therefore it's
+// the identity the fee tiers and the fees by peer are bound to.
+func WithAuthenticatedPeer(ctx context.Context, peer string) context.Context {
+	return context.WithValue(ctx, authenticatedPeerContextKey{}, peer)
+}
+
+func authenticatedPeerFromContext(ctx context.Context) string {
+	peer, _ := ctx.Value(authenticatedPeerContextKey{}).(string)
+	return peer
+}
+
+// tradeRateLimiter limits the number of swap proposals accepted per minute
+// for every market and, optionally, for every peer, with a token bucket each.
+// A bucket holds at most as many tokens as the proposals allowed per minute
+// and is refilled at the same rate, so that bursts up to the limit are
+// allowed. A proposal costs a token from both the buckets of its market and
+// its peer, and is rejected if any of the two is empty.
+type tradeRateLimiter struct {
+	limits RateLimits
+
+	lock          *sync.Mutex
+	marketBuckets map[string]*tokenBucket
+	peerBuckets   map[string]*tokenBucket
+	lastSweep     time.Time
+}
+
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newTradeRateLimiter(limits RateLimits) *tradeRateLimiter {
+	return &tradeRateLimiter{
+		limits:        limits,
+		lock:          &sync.Mutex{},
+		marketBuckets: make(map[string]*tokenBucket),
+		peerBuckets:   make(map[string]*tokenBucket),
+		lastSweep:     time.Now(),
+	}
+}
+
+// allow consumes a token from the buckets of the given market and peer, if
+// both have one. Limits that are not set, like an empty peer, never deny.
+func (l *tradeRateLimiter) allow(market, peer string) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	l.sweep(now)
+
+	var buckets []*tokenBucket
+	if l.limits.ProposalsPerMarket > 0 {
+		buckets = append(buckets, getOrRefillBucket(
+			l.marketBuckets, market, l.limits.ProposalsPerMarket, now,
+		))
+	}
+	if l.limits.ProposalsPerPeer > 0 && peer != "" {
+		buckets = append(buckets, getOrRefillBucket(
+			l.peerBuckets, peer, l.limits.ProposalsPerPeer, now,
+		))
+	}
+
+	for _, b := range buckets {
+		if b.tokens < 1 {
+			return false
+		}
+	}
+	for _, b := range buckets {
+		b.tokens--
+	}
+	return true
+}
+
+// state returns the current number of tokens of every bucket.
+func (l *tradeRateLimiter) state() []RateLimiterState {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	state := make([]RateLimiterState, 0)
+	for _, s := range []struct {
+		scope    string
+		buckets  map[string]*tokenBucket
+		capacity int
+	}{
+		{RateLimitScopeMarket, l.marketBuckets, l.limits.ProposalsPerMarket},
+		{RateLimitScopePeer, l.peerBuckets, l.limits.ProposalsPerPeer},
+	} {
+		for key := range s.buckets {
+			b := getOrRefillBucket(s.buckets, key, s.capacity, now)
+			state = append(state, RateLimiterState{
+				Scope:    s.scope,
+				Key:      key,
+				Tokens:   b.tokens,
+				Capacity: s.capacity,
+			})
+		}
+	}
+
+	sort.SliceStable(state, func(i, j int) bool {
+		if state[i].Scope != state[j].Scope {
+			return state[i].Scope < state[j].Scope
+		}
+		return state[i].Key < state[j].Key
+	})
+	return state
+}
+
+// sweep drops, at most once a minute, the buckets of peers that are full
+// again, since they behave like new ones. This prevents the peer buckets from
+// growing indefinitely.
+func (l *tradeRateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+
+	capacity := l.limits.ProposalsPerPeer
+	for peer := range l.peerBuckets {
+		if b := getOrRefillBucket(
+			l.peerBuckets, peer, capacity, now,
+		); b.tokens >= float64(capacity) {
+			delete(l.peerBuckets, peer)
+		}
+	}
+}
+
+// getOrRefillBucket returns the bucket with the given key, full if new,
+// refilled of the tokens gained since its last refill otherwise.
+func getOrRefillBucket(
+	buckets map[string]*tokenBucket,
+	key string,
+	perMinute int,
+	now time.Time,
+) *tokenBucket {
+	capacity := float64(perMinute)
+	b, ok := buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: capacity, lastRefill: now}
+		buckets[key] = b
+		return b
+	}
+
+	elapsed := now.Sub(b.lastRefill).Minutes()
+	b.tokens += elapsed * capacity
+	if b.tokens > capacity {
+		b.tokens = capacity
+	}
+	b.lastRefill = now
+	return b
+}
@@ -0,0 +1,212 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+func (t *tradeService) StartReorgWatcher(
+	ctx context.Context,
+	interval time.Duration,
+	depth uint64,
+) {
+	ticker := time.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				t.reconcileUnspents(depth)
+			}
+		}
+	}()
+}
+
+// reconcileUnspents demotes to unconfirmed the unspents confirmed in any of
+// the last depth blocks that are no longer part of the best chain, and
+// confirms again those whose tx has been included in a new block since then.
+// Unspents are always updated in place by key, so that a tx moved to another
+// block never adds its outputs twice to the balances of the markets.
+func (t *tradeService) reconcileUnspents(depth uint64) {
+	ctx := context.Background()
+
+	tipHeight, err := t.explorerSvc.GetBlockHeight()
+	if err != nil {
+		log.WithError(err).Warn("unable to get block height to detect reorgs")
+		return
+	}
+	tip := uint64(tipHeight)
+
+	// group by tx the unspents to check, since all outputs of a tx share the
+	// same block.
+	reorgedKeys := make(map[string][]domain.UnspentKey)
+	unconfirmedKeys := make(map[string][]domain.UnspentKey)
+	blockHashes := make(map[uint64]string)
+	for _, u := range t.repoManager.UnspentRepository().GetAllUnspents(ctx) {
+		if u.IsSpent() {
+			continue
+		}
+		if !u.IsConfirmed() {
+			unconfirmedKeys[u.TxID] = append(unconfirmedKeys[u.TxID], u.Key())
+			continue
+		}
+		// unspents stored before block hashes were tracked can't be checked.
+		if len(u.BlockHash) <= 0 || u.BlockHeight <= 0 {
+			continue
+		}
+		if u.BlockHeight <= tip && tip-u.BlockHeight >= depth {
+			continue
+		}
+
+		if u.BlockHeight <= tip {
+			if _, ok := blockHashes[u.BlockHeight]; !ok {
+				hash, err := t.explorerSvc.GetBlockHash(u.BlockHeight)
+				if err != nil {
+					log.WithError(err).Warnf(
+						"unable to get hash of block %d to detect reorgs", u.BlockHeight,
+					)
+					return
+				}
+				blockHashes[u.BlockHeight] = hash
+			}
+			if blockHashes[u.BlockHeight] == u.BlockHash {
+				continue
+			}
+		}
+		reorgedKeys[u.TxID] = append(reorgedKeys[u.TxID], u.Key())
+	}
+
+	for txid, keys := range reorgedKeys {
+		t.reconcileReorgedTx(ctx, txid, keys)
+	}
+	for txid, keys := range unconfirmedKeys {
+		t.reconcileUnconfirmedTx(ctx, txid, keys)
+	}
+}
+
+// reconcileReorgedTx handles the unspents of a tx whose block has been
+// reorganized out of the best chain. They're confirmed in the new block
+// including the tx, if any, otherwise they're demoted to unconfirmed so that
+// they don't count anymore for the tradable balances, and the trade that
+// settled with the tx, if any, is flagged as reorged.
+func (t *tradeService) reconcileReorgedTx(
+	ctx context.Context,
+	txid string,
+	keys []domain.UnspentKey,
+) {
+	unspentRepo := t.repoManager.UnspentRepository()
+	if _, err := unspentRepo.UnconfirmUnspents(ctx, keys); err != nil {
+		log.WithError(err).Warnf(
+			"unable to demote unspents of reorged tx %s", txid,
+		)
+		return
+	}
+	log.Warnf(
+		"block of tx %s is no longer part of the best chain, demoted %d "+
+			"unspents to unconfirmed", txid, len(keys),
+	)
+
+	if t.reconcileUnconfirmedTx(ctx, txid, keys) {
+		return
+	}
+	t.updateTradeForReorg(ctx, txid, func(trade *domain.Trade) (bool, error) {
+		if !trade.IsSettled() || trade.IsReorged() {
+			return false, nil
+		}
+		return trade.Reorg()
+	})
+}
+
+// reconcileUnconfirmedTx confirms the given unspents of a tx if it's been
+// included in a block, and settles again the trade flagged as reorged, if
+// any. It returns whether the unspents have been confirmed.
+func (t *tradeService) reconcileUnconfirmedTx(
+	ctx context.Context,
+	txid string,
+	keys []domain.UnspentKey,
+) bool {
+	status, err := t.explorerSvc.GetTransactionStatus(txid)
+	if err != nil {
+		log.WithError(err).Debugf("unable to get status of tx %s", txid)
+		return false
+	}
+	if confirmed, _ := status["confirmed"].(bool); !confirmed {
+		return false
+	}
+	blockHeight, _ := status["block_height"].(float64)
+	blockHash, _ := status["block_hash"].(string)
+	blockTime, _ := status["block_time"].(float64)
+
+	count, err := t.repoManager.UnspentRepository().ConfirmUnspents(
+		ctx, keys, uint64(blockHeight), blockHash,
+	)
+	if err != nil {
+		log.WithError(err).Warnf("unable to confirm unspents of tx %s", txid)
+		return false
+	}
+	log.Debugf("confirmed %d unspents of tx %s", count, txid)
+
+	t.updateTradeForReorg(ctx, txid, func(trade *domain.Trade) (bool, error) {
+		if !trade.IsReorged() {
+			return false, nil
+		}
+		return trade.Settle(uint64(blockTime))
+	})
+	return true
+}
+
+// updateTradeForReorg updates the trade with the given txid, if any, with the
+// given func, and notifies it if it returns true.
+func (t *tradeService) updateTradeForReorg(
+	ctx context.Context,
+	txid string,
+	updateFunc func(trade *domain.Trade) (bool, error),
+) {
+	trade, err := t.repoManager.TradeRepository().GetTradeByTxID(ctx, txid)
+	if err != nil || trade == nil {
+		return
+	}
+
+	t.tradeLocks.acquire(trade.ID)
+	defer t.tradeLocks.release(trade.ID)
+
+	var updatedTrade *domain.Trade
+	if err := t.repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&trade.ID,
+		func(trade *domain.Trade) (*domain.Trade, error) {
+			ok, err := updateFunc(trade)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				updatedTrade = trade
+			}
+			return trade, nil
+		},
+	); err != nil {
+		log.WithError(err).Warnf(
+			"unable to update trade with id %s after reorg", trade.ID,
+		)
+		return
+	}
+	if updatedTrade == nil {
+		return
+	}
+	t.peerVolumes.updateTrade(updatedTrade, t.marketBaseAsset)
+
+	if updatedTrade.IsReorged() {
+		log.Warnf(
+			"settlement block of trade with id %s has been reorged", trade.ID,
+		)
+	} else {
+		log.Infof("trade with id %s settled again after reorg", trade.ID)
+	}
+	t.notifyTradeStatus(updatedTrade)
+}
@@ -0,0 +1,164 @@
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/tdex-network/tdex-daemon/pkg/wallet"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/payment"
+)
+
+const reserveProofMessagePrefix = "tdex proof of reserves"
+
+// ProveReserves returns a proof of the ownership of all the unspents, locked
+// ones included, of every account of the daemon. Each unspent is signed with
+// the key of the address it's locked to, so that auditors can verify the
+// signatures and, by using the revealed blinders, match the unblinded amounts
+// against the on-chain commitments. The nonce, chosen by the auditor, is
+// included in every signed message so that the proof can't be replayed.
+func (o *operatorService) ProveReserves(
+	ctx context.Context, nonce string,
+) (*ReserveProof, error) {
+	vault, err := o.repoManager.VaultRepository().GetOrCreateVault(ctx, nil, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	mnemonic, err := vault.GetMnemonicSafe()
+	if err != nil {
+		return nil, err
+	}
+	w, err := vault.WalletOpts.NewWallet(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	accountIndexes := make([]int, 0, len(vault.Accounts))
+	for accountIndex := range vault.Accounts {
+		accountIndexes = append(accountIndexes, accountIndex)
+	}
+	sort.Ints(accountIndexes)
+
+	proof := &ReserveProof{
+		Nonce:     nonce,
+		Timestamp: uint64(time.Now().Unix()),
+		Accounts:  make([]AccountReserves, 0, len(accountIndexes)),
+	}
+	for _, accountIndex := range accountIndexes {
+		info, err := vault.AllDerivedAddressesInfoForAccount(accountIndex)
+		if err != nil {
+			return nil, err
+		}
+		unspents, err := o.repoManager.UnspentRepository().GetUnspentsForAddresses(
+			ctx,
+			info.Addresses(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		derivationPathByAddress := make(map[string]string)
+		for _, i := range info {
+			derivationPathByAddress[i.Address] = i.DerivationPath
+		}
+
+		outpoints := make([]OutpointReserve, 0, len(unspents))
+		for _, u := range unspents {
+			prvkey, pubkey, err := w.DeriveSigningKeyPair(
+				wallet.DeriveSigningKeyPairOpts{
+					DerivationPath: derivationPathByAddress[u.Address],
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			outpoint := OutpointReserve{
+				TxID:          u.TxID,
+				VOut:          u.VOut,
+				Asset:         u.AssetHash,
+				Amount:        u.Value,
+				AssetBlinder:  hex.EncodeToString(elementsutil.ReverseBytes(u.AssetBlinder)),
+				AmountBlinder: hex.EncodeToString(elementsutil.ReverseBytes(u.ValueBlinder)),
+				Script:        hex.EncodeToString(u.ScriptPubKey),
+				PubKey:        hex.EncodeToString(pubkey.SerializeCompressed()),
+			}
+			sig, err := prvkey.Sign(outpoint.message(proof.Nonce, proof.Timestamp))
+			if err != nil {
+				return nil, err
+			}
+			outpoint.Signature = hex.EncodeToString(sig.Serialize())
+
+			outpoints = append(outpoints, outpoint)
+		}
+
+		proof.Accounts = append(proof.Accounts, AccountReserves{
+			AccountIndex: accountIndex,
+			Outpoints:    outpoints,
+		})
+	}
+
+	return proof, nil
+}
+
+// Verify returns an error if any of the signatures of the proof is invalid, or
+// if any of the keys doesn't control the unspent it signed for.
+func (p ReserveProof) Verify() error {
+	for _, account := range p.Accounts {
+		for _, outpoint := range account.Outpoints {
+			if err := outpoint.verify(p.Nonce, p.Timestamp); err != nil {
+				return fmt.Errorf(
+					"%w: %s:%d", ErrInvalidReserveProof, outpoint.TxID, outpoint.VOut,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+func (o OutpointReserve) verify(nonce string, timestamp uint64) error {
+	buf, err := hex.DecodeString(o.PubKey)
+	if err != nil {
+		return err
+	}
+	pubkey, err := btcec.ParsePubKey(buf, btcec.S256())
+	if err != nil {
+		return err
+	}
+	if script := hex.EncodeToString(
+		payment.FromPublicKey(pubkey, nil, nil).WitnessScript,
+	); script != o.Script {
+		return ErrInvalidReserveProof
+	}
+
+	buf, err = hex.DecodeString(o.Signature)
+	if err != nil {
+		return err
+	}
+	sig, err := btcec.ParseDERSignature(buf, btcec.S256())
+	if err != nil {
+		return err
+	}
+	if !sig.Verify(o.message(nonce, timestamp), pubkey) {
+		return ErrInvalidReserveProof
+	}
+	return nil
+}
+
+// message returns the sha256 hash of the string
+// "tdex proof of reserves <nonce> <timestamp> <txid>:<vout> <asset> <amount> <asset_blinder> <amount_blinder>"
+// which is what gets signed for proving the ownership of the unspent.
+func (o OutpointReserve) message(nonce string, timestamp uint64) []byte {
+	msg := fmt.Sprintf(
+		"%s %s %d %s:%d %s %d %s %s",
+		reserveProofMessagePrefix, nonce, timestamp, o.TxID, o.VOut,
+		o.Asset, o.Amount, o.AssetBlinder, o.AmountBlinder,
+	)
+	hash := sha256.Sum256([]byte(msg))
+	return hash[:]
+}
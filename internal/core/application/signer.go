@@ -0,0 +1,69 @@
+package application
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tdex-network/tdex-daemon/pkg/wallet"
+	"github.com/vulpemventures/go-elements/pset"
+)
+
+// Signer signs the inputs owned by the daemon of a blinded PSET, like those
+// of a trade or of a withdrawal, right before it's completed or broadcasted.
+// Signing can be delegated to external devices, like an HSM, holding the keys
+// of the daemon's wallet by passing a Signer to the constructors of the
+// services. If set, it's handed the blinded PSETs of trades, withdrawals and
+// fee consolidations in place of the internal signer, and the daemon waits
+// for the signed version to go on. Previews of trades are always signed
+// internally instead, since they are never broadcasted.
+type Signer interface {
+	Sign(psetBase64 string) (signedPsetBase64 string, err error)
+}
+
+// internalSigner is the default Signer, it signs with the keys of the given
+// wallet those inputs of a PSET that spend one of the known scripts, leaving
+// any other, like the counterparty's ones, untouched.
+type internalSigner struct {
+	wallet                 *wallet.Wallet
+	derivationPathByScript map[string]string
+}
+
+// newSigner returns the given signer, if not nil, or an internal one
+// otherwise.
+func newSigner(
+	signer Signer,
+	w *wallet.Wallet,
+	derivationPathByScript map[string]string,
+) Signer {
+	if signer != nil {
+		return signer
+	}
+	return internalSigner{w, derivationPathByScript}
+}
+
+func (s internalSigner) Sign(psetBase64 string) (string, error) {
+	ptx, err := pset.NewPsetFromBase64(psetBase64)
+	if err != nil {
+		return "", err
+	}
+
+	signedPsetBase64 := psetBase64
+	for i, in := range ptx.Inputs {
+		if in.WitnessUtxo == nil {
+			continue
+		}
+		path, ok := s.derivationPathByScript[hex.EncodeToString(in.WitnessUtxo.Script)]
+		if !ok {
+			continue
+		}
+		signedPsetBase64, err = s.wallet.SignInput(wallet.SignInputOpts{
+			PsetBase64:     signedPsetBase64,
+			InIndex:        uint32(i),
+			DerivationPath: path,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to sign input %d: %s", i, err)
+		}
+	}
+	return signedPsetBase64, nil
+}
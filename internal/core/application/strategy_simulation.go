@@ -0,0 +1,202 @@
+package application
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+// SimulationResult compares the outcome of replaying the trades of a market
+// against a candidate strategy with the one of the trades as they actually
+// happened.
+type SimulationResult struct {
+	// NumOfTrades is the number of trades replayed, only completed and
+	// settled ones are.
+	NumOfTrades int
+	Candidate   SimulationOutcome
+	Actual      SimulationOutcome
+}
+
+// SimulationOutcome is the state of a market after a sequence of trades.
+type SimulationOutcome struct {
+	// FinalBalance is the balance of the market after the trades.
+	FinalBalance Balance
+	// BaseDrift and QuoteDrift are the changes of the market inventory with
+	// respect to the initial balance, negative if it has been drained.
+	BaseDrift  int64
+	QuoteDrift int64
+	// FeeRevenue is the amount of fees collected in either asset.
+	FeeRevenue Balance
+	// RejectedTrades is the number of trades the market couldn't afford.
+	RejectedTrades int
+}
+
+// SimulateStrategy replays the given trades of a market, in order of request
+// time, against the given strategy, starting from the given balance of the
+// market. Every trader is assumed to send the same amount as in the actual
+// trade and to receive what the strategy would have given for it at the spot
+// price of the simulated balance, net of the fee of the trade. Trades that
+// the simulated market can't afford are rejected.
+// This is meant for tuning the parameters of a strategy offline, therefore
+// it doesn't access any market or account of the daemon.
+func SimulateStrategy(
+	strategy PricingStrategy,
+	trades []TradeInfo,
+	initialBalance Balance,
+) (SimulationResult, error) {
+	if strategy == nil {
+		return SimulationResult{}, ErrUnknownStrategy
+	}
+
+	replayed := make([]TradeInfo, 0, len(trades))
+	for _, t := range trades {
+		if isTradeToSimulate(t) {
+			replayed = append(replayed, t)
+		}
+	}
+	sort.SliceStable(replayed, func(i, j int) bool {
+		return replayed[i].RequestTimeUnix < replayed[j].RequestTimeUnix
+	})
+
+	candidate := newMarketSimulation(initialBalance)
+	actual := newMarketSimulation(initialBalance)
+	for _, t := range replayed {
+		if t.MarketWithFee.Market != replayed[0].MarketWithFee.Market {
+			return SimulationResult{}, ErrMixedMarketTrades
+		}
+
+		baseAsset := t.MarketWithFee.BaseAsset
+		sellsBase := t.SwapInfo.AssetP == baseAsset
+		fee, isFeeOnAmountP := simulatedFee(
+			t.SwapInfo, t.MarketWithFee.Fee, baseAsset,
+		)
+
+		if !actual.trade(
+			sellsBase, t.SwapInfo.AmountP, t.SwapInfo.AmountR, fee, isFeeOnAmountP,
+		) {
+			return SimulationResult{}, ErrSimulationBalanceMismatch
+		}
+
+		price, err := strategy.SpotPrice(
+			candidate.balance.BaseAmount, candidate.balance.QuoteAmount,
+		)
+		if err != nil {
+			return SimulationResult{}, err
+		}
+		// the price to convert the amount sent by the trader into the other
+		// asset of the market.
+		exchangeRate := price.BasePrice
+		if sellsBase {
+			exchangeRate = price.QuotePrice
+		}
+		// the fee is either deducted from the amount sent before converting it,
+		// or from the converted one.
+		amountP := t.SwapInfo.AmountP
+		if isFeeOnAmountP {
+			amountP -= fee
+		}
+		amountR := decimal.NewFromInt(int64(amountP)).
+			Mul(exchangeRate).
+			IntPart()
+		if !isFeeOnAmountP {
+			amountR -= int64(fee)
+		}
+		if amountR <= 0 || !candidate.trade(
+			sellsBase, t.SwapInfo.AmountP, uint64(amountR), fee, isFeeOnAmountP,
+		) {
+			candidate.rejected++
+		}
+	}
+
+	return SimulationResult{
+		NumOfTrades: len(replayed),
+		Candidate:   candidate.outcome(initialBalance),
+		Actual:      actual.outcome(initialBalance),
+	}, nil
+}
+
+// isTradeToSimulate returns whether the given trade has actually changed
+// the balance of its market.
+func isTradeToSimulate(t TradeInfo) bool {
+	status := t.Status
+	return (status == domain.CompletedStatus || status == domain.SettledStatus) &&
+		t.SwapInfo.AmountP > 0 && t.SwapInfo.AmountR > 0
+}
+
+// simulatedFee returns the fee charged on the given swap, computed like for
+// the fee ledger, and whether it's charged on the amount sent by the trader or
+// on the one received.
+func simulatedFee(swap SwapInfo, fee Fee, baseAsset string) (uint64, bool) {
+	feeAsset := swap.FeeAsset
+	if feeAsset == "" {
+		feeAsset = swap.AssetP
+	}
+
+	fixedFee := uint64(fee.FixedQuoteFee)
+	if feeAsset == baseAsset {
+		fixedFee = uint64(fee.FixedBaseFee)
+	}
+
+	isFeeOnAmountP := feeAsset == swap.AssetP
+	amount := swap.AmountR
+	if isFeeOnAmountP {
+		amount = swap.AmountP
+	}
+	basisPointAmount, fixedAmount := chargedFee(
+		amount, isFeeOnAmountP, uint64(fee.BasisPoint), fixedFee,
+	)
+	return basisPointAmount + fixedAmount, isFeeOnAmountP
+}
+
+type marketSimulation struct {
+	balance    Balance
+	feeRevenue Balance
+	rejected   int
+}
+
+func newMarketSimulation(initialBalance Balance) *marketSimulation {
+	return &marketSimulation{balance: initialBalance}
+}
+
+// trade updates the balance of the simulated market with a trade where the
+// trader sends amountP and receives amountR, and the market collects the
+// given fee on either of them. It returns false, leaving the balance
+// untouched, if the market can't afford it.
+func (m *marketSimulation) trade(
+	sellsBase bool,
+	amountP, amountR, fee uint64,
+	isFeeOnAmountP bool,
+) bool {
+	isFeeInBase := sellsBase == isFeeOnAmountP
+	if sellsBase {
+		if m.balance.QuoteAmount < amountR {
+			return false
+		}
+		m.balance.BaseAmount += amountP
+		m.balance.QuoteAmount -= amountR
+	} else {
+		if m.balance.BaseAmount < amountR {
+			return false
+		}
+		m.balance.QuoteAmount += amountP
+		m.balance.BaseAmount -= amountR
+	}
+
+	if isFeeInBase {
+		m.feeRevenue.BaseAmount += fee
+	} else {
+		m.feeRevenue.QuoteAmount += fee
+	}
+	return true
+}
+
+func (m *marketSimulation) outcome(initialBalance Balance) SimulationOutcome {
+	return SimulationOutcome{
+		FinalBalance:   m.balance,
+		BaseDrift:      int64(m.balance.BaseAmount) - int64(initialBalance.BaseAmount),
+		QuoteDrift:     int64(m.balance.QuoteAmount) - int64(initialBalance.QuoteAmount),
+		FeeRevenue:     m.feeRevenue,
+		RejectedTrades: m.rejected,
+	}
+}
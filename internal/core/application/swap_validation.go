@@ -0,0 +1,232 @@
+package application
+
+import (
+	"context"
+	"encoding/hex"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/pkg/bufferutil"
+	pkgswap "github.com/tdex-network/tdex-daemon/pkg/swap"
+	"github.com/vulpemventures/go-elements/pset"
+)
+
+// ValidateSwapRequest runs against the given swap request the same checks of
+// TradePropose and returns the failure it would be rejected with, or nil if
+// it would be accepted. The market and the trade type are inferred from the
+// assets of the request. Nothing is selected, locked or persisted, and the
+// request doesn't count for the rate limits of the proposals.
+func (t *tradeService) ValidateSwapRequest(
+	ctx context.Context,
+	swapRequest domain.SwapRequest,
+) (*SwapFailInfo, error) {
+	var market Market
+	var tradeType int
+	switch t.marketBaseAsset {
+	case swapRequest.GetAssetP():
+		market = Market{
+			BaseAsset:  t.marketBaseAsset,
+			QuoteAsset: swapRequest.GetAssetR(),
+		}
+		tradeType = TradeSell
+	case swapRequest.GetAssetR():
+		market = Market{
+			BaseAsset:  t.marketBaseAsset,
+			QuoteAsset: swapRequest.GetAssetP(),
+		}
+		tradeType = TradeBuy
+	default:
+		return nil, ErrMarketNotExist
+	}
+	if err := validateAssetString(market.QuoteAsset); err != nil {
+		return nil, domain.ErrMarketInvalidQuoteAsset
+	}
+
+	vault, err := t.repoManager.VaultRepository().GetOrCreateVault(ctx, nil, "", nil)
+	if err != nil {
+		log.Debugf("error while retrieving vault: %s", err)
+		return nil, ErrServiceUnavailable
+	}
+	if vault.IsLocked() {
+		log.Debug("vault is locked")
+		return nil, ErrServiceUnavailable
+	}
+
+	mkt, marketAccountIndex, err := t.repoManager.MarketRepository().GetMarketByAsset(
+		ctx,
+		market.QuoteAsset,
+	)
+	if err != nil {
+		log.Debugf("error while retrieving market: %s", err)
+		return nil, ErrServiceUnavailable
+	}
+	if marketAccountIndex < 0 {
+		return nil, ErrMarketNotExist
+	}
+	mkt = t.marketForPeer(ctx, mkt)
+
+	marketInfo, marketUnspents, err :=
+		t.getInfoAndUnspentsForAccount(ctx, marketAccountIndex)
+	if err != nil {
+		log.Debugf("error while retrieving market account addresses and unspents: %s", err)
+		return nil, ErrServiceUnavailable
+	}
+	marketUnspents = selectableUnspents(marketUnspents)
+	if len(marketUnspents) <= 0 {
+		return nil, ErrMarketNotFunded
+	}
+
+	_, feeUnspents, err := t.getInfoAndUnspentsForAccount(ctx, domain.FeeAccount)
+	if err != nil {
+		log.Debugf("error while retrieving fee account addresses and unspents: %s", err)
+		return nil, ErrServiceUnavailable
+	}
+	if len(selectableUnspents(feeUnspents)) <= 0 &&
+		!(t.autoTopUpFees && canMarketPayFees(
+			mkt.BaseAsset, swapRequest.GetAssetP(), t.network,
+		)) {
+		return nil, ErrFeeAccountNotFunded
+	}
+
+	if _, swapErr := domain.SwapParserManager.SerializeRequest(
+		swapRequest,
+	); swapErr != nil {
+		return &SwapFailInfo{Code: swapErr.Code, Message: swapErr.Error()}, nil
+	}
+
+	_, failInfo := t.checkSwapRequest(
+		ctx, mkt, marketInfo, marketUnspents, tradeType, swapRequest,
+	)
+	return failInfo, nil
+}
+
+// checkSwapRequest returns the failure that the given well-formed swap request
+// is rejected with by the given market, whose selectable unspents are used for
+// the pricing, or nil if it can be accepted along with the asset the market
+// fee is charged on.
+func (t *tradeService) checkSwapRequest(
+	ctx context.Context,
+	mkt *domain.Market,
+	marketInfo domain.AddressesInfo,
+	marketUnspents []domain.Unspent,
+	tradeType int,
+	swapRequest domain.SwapRequest,
+) (string, *SwapFailInfo) {
+	if !mkt.IsTradable() {
+		return "", &SwapFailInfo{
+			Code:    int(pkgswap.ErrCodeRejectedSwapRequest),
+			Message: domain.ErrMarketIsClosed.Error(),
+		}
+	}
+
+	if mkt.IsPaused() {
+		return "", &SwapFailInfo{
+			Code:    int(pkgswap.ErrCodeMarketPaused),
+			Message: "new trades are temporarily not accepted",
+		}
+	}
+
+	if err := mkt.ValidateTradeAmount(swapRequest.GetAmountP()); err != nil {
+		return "", &SwapFailInfo{
+			Code:    int(pkgswap.ErrCodeOutOfTradeLimits),
+			Message: err.Error(),
+		}
+	}
+
+	// the pricing of a swap not covered by the selectable unspents would fail
+	// anyway, so the reason is reported here to tell an actual lack of funds
+	// from the case where these are only temporarily not selectable.
+	if getBalanceByAsset(marketUnspents)[swapRequest.GetAssetR()] <
+		swapRequest.GetAmountR() {
+		err := t.insufficientFundsError(
+			ctx, mkt, marketInfo,
+			swapRequest.GetAssetR(), swapRequest.GetAmountR(),
+		)
+		return "", &SwapFailInfo{
+			Code:    int(pkgswap.ErrCodeRejectedSwapRequest),
+			Message: err.Error(),
+		}
+	}
+
+	strategy := t.pricingStrategies.forMarket(mkt)
+	feeAsset, ok := isValidTradePrice(
+		swapRequest,
+		tradeType,
+		mkt,
+		strategy,
+		marketUnspents,
+		t.priceSlippage,
+	)
+	if !ok {
+		return "", &SwapFailInfo{
+			Code:    int(pkgswap.ErrCodeInvalidSwapRequest),
+			Message: "bad pricing",
+		}
+	}
+
+	if err := validateSlippage(
+		swapRequest,
+		mkt,
+		strategy,
+		marketUnspents,
+		t.maxSlippage,
+	); err != nil {
+		return "", &SwapFailInfo{
+			Code:    int(pkgswap.ErrCodeSlippageExceeded),
+			Message: err.Error(),
+		}
+	}
+
+	if err := t.priceBand.validate(mkt, strategy, marketUnspents); err != nil {
+		return "", &SwapFailInfo{
+			Code:    int(pkgswap.ErrCodePriceOutOfBand),
+			Message: err.Error(),
+		}
+	}
+
+	if err := checkSwapRequestOutputs(swapRequest); err != nil {
+		return "", &SwapFailInfo{
+			Code:    int(pkgswap.ErrCodeInvalidTransaction),
+			Message: err.Error(),
+		}
+	}
+
+	return feeAsset, nil
+}
+
+// checkSwapRequestOutputs makes sure that the transaction of the given swap
+// request pays to the trader exactly the agreed amount of asset to receive,
+// by revealing its confidential outputs with the provided blinding keys.
+// Otherwise, the swap could be accepted with terms that don't match those of
+// the transaction signed by the market. Outputs that can't be revealed don't
+// count.
+func checkSwapRequestOutputs(swapRequest domain.SwapRequest) error {
+	ptx, err := pset.NewPsetFromBase64(swapRequest.GetTransaction())
+	if err != nil {
+		return err
+	}
+
+	blindingKeys := swapRequest.GetOutputBlindingKey()
+	for _, out := range ptx.UnsignedTx.Outputs {
+		if len(out.Script) <= 0 {
+			continue
+		}
+		asset := bufferutil.AssetHashFromBytes(out.Asset)
+		value := bufferutil.ValueFromBytes(out.Value)
+		if out.IsConfidential() {
+			key, ok := blindingKeys[hex.EncodeToString(out.Script)]
+			if !ok {
+				continue
+			}
+			unblinded, ok := BlinderManager.UnblindOutput(out, key)
+			if !ok {
+				continue
+			}
+			asset, value = unblinded.AssetHash, unblinded.Value
+		}
+		if asset == swapRequest.GetAssetR() && value == swapRequest.GetAmountR() {
+			return nil
+		}
+	}
+	return ErrSwapRequestOutputNotFound
+}
@@ -0,0 +1,229 @@
+package application
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/tdex-network/tdex-daemon/pkg/bufferutil"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/transaction"
+)
+
+// TradeAudit returns the audit record of the inputs and outputs of the
+// transaction that settled the trade with the given id.
+func (o *operatorService) TradeAudit(
+	ctx context.Context,
+	tradeID string,
+) (*TradeAuditRecord, error) {
+	if err := validateTradeID(tradeID); err != nil {
+		return nil, err
+	}
+
+	trades, err := o.repoManager.TradeRepository().GetAllTrades(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var trade *domain.Trade
+	for _, t := range trades {
+		if t.ID.String() == tradeID {
+			trade = t
+			break
+		}
+	}
+	if trade == nil {
+		return nil, ErrTradeNotFound
+	}
+	if trade.Audit == nil {
+		return nil, ErrTradeNotAudited
+	}
+
+	return tradeAuditToRecord(trade, trade.Audit), nil
+}
+
+func tradeAuditToRecord(
+	trade *domain.Trade,
+	audit *domain.TradeAudit,
+) *TradeAuditRecord {
+	record := &TradeAuditRecord{
+		TradeID:        trade.ID.String(),
+		TxID:           trade.TxID,
+		SettleTimeUnix: trade.SettlementTime,
+		MarketInputs:   auditOutpoints(audit.MarketInputs),
+		FeeInputs:      auditOutpoints(audit.FeeInputs),
+		ChangeOutputs:  auditOutpoints(audit.ChangeOutputs),
+		CreatedAt:      audit.CreatedAt,
+	}
+	if audit.MarketOutput != nil {
+		out := TradeAuditOutpoint(*audit.MarketOutput)
+		record.MarketOutput = &out
+	}
+	if audit.CounterpartyOutput != nil {
+		out := TradeAuditOutpoint(*audit.CounterpartyOutput)
+		record.CounterpartyOutput = &out
+	}
+	return record
+}
+
+func auditOutpoints(list []domain.TradeAuditOutpoint) []TradeAuditOutpoint {
+	outpoints := make([]TradeAuditOutpoint, 0, len(list))
+	for _, o := range list {
+		outpoints = append(outpoints, TradeAuditOutpoint(o))
+	}
+	return outpoints
+}
+
+// newTradeAudit classifies the inputs and outputs of the given settlement tx
+// of a trade. Inputs are looked up in the utxo set, those not found belong to
+// the trader. Outputs are matched against the addresses of the market and fee
+// accounts, those derived on the internal chain being changes, while the
+// counterparty's one is found by unblinding the outputs of the trade's pset
+// with the revealed blinding keys.
+func newTradeAudit(
+	ctx context.Context,
+	repoManager ports.RepoManager,
+	net *network.Network,
+	trade *domain.Trade,
+	txHex string,
+) (*domain.TradeAudit, error) {
+	tx, err := transaction.NewTxFromHex(txHex)
+	if err != nil {
+		return nil, err
+	}
+	txid := tx.TxHash().String()
+
+	_, accountIndex, err := repoManager.MarketRepository().GetMarketByAsset(
+		ctx, trade.MarketQuoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if accountIndex < 0 {
+		return nil, ErrMarketNotExist
+	}
+
+	vault, err := repoManager.VaultRepository().GetOrCreateVault(
+		ctx, nil, "", nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	infoByScript := make(map[string]domain.AddressInfo)
+	for _, i := range []int{accountIndex, domain.FeeAccount} {
+		info, err := vault.AllDerivedAddressesInfoForAccount(i)
+		if err != nil {
+			return nil, err
+		}
+		for script, in := range groupAddressesInfoByScript(info) {
+			infoByScript[script] = in
+		}
+	}
+
+	audit := &domain.TradeAudit{
+		MarketInputs:  make([]domain.TradeAuditOutpoint, 0),
+		FeeInputs:     make([]domain.TradeAuditOutpoint, 0),
+		ChangeOutputs: make([]domain.TradeAuditOutpoint, 0),
+		CreatedAt:     uint64(time.Now().Unix()),
+	}
+
+	for _, in := range tx.Inputs {
+		unspent, err := repoManager.UnspentRepository().GetUnspentWithKey(
+			ctx, domain.UnspentKey{
+				TxID: bufferutil.TxIDFromBytes(in.Hash),
+				VOut: in.Index,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if unspent == nil {
+			continue
+		}
+
+		script := hex.EncodeToString(unspent.ScriptPubKey)
+		info, ok := infoByScript[script]
+		if !ok {
+			continue
+		}
+		outpoint := domain.TradeAuditOutpoint{
+			TxID:         unspent.TxID,
+			VOut:         unspent.VOut,
+			AccountIndex: info.AccountIndex,
+			Asset:        unspent.AssetHash,
+			Amount:       unspent.Value,
+			Script:       script,
+		}
+		if info.AccountIndex == domain.FeeAccount {
+			audit.FeeInputs = append(audit.FeeInputs, outpoint)
+		} else {
+			audit.MarketInputs = append(audit.MarketInputs, outpoint)
+		}
+	}
+
+	ownOutputs, _, err := TransactionManager.ExtractUnspents(
+		txHex, infoByScript, net,
+	)
+	if err != nil {
+		return nil, err
+	}
+	isOwnOutput := make(map[uint32]bool)
+	for _, u := range ownOutputs {
+		isOwnOutput[u.VOut] = true
+
+		script := hex.EncodeToString(u.ScriptPubKey)
+		info := infoByScript[script]
+		outpoint := domain.TradeAuditOutpoint{
+			TxID:         u.TxID,
+			VOut:         u.VOut,
+			AccountIndex: info.AccountIndex,
+			Asset:        u.AssetHash,
+			Amount:       u.Value,
+			Script:       script,
+		}
+		if isInternalDerivationPath(info.DerivationPath) {
+			audit.ChangeOutputs = append(audit.ChangeOutputs, outpoint)
+			continue
+		}
+		audit.MarketOutput = &outpoint
+	}
+
+	if req := trade.SwapRequestMessage(); req != nil {
+		_, outBlindingData, err := TransactionManager.ExtractBlindingData(
+			trade.PsetBase64,
+			nil, trade.SwapAcceptMessage().GetOutputBlindingKey(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for i, data := range outBlindingData {
+			if isOwnOutput[uint32(i)] || i >= len(tx.Outputs) {
+				continue
+			}
+			if data.Asset == req.GetAssetR() && data.Amount == req.GetAmountR() {
+				audit.CounterpartyOutput = &domain.TradeAuditOutpoint{
+					TxID:         txid,
+					VOut:         uint32(i),
+					AccountIndex: -1,
+					Asset:        data.Asset,
+					Amount:       data.Amount,
+					Script:       hex.EncodeToString(tx.Outputs[i].Script),
+				}
+				break
+			}
+		}
+	}
+
+	return audit, nil
+}
+
+// isInternalDerivationPath returns whether the given derivation path, in the
+// form account'/chain/index, is of an address of the internal chain.
+func isInternalDerivationPath(path string) bool {
+	parts := strings.Split(path, "/")
+	return len(parts) == 3 && parts[1] == strconv.Itoa(domain.InternalChain)
+}
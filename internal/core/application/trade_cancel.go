@@ -0,0 +1,61 @@
+package application
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+// CancelTrade brings the proposed or accepted trade with the given id to the
+// Canceled status, so that any later attempt to complete it is rejected, and
+// unlocks the unspents it reserved without waiting for the trade reaper.
+// Completed and settled trades can't be canceled.
+func (t *tradeService) CancelTrade(ctx context.Context, tradeID string) error {
+	if err := validateTradeID(tradeID); err != nil {
+		return err
+	}
+	id, _ := uuid.Parse(tradeID)
+
+	// prevent the trade from being completed or expired while canceling it.
+	t.tradeLocks.acquire(id)
+	defer t.tradeLocks.release(id)
+
+	trade, err := t.repoManager.TradeRepository().GetTradeByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if trade == nil {
+		return ErrTradeNotFound
+	}
+	if trade.IsCanceled() {
+		return nil
+	}
+	// only accepted trades have locked unspents
+	wasAccepted := trade.IsAccepted()
+
+	var canceledTrade *domain.Trade
+	if err := t.repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&id,
+		func(trade *domain.Trade) (*domain.Trade, error) {
+			if _, err := trade.Cancel(); err != nil {
+				return nil, err
+			}
+			canceledTrade = trade
+			return trade, nil
+		},
+	); err != nil {
+		return err
+	}
+
+	if wasAccepted {
+		t.blockchainListener.StopObserveTx(canceledTrade.TxID)
+		t.unlockUnspentsForTrade(canceledTrade)
+	}
+
+	log.Infof("trade with id %s canceled", tradeID)
+	t.notifyTradeStatus(canceledTrade)
+	return nil
+}
@@ -0,0 +1,120 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+// drainPollInterval is the interval between two checks for the trades still
+// in flight while shutting down.
+const drainPollInterval = 500 * time.Millisecond
+
+// tradeDrainer keeps track of the swap proposals and completions in flight,
+// so that the service can wait for them before shutting down.
+type tradeDrainer struct {
+	lock     *sync.Mutex
+	draining bool
+	inFlight int
+}
+
+func newTradeDrainer() *tradeDrainer {
+	return &tradeDrainer{lock: &sync.Mutex{}}
+}
+
+// startProposal tracks a new swap proposal, unless the service is draining.
+func (d *tradeDrainer) startProposal() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.draining {
+		return false
+	}
+	d.inFlight++
+	return true
+}
+
+// start tracks an operation that must be completed even while draining, like
+// the completion of an accepted trade.
+func (d *tradeDrainer) start() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.inFlight++
+}
+
+func (d *tradeDrainer) done() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.inFlight--
+}
+
+func (d *tradeDrainer) drain() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.draining = true
+}
+
+func (d *tradeDrainer) isIdle() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.inFlight <= 0
+}
+
+// Shutdown makes the service reject any new swap proposal, and waits for the
+// accepted trades to be completed, or to expire, and for the completed ones
+// to be persisted along with the resulting changes to the utxo set.
+// If ctx is done before, ctx.Err() is returned and the unspents of the trades
+// not yet completed stay locked until they're expired by the trade reaper,
+// since the counterparties might still broadcast their transactions.
+func (t *tradeService) Shutdown(ctx context.Context) error {
+	t.drainer.drain()
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	for {
+		pendingTrades, err := t.getPendingTrades(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pendingTrades) <= 0 && t.drainer.isIdle() {
+			return nil
+		}
+		log.Debugf(
+			"waiting for %d accepted trades to be completed", len(pendingTrades),
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// getPendingTrades returns the accepted trades that can still be completed.
+func (t *tradeService) getPendingTrades(
+	ctx context.Context,
+) ([]*domain.Trade, error) {
+	trades, err := t.repoManager.TradeRepository().GetTradesByStatus(
+		ctx, domain.AcceptedStatus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingTrades := make([]*domain.Trade, 0)
+	for _, trade := range trades {
+		if !trade.IsExpired() {
+			pendingTrades = append(pendingTrades, trade)
+		}
+	}
+	return pendingTrades, nil
+}
@@ -0,0 +1,158 @@
+package application
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+// ExportFormat is the encoding of an export of the trade history.
+type ExportFormat int
+
+const (
+	// ExportFormatCSV encodes the trades as CSV rows, preceded by a header.
+	ExportFormatCSV ExportFormat = iota
+	// ExportFormatJSON encodes the trades as a JSON array.
+	ExportFormatJSON
+)
+
+var tradeExportHeader = []string{
+	"id", "status", "failed", "base_asset", "quote_asset", "fee_basis_point",
+	"base_price", "quote_price", "asset_p", "amount_p", "asset_r", "amount_r",
+	"fee_asset", "fee_amount", "tx_url", "request_time_unix",
+	"accept_time_unix", "complete_time_unix", "settle_time_unix",
+	"expiry_time_unix",
+}
+
+// TradeExportRecord is the entry of an export of the trade history. The fee
+// is that collected by the daemon, therefore it's set only for the trades
+// that reached the Completed status.
+type TradeExportRecord struct {
+	TradeNotification
+	FeeAsset  string `json:"fee_asset,omitempty"`
+	FeeAmount uint64 `json:"fee_amount"`
+}
+
+func (r TradeExportRecord) csvRow() []string {
+	return []string{
+		r.ID,
+		r.Status,
+		strconv.FormatBool(r.Failed),
+		r.BaseAsset,
+		r.QuoteAsset,
+		strconv.FormatInt(r.FeeBasisPoint, 10),
+		r.BasePrice.String(),
+		r.QuotePrice.String(),
+		r.AssetP,
+		strconv.FormatUint(r.AmountP, 10),
+		r.AssetR,
+		strconv.FormatUint(r.AmountR, 10),
+		r.FeeAsset,
+		strconv.FormatUint(r.FeeAmount, 10),
+		r.TxURL,
+		strconv.FormatUint(r.RequestTimeUnix, 10),
+		strconv.FormatUint(r.AcceptTimeUnix, 10),
+		strconv.FormatUint(r.CompleteTimeUnix, 10),
+		strconv.FormatUint(r.SettleTimeUnix, 10),
+		strconv.FormatUint(r.ExpiryTimeUnix, 10),
+	}
+}
+
+// ExportTrades writes to w the trades requested between from and to, both
+// included and expressed in Unix seconds, encoded with the given format and
+// sorted by request time. Trades are read from the repository and written
+// one at a time, so that the export is never held in memory as a whole.
+func (o *operatorService) ExportTrades(
+	ctx context.Context,
+	from, to uint64,
+	format ExportFormat,
+	w io.Writer,
+) error {
+	if from > to {
+		return ErrInvalidTimeRange
+	}
+	if format != ExportFormatCSV && format != ExportFormatJSON {
+		return ErrUnknownExportFormat
+	}
+
+	forEachRecord := func(fn func(r TradeExportRecord) error) error {
+		return o.repoManager.TradeRepository().ForEachTradeByRequestTime(
+			ctx, from, to,
+			func(trade *domain.Trade) error {
+				if trade.IsEmpty() {
+					return nil
+				}
+				return fn(o.tradeExportRecord(trade))
+			},
+		)
+	}
+	return writeTradeExport(w, forEachRecord, format)
+}
+
+func (o *operatorService) tradeExportRecord(
+	trade *domain.Trade,
+) TradeExportRecord {
+	chInfo := make(chan TradeInfo, 1)
+	tradeToTradeInfo(trade, o.marketBaseAsset, o.network.Name, chInfo, nil)
+	close(chInfo)
+	info := <-chInfo
+	record := TradeExportRecord{TradeNotification: newTradeNotification(info)}
+
+	isCompleted := trade.Status.Code == domain.Completed ||
+		trade.Status.Code == domain.Settled
+	if isCompleted && !trade.Status.Failed && trade.SwapRequestMessage() != nil {
+		fee := collectedFeeFromTrade(trade, o.marketBaseAsset)
+		record.FeeAsset = fee.Asset
+		record.FeeAmount = fee.Amount
+	}
+	return record
+}
+
+// writeTradeExport encodes to w the records produced by forEachRecord, one at
+// a time.
+func writeTradeExport(
+	w io.Writer,
+	forEachRecord func(fn func(r TradeExportRecord) error) error,
+	format ExportFormat,
+) error {
+	if format == ExportFormatCSV {
+		cw := csv.NewWriter(w)
+		if err := cw.Write(tradeExportHeader); err != nil {
+			return err
+		}
+		if err := forEachRecord(func(r TradeExportRecord) error {
+			return cw.Write(r.csvRow())
+		}); err != nil {
+			return err
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	first := true
+	if err := forEachRecord(func(r TradeExportRecord) error {
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		buf, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(buf)
+		return err
+	}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "]")
+	return err
+}
@@ -0,0 +1,98 @@
+package application
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// tradeFeedBufferSize is the max number of updates buffered for every
+// subscriber. Slower subscribers are removed and their channel closed, so that
+// they can subscribe again instead of silently missing updates.
+const tradeFeedBufferSize = 100
+
+// TradeFilter defines which trade updates are dispatched to a subscriber.
+type TradeFilter struct {
+	// Market, if not empty, restricts the updates to the trades of this market.
+	Market Market
+	// MinStatus is the lowest status code of the trades that are dispatched.
+	MinStatus int
+}
+
+func (f TradeFilter) match(info TradeInfo) bool {
+	if f.Market.QuoteAsset != "" &&
+		f.Market.QuoteAsset != info.MarketWithFee.QuoteAsset {
+		return false
+	}
+	return info.Status.Code >= f.MinStatus
+}
+
+type tradeSubscriber struct {
+	filter TradeFilter
+	chInfo chan TradeInfo
+}
+
+// TradeFeed dispatches the status changes of trades to its subscribers.
+type TradeFeed struct {
+	subscribers map[string]*tradeSubscriber
+	lock        *sync.RWMutex
+}
+
+// NewTradeFeed returns a new TradeFeed with no subscribers.
+func NewTradeFeed() *TradeFeed {
+	return &TradeFeed{
+		subscribers: make(map[string]*tradeSubscriber),
+		lock:        &sync.RWMutex{},
+	}
+}
+
+// subscribe registers a new subscriber for the updates matching the given
+// filter and returns its id along with the channel where they are sent.
+func (f *TradeFeed) subscribe(filter TradeFilter) (string, <-chan TradeInfo) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	id := uuid.New().String()
+	chInfo := make(chan TradeInfo, tradeFeedBufferSize)
+	f.subscribers[id] = &tradeSubscriber{filter, chInfo}
+	return id, chInfo
+}
+
+// unsubscribe removes the subscriber and closes its channel.
+func (f *TradeFeed) unsubscribe(id string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if s, ok := f.subscribers[id]; ok {
+		close(s.chInfo)
+		delete(f.subscribers, id)
+	}
+}
+
+// publish dispatches the given trade update to the interested subscribers
+// without blocking. Subscribers whose buffer is full are unsubscribed.
+func (f *TradeFeed) publish(info TradeInfo) {
+	if f == nil {
+		return
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for id, s := range f.subscribers {
+		if !s.filter.match(info) {
+			continue
+		}
+		select {
+		case s.chInfo <- info:
+		default:
+			log.Warnf(
+				"removed slow subscriber %s, unable to send update of trade "+
+					"with id %s", id, info.ID,
+			)
+			close(s.chInfo)
+			delete(f.subscribers, id)
+		}
+	}
+}
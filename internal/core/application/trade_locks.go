@@ -0,0 +1,58 @@
+package application
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// tradeLocks serializes the operations that concurrently change the status of
+// the same trade, so that, for example, a trade is never expired, and its
+// unspents unlocked, while it's being completed.
+type tradeLocks struct {
+	locks map[uuid.UUID]*tradeLock
+	lock  *sync.Mutex
+}
+
+type tradeLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+func newTradeLocks() *tradeLocks {
+	return &tradeLocks{
+		locks: make(map[uuid.UUID]*tradeLock),
+		lock:  &sync.Mutex{},
+	}
+}
+
+// acquire blocks until the lock of the given trade is available.
+func (l *tradeLocks) acquire(tradeID uuid.UUID) {
+	l.lock.Lock()
+	tl, ok := l.locks[tradeID]
+	if !ok {
+		tl = &tradeLock{}
+		l.locks[tradeID] = tl
+	}
+	tl.refs++
+	l.lock.Unlock()
+
+	tl.mu.Lock()
+}
+
+// release frees the lock of the given trade, dropping it if nobody else is
+// waiting for it.
+func (l *tradeLocks) release(tradeID uuid.UUID) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	tl, ok := l.locks[tradeID]
+	if !ok {
+		return
+	}
+	tl.refs--
+	if tl.refs <= 0 {
+		delete(l.locks, tradeID)
+	}
+	tl.mu.Unlock()
+}
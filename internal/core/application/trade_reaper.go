@@ -0,0 +1,218 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	pkgswap "github.com/tdex-network/tdex-daemon/pkg/swap"
+)
+
+func (t *tradeService) StartTradeReaper(
+	ctx context.Context,
+	interval, gracePeriod time.Duration,
+) {
+	ticker := time.NewTicker(interval)
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				t.expireStuckTrades(gracePeriod)
+				t.failUnsettledTrades()
+			}
+		}
+	}()
+}
+
+// expireStuckTrades brings to failed status all trades that are stuck in
+// Proposal or Accepted status for longer than their expiration time plus the
+// grace period, and unlocks the unspents they reserved.
+func (t *tradeService) expireStuckTrades(gracePeriod time.Duration) {
+	now := time.Now()
+	for _, status := range []domain.Status{
+		domain.ProposalStatus,
+		domain.AcceptedStatus,
+		domain.FailedToCompleteStatus,
+	} {
+		trades, err := t.repoManager.TradeRepository().GetTradesByStatus(
+			context.Background(), status,
+		)
+		if err != nil {
+			log.WithError(err).Warn("unable to fetch trades to expire")
+			return
+		}
+
+		for _, trade := range trades {
+			if t.isTradeStuck(trade, now, gracePeriod) {
+				t.expireTrade(trade.ID, gracePeriod)
+			}
+		}
+	}
+}
+
+func (t *tradeService) expireTrade(tradeID uuid.UUID, gracePeriod time.Duration) {
+	t.tradeLocks.acquire(tradeID)
+	defer t.tradeLocks.release(tradeID)
+
+	ctx := context.Background()
+	var expiredTrade *domain.Trade
+	if err := t.repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&tradeID,
+		func(trade *domain.Trade) (*domain.Trade, error) {
+			// the trade might have been completed while waiting for the lock
+			if !t.isTradeStuck(trade, time.Now(), gracePeriod) {
+				return trade, nil
+			}
+
+			swapID := trade.SwapAccept.ID
+			if trade.IsProposal() {
+				swapID = trade.SwapRequest.ID
+			}
+			trade.Fail(
+				swapID,
+				int(pkgswap.ErrCodeTradeExpired),
+				"trade expired before being completed",
+			)
+			if trade.IsAccepted() {
+				if _, err := trade.Expire(); err != nil {
+					return nil, err
+				}
+			}
+			expiredTrade = trade
+			return trade, nil
+		},
+	); err != nil {
+		log.WithError(err).Warnf(
+			"unable to persist expiration of trade with id %s", tradeID,
+		)
+		return
+	}
+	if expiredTrade == nil {
+		return
+	}
+
+	// only accepted trades have locked unspents
+	if expiredTrade.Status.Code == domain.Expired {
+		t.blockchainListener.StopObserveTx(expiredTrade.TxID)
+		t.unlockUnspentsForTrade(expiredTrade)
+	}
+
+	log.Infof("trade with id %s expired", tradeID)
+	t.notifyTradeStatus(expiredTrade)
+}
+
+// isTradeStuck returns whether the given trade is in Proposal or Accepted
+// status since longer than its expiration time plus the grace period.
+// Proposals are not given an expiration time, therefore this is computed from
+// the time the trade was proposed.
+func (t *tradeService) isTradeStuck(
+	trade *domain.Trade,
+	now time.Time,
+	gracePeriod time.Duration,
+) bool {
+	var expiryTime time.Time
+	switch {
+	case trade.IsProposal() && !trade.Status.Failed:
+		expiryTime = time.Unix(int64(trade.SwapRequest.Timestamp), 0).
+			Add(t.expiryDuration)
+	case trade.IsAccepted() && trade.ExpiryTime > 0:
+		expiryTime = time.Unix(int64(trade.ExpiryTime), 0)
+	default:
+		return false
+	}
+	return now.After(expiryTime.Add(gracePeriod))
+}
+
+// failUnsettledTrades brings to failed status all trades that have been
+// accepted, but not completed by the settlement deadline set by their market,
+// and unlocks the unspents they reserved. Their tx is still observed, so that
+// a trade is settled anyway if this is later included in the blockchain.
+// Completed trades are left untouched instead, since the unspents spent by
+// their tx, already broadcasted, must not be selected for other trades.
+func (t *tradeService) failUnsettledTrades() {
+	now := uint64(time.Now().Unix())
+	trades, err := t.repoManager.TradeRepository().GetTradesByStatus(
+		context.Background(), domain.AcceptedStatus,
+	)
+	if err != nil {
+		log.WithError(err).Warn("unable to fetch trades to settle")
+		return
+	}
+
+	for _, trade := range trades {
+		if trade.IsSettlementTimedOut(now) {
+			t.failUnsettledTrade(trade.ID)
+		}
+	}
+}
+
+func (t *tradeService) failUnsettledTrade(tradeID uuid.UUID) {
+	t.tradeLocks.acquire(tradeID)
+	defer t.tradeLocks.release(tradeID)
+
+	var failedTrade *domain.Trade
+	if err := t.repoManager.TradeRepository().UpdateTrade(
+		context.Background(),
+		&tradeID,
+		func(trade *domain.Trade) (*domain.Trade, error) {
+			// the trade might have been completed while waiting for the lock
+			if !trade.IsSettlementTimedOut(uint64(time.Now().Unix())) {
+				return trade, nil
+			}
+
+			trade.Fail(
+				trade.SwapAccept.ID,
+				int(pkgswap.ErrCodeSettlementTimeout),
+				"trade not settled before the settlement deadline",
+			)
+			failedTrade = trade
+			return trade, nil
+		},
+	); err != nil {
+		log.WithError(err).Warnf(
+			"unable to persist settlement timeout of trade with id %s", tradeID,
+		)
+		return
+	}
+	if failedTrade == nil {
+		return
+	}
+
+	t.releaseUnsettledTrade(failedTrade)
+}
+
+// persistUnsettledTrade persists the given trade, already failed for not being
+// settled by its deadline, and unlocks the unspents it reserved. The caller
+// must hold the lock of the trade.
+func (t *tradeService) persistUnsettledTrade(
+	ctx context.Context,
+	failedTrade *domain.Trade,
+) {
+	if err := t.repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&failedTrade.ID,
+		func(_ *domain.Trade) (*domain.Trade, error) { return failedTrade, nil },
+	); err != nil {
+		log.WithError(err).Warnf(
+			"unable to persist settlement timeout of trade with id %s",
+			failedTrade.ID,
+		)
+		return
+	}
+
+	t.releaseUnsettledTrade(failedTrade)
+}
+
+func (t *tradeService) releaseUnsettledTrade(failedTrade *domain.Trade) {
+	t.unlockUnspentsForTrade(failedTrade)
+
+	log.Infof("trade with id %s not settled before deadline", failedTrade.ID)
+	t.notifyTradeStatus(failedTrade)
+}
@@ -18,6 +18,7 @@ import (
 	pkgswap "github.com/tdex-network/tdex-daemon/pkg/swap"
 	"github.com/tdex-network/tdex-daemon/pkg/transactionutil"
 	"github.com/tdex-network/tdex-daemon/pkg/wallet"
+	"github.com/tdex-network/tdex-daemon/pkg/webhook"
 	"github.com/vulpemventures/go-elements/network"
 	"github.com/vulpemventures/go-elements/pset"
 )
@@ -31,12 +32,33 @@ type TradeService interface {
 		amount uint64,
 		asset string,
 	) (*PriceWithFee, error)
+	PreviewTrade(
+		ctx context.Context,
+		market Market,
+		tradeType int,
+		amount uint64,
+		asset string,
+	) (*PriceWithFee, error)
+	// Quote returns the amount, net of fees, of the other asset of the market
+	// received for giving the given amount of giveAsset.
+	Quote(
+		ctx context.Context,
+		market Market,
+		giveAsset string,
+		giveAmount uint64,
+	) (*PriceWithFee, error)
 	TradePropose(
 		ctx context.Context,
 		market Market,
 		tradeType int,
 		swapRequest domain.SwapRequest,
 	) (domain.SwapAccept, domain.SwapFail, uint64, error)
+	// ValidateSwapRequest returns the failure that TradePropose would reject
+	// the given swap request with, if any, as a preflight check.
+	ValidateSwapRequest(
+		ctx context.Context,
+		swapRequest domain.SwapRequest,
+	) (*SwapFailInfo, error)
 	TradeComplete(
 		ctx context.Context,
 		swapComplete *domain.SwapComplete,
@@ -46,34 +68,112 @@ type TradeService interface {
 		ctx context.Context,
 		market Market,
 	) (*BalanceWithFee, error)
+	// StartTradeReaper periodically expires the trades not completed within
+	// their expiration time, plus the given grace period, unlocking the
+	// unspents they reserved. It runs in background until ctx is canceled.
+	StartTradeReaper(ctx context.Context, interval, gracePeriod time.Duration)
+	// StartLockReaper releases, at startup and then periodically, the locks
+	// on unspents older than the given TTL that are not held by any pending
+	// trade, like those of trades never persisted because of a crash. It runs
+	// in background until ctx is canceled.
+	StartLockReaper(ctx context.Context, interval, ttl time.Duration)
+	// StartReorgWatcher periodically demotes to unconfirmed the unspents whose
+	// block, within the given depth from the tip, has been reorganized out of
+	// the best chain, flagging the trades settled in it, and confirms them
+	// again once included in a new block. It runs in background until ctx is
+	// canceled.
+	StartReorgWatcher(ctx context.Context, interval time.Duration, depth uint64)
+	SetMarketStrategy(market Market, strategy PricingStrategy)
+	// SetPriceOracle bounds the prices of the markets within the given basis
+	// points from the reference prices of the oracle.
+	SetPriceOracle(oracle PriceOracle, maxDeviationBasisPoints uint64)
+	// SetPeerFees overrides the basis point fee of the markets for the swaps
+	// of the given peers.
+	SetPeerFees(fees map[string]int64)
+	MarketImbalance(ctx context.Context, market Market) (*Imbalance, error)
+	// RateLimiterState returns the state of the token buckets limiting the
+	// swap proposals of every market and peer.
+	RateLimiterState() []RateLimiterState
+	// CancelTrade cancels a proposed or accepted trade, rejecting any later
+	// attempt to complete it, and releases the unspents it locked.
+	CancelTrade(ctx context.Context, tradeID string) error
+	// SetPsetLog makes the service log the PSETs of the swap messages of every
+	// trade for forensic replay.
+	SetPsetLog(psetLog PsetLog)
+	// GetTradePsets returns the PSETs logged for the trade with the given id.
+	GetTradePsets(tradeID string) ([]SwapPset, error)
+	// Shutdown stops accepting new swap proposals and returns once all the
+	// accepted trades are completed, or expired, or when ctx is done.
+	Shutdown(ctx context.Context) error
 }
 
 type tradeService struct {
 	repoManager        ports.RepoManager
 	explorerSvc        explorer.Service
 	blockchainListener BlockchainListener
+	webhookSvc         webhook.Service
+	tradeFeed          *TradeFeed
+	signer             Signer
 	marketBaseAsset    string
 	expiryDuration     time.Duration
 	priceSlippage      decimal.Decimal
+	maxSlippage        uint64
+	minConfirmations   uint64
+	coinSelector       wallet.CoinSelector
+	dustThreshold      uint64
+	antiFeeSniping     bool
+	autoTopUpFees      bool
 	network            *network.Network
+	pricingStrategies  *pricingStrategies
+	tradeLocks         *tradeLocks
+	accountLocks       *accountLocks
+	fillAddresses      *fillAddressPool
+	rateLimiter        *tradeRateLimiter
+	drainer            *tradeDrainer
+	priceBand          *priceBand
+	psetLog            *tradePsetLog
+	peerFees           *peerFees
+	peerVolumes        *PeerVolumeCache
 }
 
 func NewTradeService(
 	repoManager ports.RepoManager,
 	explorerSvc explorer.Service,
 	bcListener BlockchainListener,
+	webhookSvc webhook.Service,
+	tradeFeed *TradeFeed,
+	peerVolumes *PeerVolumeCache,
+	signer Signer,
 	marketBaseAsset string,
 	expiryDuration time.Duration,
 	priceSlippage decimal.Decimal,
+	maxSlippageBasisPoints uint64,
+	minConfirmations uint64,
+	coinSelector wallet.CoinSelector,
+	dustThreshold uint64,
+	antiFeeSniping bool,
+	autoTopUpFees bool,
+	rateLimits RateLimits,
 	net *network.Network,
 ) TradeService {
 	return newTradeService(
 		repoManager,
 		explorerSvc,
 		bcListener,
+		webhookSvc,
+		tradeFeed,
+		peerVolumes,
+		signer,
 		marketBaseAsset,
 		expiryDuration,
 		priceSlippage,
+		maxSlippageBasisPoints,
+		minConfirmations,
+		coinSelector,
+		dustThreshold,
+		antiFeeSniping,
+		autoTopUpFees,
+		rateLimits,
 		net,
 	)
 }
@@ -82,19 +182,49 @@ func newTradeService(
 	repoManager ports.RepoManager,
 	explorerSvc explorer.Service,
 	bcListener BlockchainListener,
+	webhookSvc webhook.Service,
+	tradeFeed *TradeFeed,
+	peerVolumes *PeerVolumeCache,
+	signer Signer,
 	marketBaseAsset string,
 	expiryDuration time.Duration,
 	priceSlippage decimal.Decimal,
+	maxSlippageBasisPoints uint64,
+	minConfirmations uint64,
+	coinSelector wallet.CoinSelector,
+	dustThreshold uint64,
+	antiFeeSniping bool,
+	autoTopUpFees bool,
+	rateLimits RateLimits,
 	net *network.Network,
 ) *tradeService {
 	return &tradeService{
 		repoManager:        repoManager,
 		explorerSvc:        explorerSvc,
 		blockchainListener: bcListener,
+		webhookSvc:         webhookSvc,
+		tradeFeed:          tradeFeed,
+		signer:             signer,
 		marketBaseAsset:    marketBaseAsset,
 		expiryDuration:     expiryDuration,
 		priceSlippage:      priceSlippage,
+		maxSlippage:        maxSlippageBasisPoints,
+		minConfirmations:   minConfirmations,
+		coinSelector:       coinSelector,
+		dustThreshold:      dustThreshold,
+		antiFeeSniping:     antiFeeSniping,
+		autoTopUpFees:      autoTopUpFees,
 		network:            net,
+		pricingStrategies:  newPricingStrategies(),
+		tradeLocks:         newTradeLocks(),
+		accountLocks:       newAccountLocks(),
+		fillAddresses:      newFillAddressPool(),
+		rateLimiter:        newTradeRateLimiter(rateLimits),
+		drainer:            newTradeDrainer(),
+		priceBand:          newPriceBand(),
+		psetLog:            newTradePsetLog(),
+		peerFees:           newPeerFees(),
+		peerVolumes:        peerVolumes,
 	}
 }
 
@@ -120,13 +250,17 @@ func (t *tradeService) GetTradableMarkets(ctx context.Context) (
 
 	marketsWithFee := make([]MarketWithFee, 0, len(tradableMarkets))
 	for _, mkt := range tradableMarkets {
+		// paused markets are temporarily not accepting new trades
+		if mkt.IsPaused() {
+			continue
+		}
 		marketsWithFee = append(marketsWithFee, MarketWithFee{
 			Market: Market{
 				BaseAsset:  mkt.BaseAsset,
 				QuoteAsset: mkt.QuoteAsset,
 			},
 			Fee: Fee{
-				BasisPoint:    mkt.Fee,
+				BasisPoint:    mkt.ActiveFee(),
 				FixedBaseFee:  mkt.FixedFee.BaseFee,
 				FixedQuoteFee: mkt.FixedFee.QuoteFee,
 			},
@@ -136,12 +270,28 @@ func (t *tradeService) GetTradableMarkets(ctx context.Context) (
 	return marketsWithFee, nil
 }
 
+// GetMarketPrice returns the price of the given market, along with the
+// preview amount for the given trade. It's an alias of PreviewTrade.
 func (t *tradeService) GetMarketPrice(
 	ctx context.Context,
 	market Market,
 	tradeType int,
 	amount uint64,
 	asset string,
+) (*PriceWithFee, error) {
+	return t.PreviewTrade(ctx, market, tradeType, amount, asset)
+}
+
+// PreviewTrade computes the price and the amount, net of fees, for the given
+// trade by using the same strategy pricing of a real swap proposal, but without
+// selecting nor locking any of the market's unspents. The returned balance is
+// the one of the market at the time of the preview.
+func (t *tradeService) PreviewTrade(
+	ctx context.Context,
+	market Market,
+	tradeType int,
+	amount uint64,
+	asset string,
 ) (*PriceWithFee, error) {
 	if err := validateAssetString(market.BaseAsset); err != nil {
 		return nil, domain.ErrMarketInvalidBaseAsset
@@ -173,36 +323,74 @@ func (t *tradeService) GetMarketPrice(
 	if mktAccountIndex < 0 {
 		return nil, ErrMarketNotExist
 	}
+	mkt = t.marketForPeer(ctx, mkt)
 
 	if !mkt.IsTradable() {
 		return nil, domain.ErrMarketIsClosed
 	}
+	if mkt.IsPaused() {
+		return nil, domain.ErrMarketIsPaused
+	}
 
-	_, unspents, err := t.getInfoAndUnspentsForAccount(ctx, mktAccountIndex)
+	balances, err := t.getBalancesForAccount(ctx, mktAccountIndex, false)
 	if err != nil {
-		log.Debugf("error while retrieving unspents: %s", err)
+		log.Debugf("error while retrieving balances: %s", err)
 		return nil, ErrServiceUnavailable
 	}
 
-	preview, err := previewForMarket(unspents, mkt, tradeType, amount, asset)
+	preview, err := previewForMarket(
+		balances,
+		mkt,
+		t.pricingStrategies.forMarket(mkt),
+		tradeType,
+		amount,
+		asset,
+	)
 	if err != nil {
 		log.Debugf("error while making preview: %s", err)
 		return nil, ErrServiceUnavailable
 	}
 
+	// prices are rounded only here so that the amounts of the preview are
+	// computed at full precision, like those of a real trade.
 	return &PriceWithFee{
-		Price:   preview.price,
+		Price: Price{
+			BasePrice:  mkt.RoundPrice(preview.price.BasePrice),
+			QuotePrice: mkt.RoundPrice(preview.price.QuotePrice),
+		},
 		Amount:  preview.amount,
 		Asset:   preview.asset,
 		Balance: preview.balances,
 		Fee: Fee{
-			BasisPoint:    mkt.Fee,
+			BasisPoint:    mkt.ActiveFee(),
 			FixedBaseFee:  mkt.FixedFee.BaseFee,
 			FixedQuoteFee: mkt.FixedFee.QuoteFee,
 		},
 	}, nil
 }
 
+// Quote is like PreviewTrade, but the trade type is inferred from the given
+// asset: giving the base asset is a sell, while giving the quote asset is a
+// buy. Either way, the returned amount is the one of the other asset of the
+// market that is received in exchange, net of fees.
+func (t *tradeService) Quote(
+	ctx context.Context,
+	market Market,
+	giveAsset string,
+	giveAmount uint64,
+) (*PriceWithFee, error) {
+	var tradeType int
+	switch giveAsset {
+	case market.BaseAsset:
+		tradeType = TradeSell
+	case market.QuoteAsset:
+		tradeType = TradeBuy
+	default:
+		return nil, ErrAssetNotInMarket
+	}
+	return t.PreviewTrade(ctx, market, tradeType, giveAmount, giveAsset)
+}
+
 func (t *tradeService) GetMarketBalance(
 	ctx context.Context,
 	market Market,
@@ -240,46 +428,49 @@ func (t *tradeService) GetMarketBalance(
 		return nil, ErrMarketNotExist
 	}
 
-	info, err := vault.AllDerivedAddressesInfoForAccount(m.AccountIndex)
-	if err != nil {
-		log.Debugf("error while retrieving addresses: %s", err)
-		return nil, ErrServiceUnavailable
-	}
-	marketAddresses := info.Addresses()
-
-	baseAssetBalance, err := t.repoManager.UnspentRepository().GetBalance(
-		ctx,
-		marketAddresses,
-		m.BaseAsset,
-	)
+	balances, err := t.getBalancesForAccount(ctx, m.AccountIndex, true)
 	if err != nil {
-		log.Debugf("error while retrieving base asset balance: %s", err)
-		return nil, ErrServiceUnavailable
-	}
-
-	quoteAssetBalance, err := t.repoManager.UnspentRepository().GetBalance(
-		ctx,
-		marketAddresses,
-		m.QuoteAsset,
-	)
-	if err != nil {
-		log.Debugf("error while retrieving quote asset balance: %s", err)
+		log.Debugf("error while retrieving balances: %s", err)
 		return nil, ErrServiceUnavailable
 	}
 
 	return &BalanceWithFee{
 		Balance: Balance{
-			BaseAmount:  baseAssetBalance,
-			QuoteAmount: quoteAssetBalance,
+			BaseAmount:  balances[m.BaseAsset],
+			QuoteAmount: balances[m.QuoteAsset],
 		},
 		Fee: Fee{
-			BasisPoint:    m.Fee,
+			BasisPoint:    m.ActiveFee(),
 			FixedBaseFee:  m.FixedFee.BaseFee,
 			FixedQuoteFee: m.FixedFee.QuoteFee,
 		},
 	}, nil
 }
 
+// SetMarketStrategy binds a custom pricing strategy to the given market. It
+// takes precedence over the market's built-in one until a nil strategy is
+// bound. Bindings are not persisted, therefore they must be restored at every
+// restart of the daemon.
+func (t *tradeService) SetMarketStrategy(
+	market Market,
+	strategy PricingStrategy,
+) {
+	t.pricingStrategies.set(market.QuoteAsset, strategy)
+}
+
+// SetPriceOracle binds the given oracle to all markets, so that swaps are
+// rejected while the price of a market, whatever its strategy, is more than
+// maxDeviationBasisPoints far from the reference one. This protects against
+// prices manipulated or stuck far from the fair value, including those of the
+// markets priced by reserves drained by arbitrage. Binding a nil oracle
+// disables the check.
+func (t *tradeService) SetPriceOracle(
+	oracle PriceOracle,
+	maxDeviationBasisPoints uint64,
+) {
+	t.priceBand.set(oracle, maxDeviationBasisPoints)
+}
+
 func (t *tradeService) TradePropose(
 	ctx context.Context,
 	market Market,
@@ -294,6 +485,12 @@ func (t *tradeService) TradePropose(
 		return nil, nil, 0, domain.ErrMarketInvalidQuoteAsset
 	}
 
+	if !t.drainer.startProposal() {
+		log.Debug("swap request rejected: service is shutting down")
+		return nil, nil, 0, ErrServiceUnavailable
+	}
+	defer t.drainer.done()
+
 	vault, err := t.repoManager.VaultRepository().GetOrCreateVault(ctx, nil, "", nil)
 	if err != nil {
 		log.Debugf("error while retrieving vault: %s", err)
@@ -315,6 +512,31 @@ func (t *tradeService) TradePropose(
 	if marketAccountIndex < 0 {
 		return nil, nil, 0, ErrMarketNotExist
 	}
+	mkt = t.marketForPeer(ctx, mkt)
+
+	// rejected here, before any coin is selected and locked, and without
+	// persisting the trade so that spamming proposals doesn't fill the db.
+	if peer := peerFromContext(ctx); !t.rateLimiter.allow(market.QuoteAsset, peer) {
+		log.WithField("peer", peer).Debugf(
+			"swap request rejected for market %s: rate limit exceeded",
+			market.QuoteAsset,
+		)
+		trade := domain.NewTrade()
+		trade.Fail(
+			swapRequest.GetId(),
+			int(pkgswap.ErrCodeRateLimited),
+			"retry later",
+		)
+		return nil, trade.SwapFailMessage(), 0, nil
+	}
+
+	// the explorer is queried before any account is locked, so that a slow
+	// response never holds back concurrent proposals.
+	lockTime, err := antiFeeSnipingLockTime(t.antiFeeSniping, t.explorerSvc)
+	if err != nil {
+		log.Debugf("error while getting block height for locktime: %s", err)
+		return nil, nil, 0, ErrServiceUnavailable
+	}
 
 	// get all unspents for market account (both as []domain.Unspents and as
 	// []explorer.Utxo)along with private blinding keys and signing derivation
@@ -326,6 +548,8 @@ func (t *tradeService) TradePropose(
 		return nil, nil, 0, ErrServiceUnavailable
 	}
 
+	marketUnspents = selectableUnspents(marketUnspents)
+
 	// Check we got at least one
 	if len(marketUnspents) <= 0 {
 		return nil, nil, 0, ErrMarketNotFunded
@@ -338,8 +562,12 @@ func (t *tradeService) TradePropose(
 		log.Debugf("error while retrieving fee account addresses and unspents: %s", err)
 		return nil, nil, 0, ErrServiceUnavailable
 	}
-	// Check we got at least one
-	if len(feeUnspents) <= 0 {
+	feeUnspents = selectableUnspents(feeUnspents)
+	// Check we got at least one, unless the market can pay for the fees
+	if len(feeUnspents) <= 0 &&
+		!(t.autoTopUpFees && canMarketPayFees(
+			mkt.BaseAsset, swapRequest.GetAssetP(), t.network,
+		)) {
 		return nil, nil, 0, ErrFeeAccountNotFunded
 	}
 
@@ -349,16 +577,18 @@ func (t *tradeService) TradePropose(
 	var swapExpiryTime uint64
 
 	var fillProposalResult *FillProposalResult
-	var outInfo *domain.AddressInfo
-	var changeInfo *domain.AddressInfo
-	var feeChangeInfo *domain.AddressInfo
+	var addresses fillAddresses
 	var mnemonic []string
+	releaseAccounts := func() {}
+	// the addresses of a fill that's not accepted are given back to be reused.
+	releaseAddresses := func() {}
 
 	trade := domain.NewTrade()
+	trade.Peer = authenticatedPeerFromContext(ctx)
 	if ok, _ := trade.Propose(
 		swapRequest,
 		market.QuoteAsset,
-		mkt.Fee,
+		mkt.ActiveFee(),
 		mkt.FixedFee.BaseFee,
 		mkt.FixedFee.QuoteFee,
 		nil,
@@ -367,33 +597,74 @@ func (t *tradeService) TradePropose(
 		goto end
 	}
 
-	if !isValidTradePrice(swapRequest, tradeType, mkt, marketUnspents, t.priceSlippage) {
-		trade.Fail(
-			swapRequest.GetId(),
-			int(pkgswap.ErrCodeInvalidSwapRequest),
-			"bad pricing",
-		)
+	if feeAsset, failInfo := t.checkSwapRequest(
+		ctx, mkt, marketInfo, marketUnspents, tradeType, swapRequest,
+	); failInfo != nil {
+		trade.Fail(swapRequest.GetId(), failInfo.Code, failInfo.Message)
 		swapFail = trade.SwapFailMessage()
 		goto end
+	} else {
+		trade.FeeAsset = feeAsset
 	}
 
 	// derive output and change address for market, and change address for fee account
-	outInfo, _ = vault.DeriveNextExternalAddressForAccount(marketAccountIndex)
-	changeInfo, _ = vault.DeriveNextInternalAddressForAccount(marketAccountIndex)
-	feeChangeInfo, _ = vault.DeriveNextInternalAddressForAccount(domain.FeeAccount)
+	addresses, err = t.deriveFillAddresses(ctx, marketAccountIndex)
+	if err != nil {
+		log.Debugf("error while deriving addresses for trade: %s", err)
+		return nil, nil, 0, ErrServiceUnavailable
+	}
+	releaseAddresses = func() {
+		t.fillAddresses.put(marketAccountIndex, addresses)
+	}
+
+	// the unspents of the market and fee accounts must not change from when
+	// they're selected to when those used by the trade are locked, otherwise
+	// concurrent proposals could end up spending the same ones. Those locked
+	// by other proposals since they have been fetched are left out.
+	releaseAccounts = t.accountLocks.acquire(domain.FeeAccount, marketAccountIndex)
+	marketUnspents, err = t.stillAvailableUnspents(ctx, marketInfo, marketUnspents)
+	if err == nil {
+		feeUnspents, err = t.stillAvailableUnspents(ctx, feeInfo, feeUnspents)
+	}
+	if err != nil {
+		releaseAccounts()
+		releaseAddresses()
+		log.Debugf("error while retrieving available unspents: %s", err)
+		return nil, nil, 0, ErrServiceUnavailable
+	}
+	if len(marketUnspents) <= 0 {
+		releaseAccounts()
+		releaseAddresses()
+		return nil, nil, 0, ErrMarketNotFunded
+	}
+	if len(feeUnspents) <= 0 &&
+		!(t.autoTopUpFees && canMarketPayFees(
+			mkt.BaseAsset, swapRequest.GetAssetP(), t.network,
+		)) {
+		releaseAccounts()
+		releaseAddresses()
+		return nil, nil, 0, ErrFeeAccountNotFunded
+	}
 
 	mnemonic, _ = vault.GetMnemonicSafe()
 	fillProposalResult, err = TradeManager.FillProposal(FillProposalOpts{
-		Mnemonic:      mnemonic,
-		SwapRequest:   swapRequest,
-		MarketUtxos:   marketUnspents.ToUtxos(),
-		FeeUtxos:      feeUnspents.ToUtxos(),
-		MarketInfo:    marketInfo,
-		FeeInfo:       feeInfo,
-		OutputInfo:    *outInfo,
-		ChangeInfo:    *changeInfo,
-		FeeChangeInfo: *feeChangeInfo,
-		Network:       t.network,
+		Mnemonic:        mnemonic,
+		WalletOpts:      vault.WalletOpts,
+		SwapRequest:     swapRequest,
+		MarketUtxos:     marketUnspents.ToUtxos(),
+		FeeUtxos:        feeUnspents.ToUtxos(),
+		MarketInfo:      marketInfo,
+		FeeInfo:         feeInfo,
+		OutputInfo:      *addresses.out,
+		ChangeInfo:      *addresses.change,
+		FeeChangeInfo:   *addresses.feeChange,
+		Network:         t.network,
+		CoinSelector:    t.coinSelector,
+		DustThreshold:   t.dustThreshold,
+		AutoTopUpFees:   t.autoTopUpFees,
+		MarketBaseAsset: mkt.BaseAsset,
+		Signer:          t.signer,
+		LockTime:        lockTime,
 	})
 	if err != nil {
 		trade.Fail(
@@ -415,10 +686,45 @@ func (t *tradeService) TradePropose(
 		goto end
 	}
 
+	if err := trade.SetSettlementDeadline(mkt.SettlementTimeout); err != nil {
+		trade.Fail(
+			swapRequest.GetId(),
+			int(pkgswap.ErrCodeRejectedSwapRequest),
+			err.Error(),
+		)
+		swapFail = trade.SwapFailMessage()
+		goto end
+	}
+
 	swapAccept = trade.SwapAcceptMessage()
 	swapExpiryTime = trade.ExpiryTime
 
+	if fees := fillProposalResult.FeesPaidByMarket; fees > 0 {
+		logFeesPaidByMarket(
+			trade.ID.String(),
+			mkt,
+			t.pricingStrategies.forMarket(mkt),
+			marketUnspents,
+			fees,
+		)
+	}
+
 end:
+	t.psetLog.add(trade.ID.String(), newSwapPset(
+		SwapPsetRequest,
+		swapRequest.GetTransaction(),
+		swapRequest.GetInputBlindingKey(),
+		swapRequest.GetOutputBlindingKey(),
+	))
+	if fillProposalResult != nil {
+		t.psetLog.add(trade.ID.String(), newSwapPset(
+			SwapPsetAccept,
+			fillProposalResult.PsetBase64,
+			fillProposalResult.InputBlindingKeys,
+			fillProposalResult.OutputBlindingKeys,
+		))
+	}
+
 	var selectedUnspentKeys []domain.UnspentKey
 
 	if swapAccept != nil {
@@ -431,18 +737,18 @@ end:
 			trade.ID,
 		)
 		log.Debugf("locked %d unspents", lockedUnspents)
-
-		// set timer for trade expiration
-		go func() {
-			// admit a tollerance of 1 minute past the expiration time.
-			time.Sleep(t.expiryDuration + time.Minute)
-			t.checkTradeExpiration(trade.TxID, selectedUnspentKeys)
-		}()
 	} else {
 		log.WithField("reason", swapFail.GetFailureMessage()).Infof("trade with id %s rejected", trade.ID)
+		releaseAddresses()
 	}
+	releaseAccounts()
 
+	// the trade is persisted in background, but the service must wait for it
+	// before shutting down.
+	t.drainer.start()
 	go func() {
+		defer t.drainer.done()
+
 		if _, err := t.repoManager.RunTransaction(
 			context.Background(),
 			false,
@@ -455,18 +761,12 @@ end:
 				}); err != nil {
 					return nil, err
 				}
-
-				if swapAccept != nil {
-					if err := t.repoManager.VaultRepository().UpdateVault(ctx, func(_ *domain.Vault) (*domain.Vault, error) {
-						return vault, nil
-					}); err != nil {
-						return nil, err
-					}
-				}
 				return nil, nil
 			},
 		); err != nil {
 			log.WithError(err).Warn("unable to persist changes after trade is accepted")
+		} else {
+			t.notifyTradeStatus(trade)
 		}
 
 		if swapAccept != nil {
@@ -477,12 +777,69 @@ end:
 	return swapAccept, swapFail, swapExpiryTime, nil
 }
 
+// RateLimiterState returns the tokens left in the buckets of the markets and
+// of the peers that recently proposed swaps, sorted by scope and key.
+func (t *tradeService) RateLimiterState() []RateLimiterState {
+	return t.rateLimiter.state()
+}
+
+// deriveFillAddresses returns the output and change addresses of the given
+// market account and the change address of the fee account for filling a
+// proposal. Those of a previously rejected fill of the market are reused if
+// any, otherwise new ones are derived and persisted within the same
+// transaction, so that concurrent fills never get the same derivation indexes.
+func (t *tradeService) deriveFillAddresses(
+	ctx context.Context,
+	marketAccountIndex int,
+) (fillAddresses, error) {
+	if addresses, ok := t.fillAddresses.get(marketAccountIndex); ok {
+		return addresses, nil
+	}
+
+	var outInfo, changeInfo, feeChangeInfo *domain.AddressInfo
+	_, err := t.repoManager.RunTransaction(
+		ctx,
+		false,
+		func(ctx context.Context) (interface{}, error) {
+			if err := t.repoManager.VaultRepository().UpdateVault(
+				ctx,
+				func(v *domain.Vault) (*domain.Vault, error) {
+					var err error
+					outInfo, err = v.DeriveNextExternalAddressForAccount(marketAccountIndex)
+					if err != nil {
+						return nil, err
+					}
+					changeInfo, err = v.DeriveNextInternalAddressForAccount(marketAccountIndex)
+					if err != nil {
+						return nil, err
+					}
+					feeChangeInfo, err = v.DeriveNextInternalAddressForAccount(domain.FeeAccount)
+					if err != nil {
+						return nil, err
+					}
+					return v, nil
+				},
+			); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		},
+	)
+	if err != nil {
+		return fillAddresses{}, err
+	}
+	return fillAddresses{outInfo, changeInfo, feeChangeInfo}, nil
+}
+
 // TradeComplete is the domain controller for the TradeComplete RPC
 func (t *tradeService) TradeComplete(
 	ctx context.Context,
 	swapComplete *domain.SwapComplete,
 	swapFail *domain.SwapFail,
 ) (string, domain.SwapFail, error) {
+	t.drainer.start()
+	defer t.drainer.done()
+
 	if swapFail != nil {
 		swapFailMsg, err := t.tradeFail(ctx, *swapFail)
 		if err != nil {
@@ -508,11 +865,36 @@ func (t *tradeService) tradeComplete(
 		return
 	}
 
+	// prevent the trade from being expired by the reaper while completing it.
+	// The lock is released once the completed trade is persisted.
+	tradeID := trade.ID
+	t.tradeLocks.acquire(tradeID)
+	persisting := false
+	defer func() {
+		if !persisting {
+			t.tradeLocks.release(tradeID)
+		}
+	}()
+
+	// the trade might have been changed while waiting for the lock
+	trade, err = t.repoManager.TradeRepository().GetTradeBySwapAcceptID(ctx, swapComplete.GetAcceptId())
+	if err != nil {
+		return
+	}
+
 	tx := swapComplete.GetTransaction()
+	t.psetLog.add(
+		trade.ID.String(), newSwapPset(SwapPsetComplete, tx, nil, nil),
+	)
 
 	// here we manipulate the trade to reach the Complete status
 	res, err := trade.Complete(tx)
 	if err != nil {
+		// the trade has been failed for being completed too late, that must be
+		// persisted, along with releasing its unspents.
+		if errors.Is(err, domain.ErrTradeSettlementDeadlineReached) {
+			t.persistUnsettledTrade(ctx, trade)
+		}
 		return
 	}
 	// for domain related errors, we check for swap failures that can happens
@@ -537,13 +919,21 @@ func (t *tradeService) tradeComplete(
 	// is not influencing the trade therefore we run as goroutine
 	// this method will take care to retry to handle potential
 	// datastore conflicts (if any) at repository level
+	persisting = true
+	t.drainer.start()
 	go func() {
-		if err := t.repoManager.TradeRepository().UpdateTrade(
+		defer t.drainer.done()
+
+		err := t.repoManager.TradeRepository().UpdateTrade(
 			ctx,
 			&trade.ID,
 			func(previousTrade *domain.Trade) (*domain.Trade, error) { return trade, nil },
-		); err != nil {
+		)
+		t.tradeLocks.release(tradeID)
+		if err != nil {
 			log.Error("unable to persist completed trade with id ", trade.ID, " : ", err.Error())
+		} else {
+			t.notifyTradeStatus(trade)
 		}
 
 		_, accountIndex, _ := t.repoManager.MarketRepository().GetMarketByAsset(
@@ -573,6 +963,10 @@ func (t *tradeService) tradeFail(
 	}
 
 	tradeID := trade.ID
+	t.tradeLocks.acquire(tradeID)
+	defer t.tradeLocks.release(tradeID)
+
+	var failedTrade *domain.Trade
 	if err := t.repoManager.TradeRepository().UpdateTrade(
 		ctx,
 		&tradeID,
@@ -582,11 +976,13 @@ func (t *tradeService) tradeFail(
 				int(pkgswap.ErrCodeFailedToComplete),
 				"set failed by counter-party",
 			)
+			failedTrade = trade
 			return trade, nil
 		},
 	); err != nil {
 		return nil, err
 	}
+	t.notifyTradeStatus(failedTrade)
 
 	go t.unlockUnspentsForTrade(trade)
 	go t.blockchainListener.StopObserveTx(trade.TxID)
@@ -594,6 +990,35 @@ func (t *tradeService) tradeFail(
 	return swapFail, nil
 }
 
+// stillAvailableUnspents returns those of the given unspents, owned by the
+// given addresses, that have not been locked or spent since they were fetched.
+func (t *tradeService) stillAvailableUnspents(
+	ctx context.Context,
+	info domain.AddressesInfo,
+	unspents Unspents,
+) (Unspents, error) {
+	available, err := t.repoManager.UnspentRepository().GetAvailableUnspentsForAddresses(
+		ctx,
+		info.Addresses(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	availableKeys := make(map[domain.UnspentKey]struct{}, len(available))
+	for _, u := range available {
+		availableKeys[u.Key()] = struct{}{}
+	}
+
+	stillAvailable := make(Unspents, 0, len(unspents))
+	for _, u := range unspents {
+		if _, ok := availableKeys[u.Key()]; ok {
+			stillAvailable = append(stillAvailable, u)
+		}
+	}
+	return stillAvailable, nil
+}
+
 func (t *tradeService) getInfoAndUnspentsForAccount(
 	ctx context.Context,
 	account int,
@@ -611,10 +1036,99 @@ func (t *tradeService) getInfoAndUnspentsForAccount(
 	if err != nil {
 		return nil, nil, err
 	}
+	unspents, err = t.withMinConfirmations(unspents)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	return info, Unspents(unspents), nil
 }
 
+// withMinConfirmations returns only those of the given unspents that are deep
+// enough in the chain to be used for trading.
+func (t *tradeService) withMinConfirmations(
+	unspents []domain.Unspent,
+) ([]domain.Unspent, error) {
+	if t.minConfirmations <= 1 {
+		return unspents, nil
+	}
+
+	tipHeight, err := getTipHeight(t.explorerSvc, t.minConfirmations)
+	if err != nil {
+		return nil, err
+	}
+	return t.deepUnspents(unspents, tipHeight), nil
+}
+
+func (t *tradeService) deepUnspents(
+	unspents []domain.Unspent,
+	tipHeight uint64,
+) []domain.Unspent {
+	if t.minConfirmations <= 1 {
+		return unspents
+	}
+
+	deepUnspents := make([]domain.Unspent, 0, len(unspents))
+	for _, u := range unspents {
+		if u.HasConfirmations(t.minConfirmations, tipHeight) {
+			deepUnspents = append(deepUnspents, u)
+		}
+	}
+	return deepUnspents
+}
+
+// getBalancesForAccount returns the balances by asset of the unspents of the
+// given account that are deep enough in the chain, the locked ones included
+// only if requested. The balances are read from the cache of the repo
+// manager, if any, and computed from the unspents otherwise.
+func (t *tradeService) getBalancesForAccount(
+	ctx context.Context,
+	account int,
+	withLocked bool,
+) (map[string]uint64, error) {
+	info, err := t.repoManager.VaultRepository().
+		GetAllDerivedAddressesInfoForAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	addresses := info.Addresses()
+
+	tipHeight, err := getTipHeight(
+		explorer.WithContext(ctx, t.explorerSvc), t.minConfirmations,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := getBalanceCache(t.repoManager)
+	key := balanceCacheKey{account, withLocked}
+	var version uint64
+	if cache != nil {
+		balances, v, ok := cache.get(key, addresses, tipHeight)
+		if ok {
+			return balances, nil
+		}
+		version = v
+	}
+
+	unspentRepo := t.repoManager.UnspentRepository()
+	var unspents []domain.Unspent
+	if withLocked {
+		unspents, err = unspentRepo.GetUnspentsForAddresses(ctx, addresses)
+	} else {
+		unspents, err = unspentRepo.GetAvailableUnspentsForAddresses(ctx, addresses)
+	}
+	if err != nil {
+		return nil, err
+	}
+	balances := getBalanceByAsset(t.deepUnspents(unspents, tipHeight))
+
+	if cache != nil {
+		cache.set(key, version, addresses, tipHeight, balances)
+	}
+	return balances, nil
+}
+
 func (t *tradeService) unlockUnspentsForTrade(trade *domain.Trade) {
 	p, _ := pset.NewPsetFromBase64(trade.PsetBase64)
 	keyLen := len(p.Inputs)
@@ -642,53 +1156,51 @@ func (t *tradeService) unlockUnspentsForTrade(trade *domain.Trade) {
 	log.Debugf("unlocked %d unspents", count)
 }
 
-func (t *tradeService) checkTradeExpiration(
-	tradeTxID string,
-	selectedUnspentKeys []domain.UnspentKey,
-) {
-	ctx := context.Background()
+func (t *tradeService) notifyTradeStatus(trade *domain.Trade) {
+	notifyTradeStatus(
+		t.webhookSvc, t.tradeFeed, trade, t.marketBaseAsset, t.network.Name,
+	)
+}
 
-	// if the trade is expired it's required to unlock the unspents used as input
-	// and to bring the trade to failed status
-	trade, _ := t.repoManager.TradeRepository().GetTradeByTxID(ctx, tradeTxID)
+// notifyTradeStatus publishes the current status of the given trade to the
+// subscribers of the trade feed and delivers it to the webhook endpoint, if
+// one is configured. Webhook delivery, including retries, is made in
+// background, failures are only logged.
+func notifyTradeStatus(
+	webhookSvc webhook.Service,
+	tradeFeed *TradeFeed,
+	trade *domain.Trade,
+	marketBaseAsset, net string,
+) {
+	if (webhookSvc == nil && tradeFeed == nil) || trade == nil {
+		return
+	}
 
-	if trade.IsExpired() {
-		t.blockchainListener.StopObserveTx(trade.TxID)
+	chInfo := make(chan TradeInfo, 1)
+	tradeToTradeInfo(trade, marketBaseAsset, net, chInfo, nil)
+	close(chInfo)
+	info, ok := <-chInfo
+	if !ok {
+		return
+	}
 
-		count, err := t.repoManager.UnspentRepository().UnlockUnspents(ctx, selectedUnspentKeys)
-		if err != nil {
-			log.Warnf(
-				"trade with id %s has expired but an error occured while "+
-					"unlocking its unspents. You must run ReloadUtxo RPC as soon as "+
-					"possible to restore the utxo set of the internal wallet. Error: %v",
-				trade.ID, err,
-			)
-			return
-		}
-		log.Debugf("unlocked %d unspents", count)
+	tradeFeed.publish(info)
 
-		if err := t.repoManager.TradeRepository().UpdateTrade(
-			ctx,
-			&trade.ID,
-			func(tt *domain.Trade) (*domain.Trade, error) {
-				if _, err := tt.Expire(); err != nil {
-					return nil, err
-				}
-				return tt, nil
-			},
-		); err != nil {
-			log.Warnf("unable to persist expiration of trade with id %s", trade.ID)
-			return
-		}
-		log.Infof("trade with id %s expired", trade.ID)
+	if webhookSvc == nil {
 		return
 	}
+
+	go func() {
+		if err := webhookSvc.Send(newTradeNotification(info)); err != nil {
+			log.WithError(err).Warnf(
+				"unable to notify status change of trade with id %s", info.ID,
+			)
+		}
+	}()
 }
 
 func fillProposal(opts FillProposalOpts) (*FillProposalResult, error) {
-	w, err := wallet.NewWalletFromMnemonic(wallet.NewWalletFromMnemonicOpts{
-		SigningMnemonic: opts.Mnemonic,
-	})
+	w, err := opts.WalletOpts.NewWallet(opts.Mnemonic)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create wallet: %s", err)
 	}
@@ -705,6 +1217,8 @@ func fillProposal(opts FillProposalOpts) (*FillProposalResult, error) {
 		OutputDerivationPath: opts.OutputInfo.DerivationPath,
 		ChangeDerivationPath: opts.ChangeInfo.DerivationPath,
 		Network:              network,
+		CoinSelector:         opts.CoinSelector,
+		DustThreshold:        opts.DustThreshold,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update swap: %s", err)
@@ -712,17 +1226,29 @@ func fillProposal(opts FillProposalOpts) (*FillProposalResult, error) {
 
 	// top-up fees using fee account. Note that the fee output is added after
 	// blinding the transaction because it's explicit and must not be blinded
-	psetWithFeesResult, err := w.UpdateTx(wallet.UpdateTxOpts{
-		PsetBase64:        psetBase64,
-		Unspents:          opts.FeeUtxos,
-		MilliSatsPerBytes: domain.MinMilliSatPerByte,
-		Network:           network,
-		ChangePathsByAsset: map[string]string{
-			network.AssetID: opts.FeeChangeInfo.DerivationPath,
-		},
-		WantPrivateBlindKeys: true,
-		WantChangeForFees:    true,
-	})
+	psetWithFeesResult, err := addFeeInputs(
+		w, psetBase64, opts.FeeUtxos, opts.FeeChangeInfo, network,
+		opts.CoinSelector, opts.DustThreshold,
+	)
+	feesPaidByMarket := uint64(0)
+	if opts.AutoTopUpFees &&
+		(len(opts.FeeUtxos) <= 0 || err == explorer.ErrInsufficientFunds) {
+		// the fee account ran low, the market pays for the fees with a slice of
+		// the L-BTC it receives from the trader, that's by converting a slice of
+		// the quote asset it gives in exchange at the price of the trade. The
+		// L-BTC reserve of the market is never spent for this.
+		if !canMarketPayFees(
+			opts.MarketBaseAsset, opts.SwapRequest.GetAssetP(), network,
+		) {
+			return nil, ErrFeeTopUpNotSupported
+		}
+		psetWithFeesResult, err = payFeesWithSwapOutput(
+			psetBase64, opts.OutputInfo, opts.DustThreshold,
+		)
+		if err == nil {
+			feesPaidByMarket = psetWithFeesResult.FeeAmount
+		}
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to topup for paying fees: %s", err)
 	}
@@ -737,9 +1263,7 @@ func fillProposal(opts FillProposalOpts) (*FillProposalResult, error) {
 		nil,
 	)
 
-	// get the indexes of the inputs of the tx to sign
 	existingInputs := len(inputBlindingData)
-	inputsToSign := len(selectedUnspents)
 
 	for i, u := range selectedUnspents {
 		inputBlindingData[existingInputs+i] = wallet.BlindingData{
@@ -777,21 +1301,43 @@ func fillProposal(opts FillProposalOpts) (*FillProposalResult, error) {
 		return nil, fmt.Errorf("failed to add explicit fees: %s", err)
 	}
 
+	psetBase64 = blindedPlusFees.PsetBase64
+	if opts.LockTime > 0 {
+		psetBase64, err = setLockTime(psetBase64, opts.LockTime, existingInputs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set locktime: %s", err)
+		}
+	}
+
+	// make sure the transaction doesn't leak any value before signing it
+	_, outputBlindingData, err := wallet.ExtractBlindingDataFromTx(
+		psetBase64, nil, outputBlindingKeys,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unblind outputs: %s", err)
+	}
+	balanced, err := wallet.VerifyPsetBalance(
+		psetBase64, inputBlindingData, outputBlindingData,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify balance: %s", err)
+	}
+	if !balanced {
+		return nil, ErrPsetNotBalanced
+	}
+
 	// get the derivation paths of the selected inputs
 	allInfo := append(opts.MarketInfo, opts.FeeInfo...)
 	selectedInfo := getSelectedInfo(allInfo, selectedUnspents)
 
-	signedPsetBase64 := blindedPlusFees.PsetBase64
-	for i := 0; i < inputsToSign; i++ {
-		inIndex := existingInputs + i
-		signedPsetBase64, err = w.SignInput(wallet.SignInputOpts{
-			PsetBase64:     signedPsetBase64,
-			InIndex:        uint32(inIndex),
-			DerivationPath: selectedInfo[i].DerivationPath,
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to sign input %d tx: %s", inIndex, err)
-		}
+	derivationPathByScript := make(map[string]string)
+	for _, info := range selectedInfo {
+		derivationPathByScript[info.Script] = info.DerivationPath
+	}
+	signer := newSigner(opts.Signer, w, derivationPathByScript)
+	signedPsetBase64, err := signer.Sign(psetBase64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign tx: %s", err)
 	}
 
 	// get blinding private keys for selected inputs
@@ -805,9 +1351,197 @@ func fillProposal(opts FillProposalOpts) (*FillProposalResult, error) {
 		SelectedUnspents:   selectedUnspents,
 		InputBlindingKeys:  inputBlindingKeys,
 		OutputBlindingKeys: outputBlindingKeys,
+		FeesPaidByMarket:   feesPaidByMarket,
 	}, nil
 }
 
+// previewProposal is the non-committing variant of fillProposal. It builds the
+// same signed PSET and returns the same selected unspents and blinding keys,
+// but it leaves the given swap request untouched, whereas fillProposal adds
+// the daemon's blinding keys to its maps.
+// Like fillProposal, it neither locks the selected unspents nor persists
+// anything, hence the result must be used only for inspection: the selected
+// unspents might be spent by another trade at any time. For the same reason,
+// the PSET is always signed internally, never reaching any external signer.
+func previewProposal(opts FillProposalOpts) (*FillProposalResult, error) {
+	opts.Signer = nil
+	opts.SwapRequest = newSwapRequestCopy(opts.SwapRequest)
+	return fillProposal(opts)
+}
+
+// swapRequestCopy is a domain.SwapRequest with its own copy of the blinding
+// key maps of the wrapped one.
+type swapRequestCopy struct {
+	domain.SwapRequest
+	inBlindingKeys  map[string][]byte
+	outBlindingKeys map[string][]byte
+}
+
+func newSwapRequestCopy(req domain.SwapRequest) swapRequestCopy {
+	return swapRequestCopy{
+		SwapRequest:     req,
+		inBlindingKeys:  copyBlindingKeys(req.GetInputBlindingKey()),
+		outBlindingKeys: copyBlindingKeys(req.GetOutputBlindingKey()),
+	}
+}
+
+func (s swapRequestCopy) GetInputBlindingKey() map[string][]byte {
+	return s.inBlindingKeys
+}
+
+func (s swapRequestCopy) GetOutputBlindingKey() map[string][]byte {
+	return s.outBlindingKeys
+}
+
+func copyBlindingKeys(keys map[string][]byte) map[string][]byte {
+	keysCopy := make(map[string][]byte, len(keys))
+	for script, key := range keys {
+		keysCopy[script] = key
+	}
+	return keysCopy
+}
+
+// insufficientFundsError returns the error for a swap whose amount isn't
+// covered by the selectable unspents of the market. These exclude those locked
+// by pending trades, not deep enough in the chain or excluded from coin
+// selection, therefore the whole confirmed balance of the market might still
+// be enough for the swap, in which case the operator is also warned about how
+// to make it available again. A balance that exactly matches the amount is
+// enough, since the swap doesn't require any change.
+func (t *tradeService) insufficientFundsError(
+	ctx context.Context,
+	mkt *domain.Market,
+	marketInfo domain.AddressesInfo,
+	asset string,
+	amount uint64,
+) error {
+	balance, err := t.repoManager.UnspentRepository().GetBalance(
+		ctx, marketInfo.Addresses(), asset,
+	)
+	if err != nil || balance < amount {
+		return ErrMarketInsufficientBalance
+	}
+
+	log.Warnf(
+		"market with quote asset %s has a balance of %d for asset %s, but its "+
+			"selectable utxos can't cover a swap of %d: wait for pending trades "+
+			"to settle or expire, include excluded utxos, or consolidate the "+
+			"market funds if fragmented in many small utxos",
+		mkt.QuoteAsset, balance, asset, amount,
+	)
+	return ErrMarketUtxosNotSelectable
+}
+
+// addFeeInputs adds to the given pset the inputs for paying the network fees,
+// selected from the given unspents, and the eventual L-BTC change output.
+func addFeeInputs(
+	w *wallet.Wallet,
+	psetBase64 string,
+	unspents []explorer.Utxo,
+	changeInfo domain.AddressInfo,
+	network *network.Network,
+	coinSelector wallet.CoinSelector,
+	dustThreshold uint64,
+) (*wallet.UpdateTxResult, error) {
+	return w.UpdateTx(wallet.UpdateTxOpts{
+		PsetBase64:        psetBase64,
+		Unspents:          unspents,
+		MilliSatsPerBytes: domain.MinMilliSatPerByte,
+		Network:           network,
+		ChangePathsByAsset: map[string]string{
+			network.AssetID: changeInfo.DerivationPath,
+		},
+		WantPrivateBlindKeys: true,
+		WantChangeForFees:    true,
+		CoinSelector:         coinSelector,
+		DustThreshold:        dustThreshold,
+	})
+}
+
+// canMarketPayFees returns whether a market with the given base asset can
+// pay for the network fees of a trade where it receives the given asset, that
+// is if it receives the L-BTC it's selling its quote asset for.
+func canMarketPayFees(
+	marketBaseAsset, receivedAsset string, network *network.Network,
+) bool {
+	return marketBaseAsset == network.AssetID && receivedAsset == network.AssetID
+}
+
+// payFeesWithSwapOutput subtracts the network fees of the given swap
+// transaction from the L-BTC output of the market, identified by the given
+// info. The fees are refused if the output would be left with less than the
+// dust threshold.
+func payFeesWithSwapOutput(
+	psetBase64 string,
+	outputInfo domain.AddressInfo,
+	dustThreshold uint64,
+) (*wallet.UpdateTxResult, error) {
+	feeAmount, err := wallet.EstimateFeeAmount(
+		psetBase64, domain.MinMilliSatPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ptx, err := pset.NewPsetFromBase64(psetBase64)
+	if err != nil {
+		return nil, err
+	}
+	for _, out := range ptx.UnsignedTx.Outputs {
+		if hex.EncodeToString(out.Script) != outputInfo.Script {
+			continue
+		}
+
+		amount := bufferutil.ValueFromBytes(out.Value)
+		if amount <= feeAmount || amount-feeAmount < dustThreshold {
+			return nil, ErrFeeTopUpTooLow
+		}
+		out.Value, _ = bufferutil.ValueToBytes(amount - feeAmount)
+
+		psetBase64, err := ptx.ToBase64()
+		if err != nil {
+			return nil, err
+		}
+		return &wallet.UpdateTxResult{
+			PsetBase64:                psetBase64,
+			ChangeOutputsBlindingKeys: map[string][]byte{},
+			FeeAmount:                 feeAmount,
+		}, nil
+	}
+	return nil, ErrFeeTopUpNotSupported
+}
+
+// logFeesPaidByMarket reports the amount of L-BTC bought by the market with a
+// trade to pay for its network fees, along with its value in quote asset at
+// the current price of the market.
+func logFeesPaidByMarket(
+	tradeID string,
+	market *domain.Market,
+	strategy PricingStrategy,
+	unspents []domain.Unspent,
+	fees uint64,
+) {
+	balances := getBalanceByAsset(unspents)
+	price, err := strategy.SpotPrice(
+		balances[market.BaseAsset],
+		balances[market.QuoteAsset],
+	)
+	if err != nil {
+		log.Infof(
+			"network fees of %d sats of trade with id %s paid by market %s",
+			fees, tradeID, market.QuoteAsset,
+		)
+		return
+	}
+
+	quoteAmount := decimal.NewFromInt(int64(fees)).Mul(price.QuotePrice).Floor()
+	log.Infof(
+		"network fees of %d sats of trade with id %s paid by market %s, "+
+			"worth %s sats of quote asset",
+		fees, tradeID, market.QuoteAsset, quoteAmount,
+	)
+}
+
 func getSelectedInfo(allInfo domain.AddressesInfo, utxos []explorer.Utxo) domain.AddressesInfo {
 	contains := func(script string) *domain.AddressInfo {
 		for _, info := range allInfo {
@@ -857,37 +1591,48 @@ type preview struct {
 }
 
 // previewForMarket returns the current price and balances of a market, along
-// with a preview amount for a BUY or SELL trade based on the strategy type.
+// with a preview amount for a BUY or SELL trade based on the given strategy
+// and balances by asset.
 func previewForMarket(
-	unspents []domain.Unspent,
+	balances map[string]uint64,
 	market *domain.Market,
+	strategy PricingStrategy,
 	tradeType int,
 	amount uint64,
 	asset string,
 ) (*preview, error) {
-	balances := getBalanceByAsset(unspents)
 	marketBalance := Balance{
 		BaseAmount:  balances[market.BaseAsset],
 		QuoteAmount: balances[market.QuoteAsset],
 	}
 
 	if tradeType == TradeBuy {
-		if asset == market.BaseAsset && amount >= uint64(marketBalance.BaseAmount) {
+		if asset == market.BaseAsset && amount > uint64(marketBalance.BaseAmount) {
 			return nil, errors.New("provided amount is too big")
 		}
 	} else {
-		if asset == market.QuoteAsset && amount >= uint64(marketBalance.QuoteAmount) {
+		if asset == market.QuoteAsset && amount > uint64(marketBalance.QuoteAmount) {
 			return nil, errors.New("provided amount is too big")
 		}
 	}
 
-	if market.IsStrategyPluggable() {
-		return previewFromPrice(market, marketBalance, tradeType, amount, asset), nil
+	// AMM formulas account for the slippage caused by the trade on the
+	// reserves, while any other strategy trades at spot price.
+	if s, ok := strategy.(formulaStrategy); ok {
+		return previewFromFormula(
+			market, s, marketBalance, tradeType, amount, asset,
+		)
 	}
 
-	return previewFromFormula(
-		market, marketBalance, tradeType, amount, asset,
+	price, err := strategy.SpotPrice(
+		marketBalance.BaseAmount, marketBalance.QuoteAmount,
 	)
+	if err != nil {
+		return nil, err
+	}
+	return previewFromPrice(
+		market, price, marketBalance, tradeType, amount, asset,
+	), nil
 }
 
 func getBalanceByAsset(unspents []domain.Unspent) map[string]uint64 {
@@ -903,6 +1648,7 @@ func getBalanceByAsset(unspents []domain.Unspent) map[string]uint64 {
 
 func previewFromPrice(
 	market *domain.Market,
+	marketPrice Price,
 	marketBalance Balance,
 	tradeType int,
 	amount uint64,
@@ -912,29 +1658,27 @@ func previewFromPrice(
 	if asset == market.BaseAsset {
 		previewAsset = market.QuoteAsset
 	}
-	previewAmount := previewAmountFromPrice(market, tradeType, amount, asset)
-
-	price := Price{
-		BasePrice:  market.BaseAssetPrice(),
-		QuotePrice: market.QuoteAssetPrice(),
-	}
+	previewAmount := previewAmountFromPrice(
+		market, marketPrice, tradeType, amount, asset,
+	)
 
-	return &preview{price, previewAmount, previewAsset, marketBalance}
+	return &preview{marketPrice, previewAmount, previewAsset, marketBalance}
 }
 
 func previewAmountFromPrice(
 	market *domain.Market,
+	marketPrice Price,
 	tradeType int,
 	amount uint64,
 	asset string,
 ) uint64 {
-	price := market.QuoteAssetPrice()
+	price := marketPrice.QuotePrice
 	if asset != market.BaseAsset {
-		price = market.BaseAssetPrice()
+		price = marketPrice.BasePrice
 	}
 
 	isBaseAsset := asset == market.BaseAsset
-	feePercentage := uint64(market.Fee)
+	feePercentage := uint64(market.ActiveFee())
 	var previewAmount uint64
 
 	if tradeType == TradeBuy {
@@ -955,32 +1699,48 @@ func previewAmountFromPrice(
 }
 
 // previewAmountP calculate the amountP due for the given amountR and charges
-// (adds) the fees.
+// (adds) the fees. The percentage fee is skipped for markets that charge only
+// the fixed one.
 func previewAmountP(amountR uint64, price decimal.Decimal, feePercentage, fixedFee uint64) uint64 {
 	amountP := decimal.NewFromInt(int64(amountR)).Mul(price).BigInt().Uint64()
-	amountP, _ = mathutil.PlusFee(amountP, feePercentage)
+	if feePercentage > 0 {
+		amountP, _ = mathutil.PlusFee(amountP, feePercentage)
+	}
 	return amountP + fixedFee
 }
 
 // previewAmountR calculate the amountR due for the given amountP and charges
-// (subtracts) the fees.
+// (subtracts) the fees. The percentage fee is skipped for markets that charge
+// only the fixed one.
 func previewAmountR(amountP uint64, price decimal.Decimal, feePercentage, fixedFee uint64) uint64 {
 	amountR := decimal.NewFromInt(int64(amountP)).Mul(price).BigInt().Uint64()
-	amountR, _ = mathutil.LessFee(amountR, feePercentage)
-	return amountR - fixedFee
+	if feePercentage > 0 {
+		amountR, _ = mathutil.LessFee(amountR, feePercentage)
+	}
+	return lessFixedFee(amountR, fixedFee)
+}
+
+// lessFixedFee subtracts the fixed fee from the given amount, without
+// underflowing for amounts that don't even cover the fee.
+func lessFixedFee(amount, fixedFee uint64) uint64 {
+	if amount < fixedFee {
+		return 0
+	}
+	return amount - fixedFee
 }
 
 func previewFromFormula(
 	market *domain.Market,
+	strategy formulaStrategy,
 	marketBalance Balance,
 	tradeType int,
 	amount uint64,
 	asset string,
 ) (*preview, error) {
-	formula := market.Strategy.Formula()
+	formula := strategy.formula
 	baseAssetBalance := uint64(marketBalance.BaseAmount)
 	quoteAssetBalance := uint64(marketBalance.QuoteAmount)
-	fee := uint64(market.Fee)
+	fee := uint64(market.ActiveFee())
 	var previewAmount uint64
 	var err error
 	if tradeType == TradeBuy {
@@ -1036,11 +1796,11 @@ func previewFromFormula(
 		if asset == market.BaseAsset {
 			previewAmount += uint64(market.FixedFee.QuoteFee)
 		} else {
-			previewAmount -= uint64(market.FixedFee.BaseFee)
+			previewAmount = lessFixedFee(previewAmount, uint64(market.FixedFee.BaseFee))
 		}
 	} else {
 		if asset == market.BaseAsset {
-			previewAmount -= uint64(market.FixedFee.QuoteFee)
+			previewAmount = lessFixedFee(previewAmount, uint64(market.FixedFee.QuoteFee))
 		} else {
 			previewAmount += uint64(market.FixedFee.BaseFee)
 		}
@@ -1063,9 +1823,9 @@ func previewFromFormula(
 	// we can ignore errors because if the above function calls do not return
 	// any, we can assume the following do the same because they all perform the
 	// same checks.
-	price, _ := priceFromBalances(formula, baseAssetBalance, quoteAssetBalance)
+	price, _ := strategy.SpotPrice(baseAssetBalance, quoteAssetBalance)
 
-	return &preview{*price, previewAmount, previewAsset, marketBalance}, nil
+	return &preview{price, previewAmount, previewAsset, marketBalance}, nil
 }
 
 func priceFromBalances(
@@ -1100,13 +1860,17 @@ func priceFromBalances(
 // Since the price is variable in time, the predicted amounts are not compared
 // against those of the swap, but rather they are used to create a range in
 // which the swap amounts must be included to be considered valid.
+// If valid, it also returns the asset the market fee is charged on, that of
+// the amount previewed, since the fee is always added to or subtracted from
+// the amount the market computes from the one given by the trader.
 func isValidTradePrice(
 	swapRequest domain.SwapRequest,
 	tradeType int,
 	market *domain.Market,
+	strategy PricingStrategy,
 	unspents []domain.Unspent,
 	slippage decimal.Decimal,
-) bool {
+) (string, bool) {
 	// TODO: parallelize the 2 ways of calculating and validating the preview
 	// amount to speed up the process.
 	amount := swapRequest.GetAmountR()
@@ -1114,16 +1878,21 @@ func isValidTradePrice(
 		amount = swapRequest.GetAmountP()
 	}
 
-	preview, _ := previewForMarket(
-		unspents,
+	balances := getBalanceByAsset(unspents)
+	preview, err := previewForMarket(
+		balances,
 		market,
+		strategy,
 		tradeType,
 		amount,
 		market.BaseAsset,
 	)
+	if err != nil {
+		return "", false
+	}
 
 	if isPriceInRange(swapRequest, tradeType, preview.amount, true, slippage) {
-		return true
+		return market.QuoteAsset, true
 	}
 
 	amount = swapRequest.GetAmountP()
@@ -1131,15 +1900,22 @@ func isValidTradePrice(
 		amount = swapRequest.GetAmountR()
 	}
 
-	preview, _ = previewForMarket(
-		unspents,
+	preview, err = previewForMarket(
+		balances,
 		market,
+		strategy,
 		tradeType,
 		amount,
 		market.QuoteAsset,
 	)
+	if err != nil {
+		return "", false
+	}
 
-	return isPriceInRange(swapRequest, tradeType, preview.amount, false, slippage)
+	if !isPriceInRange(swapRequest, tradeType, preview.amount, false, slippage) {
+		return "", false
+	}
+	return market.BaseAsset, true
 }
 
 func isPriceInRange(
@@ -1166,3 +1942,55 @@ func isPriceInRange(
 
 	return amountToCheck.GreaterThanOrEqual(lowerBound) && amountToCheck.LessThanOrEqual(upperBound)
 }
+
+// validateSlippage checks that the price implied by the amounts of the swap
+// request, expressed in quote asset for 1 unit of base asset, doesn't deviate
+// from the current spot price of the market by more than the given basis
+// points. The check is skipped if maxSlippage is 0.
+func validateSlippage(
+	swapRequest domain.SwapRequest,
+	market *domain.Market,
+	strategy PricingStrategy,
+	unspents []domain.Unspent,
+	maxSlippage uint64,
+) error {
+	if maxSlippage == 0 {
+		return nil
+	}
+
+	balances := getBalanceByAsset(unspents)
+	spotPrice, err := strategy.SpotPrice(
+		balances[market.BaseAsset], balances[market.QuoteAsset],
+	)
+	if err != nil {
+		return fmt.Errorf("unable to get market spot price: %w", err)
+	}
+	if !spotPrice.QuotePrice.IsPositive() {
+		return errors.New("market spot price must be a positive number")
+	}
+
+	baseAmount, quoteAmount := swapRequest.GetAmountP(), swapRequest.GetAmountR()
+	if swapRequest.GetAssetP() != market.BaseAsset {
+		baseAmount, quoteAmount = quoteAmount, baseAmount
+	}
+	if baseAmount == 0 {
+		return errors.New("swap request base amount must not be zero")
+	}
+
+	effectivePrice := decimal.NewFromInt(int64(quoteAmount)).
+		Div(decimal.NewFromInt(int64(baseAmount)))
+	deviation := effectivePrice.Sub(spotPrice.QuotePrice).Abs().
+		Div(spotPrice.QuotePrice).
+		Mul(decimal.NewFromInt(10000))
+	if deviation.GreaterThan(decimal.NewFromInt(int64(maxSlippage))) {
+		return fmt.Errorf(
+			"price %s deviates from spot price %s by %s basis points, more "+
+				"than the allowed %d",
+			effectivePrice.StringFixed(8),
+			spotPrice.QuotePrice.StringFixed(8),
+			deviation.StringFixed(0),
+			maxSlippage,
+		)
+	}
+	return nil
+}
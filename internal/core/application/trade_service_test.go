@@ -1,21 +1,37 @@
 package application_test
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/tdex-network/tdex-daemon/internal/core/application"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/tdex-network/tdex-daemon/pkg/bufferutil"
+	"github.com/tdex-network/tdex-daemon/pkg/crawler"
 	"github.com/tdex-network/tdex-daemon/pkg/explorer"
 	"github.com/tdex-network/tdex-daemon/pkg/explorer/esplora"
+	pkgswap "github.com/tdex-network/tdex-daemon/pkg/swap"
 	"github.com/tdex-network/tdex-daemon/pkg/trade"
+	"github.com/tdex-network/tdex-daemon/pkg/wallet"
+	"github.com/tdex-network/tdex-daemon/pkg/webhook"
 	pbswap "github.com/tdex-network/tdex-protobuf/generated/go/swap"
+	"github.com/vulpemventures/go-elements/pset"
+	"github.com/vulpemventures/go-elements/transaction"
 )
 
 var (
@@ -74,7 +90,7 @@ var (
 
 func TestMarketTrading(t *testing.T) {
 	t.Run("without fixed fees", func(t *testing.T) {
-		tradeSvc, err := newTradeService(false)
+		tradeSvc, err := newTradeService(marketFee, false)
 		require.NoError(t, err)
 
 		markets, err := tradeSvc.GetTradableMarkets(ctx)
@@ -107,7 +123,7 @@ func TestMarketTrading(t *testing.T) {
 	})
 
 	t.Run("with fixed fees", func(t *testing.T) {
-		tradeSvc, err := newTradeService(true)
+		tradeSvc, err := newTradeService(marketFee, true)
 		require.NoError(t, err)
 
 		markets, err := tradeSvc.GetTradableMarkets(ctx)
@@ -138,16 +154,2676 @@ func TestMarketTrading(t *testing.T) {
 			marketOrder(t, tradeSvc, market, application.TradeSell, 900.0, marketQuoteAsset)
 		})
 	})
+
+	t.Run("with fixed fees only", func(t *testing.T) {
+		tradeSvc, err := newTradeService(0, true)
+		require.NoError(t, err)
+
+		markets, err := tradeSvc.GetTradableMarkets(ctx)
+		require.NoError(t, err)
+		require.NotEmpty(t, markets)
+		require.Zero(t, markets[0].Fee.BasisPoint)
+
+		market := markets[0].Market
+		t.Run("buy LBTC fixed LBTC", func(t *testing.T) {
+			t.Parallel()
+			marketOrder(t, tradeSvc, market, application.TradeBuy, 0.1, marketBaseAsset)
+		})
+		t.Run("sell LBTC fixed USDT", func(t *testing.T) {
+			t.Parallel()
+			marketOrder(t, tradeSvc, market, application.TradeSell, 900.0, marketQuoteAsset)
+		})
+	})
+
+	t.Run("without fees", func(t *testing.T) {
+		tradeSvc, err := newTradeService(0, false)
+		require.NoError(t, err)
+
+		markets, err := tradeSvc.GetTradableMarkets(ctx)
+		require.NoError(t, err)
+		require.NotEmpty(t, markets)
+
+		market := markets[0].Market
+		t.Run("buy LBTC fixed USDT", func(t *testing.T) {
+			t.Parallel()
+			marketOrder(t, tradeSvc, market, application.TradeBuy, 900.0, marketQuoteAsset)
+		})
+		t.Run("sell LBTC fixed LBTC", func(t *testing.T) {
+			t.Parallel()
+			marketOrder(t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset)
+		})
+	})
+}
+
+func TestPreviewTrade(t *testing.T) {
+	tradeSvc, err := newTradeService(marketFee, true)
+	require.NoError(t, err)
+
+	markets, err := tradeSvc.GetTradableMarkets(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, markets)
+
+	market := markets[0].Market
+	balanceBefore, err := tradeSvc.GetMarketBalance(ctx, market)
+	require.NoError(t, err)
+
+	amount := uint64(0.1 * math.Pow10(8))
+	for i := 0; i < 3; i++ {
+		preview, err := tradeSvc.PreviewTrade(
+			ctx, market, application.TradeBuy, amount, marketBaseAsset,
+		)
+		require.NoError(t, err)
+		require.NotNil(t, preview)
+		require.Equal(t, marketQuoteAsset, preview.Asset)
+		require.Greater(t, preview.Amount, uint64(0))
+		require.Equal(t, balanceBefore.Balance, preview.Balance)
+	}
+
+	balanceAfter, err := tradeSvc.GetMarketBalance(ctx, market)
+	require.NoError(t, err)
+	require.Equal(t, balanceBefore.Balance, balanceAfter.Balance)
+}
+
+func TestPreviewTradeWithPeerFee(t *testing.T) {
+	tradeSvc, err := newTradeService(marketFee, false)
+	require.NoError(t, err)
+
+	markets, err := tradeSvc.GetTradableMarkets(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, markets)
+
+	market := markets[0].Market
+	peerFee := int64(marketFee / 2)
+	tradeSvc.SetPeerFees(map[string]int64{"alice": peerFee})
+
+	amount := uint64(0.1 * math.Pow10(8))
+	preview, err := tradeSvc.PreviewTrade(
+		ctx, market, application.TradeBuy, amount, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(marketFee), preview.Fee.BasisPoint)
+
+	unknownPeerPreview, err := tradeSvc.PreviewTrade(
+		application.WithAuthenticatedPeer(ctx, "bob"),
+		market, application.TradeBuy, amount, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.Equal(t, preview, unknownPeerPreview)
+
+	// the network address of a peer doesn't identify it for the fees.
+	anonymousPreview, err := tradeSvc.PreviewTrade(
+		application.WithPeer(ctx, "alice"),
+		market, application.TradeBuy, amount, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.Equal(t, preview, anonymousPreview)
+
+	peerPreview, err := tradeSvc.PreviewTrade(
+		application.WithAuthenticatedPeer(
+			application.WithPeer(ctx, "10.0.0.5"), "alice",
+		),
+		market, application.TradeBuy, amount, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.Equal(t, peerFee, peerPreview.Fee.BasisPoint)
+	require.Equal(t, preview.Price, peerPreview.Price)
+	require.NotEqual(t, preview.Amount, peerPreview.Amount)
+}
+
+func TestPreviewTradeWithFeeTiers(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+	peerVolumes := application.NewPeerVolumeCache()
+
+	mkt, accountIndex, err := repoManager.MarketRepository().GetMarketByAsset(
+		ctx, marketQuoteAsset,
+	)
+	require.NoError(t, err)
+	tierFee := int64(marketFee / 4)
+	err = repoManager.MarketRepository().UpdateMarket(
+		ctx,
+		accountIndex,
+		func(m *domain.Market) (*domain.Market, error) {
+			m.FeeTiers = []domain.FeeTier{{MinVolume: 1, BasisPoint: tierFee}}
+			return m, nil
+		},
+	)
+	require.NoError(t, err)
+
+	// the mocked swap request of the trade always exchanges a positive amount
+	// of base asset.
+	settledTrade := domain.Trade{
+		ID:               uuid.New(),
+		MarketQuoteAsset: marketQuoteAsset,
+		Status:           domain.SettledStatus,
+		Peer:             "alice",
+		SwapRequest:      domain.Swap{ID: randomId(), Message: randomBytes(32)},
+	}
+	_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &settledTrade.ID)
+	require.NoError(t, err)
+	err = repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&settledTrade.ID,
+		func(_ *domain.Trade) (*domain.Trade, error) { return &settledTrade, nil },
+	)
+	require.NoError(t, err)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{peerVolumes: peerVolumes},
+	)
+	market := application.Market{
+		BaseAsset:  mkt.BaseAsset,
+		QuoteAsset: mkt.QuoteAsset,
+	}
+	amount := uint64(0.1 * math.Pow10(8))
+
+	newPeerPreview, err := tradeSvc.PreviewTrade(
+		application.WithAuthenticatedPeer(ctx, "bob"),
+		market, application.TradeBuy, amount, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(marketFee), newPeerPreview.Fee.BasisPoint)
+
+	// the network address of a peer doesn't identify it for the tiers.
+	anonymousPreview, err := tradeSvc.PreviewTrade(
+		application.WithPeer(ctx, "alice"),
+		market, application.TradeBuy, amount, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(marketFee), anonymousPreview.Fee.BasisPoint)
+
+	peerCtx := application.WithAuthenticatedPeer(
+		application.WithPeer(ctx, "10.0.0.5"), "alice",
+	)
+	tierPreview, err := tradeSvc.PreviewTrade(
+		peerCtx, market, application.TradeBuy, amount, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.Equal(t, tierFee, tierPreview.Fee.BasisPoint)
+
+	// fees designated for a peer take precedence over the tiers.
+	peerFee := int64(marketFee / 2)
+	tradeSvc.SetPeerFees(map[string]int64{"alice": peerFee})
+	peerPreview, err := tradeSvc.PreviewTrade(
+		peerCtx, market, application.TradeBuy, amount, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.Equal(t, peerFee, peerPreview.Fee.BasisPoint)
+}
+
+func TestFeeTierAfterTradeSettlement(t *testing.T) {
+	repoManager, explorerSvc, _, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	// the cache of the peer volumes is shared by the listener, that updates
+	// it as trades settle, and the service.
+	peerVolumes := application.NewPeerVolumeCache()
+	bcListener := application.NewBlockchainListener(
+		crawler.NewService(crawler.Opts{
+			ExplorerSvc:        explorerSvc,
+			ExplorerLimit:      10,
+			ExplorerTokenBurst: 1,
+			CrawlerInterval:    1000,
+		}),
+		repoManager,
+		nil,
+		nil,
+		peerVolumes,
+		marketBaseAsset,
+		regtest,
+	)
+	t.Cleanup(bcListener.StopObservation)
+
+	mkt, accountIndex, err := repoManager.MarketRepository().GetMarketByAsset(
+		ctx, marketQuoteAsset,
+	)
+	require.NoError(t, err)
+	tierFee := int64(marketFee / 4)
+	err = repoManager.MarketRepository().UpdateMarket(
+		ctx,
+		accountIndex,
+		func(m *domain.Market) (*domain.Market, error) {
+			m.FeeTiers = []domain.FeeTier{{MinVolume: 1, BasisPoint: tierFee}}
+			return m, nil
+		},
+	)
+	require.NoError(t, err)
+
+	completedTrade := domain.Trade{
+		ID:               uuid.New(),
+		MarketQuoteAsset: marketQuoteAsset,
+		Status:           domain.CompletedStatus,
+		Peer:             "alice",
+		TxID:             randomHex(32),
+		SwapRequest:      domain.Swap{ID: randomId(), Message: randomBytes(32)},
+	}
+	_, err = repoManager.TradeRepository().GetOrCreateTrade(
+		ctx, &completedTrade.ID,
+	)
+	require.NoError(t, err)
+	err = repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&completedTrade.ID,
+		func(_ *domain.Trade) (*domain.Trade, error) { return &completedTrade, nil },
+	)
+	require.NoError(t, err)
+
+	mockedExplorer := explorerSvc.(*mockExplorer)
+	mockedExplorer.On("GetTransactionStatus", completedTrade.TxID).Return(
+		map[string]interface{}{
+			"confirmed":    true,
+			"block_hash":   randomHex(32),
+			"block_height": float64(100),
+			"block_time":   float64(time.Now().Unix()),
+		}, nil,
+	)
+	mockedExplorer.On("GetTransactionHex", completedTrade.TxID).Return("", nil)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{peerVolumes: peerVolumes},
+	)
+	market := application.Market{
+		BaseAsset:  mkt.BaseAsset,
+		QuoteAsset: mkt.QuoteAsset,
+	}
+	amount := uint64(0.1 * math.Pow10(8))
+	previewFee := func() int64 {
+		preview, err := tradeSvc.PreviewTrade(
+			application.WithAuthenticatedPeer(ctx, completedTrade.Peer),
+			market, application.TradeBuy, amount, marketBaseAsset,
+		)
+		require.NoError(t, err)
+		return preview.Fee.BasisPoint
+	}
+
+	// the volume of the peer is counted once its trade settles.
+	require.Equal(t, int64(marketFee), previewFee())
+
+	bcListener.StartObservation()
+	bcListener.StartObserveTx(completedTrade.TxID)
+
+	require.Eventually(t, func() bool {
+		return previewFee() == tierFee
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestFeeTierAfterTradeReorg(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	mkt, accountIndex, err := repoManager.MarketRepository().GetMarketByAsset(
+		ctx, marketQuoteAsset,
+	)
+	require.NoError(t, err)
+	tierFee := int64(marketFee / 4)
+	err = repoManager.MarketRepository().UpdateMarket(
+		ctx,
+		accountIndex,
+		func(m *domain.Market) (*domain.Market, error) {
+			m.FeeTiers = []domain.FeeTier{{MinVolume: 1, BasisPoint: tierFee}}
+			return m, nil
+		},
+	)
+	require.NoError(t, err)
+
+	// the tx of the settled trade is confirmed in a block that is going to be
+	// reorged out of the best chain.
+	settledTrade := domain.Trade{
+		ID:               uuid.New(),
+		MarketQuoteAsset: marketQuoteAsset,
+		Status:           domain.SettledStatus,
+		Peer:             "alice",
+		TxID:             randomHex(32),
+		SwapRequest:      domain.Swap{ID: randomId(), Message: randomBytes(32)},
+	}
+	_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &settledTrade.ID)
+	require.NoError(t, err)
+	err = repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&settledTrade.ID,
+		func(_ *domain.Trade) (*domain.Trade, error) { return &settledTrade, nil },
+	)
+	require.NoError(t, err)
+	err = repoManager.UnspentRepository().AddUnspents(ctx, []domain.Unspent{
+		{
+			TxID:        settledTrade.TxID,
+			Value:       1000,
+			AssetHash:   marketBaseAsset,
+			Address:     "address",
+			Confirmed:   true,
+			BlockHeight: 100,
+			BlockHash:   "oldblock100",
+		},
+	})
+	require.NoError(t, err)
+
+	mockedExplorer := explorerSvc.(*mockExplorer)
+	mockedExplorer.ExpectedCalls = nil
+	mockedExplorer.On("GetBlockHeight").Return(105, nil)
+	mockedExplorer.On("GetBlockHash", uint64(100)).Return("block100", nil)
+	mockedExplorer.On("GetTransactionStatus", settledTrade.TxID).
+		Return(map[string]interface{}{"confirmed": false}, nil)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{peerVolumes: application.NewPeerVolumeCache()},
+	)
+	market := application.Market{
+		BaseAsset:  mkt.BaseAsset,
+		QuoteAsset: mkt.QuoteAsset,
+	}
+	amount := uint64(0.1 * math.Pow10(8))
+	previewFee := func() int64 {
+		preview, err := tradeSvc.PreviewTrade(
+			application.WithAuthenticatedPeer(ctx, settledTrade.Peer),
+			market, application.TradeBuy, amount, marketBaseAsset,
+		)
+		require.NoError(t, err)
+		return preview.Fee.BasisPoint
+	}
+
+	require.Equal(t, tierFee, previewFee())
+
+	// the volume of the reorged trade doesn't count anymore.
+	watcherCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	tradeSvc.StartReorgWatcher(watcherCtx, 10*time.Millisecond, 10)
+
+	require.Eventually(t, func() bool {
+		return previewFee() == int64(marketFee)
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestPreviewTradeWithPricePrecision(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	precision := uint(2)
+
+	err = operatorSvc.UpdateMarketPricePrecision(
+		ctx, application.MarketPricePrecision{Market: market, Precision: precision},
+	)
+	require.EqualError(t, err, domain.ErrMarketMustBeClosed.Error())
+
+	err = operatorSvc.CloseMarket(ctx, market.BaseAsset, market.QuoteAsset)
+	require.NoError(t, err)
+
+	err = operatorSvc.UpdateMarketPricePrecision(
+		ctx, application.MarketPricePrecision{
+			Market:    market,
+			Precision: domain.MaxPricePrecision + 1,
+		},
+	)
+	require.EqualError(t, err, domain.ErrInvalidPricePrecision.Error())
+
+	err = operatorSvc.UpdateMarketPricePrecision(
+		ctx, application.MarketPricePrecision{Market: market, Precision: precision},
+	)
+	require.NoError(t, err)
+
+	err = operatorSvc.OpenMarket(ctx, market.BaseAsset, market.QuoteAsset)
+	require.NoError(t, err)
+
+	markets, err := operatorSvc.ListMarket(ctx)
+	require.NoError(t, err)
+	require.Len(t, markets, 1)
+	require.Equal(t, precision, markets[0].PricePrecision)
+
+	amount := uint64(0.1 * math.Pow10(8))
+	preview, err := tradeSvc.PreviewTrade(
+		ctx, market, application.TradeBuy, amount, marketBaseAsset,
+	)
+	require.NoError(t, err)
+
+	basePrice, quotePrice := preview.Price.BasePrice, preview.Price.QuotePrice
+	require.True(t, basePrice.Equal(basePrice.RoundBank(int32(precision))))
+	require.True(t, quotePrice.Equal(quotePrice.RoundBank(int32(precision))))
+	require.Greater(t, preview.Amount, uint64(0))
+}
+
+func TestMarketTradingWithCustomStrategy(t *testing.T) {
+	tradeSvc, err := newTradeService(marketFee, false)
+	require.NoError(t, err)
+
+	markets, err := tradeSvc.GetTradableMarkets(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, markets)
+
+	market := markets[0].Market
+	strategy := fixedPriceStrategy{
+		application.Price{
+			BasePrice:  decimal.NewFromFloat(0.0001),
+			QuotePrice: decimal.NewFromInt(10000),
+		},
+	}
+	tradeSvc.SetMarketStrategy(market, strategy)
+
+	amount := uint64(0.1 * math.Pow10(8))
+	preview, err := tradeSvc.PreviewTrade(
+		ctx, market, application.TradeSell, amount, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.Equal(t, strategy.price, preview.Price)
+
+	t.Run("buy LBTC fixed LBTC", func(t *testing.T) {
+		marketOrder(t, tradeSvc, market, application.TradeBuy, 0.1, marketBaseAsset)
+	})
+	t.Run("sell LBTC fixed USDT", func(t *testing.T) {
+		marketOrder(t, tradeSvc, market, application.TradeSell, 900.0, marketQuoteAsset)
+	})
+
+	tradeSvc.SetMarketStrategy(market, nil)
+	preview, err = tradeSvc.PreviewTrade(
+		ctx, market, application.TradeSell, amount, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.NotEqual(t, strategy.price, preview.Price)
+}
+
+func TestSimulateStrategy(t *testing.T) {
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	marketWithFee := application.MarketWithFee{
+		Market: market,
+		Fee:    application.Fee{BasisPoint: 25},
+	}
+	strategy := fixedPriceStrategy{
+		application.Price{
+			BasePrice:  decimal.NewFromFloat(0.0001),
+			QuotePrice: decimal.NewFromInt(10000),
+		},
+	}
+	trades := []application.TradeInfo{
+		{
+			Status:        domain.SettledStatus,
+			MarketWithFee: marketWithFee,
+			SwapInfo: application.SwapInfo{
+				AssetP:  marketBaseAsset,
+				AmountP: 10000000,
+				AssetR:  marketQuoteAsset,
+				AmountR: 99000000000,
+			},
+			RequestTimeUnix: 2,
+		},
+		{
+			Status:        domain.CompletedStatus,
+			MarketWithFee: marketWithFee,
+			SwapInfo: application.SwapInfo{
+				AssetP:  marketQuoteAsset,
+				AmountP: 50000000000,
+				AssetR:  marketBaseAsset,
+				AmountR: 4900000,
+			},
+			RequestTimeUnix: 1,
+		},
+		{
+			Status:        domain.ExpiredStatus,
+			MarketWithFee: marketWithFee,
+			SwapInfo: application.SwapInfo{
+				AssetP:  marketBaseAsset,
+				AmountP: 10000000,
+				AssetR:  marketQuoteAsset,
+				AmountR: 99000000000,
+			},
+			RequestTimeUnix: 3,
+		},
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		res, err := application.SimulateStrategy(
+			strategy, trades, application.Balance{
+				BaseAmount:  100000000,
+				QuoteAmount: 1000000000000,
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, 2, res.NumOfTrades)
+
+		require.Equal(t, application.SimulationOutcome{
+			FinalBalance: application.Balance{
+				BaseAmount:  105012500,
+				QuoteAmount: 950250000000,
+			},
+			BaseDrift:  5012500,
+			QuoteDrift: -49750000000,
+			FeeRevenue: application.Balance{
+				BaseAmount:  25000,
+				QuoteAmount: 125000000,
+			},
+		}, res.Candidate)
+		require.Equal(t, application.SimulationOutcome{
+			FinalBalance: application.Balance{
+				BaseAmount:  105100000,
+				QuoteAmount: 951000000000,
+			},
+			BaseDrift:  5100000,
+			QuoteDrift: -49000000000,
+			FeeRevenue: application.Balance{
+				BaseAmount:  25000,
+				QuoteAmount: 125000000,
+			},
+		}, res.Actual)
+	})
+
+	t.Run("replay_with_rejections", func(t *testing.T) {
+		// the candidate strategy gives more base asset than the one actually
+		// used, therefore the market can't afford the first trade.
+		res, err := application.SimulateStrategy(
+			strategy, trades, application.Balance{
+				BaseAmount:  4900000,
+				QuoteAmount: 100000000000,
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Candidate.RejectedTrades)
+		require.Equal(t, uint64(0), res.Candidate.FeeRevenue.QuoteAmount)
+		require.Equal(t, 0, res.Actual.RejectedTrades)
+		require.Equal(t, uint64(10000000), res.Actual.FinalBalance.BaseAmount)
+	})
+
+	t.Run("replay_with_fee_on_amount_r", func(t *testing.T) {
+		// the trader fixed the amount to send, therefore the fee is subtracted
+		// from the quote amount computed by the market.
+		trade := trades[0]
+		trade.SwapInfo.AmountR = 99750000000
+		trade.SwapInfo.FeeAsset = marketQuoteAsset
+
+		res, err := application.SimulateStrategy(
+			strategy, []application.TradeInfo{trade}, application.Balance{
+				BaseAmount:  100000000,
+				QuoteAmount: 1000000000000,
+			},
+		)
+		require.NoError(t, err)
+
+		expectedFeeRevenue := application.Balance{QuoteAmount: 249375000}
+		require.Equal(t, expectedFeeRevenue, res.Actual.FeeRevenue)
+		require.Equal(t, expectedFeeRevenue, res.Candidate.FeeRevenue)
+		require.Equal(t, application.Balance{
+			BaseAmount:  110000000,
+			QuoteAmount: 900249375000,
+		}, res.Candidate.FinalBalance)
+	})
+
+	t.Run("failing", func(t *testing.T) {
+		_, err := application.SimulateStrategy(
+			strategy, trades, application.Balance{},
+		)
+		require.EqualError(t, err, application.ErrSimulationBalanceMismatch.Error())
+
+		otherMarketTrade := trades[0]
+		otherMarketTrade.MarketWithFee.QuoteAsset = randomHex(32)
+		_, err = application.SimulateStrategy(
+			strategy,
+			append([]application.TradeInfo{otherMarketTrade}, trades...),
+			application.Balance{
+				BaseAmount:  100000000,
+				QuoteAmount: 1000000000000,
+			},
+		)
+		require.EqualError(t, err, application.ErrMixedMarketTrades.Error())
+	})
+}
+
+func TestQuote(t *testing.T) {
+	tradeSvc, err := newTradeService(marketFee, false)
+	require.NoError(t, err)
+
+	markets, err := tradeSvc.GetTradableMarkets(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, markets)
+	market := markets[0].Market
+
+	tests := []struct {
+		name          string
+		giveAsset     string
+		giveAmount    uint64
+		tradeType     int
+		receivedAsset string
+	}{
+		{
+			name:          "give LBTC",
+			giveAsset:     marketBaseAsset,
+			giveAmount:    uint64(0.1 * math.Pow10(8)),
+			tradeType:     application.TradeSell,
+			receivedAsset: marketQuoteAsset,
+		},
+		{
+			name:          "give USDT",
+			giveAsset:     marketQuoteAsset,
+			giveAmount:    uint64(100 * math.Pow10(8)),
+			tradeType:     application.TradeBuy,
+			receivedAsset: marketBaseAsset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote, err := tradeSvc.Quote(ctx, market, tt.giveAsset, tt.giveAmount)
+			require.NoError(t, err)
+			require.Equal(t, tt.receivedAsset, quote.Asset)
+			require.Greater(t, quote.Amount, uint64(0))
+
+			preview, err := tradeSvc.PreviewTrade(
+				ctx, market, tt.tradeType, tt.giveAmount, tt.giveAsset,
+			)
+			require.NoError(t, err)
+			require.Equal(t, preview, quote)
+		})
+	}
+
+	_, err = tradeSvc.Quote(ctx, market, randomHex(32), 100)
+	require.EqualError(t, err, application.ErrAssetNotInMarket.Error())
+}
+
+func TestMarketImbalance(t *testing.T) {
+	tradeSvc, err := newTradeService(marketFee, false)
+	require.NoError(t, err)
+
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	// markets priced by reserves are balanced by definition
+	imbalance, err := tradeSvc.MarketImbalance(ctx, market)
+	require.NoError(t, err)
+	require.True(t, imbalance.BaseWeight.Sub(decimal.NewFromFloat(0.5)).Abs().
+		LessThan(decimal.NewFromFloat(0.000001)))
+	require.Zero(t, imbalance.Rebalance.SellAmount)
+
+	strategy := fixedPriceStrategy{
+		application.Price{
+			BasePrice:  decimal.NewFromFloat(0.00001),
+			QuotePrice: decimal.NewFromInt(100000),
+		},
+	}
+	tradeSvc.SetMarketStrategy(market, strategy)
+
+	imbalance, err = tradeSvc.MarketImbalance(ctx, market)
+	require.NoError(t, err)
+	require.Equal(t, strategy.price, imbalance.Price)
+	require.True(t, imbalance.Deviation.IsPositive())
+	require.Equal(t, marketBaseAsset, imbalance.Rebalance.SellAsset)
+	require.Equal(t, marketQuoteAsset, imbalance.Rebalance.BuyAsset)
+
+	// once rebalanced, the values of the two sides match at spot price
+	balance := imbalance.Balance
+	baseValue := decimal.NewFromInt(
+		int64(balance.BaseAmount - imbalance.Rebalance.SellAmount),
+	).Mul(strategy.price.QuotePrice)
+	quoteValue := decimal.NewFromInt(
+		int64(balance.QuoteAmount + imbalance.Rebalance.BuyAmount),
+	)
+	require.True(t, baseValue.Sub(quoteValue).Abs().
+		LessThanOrEqual(strategy.price.QuotePrice))
+
+	_, err = tradeSvc.MarketImbalance(ctx, application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: randomHex(32),
+	})
+	require.EqualError(t, err, application.ErrMarketNotExist.Error())
+
+	_, err = tradeSvc.MarketImbalance(ctx, application.Market{
+		BaseAsset:  randomHex(32),
+		QuoteAsset: marketQuoteAsset,
+	})
+	require.EqualError(t, err, domain.ErrMarketInvalidBaseAsset.Error())
+}
+
+func TestMarketTradingWithMaxSlippage(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	mockedTradeManager := newMockedTradeManager()
+	mockedTradeManager.
+		On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
+		Return(&application.FillProposalResult{
+			PsetBase64:       randomBase64(),
+			SelectedUnspents: randomSelection(unspents, mockedTradeManager.counter),
+		}, nil)
+	application.TradeManager = mockedTradeManager
+
+	maxSlippageBasisPoints := uint64(100)
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{maxSlippage: maxSlippageBasisPoints},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	strategy := fixedPriceStrategy{
+		application.Price{
+			BasePrice:  decimal.NewFromFloat(0.0001),
+			QuotePrice: decimal.NewFromInt(10000),
+		},
+	}
+	tradeSvc.SetMarketStrategy(market, strategy)
+
+	swapRequest := newSwapRequest(
+		t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset,
+	)
+
+	// the price moves by 3% before the request is processed, still within the
+	// price slippage but beyond the max one.
+	tradeSvc.SetMarketStrategy(market, fixedPriceStrategy{
+		application.Price{
+			BasePrice:  decimal.NewFromInt(1).Div(decimal.NewFromInt(10300)),
+			QuotePrice: decimal.NewFromInt(10300),
+		},
+	})
+	swapAccept, swapFail, _, err := tradeSvc.TradePropose(
+		ctx, market, application.TradeSell, swapRequest,
+	)
+	require.NoError(t, err)
+	require.Nil(t, swapAccept)
+	require.NotNil(t, swapFail)
+	domain.SwapParserManager.(*mockSwapParser).AssertCalled(
+		t,
+		"SerializeFail",
+		swapRequest.GetId(),
+		int(pkgswap.ErrCodeSlippageExceeded),
+		mock.Anything,
+	)
+
+	tradeSvc.SetMarketStrategy(market, strategy)
+	marketOrder(t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset)
+}
+
+func TestMarketTradingWithPriceBand(t *testing.T) {
+	tradeSvc, err := newTradeService(marketFee, false)
+	require.NoError(t, err)
+
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	maxDeviationBasisPoints := uint64(100)
+
+	// markets priced by reserves are bounded as well.
+	tradeSvc.SetPriceOracle(
+		fixedPriceOracle{decimal.NewFromInt(1)}, maxDeviationBasisPoints,
+	)
+	swapRequest := newSwapRequest(
+		t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset,
+	)
+	swapAccept, swapFail, _, err := tradeSvc.TradePropose(
+		ctx, market, application.TradeSell, swapRequest,
+	)
+	require.NoError(t, err)
+	require.Nil(t, swapAccept)
+	require.NotNil(t, swapFail)
+	domain.SwapParserManager.(*mockSwapParser).AssertCalled(
+		t,
+		"SerializeFail",
+		swapRequest.GetId(),
+		int(pkgswap.ErrCodePriceOutOfBand),
+		application.ErrPriceOutOfBand.Error(),
+	)
+
+	tradeSvc.SetMarketStrategy(market, fixedPriceStrategy{
+		application.Price{
+			BasePrice:  decimal.NewFromFloat(0.0001),
+			QuotePrice: decimal.NewFromInt(10000),
+		},
+	})
+
+	// the reference price is 5% higher than the market one.
+	tradeSvc.SetPriceOracle(
+		fixedPriceOracle{decimal.NewFromInt(10500)}, maxDeviationBasisPoints,
+	)
+	swapRequest = newSwapRequest(
+		t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset,
+	)
+	swapAccept, swapFail, _, err = tradeSvc.TradePropose(
+		ctx, market, application.TradeSell, swapRequest,
+	)
+	require.NoError(t, err)
+	require.Nil(t, swapAccept)
+	require.NotNil(t, swapFail)
+	domain.SwapParserManager.(*mockSwapParser).AssertCalled(
+		t,
+		"SerializeFail",
+		swapRequest.GetId(),
+		int(pkgswap.ErrCodePriceOutOfBand),
+		application.ErrPriceOutOfBand.Error(),
+	)
+
+	tradeSvc.SetPriceOracle(
+		fixedPriceOracle{decimal.NewFromInt(10050)}, maxDeviationBasisPoints,
+	)
+	marketOrder(t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset)
+
+	tradeSvc.SetPriceOracle(nil, 0)
+	marketOrder(t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset)
+}
+
+func TestValidateSwapRequest(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	unspentsBefore, err := repoManager.UnspentRepository().GetAvailableUnspents(ctx)
+	require.NoError(t, err)
+
+	swapRequest := newSwapRequest(
+		t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset,
+	)
+	failInfo, err := tradeSvc.ValidateSwapRequest(ctx, swapRequest)
+	require.NoError(t, err)
+	require.Nil(t, failInfo)
+
+	// the amount to receive is way more than the market would give.
+	badSwapRequest := newSwapRequest(
+		t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset,
+	)
+	badSwapRequest.(*pbswap.SwapRequest).AmountR *= 2
+	failInfo, err = tradeSvc.ValidateSwapRequest(ctx, badSwapRequest)
+	require.NoError(t, err)
+	require.NotNil(t, failInfo)
+	require.Equal(t, int(pkgswap.ErrCodeInvalidSwapRequest), failInfo.Code)
+
+	// the tx pays the trader less than the amount to receive of the request.
+	shortchangingSwapRequest := newSwapRequest(
+		t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset,
+	)
+	ptx, err := pset.NewPsetFromBase64(shortchangingSwapRequest.GetTransaction())
+	require.NoError(t, err)
+	for _, out := range ptx.UnsignedTx.Outputs {
+		if bufferutil.AssetHashFromBytes(out.Asset) ==
+			shortchangingSwapRequest.GetAssetR() {
+			out.Value, _ = bufferutil.ValueToBytes(
+				shortchangingSwapRequest.GetAmountR() - 1,
+			)
+		}
+	}
+	psetBase64, err := ptx.ToBase64()
+	require.NoError(t, err)
+	shortchangingSwapRequest.(*pbswap.SwapRequest).Transaction = psetBase64
+	failInfo, err = tradeSvc.ValidateSwapRequest(ctx, shortchangingSwapRequest)
+	require.NoError(t, err)
+	require.NotNil(t, failInfo)
+	require.Equal(t, int(pkgswap.ErrCodeInvalidTransaction), failInfo.Code)
+
+	err = operatorSvc.PauseMarket(ctx, market)
+	require.NoError(t, err)
+	failInfo, err = tradeSvc.ValidateSwapRequest(ctx, swapRequest)
+	require.NoError(t, err)
+	require.NotNil(t, failInfo)
+	require.Equal(t, int(pkgswap.ErrCodeMarketPaused), failInfo.Code)
+
+	// nothing is locked by the checks.
+	unspentsAfter, err := repoManager.UnspentRepository().GetAvailableUnspents(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(unspentsBefore), len(unspentsAfter))
+
+	t.Run("failing", func(t *testing.T) {
+		unknownMarketRequest := badSwapRequest
+		unknownMarketRequest.(*pbswap.SwapRequest).AssetR = randomHex(32)
+		_, err := tradeSvc.ValidateSwapRequest(ctx, unknownMarketRequest)
+		require.EqualError(t, err, application.ErrMarketNotExist.Error())
+
+		unknownMarketRequest.(*pbswap.SwapRequest).AssetP = randomHex(32)
+		_, err = tradeSvc.ValidateSwapRequest(ctx, unknownMarketRequest)
+		require.EqualError(t, err, application.ErrMarketNotExist.Error())
+	})
+}
+
+func TestMarketTradingWhilePaused(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	mockedTradeManager := newMockedTradeManager()
+	mockedTradeManager.
+		On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
+		Return(&application.FillProposalResult{
+			PsetBase64:       randomBase64(),
+			SelectedUnspents: randomSelection(unspents, mockedTradeManager.counter),
+		}, nil)
+	application.TradeManager = mockedTradeManager
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+	operatorSvc := buildOperatorService(
+		repoManager, explorerSvc, bcListener,
+		operatorServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	swapRequest := newSwapRequest(
+		t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset,
+	)
+
+	err = operatorSvc.PauseMarket(ctx, market)
+	require.NoError(t, err)
+	err = operatorSvc.PauseMarket(ctx, market)
+	require.EqualError(t, err, domain.ErrMarketIsPaused.Error())
+
+	markets, err := tradeSvc.GetTradableMarkets(ctx)
+	require.NoError(t, err)
+	require.Len(t, markets, 0)
+
+	_, err = tradeSvc.GetMarketPrice(
+		ctx, market, application.TradeSell, 10000000, marketBaseAsset,
+	)
+	require.EqualError(t, err, domain.ErrMarketIsPaused.Error())
+
+	swapAccept, swapFail, _, err := tradeSvc.TradePropose(
+		ctx, market, application.TradeSell, swapRequest,
+	)
+	require.NoError(t, err)
+	require.Nil(t, swapAccept)
+	require.NotNil(t, swapFail)
+	domain.SwapParserManager.(*mockSwapParser).AssertCalled(
+		t,
+		"SerializeFail",
+		swapRequest.GetId(),
+		int(pkgswap.ErrCodeMarketPaused),
+		mock.Anything,
+	)
+
+	err = operatorSvc.ResumeMarket(ctx, market)
+	require.NoError(t, err)
+	err = operatorSvc.ResumeMarket(ctx, market)
+	require.EqualError(t, err, domain.ErrMarketNotPaused.Error())
+
+	marketOrder(t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset)
+}
+
+func TestTradeProposeRateLimits(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	mockedTradeManager := newMockedTradeManager()
+	mockedTradeManager.
+		On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
+		Return(&application.FillProposalResult{
+			PsetBase64:       randomBase64(),
+			SelectedUnspents: randomSelection(unspents, mockedTradeManager.counter),
+		}, nil)
+	application.TradeManager = mockedTradeManager
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{
+			rateLimits: application.RateLimits{
+				ProposalsPerMarket: 2,
+				ProposalsPerPeer:   1,
+			},
+		},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	tests := []struct {
+		peer        string
+		rateLimited bool
+	}{
+		{peer: "10.0.0.1"},
+		{peer: "10.0.0.1", rateLimited: true},
+		{peer: "10.0.0.2"},
+		{peer: "10.0.0.3", rateLimited: true},
+	}
+
+	for _, tt := range tests {
+		swapRequest := newSwapRequest(
+			t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset,
+		)
+		swapAccept, swapFail, _, err := tradeSvc.TradePropose(
+			application.WithPeer(ctx, tt.peer),
+			market, application.TradeSell, swapRequest,
+		)
+		require.NoError(t, err)
+		if !tt.rateLimited {
+			require.NotNil(t, swapAccept)
+			continue
+		}
+		require.Nil(t, swapAccept)
+		require.NotNil(t, swapFail)
+		domain.SwapParserManager.(*mockSwapParser).AssertCalled(
+			t,
+			"SerializeFail",
+			swapRequest.GetId(),
+			int(pkgswap.ErrCodeRateLimited),
+			mock.Anything,
+		)
+	}
+
+	// rate limited proposals never get to select and lock any coin.
+	mockedTradeManager.AssertNumberOfCalls(t, "FillProposal", 2)
+
+	// the last peer is not charged for the proposal denied by the market limit.
+	state := tradeSvc.RateLimiterState()
+	require.Len(t, state, 4)
+	require.Equal(t, application.RateLimitScopeMarket, state[0].Scope)
+	require.Equal(t, marketQuoteAsset, state[0].Key)
+	require.Equal(t, 2, state[0].Capacity)
+	require.Less(t, state[0].Tokens, float64(1))
+	for _, s := range state[1:3] {
+		require.Equal(t, application.RateLimitScopePeer, s.Scope)
+		require.Less(t, s.Tokens, float64(1))
+	}
+	require.Equal(t, "10.0.0.3", state[3].Key)
+	require.Equal(t, float64(1), state[3].Tokens)
+}
+
+func TestConcurrentTradeProposals(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	mockedTradeManager := newMockedTradeManager()
+	mockedTradeManager.
+		On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
+		Return(&application.FillProposalResult{
+			PsetBase64:       randomBase64(),
+			SelectedUnspents: randomSelection(unspents, mockedTradeManager.counter),
+		}, nil)
+	application.TradeManager = mockedTradeManager
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	numOfProposals := 2
+	swapRequests := make([]domain.SwapRequest, 0, numOfProposals)
+	for i := 0; i < numOfProposals; i++ {
+		swapRequests = append(swapRequests, newSwapRequest(
+			t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset,
+		))
+	}
+
+	chErrs := make(chan error, numOfProposals)
+	wg := &sync.WaitGroup{}
+	wg.Add(numOfProposals)
+	for _, swapRequest := range swapRequests {
+		go func(swapRequest domain.SwapRequest) {
+			defer wg.Done()
+			_, _, _, err := tradeSvc.TradePropose(
+				ctx, market, application.TradeSell, swapRequest,
+			)
+			chErrs <- err
+		}(swapRequest)
+	}
+	wg.Wait()
+	close(chErrs)
+
+	for err := range chErrs {
+		require.NoError(t, err)
+	}
+
+	changeScripts := make(map[string]bool)
+	feeChangeScripts := make(map[string]bool)
+	for _, call := range mockedTradeManager.Calls {
+		opts := call.Arguments.Get(0).(application.FillProposalOpts)
+		changeScripts[opts.ChangeInfo.Script] = true
+		feeChangeScripts[opts.FeeChangeInfo.Script] = true
+	}
+	require.Len(t, mockedTradeManager.Calls, numOfProposals)
+	require.Len(t, changeScripts, numOfProposals)
+	require.Len(t, feeChangeScripts, numOfProposals)
+}
+
+func TestRejectedTradeProposalAddressesAreReused(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	mockedTradeManager := newMockedTradeManager()
+	mockedTradeManager.
+		On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
+		Return(nil, errors.New("fill proposal")).
+		Once()
+	mockedTradeManager.
+		On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
+		Return(&application.FillProposalResult{
+			PsetBase64:       randomBase64(),
+			SelectedUnspents: randomSelection(unspents, mockedTradeManager.counter),
+		}, nil)
+	application.TradeManager = mockedTradeManager
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	// the first fill is rejected, the next ones are accepted.
+	for i := 0; i < 3; i++ {
+		swapRequest := newSwapRequest(
+			t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset,
+		)
+		swapAccept, _, _, err := tradeSvc.TradePropose(
+			ctx, market, application.TradeSell, swapRequest,
+		)
+		require.NoError(t, err)
+		require.Equal(t, i > 0, swapAccept != nil)
+	}
+
+	require.Len(t, mockedTradeManager.Calls, 3)
+	fillOpts := make([]application.FillProposalOpts, 0, 3)
+	for _, call := range mockedTradeManager.Calls {
+		fillOpts = append(
+			fillOpts, call.Arguments.Get(0).(application.FillProposalOpts),
+		)
+	}
+	rejected, accepted, next := fillOpts[0], fillOpts[1], fillOpts[2]
+	require.Equal(t, rejected.OutputInfo, accepted.OutputInfo)
+	require.Equal(t, rejected.ChangeInfo, accepted.ChangeInfo)
+	require.Equal(t, rejected.FeeChangeInfo, accepted.FeeChangeInfo)
+	require.NotEqual(t, accepted.ChangeInfo, next.ChangeInfo)
+}
+
+func TestConcurrentTradeProposalsSelectDistinctUnspents(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	// the mocked manager selects the first of the unspents it's given, so
+	// that proposals not serialized would select the same ones.
+	mockedTradeManager := newMockedTradeManager()
+	mockedTradeManager.
+		On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
+		Return(func(opts application.FillProposalOpts) *application.FillProposalResult {
+			return &application.FillProposalResult{
+				PsetBase64: randomBase64(),
+				SelectedUnspents: []explorer.Utxo{
+					opts.MarketUtxos[0], opts.FeeUtxos[0],
+				},
+			}
+		}, nil)
+	application.TradeManager = mockedTradeManager
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	// proposals exceeding the fee unspents are rejected, those accepted must
+	// select distinct ones anyway.
+	numOfProposals := 10
+	swapRequests := make([]domain.SwapRequest, 0, numOfProposals)
+	for i := 0; i < numOfProposals; i++ {
+		swapRequests = append(swapRequests, newSwapRequest(
+			t, tradeSvc, market, application.TradeSell, 0.01, marketBaseAsset,
+		))
+	}
+
+	var accepted int32
+	chErrs := make(chan error, numOfProposals)
+	wg := &sync.WaitGroup{}
+	wg.Add(numOfProposals)
+	for _, swapRequest := range swapRequests {
+		go func(swapRequest domain.SwapRequest) {
+			defer wg.Done()
+			swapAccept, _, _, err := tradeSvc.TradePropose(
+				ctx, market, application.TradeSell, swapRequest,
+			)
+			if err != nil {
+				chErrs <- err
+				return
+			}
+			if swapAccept != nil {
+				atomic.AddInt32(&accepted, 1)
+			}
+		}(swapRequest)
+	}
+	wg.Wait()
+	close(chErrs)
+
+	for err := range chErrs {
+		require.Equal(t, application.ErrFeeAccountNotFunded, err)
+	}
+
+	selected := make(map[string]bool)
+	for _, call := range mockedTradeManager.Calls {
+		opts := call.Arguments.Get(0).(application.FillProposalOpts)
+		for _, u := range []explorer.Utxo{opts.MarketUtxos[0], opts.FeeUtxos[0]} {
+			key := fmt.Sprintf("%s:%d", u.Hash(), u.Index())
+			require.False(t, selected[key], "unspent %s selected twice", key)
+			selected[key] = true
+		}
+	}
+	require.Greater(t, int(accepted), 1)
+	require.Len(t, mockedTradeManager.Calls, int(accepted))
+}
+
+func TestMarketTradingWithFeesPaidByMarket(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	// drain the fee account
+	feeUnspentKeys := make([]domain.UnspentKey, 0, len(tradeFeeOutpoints))
+	for _, u := range unspents[:len(tradeFeeOutpoints)] {
+		feeUnspentKeys = append(feeUnspentKeys, u.Key())
+	}
+	_, err = repoManager.UnspentRepository().SpendUnspents(ctx, feeUnspentKeys)
+	require.NoError(t, err)
+
+	mockedTradeManager := newMockedTradeManager()
+	mockedTradeManager.
+		On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
+		Return(&application.FillProposalResult{
+			PsetBase64:         randomBase64(),
+			SelectedUnspents:   randomSelection(unspents, mockedTradeManager.counter),
+			InputBlindingKeys:  nil,
+			OutputBlindingKeys: nil,
+			FeesPaidByMarket:   500,
+		}, nil)
+	application.TradeManager = mockedTradeManager
+
+	newTradeSvc := func(autoTopUpFees bool) application.TradeService {
+		return buildTradeService(
+			repoManager, explorerSvc, bcListener,
+			tradeServiceOpts{autoTopUpFees: autoTopUpFees},
+		)
+	}
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	t.Run("without auto top-up", func(t *testing.T) {
+		tradeSvc := newTradeSvc(false)
+		swapRequest := newSwapRequest(
+			t, tradeSvc, market, application.TradeBuy, 0.1, marketBaseAsset,
+		)
+
+		_, _, _, err := tradeSvc.TradePropose(
+			ctx, market, application.TradeBuy, swapRequest,
+		)
+		require.EqualError(t, err, application.ErrFeeAccountNotFunded.Error())
+	})
+
+	t.Run("with auto top-up", func(t *testing.T) {
+		tradeSvc := newTradeSvc(true)
+		swapRequest := newSwapRequest(
+			t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset,
+		)
+
+		swapAccept, swapFail, _, err := tradeSvc.TradePropose(
+			ctx, market, application.TradeSell, swapRequest,
+		)
+		require.NoError(t, err)
+		require.Nil(t, swapFail)
+		require.NotNil(t, swapAccept)
+		mockedTradeManager.AssertCalled(
+			t,
+			"FillProposal",
+			mock.MatchedBy(func(opts application.FillProposalOpts) bool {
+				return opts.AutoTopUpFees &&
+					opts.MarketBaseAsset == marketBaseAsset &&
+					len(opts.FeeUtxos) == 0
+			}),
+		)
+	})
+
+	t.Run("refuse top-up if the market sells L-BTC", func(t *testing.T) {
+		tradeSvc := newTradeSvc(true)
+		swapRequest := newSwapRequest(
+			t, tradeSvc, market, application.TradeBuy, 0.1, marketBaseAsset,
+		)
+
+		_, _, _, err := tradeSvc.TradePropose(
+			ctx, market, application.TradeBuy, swapRequest,
+		)
+		require.EqualError(t, err, application.ErrFeeAccountNotFunded.Error())
+	})
+
+	t.Run("refuse top-up not covered by the L-BTC bought", func(t *testing.T) {
+		tradeSvc := newTradeSvc(true)
+		swapRequest := newSwapRequest(
+			t, tradeSvc, market, application.TradeSell, 0.000001, marketBaseAsset,
+		)
+
+		v, err := repoManager.VaultRepository().GetOrCreateVault(ctx, nil, "", nil)
+		require.NoError(t, err)
+		outInfo, err := v.DeriveNextExternalAddressForAccount(domain.MarketAccountStart)
+		require.NoError(t, err)
+		changeInfo, err := v.DeriveNextInternalAddressForAccount(domain.MarketAccountStart)
+		require.NoError(t, err)
+
+		marketUtxos := make([]explorer.Utxo, 0)
+		for _, u := range unspents[len(tradeFeeOutpoints):] {
+			marketUtxos = append(marketUtxos, esplora.NewUnconfidentialWitnessUtxo(
+				u.TxID, u.VOut, u.Value, u.AssetHash, u.ScriptPubKey,
+			))
+		}
+
+		for req, expectedErr := range map[domain.SwapRequest]error{
+			swapRequest: application.ErrFeeTopUpTooLow,
+			// the market sells L-BTC, it has none to pay with.
+			newSwapRequest(
+				t, tradeSvc, market, application.TradeBuy, 0.1, marketBaseAsset,
+			): application.ErrFeeTopUpNotSupported,
+		} {
+			_, err = tradeManager.FillProposal(application.FillProposalOpts{
+				Mnemonic:        mnemonic,
+				SwapRequest:     req,
+				MarketUtxos:     marketUtxos,
+				OutputInfo:      *outInfo,
+				ChangeInfo:      *changeInfo,
+				Network:         regtest,
+				AutoTopUpFees:   true,
+				MarketBaseAsset: marketBaseAsset,
+			})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), expectedErr.Error())
+		}
+	})
+
+	t.Run("refuse top-up for non L-BTC markets", func(t *testing.T) {
+		tradeSvc := newTradeSvc(true)
+		swapRequest := newSwapRequest(
+			t, tradeSvc, market, application.TradeBuy, 0.1, marketBaseAsset,
+		)
+		// the trader side of the swap doesn't matter here
+		ptx, err := pset.New(nil, nil, 2, 0)
+		require.NoError(t, err)
+		swapRequest.(*pbswap.SwapRequest).Transaction, err = ptx.ToBase64()
+		require.NoError(t, err)
+
+		v, err := repoManager.VaultRepository().GetOrCreateVault(ctx, nil, "", nil)
+		require.NoError(t, err)
+		outInfo, err := v.DeriveNextExternalAddressForAccount(domain.MarketAccountStart)
+		require.NoError(t, err)
+		changeInfo, err := v.DeriveNextInternalAddressForAccount(domain.MarketAccountStart)
+		require.NoError(t, err)
+
+		marketUtxos := make([]explorer.Utxo, 0)
+		for _, u := range unspents[len(tradeFeeOutpoints):] {
+			marketUtxos = append(marketUtxos, esplora.NewUnconfidentialWitnessUtxo(
+				u.TxID, u.VOut, u.Value, u.AssetHash, u.ScriptPubKey,
+			))
+		}
+
+		_, err = tradeManager.FillProposal(application.FillProposalOpts{
+			Mnemonic:        mnemonic,
+			SwapRequest:     swapRequest,
+			MarketUtxos:     marketUtxos,
+			OutputInfo:      *outInfo,
+			ChangeInfo:      *changeInfo,
+			Network:         regtest,
+			AutoTopUpFees:   true,
+			MarketBaseAsset: marketQuoteAsset,
+		})
+		require.EqualError(t, err, application.ErrFeeTopUpNotSupported.Error())
+	})
+}
+
+func TestMarketTradingWithUnselectableUtxos(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	tradeSvc.SetMarketStrategy(market, fixedPriceStrategy{
+		application.Price{
+			BasePrice:  decimal.NewFromFloat(0.0001),
+			QuotePrice: decimal.NewFromInt(10000),
+		},
+	})
+
+	// all but one of the LBTC utxos of the market, worth 0.05 LBTC each, are
+	// made unavailable right after the trader has previewed the trade.
+	lbtcUnspentKeys := make([]domain.UnspentKey, 0)
+	for _, u := range unspents[len(tradeFeeOutpoints):] {
+		if u.AssetHash == marketBaseAsset {
+			lbtcUnspentKeys = append(lbtcUnspentKeys, u.Key())
+		}
+	}
+	lbtcUnspentKeys = lbtcUnspentKeys[1:]
+
+	t.Run("selectable utxos matching the amount", func(t *testing.T) {
+		mockedTradeManager := newMockedTradeManager()
+		mockedTradeManager.
+			On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
+			Return(nil, errors.New("fill proposal"))
+		application.TradeManager = mockedTradeManager
+		t.Cleanup(func() { application.TradeManager = tradeManager })
+
+		swapRequest := newSwapRequest(
+			t, tradeSvc, market, application.TradeBuy, 0.1, marketBaseAsset,
+		)
+		// only 2 utxos, worth exactly the requested amount, are left selectable.
+		_, err = repoManager.UnspentRepository().LockUnspents(
+			ctx, lbtcUnspentKeys[1:], uuid.New(),
+		)
+		require.NoError(t, err)
+		defer func() {
+			_, err := repoManager.UnspentRepository().UnlockUnspents(
+				ctx, lbtcUnspentKeys[1:],
+			)
+			require.NoError(t, err)
+		}()
+
+		_, _, _, err := tradeSvc.TradePropose(
+			ctx, market, application.TradeBuy, swapRequest,
+		)
+		require.NoError(t, err)
+		mockedTradeManager.AssertCalled(
+			t, "FillProposal", mock.AnythingOfType("application.FillProposalOpts"),
+		)
+	})
+
+	t.Run("locked utxos", func(t *testing.T) {
+		swapRequest := newSwapRequest(
+			t, tradeSvc, market, application.TradeBuy, 0.1, marketBaseAsset,
+		)
+		_, err = repoManager.UnspentRepository().LockUnspents(
+			ctx, lbtcUnspentKeys, uuid.New(),
+		)
+		require.NoError(t, err)
+
+		swapAccept, swapFail, _, err := tradeSvc.TradePropose(
+			ctx, market, application.TradeBuy, swapRequest,
+		)
+		require.NoError(t, err)
+		require.Nil(t, swapAccept)
+		require.NotNil(t, swapFail)
+		domain.SwapParserManager.(*mockSwapParser).AssertCalled(
+			t,
+			"SerializeFail",
+			swapRequest.GetId(),
+			int(pkgswap.ErrCodeRejectedSwapRequest),
+			application.ErrMarketUtxosNotSelectable.Error(),
+		)
+	})
+
+	t.Run("not enough balance", func(t *testing.T) {
+		_, err := repoManager.UnspentRepository().UnlockUnspents(
+			ctx, lbtcUnspentKeys,
+		)
+		require.NoError(t, err)
+
+		swapRequest := newSwapRequest(
+			t, tradeSvc, market, application.TradeBuy, 0.1, marketBaseAsset,
+		)
+		_, err = repoManager.UnspentRepository().SpendUnspents(
+			ctx, lbtcUnspentKeys,
+		)
+		require.NoError(t, err)
+
+		swapAccept, swapFail, _, err := tradeSvc.TradePropose(
+			ctx, market, application.TradeBuy, swapRequest,
+		)
+		require.NoError(t, err)
+		require.Nil(t, swapAccept)
+		require.NotNil(t, swapFail)
+		domain.SwapParserManager.(*mockSwapParser).AssertCalled(
+			t,
+			"SerializeFail",
+			swapRequest.GetId(),
+			int(pkgswap.ErrCodeRejectedSwapRequest),
+			application.ErrMarketInsufficientBalance.Error(),
+		)
+	})
+}
+
+func TestMarketTradingWithAntiFeeSniping(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	mockedTradeManager := newMockedTradeManager()
+	mockedTradeManager.
+		On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
+		Return(&application.FillProposalResult{
+			PsetBase64:         randomBase64(),
+			SelectedUnspents:   randomSelection(unspents, mockedTradeManager.counter),
+			InputBlindingKeys:  nil,
+			OutputBlindingKeys: nil,
+		}, nil)
+	application.TradeManager = mockedTradeManager
+
+	blockHeight := 1200
+	explorerSvc.(*mockExplorer).On("GetBlockHeight").Return(blockHeight, nil)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{antiFeeSniping: true},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	marketOrder(t, tradeSvc, market, application.TradeBuy, 0.1, marketBaseAsset)
+	mockedTradeManager.AssertCalled(
+		t,
+		"FillProposal",
+		mock.MatchedBy(func(opts application.FillProposalOpts) bool {
+			return opts.LockTime == uint32(blockHeight)
+		}),
+	)
+}
+
+func TestFailingPreviewProposal(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	swapRequest := newSwapRequest(
+		t, tradeSvc, market, application.TradeBuy, 0.1, marketBaseAsset,
+	)
+	ptx, err := pset.New(nil, nil, 2, 0)
+	require.NoError(t, err)
+	swapRequest.(*pbswap.SwapRequest).Transaction, err = ptx.ToBase64()
+	require.NoError(t, err)
+
+	v, err := repoManager.VaultRepository().GetOrCreateVault(ctx, nil, "", nil)
+	require.NoError(t, err)
+	outInfo, err := v.DeriveNextExternalAddressForAccount(domain.MarketAccountStart)
+	require.NoError(t, err)
+	changeInfo, err := v.DeriveNextInternalAddressForAccount(domain.MarketAccountStart)
+	require.NoError(t, err)
+
+	marketUtxos := make([]explorer.Utxo, 0)
+	for _, u := range unspents[len(tradeFeeOutpoints):] {
+		marketUtxos = append(marketUtxos, esplora.NewUnconfidentialWitnessUtxo(
+			u.TxID, u.VOut, u.Value, u.AssetHash, u.ScriptPubKey,
+		))
+	}
+
+	// a preview fails exactly like the proposal it previews would.
+	_, err = tradeManager.PreviewProposal(application.FillProposalOpts{
+		Mnemonic:        mnemonic,
+		SwapRequest:     swapRequest,
+		MarketUtxos:     marketUtxos,
+		OutputInfo:      *outInfo,
+		ChangeInfo:      *changeInfo,
+		Network:         regtest,
+		AutoTopUpFees:   true,
+		MarketBaseAsset: marketQuoteAsset,
+	})
+	require.EqualError(t, err, application.ErrFeeTopUpNotSupported.Error())
+}
+
+func TestMarketBalanceWithMinConfirmations(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	info, err := repoManager.VaultRepository().
+		GetAllDerivedAddressesInfoForAccount(ctx, domain.MarketAccountStart)
+	require.NoError(t, err)
+	script, _ := hex.DecodeString(info[0].Script)
+
+	// one unspent with 2 confirmations and one not yet confirmed.
+	value := uint64(1000)
+	recentUnspents := []domain.Unspent{
+		{
+			TxID:         randomHex(32),
+			Value:        value,
+			AssetHash:    marketBaseAsset,
+			ScriptPubKey: script,
+			Address:      info[0].Address,
+			Confirmed:    true,
+			BlockHeight:  100,
+		},
+		{
+			TxID:         randomHex(32),
+			Value:        value,
+			AssetHash:    marketBaseAsset,
+			ScriptPubKey: script,
+			Address:      info[0].Address,
+		},
+	}
+	err = repoManager.UnspentRepository().AddUnspents(ctx, recentUnspents)
+	require.NoError(t, err)
+	explorerSvc.(*mockExplorer).On("GetBlockHeight").Return(101, nil)
+
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	balances := make(map[uint64]uint64)
+	for _, minConfirmations := range []uint64{1, 2, 3} {
+		tradeSvc := buildTradeService(
+			repoManager, explorerSvc, bcListener,
+			tradeServiceOpts{minConfirmations: minConfirmations},
+		)
+
+		balance, err := tradeSvc.GetMarketBalance(ctx, market)
+		require.NoError(t, err)
+		balances[minConfirmations] = balance.Balance.BaseAmount
+
+		preview, err := tradeSvc.PreviewTrade(
+			ctx, market, application.TradeSell, 200000, marketBaseAsset,
+		)
+		require.NoError(t, err)
+		require.Equal(t, balance.Balance, preview.Balance)
+	}
+
+	// the funded market has 20 unspents of 5000000 sats of base asset.
+	require.Equal(t, 100000000+value, balances[1])
+	require.Equal(t, balances[1], balances[2])
+	require.Equal(t, balances[2]-value, balances[3])
+}
+
+func TestMarketBalanceCache(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+	repoManager = application.WithBalanceCache(repoManager)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+
+	balance, err := tradeSvc.GetMarketBalance(ctx, market)
+	require.NoError(t, err)
+	baseAmount := balance.Balance.BaseAmount
+
+	info, err := repoManager.VaultRepository().
+		GetAllDerivedAddressesInfoForAccount(ctx, domain.MarketAccountStart)
+	require.NoError(t, err)
+	script, _ := hex.DecodeString(info[0].Script)
+
+	value := uint64(1000)
+	newUnspent := domain.Unspent{
+		TxID:         randomHex(32),
+		Value:        value,
+		AssetHash:    marketBaseAsset,
+		ScriptPubKey: script,
+		Address:      info[0].Address,
+		Confirmed:    true,
+	}
+	err = repoManager.UnspentRepository().AddUnspents(
+		ctx, []domain.Unspent{newUnspent},
+	)
+	require.NoError(t, err)
+
+	balance, err = tradeSvc.GetMarketBalance(ctx, market)
+	require.NoError(t, err)
+	require.Equal(t, baseAmount+value, balance.Balance.BaseAmount)
+
+	// locked unspents are still part of the market balance, but can't be used
+	// for trading.
+	_, err = repoManager.UnspentRepository().LockUnspents(
+		ctx, []domain.UnspentKey{newUnspent.Key()}, uuid.New(),
+	)
+	require.NoError(t, err)
+
+	balance, err = tradeSvc.GetMarketBalance(ctx, market)
+	require.NoError(t, err)
+	require.Equal(t, baseAmount+value, balance.Balance.BaseAmount)
+
+	preview, err := tradeSvc.PreviewTrade(
+		ctx, market, application.TradeSell, 200000, marketBaseAsset,
+	)
+	require.NoError(t, err)
+	require.Equal(t, baseAmount, preview.Balance.BaseAmount)
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := tradeSvc.GetMarketBalance(ctx, market)
+			require.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			err := repoManager.UnspentRepository().AddUnspents(
+				ctx,
+				[]domain.Unspent{{
+					TxID:         randomHex(32),
+					Value:        value,
+					AssetHash:    marketBaseAsset,
+					ScriptPubKey: script,
+					Address:      info[0].Address,
+					Confirmed:    true,
+				}},
+			)
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	balance, err = tradeSvc.GetMarketBalance(ctx, market)
+	require.NoError(t, err)
+	require.Equal(t, baseAmount+11*value, balance.Balance.BaseAmount)
+}
+
+func TestTradeReaper(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	lockedUnspent := unspents[len(tradeFeeOutpoints)]
+	hash, _ := bufferutil.TxIDToBytes(lockedUnspent.TxID)
+	p, err := pset.New(
+		[]*transaction.TxInput{
+			transaction.NewTxInput(hash, lockedUnspent.VOut),
+		},
+		[]*transaction.TxOutput{},
+		2,
+		0,
+	)
+	require.NoError(t, err)
+	psetBase64, err := p.ToBase64()
+	require.NoError(t, err)
+
+	now := uint64(time.Now().Unix())
+	expiredTrade := domain.Trade{
+		ID:               uuid.New(),
+		MarketQuoteAsset: marketQuoteAsset,
+		Status:           domain.AcceptedStatus,
+		PsetBase64:       psetBase64,
+		TxID:             randomHex(32),
+		ExpiryTime:       now - 10,
+		SwapRequest:      domain.Swap{ID: randomId(), Timestamp: now - 130},
+		SwapAccept:       domain.Swap{ID: randomId(), Timestamp: now - 130},
+	}
+	stuckProposal := domain.Trade{
+		ID:               uuid.New(),
+		MarketQuoteAsset: marketQuoteAsset,
+		Status:           domain.ProposalStatus,
+		SwapRequest:      domain.Swap{ID: randomId(), Timestamp: now - 300},
+	}
+	pendingTrade := domain.Trade{
+		ID:               uuid.New(),
+		MarketQuoteAsset: marketQuoteAsset,
+		Status:           domain.AcceptedStatus,
+		PsetBase64:       psetBase64,
+		TxID:             randomHex(32),
+		ExpiryTime:       now + 100,
+		SwapRequest:      domain.Swap{ID: randomId(), Timestamp: now - 20},
+		SwapAccept:       domain.Swap{ID: randomId(), Timestamp: now - 20},
+	}
+
+	for _, tr := range []domain.Trade{expiredTrade, stuckProposal, pendingTrade} {
+		tr := tr
+		_, err := repoManager.TradeRepository().GetOrCreateTrade(ctx, &tr.ID)
+		require.NoError(t, err)
+		err = repoManager.TradeRepository().UpdateTrade(
+			ctx,
+			&tr.ID,
+			func(_ *domain.Trade) (*domain.Trade, error) { return &tr, nil },
+		)
+		require.NoError(t, err)
+	}
+	_, err = repoManager.UnspentRepository().LockUnspents(
+		ctx, []domain.UnspentKey{lockedUnspent.Key()}, expiredTrade.ID,
+	)
+	require.NoError(t, err)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+
+	reaperCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	tradeSvc.StartTradeReaper(reaperCtx, 10*time.Millisecond, 0)
+	time.Sleep(200 * time.Millisecond)
+
+	trade, err := repoManager.TradeRepository().GetOrCreateTrade(
+		ctx, &expiredTrade.ID,
+	)
+	require.NoError(t, err)
+	require.Equal(t, domain.ExpiredStatus, trade.Status)
+	domain.SwapParserManager.(*mockSwapParser).AssertCalled(
+		t,
+		"SerializeFail",
+		expiredTrade.SwapAccept.ID,
+		int(pkgswap.ErrCodeTradeExpired),
+		mock.Anything,
+	)
+
+	trade, err = repoManager.TradeRepository().GetOrCreateTrade(
+		ctx, &stuckProposal.ID,
+	)
+	require.NoError(t, err)
+	require.Equal(t, domain.ProposalRejectedStatus, trade.Status)
+
+	trade, err = repoManager.TradeRepository().GetOrCreateTrade(
+		ctx, &pendingTrade.ID,
+	)
+	require.NoError(t, err)
+	require.Equal(t, domain.AcceptedStatus, trade.Status)
+
+	unspent, err := repoManager.UnspentRepository().GetUnspentWithKey(
+		ctx, lockedUnspent.Key(),
+	)
+	require.NoError(t, err)
+	require.False(t, unspent.IsLocked())
+}
+
+func TestLockReaper(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	orphanedUnspent := unspents[len(tradeFeeOutpoints)]
+	reservedUnspent := unspents[len(tradeFeeOutpoints)+1]
+
+	now := uint64(time.Now().Unix())
+	pendingTrade := domain.Trade{
+		ID:               uuid.New(),
+		MarketQuoteAsset: marketQuoteAsset,
+		Status:           domain.AcceptedStatus,
+		TxID:             randomHex(32),
+		ExpiryTime:       now + 100,
+		SwapRequest:      domain.Swap{ID: randomId(), Timestamp: now},
+		SwapAccept:       domain.Swap{ID: randomId(), Timestamp: now},
+	}
+	_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &pendingTrade.ID)
+	require.NoError(t, err)
+	err = repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&pendingTrade.ID,
+		func(_ *domain.Trade) (*domain.Trade, error) { return &pendingTrade, nil },
+	)
+	require.NoError(t, err)
+
+	// the trade the orphaned unspent is locked for has never been persisted.
+	_, err = repoManager.UnspentRepository().LockUnspents(
+		ctx, []domain.UnspentKey{orphanedUnspent.Key()}, uuid.New(),
+	)
+	require.NoError(t, err)
+	_, err = repoManager.UnspentRepository().LockUnspents(
+		ctx, []domain.UnspentKey{reservedUnspent.Key()}, pendingTrade.ID,
+	)
+	require.NoError(t, err)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+
+	isLocked := func(key domain.UnspentKey) bool {
+		unspent, err := repoManager.UnspentRepository().GetUnspentWithKey(ctx, key)
+		require.NoError(t, err)
+		return unspent.IsLocked()
+	}
+
+	reaperCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	tradeSvc.StartLockReaper(reaperCtx, 10*time.Millisecond, 2*time.Second)
+
+	time.Sleep(100 * time.Millisecond)
+	require.True(t, isLocked(orphanedUnspent.Key()))
+	require.True(t, isLocked(reservedUnspent.Key()))
+
+	require.Eventually(t, func() bool {
+		return !isLocked(orphanedUnspent.Key())
+	}, 4*time.Second, 50*time.Millisecond)
+	require.True(t, isLocked(reservedUnspent.Key()))
+}
+
+func TestTradeSettlementTimeout(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	lockedUnspent := unspents[len(tradeFeeOutpoints)]
+	hash, _ := bufferutil.TxIDToBytes(lockedUnspent.TxID)
+	p, err := pset.New(
+		[]*transaction.TxInput{
+			transaction.NewTxInput(hash, lockedUnspent.VOut),
+		},
+		[]*transaction.TxOutput{},
+		2,
+		0,
+	)
+	require.NoError(t, err)
+	psetBase64, err := p.ToBase64()
+	require.NoError(t, err)
+
+	completedUnspent := unspents[len(tradeFeeOutpoints)+1]
+	hash, _ = bufferutil.TxIDToBytes(completedUnspent.TxID)
+	p, err = pset.New(
+		[]*transaction.TxInput{
+			transaction.NewTxInput(hash, completedUnspent.VOut),
+		},
+		[]*transaction.TxOutput{},
+		2,
+		0,
+	)
+	require.NoError(t, err)
+	completedPsetBase64, err := p.ToBase64()
+	require.NoError(t, err)
+
+	now := uint64(time.Now().Unix())
+	unsettledTrade := domain.Trade{
+		ID:                 uuid.New(),
+		MarketQuoteAsset:   marketQuoteAsset,
+		Status:             domain.AcceptedStatus,
+		PsetBase64:         psetBase64,
+		TxID:               randomHex(32),
+		SettlementDeadline: now - 10,
+		SwapRequest:        domain.Swap{ID: randomId(), Timestamp: now - 70},
+		SwapAccept:         domain.Swap{ID: randomId(), Timestamp: now - 70},
+	}
+	pendingTrade := domain.Trade{
+		ID:                 uuid.New(),
+		MarketQuoteAsset:   marketQuoteAsset,
+		Status:             domain.AcceptedStatus,
+		PsetBase64:         psetBase64,
+		TxID:               randomHex(32),
+		SettlementDeadline: now + 100,
+		SwapRequest:        domain.Swap{ID: randomId(), Timestamp: now - 20},
+		SwapAccept:         domain.Swap{ID: randomId(), Timestamp: now - 20},
+	}
+	// the tx of a completed trade has already been broadcasted, therefore it
+	// is never failed nor are its unspents unlocked.
+	completedTrade := domain.Trade{
+		ID:                 uuid.New(),
+		MarketQuoteAsset:   marketQuoteAsset,
+		Status:             domain.CompletedStatus,
+		PsetBase64:         completedPsetBase64,
+		TxID:               randomHex(32),
+		SettlementDeadline: now - 10,
+		SwapRequest:        domain.Swap{ID: randomId(), Timestamp: now - 70},
+		SwapAccept:         domain.Swap{ID: randomId(), Timestamp: now - 70},
+		SwapComplete:       domain.Swap{ID: randomId(), Timestamp: now - 60},
+	}
+
+	for _, tr := range []domain.Trade{unsettledTrade, pendingTrade, completedTrade} {
+		tr := tr
+		_, err := repoManager.TradeRepository().GetOrCreateTrade(ctx, &tr.ID)
+		require.NoError(t, err)
+		err = repoManager.TradeRepository().UpdateTrade(
+			ctx,
+			&tr.ID,
+			func(_ *domain.Trade) (*domain.Trade, error) { return &tr, nil },
+		)
+		require.NoError(t, err)
+	}
+	_, err = repoManager.UnspentRepository().LockUnspents(
+		ctx, []domain.UnspentKey{lockedUnspent.Key()}, unsettledTrade.ID,
+	)
+	require.NoError(t, err)
+	_, err = repoManager.UnspentRepository().LockUnspents(
+		ctx, []domain.UnspentKey{completedUnspent.Key()}, completedTrade.ID,
+	)
+	require.NoError(t, err)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+
+	reaperCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	tradeSvc.StartTradeReaper(reaperCtx, 10*time.Millisecond, 0)
+	time.Sleep(200 * time.Millisecond)
+
+	trade, err := repoManager.TradeRepository().GetOrCreateTrade(
+		ctx, &unsettledTrade.ID,
+	)
+	require.NoError(t, err)
+	require.Equal(t, domain.FailedToCompleteStatus, trade.Status)
+	domain.SwapParserManager.(*mockSwapParser).AssertCalled(
+		t,
+		"SerializeFail",
+		unsettledTrade.SwapAccept.ID,
+		int(pkgswap.ErrCodeSettlementTimeout),
+		mock.Anything,
+	)
+
+	trade, err = repoManager.TradeRepository().GetOrCreateTrade(
+		ctx, &pendingTrade.ID,
+	)
+	require.NoError(t, err)
+	require.Equal(t, domain.AcceptedStatus, trade.Status)
+
+	trade, err = repoManager.TradeRepository().GetOrCreateTrade(
+		ctx, &completedTrade.ID,
+	)
+	require.NoError(t, err)
+	require.Equal(t, domain.CompletedStatus, trade.Status)
+
+	unspent, err := repoManager.UnspentRepository().GetUnspentWithKey(
+		ctx, lockedUnspent.Key(),
+	)
+	require.NoError(t, err)
+	require.False(t, unspent.IsLocked())
+
+	unspent, err = repoManager.UnspentRepository().GetUnspentWithKey(
+		ctx, completedUnspent.Key(),
+	)
+	require.NoError(t, err)
+	require.True(t, unspent.IsLocked())
+}
+
+func TestTradeCompleteAfterSettlementDeadline(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	lockedUnspent := unspents[len(tradeFeeOutpoints)]
+	hash, _ := bufferutil.TxIDToBytes(lockedUnspent.TxID)
+	p, err := pset.New(
+		[]*transaction.TxInput{
+			transaction.NewTxInput(hash, lockedUnspent.VOut),
+		},
+		[]*transaction.TxOutput{},
+		2,
+		0,
+	)
+	require.NoError(t, err)
+	psetBase64, err := p.ToBase64()
+	require.NoError(t, err)
+
+	now := uint64(time.Now().Unix())
+	lateTrade := domain.Trade{
+		ID:                 uuid.New(),
+		MarketQuoteAsset:   marketQuoteAsset,
+		Status:             domain.AcceptedStatus,
+		PsetBase64:         psetBase64,
+		SettlementDeadline: now - 10,
+		SwapRequest:        domain.Swap{ID: randomId(), Timestamp: now - 70},
+		SwapAccept:         domain.Swap{ID: randomId(), Timestamp: now - 70},
+	}
+	_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &lateTrade.ID)
+	require.NoError(t, err)
+	err = repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&lateTrade.ID,
+		func(_ *domain.Trade) (*domain.Trade, error) { return &lateTrade, nil },
+	)
+	require.NoError(t, err)
+	_, err = repoManager.UnspentRepository().LockUnspents(
+		ctx, []domain.UnspentKey{lockedUnspent.Key()}, lateTrade.ID,
+	)
+	require.NoError(t, err)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+
+	var swapComplete domain.SwapComplete = &pbswap.SwapComplete{
+		Id:          randomId(),
+		AcceptId:    lateTrade.SwapAccept.ID,
+		Transaction: psetBase64,
+	}
+	_, _, err = tradeSvc.TradeComplete(ctx, &swapComplete, nil)
+	require.EqualError(t, err, domain.ErrTradeSettlementDeadlineReached.Error())
+
+	// the failure is persisted and the unspents are released right away,
+	// without waiting for the trade reaper.
+	trade, err := repoManager.TradeRepository().GetOrCreateTrade(
+		ctx, &lateTrade.ID,
+	)
+	require.NoError(t, err)
+	require.Equal(t, domain.FailedToCompleteStatus, trade.Status)
+
+	unspent, err := repoManager.UnspentRepository().GetUnspentWithKey(
+		ctx, lockedUnspent.Key(),
+	)
+	require.NoError(t, err)
+	require.False(t, unspent.IsLocked())
+}
+
+func TestCancelTrade(t *testing.T) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	lockedUnspent := unspents[len(tradeFeeOutpoints)]
+	hash, _ := bufferutil.TxIDToBytes(lockedUnspent.TxID)
+	p, err := pset.New(
+		[]*transaction.TxInput{
+			transaction.NewTxInput(hash, lockedUnspent.VOut),
+		},
+		[]*transaction.TxOutput{},
+		2,
+		0,
+	)
+	require.NoError(t, err)
+	psetBase64, err := p.ToBase64()
+	require.NoError(t, err)
+
+	now := uint64(time.Now().Unix())
+	acceptedTrade := domain.Trade{
+		ID:               uuid.New(),
+		MarketQuoteAsset: marketQuoteAsset,
+		Status:           domain.AcceptedStatus,
+		PsetBase64:       psetBase64,
+		TxID:             randomHex(32),
+		ExpiryTime:       now + 100,
+		SwapRequest:      domain.Swap{ID: randomId(), Timestamp: now - 20},
+		SwapAccept:       domain.Swap{ID: randomId(), Timestamp: now - 20},
+	}
+	settledTrade := domain.Trade{
+		ID:               uuid.New(),
+		MarketQuoteAsset: marketQuoteAsset,
+		Status:           domain.SettledStatus,
+		TxID:             randomHex(32),
+		SettlementTime:   now,
+		SwapRequest:      domain.Swap{ID: randomId(), Timestamp: now - 100},
+		SwapAccept:       domain.Swap{ID: randomId(), Timestamp: now - 100},
+		SwapComplete:     domain.Swap{ID: randomId(), Timestamp: now - 90},
+	}
+
+	for _, tr := range []domain.Trade{acceptedTrade, settledTrade} {
+		tr := tr
+		_, err := repoManager.TradeRepository().GetOrCreateTrade(ctx, &tr.ID)
+		require.NoError(t, err)
+		err = repoManager.TradeRepository().UpdateTrade(
+			ctx,
+			&tr.ID,
+			func(_ *domain.Trade) (*domain.Trade, error) { return &tr, nil },
+		)
+		require.NoError(t, err)
+	}
+	_, err = repoManager.UnspentRepository().LockUnspents(
+		ctx, []domain.UnspentKey{lockedUnspent.Key()}, acceptedTrade.ID,
+	)
+	require.NoError(t, err)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+
+	err = tradeSvc.CancelTrade(ctx, acceptedTrade.ID.String())
+	require.NoError(t, err)
+
+	trade, err := repoManager.TradeRepository().GetOrCreateTrade(
+		ctx, &acceptedTrade.ID,
+	)
+	require.NoError(t, err)
+	require.Equal(t, domain.CanceledStatus, trade.Status)
+	domain.SwapParserManager.(*mockSwapParser).AssertCalled(
+		t,
+		"SerializeFail",
+		acceptedTrade.SwapAccept.ID,
+		int(pkgswap.ErrCodeTradeCanceled),
+		mock.Anything,
+	)
+
+	unspent, err := repoManager.UnspentRepository().GetUnspentWithKey(
+		ctx, lockedUnspent.Key(),
+	)
+	require.NoError(t, err)
+	require.False(t, unspent.IsLocked())
+
+	// late completions of the canceled trade are rejected.
+	var swapComplete domain.SwapComplete = &pbswap.SwapComplete{
+		Id:          randomId(),
+		AcceptId:    acceptedTrade.SwapAccept.ID,
+		Transaction: psetBase64,
+	}
+	_, _, err = tradeSvc.TradeComplete(ctx, &swapComplete, nil)
+	require.EqualError(t, err, domain.ErrTradeCanceled.Error())
+
+	// canceling twice is a no-op.
+	err = tradeSvc.CancelTrade(ctx, acceptedTrade.ID.String())
+	require.NoError(t, err)
+
+	err = tradeSvc.CancelTrade(ctx, settledTrade.ID.String())
+	require.EqualError(t, err, domain.ErrTradeMustBeProposalOrAccepted.Error())
+
+	err = tradeSvc.CancelTrade(ctx, uuid.New().String())
+	require.EqualError(t, err, application.ErrTradeNotFound.Error())
+
+	err = tradeSvc.CancelTrade(ctx, "not-a-uuid")
+	require.EqualError(t, err, application.ErrInvalidTradeID.Error())
+}
+
+func TestReorgWatcher(t *testing.T) {
+	repoManager, explorerSvc, bcListener := newServices()
+
+	newUnspent := func(blockHeight uint64, blockHash string) domain.Unspent {
+		confirmed := blockHeight > 0
+		return domain.Unspent{
+			TxID:        randomHex(32),
+			Value:       1000,
+			AssetHash:   marketBaseAsset,
+			Address:     "address",
+			Confirmed:   confirmed,
+			BlockHeight: blockHeight,
+			BlockHash:   blockHash,
+		}
+	}
+	// confirmed in a block reorged out, and not included in another one yet.
+	droppedUnspent := newUnspent(100, "oldblock100")
+	// confirmed in a block still part of the best chain.
+	finalUnspent := newUnspent(101, "block101")
+	// confirmed in a block reorged out, and already included in another one.
+	movedUnspent := newUnspent(102, "oldblock102")
+	// confirmed in a block older than the depth checked.
+	oldUnspent := newUnspent(90, "oldblock90")
+	// demoted by a previous reorg and now included in another block.
+	reconfirmedUnspent := newUnspent(0, "")
+	unspents := []domain.Unspent{
+		droppedUnspent, finalUnspent, movedUnspent, oldUnspent, reconfirmedUnspent,
+	}
+	err := repoManager.UnspentRepository().AddUnspents(ctx, unspents)
+	require.NoError(t, err)
+
+	now := uint64(time.Now().Unix())
+	droppedTrade := domain.Trade{
+		ID:             uuid.New(),
+		Status:         domain.SettledStatus,
+		TxID:           droppedUnspent.TxID,
+		SettlementTime: now,
+	}
+	reconfirmedTrade := domain.Trade{
+		ID:             uuid.New(),
+		Status:         domain.SettledStatus,
+		TxID:           reconfirmedUnspent.TxID,
+		SettlementTime: now,
+		Reorged:        true,
+	}
+	for _, tr := range []domain.Trade{droppedTrade, reconfirmedTrade} {
+		tr := tr
+		_, err := repoManager.TradeRepository().GetOrCreateTrade(ctx, &tr.ID)
+		require.NoError(t, err)
+		err = repoManager.TradeRepository().UpdateTrade(
+			ctx,
+			&tr.ID,
+			func(_ *domain.Trade) (*domain.Trade, error) { return &tr, nil },
+		)
+		require.NoError(t, err)
+	}
+
+	mockedExplorer := explorerSvc.(*mockExplorer)
+	mockedExplorer.On("GetBlockHeight").Return(105, nil)
+	mockedExplorer.On("GetBlockHash", uint64(100)).Return("block100", nil)
+	mockedExplorer.On("GetBlockHash", uint64(101)).Return("block101", nil)
+	mockedExplorer.On("GetBlockHash", uint64(102)).Return("block102", nil)
+	mockedExplorer.On("GetBlockHash", uint64(103)).Return("block103", nil)
+	mockedExplorer.On("GetTransactionStatus", droppedUnspent.TxID).
+		Return(map[string]interface{}{"confirmed": false}, nil)
+	for _, txid := range []string{movedUnspent.TxID, reconfirmedUnspent.TxID} {
+		mockedExplorer.On("GetTransactionStatus", txid).
+			Return(map[string]interface{}{
+				"confirmed":    true,
+				"block_height": float64(103),
+				"block_hash":   "block103",
+				"block_time":   float64(now + 60),
+			}, nil)
+	}
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+
+	watcherCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	tradeSvc.StartReorgWatcher(watcherCtx, 10*time.Millisecond, 10)
+	time.Sleep(200 * time.Millisecond)
+
+	getUnspent := func(u domain.Unspent) *domain.Unspent {
+		unspent, err := repoManager.UnspentRepository().GetUnspentWithKey(
+			ctx, u.Key(),
+		)
+		require.NoError(t, err)
+		require.NotNil(t, unspent)
+		return unspent
+	}
+
+	unspent := getUnspent(droppedUnspent)
+	require.False(t, unspent.IsConfirmed())
+	require.Empty(t, unspent.BlockHash)
+
+	unspent = getUnspent(finalUnspent)
+	require.True(t, unspent.IsConfirmed())
+	require.Equal(t, finalUnspent.BlockHash, unspent.BlockHash)
+
+	for _, u := range []domain.Unspent{movedUnspent, reconfirmedUnspent} {
+		unspent = getUnspent(u)
+		require.True(t, unspent.IsConfirmed())
+		require.Equal(t, uint64(103), unspent.BlockHeight)
+		require.Equal(t, "block103", unspent.BlockHash)
+	}
+
+	unspent = getUnspent(oldUnspent)
+	require.True(t, unspent.IsConfirmed())
+	require.Equal(t, oldUnspent.BlockHash, unspent.BlockHash)
+	mockedExplorer.AssertNotCalled(t, "GetBlockHash", uint64(90))
+
+	// unspents are updated in place, never added again.
+	require.Len(
+		t, repoManager.UnspentRepository().GetAllUnspents(ctx), len(unspents),
+	)
+
+	trade, err := repoManager.TradeRepository().GetOrCreateTrade(
+		ctx, &droppedTrade.ID,
+	)
+	require.NoError(t, err)
+	require.True(t, trade.IsSettled())
+	require.True(t, trade.IsReorged())
+
+	trade, err = repoManager.TradeRepository().GetOrCreateTrade(
+		ctx, &reconfirmedTrade.ID,
+	)
+	require.NoError(t, err)
+	require.True(t, trade.IsSettled())
+	require.False(t, trade.IsReorged())
+	require.Equal(t, now+60, trade.SettlementTime)
+}
+
+func TestTradePsetLog(t *testing.T) {
+	tradeSvc, err := newTradeService(marketFee, false)
+	require.NoError(t, err)
+
+	_, err = tradeSvc.GetTradePsets(uuid.New().String())
+	require.EqualError(t, err, application.ErrPsetLogDisabled.Error())
+
+	dir := t.TempDir()
+	psetLog, err := application.NewFilePsetLog(dir, 2, false)
+	require.NoError(t, err)
+	tradeSvc.SetPsetLog(psetLog)
+
+	markets, err := tradeSvc.GetTradableMarkets(ctx)
+	require.NoError(t, err)
+	market := markets[0].Market
+	marketOrder(t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset)
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	tradeID := strings.TrimSuffix(files[0].Name(), ".json")
+
+	psets, err := tradeSvc.GetTradePsets(tradeID)
+	require.NoError(t, err)
+	require.Len(t, psets, 3)
+	for i, swap := range []string{
+		application.SwapPsetRequest,
+		application.SwapPsetAccept,
+		application.SwapPsetComplete,
+	} {
+		require.Equal(t, swap, psets[i].Swap)
+		require.NotEmpty(t, psets[i].PsetBase64)
+	}
+	for _, key := range psets[0].InputBlindingKeys {
+		require.Equal(t, "redacted", key)
+	}
+
+	t.Run("rotation", func(t *testing.T) {
+		dir := t.TempDir()
+		psetLog, err := application.NewFilePsetLog(dir, 2, true)
+		require.NoError(t, err)
+
+		pset := application.SwapPset{
+			Swap:              application.SwapPsetRequest,
+			PsetBase64:        randomBase64(),
+			InputBlindingKeys: map[string]string{randomHex(22): randomHex(32)},
+		}
+		tradeIDs := []string{
+			uuid.New().String(), uuid.New().String(), uuid.New().String(),
+		}
+		for _, id := range tradeIDs {
+			require.NoError(t, psetLog.Add(id, pset))
+		}
+
+		_, err = psetLog.Get(tradeIDs[0])
+		require.EqualError(t, err, application.ErrTradeNotFound.Error())
+
+		// logged trades are kept across restarts.
+		psetLog, err = application.NewFilePsetLog(dir, 2, true)
+		require.NoError(t, err)
+		for _, id := range tradeIDs[1:] {
+			psets, err := psetLog.Get(id)
+			require.NoError(t, err)
+			require.Equal(t, []application.SwapPset{pset}, psets)
+		}
+	})
+}
+
+type fixedPriceStrategy struct {
+	price application.Price
+}
+
+func (s fixedPriceStrategy) SpotPrice(_, _ uint64) (application.Price, error) {
+	return s.price, nil
+}
+
+type fixedPriceOracle struct {
+	price decimal.Decimal
+}
+
+func (o fixedPriceOracle) ReferencePrice(
+	_ application.Market,
+) (decimal.Decimal, error) {
+	return o.price, nil
 }
 
-func newTradeService(withFixedFee bool) (application.TradeService, error) {
+func TestTradeServiceShutdown(t *testing.T) {
+	repoManager, explorerSvc, bcListener, _, err :=
+		newServicesWithFundedMarket(marketFee, false)
+	require.NoError(t, err)
+
+	now := uint64(time.Now().Unix())
+	pendingTrade := domain.Trade{
+		ID:               uuid.New(),
+		MarketQuoteAsset: marketQuoteAsset,
+		Status:           domain.AcceptedStatus,
+		TxID:             randomHex(32),
+		ExpiryTime:       now + 100,
+		SwapRequest:      domain.Swap{ID: randomId(), Timestamp: now},
+		SwapAccept:       domain.Swap{ID: randomId(), Timestamp: now},
+	}
+	_, err = repoManager.TradeRepository().GetOrCreateTrade(ctx, &pendingTrade.ID)
+	require.NoError(t, err)
+	err = repoManager.TradeRepository().UpdateTrade(
+		ctx,
+		&pendingTrade.ID,
+		func(_ *domain.Trade) (*domain.Trade, error) { return &pendingTrade, nil },
+	)
+	require.NoError(t, err)
+
+	tradeSvc := buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	)
+	market := application.Market{
+		BaseAsset:  marketBaseAsset,
+		QuoteAsset: marketQuoteAsset,
+	}
+	swapRequest := newSwapRequest(
+		t, tradeSvc, market, application.TradeSell, 0.1, marketBaseAsset,
+	)
+
+	// the accepted trade is not completed before the deadline.
+	shutdownCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	err = tradeSvc.Shutdown(shutdownCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, _, _, err = tradeSvc.TradePropose(
+		ctx, market, application.TradeSell, swapRequest,
+	)
+	require.EqualError(t, err, application.ErrServiceUnavailable.Error())
+
+	go func() {
+		time.Sleep(time.Second)
+		repoManager.TradeRepository().UpdateTrade(
+			ctx,
+			&pendingTrade.ID,
+			func(trade *domain.Trade) (*domain.Trade, error) {
+				trade.Status = domain.CompletedStatus
+				return trade, nil
+			},
+		)
+	}()
+
+	shutdownCtx, cancel = context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	err = tradeSvc.Shutdown(shutdownCtx)
+	require.NoError(t, err)
+}
+
+func newTradeService(
+	feeBasisPoint int64,
+	withFixedFee bool,
+) (application.TradeService, error) {
+	repoManager, explorerSvc, bcListener, unspents, err :=
+		newServicesWithFundedMarket(feeBasisPoint, withFixedFee)
+	if err != nil {
+		return nil, err
+	}
+
+	mockedTradeManager := newMockedTradeManager()
+	mockedTradeManager.
+		On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
+		Return(&application.FillProposalResult{
+			PsetBase64:         randomBase64(),
+			SelectedUnspents:   randomSelection(unspents, mockedTradeManager.counter),
+			InputBlindingKeys:  nil,
+			OutputBlindingKeys: nil,
+		}, nil)
+
+	application.TradeManager = mockedTradeManager
+
+	return buildTradeService(
+		repoManager, explorerSvc, bcListener,
+		tradeServiceOpts{},
+	), nil
+}
+
+// tradeServiceOpts are the dependencies of the trade services built by
+// buildTradeService that tests might want to override. Those left zero are
+// either disabled or replaced by the defaults of the tests.
+type tradeServiceOpts struct {
+	webhookSvc       webhook.Service
+	tradeFeed        *application.TradeFeed
+	peerVolumes      *application.PeerVolumeCache
+	signer           application.Signer
+	maxSlippage      uint64
+	minConfirmations uint64
+	coinSelector     wallet.CoinSelector
+	dustThreshold    uint64
+	antiFeeSniping   bool
+	autoTopUpFees    bool
+	rateLimits       application.RateLimits
+}
+
+// buildTradeService returns a trade service for the given services with the
+// expiry duration and the price slippage of the tests.
+func buildTradeService(
+	repoManager ports.RepoManager,
+	explorerSvc explorer.Service,
+	bcListener application.BlockchainListener,
+	opts tradeServiceOpts,
+) application.TradeService {
+	return application.NewTradeService(
+		repoManager,
+		explorerSvc,
+		bcListener,
+		opts.webhookSvc,
+		opts.tradeFeed,
+		opts.peerVolumes,
+		opts.signer,
+		marketBaseAsset,
+		tradeExpiryDuration,
+		tradePriceSlippage,
+		opts.maxSlippage,
+		opts.minConfirmations,
+		opts.coinSelector,
+		opts.dustThreshold,
+		opts.antiFeeSniping,
+		opts.autoTopUpFees,
+		opts.rateLimits,
+		regtest,
+	)
+}
+
+// newServicesWithFundedMarket returns a repo manager with an unlocked wallet,
+// a funded fee account and a funded and tradable market, along with the
+// unspents of such accounts.
+func newServicesWithFundedMarket(
+	feeBasisPoint int64,
+	withFixedFee bool,
+) (
+	ports.RepoManager,
+	explorer.Service,
+	application.BlockchainListener,
+	[]domain.Unspent,
+	error,
+) {
 	repoManager, explorerSvc, bcListener := newServices()
 
 	v, err := repoManager.VaultRepository().GetOrCreateVault(
 		ctx, mnemonic, passphrase, regtest,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	unspents := make([]domain.Unspent, 0)
@@ -213,11 +2889,11 @@ func newTradeService(withFixedFee bool) (application.TradeService, error) {
 		ctx,
 		&domain.Market{
 			AccountIndex: domain.MarketAccountStart,
-			Fee:          marketFee,
+			Fee:          feeBasisPoint,
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	if err := repoManager.MarketRepository().UpdateMarket(ctx, mkt.AccountIndex, func(m *domain.Market) (*domain.Market, error) {
@@ -228,30 +2904,18 @@ func newTradeService(withFixedFee bool) (application.TradeService, error) {
 		m.MakeTradable()
 		return m, nil
 	}); err != nil {
-		return nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	if err := repoManager.VaultRepository().UpdateVault(ctx, func(_ *domain.Vault) (*domain.Vault, error) {
 		return v, nil
 	}); err != nil {
-		return nil, err
+		return nil, nil, nil, nil, err
 	}
 	if err := repoManager.UnspentRepository().AddUnspents(ctx, unspents); err != nil {
-		return nil, err
+		return nil, nil, nil, nil, err
 	}
 
-	mockedTradeManager := newMockedTradeManager()
-	mockedTradeManager.
-		On("FillProposal", mock.AnythingOfType("application.FillProposalOpts")).
-		Return(&application.FillProposalResult{
-			PsetBase64:         randomBase64(),
-			SelectedUnspents:   randomSelection(unspents, mockedTradeManager.counter),
-			InputBlindingKeys:  nil,
-			OutputBlindingKeys: nil,
-		}, nil)
-
-	application.TradeManager = mockedTradeManager
-
 	explorerSvc.(*mockExplorer).
 		On("GetTransactionStatus", mock.AnythingOfType("string")).
 		Return(map[string]interface{}{
@@ -266,15 +2930,7 @@ func newTradeService(withFixedFee bool) (application.TradeService, error) {
 		On("BroadcastTransaction", mock.AnythingOfType("string")).
 		Return(randomHex(32), nil)
 
-	return application.NewTradeService(
-		repoManager,
-		explorerSvc,
-		bcListener,
-		marketBaseAsset,
-		tradeExpiryDuration,
-		tradePriceSlippage,
-		regtest,
-	), nil
+	return repoManager, explorerSvc, bcListener, unspents, nil
 }
 
 func marketOrder(
@@ -285,6 +2941,40 @@ func marketOrder(
 	btcAmount float64,
 	asset string,
 ) {
+	swapRequest := newSwapRequest(t, tradeSvc, market, tradeType, btcAmount, asset)
+
+	swapAccept, swapFail, expiryTimestamp, err := tradeSvc.TradePropose(ctx, market, tradeType, swapRequest)
+	require.NoError(t, err)
+	require.Nil(t, swapFail)
+	require.NotNil(t, swapAccept)
+	require.True(t, time.Now().Before(time.Unix(int64(expiryTimestamp), 0)))
+
+	var swapCompletePtr *domain.SwapComplete
+	var swapComplete domain.SwapComplete
+	swapComplete = &pbswap.SwapComplete{
+		Id:          randomId(),
+		AcceptId:    swapAccept.GetId(),
+		Transaction: swapAccept.GetTransaction(),
+	}
+	swapCompletePtr = &swapComplete
+
+	time.Sleep(200 * time.Millisecond)
+	_, _, err = tradeSvc.TradeComplete(ctx, swapCompletePtr, nil)
+	require.NoError(t, err)
+
+	time.Sleep(200 * time.Millisecond)
+}
+
+// newSwapRequest returns a swap request for a trade of the given amount at the
+// current price of the market.
+func newSwapRequest(
+	t *testing.T,
+	tradeSvc application.TradeService,
+	market application.Market,
+	tradeType int,
+	btcAmount float64,
+	asset string,
+) domain.SwapRequest {
 	amount := uint64(btcAmount * math.Pow10(8))
 	preview, err := tradeSvc.GetMarketPrice(ctx, market, tradeType, amount, asset)
 	require.NoError(t, err)
@@ -308,20 +2998,22 @@ func marketOrder(
 		amountToSend, amountToReceive = amountToReceive, amountToSend
 	}
 
+	// the utxo of the trader is unconfidential so that the swap tx is a valid
+	// pset whose outputs can be checked by the market.
 	unspents := []explorer.Utxo{
 		esplora.NewWitnessUtxo(
 			randomHex(32),
 			uint32(randomIntInRange(0, 15)),
 			amountToSend,
 			assetToSend,
-			randomValueCommitment(),
-			randomAssetCommitment(),
-			randomBytes(32),
-			randomBytes(32),
+			"",
+			"",
+			nil,
+			nil,
 			script,
-			randomBytes(32),
-			randomBytes(100),
-			randomBytes(100),
+			nil,
+			nil,
+			nil,
 			true,
 		),
 	}
@@ -339,7 +3031,7 @@ func marketOrder(
 		hex.EncodeToString(script): wallet.BlindingKey(),
 	}
 
-	swapRequest := &pbswap.SwapRequest{
+	return &pbswap.SwapRequest{
 		Id:                randomId(),
 		AssetP:            assetToSend,
 		AmountP:           amountToSend,
@@ -349,27 +3041,6 @@ func marketOrder(
 		InputBlindingKey:  blindingKeyMap,
 		OutputBlindingKey: blindingKeyMap,
 	}
-
-	swapAccept, swapFail, expiryTimestamp, err := tradeSvc.TradePropose(ctx, market, tradeType, swapRequest)
-	require.NoError(t, err)
-	require.Nil(t, swapFail)
-	require.NotNil(t, swapAccept)
-	require.True(t, time.Now().Before(time.Unix(int64(expiryTimestamp), 0)))
-
-	var swapCompletePtr *domain.SwapComplete
-	var swapComplete domain.SwapComplete
-	swapComplete = &pbswap.SwapComplete{
-		Id:          randomId(),
-		AcceptId:    swapAccept.GetId(),
-		Transaction: swapAccept.GetTransaction(),
-	}
-	swapCompletePtr = &swapComplete
-
-	time.Sleep(200 * time.Millisecond)
-	_, _, err = tradeSvc.TradeComplete(ctx, swapCompletePtr, nil)
-	require.NoError(t, err)
-
-	time.Sleep(200 * time.Millisecond)
 }
 
 func randomBase64() string {
@@ -1,10 +1,13 @@
 package application
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/shopspring/decimal"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/pkg/bufferutil"
@@ -22,6 +25,16 @@ type SwapInfo struct {
 	AssetP  string
 	AmountR uint64
 	AssetR  string
+	// FeeAsset is the asset the market fee is charged on, either AssetP or
+	// AssetR.
+	FeeAsset string
+	// The hex encoded value and asset commitments of the outputs of the
+	// settlement tx receiving the proposer's and responder's amounts.
+	// They are set only for settled trades.
+	ValueCommitmentP string
+	AssetCommitmentP string
+	ValueCommitmentR string
+	AssetCommitmentR string
 }
 
 type SwapFailInfo struct {
@@ -45,14 +58,97 @@ type TradeInfo struct {
 	ExpiryTimeUnix   uint64
 }
 
+// TradeNotification is the JSON payload delivered to the configured webhook
+// endpoint whenever a trade changes status.
+type TradeNotification struct {
+	ID               string          `json:"id"`
+	Status           string          `json:"status"`
+	StatusCode       int             `json:"status_code"`
+	Failed           bool            `json:"failed"`
+	FailureCode      int             `json:"failure_code,omitempty"`
+	FailureMessage   string          `json:"failure_message,omitempty"`
+	BaseAsset        string          `json:"base_asset"`
+	QuoteAsset       string          `json:"quote_asset"`
+	FeeBasisPoint    int64           `json:"fee_basis_point"`
+	BasePrice        decimal.Decimal `json:"base_price"`
+	QuotePrice       decimal.Decimal `json:"quote_price"`
+	AmountP          uint64          `json:"amount_p"`
+	AssetP           string          `json:"asset_p"`
+	AmountR          uint64          `json:"amount_r"`
+	AssetR           string          `json:"asset_r"`
+	TxURL            string          `json:"tx_url,omitempty"`
+	RequestTimeUnix  uint64          `json:"request_time_unix"`
+	AcceptTimeUnix   uint64          `json:"accept_time_unix"`
+	CompleteTimeUnix uint64          `json:"complete_time_unix"`
+	SettleTimeUnix   uint64          `json:"settle_time_unix"`
+	ExpiryTimeUnix   uint64          `json:"expiry_time_unix"`
+}
+
+var statusNames = map[int]string{
+	domain.Undefined: "UNDEFINED",
+	domain.Proposal:  "PROPOSAL",
+	domain.Accepted:  "ACCEPTED",
+	domain.Completed: "COMPLETED",
+	domain.Settled:   "SETTLED",
+	domain.Expired:   "EXPIRED",
+	domain.Canceled:  "CANCELED",
+}
+
+func newTradeNotification(info TradeInfo) TradeNotification {
+	return TradeNotification{
+		ID:               info.ID,
+		Status:           statusNames[info.Status.Code],
+		StatusCode:       info.Status.Code,
+		Failed:           info.Status.Failed,
+		FailureCode:      info.SwapFailInfo.Code,
+		FailureMessage:   info.SwapFailInfo.Message,
+		BaseAsset:        info.MarketWithFee.BaseAsset,
+		QuoteAsset:       info.MarketWithFee.QuoteAsset,
+		FeeBasisPoint:    info.MarketWithFee.BasisPoint,
+		BasePrice:        info.Price.BasePrice,
+		QuotePrice:       info.Price.QuotePrice,
+		AmountP:          info.SwapInfo.AmountP,
+		AssetP:           info.SwapInfo.AssetP,
+		AmountR:          info.SwapInfo.AmountR,
+		AssetR:           info.SwapInfo.AssetR,
+		TxURL:            info.TxURL,
+		RequestTimeUnix:  info.RequestTimeUnix,
+		AcceptTimeUnix:   info.AcceptTimeUnix,
+		CompleteTimeUnix: info.CompleteTimeUnix,
+		SettleTimeUnix:   info.SettleTimeUnix,
+		ExpiryTimeUnix:   info.ExpiryTimeUnix,
+	}
+}
+
 // MarketInfo is the data struct returned by ListMarket RPC.
 type MarketInfo struct {
-	AccountIndex uint64
-	Market       Market
-	Fee          Fee
-	Tradable     bool
-	StrategyType int
-	Price        domain.Prices
+	AccountIndex   uint64
+	Market         Market
+	Fee            Fee
+	Tradable       bool
+	Paused         bool
+	StrategyType   int
+	Price          domain.Prices
+	PricePrecision uint
+	// SettlementTimeout is in seconds, zero if trades have no deadline.
+	SettlementTimeout uint64
+	// FeeTiers are the fees charged to peers by volume, sorted by min volume.
+	FeeTiers []FeeTier
+	// BaseAssetInfo and QuoteAssetInfo are the metadata of the assets of the
+	// market, nil if they couldn't be resolved.
+	BaseAssetInfo  *AssetInfo
+	QuoteAssetInfo *AssetInfo
+}
+
+// AssetInfo is the metadata of an asset resolved from the asset registry.
+// Registered is false for assets not found in the registry, whose amounts
+// are expected to be displayed with the default precision.
+type AssetInfo struct {
+	AssetHash  string
+	Ticker     string
+	Name       string
+	Precision  int
+	Registered bool
 }
 
 type Market struct {
@@ -66,6 +162,16 @@ type Fee struct {
 	FixedQuoteFee int64
 }
 
+// FeeChange is a change of the basis point fee of a market, effective since
+// EffectiveAt and requested at CreatedAt, both expressed as Unix seconds.
+// Pending changes are not yet effective.
+type FeeChange struct {
+	BasisPoint  int64
+	EffectiveAt uint64
+	CreatedAt   uint64
+	Pending     bool
+}
+
 type MarketWithFee struct {
 	Market
 	Fee
@@ -89,16 +195,108 @@ type PriceWithFee struct {
 	Balance Balance
 }
 
+type MarketTradeLimits struct {
+	Market
+	MinTradeAmount uint64
+	MaxTradeAmount uint64
+}
+
+// FeeTier is the basis point fee charged to the peers that traded at least
+// MinVolume, in base asset, with a market.
+type FeeTier struct {
+	MinVolume  uint64
+	BasisPoint int64
+}
+
+type MarketFeeTiers struct {
+	Market
+	Tiers []FeeTier
+}
+
+type MarketPricePrecision struct {
+	Market
+	Precision uint
+}
+
+type MarketSettlementTimeout struct {
+	Market
+	// Timeout is expressed in seconds, zero disables it.
+	Timeout uint64
+}
+
 type MarketStrategy struct {
 	Market
 	Strategy domain.StrategyType
 }
 
+// StrategyParams are the parameters of the strategy a live market is switched
+// to with SetStrategy. Price is the starting price of a pluggable strategy,
+// until a new one is set, and must be left zero for a balanced one.
+type StrategyParams struct {
+	Price Price
+}
+
 type Balance struct {
 	BaseAmount  uint64
 	QuoteAmount uint64
 }
 
+// BalanceSnapshot is the balance of a market at a certain point in time.
+type BalanceSnapshot struct {
+	BaseAmount  uint64
+	QuoteAmount uint64
+	Timestamp   uint64
+}
+
+// VolumeReport is the volume traded by a market over a time window.
+type VolumeReport struct {
+	BaseVolume  uint64
+	QuoteVolume uint64
+	// TradeCount is the number of trades settled within the window.
+	TradeCount int
+}
+
+// RateLimits are the max number of swap proposals accepted per minute for
+// every market and for every peer. Zero values disable the related limit.
+type RateLimits struct {
+	ProposalsPerMarket int
+	ProposalsPerPeer   int
+}
+
+// RateLimiterState is the number of tokens left in the bucket of a market or
+// of a peer, out of its capacity. A proposal costs a token.
+type RateLimiterState struct {
+	// Scope is either RateLimitScopeMarket or RateLimitScopePeer.
+	Scope string
+	// Key is the quote asset of the market or the identity of the peer.
+	Key string
+	// Tokens are fractional since buckets are refilled continuously.
+	Tokens   float64
+	Capacity int
+}
+
+// Imbalance is how far the balances of a market deviate from the target ratio
+// of its strategy, valued at spot price. BaseWeight is the share of the value
+// held in base asset while Deviation is its distance from the target weight,
+// positive if the base asset is overweight.
+type Imbalance struct {
+	Balance    Balance
+	Price      Price
+	BaseWeight decimal.Decimal
+	Deviation  decimal.Decimal
+	Rebalance  Rebalance
+}
+
+// Rebalance is the trade, made at spot price, that restores the target ratio
+// of a market, which gives away SellAmount of SellAsset in exchange for
+// BuyAmount of BuyAsset. Amounts are zero if the market is balanced.
+type Rebalance struct {
+	SellAsset  string
+	SellAmount uint64
+	BuyAsset   string
+	BuyAmount  uint64
+}
+
 type BalanceWithFee struct {
 	Balance Balance
 	Fee     Fee
@@ -110,17 +308,58 @@ type BalanceInfo struct {
 	UnconfirmedBalance uint64
 }
 
+// Build is the version, git commit and date of a build of the daemon.
+type Build struct {
+	Version string
+	Commit  string
+	Date    string
+}
+
+type DaemonInfo struct {
+	Build
+	Network       string
+	StartTimeUnix uint64
+	Uptime        time.Duration
+	NumOfMarkets  int
+}
+
+// FeeRateFloor is the min fee rate, in millisatoshi per byte, accepted for
+// withdrawals. Requests with a lower rate are rejected, unless ClampUp is set,
+// in which case they're made at the min rate. Requests with no rate always use
+// the min one.
+type FeeRateFloor struct {
+	MinMilliSatPerByte int
+	ClampUp            bool
+}
+
 type WithdrawMarketReq struct {
 	Market
 	BalanceToWithdraw Balance
-	MillisatPerByte   int64
-	Address           string
-	Push              bool
+	// DisplayBalanceToWithdraw is the optional alternative to BalanceToWithdraw
+	// expressed in the display units of the assets.
+	DisplayBalanceToWithdraw *DisplayBalance
+	// MinReserve is the optional minimum balance that must be left in the
+	// market after the withdrawal.
+	MinReserve      Balance
+	MillisatPerByte int64
+	Address         string
+	Push            bool
+}
+
+// DisplayBalance is a balance expressed in the display units of the assets,
+// that are their amounts in satoshi divided by 10^precision.
+type DisplayBalance struct {
+	BaseAmount  decimal.Decimal
+	QuoteAmount decimal.Decimal
 }
 
 type ReportMarketFee struct {
 	CollectedFees              []FeeInfo
 	TotalCollectedFeesPerAsset map[string]int64
+	// TotalCollectedFeesFiat is the fiat value of the collected fees grouped
+	// by asset. It's nil if no fiat price source is configured.
+	TotalCollectedFeesFiat map[string]decimal.Decimal
+	FiatCurrency           string
 }
 
 type AddressAndBlindingKey struct {
@@ -129,11 +368,36 @@ type AddressAndBlindingKey struct {
 }
 
 type FeeInfo struct {
-	TradeID     string
-	BasisPoint  int64
-	Asset       string
-	Amount      uint64
-	MarketPrice decimal.Decimal
+	TradeID    string
+	BasisPoint int64
+	Asset      string
+	// Amount is the total fee, the sum of BasisPointAmount and FixedAmount.
+	Amount           uint64
+	BasisPointAmount uint64
+	FixedAmount      uint64
+	MarketPrice      decimal.Decimal
+	// FiatValue is the value of the fee at the time it was collected. It's nil
+	// if no fiat price source is configured.
+	FiatValue *decimal.Decimal
+}
+
+const (
+	// FeeMissingFromLedger is the reason of the discrepancy of a settled trade
+	// whose fee is not recorded in the ledger.
+	FeeMissingFromLedger = "fee of settled trade is missing from the ledger"
+	// FeeWithoutSettledTrade is the reason of the discrepancy of a fee recorded
+	// in the ledger for a trade that is not settled.
+	FeeWithoutSettledTrade = "fee is recorded for a trade that is not settled"
+)
+
+// FeeLedgerDiscrepancy is an inconsistency between the fee ledger and the
+// settled trades.
+type FeeLedgerDiscrepancy struct {
+	TradeID          string
+	MarketQuoteAsset string
+	Reason           string
+	// Repaired is true if the fee missing from the ledger has been recorded.
+	Repaired bool
 }
 
 type TxOutpoint struct {
@@ -141,16 +405,141 @@ type TxOutpoint struct {
 	Index int
 }
 
+// ReserveProof proves the ownership of the unspents of every account of the
+// daemon at the given time, expressed as Unix seconds.
+type ReserveProof struct {
+	Nonce     string
+	Timestamp uint64
+	Accounts  []AccountReserves
+}
+
+type AccountReserves struct {
+	AccountIndex int
+	Outpoints    []OutpointReserve
+}
+
+// OutpointReserve is an owned unspent along with its unblinded asset and
+// amount, revealed by the blinders, and a signature of its proof of reserve
+// message made with the key that controls it. All byte fields are hex encoded.
+type OutpointReserve struct {
+	TxID          string
+	VOut          uint32
+	Asset         string
+	Amount        uint64
+	AssetBlinder  string
+	AmountBlinder string
+	Script        string
+	PubKey        string
+	Signature     string
+}
+
+// TradeAuditRecord is the provenance of the inputs and outputs of the
+// transaction that settled a trade.
+type TradeAuditRecord struct {
+	TradeID            string
+	TxID               string
+	SettleTimeUnix     uint64
+	MarketInputs       []TradeAuditOutpoint
+	FeeInputs          []TradeAuditOutpoint
+	MarketOutput       *TradeAuditOutpoint
+	ChangeOutputs      []TradeAuditOutpoint
+	CounterpartyOutput *TradeAuditOutpoint
+	CreatedAt          uint64
+}
+
+// TradeAuditOutpoint is an input or output of a trade's transaction with its
+// unblinded asset and amount. AccountIndex is -1 if not owned by the daemon.
+type TradeAuditOutpoint struct {
+	TxID         string
+	VOut         uint32
+	AccountIndex int
+	Asset        string
+	Amount       uint64
+	Script       string
+}
+
+// Page identifies a page of a paginated list by its zero-based index and
+// its size.
+type Page struct {
+	Number int
+	Size   int
+}
+
 type UtxoInfoList struct {
 	Unspents []UtxoInfo
 	Spents   []UtxoInfo
 	Locks    []UtxoInfo
 }
 
+func (l *UtxoInfoList) add(unspent domain.Unspent) {
+	if unspent.Spent {
+		l.Spents = appendUtxoInfo(l.Spents, unspent)
+	} else if unspent.Locked {
+		l.Locks = appendUtxoInfo(l.Locks, unspent)
+	} else {
+		l.Unspents = appendUtxoInfo(l.Unspents, unspent)
+	}
+}
+
 type UtxoInfo struct {
 	Outpoint *TxOutpoint
 	Value    uint64
 	Asset    string
+	// TradeID is the id of the trade holding the lock of a locked utxo.
+	TradeID string
+	// LockTimestamp is the time the trade holding the lock was accepted. It's
+	// set only by ListLockedUtxos, and left zero if such trade is not found.
+	LockTimestamp uint64
+	// Excluded tells whether the utxo is skipped when selecting coins for
+	// trades and withdrawals.
+	Excluded bool
+}
+
+// PendingDeposit is an unconfirmed utxo received by an account. It's no
+// longer pending if its tx has been confirmed since the utxo set was synced,
+// in which case Confirmations is the depth of its block.
+type PendingDeposit struct {
+	UtxoInfo
+	Address       string
+	Pending       bool
+	Confirmations uint64
+}
+
+// UtxoFilter narrows the list of utxos returned by ListUtxosForAccount. Zero
+// values don't filter anything.
+type UtxoFilter struct {
+	// Asset, if not empty, restricts the list to the utxos of this asset.
+	Asset string
+	// MinValue is the lowest value of the utxos returned.
+	MinValue uint64
+	// ConfirmedOnly excludes the unconfirmed utxos.
+	ConfirmedOnly bool
+}
+
+func (f UtxoFilter) match(unspent domain.Unspent) bool {
+	if f.Asset != "" && f.Asset != unspent.AssetHash {
+		return false
+	}
+	if unspent.Value < f.MinValue {
+		return false
+	}
+	return !f.ConfirmedOnly || unspent.Confirmed
+}
+
+// RescanAccountResult reports how the utxo set of an account changed after
+// reconciling it with the blockchain.
+type RescanAccountResult struct {
+	AddedUnspents int
+	SpentUnspents int
+}
+
+// RescanDiff reports how the utxo set of an account would change after
+// reconciling it with the blockchain.
+type RescanDiff struct {
+	// UnspentsToAdd are found on-chain but missing from the utxo set.
+	UnspentsToAdd []UtxoInfo
+	// UnspentsToSpend are stored as unspent but no longer found on-chain.
+	UnspentsToSpend []UtxoInfo
 }
 
 type Unspents []domain.Unspent
@@ -172,6 +561,7 @@ type Blinder interface {
 
 type FillProposalOpts struct {
 	Mnemonic      []string
+	WalletOpts    domain.WalletOptions
 	SwapRequest   domain.SwapRequest
 	MarketUtxos   []explorer.Utxo
 	FeeUtxos      []explorer.Utxo
@@ -181,6 +571,20 @@ type FillProposalOpts struct {
 	ChangeInfo    domain.AddressInfo
 	FeeChangeInfo domain.AddressInfo
 	Network       *network.Network
+	CoinSelector  wallet.CoinSelector
+	// DustThreshold is the min amount of the change outputs, whenever possible.
+	DustThreshold uint64
+	// AutoTopUpFees makes the market pay the network fees whenever the fee
+	// account can't cover them, out of the L-BTC it receives from the trade in
+	// exchange for its quote asset. This is possible only for markets whose
+	// base asset is L-BTC, and only for trades where they buy it.
+	AutoTopUpFees   bool
+	MarketBaseAsset string
+	// Signer signs the market and fee inputs of the blinded PSET. If not
+	// defined, they're signed with the keys derived from Mnemonic.
+	Signer Signer
+	// LockTime, if not zero, is the block height the transaction is locked to.
+	LockTime uint32
 }
 
 type FillProposalResult struct {
@@ -188,9 +592,15 @@ type FillProposalResult struct {
 	SelectedUnspents   []explorer.Utxo
 	InputBlindingKeys  map[string][]byte
 	OutputBlindingKeys map[string][]byte
+	// FeesPaidByMarket is the amount of network fees paid by the market
+	// instead of the fee account, if any
+	FeesPaidByMarket uint64
 }
 type TradeHandler interface {
 	FillProposal(FillProposalOpts) (*FillProposalResult, error)
+	// PreviewProposal returns what FillProposal would for the same opts,
+	// without any side effect. Useful to inspect the trade construction.
+	PreviewProposal(FillProposalOpts) (*FillProposalResult, error)
 }
 
 type TransactionHandler interface {
@@ -226,6 +636,10 @@ func (t tradeManager) FillProposal(opts FillProposalOpts) (*FillProposalResult,
 	return fillProposal(opts)
 }
 
+func (t tradeManager) PreviewProposal(opts FillProposalOpts) (*FillProposalResult, error) {
+	return previewProposal(opts)
+}
+
 type transactionManager struct{}
 
 func (t transactionManager) ExtractUnspents(
@@ -242,19 +656,25 @@ func (t transactionManager) ExtractUnspents(
 	unspentsToSpend := make([]domain.UnspentKey, 0)
 
 	for _, in := range tx.Inputs {
-		// our unspents are native-segiwt only
-		if len(in.Witness) > 0 {
-			pubkey, _ := btcec.ParsePubKey(in.Witness[1], btcec.S256())
-			p := payment.FromPublicKey(pubkey, network, nil)
-
-			script := hex.EncodeToString(p.WitnessScript)
-			if _, ok := infoByScript[script]; ok {
-				unspentsToSpend = append(unspentsToSpend, domain.UnspentKey{
-					TxID: bufferutil.TxIDFromBytes(in.Hash),
-					VOut: in.Index,
-				})
+		script, ok := witnessScriptForInput(in, network)
+		if !ok {
+			continue
+		}
+		// nested P2WPKH inputs spend the P2SH script wrapping their redeem
+		// one, that's how the nested segwit addresses of the accounts are
+		// identified.
+		if len(in.Script) > 0 && isP2WPKHScript(script) {
+			nestedScript := wallet.NestedWitnessScript(script)
+			if _, ok := infoByScript[hex.EncodeToString(nestedScript)]; ok {
+				script = nestedScript
 			}
 		}
+		if _, ok := infoByScript[hex.EncodeToString(script)]; ok {
+			unspentsToSpend = append(unspentsToSpend, domain.UnspentKey{
+				TxID: bufferutil.TxIDFromBytes(in.Hash),
+				VOut: in.Index,
+			})
+		}
 	}
 
 	for i, out := range tx.Outputs {
@@ -285,6 +705,70 @@ func (t transactionManager) ExtractUnspents(
 	return unspentsToAdd, unspentsToSpend, nil
 }
 
+// witnessScriptForInput returns the script identifying the account spent by
+// the given input, either native or nested in a P2SH script:
+//   - for P2WPKH inputs, it's the P2WPKH witness script of the pubkey;
+//   - for multisig P2WSH inputs, whose last witness item is the multisig
+//     witness script, it's the P2WSH script of the latter.
+//
+// In the nested case the scriptSig pushes the redeem script, that is the
+// script itself. Inputs of any other type are not supported.
+// The vault doesn't derive multisig accounts, so multisig inputs are matched
+// only if their P2WSH scripts are explicitly part of the given info.
+func witnessScriptForInput(
+	in *transaction.TxInput,
+	network *network.Network,
+) ([]byte, bool) {
+	var multisigScript []byte
+	if len(in.Witness) > 2 {
+		witnessScript := in.Witness[len(in.Witness)-1]
+		if wallet.IsMultisigScript(witnessScript) {
+			script, err := wallet.MultisigOutputScript(witnessScript)
+			if err != nil {
+				return nil, false
+			}
+			multisigScript = script
+		}
+	}
+
+	if len(in.Script) > 0 {
+		pushes, err := txscript.PushedData(in.Script)
+		if err != nil || len(pushes) != 1 {
+			return nil, false
+		}
+		redeemScript := pushes[0]
+		if multisigScript != nil {
+			if !bytes.Equal(redeemScript, multisigScript) {
+				return nil, false
+			}
+			return redeemScript, true
+		}
+		if !isP2WPKHScript(redeemScript) {
+			return nil, false
+		}
+		return redeemScript, true
+	}
+
+	if multisigScript != nil {
+		return multisigScript, true
+	}
+
+	if len(in.Witness) != 2 {
+		return nil, false
+	}
+	pubkey, err := btcec.ParsePubKey(in.Witness[1], btcec.S256())
+	if err != nil {
+		return nil, false
+	}
+	return payment.FromPublicKey(pubkey, network, nil).WitnessScript, true
+}
+
+func isP2WPKHScript(script []byte) bool {
+	return len(script) == 22 &&
+		script[0] == txscript.OP_0 &&
+		script[1] == txscript.OP_DATA_20
+}
+
 func (t transactionManager) ExtractBlindingData(
 	psetBase64 string,
 	inBlindingKeys, outBlindingKeys map[string][]byte,
@@ -0,0 +1,176 @@
+package application_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/application"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/pkg/bufferutil"
+	"github.com/tdex-network/tdex-daemon/pkg/wallet"
+	"github.com/vulpemventures/go-elements/payment"
+	"github.com/vulpemventures/go-elements/transaction"
+)
+
+// the global managers are replaced with mocks in tests, therefore a reference
+// to the real ones is kept here.
+var (
+	transactionManager = application.TransactionManager
+	tradeManager       = application.TradeManager
+)
+
+func TestExtractUnspentsWithMixedInputs(t *testing.T) {
+	ourKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	otherKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	ourScript := payment.FromPublicKey(ourKey.PubKey(), regtest, nil).WitnessScript
+	infoByScript := map[string]domain.AddressInfo{
+		hex.EncodeToString(ourScript): {AccountIndex: domain.FeeAccount},
+	}
+
+	tx := transaction.NewTx(2)
+	nativeIn := newTestInput(0)
+	nativeIn.Witness = transaction.TxWitness{
+		make([]byte, 72), ourKey.PubKey().SerializeCompressed(),
+	}
+	nestedIn := newTestInput(1)
+	nestedIn.Witness = transaction.TxWitness{
+		make([]byte, 72), ourKey.PubKey().SerializeCompressed(),
+	}
+	nestedIn.Script = newP2SHScriptSig(t, ourScript)
+	otherScript := payment.FromPublicKey(otherKey.PubKey(), regtest, nil).WitnessScript
+	otherIn := newTestInput(2)
+	otherIn.Witness = transaction.TxWitness{
+		make([]byte, 72), otherKey.PubKey().SerializeCompressed(),
+	}
+	otherIn.Script = newP2SHScriptSig(t, otherScript)
+	for _, in := range []*transaction.TxInput{nativeIn, nestedIn, otherIn} {
+		tx.AddInput(in)
+	}
+
+	txHex, err := tx.ToHex()
+	require.NoError(t, err)
+
+	unspentsToAdd, unspentsToSpend, err := transactionManager.ExtractUnspents(
+		txHex,
+		infoByScript,
+		regtest,
+	)
+	require.NoError(t, err)
+	require.Len(t, unspentsToAdd, 0)
+	require.Equal(t, []domain.UnspentKey{
+		{TxID: bufferutil.TxIDFromBytes(nativeIn.Hash), VOut: nativeIn.Index},
+		{TxID: bufferutil.TxIDFromBytes(nestedIn.Hash), VOut: nestedIn.Index},
+	}, unspentsToSpend)
+}
+
+func TestExtractUnspentsWithNestedAccountScript(t *testing.T) {
+	ourKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	redeemScript := payment.FromPublicKey(ourKey.PubKey(), regtest, nil).WitnessScript
+	ourScript := wallet.NestedWitnessScript(redeemScript)
+	infoByScript := map[string]domain.AddressInfo{
+		hex.EncodeToString(ourScript): {AccountIndex: domain.MarketAccountStart},
+	}
+
+	tx := transaction.NewTx(2)
+	nativeIn := newTestInput(0)
+	nativeIn.Witness = transaction.TxWitness{
+		make([]byte, 72), ourKey.PubKey().SerializeCompressed(),
+	}
+	nestedIn := newTestInput(1)
+	nestedIn.Witness = transaction.TxWitness{
+		make([]byte, 72), ourKey.PubKey().SerializeCompressed(),
+	}
+	nestedIn.Script = newP2SHScriptSig(t, redeemScript)
+	for _, in := range []*transaction.TxInput{nativeIn, nestedIn} {
+		tx.AddInput(in)
+	}
+
+	txHex, err := tx.ToHex()
+	require.NoError(t, err)
+
+	_, unspentsToSpend, err := transactionManager.ExtractUnspents(
+		txHex,
+		infoByScript,
+		regtest,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []domain.UnspentKey{
+		{TxID: bufferutil.TxIDFromBytes(nestedIn.Hash), VOut: nestedIn.Index},
+	}, unspentsToSpend)
+}
+
+func TestExtractUnspentsWithMultisigInputs(t *testing.T) {
+	pubkeys := make([][]byte, 0, 3)
+	for i := 0; i < 3; i++ {
+		key, err := btcec.NewPrivateKey(btcec.S256())
+		require.NoError(t, err)
+		pubkeys = append(pubkeys, key.PubKey().SerializeCompressed())
+	}
+	witnessScript, err := wallet.MultisigScript(wallet.MultisigScriptOpts{
+		Threshold: 2,
+		PubKeys:   pubkeys,
+	})
+	require.NoError(t, err)
+	ourScript, err := wallet.MultisigOutputScript(witnessScript)
+	require.NoError(t, err)
+	infoByScript := map[string]domain.AddressInfo{
+		hex.EncodeToString(ourScript): {AccountIndex: domain.MarketAccountStart},
+	}
+	otherWitnessScript, err := wallet.MultisigScript(wallet.MultisigScriptOpts{
+		Threshold: 1,
+		PubKeys:   pubkeys,
+	})
+	require.NoError(t, err)
+
+	tx := transaction.NewTx(2)
+	nativeIn := newTestInput(0)
+	nativeIn.Witness = transaction.TxWitness{
+		nil, make([]byte, 72), make([]byte, 72), witnessScript,
+	}
+	nestedIn := newTestInput(1)
+	nestedIn.Witness = transaction.TxWitness{
+		nil, make([]byte, 72), make([]byte, 72), witnessScript,
+	}
+	nestedIn.Script = newP2SHScriptSig(t, ourScript)
+	otherIn := newTestInput(2)
+	otherIn.Witness = transaction.TxWitness{
+		nil, make([]byte, 72), otherWitnessScript,
+	}
+	for _, in := range []*transaction.TxInput{nativeIn, nestedIn, otherIn} {
+		tx.AddInput(in)
+	}
+
+	txHex, err := tx.ToHex()
+	require.NoError(t, err)
+
+	_, unspentsToSpend, err := transactionManager.ExtractUnspents(
+		txHex,
+		infoByScript,
+		regtest,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []domain.UnspentKey{
+		{TxID: bufferutil.TxIDFromBytes(nativeIn.Hash), VOut: nativeIn.Index},
+		{TxID: bufferutil.TxIDFromBytes(nestedIn.Hash), VOut: nestedIn.Index},
+	}, unspentsToSpend)
+}
+
+func newTestInput(index uint32) *transaction.TxInput {
+	hash := make([]byte, 32)
+	hash[0] = byte(index + 1)
+	return transaction.NewTxInput(hash, index)
+}
+
+func newP2SHScriptSig(t *testing.T, redeemScript []byte) []byte {
+	script, err := txscript.NewScriptBuilder().AddData(redeemScript).Script()
+	require.NoError(t, err)
+	return script
+}
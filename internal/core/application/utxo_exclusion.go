@@ -0,0 +1,77 @@
+package application
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+func (o *operatorService) ExcludeUtxo(
+	ctx context.Context,
+	outpoint TxOutpoint,
+) error {
+	return o.setUtxoExcluded(ctx, outpoint, true)
+}
+
+func (o *operatorService) IncludeUtxo(
+	ctx context.Context,
+	outpoint TxOutpoint,
+) error {
+	return o.setUtxoExcluded(ctx, outpoint, false)
+}
+
+// setUtxoExcluded updates the unspent with the given outpoint so that it's
+// either skipped or selected again by the coin selection. Updating an unspent
+// that is already in the wanted state is a no-op.
+func (o *operatorService) setUtxoExcluded(
+	ctx context.Context,
+	outpoint TxOutpoint,
+	excluded bool,
+) error {
+	if err := validateTxid(outpoint.Hash); err != nil {
+		return err
+	}
+	if outpoint.Index < 0 {
+		return ErrInvalidOutpoint
+	}
+
+	unspentRepo := o.repoManager.UnspentRepository()
+	key := domain.UnspentKey{TxID: outpoint.Hash, VOut: uint32(outpoint.Index)}
+	u, err := unspentRepo.GetUnspentWithKey(ctx, key)
+	if err != nil {
+		return err
+	}
+	if u == nil {
+		return ErrUtxoNotFound
+	}
+
+	keys := []domain.UnspentKey{key}
+	if excluded {
+		_, err = unspentRepo.ExcludeUnspents(ctx, keys)
+	} else {
+		_, err = unspentRepo.IncludeUnspents(ctx, keys)
+	}
+	if err != nil {
+		return err
+	}
+
+	if excluded {
+		log.Infof("utxo %s:%d excluded from coin selection", key.TxID, key.VOut)
+	} else {
+		log.Infof("utxo %s:%d included in coin selection", key.TxID, key.VOut)
+	}
+	return nil
+}
+
+// selectableUnspents returns those of the given unspents that are not
+// excluded from the coin selection.
+func selectableUnspents(unspents []domain.Unspent) []domain.Unspent {
+	selectable := make([]domain.Unspent, 0, len(unspents))
+	for _, u := range unspents {
+		if !u.IsExcluded() {
+			selectable = append(selectable, u)
+		}
+	}
+	return selectable
+}
@@ -1,9 +1,11 @@
 package application
 
 import (
+	"encoding/hex"
 	"errors"
 	"regexp"
 
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
 
@@ -33,3 +35,17 @@ func validateAssetString(asset string) error {
 
 	return nil
 }
+
+func validateTxid(txid string) error {
+	if buf, err := hex.DecodeString(txid); err != nil || len(buf) != 32 {
+		return ErrInvalidTxid
+	}
+	return nil
+}
+
+func validateTradeID(tradeID string) error {
+	if _, err := uuid.Parse(tradeID); err != nil {
+		return ErrInvalidTradeID
+	}
+	return nil
+}
@@ -17,8 +17,8 @@ import (
 	"github.com/tdex-network/tdex-daemon/pkg/explorer"
 	"github.com/tdex-network/tdex-daemon/pkg/transactionutil"
 	"github.com/tdex-network/tdex-daemon/pkg/wallet"
-	"github.com/vulpemventures/go-elements/address"
 	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/pset"
 	"github.com/vulpemventures/go-elements/transaction"
 )
 
@@ -68,12 +68,16 @@ type walletService struct {
 	repoManager        ports.RepoManager
 	explorerService    explorer.Service
 	blockchainListener BlockchainListener
+	signer             Signer
 	walletInitialized  bool
 	walletIsSyncing    bool
 	withElements       bool
 	network            *network.Network
+	walletOpts         domain.WalletOptions
 	marketFee          int64
 	marketBaseAsset    string
+	minConfirmations   uint64
+	dustThreshold      uint64
 
 	lock *sync.RWMutex
 }
@@ -82,19 +86,27 @@ func NewWalletService(
 	repoManager ports.RepoManager,
 	explorerService explorer.Service,
 	blockchainListener BlockchainListener,
+	signer Signer,
 	withElements bool,
 	net *network.Network,
+	walletOpts domain.WalletOptions,
 	marketFee int64,
 	marketBaseAsset string,
+	minConfirmations uint64,
+	dustThreshold uint64,
 ) (WalletService, error) {
 	return newWalletService(
 		repoManager,
 		explorerService,
 		blockchainListener,
+		signer,
 		withElements,
 		net,
+		walletOpts,
 		marketFee,
 		marketBaseAsset,
+		minConfirmations,
+		dustThreshold,
 	)
 }
 
@@ -102,29 +114,50 @@ func newWalletService(
 	repoManager ports.RepoManager,
 	explorerService explorer.Service,
 	blockchainListener BlockchainListener,
+	signer Signer,
 	withElements bool,
 	net *network.Network,
+	walletOpts domain.WalletOptions,
 	marketFee int64,
 	marketBaseAsset string,
+	minConfirmations uint64,
+	dustThreshold uint64,
 ) (*walletService, error) {
 	w := &walletService{
 		repoManager:        repoManager,
 		explorerService:    explorerService,
 		blockchainListener: blockchainListener,
+		signer:             signer,
 		withElements:       withElements,
 		network:            net,
+		walletOpts:         walletOpts,
 		marketFee:          marketFee,
 		marketBaseAsset:    marketBaseAsset,
+		minConfirmations:   minConfirmations,
+		dustThreshold:      dustThreshold,
 		lock:               &sync.RWMutex{},
 	}
 	// to understand if the service has an already initialized wallet we check
 	// if the inner vaultRepo is able to return a Vault without passing mnemonic
 	// and passphrase. If it does, it means it's been retrieved from storage,
 	// therefore we let the crawler to start watch all derived addresses and mark
-	// the wallet as initialized
+	// the wallet as initialized. The configured wallet options are applied to
+	// the vault, that rejects those that would make its addresses unreachable.
 	if vault, err := w.repoManager.VaultRepository().GetOrCreateVault(
 		context.Background(), nil, "", nil,
 	); err == nil {
+		if err := w.repoManager.VaultRepository().UpdateVault(
+			context.Background(),
+			func(v *domain.Vault) (*domain.Vault, error) {
+				if err := v.SetWalletOptions(walletOpts); err != nil {
+					return nil, err
+				}
+				return v, nil
+			},
+		); err != nil {
+			return nil, err
+		}
+
 		log.Info("Restoring internal wallet's utxo set. This could take a while...")
 
 		info := vault.AllDerivedAddressesInfo()
@@ -205,6 +238,11 @@ func (w *walletService) InitWallet(
 	}
 	defer vault.Lock()
 
+	if err := vault.SetWalletOptions(w.walletOpts); err != nil {
+		chErr <- fmt.Errorf("unable to set wallet options: %v", err)
+		return
+	}
+
 	if restore {
 		data := "addresses discovery"
 		if w.withElements {
@@ -382,7 +420,14 @@ func (w *walletService) GetWalletBalance(
 		return nil, err
 	}
 
-	return getBalancesByAsset(unspents), nil
+	tipHeight, err := getTipHeight(
+		explorer.WithContext(ctx, w.explorerService), w.minConfirmations,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return getBalancesByAsset(unspents, w.minConfirmations, tipHeight), nil
 }
 
 type SendToManyRequest struct {
@@ -408,7 +453,7 @@ func (w *walletService) SendToMany(
 		return nil, ErrWalletNotInitialized
 	}
 
-	outputs, outputsBlindingKeys, err := parseRequestOutputs(req.Outputs)
+	outputs, outputsBlindingKeys, err := parseRequestOutputs(req.Outputs, w.network)
 	if err != nil {
 		return nil, err
 	}
@@ -466,6 +511,7 @@ func (w *walletService) SendToMany(
 
 			_txHex, err := sendToMany(sendToManyOpts{
 				mnemonic:              mnemonic,
+				walletOpts:            v.WalletOpts,
 				unspents:              walletUnspents,
 				feeUnspents:           feeUnspents,
 				outputs:               outputs,
@@ -476,6 +522,8 @@ func (w *walletService) SendToMany(
 				feeInputPathsByScript: feeAccount.DerivationPathByScript,
 				milliSatPerByte:       int(req.MillisatPerByte),
 				network:               w.network,
+				signer:                w.signer,
+				dustThreshold:         w.dustThreshold,
 			})
 			if err != nil {
 				return nil, err
@@ -545,9 +593,7 @@ func (w *walletService) restoreVault(
 	vault *domain.Vault,
 	restore bool,
 ) (domain.AddressesInfo, map[int]domain.AddressesInfo, error) {
-	ww, _ := wallet.NewWalletFromMnemonic(wallet.NewWalletFromMnemonicOpts{
-		SigningMnemonic: mnemonic,
-	})
+	ww, _ := vault.WalletOpts.NewWallet(mnemonic)
 
 	var feeRestoreInfo, walletRestoreInfo *accountLastDerivedIndex
 	var marketsRestoreInfo []*accountLastDerivedIndex
@@ -584,22 +630,37 @@ func (w *walletService) restoreVault(
 func (w *walletService) restoreAccount(
 	ww *wallet.Wallet,
 	accountIndex int,
+) *accountLastDerivedIndex {
+	return discoverAccount(
+		ww, w.walletOpts, accountIndex, w.explorerService, w.network,
+	)
+}
+
+// discoverAccount scans both the external and internal chains of the given
+// account, stopping at the first gap of consecutive unused addresses as long as
+// the gap limit of the wallet, and returns the index of the last used address of each chain.
+// Nil is returned if none of the addresses is used.
+func discoverAccount(
+	ww *wallet.Wallet,
+	walletOpts domain.WalletOptions,
+	accountIndex int,
+	explorerSvc explorer.Service,
+	net *network.Network,
 ) *accountLastDerivedIndex {
 	lastDerivedIndex := &accountLastDerivedIndex{}
 	for chainIndex := 0; chainIndex <= 1; chainIndex++ {
 		firstUnusedAddress := -1
 		unusedAddressesCounter := 0
 		i := 0
-		for unusedAddressesCounter < 20 {
+		for unusedAddressesCounter < ww.GapLimit() {
 			ctAddress, script, _ := ww.DeriveConfidentialAddress(wallet.DeriveConfidentialAddressOpts{
 				DerivationPath: fmt.Sprintf("%d'/%d/%d", accountIndex, chainIndex, i),
-				Network:        w.network,
-			})
-			blindKey, _, _ := ww.DeriveBlindingKeyPair(wallet.DeriveBlindingKeyPairOpts{
-				Script: script,
+				Network:        net,
+				Nested:         walletOpts.IsNestedChain(chainIndex),
 			})
+			blindKey, _ := ww.BlindingKeyByScript(script)
 
-			if !isAddressFunded(ctAddress, blindKey.Serialize(), w.explorerService) {
+			if !isAddressFunded(ctAddress, blindKey, explorerSvc) {
 				if firstUnusedAddress < 0 {
 					firstUnusedAddress = i
 				}
@@ -746,6 +807,7 @@ func (w *walletService) getAllUnspentsForAccount(
 	if err != nil {
 		return nil, err
 	}
+	unspents = selectableUnspents(unspents)
 
 	utxos := make([]explorer.Utxo, 0, len(unspents))
 	for _, u := range unspents {
@@ -851,13 +913,15 @@ func (w *walletService) restoreUnspentsForAddress(
 			RangeProof:      make([]byte, 1),
 			SurjectionProof: make([]byte, 1),
 			Confirmed:       u.IsConfirmed(),
+			BlockHeight:     u.BlockHeight(),
+			BlockHash:       u.BlockHash(),
 			Address:         addr,
 		}
 	}
 	chUnspent <- unspentInfo{info: info, unspents: unspents}
 }
 
-func parseRequestOutputs(reqOutputs []TxOut) (
+func parseRequestOutputs(reqOutputs []TxOut, net *network.Network) (
 	[]*transaction.TxOutput,
 	[][]byte,
 	error,
@@ -874,7 +938,7 @@ func parseRequestOutputs(reqOutputs []TxOut) (
 		if err != nil {
 			return nil, nil, err
 		}
-		script, blindingKey, err := parseConfidentialAddress(out.Address)
+		script, blindingKey, err := parseConfidentialAddress(out.Address, net)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -886,16 +950,18 @@ func parseRequestOutputs(reqOutputs []TxOut) (
 	return outputs, blindingKeys, nil
 }
 
-func parseConfidentialAddress(addr string) ([]byte, []byte, error) {
-	script, err := address.ToOutputScript(addr)
+func parseConfidentialAddress(
+	addr string,
+	net *network.Network,
+) ([]byte, []byte, error) {
+	script, blindingKey, err := wallet.ParseAddress(addr, net)
 	if err != nil {
 		return nil, nil, err
 	}
-	ctAddr, err := address.FromConfidential(addr)
-	if err != nil {
-		return nil, nil, err
+	if len(blindingKey) <= 0 {
+		return nil, nil, ErrAddressNotConfidential
 	}
-	return script, ctAddr.BlindingKey, nil
+	return script, blindingKey, nil
 }
 
 func getAssetsOfOutputs(outputs []*transaction.TxOutput) []string {
@@ -920,6 +986,7 @@ func containsAsset(assets []string, asset string) bool {
 
 type sendToManyOpts struct {
 	mnemonic              []string
+	walletOpts            domain.WalletOptions
 	unspents              []explorer.Utxo
 	feeUnspents           []explorer.Utxo
 	outputs               []*transaction.TxOutput
@@ -930,16 +997,146 @@ type sendToManyOpts struct {
 	feeInputPathsByScript map[string]string
 	milliSatPerByte       int
 	network               *network.Network
+	// replaceable makes the transaction signal opt-in replace-by-fee (BIP125)
+	replaceable bool
+	// otherLegs are additional outputs of the transaction, each funded by the
+	// unspents of a different account.
+	otherLegs []sendToManyLeg
+	// signer signs the blinded transaction, defaults to the internal one.
+	signer Signer
+	// dustThreshold is the min amount of the changes, whenever possible.
+	dustThreshold uint64
+	// lockTime, if not zero, is the block height the transaction is locked to.
+	lockTime uint32
+	// coinSelector selects the unspents of every account, defaults to the
+	// strategy of explorer.SelectUnspents.
+	coinSelector wallet.CoinSelector
+}
+
+// sendToManyLeg is a set of outputs funded by the unspents of one account,
+// which also receives the eventual changes.
+type sendToManyLeg struct {
+	unspents            []explorer.Utxo
+	outputs             []*transaction.TxOutput
+	outputsBlindingKeys [][]byte
+	changePathsByAsset  map[string]string
+	inputPathsByScript  map[string]string
 }
 
 func sendToMany(opts sendToManyOpts) (string, error) {
-	w, err := wallet.NewWalletFromMnemonic(wallet.NewWalletFromMnemonicOpts{
-		SigningMnemonic: opts.mnemonic,
+	w, err := opts.walletOpts.NewWallet(opts.mnemonic)
+	if err != nil {
+		return "", err
+	}
+
+	unsignedTx, err := buildSendToManyPset(w, opts)
+	if err != nil {
+		return "", err
+	}
+	network := opts.network
+
+	// blind the transaction
+	blindedPset, err := w.BlindTransactionWithKeys(wallet.BlindTransactionWithKeysOpts{
+		PsetBase64:         unsignedTx.psetBase64,
+		OutputBlindingKeys: unsignedTx.outputsBlindingKeys,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// add the explicit fee amount
+	blindedPlusFees, err := w.UpdateTx(wallet.UpdateTxOpts{
+		PsetBase64: blindedPset,
+		Outputs:    transactionutil.NewFeeOutput(unsignedTx.feeAmount, network),
+		Network:    network,
 	})
 	if err != nil {
 		return "", err
 	}
 
+	psetBase64 := blindedPlusFees.PsetBase64
+	if opts.replaceable {
+		psetBase64, err = signalReplaceability(psetBase64)
+		if err != nil {
+			return "", err
+		}
+	}
+	if opts.lockTime > 0 {
+		psetBase64, err = setLockTime(psetBase64, opts.lockTime, 0)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// sign the inputs
+	signer := newSigner(opts.signer, w, unsignedTx.inputPathsByScript)
+	signedPset, err := signer.Sign(psetBase64)
+	if err != nil {
+		return "", err
+	}
+
+	// finalize, extract and return the transaction
+	txHex, _, err := wallet.FinalizeAndExtractTransaction(
+		wallet.FinalizeAndExtractTransactionOpts{
+			PsetBase64: signedPset,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return txHex, nil
+}
+
+// estimateSendToMany returns the virtual size of the transaction that
+// sendToMany would make with the given opts, once signed, and the network fee
+// amount it would pay. The fee amount might slightly exceed the one given by
+// the size and the fee rate because coin selection reserves room for one more
+// input to pay for fees.
+func estimateSendToMany(opts sendToManyOpts) (int, uint64, error) {
+	w, err := opts.walletOpts.NewWallet(opts.mnemonic)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	unsignedTx, err := buildSendToManyPset(w, opts)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ptx, err := pset.NewPsetFromBase64(unsignedTx.psetBase64)
+	if err != nil {
+		return 0, 0, err
+	}
+	// all inputs and outputs, but the fee one, are P2WPKH.
+	inScriptTypes := make([]int, 0, len(ptx.Inputs))
+	for range ptx.Inputs {
+		inScriptTypes = append(inScriptTypes, wallet.P2WPKH)
+	}
+	outScriptTypes := make([]int, 0, len(ptx.Outputs))
+	for range ptx.Outputs {
+		outScriptTypes = append(outScriptTypes, wallet.P2WPKH)
+	}
+	vsize := wallet.EstimateTxSize(inScriptTypes, nil, nil, outScriptTypes, nil)
+
+	return vsize, unsignedTx.feeAmount, nil
+}
+
+// sendToManyPset is the unsigned and unblinded transaction of a sendToMany,
+// along with what's required to complete it.
+type sendToManyPset struct {
+	psetBase64          string
+	outputsBlindingKeys [][]byte
+	inputPathsByScript  map[string]string
+	feeAmount           uint64
+}
+
+// buildSendToManyPset selects the coins of every leg and of the fee account
+// and adds them, along with the outputs and changes, to a new transaction.
+func buildSendToManyPset(
+	w *wallet.Wallet,
+	opts sendToManyOpts,
+) (*sendToManyPset, error) {
 	// default to MinMilliSatPerByte if needed
 	milliSatPerByte := opts.milliSatPerByte
 	if milliSatPerByte < domain.MinMilliSatPerByte {
@@ -949,7 +1146,7 @@ func sendToMany(opts sendToManyOpts) (string, error) {
 	// create the transaction
 	newPset, err := w.CreateTx()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	network := opts.network
 
@@ -961,9 +1158,11 @@ func sendToMany(opts sendToManyOpts) (string, error) {
 		ChangePathsByAsset: opts.changePathsByAsset,
 		MilliSatsPerBytes:  milliSatPerByte,
 		Network:            network,
+		DustThreshold:      opts.dustThreshold,
+		CoinSelector:       opts.coinSelector,
 	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	// update the list of output blinding keys with those of the eventual changes
@@ -972,6 +1171,35 @@ func sendToMany(opts sendToManyOpts) (string, error) {
 		outputsBlindingKeys = append(outputsBlindingKeys, v)
 	}
 
+	// add inputs and outputs of the other legs, keeping the list of blinding
+	// keys in the same order of the outputs
+	inputPathsByScript := mergeDerivationPaths(
+		opts.inputPathsByScript, opts.feeInputPathsByScript,
+	)
+	for _, leg := range opts.otherLegs {
+		updateResult, err = w.UpdateTx(wallet.UpdateTxOpts{
+			PsetBase64:         updateResult.PsetBase64,
+			Unspents:           leg.unspents,
+			Outputs:            leg.outputs,
+			ChangePathsByAsset: leg.changePathsByAsset,
+			MilliSatsPerBytes:  milliSatPerByte,
+			Network:            network,
+			DustThreshold:      opts.dustThreshold,
+			CoinSelector:       opts.coinSelector,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		outputsBlindingKeys = append(outputsBlindingKeys, leg.outputsBlindingKeys...)
+		for _, v := range updateResult.ChangeOutputsBlindingKeys {
+			outputsBlindingKeys = append(outputsBlindingKeys, v)
+		}
+		inputPathsByScript = mergeDerivationPaths(
+			inputPathsByScript, leg.inputPathsByScript,
+		)
+	}
+
 	// add inputs for paying network fees
 	feeUpdateResult, err := w.UpdateTx(wallet.UpdateTxOpts{
 		PsetBase64:         updateResult.PsetBase64,
@@ -980,9 +1208,11 @@ func sendToMany(opts sendToManyOpts) (string, error) {
 		MilliSatsPerBytes:  milliSatPerByte,
 		Network:            network,
 		WantChangeForFees:  true,
+		DustThreshold:      opts.dustThreshold,
+		CoinSelector:       opts.coinSelector,
 	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	// again, add changes' blinding keys to the list of those of the outputs
@@ -990,46 +1220,26 @@ func sendToMany(opts sendToManyOpts) (string, error) {
 		outputsBlindingKeys = append(outputsBlindingKeys, v)
 	}
 
-	// blind the transaction
-	blindedPset, err := w.BlindTransactionWithKeys(wallet.BlindTransactionWithKeysOpts{
-		PsetBase64:         feeUpdateResult.PsetBase64,
-		OutputBlindingKeys: outputsBlindingKeys,
-	})
-	if err != nil {
-		return "", err
-	}
+	return &sendToManyPset{
+		psetBase64:          feeUpdateResult.PsetBase64,
+		outputsBlindingKeys: outputsBlindingKeys,
+		inputPathsByScript:  inputPathsByScript,
+		feeAmount:           feeUpdateResult.FeeAmount,
+	}, nil
+}
 
-	// add the explicit fee amount
-	blindedPlusFees, err := w.UpdateTx(wallet.UpdateTxOpts{
-		PsetBase64: blindedPset,
-		Outputs:    transactionutil.NewFeeOutput(feeUpdateResult.FeeAmount, network),
-		Network:    network,
-	})
+// signalReplaceability sets the sequence of all inputs of the given unsigned
+// partial transaction so that it can be later replaced by one paying higher
+// fees, as defined in BIP125.
+func signalReplaceability(psetBase64 string) (string, error) {
+	ptx, err := pset.NewPsetFromBase64(psetBase64)
 	if err != nil {
 		return "", err
 	}
-
-	// sign the inputs
-	inputPathsByScript := mergeDerivationPaths(opts.inputPathsByScript, opts.feeInputPathsByScript)
-	signedPset, err := w.SignTransaction(wallet.SignTransactionOpts{
-		PsetBase64:        blindedPlusFees.PsetBase64,
-		DerivationPathMap: inputPathsByScript,
-	})
-	if err != nil {
-		return "", err
+	for _, in := range ptx.UnsignedTx.Inputs {
+		in.Sequence = rbfSequence
 	}
-
-	// finalize, extract and return the transaction
-	txHex, _, err := wallet.FinalizeAndExtractTransaction(
-		wallet.FinalizeAndExtractTransactionOpts{
-			PsetBase64: signedPset,
-		},
-	)
-	if err != nil {
-		return "", err
-	}
-
-	return txHex, nil
+	return ptx.ToBase64()
 }
 
 func getDerivationPathsForUnspents(
@@ -1093,7 +1303,13 @@ func isAddressFunded(addr string, blindKey []byte, explorerSvc explorer.Service)
 	return len(txs) > 0
 }
 
-func getBalancesByAsset(unspents []explorer.Utxo) map[string]BalanceInfo {
+// getBalancesByAsset returns the balance of the given utxos grouped by asset.
+// Those with less than minConfirmations confirmations at the given chain tip
+// height are counted as unconfirmed.
+func getBalancesByAsset(
+	unspents []explorer.Utxo,
+	minConfirmations, tipHeight uint64,
+) map[string]BalanceInfo {
 	balances := map[string]BalanceInfo{}
 	for _, unspent := range unspents {
 		if _, ok := balances[unspent.Asset()]; !ok {
@@ -1102,7 +1318,11 @@ func getBalancesByAsset(unspents []explorer.Utxo) map[string]BalanceInfo {
 
 		balance := balances[unspent.Asset()]
 		balance.TotalBalance += unspent.Value()
-		if unspent.IsConfirmed() {
+		u := domain.Unspent{
+			Confirmed:   unspent.IsConfirmed(),
+			BlockHeight: unspent.BlockHeight(),
+		}
+		if u.IsConfirmed() && u.HasConfirmations(minConfirmations, tipHeight) {
 			balance.ConfirmedBalance += unspent.Value()
 		} else {
 			balance.UnconfirmedBalance += unspent.Value()
@@ -1112,6 +1332,22 @@ func getBalancesByAsset(unspents []explorer.Utxo) map[string]BalanceInfo {
 	return balances
 }
 
+// getTipHeight returns the current height of the chain, needed for counting
+// the confirmations of an unspent only if more than one is required.
+func getTipHeight(
+	explorerSvc explorer.Service,
+	minConfirmations uint64,
+) (uint64, error) {
+	if minConfirmations <= 1 {
+		return 0, nil
+	}
+	height, err := explorerSvc.GetBlockHeight()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(height), nil
+}
+
 func fetchUnspents(explorerSvc explorer.Service, info domain.AddressesInfo) ([]domain.Unspent, error) {
 	if len(info) <= 0 {
 		return nil, nil
@@ -1146,6 +1382,8 @@ func fetchUnspents(explorerSvc explorer.Service, info domain.AddressesInfo) ([]d
 			RangeProof:      make([]byte, 1),
 			SurjectionProof: make([]byte, 1),
 			Confirmed:       u.IsConfirmed(),
+			BlockHeight:     u.BlockHeight(),
+			BlockHash:       u.BlockHash(),
 			Address:         addr,
 		})
 	}
@@ -124,6 +124,44 @@ func TestInitWallet(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("wallet_from_restart_with_another_derivation_path", func(t *testing.T) {
+		repoManager, explorerSvc, bcListener := newServices()
+		_, err := repoManager.VaultRepository().GetOrCreateVault(
+			ctx, mnemonic, passphrase, regtest,
+		)
+		require.NoError(t, err)
+		var info *domain.AddressInfo
+		err = repoManager.VaultRepository().UpdateVault(
+			ctx,
+			func(v *domain.Vault) (*domain.Vault, error) {
+				info, err = v.DeriveNextExternalAddressForAccount(domain.FeeAccount)
+				if err != nil {
+					return nil, err
+				}
+				return v, nil
+			},
+		)
+		require.NoError(t, err)
+		explorerSvc.(*mockExplorer).
+			On("GetUnspentsForAddresses", mock.Anything, mock.Anything).
+			Return(randomUtxos([]string{info.Address}), nil)
+
+		_, err = application.NewWalletService(
+			repoManager,
+			explorerSvc,
+			bcListener,
+			nil,
+			false,
+			regtest,
+			domain.WalletOptions{BaseDerivationPath: "m/84'/1'"},
+			marketFee,
+			marketBaseAsset,
+			0,
+			0,
+		)
+		require.ErrorIs(t, err, domain.ErrVaultBaseDerivationPathChanged)
+	})
+
 	t.Run("wallet_from_restore", func(t *testing.T) {
 		walletSvc, err := newWalletServiceRestore()
 		require.NoError(t, err)
@@ -154,10 +192,14 @@ func newWalletService() (application.WalletService, error) {
 		repoManager,
 		explorerSvc,
 		bcListener,
+		nil,
 		false,
 		regtest,
+		domain.WalletOptions{},
 		marketFee,
 		marketBaseAsset,
+		0,
+		0,
 	)
 }
 
@@ -184,10 +226,14 @@ func newWalletServiceRestart() (application.WalletService, error) {
 		repoManager,
 		explorerSvc,
 		bcListener,
+		nil,
 		false,
 		regtest,
+		domain.WalletOptions{},
 		marketFee,
 		marketBaseAsset,
+		0,
+		0,
 	)
 }
 
@@ -249,10 +295,14 @@ func newWalletServiceRestore() (application.WalletService, error) {
 		repoManager,
 		explorerSvc,
 		bcListener,
+		nil,
 		false,
 		regtest,
+		domain.WalletOptions{},
 		marketFee,
 		marketBaseAsset,
+		0,
+		0,
 	)
 }
 
@@ -261,7 +311,7 @@ func newServices() (
 	explorer.Service,
 	application.BlockchainListener,
 ) {
-	repoManager, _ := dbbadger.NewRepoManager("", nil)
+	repoManager, _ := dbbadger.NewRepoManager("", nil, nil)
 	explorerSvc := &mockExplorer{}
 	crawlerSvc := crawler.NewService(crawler.Opts{
 		ExplorerSvc:        explorerSvc,
@@ -272,6 +322,9 @@ func newServices() (
 	bcListener := application.NewBlockchainListener(
 		crawlerSvc,
 		repoManager,
+		nil,
+		nil,
+		nil,
 		marketBaseAsset,
 		regtest,
 	)
@@ -0,0 +1,151 @@
+package application
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/tdex-network/tdex-daemon/pkg/bufferutil"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+	"github.com/vulpemventures/go-elements/transaction"
+)
+
+// rbfSequence is the max sequence number that an input can have for its
+// transaction to signal replaceability.
+const rbfSequence = 0xfffffffd
+
+// withdrawal holds what's needed to craft a replacement for a withdrawal
+// transaction of one or more markets. The unspents of the legs and the fee
+// ones are only those spent by the transaction.
+type withdrawal struct {
+	txHex           string
+	legs            []withdrawalLeg
+	feeUnspents     []explorer.Utxo
+	milliSatPerByte int
+}
+
+// newWithdrawal returns the withdrawal made with the given tx, keeping only
+// those of the given unspents that it spends.
+func newWithdrawal(
+	txHex string,
+	legs []withdrawalLeg,
+	feeUnspents []explorer.Utxo,
+	milliSatPerByte int,
+) withdrawal {
+	tx, _ := transaction.NewTxFromHex(txHex)
+
+	spentLegs := make([]withdrawalLeg, 0, len(legs))
+	for _, leg := range legs {
+		leg.unspents = filterUnspentsSpentByTx(leg.unspents, tx)
+		spentLegs = append(spentLegs, leg)
+	}
+	return withdrawal{
+		txHex:           txHex,
+		legs:            spentLegs,
+		feeUnspents:     filterUnspentsSpentByTx(feeUnspents, tx),
+		milliSatPerByte: milliSatPerByte,
+	}
+}
+
+// withdrawals keeps track of the market withdrawals that can be replaced by
+// fee, indexed by txid. Entries are not persisted, therefore it's not possible
+// to bump the fees of withdrawals made before the daemon has been restarted.
+type withdrawals struct {
+	withdrawals map[string]withdrawal
+	lock        *sync.RWMutex
+}
+
+func newWithdrawals() *withdrawals {
+	return &withdrawals{
+		withdrawals: make(map[string]withdrawal),
+		lock:        &sync.RWMutex{},
+	}
+}
+
+func (w *withdrawals) add(txid string, wd withdrawal) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.withdrawals[txid] = wd
+}
+
+func (w *withdrawals) get(txid string) (withdrawal, bool) {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+
+	wd, ok := w.withdrawals[txid]
+	return wd, ok
+}
+
+func (w *withdrawals) remove(txid string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	delete(w.withdrawals, txid)
+}
+
+// filterUnspentsSpentByTx returns those of the given unspents that are spent
+// by the inputs of tx.
+func filterUnspentsSpentByTx(
+	unspents []explorer.Utxo,
+	tx *transaction.Transaction,
+) []explorer.Utxo {
+	spent := make(map[string]bool)
+	for _, in := range tx.Inputs {
+		spent[outpointKey(bufferutil.TxIDFromBytes(in.Hash), in.Index)] = true
+	}
+
+	filtered := make([]explorer.Utxo, 0)
+	for _, u := range unspents {
+		if spent[outpointKey(u.Hash(), u.Index())] {
+			filtered = append(filtered, u)
+		}
+	}
+	return filtered
+}
+
+// unspentsNotFromTx returns the given unspents that are not outputs of the
+// transaction with the given txid.
+func unspentsNotFromTx(unspents []explorer.Utxo, txid string) []explorer.Utxo {
+	filtered := make([]explorer.Utxo, 0, len(unspents))
+	for _, u := range unspents {
+		if u.Hash() != txid {
+			filtered = append(filtered, u)
+		}
+	}
+	return filtered
+}
+
+// mergeUnspents returns the union of the given lists of unspents.
+func mergeUnspents(unspents, others []explorer.Utxo) []explorer.Utxo {
+	merged := make([]explorer.Utxo, 0, len(unspents)+len(others))
+	seen := make(map[string]bool)
+	for _, list := range [][]explorer.Utxo{unspents, others} {
+		for _, u := range list {
+			key := outpointKey(u.Hash(), u.Index())
+			if !seen[key] {
+				seen[key] = true
+				merged = append(merged, u)
+			}
+		}
+	}
+	return merged
+}
+
+func outpointKey(hash string, index uint32) string {
+	return fmt.Sprintf("%s:%d", hash, index)
+}
+
+// apply returns the fee rate to use for a withdrawal requested with the given
+// one, or an error if it's below the floor and can't be raised.
+func (f FeeRateFloor) apply(milliSatPerByte int) (int, error) {
+	if milliSatPerByte >= f.MinMilliSatPerByte {
+		return milliSatPerByte, nil
+	}
+	if milliSatPerByte <= 0 || f.ClampUp {
+		return f.MinMilliSatPerByte, nil
+	}
+	return 0, fmt.Errorf(
+		"%w: requested %d msat/byte, min is %d",
+		ErrFeeRateBelowFloor, milliSatPerByte, f.MinMilliSatPerByte,
+	)
+}
@@ -13,6 +13,10 @@ const (
 
 	MinMilliSatPerByte = 100
 
+	// MaxPricePrecision is the max number of decimal places that the prices
+	// of a market can be rounded to.
+	MaxPricePrecision = 16
+
 	StrategyTypePluggable  StrategyType = 0
 	StrategyTypeBalanced   StrategyType = 1
 	StrategyTypeUnbalanced StrategyType = 2
@@ -26,4 +30,5 @@ const (
 	Completed
 	Settled
 	Expired
+	Canceled
 )
@@ -2,12 +2,13 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 )
 
 // Market errors
 var (
 	// ErrMarketFeeTooLow ...
-	ErrMarketFeeTooLow = errors.New("market fee too low, must be at least 1 bp (0.01%)")
+	ErrMarketFeeTooLow = errors.New("market fee too low, must not be a negative number of bp")
 	// ErrMarketFeeTooHigh ...
 	ErrMarketFeeTooHigh = errors.New("market fee too high, must be at most 9999 bp (99,99%)")
 	// ErrMarketMissingBaseAsset ...
@@ -25,6 +26,10 @@ var (
 	ErrMarketIsClosed = errors.New("market is closed")
 	//ErrMarketMustBeClosed is thrown when a market requires being NOT tradable for a change
 	ErrMarketMustBeClosed = errors.New("market must be closed")
+	// ErrMarketIsPaused is thrown when a market requires being NOT paused for a change
+	ErrMarketIsPaused = errors.New("market is paused")
+	// ErrMarketNotPaused is thrown when a market requires being paused for a change
+	ErrMarketNotPaused = errors.New("market is not paused")
 	//ErrMarketNotPriced is thrown when the price is still 0 (ie. not initialized)
 	ErrMarketNotPriced = errors.New("price must be inserted")
 	//ErrMarketInvalidBasePrice is thrown when the amount for Base price is an invalid satoshis value.
@@ -39,6 +44,22 @@ var (
 	ErrInvalidFixedFee = errors.New("fixed fee must be a positive value")
 	// ErrMissingFixedFee ...
 	ErrMissingFixedFee = errors.New("fixed fee requires both base and quote amounts to be defined")
+	// ErrInvalidTradeLimits ...
+	ErrInvalidTradeLimits = errors.New("min trade amount must not be greater than max trade amount")
+	// ErrInvalidFeeTiers is thrown when fee tiers don't have distinct, positive
+	// min volumes
+	ErrInvalidFeeTiers = errors.New("fee tiers must have distinct, positive min volumes")
+	// ErrTradeAmountTooLow is thrown when a trade amount is lower than the market min one
+	ErrTradeAmountTooLow = errors.New("trade amount is lower than the market min trade amount")
+	// ErrTradeAmountTooHigh is thrown when a trade amount is greater than the market max one
+	ErrTradeAmountTooHigh = errors.New("trade amount is greater than the market max trade amount")
+	// ErrInvalidPricePrecision ...
+	ErrInvalidPricePrecision = fmt.Errorf("price precision must not be greater than %d", MaxPricePrecision)
+	// ErrEmptyDepositLabel is thrown when labeling a deposit with an empty label
+	ErrEmptyDepositLabel = errors.New("deposit label must not be empty")
+	// ErrDepositAssetNotInMarket is thrown when labeling a deposit of an asset
+	// that is neither the base nor the quote one of the market
+	ErrDepositAssetNotInMarket = errors.New("deposit asset is not one of the market")
 )
 
 // Unspent errors
@@ -69,6 +90,11 @@ var (
 	ErrVaultNullNetwork = errors.New("network must not be null")
 	// ErrVaultAccountNotFound ...
 	ErrVaultAccountNotFound = errors.New("account not found")
+	// ErrVaultBaseDerivationPathChanged is thrown when trying to change the
+	// base derivation path of a vault that already derived some addresses.
+	ErrVaultBaseDerivationPathChanged = errors.New(
+		"base derivation path can't change once addresses have been derived",
+	)
 )
 
 // Trade errors
@@ -95,6 +121,20 @@ var (
 	)
 	// ErrTradeExpired ...
 	ErrTradeExpired = errors.New("trade has expired")
+	// ErrTradeSettlementDeadlineReached ...
+	ErrTradeSettlementDeadlineReached = errors.New(
+		"trade has reached the settlement deadline",
+	)
+	// ErrTradeCanceled ...
+	ErrTradeCanceled = errors.New("trade has been canceled")
+	// ErrTradeMustBeProposalOrAccepted ...
+	ErrTradeMustBeProposalOrAccepted = errors.New(
+		"trade must be in proposal or accepted state to be canceled",
+	)
+	// ErrTradeMustBeSettled ...
+	ErrTradeMustBeSettled = errors.New(
+		"trade must be in settled state to be reorged",
+	)
 	// ErrTradeNullExpirationDate ...
 	ErrTradeNullExpirationDate = errors.New(
 		"trade must have an expiration date set to be set expired",
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CollectedFee is an entry of the fee ledger, recording the fee collected by a
+// market when one of its trades settled.
+type CollectedFee struct {
+	TradeID          uuid.UUID
+	MarketQuoteAsset string
+	BasisPoint       int64
+	Asset            string
+	// Amount is the total fee collected, the sum of BasisPointAmount and
+	// FixedAmount.
+	Amount uint64
+	// BasisPointAmount is the part of the fee charged as percentage of the
+	// traded amount.
+	BasisPointAmount uint64
+	// FixedAmount is the part of the fee charged as the market's fixed fee
+	// for the fee asset.
+	FixedAmount uint64
+	// BaseVolume and QuoteVolume are the amounts of base and quote asset
+	// exchanged with the trade.
+	BaseVolume  uint64
+	QuoteVolume uint64
+	MarketPrice decimal.Decimal
+	// CollectionTime is the blocktime of the settlement of the trade.
+	CollectionTime uint64
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "context"
+
+// FeeRepository is the abstraction for any kind of database intended to
+// persist the append-only ledger of the fees collected by markets.
+type FeeRepository interface {
+	// AddCollectedFee appends the provided fee to the ledger. A fee already
+	// recorded for the same trade won't be re-added.
+	AddCollectedFee(ctx context.Context, fee CollectedFee) error
+	// GetAllCollectedFees returns the entire fee ledger.
+	GetAllCollectedFees(ctx context.Context) ([]CollectedFee, error)
+	// GetCollectedFeesByMarket returns all the fees collected by a market
+	// identified by its quote asset.
+	GetCollectedFeesByMarket(
+		ctx context.Context,
+		marketQuoteAsset string,
+	) ([]CollectedFee, error)
+	// GetCollectedFeesByMarketInTimeRange returns the fees collected by a
+	// market, identified by its quote asset, between from and to, both
+	// included.
+	GetCollectedFeesByMarketInTimeRange(
+		ctx context.Context,
+		marketQuoteAsset string,
+		from, to uint64,
+	) ([]CollectedFee, error)
+}
@@ -20,12 +20,58 @@ type Market struct {
 	// Each Market has a different fee expressed in basis point of each swap.
 	Fee      int64
 	FixedFee FixedFee
+	// History of the changes of Fee, either applied or scheduled, sorted by
+	// effective time.
+	FeeHistory []FeeChange
+	// Fees charged in place of Fee to the peers that traded at least the min
+	// volume of a tier, sorted by min volume.
+	FeeTiers []FeeTier
 	// if curretly open for trades
 	Tradable bool
+	// if currently rejecting new trades while still completing those in-flight
+	Paused bool
 	// Market Making strategy
 	Strategy mm.MakingStrategy
 	// Pluggable Price of the asset pair.
 	Price Prices
+	// Min and max amount that the proposer of a trade can send, whatever the
+	// asset. Zero means unbounded.
+	MinTradeAmount uint64
+	MaxTradeAmount uint64
+	// Number of decimal places the prices of the market are rounded to when
+	// quoted. Zero means no rounding.
+	PricePrecision uint
+	// Seconds since acceptance within which the tx of a trade must be settled
+	// before this is failed. Zero means no deadline.
+	SettlementTimeout uint64
+	// Deposits claimed on behalf of a liquidity provider, identified by label.
+	LabeledDeposits []LabeledDeposit
+}
+
+// LabeledDeposit associates an outpoint deposited into a market with the
+// label of the liquidity provider it belongs to.
+type LabeledDeposit struct {
+	TxID  string
+	VOut  uint32
+	Asset string
+	Value uint64
+	Label string
+}
+
+// FeeChange is a change of the basis point fee of a market that is effective
+// since the given time, both expressed as Unix seconds.
+type FeeChange struct {
+	BasisPoint  int64
+	EffectiveAt uint64
+	CreatedAt   uint64
+}
+
+// FeeTier is the basis point fee charged to an authenticated peer whose
+// volume, in base asset, of the trades settled with the market is at least
+// MinVolume.
+type FeeTier struct {
+	MinVolume  uint64
+	BasisPoint int64
 }
 
 // OutpointWithAsset contains the transaction outpoint (tx hash and vout) along with the asset hash
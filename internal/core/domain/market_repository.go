@@ -10,6 +10,9 @@ type MarketRepository interface {
 	GetMarketByAccount(ctx context.Context, accountIndex int) (market *Market, err error)
 	// Retrieves a market with a given a quote asset hash.
 	GetMarketByAsset(ctx context.Context, quoteAsset string) (market *Market, accountIndex int, err error)
+	// Retrieves all the markets with the given asset hash as either base or
+	// quote asset.
+	GetMarketsByAsset(ctx context.Context, asset string) ([]Market, error)
 	// Retrieves the latest market sorted by account index
 	GetLatestMarket(ctx context.Context) (market *Market, accountIndex int, err error)
 	// Retrieves all the markets that are open for trading
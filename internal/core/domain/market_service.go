@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"sort"
+	"time"
+
 	"github.com/shopspring/decimal"
 	mm "github.com/tdex-network/tdex-daemon/pkg/marketmaking"
 	"github.com/tdex-network/tdex-daemon/pkg/marketmaking/formula"
@@ -37,6 +40,11 @@ func (m *Market) IsTradable() bool {
 	return m.Tradable
 }
 
+// IsPaused returns true if the market is tradable but rejects new trades
+func (m *Market) IsPaused() bool {
+	return m.Paused
+}
+
 // IsFunded method returns true if the market contains a non empty funding tx outpoint for each asset
 func (m *Market) IsFunded() bool {
 	return m.BaseAsset != "" && m.QuoteAsset != ""
@@ -126,6 +134,32 @@ func (m *Market) MakeNotTradable() error {
 	}
 
 	m.Tradable = false
+	m.Paused = false
+	return nil
+}
+
+// Pause makes a tradable market reject new trades, while those already
+// accepted can still be completed and settled.
+func (m *Market) Pause() error {
+	if !m.IsTradable() {
+		return ErrMarketIsClosed
+	}
+
+	if m.IsPaused() {
+		return ErrMarketIsPaused
+	}
+
+	m.Paused = true
+	return nil
+}
+
+// Resume makes a paused market accept new trades again.
+func (m *Market) Resume() error {
+	if !m.IsPaused() {
+		return ErrMarketNotPaused
+	}
+
+	m.Paused = false
 	return nil
 }
 
@@ -156,6 +190,25 @@ func (m *Market) MakeStrategyBalanced() error {
 	return nil
 }
 
+// SwitchStrategyPluggable makes the market, that can be tradable, using a
+// pluggable price feed starting from the given prices.
+func (m *Market) SwitchStrategyPluggable(prices Prices) error {
+	if !prices.BasePrice.IsPositive() || !prices.QuotePrice.IsPositive() {
+		return ErrMarketNotPriced
+	}
+
+	m.Strategy = mm.MakingStrategy{}
+	m.Price = prices
+
+	return nil
+}
+
+// SwitchStrategyBalanced makes the market, that can be tradable, using a
+// balanced AMM formula 50/50.
+func (m *Market) SwitchStrategyBalanced() {
+	m.Strategy = mm.NewStrategyFromFormula(formula.BalancedReserves{})
+}
+
 // ChangeFeeBasisPoint ...
 func (m *Market) ChangeFeeBasisPoint(fee int64) error {
 	if !m.IsFunded() {
@@ -170,10 +223,112 @@ func (m *Market) ChangeFeeBasisPoint(fee int64) error {
 		return err
 	}
 
+	now := uint64(time.Now().Unix())
 	m.Fee = fee
+	m.addFeeChange(FeeChange{BasisPoint: fee, EffectiveAt: now, CreatedAt: now})
+	return nil
+}
+
+// ScheduleFeeChange makes the given basis point fee effective since the given
+// time. A change effective since now, or earlier, is applied immediately and
+// thus requires the market to be closed, while future ones can be scheduled
+// at any time. Trades keep the fee quoted at proposal time anyway.
+func (m *Market) ScheduleFeeChange(fee int64, effectiveAt uint64) error {
+	now := uint64(time.Now().Unix())
+	if effectiveAt <= now {
+		return m.ChangeFeeBasisPoint(fee)
+	}
+
+	if !m.IsFunded() {
+		return ErrMarketNotFunded
+	}
+
+	if err := validateFee(fee); err != nil {
+		return err
+	}
+
+	m.addFeeChange(FeeChange{BasisPoint: fee, EffectiveAt: effectiveAt, CreatedAt: now})
+	return nil
+}
+
+// FeeAt returns the basis point fee effective at the given time.
+func (m *Market) FeeAt(timestamp uint64) int64 {
+	fee := m.Fee
+	for _, change := range m.FeeHistory {
+		if change.EffectiveAt > timestamp {
+			break
+		}
+		fee = change.BasisPoint
+	}
+	return fee
+}
+
+// ActiveFee returns the basis point fee currently effective.
+func (m *Market) ActiveFee() int64 {
+	return m.FeeAt(uint64(time.Now().Unix()))
+}
+
+// addFeeChange adds the given change to the history, keeping it sorted by
+// effective time. Among changes effective since the same time, the latest
+// added takes precedence.
+func (m *Market) addFeeChange(change FeeChange) {
+	i := sort.Search(len(m.FeeHistory), func(i int) bool {
+		return m.FeeHistory[i].EffectiveAt > change.EffectiveAt
+	})
+	history := make([]FeeChange, 0, len(m.FeeHistory)+1)
+	history = append(history, m.FeeHistory[:i]...)
+	history = append(history, change)
+	m.FeeHistory = append(history, m.FeeHistory[i:]...)
+}
+
+// ChangeFeeTiers replaces the fee tiers of the market with the given ones.
+// An empty list removes them, so that all peers are charged the market fee.
+func (m *Market) ChangeFeeTiers(tiers []FeeTier) error {
+	if !m.IsFunded() {
+		return ErrMarketNotFunded
+	}
+
+	if m.IsTradable() {
+		return ErrMarketMustBeClosed
+	}
+
+	sortedTiers := make([]FeeTier, len(tiers))
+	copy(sortedTiers, tiers)
+	sort.Slice(sortedTiers, func(i, j int) bool {
+		return sortedTiers[i].MinVolume < sortedTiers[j].MinVolume
+	})
+	for i, tier := range sortedTiers {
+		if tier.MinVolume == 0 {
+			return ErrInvalidFeeTiers
+		}
+		if i > 0 && tier.MinVolume == sortedTiers[i-1].MinVolume {
+			return ErrInvalidFeeTiers
+		}
+		if err := validateFee(tier.BasisPoint); err != nil {
+			return err
+		}
+	}
+
+	if len(sortedTiers) <= 0 {
+		sortedTiers = nil
+	}
+	m.FeeTiers = sortedTiers
 	return nil
 }
 
+// FeeTierForVolume returns the basis point fee of the highest tier whose min
+// volume is reached by the given one, if any.
+func (m *Market) FeeTierForVolume(volume uint64) (int64, bool) {
+	fee, found := int64(0), false
+	for _, tier := range m.FeeTiers {
+		if tier.MinVolume > volume {
+			break
+		}
+		fee, found = tier.BasisPoint, true
+	}
+	return fee, found
+}
+
 // ChangeFixedFee ...
 func (m *Market) ChangeFixedFee(baseFee, quoteFee int64) error {
 	if !m.IsFunded() {
@@ -193,6 +348,100 @@ func (m *Market) ChangeFixedFee(baseFee, quoteFee int64) error {
 	return nil
 }
 
+// ChangeTradeLimits ...
+func (m *Market) ChangeTradeLimits(minAmount, maxAmount uint64) error {
+	if !m.IsFunded() {
+		return ErrMarketNotFunded
+	}
+
+	if m.IsTradable() {
+		return ErrMarketMustBeClosed
+	}
+
+	if maxAmount > 0 && minAmount > maxAmount {
+		return ErrInvalidTradeLimits
+	}
+
+	m.MinTradeAmount = minAmount
+	m.MaxTradeAmount = maxAmount
+	return nil
+}
+
+// ValidateTradeAmount returns an error if the given amount is out of the
+// market trade limits.
+func (m *Market) ValidateTradeAmount(amount uint64) error {
+	if m.MinTradeAmount > 0 && amount < m.MinTradeAmount {
+		return ErrTradeAmountTooLow
+	}
+	if m.MaxTradeAmount > 0 && amount > m.MaxTradeAmount {
+		return ErrTradeAmountTooHigh
+	}
+	return nil
+}
+
+// ChangePricePrecision changes the number of decimal places the prices of the
+// market are rounded to when quoted. Zero disables rounding.
+func (m *Market) ChangePricePrecision(precision uint) error {
+	if !m.IsFunded() {
+		return ErrMarketNotFunded
+	}
+
+	if m.IsTradable() {
+		return ErrMarketMustBeClosed
+	}
+
+	if precision > MaxPricePrecision {
+		return ErrInvalidPricePrecision
+	}
+
+	m.PricePrecision = precision
+	return nil
+}
+
+// ChangeSettlementTimeout changes the time, in seconds since acceptance,
+// within which a trade of the market must be completed. Zero disables the
+// deadline.
+func (m *Market) ChangeSettlementTimeout(timeout uint64) error {
+	if !m.IsFunded() {
+		return ErrMarketNotFunded
+	}
+
+	m.SettlementTimeout = timeout
+	return nil
+}
+
+// LabelDeposit associates the given deposit of one of the market assets with
+// its label, replacing the label of the same outpoint if already labeled.
+func (m *Market) LabelDeposit(deposit LabeledDeposit) error {
+	if !m.IsFunded() {
+		return ErrMarketNotFunded
+	}
+	if deposit.Label == "" {
+		return ErrEmptyDepositLabel
+	}
+	if deposit.Asset != m.BaseAsset && deposit.Asset != m.QuoteAsset {
+		return ErrDepositAssetNotInMarket
+	}
+
+	for i, d := range m.LabeledDeposits {
+		if d.TxID == deposit.TxID && d.VOut == deposit.VOut {
+			m.LabeledDeposits[i] = deposit
+			return nil
+		}
+	}
+	m.LabeledDeposits = append(m.LabeledDeposits, deposit)
+	return nil
+}
+
+// RoundPrice rounds the given price to the precision of the market, if any,
+// using the banker's (half-even) rounding.
+func (m *Market) RoundPrice(price decimal.Decimal) decimal.Decimal {
+	if m.PricePrecision == 0 {
+		return price
+	}
+	return price.RoundBank(int32(m.PricePrecision))
+}
+
 // ChangeBasePrice ...
 func (m *Market) ChangeBasePrice(price decimal.Decimal) error {
 	if !m.IsFunded() {
@@ -2,6 +2,7 @@ package domain_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
@@ -199,6 +200,77 @@ func TestFailingMakeStrategyBalanced(t *testing.T) {
 	require.EqualError(t, err, domain.ErrMarketMustBeClosed.Error())
 }
 
+func TestSwitchStrategy(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMarketTradable()
+	prices := domain.Prices{
+		BasePrice:  decimal.NewFromInt(20000),
+		QuotePrice: decimal.NewFromFloat(0.00005),
+	}
+
+	err := m.SwitchStrategyPluggable(prices)
+	require.NoError(t, err)
+	require.True(t, m.IsTradable())
+	require.True(t, m.IsStrategyPluggable())
+	require.True(t, m.IsStrategyPluggableInitialized())
+	require.Equal(t, prices, m.Price)
+
+	m.SwitchStrategyBalanced()
+	require.True(t, m.IsTradable())
+	require.False(t, m.IsStrategyPluggable())
+}
+
+func TestFailingSwitchStrategyPluggable(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMarketTradable()
+
+	err := m.SwitchStrategyPluggable(domain.Prices{
+		BasePrice: decimal.NewFromInt(20000),
+	})
+	require.EqualError(t, err, domain.ErrMarketNotPriced.Error())
+	require.False(t, m.IsStrategyPluggable())
+}
+
+func TestPauseMarket(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMarketTradable()
+
+	err := m.Pause()
+	require.NoError(t, err)
+	require.True(t, m.IsPaused())
+	require.True(t, m.IsTradable())
+
+	err = m.Resume()
+	require.NoError(t, err)
+	require.False(t, m.IsPaused())
+
+	err = m.Pause()
+	require.NoError(t, err)
+	err = m.MakeNotTradable()
+	require.NoError(t, err)
+	require.False(t, m.IsPaused())
+}
+
+func TestFailingPauseMarket(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMarketFunded()
+	err := m.Pause()
+	require.EqualError(t, err, domain.ErrMarketIsClosed.Error())
+
+	err = m.Resume()
+	require.EqualError(t, err, domain.ErrMarketNotPaused.Error())
+
+	m = newTestMarketTradable()
+	err = m.Pause()
+	require.NoError(t, err)
+	err = m.Pause()
+	require.EqualError(t, err, domain.ErrMarketIsPaused.Error())
+}
+
 func TestChangeFeeBasisPoint(t *testing.T) {
 	t.Parallel()
 
@@ -208,6 +280,7 @@ func TestChangeFeeBasisPoint(t *testing.T) {
 	err := m.ChangeFeeBasisPoint(newFee)
 	require.NoError(t, err)
 	require.Equal(t, newFee, m.Fee)
+	require.Len(t, m.FeeHistory, 1)
 }
 
 func TestFailingChangeFeeBasisPoint(t *testing.T) {
@@ -253,6 +326,70 @@ func TestFailingChangeFeeBasisPoint(t *testing.T) {
 	}
 }
 
+func TestScheduleFeeChange(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMarketTradable()
+	fee := m.Fee
+	now := uint64(time.Now().Unix())
+
+	err := m.ScheduleFeeChange(50, now+200)
+	require.NoError(t, err)
+	err = m.ScheduleFeeChange(30, now+100)
+	require.NoError(t, err)
+	require.Len(t, m.FeeHistory, 2)
+	require.Equal(t, now+100, m.FeeHistory[0].EffectiveAt)
+
+	require.Equal(t, fee, m.Fee)
+	require.Equal(t, fee, m.ActiveFee())
+	require.Equal(t, int64(30), m.FeeAt(now+100))
+	require.Equal(t, int64(30), m.FeeAt(now+199))
+	require.Equal(t, int64(50), m.FeeAt(now+200))
+}
+
+func TestFailingScheduleFeeChange(t *testing.T) {
+	t.Parallel()
+
+	future := uint64(time.Now().Add(time.Hour).Unix())
+
+	tests := []struct {
+		name          string
+		market        *domain.Market
+		marketFee     int64
+		effectiveAt   uint64
+		expectedError error
+	}{
+		{
+			name:          "not_funded",
+			market:        newTestMarket(),
+			marketFee:     50,
+			effectiveAt:   future,
+			expectedError: domain.ErrMarketNotFunded,
+		},
+		{
+			name:          "immediate_must_be_closed",
+			market:        newTestMarketTradable(),
+			marketFee:     50,
+			effectiveAt:   0,
+			expectedError: domain.ErrMarketMustBeClosed,
+		},
+		{
+			name:          "fee_too_high",
+			market:        newTestMarketTradable(),
+			marketFee:     10000,
+			effectiveAt:   future,
+			expectedError: domain.ErrMarketFeeTooHigh,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.market.ScheduleFeeChange(tt.marketFee, tt.effectiveAt)
+			require.EqualError(t, err, tt.expectedError.Error())
+		})
+	}
+}
+
 func TestChangeFixedFee(t *testing.T) {
 	t.Parallel()
 
@@ -321,6 +458,294 @@ func TestFailingChangeFixedFee(t *testing.T) {
 	}
 }
 
+func TestChangeTradeLimits(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMarketFunded()
+	minAmount := uint64(1000)
+	maxAmount := uint64(100000000)
+
+	err := m.ChangeTradeLimits(minAmount, maxAmount)
+	require.NoError(t, err)
+	require.Equal(t, minAmount, m.MinTradeAmount)
+	require.Equal(t, maxAmount, m.MaxTradeAmount)
+
+	err = m.ChangeTradeLimits(minAmount, 0)
+	require.NoError(t, err)
+	require.Zero(t, m.MaxTradeAmount)
+}
+
+func TestFailingChangeTradeLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                 string
+		market               *domain.Market
+		minAmount, maxAmount uint64
+		expectedError        error
+	}{
+		{
+			name:          "not_funded",
+			market:        newTestMarket(),
+			expectedError: domain.ErrMarketNotFunded,
+		},
+		{
+			name:          "must_be_closed",
+			market:        newTestMarketTradable(),
+			expectedError: domain.ErrMarketMustBeClosed,
+		},
+		{
+			name:          "invalid_limits",
+			market:        newTestMarketFunded(),
+			minAmount:     1000,
+			maxAmount:     100,
+			expectedError: domain.ErrInvalidTradeLimits,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.market.ChangeTradeLimits(tt.minAmount, tt.maxAmount)
+			require.EqualError(t, err, tt.expectedError.Error())
+		})
+	}
+}
+
+func TestChangeFeeTiers(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMarketFunded()
+	_, ok := m.FeeTierForVolume(1000000)
+	require.False(t, ok)
+
+	err := m.ChangeFeeTiers([]domain.FeeTier{
+		{MinVolume: 100000000, BasisPoint: 10},
+		{MinVolume: 10000000, BasisPoint: 20},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10000000), m.FeeTiers[0].MinVolume)
+
+	_, ok = m.FeeTierForVolume(9999999)
+	require.False(t, ok)
+	fee, ok := m.FeeTierForVolume(10000000)
+	require.True(t, ok)
+	require.Equal(t, int64(20), fee)
+	fee, ok = m.FeeTierForVolume(200000000)
+	require.True(t, ok)
+	require.Equal(t, int64(10), fee)
+
+	err = m.ChangeFeeTiers(nil)
+	require.NoError(t, err)
+	require.Empty(t, m.FeeTiers)
+}
+
+func TestFailingChangeFeeTiers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		market        *domain.Market
+		tiers         []domain.FeeTier
+		expectedError error
+	}{
+		{
+			name:          "not_funded",
+			market:        newTestMarket(),
+			expectedError: domain.ErrMarketNotFunded,
+		},
+		{
+			name:          "must_be_closed",
+			market:        newTestMarketTradable(),
+			expectedError: domain.ErrMarketMustBeClosed,
+		},
+		{
+			name:          "zero_min_volume",
+			market:        newTestMarketFunded(),
+			tiers:         []domain.FeeTier{{MinVolume: 0, BasisPoint: 10}},
+			expectedError: domain.ErrInvalidFeeTiers,
+		},
+		{
+			name:   "duplicated_min_volume",
+			market: newTestMarketFunded(),
+			tiers: []domain.FeeTier{
+				{MinVolume: 1000, BasisPoint: 10},
+				{MinVolume: 1000, BasisPoint: 20},
+			},
+			expectedError: domain.ErrInvalidFeeTiers,
+		},
+		{
+			name:          "fee_too_high",
+			market:        newTestMarketFunded(),
+			tiers:         []domain.FeeTier{{MinVolume: 1000, BasisPoint: 10000}},
+			expectedError: domain.ErrMarketFeeTooHigh,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.market.ChangeFeeTiers(tt.tiers)
+			require.EqualError(t, err, tt.expectedError.Error())
+		})
+	}
+}
+
+func TestValidateTradeAmount(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMarketFunded()
+	require.NoError(t, m.ValidateTradeAmount(1))
+
+	m.ChangeTradeLimits(1000, 100000)
+
+	tests := []struct {
+		amount        uint64
+		expectedError error
+	}{
+		{1000, nil},
+		{100000, nil},
+		{999, domain.ErrTradeAmountTooLow},
+		{100001, domain.ErrTradeAmountTooHigh},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expectedError, m.ValidateTradeAmount(tt.amount))
+	}
+}
+
+func TestChangePricePrecision(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMarketFunded()
+	price := decimal.RequireFromString("0.000123455")
+	require.True(t, price.Equal(m.RoundPrice(price)))
+
+	err := m.ChangePricePrecision(8)
+	require.NoError(t, err)
+	require.Equal(t, uint(8), m.PricePrecision)
+	require.Equal(t, "0.00012346", m.RoundPrice(price).String())
+	require.Equal(
+		t, "0.00012344",
+		m.RoundPrice(decimal.RequireFromString("0.000123445")).String(),
+	)
+}
+
+func TestFailingChangePricePrecision(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		market        *domain.Market
+		precision     uint
+		expectedError error
+	}{
+		{
+			name:          "not_funded",
+			market:        newTestMarket(),
+			expectedError: domain.ErrMarketNotFunded,
+		},
+		{
+			name:          "must_be_closed",
+			market:        newTestMarketTradable(),
+			expectedError: domain.ErrMarketMustBeClosed,
+		},
+		{
+			name:          "invalid_precision",
+			market:        newTestMarketFunded(),
+			precision:     domain.MaxPricePrecision + 1,
+			expectedError: domain.ErrInvalidPricePrecision,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.market.ChangePricePrecision(tt.precision)
+			require.EqualError(t, err, tt.expectedError.Error())
+		})
+	}
+}
+
+func TestChangeSettlementTimeout(t *testing.T) {
+	t.Parallel()
+
+	// the timeout can be changed while the market is open, since it applies
+	// only to the trades accepted afterwards.
+	m := newTestMarketTradable()
+	err := m.ChangeSettlementTimeout(600)
+	require.NoError(t, err)
+	require.Equal(t, uint64(600), m.SettlementTimeout)
+
+	err = m.ChangeSettlementTimeout(0)
+	require.NoError(t, err)
+	require.Zero(t, m.SettlementTimeout)
+
+	err = newTestMarket().ChangeSettlementTimeout(600)
+	require.EqualError(t, err, domain.ErrMarketNotFunded.Error())
+}
+
+func TestLabelDeposit(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMarketFunded()
+	deposit := domain.LabeledDeposit{
+		TxID:  "0000000000000000000000000000000000000000000000000000000000000000",
+		VOut:  1,
+		Asset: m.BaseAsset,
+		Value: 100000,
+		Label: "alice",
+	}
+	err := m.LabelDeposit(deposit)
+	require.NoError(t, err)
+	require.Equal(t, []domain.LabeledDeposit{deposit}, m.LabeledDeposits)
+
+	// labeling again the same outpoint replaces the previous label.
+	deposit.Label = "bob"
+	err = m.LabelDeposit(deposit)
+	require.NoError(t, err)
+	require.Equal(t, []domain.LabeledDeposit{deposit}, m.LabeledDeposits)
+
+	t.Run("failing", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name          string
+			market        *domain.Market
+			deposit       domain.LabeledDeposit
+			expectedError error
+		}{
+			{
+				name:          "failing_because_market_not_funded",
+				market:        newTestMarket(),
+				deposit:       deposit,
+				expectedError: domain.ErrMarketNotFunded,
+			},
+			{
+				name:   "failing_because_empty_label",
+				market: newTestMarketFunded(),
+				deposit: domain.LabeledDeposit{
+					TxID:  deposit.TxID,
+					Asset: deposit.Asset,
+				},
+				expectedError: domain.ErrEmptyDepositLabel,
+			},
+			{
+				name:   "failing_because_asset_not_in_market",
+				market: newTestMarketFunded(),
+				deposit: domain.LabeledDeposit{
+					TxID:  deposit.TxID,
+					Asset: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+					Label: "alice",
+				},
+				expectedError: domain.ErrDepositAssetNotInMarket,
+			},
+		}
+
+		for _, tt := range tests {
+			err := tt.market.LabelDeposit(tt.deposit)
+			require.EqualError(t, err, tt.expectedError.Error(), tt.name)
+		}
+	})
+}
+
 func TestChangeMarketPrices(t *testing.T) {
 	t.Parallel()
 
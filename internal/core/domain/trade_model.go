@@ -61,6 +61,12 @@ var (
 		Code:   Expired,
 		Failed: true,
 	}
+	// CanceledStatus represents the status of a trade that has been canceled
+	// by the counter-party before being completed.
+	CanceledStatus = Status{
+		Code:   Canceled,
+		Failed: true,
+	}
 )
 
 // AcceptArgs ...
@@ -296,6 +302,50 @@ type Trade struct {
 	SwapAccept          Swap
 	SwapComplete        Swap
 	SwapFail            Swap
+	// Audit is recorded when the trade is settled, it's nil for trades
+	// settled before audits were introduced.
+	Audit *TradeAudit
+	// Reorged tells whether the block that settled the trade has been
+	// reorganized out of the best chain, and the tx not confirmed again yet.
+	Reorged bool
+	// SettlementDeadline is the time by which the tx of an accepted trade must
+	// be settled, zero if the market doesn't set any. Not to be confused with
+	// SettlementTime, when the trade actually settled.
+	SettlementDeadline uint64
+	// Peer is the name of the authenticated trader that proposed the trade,
+	// empty if not authenticated.
+	Peer string
+	// FeeAsset is the asset the market fee is charged on, either AssetP or
+	// AssetR depending on which amount has been computed by the market. It's
+	// empty for trades proposed before it was recorded.
+	FeeAsset string
+}
+
+// TradeAudit records the provenance of the inputs and outputs of the
+// transaction that settled a trade.
+type TradeAudit struct {
+	// Unspents of the market and of the fee account spent by the transaction.
+	MarketInputs []TradeAuditOutpoint
+	FeeInputs    []TradeAuditOutpoint
+	// Output of the market receiving the asset sent by the trader.
+	MarketOutput *TradeAuditOutpoint
+	// Changes returned to the market and to the fee account.
+	ChangeOutputs []TradeAuditOutpoint
+	// Output of the trader receiving the asset sent by the market.
+	CounterpartyOutput *TradeAuditOutpoint
+	CreatedAt          uint64
+}
+
+// TradeAuditOutpoint is an input or output of the transaction of a trade,
+// along with its unblinded asset and amount. AccountIndex is -1 for those not
+// owned by the daemon.
+type TradeAuditOutpoint struct {
+	TxID         string
+	VOut         uint32
+	AccountIndex int
+	Asset        string
+	Amount       uint64
+	Script       string
 }
 
 // NewTrade returns a trade with a new id and Empty status.
@@ -12,6 +12,8 @@ type TradeRepository interface {
 	// // GetOrCreateVault returns the trade with the given tradeID, or create a
 	// new empty one if not found.
 	GetOrCreateTrade(ctx context.Context, tradeID *uuid.UUID) (*Trade, error)
+	// GetTradeByID returns the trade with the given id, or nil if not found.
+	GetTradeByID(ctx context.Context, tradeID uuid.UUID) (*Trade, error)
 	// GetAllTrades returns all the trades stored in the repository.
 	GetAllTrades(ctx context.Context) ([]*Trade, error)
 	// GetAllTradesByMarket returns all the trades filtered by a market
@@ -20,11 +22,21 @@ type TradeRepository interface {
 	// GetCompletedTradesByMarket returns all the Completed or Settled trades
 	// for the provided market identified by its quote asset.
 	GetCompletedTradesByMarket(ctx context.Context, marketQuoteAsset string) ([]*Trade, error)
+	// GetTradesByStatus returns all the trades currently in the given status.
+	GetTradesByStatus(ctx context.Context, status Status) ([]*Trade, error)
+	// ForEachTradeByRequestTime calls fn with every trade requested between
+	// from and to, both included and expressed in Unix seconds, one at a time
+	// and sorted by request time. It stops at the first error returned by fn.
+	ForEachTradeByRequestTime(
+		ctx context.Context,
+		from, to uint64,
+		fn func(t *Trade) error,
+	) error
 	// GetTradeBySwapAcceptID returns the trade that contains the SwapAccept
 	// message matching the given id.
 	GetTradeBySwapAcceptID(ctx context.Context, swapAcceptID string) (*Trade, error)
 	// GetTradeByTxID returns the trade which transaction matches the given
-	// transaction id.
+	// transaction id, or nil if not found.
 	GetTradeByTxID(ctx context.Context, txID string) (*Trade, error)
 	// UpdateTrade allowa to commit multiple changes to the same trade in a
 	// transactional way.
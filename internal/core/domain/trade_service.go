@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/shopspring/decimal"
+	pkgswap "github.com/tdex-network/tdex-daemon/pkg/swap"
 )
 
 // Propose brings an Empty trade to the Propose status by first validating the
@@ -56,6 +57,9 @@ func (t *Trade) Accept(
 	outputBlindingKeys map[string][]byte,
 	expiryDuration uint64,
 ) (bool, error) {
+	if t.IsCanceled() {
+		return false, ErrTradeCanceled
+	}
 	if t.Status.Code >= Accepted {
 		return true, nil
 	}
@@ -101,9 +105,12 @@ type CompleteResult struct {
 // Complete brings a trade from the Accepted to the Completed status by
 // checiking that the given PSET completes the one of the SwapAccept message
 // and by finalizing it and extracting the raw tx in hex format. Complete must
-// be called before the trade expires, otherwise it won't be possible to
-// actually complete an accepted trade.
+// be called before the trade expires and before its settlement deadline, if
+// any, otherwise it won't be possible to actually complete an accepted trade.
 func (t *Trade) Complete(tx string) (*CompleteResult, error) {
+	if t.IsCanceled() {
+		return nil, ErrTradeCanceled
+	}
 	if t.Status.Code >= Completed {
 		return &CompleteResult{OK: true, TxHex: t.TxHex, TxID: t.TxID}, nil
 	}
@@ -119,6 +126,15 @@ func (t *Trade) Complete(tx string) (*CompleteResult, error) {
 		return nil, ErrTradeExpired
 	}
 
+	if t.SettlementDeadline > 0 && uint64(time.Now().Unix()) >= t.SettlementDeadline {
+		t.Fail(
+			t.SwapAccept.ID,
+			int(pkgswap.ErrCodeSettlementTimeout),
+			"trade not completed before the settlement deadline",
+		)
+		return nil, ErrTradeSettlementDeadlineReached
+	}
+
 	swapCompleteID, swapCompleteMsg, err := SwapParserManager.SerializeComplete(
 		t.SwapAccept.Message,
 		tx,
@@ -155,9 +171,17 @@ func (t *Trade) Complete(tx string) (*CompleteResult, error) {
 
 // Settle brings the trade from the Completed to the Settled status, unsets the
 // expiration time and adds the timestamp of the settlement (it must be a
-// blocktime).
+// blocktime). The blocktime is recorded as is, even if it precedes the
+// completion of the trade, since it's set by the block producer.
+// If the trader broadcasted the tx without completing the trade through the
+// daemon, the trade is settled without a completion timestamp.
 func (t *Trade) Settle(settlementTime uint64) (bool, error) {
 	if t.Status.Code == Settled {
+		// the tx of a reorged trade has been confirmed again in another block.
+		if t.Reorged {
+			t.Reorged = false
+			t.SettlementTime = settlementTime
+		}
 		return true, nil
 	}
 
@@ -181,6 +205,7 @@ func (t *Trade) Fail(swapID string, errCode int, errMsg string) {
 	swapFailID, swapFailMsg := SwapParserManager.SerializeFail(swapID, errCode, errMsg)
 	t.SwapFail.ID = swapFailID
 	t.SwapFail.Message = swapFailMsg
+	t.SwapFail.Timestamp = uint64(time.Now().Unix())
 	t.Status.Failed = true
 }
 
@@ -206,6 +231,69 @@ func (t *Trade) Expire() (bool, error) {
 	return true, nil
 }
 
+// Cancel brings a trade from the Proposal or Accepted status to the Canceled
+// one and adds the SwapFail message. Any later attempt to accept or complete
+// the trade is rejected with ErrTradeCanceled.
+func (t *Trade) Cancel() (bool, error) {
+	if t.IsCanceled() {
+		return true, nil
+	}
+
+	if !(t.IsProposal() || t.IsAccepted()) {
+		return false, ErrTradeMustBeProposalOrAccepted
+	}
+
+	swapID := t.SwapAccept.ID
+	if t.IsProposal() {
+		swapID = t.SwapRequest.ID
+	}
+	t.Fail(
+		swapID,
+		int(pkgswap.ErrCodeTradeCanceled),
+		"trade canceled by counter-party",
+	)
+	t.ExpiryTime = 0
+	t.Status = CanceledStatus
+	return true, nil
+}
+
+// SetSettlementDeadline sets the deadline by which the tx of an accepted trade
+// must be settled, given the timeout since acceptance. Zero unsets it.
+func (t *Trade) SetSettlementDeadline(timeout uint64) error {
+	if !t.IsAccepted() || t.Status.Failed {
+		return ErrTradeMustBeAccepted
+	}
+
+	if timeout <= 0 {
+		t.SettlementDeadline = 0
+		return nil
+	}
+	t.SettlementDeadline = t.SwapAccept.Timestamp + timeout
+	return nil
+}
+
+// IsSettlementTimedOut returns whether the accepted trade has not been
+// completed by its settlement deadline, if any. Completed trades never time
+// out, since their tx has already been broadcasted.
+func (t *Trade) IsSettlementTimedOut(now uint64) bool {
+	if t.SettlementDeadline <= 0 || t.Status.Failed || !t.IsAccepted() {
+		return false
+	}
+	return now >= t.SettlementDeadline
+}
+
+// Reorg flags a settled trade whose settlement block is no longer part of the
+// best chain. The flag is cleared by settling the trade again once its tx is
+// included in a new block.
+func (t *Trade) Reorg() (bool, error) {
+	if !t.IsSettled() {
+		return false, ErrTradeMustBeSettled
+	}
+
+	t.Reorged = true
+	return true, nil
+}
+
 // IsEmpty returns whether the Trade is empty.
 func (t *Trade) IsEmpty() bool {
 	return t.Status == EmptyStatus
@@ -231,11 +319,22 @@ func (t *Trade) IsSettled() bool {
 	return t.Status.Code == Settled
 }
 
+// IsReorged returns whether the settlement block of the trade has been
+// reorganized out of the best chain.
+func (t *Trade) IsReorged() bool {
+	return t.Reorged
+}
+
 // IsRejected returns whether the trade has failed.
 func (t *Trade) IsRejected() bool {
 	return t.Status.Failed
 }
 
+// IsCanceled returns whether the trade is in Canceled status.
+func (t *Trade) IsCanceled() bool {
+	return t.Status.Code == Canceled
+}
+
 // IsExpired returns whether the trade has is in Expired status, or if its
 // expiration date has passed.
 func (t *Trade) IsExpired() bool {
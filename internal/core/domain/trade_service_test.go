@@ -5,13 +5,16 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
+	"math"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	pkgswap "github.com/tdex-network/tdex-daemon/pkg/swap"
 )
 
 var mockedErr = &domain.SwapError{
@@ -291,6 +294,25 @@ func TestFailingTradeComplete(t *testing.T) {
 		require.True(t, trade.IsRejected())
 	})
 
+	t.Run("failing_because_settlement_deadline_reached", func(t *testing.T) {
+		trade := newTradeAccepted()
+		trade.SettlementDeadline = uint64(time.Now().Add(-time.Minute).Unix())
+		mockedSwapParser := mockSwapParser{}
+		mockedSwapParser.On(
+			"SerializeFail",
+			trade.SwapAccept.ID,
+			int(pkgswap.ErrCodeSettlementTimeout),
+			mock.Anything,
+		).Return(randomID(), randomBytes(100))
+		domain.SwapParserManager = mockedSwapParser
+
+		res, err := trade.Complete(tx)
+		require.EqualError(t, err, domain.ErrTradeSettlementDeadlineReached.Error())
+		require.Nil(t, res)
+		require.False(t, trade.IsCompleted())
+		require.True(t, trade.IsRejected())
+	})
+
 	t.Run("failing_because_invalid_status", func(t *testing.T) {
 		tests := []struct {
 			name  string
@@ -391,6 +413,94 @@ func TestFailingTradeSettle(t *testing.T) {
 	})
 }
 
+func TestTradeLifecycleTimestamps(t *testing.T) {
+	swapRequest := newMockedSwapRequest()
+	marketAsset := swapRequest.GetAssetR()
+	tx := randomBase64(100)
+	completeTx := randomBase64(100)
+	inBlindKeys := map[string][]byte{
+		randomHex(20): randomBytes(32),
+	}
+	outBlindKeys := map[string][]byte{
+		randomHex(20): randomBytes(32),
+	}
+	acceptMsg := randomBytes(100)
+
+	mockedSwapParser := mockSwapParser{}
+	mockedSwapParser.On("SerializeRequest", swapRequest).Return(randomBytes(100), nil)
+	mockedSwapParser.On("SerializeAccept", mock.Anything).
+		Return(randomID(), acceptMsg, nil)
+	mockedSwapParser.On("SerializeComplete", acceptMsg, completeTx).
+		Return(randomID(), randomBytes(100), nil)
+	domain.SwapParserManager = mockedSwapParser
+
+	mockedPsetParser := mockPsetParser{}
+	mockedPsetParser.On("GetTxID", mock.Anything).Return(randomHex(32), nil)
+	mockedPsetParser.On("GetTxHex", mock.Anything).Return(randomHex(100), nil)
+	domain.PsetParserManager = mockedPsetParser
+
+	requireMonotonicTimestamps := func(t *testing.T, timestamps ...uint64) {
+		for i, timestamp := range timestamps {
+			require.NotZero(t, timestamp)
+			if i > 0 {
+				require.GreaterOrEqual(t, timestamp, timestamps[i-1])
+			}
+		}
+	}
+
+	t.Run("completed_by_trader", func(t *testing.T) {
+		trade := newTradeEmpty()
+
+		ok, err := trade.Propose(swapRequest, marketAsset, 25, 0, 0, nil)
+		require.NoError(t, err)
+		require.True(t, ok)
+		ok, err = trade.Accept(tx, inBlindKeys, outBlindKeys, 600)
+		require.NoError(t, err)
+		require.True(t, ok)
+		res, err := trade.Complete(completeTx)
+		require.NoError(t, err)
+		require.True(t, res.OK)
+		// the blocktime precedes the completion of the trade.
+		blockTime := trade.SwapComplete.Timestamp - 10
+		ok, err = trade.Settle(blockTime)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		requireMonotonicTimestamps(
+			t,
+			trade.SwapRequest.Timestamp,
+			trade.SwapAccept.Timestamp,
+			trade.SwapComplete.Timestamp,
+		)
+		require.Equal(t, blockTime, trade.SettlementTime)
+	})
+
+	t.Run("broadcasted_by_trader", func(t *testing.T) {
+		trade := newTradeEmpty()
+
+		ok, err := trade.Propose(swapRequest, marketAsset, 25, 0, 0, nil)
+		require.NoError(t, err)
+		require.True(t, ok)
+		ok, err = trade.Accept(tx, inBlindKeys, outBlindKeys, 600)
+		require.NoError(t, err)
+		require.True(t, ok)
+		// the tx is settled without completing the trade.
+		blockTime := uint64(time.Now().Unix())
+		ok, err = trade.Settle(blockTime)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		requireMonotonicTimestamps(
+			t,
+			trade.SwapRequest.Timestamp,
+			trade.SwapAccept.Timestamp,
+			trade.SettlementTime,
+		)
+		require.Zero(t, trade.SwapComplete.Timestamp)
+		require.Equal(t, blockTime, trade.SettlementTime)
+	})
+}
+
 func TestTradeExpire(t *testing.T) {
 	oneDayAgo := uint64(time.Now().AddDate(0, 0, -1).Unix())
 
@@ -459,6 +569,176 @@ func TestFailingTradeExpire(t *testing.T) {
 	})
 }
 
+func TestTradeCancel(t *testing.T) {
+	tests := []struct {
+		name  string
+		trade *domain.Trade
+	}{
+		{
+			name:  "with_trade_proposal",
+			trade: newTradeProposal(),
+		},
+		{
+			name:  "with_trade_accepted",
+			trade: newTradeAccepted(),
+		},
+	}
+
+	mockedSwapParser := mockSwapParser{}
+	for _, tt := range tests {
+		swapID := tt.trade.SwapAccept.ID
+		if tt.trade.IsProposal() {
+			swapID = tt.trade.SwapRequest.ID
+		}
+		mockedSwapParser.On(
+			"SerializeFail",
+			swapID,
+			int(pkgswap.ErrCodeTradeCanceled),
+			mock.Anything,
+		).Return(randomID(), randomBytes(100))
+	}
+	domain.SwapParserManager = mockedSwapParser
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := tt.trade.Cancel()
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.True(t, tt.trade.IsCanceled())
+			require.True(t, tt.trade.IsRejected())
+
+			_, err = tt.trade.Accept(randomBase64(100), nil, nil, 60)
+			require.EqualError(t, err, domain.ErrTradeCanceled.Error())
+			_, err = tt.trade.Complete(randomBase64(100))
+			require.EqualError(t, err, domain.ErrTradeCanceled.Error())
+		})
+	}
+}
+
+func TestFailingTradeCancel(t *testing.T) {
+	tests := []struct {
+		name  string
+		trade *domain.Trade
+	}{
+		{
+			name:  "with_trade_empty",
+			trade: newTradeEmpty(),
+		},
+		{
+			name:  "with_trade_completed",
+			trade: newTradeCompleted(),
+		},
+		{
+			name:  "with_trade_settled",
+			trade: newTradeSettled(),
+		},
+	}
+
+	for i := range tests {
+		tt := tests[i]
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ok, err := tt.trade.Cancel()
+			require.EqualError(
+				t, err, domain.ErrTradeMustBeProposalOrAccepted.Error(),
+			)
+			require.False(t, ok)
+			require.False(t, tt.trade.IsCanceled())
+		})
+	}
+}
+
+func TestTradeSettlementDeadline(t *testing.T) {
+	t.Run("with_trade_accepted", func(t *testing.T) {
+		trade := newTradeAccepted()
+
+		err := trade.SetSettlementDeadline(60)
+		require.NoError(t, err)
+		require.Equal(t, trade.SwapAccept.Timestamp+60, trade.SettlementDeadline)
+		require.False(t, trade.IsSettlementTimedOut(trade.SwapAccept.Timestamp))
+		require.True(t, trade.IsSettlementTimedOut(trade.SettlementDeadline))
+
+		err = trade.SetSettlementDeadline(0)
+		require.NoError(t, err)
+		require.Zero(t, trade.SettlementDeadline)
+		require.False(t, trade.IsSettlementTimedOut(math.MaxUint64))
+	})
+
+	t.Run("with_trade_completed", func(t *testing.T) {
+		trade := newTradeCompleted()
+		trade.SettlementDeadline = trade.SwapAccept.Timestamp + 60
+		require.False(t, trade.IsSettlementTimedOut(trade.SettlementDeadline))
+	})
+
+	t.Run("with_trade_settled", func(t *testing.T) {
+		trade := newTradeSettled()
+		trade.SettlementDeadline = trade.SwapAccept.Timestamp + 60
+		require.False(t, trade.IsSettlementTimedOut(trade.SettlementDeadline))
+	})
+
+	t.Run("failing_because_invalid_status", func(t *testing.T) {
+		trade := newTradeProposal()
+
+		err := trade.SetSettlementDeadline(60)
+		require.EqualError(t, err, domain.ErrTradeMustBeAccepted.Error())
+		require.Zero(t, trade.SettlementDeadline)
+	})
+}
+
+func TestTradeReorg(t *testing.T) {
+	trade := newTradeSettled()
+
+	ok, err := trade.Reorg()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, trade.IsReorged())
+	require.True(t, trade.IsSettled())
+
+	// settling again the trade once its tx is confirmed in a new block clears
+	// the flag and updates the settlement time.
+	settlementTime := trade.SettlementTime + 60
+	ok, err = trade.Settle(settlementTime)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.False(t, trade.IsReorged())
+	require.Equal(t, settlementTime, trade.SettlementTime)
+}
+
+func TestFailingTradeReorg(t *testing.T) {
+	tests := []struct {
+		name  string
+		trade *domain.Trade
+	}{
+		{
+			name:  "with_trade_empty",
+			trade: newTradeEmpty(),
+		},
+		{
+			name:  "with_trade_accepted",
+			trade: newTradeAccepted(),
+		},
+		{
+			name:  "with_trade_completed",
+			trade: newTradeCompleted(),
+		},
+	}
+
+	for i := range tests {
+		tt := tests[i]
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ok, err := tt.trade.Reorg()
+			require.EqualError(t, err, domain.ErrTradeMustBeSettled.Error())
+			require.False(t, ok)
+			require.False(t, tt.trade.IsReorged())
+		})
+	}
+}
+
 func newTradeEmpty() *domain.Trade {
 	return domain.NewTrade()
 }
@@ -30,5 +30,18 @@ type Unspent struct {
 	Spent           bool
 	Locked          bool
 	LockedBy        *uuid.UUID
-	Confirmed       bool
+	// LockTimestamp is the Unix time the unspent was locked at, or 0 if not
+	// locked or unknown.
+	LockTimestamp uint64
+	Confirmed     bool
+	// BlockHeight is the height of the block that confirmed the unspent, or 0
+	// if not confirmed or unknown.
+	BlockHeight uint64
+	// BlockHash is the hash of the block that confirmed the unspent, or empty
+	// if not confirmed or unknown. It's used to detect chain reorgs.
+	BlockHash string
+	// Excluded tells whether the unspent must be skipped by the automatic coin
+	// selection, either for trades or withdrawals. It still counts for the
+	// balance.
+	Excluded bool
 }
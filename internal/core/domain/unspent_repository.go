@@ -39,11 +39,20 @@ type UnspentRepository interface {
 	// keys) as spent.
 	SpendUnspents(ctx context.Context, unspentKeys []UnspentKey) (int, error)
 	// ConfirmUnspents let mark the provided list of unconfirmed unspent UTXOs as
-	// confirmed.
-	ConfirmUnspents(ctx context.Context, unspentKeys []UnspentKey) (int, error)
+	// confirmed in the block with the given height and hash.
+	ConfirmUnspents(ctx context.Context, unspentKeys []UnspentKey, blockHeight uint64, blockHash string) (int, error)
+	// UnconfirmUnspents let mark the provided list of confirmed unspent UTXOs as
+	// unconfirmed.
+	UnconfirmUnspents(ctx context.Context, unspentKeys []UnspentKey) (int, error)
 	// LockUnspents let lock the provided list of unlocked, unspent UTXOs,
 	// referring to a certain trade by its UUID.
 	LockUnspents(ctx context.Context, unspentKeys []UnspentKey, tradeID uuid.UUID) (int, error)
 	// UnlockUnspents let unlock the provided list of locked, unspent UTXOs.
 	UnlockUnspents(ctx context.Context, unspentKeys []UnspentKey) (int, error)
+	// ExcludeUnspents let exclude the provided list of unspent UTXOs from the
+	// coin selection.
+	ExcludeUnspents(ctx context.Context, unspentKeys []UnspentKey) (int, error)
+	// IncludeUnspents let include again the provided list of excluded unspent
+	// UTXOs in the coin selection.
+	IncludeUnspents(ctx context.Context, unspentKeys []UnspentKey) (int, error)
 }
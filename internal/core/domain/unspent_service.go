@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/tdex-network/tdex-daemon/pkg/explorer"
 	"github.com/tdex-network/tdex-daemon/pkg/explorer/esplora"
@@ -22,12 +24,35 @@ func (u *Unspent) IsConfirmed() bool {
 	return u.Confirmed
 }
 
+// HasConfirmations returns whether the unspent has at least minConfirmations
+// confirmations when the chain tip is at the given height. Confirmed unspents
+// whose block height is unknown, like those stored before it was tracked, are
+// considered deep enough.
+func (u *Unspent) HasConfirmations(minConfirmations, tipHeight uint64) bool {
+	if minConfirmations == 0 {
+		return true
+	}
+	if !u.IsConfirmed() {
+		return false
+	}
+	if minConfirmations == 1 || u.BlockHeight == 0 {
+		return true
+	}
+	return tipHeight >= u.BlockHeight &&
+		tipHeight-u.BlockHeight+1 >= minConfirmations
+}
+
 // IsLocked returns whether the unspent is already locked - used in some not yet
 // broadcasted trade.
 func (u *Unspent) IsLocked() bool {
 	return u.Locked
 }
 
+// IsExcluded returns whether the unspent is excluded from the coin selection.
+func (u *Unspent) IsExcluded() bool {
+	return u.Excluded
+}
+
 // Key returns the UnspentKey of the current unspent.
 func (u *Unspent) Key() UnspentKey {
 	return UnspentKey{
@@ -41,9 +66,20 @@ func (u *Unspent) Spend() {
 	u.Spent = true
 }
 
-// Confirm marks the unspents as confirmed.
-func (u *Unspent) Confirm() {
+// Confirm marks the unspents as confirmed in the block with the given height
+// and hash.
+func (u *Unspent) Confirm(blockHeight uint64, blockHash string) {
 	u.Confirmed = true
+	u.BlockHeight = blockHeight
+	u.BlockHash = blockHash
+}
+
+// Unconfirm marks the unspent as unconfirmed, like when the block that
+// confirmed it is no longer part of the best chain.
+func (u *Unspent) Unconfirm() {
+	u.Confirmed = false
+	u.BlockHeight = 0
+	u.BlockHash = ""
 }
 
 // Lock marks the current unspent as locked, referring to some trade by its
@@ -58,6 +94,7 @@ func (u *Unspent) Lock(tradeID *uuid.UUID) error {
 
 	u.Locked = true
 	u.LockedBy = tradeID
+	u.LockTimestamp = uint64(time.Now().Unix())
 	return nil
 }
 
@@ -65,6 +102,29 @@ func (u *Unspent) Lock(tradeID *uuid.UUID) error {
 func (u *Unspent) Unlock() {
 	u.Locked = false
 	u.LockedBy = nil
+	u.LockTimestamp = 0
+}
+
+// IsLockExpired returns whether the unspent is locked since longer than the
+// given TTL. Locks whose time is unknown are considered expired.
+func (u *Unspent) IsLockExpired(now time.Time, ttl time.Duration) bool {
+	if !u.IsLocked() {
+		return false
+	}
+	if u.LockTimestamp == 0 {
+		return true
+	}
+	return now.After(time.Unix(int64(u.LockTimestamp), 0).Add(ttl))
+}
+
+// Exclude marks the unspent as excluded from the coin selection.
+func (u *Unspent) Exclude() {
+	u.Excluded = true
+}
+
+// Include marks the excluded unspent as selectable again.
+func (u *Unspent) Include() {
+	u.Excluded = false
 }
 
 func (u *Unspent) ToOutpointWithAsset() OutpointWithAsset {
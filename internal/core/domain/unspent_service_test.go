@@ -2,6 +2,7 @@ package domain_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -24,8 +25,57 @@ func TestConfirmUnspent(t *testing.T) {
 	u := domain.Unspent{}
 	require.False(t, u.IsConfirmed())
 
-	u.Confirm()
+	u.Confirm(100, "blockhash")
 	require.True(t, u.IsConfirmed())
+	require.Equal(t, uint64(100), u.BlockHeight)
+	require.Equal(t, "blockhash", u.BlockHash)
+
+	u.Unconfirm()
+	require.False(t, u.IsConfirmed())
+	require.Zero(t, u.BlockHeight)
+	require.Empty(t, u.BlockHash)
+}
+
+func TestExcludeIncludeUnspent(t *testing.T) {
+	t.Parallel()
+
+	u := domain.Unspent{}
+	require.False(t, u.IsExcluded())
+
+	u.Exclude()
+	require.True(t, u.IsExcluded())
+
+	u.Include()
+	require.False(t, u.IsExcluded())
+}
+
+func TestUnspentHasConfirmations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		unspent          domain.Unspent
+		minConfirmations uint64
+		tipHeight        uint64
+		expected         bool
+	}{
+		{"no_threshold", domain.Unspent{}, 0, 100, true},
+		{"unconfirmed", domain.Unspent{}, 1, 100, false},
+		{"one_conf", domain.Unspent{Confirmed: true, BlockHeight: 100}, 1, 100, true},
+		{"below_threshold", domain.Unspent{Confirmed: true, BlockHeight: 100}, 6, 104, false},
+		{"at_threshold", domain.Unspent{Confirmed: true, BlockHeight: 100}, 6, 105, true},
+		{"unknown_height", domain.Unspent{Confirmed: true}, 6, 100, true},
+		{"tip_behind", domain.Unspent{Confirmed: true, BlockHeight: 100}, 2, 99, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(
+				t, tt.expected, tt.unspent.HasConfirmations(tt.minConfirmations, tt.tipHeight),
+			)
+		})
+	}
 }
 
 func TestLockUnlockUnspent(t *testing.T) {
@@ -61,3 +111,24 @@ func TestFailingLockUnspent(t *testing.T) {
 	err = u.Lock(&otherTradeID)
 	require.EqualError(t, err, domain.ErrUnspentAlreadyLocked.Error())
 }
+
+func TestLockExpiration(t *testing.T) {
+	t.Parallel()
+
+	u := domain.Unspent{}
+	now := time.Now()
+	require.False(t, u.IsLockExpired(now, time.Minute))
+
+	tradeID := uuid.New()
+	err := u.Lock(&tradeID)
+	require.NoError(t, err)
+	require.False(t, u.IsLockExpired(now, time.Minute))
+	require.True(t, u.IsLockExpired(now.Add(2*time.Minute), time.Minute))
+
+	// locks of unknown time are expired.
+	u.LockTimestamp = 0
+	require.True(t, u.IsLockExpired(now, time.Minute))
+
+	u.Unlock()
+	require.False(t, u.IsLockExpired(now.Add(2*time.Minute), time.Minute))
+}
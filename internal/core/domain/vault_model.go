@@ -20,6 +20,7 @@ type Vault struct {
 	Accounts               map[int]*Account
 	AccountAndKeyByAddress map[string]AccountAndKey
 	Network                *network.Network
+	WalletOpts             WalletOptions
 }
 
 // Account defines the entity data struture for a derived account of the
@@ -121,6 +122,49 @@ var (
 	EncrypterManager     Encrypter
 )
 
+// WalletOptions are the options every wallet of the vault is constructed with.
+// They let customize the base derivation path and the addresses gap limit of
+// the wallet, for example to restore one created with another tool, and the
+// type of the deposit addresses.
+type WalletOptions struct {
+	BaseDerivationPath string
+	GapLimit           int
+	// DepositAddressType is either wallet.NativeSegwitAddress (default) or
+	// wallet.NestedSegwitAddress.
+	DepositAddressType string
+}
+
+// IsNestedChain returns whether the addresses of the given chain are derived
+// as nested segwit ones. Only the deposit addresses of the external chain can
+// be so, the change ones are always native segwit.
+func (o WalletOptions) IsNestedChain(chainIndex int) bool {
+	return chainIndex == ExternalChain &&
+		o.DepositAddressType == wallet.NestedSegwitAddress
+}
+
+// baseDerivationPath returns the base derivation path of the options in its
+// canonical form, the default one if not set.
+func (o WalletOptions) baseDerivationPath() string {
+	if len(o.BaseDerivationPath) <= 0 {
+		return wallet.DefaultBaseDerivationPath.String()
+	}
+	path, err := wallet.ParseDerivationPath(o.BaseDerivationPath)
+	if err != nil {
+		return o.BaseDerivationPath
+	}
+	return path.String()
+}
+
+// NewWallet returns a wallet for the given mnemonic constructed with the
+// options.
+func (o WalletOptions) NewWallet(mnemonic []string) (*wallet.Wallet, error) {
+	return wallet.NewWalletFromMnemonic(wallet.NewWalletFromMnemonicOpts{
+		SigningMnemonic:    mnemonic,
+		BaseDerivationPath: o.BaseDerivationPath,
+		GapLimit:           o.GapLimit,
+	})
+}
+
 func init() {
 	EncrypterManager = walletEncrypter{}
 	MnemonicStoreManager = configStore{}
@@ -138,9 +182,7 @@ func NewVault(mnemonic []string, passphrase string, net *network.Network) (*Vaul
 		return nil, ErrVaultNullNetwork
 	}
 
-	if _, err := wallet.NewWalletFromMnemonic(wallet.NewWalletFromMnemonicOpts{
-		SigningMnemonic: mnemonic,
-	}); err != nil {
+	if _, err := (WalletOptions{}).NewWallet(mnemonic); err != nil {
 		return nil, err
 	}
 
@@ -86,6 +86,19 @@ func (v *Vault) ChangePassphrase(currentPassphrase, newPassphrase string) error
 	return nil
 }
 
+// SetWalletOptions sets the options the wallet of the Vault is constructed
+// with. The base derivation path can't change once the Vault has derived
+// some addresses, since they, and their funds, would be no longer reachable.
+func (v *Vault) SetWalletOptions(opts WalletOptions) error {
+	if len(v.AccountAndKeyByAddress) > 0 &&
+		opts.baseDerivationPath() != v.WalletOpts.baseDerivationPath() {
+		return ErrVaultBaseDerivationPathChanged
+	}
+
+	v.WalletOpts = opts
+	return nil
+}
+
 // InitAccount creates a new account in the current Vault if not existing
 func (v *Vault) InitAccount(accountIndex int) {
 	if _, ok := v.Accounts[accountIndex]; !ok {
@@ -116,6 +129,41 @@ func (v *Vault) AccountByAddress(addr string) (*Account, int, error) {
 	return account, info.AccountIndex, nil
 }
 
+// AccountXPub returns the extended public key of the account with the given
+// index, from which all the addresses of the account can be derived, but not
+// the keys to spend their funds. Only existing accounts can be exported.
+func (v *Vault) AccountXPub(accountIndex int) (string, error) {
+	if v.IsLocked() {
+		return "", ErrVaultMustBeUnlocked
+	}
+	if _, err := v.AccountByIndex(accountIndex); err != nil {
+		return "", err
+	}
+
+	w, err := v.WalletOpts.NewWallet(MnemonicStoreManager.Get())
+	if err != nil {
+		return "", err
+	}
+	return w.AccountExtendedPublicKey(wallet.ExtendedKeyOpts{
+		Account: uint32(accountIndex),
+	})
+}
+
+// MasterBlindingKey returns the SLIP77 master blinding key of the Vault,
+// which is required, along with the extended public key of an account, to
+// unblind the outputs received by the account.
+func (v *Vault) MasterBlindingKey() (string, error) {
+	if v.IsLocked() {
+		return "", ErrVaultMustBeUnlocked
+	}
+
+	w, err := v.WalletOpts.NewWallet(MnemonicStoreManager.Get())
+	if err != nil {
+		return "", err
+	}
+	return w.MasterBlindingKey()
+}
+
 // DeriveNextExternalAddressForAccount returns the next unused address, the corresponding output script, the blinding key.
 func (v *Vault) DeriveNextExternalAddressForAccount(accountIndex int) (*AddressInfo, error) {
 	if v.IsLocked() {
@@ -179,9 +227,7 @@ func (v *Vault) isPassphraseSet() bool {
 }
 
 func (v *Vault) deriveNextAddressForAccount(accountIndex, chainIndex int) (*AddressInfo, error) {
-	w, err := wallet.NewWalletFromMnemonic(wallet.NewWalletFromMnemonicOpts{
-		SigningMnemonic: MnemonicStoreManager.Get(),
-	})
+	w, err := v.WalletOpts.NewWallet(MnemonicStoreManager.Get())
 	if err != nil {
 		return nil, err
 	}
@@ -206,14 +252,13 @@ func (v *Vault) deriveNextAddressForAccount(accountIndex, chainIndex int) (*Addr
 	addr, script, err := w.DeriveConfidentialAddress(wallet.DeriveConfidentialAddressOpts{
 		DerivationPath: derivationPath,
 		Network:        v.Network,
+		Nested:         v.WalletOpts.IsNestedChain(chainIndex),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	blindingKey, _, _ := w.DeriveBlindingKeyPair(wallet.DeriveBlindingKeyPairOpts{
-		Script: script,
-	})
+	blindingKey, _ := w.BlindingKeyByScript(script)
 
 	account.addDerivationPath(hex.EncodeToString(script), derivationPath)
 	if chainIndex == InternalChain {
@@ -223,14 +268,14 @@ func (v *Vault) deriveNextAddressForAccount(accountIndex, chainIndex int) (*Addr
 	}
 	v.AccountAndKeyByAddress[addr] = AccountAndKey{
 		AccountIndex: account.AccountIndex,
-		BlindingKey:  blindingKey.Serialize(),
+		BlindingKey:  blindingKey,
 	}
 
 	return &AddressInfo{
 		AccountIndex:   accountIndex,
 		Address:        addr,
 		Script:         hex.EncodeToString(script),
-		BlindingKey:    blindingKey.Serialize(),
+		BlindingKey:    blindingKey,
 		DerivationPath: derivationPath,
 	}, nil
 }
@@ -242,7 +287,7 @@ func (v *Vault) allDerivedAddressesInfo() AddressesInfo {
 
 	for addr, info := range v.AccountAndKeyByAddress {
 		account, _ := v.AccountByIndex(info.AccountIndex)
-		script, _ := address.ToOutputScript(addr)
+		script, _, _ := wallet.ParseAddress(addr, v.Network)
 		path, _ := account.DerivationPathByScript[hex.EncodeToString(script)]
 
 		list[i] = AddressInfo{
@@ -271,9 +316,7 @@ func (v *Vault) allDerivedAddressesInfoForAccount(
 		return nil, err
 	}
 
-	w, err := wallet.NewWalletFromMnemonic(wallet.NewWalletFromMnemonicOpts{
-		SigningMnemonic: MnemonicStoreManager.Get(),
-	})
+	w, err := v.WalletOpts.NewWallet(MnemonicStoreManager.Get())
 	if err != nil {
 		return nil, err
 	}
@@ -285,7 +328,7 @@ func (v *Vault) allDerivedAddressesInfoForAccount(
 	extAddressesInfo := deriveAddressesInRange(
 		w,
 		net,
-		accountIndex,
+		account,
 		ExternalChain,
 		0,
 		account.LastExternalIndex-1,
@@ -296,7 +339,7 @@ func (v *Vault) allDerivedAddressesInfoForAccount(
 		inAddressesInfo := deriveAddressesInRange(
 			w,
 			net,
-			accountIndex,
+			account,
 			InternalChain,
 			0,
 			account.LastInternalIndex-1,
@@ -344,30 +387,46 @@ func (a *Account) addDerivationPath(outputScript, derivationPath string) {
 	}
 }
 
+// nestedDerivationPaths returns the derivation paths of the nested segwit
+// addresses of the account. These are recorded along with the native ones so
+// that the addresses keep being re-derived with their original type even if
+// the configured one changes.
+func (a *Account) nestedDerivationPaths() map[string]bool {
+	paths := make(map[string]bool)
+	for script, path := range a.DerivationPathByScript {
+		buf, _ := hex.DecodeString(script)
+		if address.GetScriptType(buf) == address.P2ShScript {
+			paths[path] = true
+		}
+	}
+	return paths
+}
+
 func deriveAddressesInRange(
 	w *wallet.Wallet,
 	net *network.Network,
-	accountIndex,
+	account *Account,
 	chainIndex,
 	firstAddressIndex,
 	lastAddressIndex int,
 ) AddressesInfo {
 	infoLen := lastAddressIndex - firstAddressIndex + 1
 	info := make(AddressesInfo, infoLen, infoLen)
+	accountIndex := account.AccountIndex
+	nestedPaths := account.nestedDerivationPaths()
 
 	for i := firstAddressIndex; i <= lastAddressIndex; i++ {
 		derivationPath := fmt.Sprintf("%d'/%d/%d", accountIndex, chainIndex, i)
 		addr, script, _ := w.DeriveConfidentialAddress(wallet.DeriveConfidentialAddressOpts{
 			DerivationPath: derivationPath,
 			Network:        net,
+			Nested:         nestedPaths[derivationPath],
 		})
-		key, _, _ := w.DeriveBlindingKeyPair(wallet.DeriveBlindingKeyPairOpts{
-			Script: script,
-		})
+		key, _ := w.BlindingKeyByScript(script)
 		info[i] = AddressInfo{
 			AccountIndex:   accountIndex,
 			Address:        addr,
-			BlindingKey:    key.Serialize(),
+			BlindingKey:    key,
 			DerivationPath: derivationPath,
 			Script:         hex.EncodeToString(script),
 		}
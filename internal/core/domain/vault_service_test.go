@@ -1,15 +1,21 @@
 package domain_test
 
 import (
+	"encoding/hex"
 	"errors"
 	"strings"
 	"sync"
 	"testing"
 
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/stretchr/testify/require"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/pkg/wallet"
+	"github.com/vulpemventures/go-elements/address"
 	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/payment"
+	"github.com/vulpemventures/go-elements/slip77"
 )
 
 func TestIsZero(t *testing.T) {
@@ -129,6 +135,59 @@ func TestFailingChangePassphrase(t *testing.T) {
 	})
 }
 
+func TestSetWalletOptions(t *testing.T) {
+	v := newTestVaultLocked()
+	domain.MnemonicStoreManager = newSimpleMnemonicStore([]string{
+		"leave", "dice", "fine", "decrease", "dune", "ribbon", "ocean", "earn",
+		"lunar", "account", "silver", "admit", "cheap", "fringe", "disorder", "trade",
+		"because", "trade", "steak", "clock", "grace", "video", "jacket", "equal",
+	})
+	opts := domain.WalletOptions{BaseDerivationPath: "m/84'/1'"}
+
+	err := v.SetWalletOptions(opts)
+	require.NoError(t, err)
+	require.Equal(t, opts, v.WalletOpts)
+
+	_, err = v.DeriveNextExternalAddressForAccount(0)
+	require.NoError(t, err)
+
+	// options other than the base derivation path can still change.
+	opts.GapLimit = 50
+	err = v.SetWalletOptions(opts)
+	require.NoError(t, err)
+	require.Equal(t, opts, v.WalletOpts)
+
+	// an unset base derivation path is the default one.
+	v = newTestVaultLocked()
+	_, err = v.DeriveNextExternalAddressForAccount(0)
+	require.NoError(t, err)
+
+	opts = domain.WalletOptions{
+		BaseDerivationPath: wallet.DefaultBaseDerivationPath.String(),
+	}
+	err = v.SetWalletOptions(opts)
+	require.NoError(t, err)
+	require.Equal(t, opts, v.WalletOpts)
+}
+
+func TestFailingSetWalletOptions(t *testing.T) {
+	v := newTestVaultLocked()
+	domain.MnemonicStoreManager = newSimpleMnemonicStore([]string{
+		"leave", "dice", "fine", "decrease", "dune", "ribbon", "ocean", "earn",
+		"lunar", "account", "silver", "admit", "cheap", "fringe", "disorder", "trade",
+		"because", "trade", "steak", "clock", "grace", "video", "jacket", "equal",
+	})
+
+	_, err := v.DeriveNextExternalAddressForAccount(0)
+	require.NoError(t, err)
+
+	err = v.SetWalletOptions(domain.WalletOptions{
+		BaseDerivationPath: "m/84'/1'",
+	})
+	require.EqualError(t, err, domain.ErrVaultBaseDerivationPathChanged.Error())
+	require.Equal(t, domain.WalletOptions{}, v.WalletOpts)
+}
+
 func TestAccountByIndex(t *testing.T) {
 	v := newTestVaultLocked()
 	domain.MnemonicStoreManager = newSimpleMnemonicStore(nil)
@@ -277,6 +336,40 @@ func TestAllDerivedAddressesInfoForAccount(t *testing.T) {
 	require.Equal(t, inInfo.BlindingKey, blindkeys[1])
 }
 
+func TestAllDerivedNestedAddressesInfoForAccount(t *testing.T) {
+	v := newTestVaultLocked()
+	domain.MnemonicStoreManager = newSimpleMnemonicStore([]string{
+		"leave", "dice", "fine", "decrease", "dune", "ribbon", "ocean", "earn",
+		"lunar", "account", "silver", "admit", "cheap", "fringe", "disorder", "trade",
+		"because", "trade", "steak", "clock", "grace", "video", "jacket", "equal",
+	})
+	accountIndex := 5
+	err := v.SetWalletOptions(domain.WalletOptions{
+		DepositAddressType: wallet.NestedSegwitAddress,
+	})
+	require.NoError(t, err)
+	extInfo, err := v.DeriveNextExternalAddressForAccount(accountIndex)
+	require.NoError(t, err)
+	inInfo, err := v.DeriveNextInternalAddressForAccount(accountIndex)
+	require.NoError(t, err)
+
+	extScript, _ := hex.DecodeString(extInfo.Script)
+	require.Equal(t, address.P2ShScript, address.GetScriptType(extScript))
+	inScript, _ := hex.DecodeString(inInfo.Script)
+	require.Equal(t, address.P2WpkhScript, address.GetScriptType(inScript))
+
+	// already derived addresses keep their type if the configured one changes.
+	err = v.SetWalletOptions(domain.WalletOptions{
+		DepositAddressType: wallet.NativeSegwitAddress,
+	})
+	require.NoError(t, err)
+	allInfo, err := v.AllDerivedAddressesInfoForAccount(accountIndex)
+	require.NoError(t, err)
+	require.Len(t, allInfo, 2)
+	require.Equal(t, *extInfo, allInfo[0])
+	require.Equal(t, *inInfo, allInfo[1])
+}
+
 func TestFailingAllDerivedAddressesInfoForAccount(t *testing.T) {
 	accountIndex := 6
 
@@ -351,6 +444,76 @@ func TestFailingAllDerivedExternalAddressesInfoForAccount(t *testing.T) {
 	})
 }
 
+func TestAccountXPub(t *testing.T) {
+	v := newTestVaultLocked()
+	domain.MnemonicStoreManager = newSimpleMnemonicStore([]string{
+		"leave", "dice", "fine", "decrease", "dune", "ribbon", "ocean", "earn",
+		"lunar", "account", "silver", "admit", "cheap", "fringe", "disorder", "trade",
+		"because", "trade", "steak", "clock", "grace", "video", "jacket", "equal",
+	})
+	accountIndex := 9
+
+	info, err := v.DeriveNextExternalAddressForAccount(accountIndex)
+	require.NoError(t, err)
+
+	xpub, err := v.AccountXPub(accountIndex)
+	require.NoError(t, err)
+	masterBlindingKey, err := v.MasterBlindingKey()
+	require.NoError(t, err)
+
+	// the first external address of the account must be derivable from the
+	// exported keys only.
+	key, err := hdkeychain.NewKeyFromString(xpub)
+	require.NoError(t, err)
+	require.False(t, key.IsPrivate())
+	key, err = key.Child(domain.ExternalChain)
+	require.NoError(t, err)
+	key, err = key.Child(0)
+	require.NoError(t, err)
+	pubkey, err := key.ECPubKey()
+	require.NoError(t, err)
+	script := payment.FromPublicKey(pubkey, v.Network, nil).WitnessScript
+	require.Equal(t, info.Script, hex.EncodeToString(script))
+
+	masterKey, err := hex.DecodeString(masterBlindingKey)
+	require.NoError(t, err)
+	slip77Node, err := slip77.FromMasterKey(masterKey)
+	require.NoError(t, err)
+	blindingKey, _, err := slip77Node.DeriveKey(script)
+	require.NoError(t, err)
+	require.Equal(t, info.BlindingKey, blindingKey.Serialize())
+}
+
+func TestFailingAccountXPub(t *testing.T) {
+	accountIndex := 10
+
+	t.Run("failing_because_locked", func(t *testing.T) {
+		v := newTestVaultLocked()
+		domain.MnemonicStoreManager = newSimpleMnemonicStore(nil)
+
+		xpub, err := v.AccountXPub(accountIndex)
+		require.EqualError(t, err, domain.ErrVaultMustBeUnlocked.Error())
+		require.Empty(t, xpub)
+
+		masterBlindingKey, err := v.MasterBlindingKey()
+		require.EqualError(t, err, domain.ErrVaultMustBeUnlocked.Error())
+		require.Empty(t, masterBlindingKey)
+	})
+
+	t.Run("failing_because_account_not_found", func(t *testing.T) {
+		v := newTestVaultLocked()
+		domain.MnemonicStoreManager = newSimpleMnemonicStore([]string{
+			"leave", "dice", "fine", "decrease", "dune", "ribbon", "ocean", "earn",
+			"lunar", "account", "silver", "admit", "cheap", "fringe", "disorder", "trade",
+			"because", "trade", "steak", "clock", "grace", "video", "jacket", "equal",
+		})
+
+		xpub, err := v.AccountXPub(accountIndex)
+		require.EqualError(t, err, domain.ErrVaultAccountNotFound.Error())
+		require.Empty(t, xpub)
+	})
+}
+
 func newTestVaultEmpty() *domain.Vault {
 	return &domain.Vault{}
 }
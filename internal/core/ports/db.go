@@ -12,8 +12,11 @@ type RepoManager interface {
 	MarketRepository() domain.MarketRepository
 	UnspentRepository() domain.UnspentRepository
 	TradeRepository() domain.TradeRepository
+	FeeRepository() domain.FeeRepository
 
 	Close()
+	// IsClosed returns whether the connection with the database is closed.
+	IsClosed() bool
 
 	NewTransaction() Transaction
 	NewPricesTransaction() Transaction
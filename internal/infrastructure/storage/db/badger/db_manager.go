@@ -26,38 +26,54 @@ type repoManager struct {
 	unspentRepository domain.UnspentRepository
 	tradeRepository   domain.TradeRepository
 	vaultRepository   domain.VaultRepository
+	feeRepository     domain.FeeRepository
+
+	tradeIndex *tradeIndex
 }
 
 // NewRepoManager opens (or creates if not exists) the badger store on disk.
-// It expects a base data dir and an optional logger.
+// It expects a base data dir, an optional logger and an optional encryption
+// key of 16, 24 or 32 bytes.
 // It creates a dedicated directory for main and prices stores, while the
 // unspent repository lives in memory.
-func NewRepoManager(baseDbDir string, logger badger.Logger) (ports.RepoManager, error) {
+// If the key is given, the on-disk stores are encrypted at rest with it. Stores
+// previously created in plaintext are encrypted the first time they're opened
+// with a key, while opening encrypted stores with the wrong key fails with
+// ErrInvalidEncryptionKey.
+func NewRepoManager(
+	baseDbDir string,
+	logger badger.Logger,
+	encryptionKey []byte,
+) (ports.RepoManager, error) {
 	var maindbDir, pricedbDir string
 	if len(baseDbDir) > 0 {
 		maindbDir = filepath.Join(baseDbDir, "main")
 		pricedbDir = filepath.Join(baseDbDir, "prices")
 	}
 
-	mainDb, err := createDb(maindbDir, logger)
+	mainDb, err := createDb(maindbDir, logger, encryptionKey)
 	if err != nil {
 		return nil, fmt.Errorf("opening main db: %w", err)
 	}
 
-	priceDb, err := createDb(pricedbDir, logger)
+	priceDb, err := createDb(pricedbDir, logger, encryptionKey)
 	if err != nil {
 		return nil, fmt.Errorf("opening prices db: %w", err)
 	}
 
-	unspentDb, err := createDb("", logger)
+	unspentDb, err := createDb("", logger, nil)
 	if err != nil {
 		return nil, fmt.Errorf("opening unspents db: %w", err)
 	}
 
 	marketRepo := NewMarketRepositoryImpl(mainDb, priceDb)
 	unspentRepo := NewUnspentRepositoryImpl(unspentDb, mainDb)
-	tradeRepo := NewTradeRepositoryImpl(mainDb)
+	tradeRepo, tradeIndex, err := newTradeRepositoryImpl(mainDb)
+	if err != nil {
+		return nil, fmt.Errorf("indexing trades: %w", err)
+	}
 	vaultRepo := NewVaultRepositoryImpl(mainDb)
+	feeRepo := NewFeeRepositoryImpl(mainDb)
 
 	return &repoManager{
 		store:             mainDb,
@@ -67,6 +83,8 @@ func NewRepoManager(baseDbDir string, logger badger.Logger) (ports.RepoManager,
 		unspentRepository: unspentRepo,
 		tradeRepository:   tradeRepo,
 		vaultRepository:   vaultRepo,
+		feeRepository:     feeRepo,
+		tradeIndex:        tradeIndex,
 	}, nil
 }
 
@@ -86,12 +104,23 @@ func (d *repoManager) VaultRepository() domain.VaultRepository {
 	return d.vaultRepository
 }
 
+func (d *repoManager) FeeRepository() domain.FeeRepository {
+	return d.feeRepository
+}
+
 func (d *repoManager) Close() {
 	d.store.Close()
 	d.priceStore.Close()
 	d.unspentStore.Close()
 }
 
+// IsClosed implements the RepoManager interface
+func (d *repoManager) IsClosed() bool {
+	return d.store.Badger().IsClosed() ||
+		d.priceStore.Badger().IsClosed() ||
+		d.unspentStore.Badger().IsClosed()
+}
+
 // NewTransaction implements the RepoManager interface
 func (d *repoManager) NewTransaction() ports.Transaction {
 	return d.store.Badger().NewTransaction(true)
@@ -119,10 +148,17 @@ func (d *repoManager) RunTransaction(
 		_ctx := context.WithValue(ctx, "tx", tx)
 		return tx, _ctx
 	}
+	// changes to the trades are reflected by their index only if committed.
 	return d.runTransaction(runTransactionArgs{
 		ctxMaker: ctxMaker,
 		readOnly: readOnly,
 		handler:  handler,
+		onCommit: func(tx ports.Transaction) {
+			d.tradeIndex.commit(tx.(*badger.Txn))
+		},
+		onDiscard: func(tx ports.Transaction) {
+			d.tradeIndex.discard(tx.(*badger.Txn))
+		},
 	})
 }
 
@@ -167,9 +203,11 @@ func (d *repoManager) RunPricesTransaction(
 }
 
 type runTransactionArgs struct {
-	ctxMaker func() (ports.Transaction, context.Context)
-	readOnly bool
-	handler  func(ctx context.Context) (interface{}, error)
+	ctxMaker  func() (ports.Transaction, context.Context)
+	readOnly  bool
+	handler   func(ctx context.Context) (interface{}, error)
+	onCommit  func(tx ports.Transaction)
+	onDiscard func(tx ports.Transaction)
 }
 
 func (d *repoManager) runTransaction(
@@ -179,6 +217,7 @@ func (d *repoManager) runTransaction(
 		tx, ctx := args.ctxMaker()
 		res, err := args.handler(ctx)
 		if err != nil {
+			args.discarded(tx)
 			if args.readOnly && isTransactionConflict(err) {
 				time.Sleep(50 * time.Millisecond)
 				continue
@@ -188,17 +227,29 @@ func (d *repoManager) runTransaction(
 
 		if !args.readOnly {
 			if err := tx.Commit(); err != nil {
+				args.discarded(tx)
 				if !isTransactionConflict(err) {
 					return nil, err
 				}
 				time.Sleep(50 * time.Millisecond)
 				continue
 			}
+			if args.onCommit != nil {
+				args.onCommit(tx)
+			}
+			return res, nil
 		}
+		args.discarded(tx)
 		return res, nil
 	}
 }
 
+func (a runTransactionArgs) discarded(tx ports.Transaction) {
+	if a.onDiscard != nil {
+		a.onDiscard(tx)
+	}
+}
+
 // isTransactionConflict returns wheter the error occured when commiting a
 // transacton is a conflict
 func isTransactionConflict(err error) bool {
@@ -243,25 +294,20 @@ func EncodeKey(key interface{}, typeName string) ([]byte, error) {
 	return append([]byte(typeName), encoded...), nil
 }
 
-func createDb(dbDir string, logger badger.Logger) (*badgerhold.Store, error) {
+func createDb(
+	dbDir string,
+	logger badger.Logger,
+	encryptionKey []byte,
+) (*badgerhold.Store, error) {
 	isInMemory := len(dbDir) <= 0
 
-	opts := badger.DefaultOptions(dbDir)
-	opts.Logger = logger
-
-	if isInMemory {
-		opts.InMemory = true
+	var db *badgerhold.Store
+	var err error
+	if isInMemory || len(encryptionKey) <= 0 {
+		db, err = openDb(dbDir, nil, logger)
 	} else {
-		opts.ValueLogLoadingMode = options.FileIO
-		opts.Compression = options.ZSTD
+		db, err = openEncryptedDb(dbDir, encryptionKey, logger)
 	}
-
-	db, err := badgerhold.Open(badgerhold.Options{
-		Encoder:          badgerhold.DefaultEncode,
-		Decoder:          badgerhold.DefaultDecode,
-		SequenceBandwith: 100,
-		Options:          opts,
-	})
 	if err != nil {
 		return nil, err
 	}
@@ -281,3 +327,30 @@ func createDb(dbDir string, logger badger.Logger) (*badgerhold.Store, error) {
 
 	return db, nil
 }
+
+func openDb(
+	dbDir string,
+	encryptionKey []byte,
+	logger badger.Logger,
+) (*badgerhold.Store, error) {
+	opts := badger.DefaultOptions(dbDir)
+	opts.Logger = logger
+
+	if len(dbDir) <= 0 {
+		opts.InMemory = true
+	} else {
+		opts.ValueLogLoadingMode = options.FileIO
+		opts.Compression = options.ZSTD
+	}
+	if len(encryptionKey) > 0 {
+		opts.EncryptionKey = encryptionKey
+		opts.IndexCacheSize = indexCacheSize
+	}
+
+	return badgerhold.Open(badgerhold.Options{
+		Encoder:          badgerhold.DefaultEncode,
+		Decoder:          badgerhold.DefaultDecode,
+		SequenceBandwith: 100,
+		Options:          opts,
+	})
+}
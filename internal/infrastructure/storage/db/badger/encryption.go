@@ -0,0 +1,180 @@
+package dbbadger
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/tdex-network/tdex-daemon/pkg/wallet"
+	"github.com/timshannon/badgerhold/v2"
+)
+
+const (
+	saltFileName = "encryption.salt"
+	// indexCacheSize is the size of the cache of the decrypted indexes of the
+	// tables, recommended by badger whenever encryption is enabled.
+	indexCacheSize = 100 << 20
+	// bakDirSuffix is appended to the dir of a plaintext db that is being
+	// replaced by its encrypted copy.
+	bakDirSuffix = ".bak"
+)
+
+// ErrInvalidEncryptionKey is returned when opening an encrypted db with a key
+// other than the one it was encrypted with.
+var ErrInvalidEncryptionKey = errors.New(
+	"db encryption key doesn't match the one of the existing db",
+)
+
+// DeriveEncryptionKey derives a 32-byte key from the given passphrase with
+// the same scrypt parameters used for the mnemonic of the wallet.
+// The random salt is generated the first time and stored in the given base db
+// dir, so that the same passphrase always derives the same key for that db.
+func DeriveEncryptionKey(baseDbDir, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, errors.New("db encryption passphrase must not be empty")
+	}
+
+	saltFile := filepath.Join(baseDbDir, saltFileName)
+	salt, err := ioutil.ReadFile(saltFile)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	key, newSalt, err := wallet.DeriveKey([]byte(passphrase), salt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(salt) <= 0 {
+		if err := os.MkdirAll(baseDbDir, os.ModeDir|0755); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(saltFile, newSalt, 0600); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// openEncryptedDb opens the db in the given dir with the given key, possibly
+// encrypting it first if it was created in plaintext.
+func openEncryptedDb(
+	dbDir string,
+	encryptionKey []byte,
+	logger badger.Logger,
+) (*badgerhold.Store, error) {
+	if err := restoreDbBackup(dbDir); err != nil {
+		return nil, err
+	}
+
+	db, err := openDb(dbDir, encryptionKey, logger)
+	if err == nil {
+		return db, nil
+	}
+	if !errors.Is(err, badger.ErrEncryptionKeyMismatch) {
+		return nil, err
+	}
+
+	// the key doesn't match, either because it's wrong or because the db is
+	// not encrypted yet. In the latter case it can be opened without key.
+	plainDb, err := openDb(dbDir, nil, logger)
+	if err != nil {
+		if errors.Is(err, badger.ErrEncryptionKeyMismatch) {
+			return nil, ErrInvalidEncryptionKey
+		}
+		return nil, err
+	}
+	if err := encryptDb(plainDb, dbDir, encryptionKey, logger); err != nil {
+		return nil, fmt.Errorf("encrypting db: %w", err)
+	}
+
+	return openDb(dbDir, encryptionKey, logger)
+}
+
+// encryptDb copies all the entries of the given plaintext db into a new one,
+// encrypted with the given key, that replaces the former in the given dir.
+// The given db is closed.
+func encryptDb(
+	plainDb *badgerhold.Store,
+	dbDir string,
+	encryptionKey []byte,
+	logger badger.Logger,
+) error {
+	backupFile, err := ioutil.TempFile(filepath.Dir(dbDir), "backup")
+	if err != nil {
+		plainDb.Close()
+		return err
+	}
+	defer os.Remove(backupFile.Name())
+	defer backupFile.Close()
+
+	_, err = plainDb.Badger().Backup(backupFile, 0)
+	plainDb.Close()
+	if err != nil {
+		return err
+	}
+	if _, err := backupFile.Seek(0, 0); err != nil {
+		return err
+	}
+
+	tmpDir := dbDir + ".encrypted"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+	encryptedDb, err := openDb(tmpDir, encryptionKey, logger)
+	if err != nil {
+		return err
+	}
+	if err := encryptedDb.Badger().Load(backupFile, 256); err != nil {
+		encryptedDb.Close()
+		os.RemoveAll(tmpDir)
+		return err
+	}
+	if err := encryptedDb.Close(); err != nil {
+		return err
+	}
+
+	// the plaintext db is kept aside until the encrypted one has replaced it,
+	// so that it can be restored if the swap doesn't complete.
+	bakDir := dbDir + bakDirSuffix
+	if err := os.RemoveAll(bakDir); err != nil {
+		return err
+	}
+	if err := os.Rename(dbDir, bakDir); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpDir, dbDir); err != nil {
+		if rerr := os.Rename(bakDir, dbDir); rerr != nil {
+			return fmt.Errorf(
+				"%s, restoring plaintext db from %s: %s", err, bakDir, rerr,
+			)
+		}
+		return err
+	}
+	return os.RemoveAll(bakDir)
+}
+
+// restoreDbBackup completes a swap of the db in the given dir with its
+// encrypted copy that was interrupted. If the db dir is missing, the plaintext
+// one is moved back in place, otherwise the swap did complete and the leftover
+// is removed.
+func restoreDbBackup(dbDir string) error {
+	bakDir := dbDir + bakDirSuffix
+	if _, err := os.Stat(bakDir); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if _, err := os.Stat(dbDir); err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		return os.Rename(bakDir, dbDir)
+	}
+	return os.RemoveAll(bakDir)
+}
@@ -0,0 +1,85 @@
+package dbbadger
+
+import (
+	"context"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/timshannon/badgerhold/v2"
+)
+
+type feeRepositoryImpl struct {
+	store *badgerhold.Store
+}
+
+// NewFeeRepositoryImpl returns a new badger FeeRepository implementation.
+// The ledger lives in the same store of trades so that a fee can be recorded
+// in the same transaction that settles its trade.
+func NewFeeRepositoryImpl(store *badgerhold.Store) domain.FeeRepository {
+	return feeRepositoryImpl{store}
+}
+
+func (f feeRepositoryImpl) AddCollectedFee(
+	ctx context.Context,
+	fee domain.CollectedFee,
+) error {
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = f.store.TxInsert(tx, fee.TradeID, &fee)
+	} else {
+		err = f.store.Insert(fee.TradeID, &fee)
+	}
+	if err != nil && err != badgerhold.ErrKeyExists {
+		return err
+	}
+	return nil
+}
+
+func (f feeRepositoryImpl) GetAllCollectedFees(
+	ctx context.Context,
+) ([]domain.CollectedFee, error) {
+	query := (&badgerhold.Query{}).SortBy("CollectionTime")
+	return f.findFees(ctx, query)
+}
+
+func (f feeRepositoryImpl) GetCollectedFeesByMarket(
+	ctx context.Context,
+	marketQuoteAsset string,
+) ([]domain.CollectedFee, error) {
+	query := badgerhold.
+		Where("MarketQuoteAsset").Eq(marketQuoteAsset).
+		SortBy("CollectionTime")
+	return f.findFees(ctx, query)
+}
+
+func (f feeRepositoryImpl) GetCollectedFeesByMarketInTimeRange(
+	ctx context.Context,
+	marketQuoteAsset string,
+	from, to uint64,
+) ([]domain.CollectedFee, error) {
+	query := badgerhold.
+		Where("MarketQuoteAsset").Eq(marketQuoteAsset).
+		And("CollectionTime").Ge(from).
+		And("CollectionTime").Le(to).
+		SortBy("CollectionTime")
+	return f.findFees(ctx, query)
+}
+
+func (f feeRepositoryImpl) findFees(
+	ctx context.Context,
+	query *badgerhold.Query,
+) ([]domain.CollectedFee, error) {
+	var fees []domain.CollectedFee
+	var err error
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		err = f.store.TxFind(tx, &fees, query)
+	} else {
+		err = f.store.Find(&fees, query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fees, nil
+}
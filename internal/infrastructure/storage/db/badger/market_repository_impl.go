@@ -54,6 +54,16 @@ func (m marketRepositoryImpl) GetMarketByAsset(
 	return nil, -1, nil
 }
 
+func (m marketRepositoryImpl) GetMarketsByAsset(
+	ctx context.Context,
+	asset string,
+) ([]domain.Market, error) {
+	query := badgerhold.Where("BaseAsset").Eq(asset).
+		Or(badgerhold.Where("QuoteAsset").Eq(asset))
+
+	return m.findMarkets(ctx, query)
+}
+
 func (m marketRepositoryImpl) GetLatestMarket(
 	ctx context.Context,
 ) (market *domain.Market, accountIndex int, err error) {
@@ -0,0 +1,216 @@
+package dbbadger
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/google/uuid"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+type tradeIndexKey struct {
+	status      domain.Status
+	market      string
+	requestTime uint64
+}
+
+// tradeIndex keeps in memory the ids of the stored trades grouped by status
+// and by market, along with their request times, so that looking up trades by
+// any of them doesn't require to scan the whole store. The index is built from the store when this is opened.
+// Changes made within a db transaction are staged and applied to the index
+// only once the transaction is committed, so that it never refers to trades
+// in a state the store has never been in.
+type tradeIndex struct {
+	lock     *sync.RWMutex
+	keys     map[uuid.UUID]tradeIndexKey
+	byStatus map[domain.Status]map[uuid.UUID]struct{}
+	byMarket map[string]map[uuid.UUID]struct{}
+	staged   map[*badger.Txn]map[uuid.UUID]tradeIndexKey
+}
+
+func newTradeIndex() *tradeIndex {
+	return &tradeIndex{
+		lock:     &sync.RWMutex{},
+		keys:     make(map[uuid.UUID]tradeIndexKey),
+		byStatus: make(map[domain.Status]map[uuid.UUID]struct{}),
+		byMarket: make(map[string]map[uuid.UUID]struct{}),
+		staged:   make(map[*badger.Txn]map[uuid.UUID]tradeIndexKey),
+	}
+}
+
+// rebuild replaces the content of the index with the given trades.
+func (i *tradeIndex) rebuild(trades []domain.Trade) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.keys = make(map[uuid.UUID]tradeIndexKey)
+	i.byStatus = make(map[domain.Status]map[uuid.UUID]struct{})
+	i.byMarket = make(map[string]map[uuid.UUID]struct{})
+	for _, t := range trades {
+		i.set(t.ID, keyForTrade(t))
+	}
+}
+
+// put indexes the given trade, or stages the change if the context carries a
+// db transaction.
+func (i *tradeIndex) put(ctx context.Context, trade domain.Trade) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if tx, ok := ctx.Value("tx").(*badger.Txn); ok {
+		if _, ok := i.staged[tx]; !ok {
+			i.staged[tx] = make(map[uuid.UUID]tradeIndexKey)
+		}
+		i.staged[tx][trade.ID] = keyForTrade(trade)
+		return
+	}
+	i.set(trade.ID, keyForTrade(trade))
+}
+
+// commit applies the changes staged by the given transaction.
+func (i *tradeIndex) commit(tx *badger.Txn) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	for id, key := range i.staged[tx] {
+		i.set(id, key)
+	}
+	delete(i.staged, tx)
+}
+
+// discard drops the changes staged by the given transaction.
+func (i *tradeIndex) discard(tx *badger.Txn) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	delete(i.staged, tx)
+}
+
+// idsByStatus returns the ids of the trades in the given status, including
+// the changes staged by the transaction of the context, if any.
+func (i *tradeIndex) idsByStatus(
+	ctx context.Context,
+	status domain.Status,
+) []uuid.UUID {
+	return i.ids(ctx, i.byStatus[status], func(key tradeIndexKey) bool {
+		return key.status == status
+	})
+}
+
+// idsByMarket returns the ids of the trades of the market with the given
+// quote asset, including the changes staged by the transaction of the
+// context, if any.
+func (i *tradeIndex) idsByMarket(
+	ctx context.Context,
+	marketQuoteAsset string,
+) []uuid.UUID {
+	return i.ids(ctx, i.byMarket[marketQuoteAsset], func(key tradeIndexKey) bool {
+		return key.market == marketQuoteAsset
+	})
+}
+
+// idsByRequestTime returns the ids of the trades requested between from and
+// to, both included, sorted by request time, including the changes staged by
+// the transaction of the context, if any.
+func (i *tradeIndex) idsByRequestTime(
+	ctx context.Context,
+	from, to uint64,
+) []uuid.UUID {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	var staged map[uuid.UUID]tradeIndexKey
+	if tx, ok := ctx.Value("tx").(*badger.Txn); ok {
+		staged = i.staged[tx]
+	}
+
+	keys := make(map[uuid.UUID]tradeIndexKey)
+	for id, key := range i.keys {
+		keys[id] = key
+	}
+	for id, key := range staged {
+		keys[id] = key
+	}
+
+	ids := make([]uuid.UUID, 0)
+	for id, key := range keys {
+		if key.requestTime >= from && key.requestTime <= to {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(a, b int) bool {
+		ta, tb := keys[ids[a]].requestTime, keys[ids[b]].requestTime
+		if ta == tb {
+			return bytes.Compare(ids[a][:], ids[b][:]) < 0
+		}
+		return ta < tb
+	})
+	return ids
+}
+
+func (i *tradeIndex) ids(
+	ctx context.Context,
+	bucket map[uuid.UUID]struct{},
+	match func(key tradeIndexKey) bool,
+) []uuid.UUID {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	var staged map[uuid.UUID]tradeIndexKey
+	if tx, ok := ctx.Value("tx").(*badger.Txn); ok {
+		staged = i.staged[tx]
+	}
+
+	ids := make([]uuid.UUID, 0, len(bucket))
+	for id := range bucket {
+		if key, ok := staged[id]; ok && !match(key) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	for id, key := range staged {
+		if _, ok := bucket[id]; !ok && match(key) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// set moves the given trade id to the buckets of the given key. It must be
+// called with the lock held.
+func (i *tradeIndex) set(id uuid.UUID, key tradeIndexKey) {
+	if prevKey, ok := i.keys[id]; ok {
+		if prevKey == key {
+			return
+		}
+		delete(i.byStatus[prevKey.status], id)
+		if len(i.byStatus[prevKey.status]) <= 0 {
+			delete(i.byStatus, prevKey.status)
+		}
+		delete(i.byMarket[prevKey.market], id)
+		if len(i.byMarket[prevKey.market]) <= 0 {
+			delete(i.byMarket, prevKey.market)
+		}
+	}
+
+	i.keys[id] = key
+	if _, ok := i.byStatus[key.status]; !ok {
+		i.byStatus[key.status] = make(map[uuid.UUID]struct{})
+	}
+	i.byStatus[key.status][id] = struct{}{}
+	if _, ok := i.byMarket[key.market]; !ok {
+		i.byMarket[key.market] = make(map[uuid.UUID]struct{})
+	}
+	i.byMarket[key.market][id] = struct{}{}
+}
+
+func keyForTrade(trade domain.Trade) tradeIndexKey {
+	return tradeIndexKey{
+		status:      trade.Status,
+		market:      trade.MarketQuoteAsset,
+		requestTime: trade.SwapRequest.Timestamp,
+	}
+}
@@ -1,7 +1,9 @@
 package dbbadger
 
 import (
+	"bytes"
 	"context"
+	"sort"
 
 	"github.com/dgraph-io/badger/v2"
 	"github.com/google/uuid"
@@ -18,10 +20,22 @@ var tradeTablePrefixKey = []byte(TradeBadgerholdKeyPrefix)
 
 type tradeRepositoryImpl struct {
 	store *badgerhold.Store
+	index *tradeIndex
 }
 
-func NewTradeRepositoryImpl(store *badgerhold.Store) domain.TradeRepository {
-	return tradeRepositoryImpl{store}
+// newTradeRepositoryImpl returns a new badger TradeRepository implementation
+// along with the index of its trades, built from the given store.
+func newTradeRepositoryImpl(
+	store *badgerhold.Store,
+) (domain.TradeRepository, *tradeIndex, error) {
+	var trades []domain.Trade
+	if err := store.Find(&trades, nil); err != nil {
+		return nil, nil, err
+	}
+	index := newTradeIndex()
+	index.rebuild(trades)
+
+	return tradeRepositoryImpl{store, index}, index, nil
 }
 
 func (t tradeRepositoryImpl) GetOrCreateTrade(
@@ -31,6 +45,13 @@ func (t tradeRepositoryImpl) GetOrCreateTrade(
 	return t.getOrCreateTrade(ctx, tradeID)
 }
 
+func (t tradeRepositoryImpl) GetTradeByID(
+	ctx context.Context,
+	tradeID uuid.UUID,
+) (*domain.Trade, error) {
+	return t.getTrade(ctx, tradeID)
+}
+
 func (t tradeRepositoryImpl) GetAllTrades(
 	ctx context.Context,
 ) ([]*domain.Trade, error) {
@@ -41,19 +62,52 @@ func (t tradeRepositoryImpl) GetAllTradesByMarket(
 	ctx context.Context,
 	marketQuoteAsset string,
 ) ([]*domain.Trade, error) {
-	query := badgerhold.Where("MarketQuoteAsset").Eq(marketQuoteAsset)
-	return t.findTrades(ctx, query)
+	return t.getTradesWithIDs(ctx, t.index.idsByMarket(ctx, marketQuoteAsset))
 }
 
 func (t tradeRepositoryImpl) GetCompletedTradesByMarket(
 	ctx context.Context,
 	marketQuoteAsset string,
 ) ([]*domain.Trade, error) {
-	query := badgerhold.
-		Where("MarketQuoteAsset").Eq(marketQuoteAsset).
-		And("Status.Code").Ge(domain.Completed).
-		And("Status.Failed").Eq(false)
-	return t.findTrades(ctx, query)
+	trades, err := t.GetAllTradesByMarket(ctx, marketQuoteAsset)
+	if err != nil {
+		return nil, err
+	}
+
+	completedTrades := make([]*domain.Trade, 0, len(trades))
+	for _, trade := range trades {
+		if trade.Status.Code >= domain.Completed && !trade.Status.Failed {
+			completedTrades = append(completedTrades, trade)
+		}
+	}
+	return completedTrades, nil
+}
+
+func (t tradeRepositoryImpl) GetTradesByStatus(
+	ctx context.Context,
+	status domain.Status,
+) ([]*domain.Trade, error) {
+	return t.getTradesWithIDs(ctx, t.index.idsByStatus(ctx, status))
+}
+
+func (t tradeRepositoryImpl) ForEachTradeByRequestTime(
+	ctx context.Context,
+	from, to uint64,
+	fn func(t *domain.Trade) error,
+) error {
+	for _, id := range t.index.idsByRequestTime(ctx, from, to) {
+		trade, err := t.getTrade(ctx, id)
+		if err != nil {
+			return err
+		}
+		if trade == nil {
+			continue
+		}
+		if err := fn(trade); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (t tradeRepositoryImpl) GetTradeBySwapAcceptID(
@@ -86,7 +140,7 @@ func (t tradeRepositoryImpl) GetTradeByTxID(
 	}
 
 	if len(trades) <= 0 {
-		return nil, ErrTradeNotFound
+		return nil, nil
 	}
 
 	return trades[0], nil
@@ -170,6 +224,29 @@ func (t tradeRepositoryImpl) findTrades(
 	return trades, nil
 }
 
+// getTradesWithIDs returns the trades with the given ids, sorted as they're
+// stored, like those returned by a query.
+func (t tradeRepositoryImpl) getTradesWithIDs(
+	ctx context.Context,
+	ids []uuid.UUID,
+) ([]*domain.Trade, error) {
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i][:], ids[j][:]) < 0
+	})
+
+	trades := make([]*domain.Trade, 0, len(ids))
+	for _, id := range ids {
+		trade, err := t.getTrade(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if trade != nil {
+			trades = append(trades, trade)
+		}
+	}
+	return trades, nil
+}
+
 func (t tradeRepositoryImpl) getTrade(
 	ctx context.Context,
 	ID uuid.UUID,
@@ -197,11 +274,19 @@ func (t tradeRepositoryImpl) updateTrade(
 	ID uuid.UUID,
 	trade domain.Trade,
 ) error {
+	var err error
 	if ctx.Value("tx") != nil {
 		tx := ctx.Value("tx").(*badger.Txn)
-		return t.store.TxUpdate(tx, ID, trade)
+		err = t.store.TxUpdate(tx, ID, trade)
+	} else {
+		err = t.store.Update(ID, trade)
 	}
-	return t.store.Update(ID, trade)
+	if err != nil {
+		return err
+	}
+
+	t.index.put(ctx, trade)
+	return nil
 }
 
 func (t tradeRepositoryImpl) insertTrade(
@@ -219,7 +304,10 @@ func (t tradeRepositoryImpl) insertTrade(
 		if err != badgerhold.ErrKeyExists {
 			return err
 		}
+		return nil
 	}
+
+	t.index.put(ctx, trade)
 	return nil
 }
 
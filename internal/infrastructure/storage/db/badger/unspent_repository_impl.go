@@ -2,6 +2,7 @@ package dbbadger
 
 import (
 	"context"
+	"time"
 
 	"github.com/dgraph-io/badger/v2"
 	"github.com/google/uuid"
@@ -132,8 +133,17 @@ func (u unspentRepositoryImpl) SpendUnspents(
 func (u unspentRepositoryImpl) ConfirmUnspents(
 	ctx context.Context,
 	unspentKeys []domain.UnspentKey,
+	blockHeight uint64,
+	blockHash string,
 ) (int, error) {
-	return u.confirmUnspents(ctx, unspentKeys)
+	return u.confirmUnspents(ctx, unspentKeys, blockHeight, blockHash)
+}
+
+func (u unspentRepositoryImpl) UnconfirmUnspents(
+	ctx context.Context,
+	unspentKeys []domain.UnspentKey,
+) (int, error) {
+	return u.unconfirmUnspents(ctx, unspentKeys)
 }
 
 func (u unspentRepositoryImpl) LockUnspents(
@@ -151,6 +161,20 @@ func (u unspentRepositoryImpl) UnlockUnspents(
 	return u.unlockUnspents(ctx, unspentKeys)
 }
 
+func (u unspentRepositoryImpl) ExcludeUnspents(
+	ctx context.Context,
+	unspentKeys []domain.UnspentKey,
+) (int, error) {
+	return u.excludeUnspents(ctx, unspentKeys, true)
+}
+
+func (u unspentRepositoryImpl) IncludeUnspents(
+	ctx context.Context,
+	unspentKeys []domain.UnspentKey,
+) (int, error) {
+	return u.excludeUnspents(ctx, unspentKeys, false)
+}
+
 func (u unspentRepositoryImpl) addUnspents(
 	ctx context.Context,
 	unspents []domain.Unspent,
@@ -241,10 +265,12 @@ func (u unspentRepositoryImpl) spendUnspent(
 func (u unspentRepositoryImpl) confirmUnspents(
 	ctx context.Context,
 	unspentKeys []domain.UnspentKey,
+	blockHeight uint64,
+	blockHash string,
 ) (int, error) {
 	count := 0
 	for _, key := range unspentKeys {
-		done, err := u.confirmUnspent(ctx, key)
+		done, err := u.confirmUnspent(ctx, key, blockHeight, blockHash)
 		if err != nil {
 			return -1, err
 		}
@@ -258,6 +284,8 @@ func (u unspentRepositoryImpl) confirmUnspents(
 func (u unspentRepositoryImpl) confirmUnspent(
 	ctx context.Context,
 	key domain.UnspentKey,
+	blockHeight uint64,
+	blockHash string,
 ) (bool, error) {
 	unspent, err := u.getUnspent(ctx, key)
 	if err != nil {
@@ -268,7 +296,93 @@ func (u unspentRepositoryImpl) confirmUnspent(
 		return false, nil
 	}
 
-	unspent.Confirm()
+	unspent.Confirm(blockHeight, blockHash)
+	unspent.Unlock() // prevent conflict, locks not stored under unspent prefix
+
+	if err := u.updateUnspent(ctx, key, *unspent); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (u unspentRepositoryImpl) unconfirmUnspents(
+	ctx context.Context,
+	unspentKeys []domain.UnspentKey,
+) (int, error) {
+	count := 0
+	for _, key := range unspentKeys {
+		done, err := u.unconfirmUnspent(ctx, key)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (u unspentRepositoryImpl) unconfirmUnspent(
+	ctx context.Context,
+	key domain.UnspentKey,
+) (bool, error) {
+	unspent, err := u.getUnspent(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	if unspent == nil || !unspent.IsConfirmed() {
+		return false, nil
+	}
+
+	unspent.Unconfirm()
+	unspent.Unlock() // prevent conflict, locks not stored under unspent prefix
+
+	if err := u.updateUnspent(ctx, key, *unspent); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (u unspentRepositoryImpl) excludeUnspents(
+	ctx context.Context,
+	unspentKeys []domain.UnspentKey,
+	excluded bool,
+) (int, error) {
+	count := 0
+	for _, key := range unspentKeys {
+		done, err := u.excludeUnspent(ctx, key, excluded)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (u unspentRepositoryImpl) excludeUnspent(
+	ctx context.Context,
+	key domain.UnspentKey,
+	excluded bool,
+) (bool, error) {
+	unspent, err := u.getUnspent(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	if unspent == nil || unspent.IsExcluded() == excluded {
+		return false, nil
+	}
+
+	if excluded {
+		unspent.Exclude()
+	} else {
+		unspent.Include()
+	}
 	unspent.Unlock() // prevent conflict, locks not stored under unspent prefix
 
 	if err := u.updateUnspent(ctx, key, *unspent); err != nil {
@@ -382,12 +496,12 @@ func (u unspentRepositoryImpl) findUnspents(
 	}
 
 	for i, unspent := range unspents {
-		tradeID, err := u.getLock(ctx, unspent.Key())
+		lock, err := u.getLock(ctx, unspent.Key())
 		if err != nil {
 			return nil, err
 		}
-		if tradeID != nil {
-			unspent.Lock(tradeID)
+		if lock != nil {
+			lock.apply(&unspent)
 			unspents[i] = unspent
 		}
 		if unlockedOnly && !unspent.IsLocked() {
@@ -421,12 +535,12 @@ func (u unspentRepositoryImpl) getUnspent(
 		return nil, err
 	}
 
-	tradeID, err := u.getLock(ctx, key)
+	lock, err := u.getLock(ctx, key)
 	if err != nil {
 		return nil, err
 	}
-	if tradeID != nil {
-		unspent.Lock(tradeID)
+	if lock != nil {
+		lock.apply(&unspent)
 	}
 
 	return &unspent, nil
@@ -467,12 +581,22 @@ func (u unspentRepositoryImpl) insertUnspent(
 
 type LockedUnspent struct {
 	TradeID uuid.UUID
+	// Timestamp is the Unix time of the lock, 0 for those stored before it was
+	// recorded.
+	Timestamp uint64
+}
+
+// apply locks the given unspent as stored, preserving the time of the lock.
+func (l LockedUnspent) apply(unspent *domain.Unspent) {
+	tradeID := l.TradeID
+	unspent.Lock(&tradeID)
+	unspent.LockTimestamp = l.Timestamp
 }
 
 func (u unspentRepositoryImpl) getLock(
 	ctx context.Context,
 	key domain.UnspentKey,
-) (*uuid.UUID, error) {
+) (*LockedUnspent, error) {
 	var lockedUnspent LockedUnspent
 	var err error
 
@@ -494,7 +618,7 @@ func (u unspentRepositoryImpl) getLock(
 		return nil, err
 	}
 
-	return &lockedUnspent.TradeID, nil
+	return &lockedUnspent, nil
 }
 
 func (u unspentRepositoryImpl) insertLock(
@@ -509,11 +633,12 @@ func (u unspentRepositoryImpl) insertLock(
 		return err
 	}
 
+	lock := LockedUnspent{tradeID, uint64(time.Now().Unix())}
 	if ctx.Value("tx") != nil {
 		tx := ctx.Value("tx").(*badger.Txn)
-		err = u.lockStore.TxInsert(tx, encKey, LockedUnspent{tradeID})
+		err = u.lockStore.TxInsert(tx, encKey, lock)
 	} else {
-		err = u.lockStore.Insert(encKey, LockedUnspent{tradeID})
+		err = u.lockStore.Insert(encKey, lock)
 	}
 	if err != nil {
 		if err != badgerhold.ErrKeyExists {
@@ -28,6 +28,12 @@ type unspentInmemoryStore struct {
 	locker   *sync.RWMutex
 }
 
+type feeInmemoryStore struct {
+	fees   map[uuid.UUID]domain.CollectedFee
+	ledger []uuid.UUID
+	locker *sync.RWMutex
+}
+
 type vaultInmemoryStore struct {
 	vault  *domain.Vault
 	locker *sync.Mutex
@@ -38,11 +44,13 @@ type RepoManager struct {
 	tradeStore   *tradeInmemoryStore
 	unspentStore *unspentInmemoryStore
 	vaultStore   *vaultInmemoryStore
+	feeStore     *feeInmemoryStore
 
 	marketRepository  domain.MarketRepository
 	unspentRepository domain.UnspentRepository
 	tradeRepository   domain.TradeRepository
 	vaultRepository   domain.VaultRepository
+	feeRepository     domain.FeeRepository
 }
 
 type InmemoryTx struct {
@@ -82,21 +90,29 @@ func NewRepoManager() ports.RepoManager {
 		vault:  &domain.Vault{},
 		locker: &sync.Mutex{},
 	}
+	feeStore := &feeInmemoryStore{
+		fees:   map[uuid.UUID]domain.CollectedFee{},
+		ledger: []uuid.UUID{},
+		locker: &sync.RWMutex{},
+	}
 
 	marketRepo := NewMarketRepositoryImpl(marketStore)
 	tradeRepo := NewTradeRepositoryImpl(tradeStore)
 	unspentRepo := NewUnspentRepositoryImpl(unspentStore)
 	vaultRepo := NewVaultRepositoryImpl(vaultStore)
+	feeRepo := NewFeeRepositoryImpl(feeStore)
 
 	return &RepoManager{
 		marketStore:       marketStore,
 		tradeStore:        tradeStore,
 		unspentStore:      unspentStore,
 		vaultStore:        vaultStore,
+		feeStore:          feeStore,
 		marketRepository:  marketRepo,
 		tradeRepository:   tradeRepo,
 		unspentRepository: unspentRepo,
 		vaultRepository:   vaultRepo,
+		feeRepository:     feeRepo,
 	}
 }
 
@@ -116,8 +132,16 @@ func (d *RepoManager) VaultRepository() domain.VaultRepository {
 	return d.vaultRepository
 }
 
+func (d *RepoManager) FeeRepository() domain.FeeRepository {
+	return d.feeRepository
+}
+
 func (d *RepoManager) Close() {}
 
+func (d *RepoManager) IsClosed() bool {
+	return false
+}
+
 func (db *RepoManager) NewTransaction() ports.Transaction {
 	return &InmemoryTx{
 		db:      db,
@@ -0,0 +1,82 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+type feeRepositoryImpl struct {
+	store *feeInmemoryStore
+}
+
+// NewFeeRepositoryImpl returns a new inmemory FeeRepository implementation.
+func NewFeeRepositoryImpl(store *feeInmemoryStore) domain.FeeRepository {
+	return &feeRepositoryImpl{store}
+}
+
+func (r feeRepositoryImpl) AddCollectedFee(
+	_ context.Context,
+	fee domain.CollectedFee,
+) error {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	if _, ok := r.store.fees[fee.TradeID]; ok {
+		return nil
+	}
+	r.store.fees[fee.TradeID] = fee
+	r.store.ledger = append(r.store.ledger, fee.TradeID)
+	return nil
+}
+
+func (r feeRepositoryImpl) GetAllCollectedFees(
+	_ context.Context,
+) ([]domain.CollectedFee, error) {
+	r.store.locker.RLock()
+	defer r.store.locker.RUnlock()
+
+	return r.findFees(func(domain.CollectedFee) bool { return true }), nil
+}
+
+func (r feeRepositoryImpl) GetCollectedFeesByMarket(
+	_ context.Context,
+	marketQuoteAsset string,
+) ([]domain.CollectedFee, error) {
+	r.store.locker.RLock()
+	defer r.store.locker.RUnlock()
+
+	return r.findFees(func(fee domain.CollectedFee) bool {
+		return fee.MarketQuoteAsset == marketQuoteAsset
+	}), nil
+}
+
+func (r feeRepositoryImpl) GetCollectedFeesByMarketInTimeRange(
+	_ context.Context,
+	marketQuoteAsset string,
+	from, to uint64,
+) ([]domain.CollectedFee, error) {
+	r.store.locker.RLock()
+	defer r.store.locker.RUnlock()
+
+	return r.findFees(func(fee domain.CollectedFee) bool {
+		return fee.MarketQuoteAsset == marketQuoteAsset &&
+			fee.CollectionTime >= from && fee.CollectionTime <= to
+	}), nil
+}
+
+func (r feeRepositoryImpl) findFees(
+	match func(domain.CollectedFee) bool,
+) []domain.CollectedFee {
+	fees := make([]domain.CollectedFee, 0)
+	for _, tradeID := range r.store.ledger {
+		if fee := r.store.fees[tradeID]; match(fee) {
+			fees = append(fees, fee)
+		}
+	}
+	sort.SliceStable(fees, func(i, j int) bool {
+		return fees[i].CollectionTime < fees[j].CollectionTime
+	})
+	return fees
+}
@@ -41,6 +41,21 @@ func (r MarketRepositoryImpl) GetMarketByAsset(_ context.Context, quoteAsset str
 	return r.getMarketByAsset(quoteAsset)
 }
 
+// GetMarketsByAsset returns all the markets with the given asset hash as
+// either base or quote asset
+func (r MarketRepositoryImpl) GetMarketsByAsset(_ context.Context, asset string) ([]domain.Market, error) {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	markets := make([]domain.Market, 0)
+	for _, mkt := range r.store.markets {
+		if mkt.BaseAsset == asset || mkt.QuoteAsset == asset {
+			markets = append(markets, mkt)
+		}
+	}
+	return markets, nil
+}
+
 // GetLatestMarket returns the latest stored market (either funded or not)
 func (r MarketRepositoryImpl) GetLatestMarket(_ context.Context) (market *domain.Market, accountIndex int, err error) {
 	r.store.locker.Lock()
@@ -1,7 +1,9 @@
 package inmemory
 
 import (
+	"bytes"
 	"context"
+	"sort"
 
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 
@@ -24,6 +26,20 @@ func (r tradeRepositoryImpl) GetOrCreateTrade(_ context.Context, tradeID *uuid.U
 	return r.getOrCreateTrade(tradeID)
 }
 
+func (r tradeRepositoryImpl) GetTradeByID(
+	_ context.Context,
+	tradeID uuid.UUID,
+) (*domain.Trade, error) {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	trade, ok := r.store.trades[tradeID]
+	if !ok {
+		return nil, nil
+	}
+	return &trade, nil
+}
+
 func (r tradeRepositoryImpl) GetAllTrades(_ context.Context) ([]*domain.Trade, error) {
 	r.store.locker.Lock()
 	defer r.store.locker.Unlock()
@@ -57,6 +73,23 @@ func (r tradeRepositoryImpl) GetCompletedTradesByMarket(ctx context.Context, mar
 	return completedTrades, nil
 }
 
+func (r tradeRepositoryImpl) GetTradesByStatus(
+	_ context.Context,
+	status domain.Status,
+) ([]*domain.Trade, error) {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	trades := make([]*domain.Trade, 0)
+	for _, t := range r.store.trades {
+		if t.Status == status {
+			trade := t
+			trades = append(trades, &trade)
+		}
+	}
+	return trades, nil
+}
+
 func (r tradeRepositoryImpl) GetTradeByTxID(
 	ctx context.Context,
 	txID string,
@@ -77,6 +110,44 @@ func (r tradeRepositoryImpl) GetTradeByTxID(
 	return nil, nil
 }
 
+func (r tradeRepositoryImpl) ForEachTradeByRequestTime(
+	_ context.Context,
+	from, to uint64,
+	fn func(t *domain.Trade) error,
+) error {
+	r.store.locker.Lock()
+	ids := make([]uuid.UUID, 0)
+	for id, t := range r.store.trades {
+		if ts := t.SwapRequest.Timestamp; ts >= from && ts <= to {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		ti := r.store.trades[ids[i]].SwapRequest.Timestamp
+		tj := r.store.trades[ids[j]].SwapRequest.Timestamp
+		if ti == tj {
+			return bytes.Compare(ids[i][:], ids[j][:]) < 0
+		}
+		return ti < tj
+	})
+	r.store.locker.Unlock()
+
+	// fn is called without holding the lock, so that it can make use of the
+	// repository.
+	for _, id := range ids {
+		r.store.locker.Lock()
+		trade, ok := r.store.trades[id]
+		r.store.locker.Unlock()
+		if !ok {
+			continue
+		}
+		if err := fn(&trade); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r tradeRepositoryImpl) GetTradeBySwapAcceptID(_ context.Context, swapAcceptID string) (*domain.Trade, error) {
 	r.store.locker.Lock()
 	defer r.store.locker.Unlock()
@@ -170,6 +170,8 @@ func (r UnspentRepositoryImpl) SpendUnspents(
 func (r UnspentRepositoryImpl) ConfirmUnspents(
 	ctx context.Context,
 	unspentKeys []domain.UnspentKey,
+	blockHeight uint64,
+	blockHash string,
 ) (int, error) {
 	r.store.locker.Lock()
 	defer r.store.locker.Unlock()
@@ -178,7 +180,7 @@ func (r UnspentRepositoryImpl) ConfirmUnspents(
 	for _, key := range unspentKeys {
 		if unspent, ok := r.store.unspents[key]; ok {
 			if !unspent.IsConfirmed() {
-				unspent.Confirm()
+				unspent.Confirm(blockHeight, blockHash)
 				unspent.Unlock()
 				r.store.unspents[key] = unspent
 				count++
@@ -189,6 +191,28 @@ func (r UnspentRepositoryImpl) ConfirmUnspents(
 	return count, nil
 }
 
+// UnconfirmUnspents ...
+func (r UnspentRepositoryImpl) UnconfirmUnspents(
+	_ context.Context,
+	unspentKeys []domain.UnspentKey,
+) (int, error) {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	count := 0
+	for _, key := range unspentKeys {
+		if unspent, ok := r.store.unspents[key]; ok {
+			if unspent.IsConfirmed() {
+				unspent.Unconfirm()
+				r.store.unspents[key] = unspent
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
+
 // LockUnspents ...
 func (r UnspentRepositoryImpl) LockUnspents(
 	_ context.Context,
@@ -212,6 +236,50 @@ func (r UnspentRepositoryImpl) UnlockUnspents(
 	return r.unlockUnspents(unspentKeys)
 }
 
+// ExcludeUnspents ...
+func (r UnspentRepositoryImpl) ExcludeUnspents(
+	_ context.Context,
+	unspentKeys []domain.UnspentKey,
+) (int, error) {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	count := 0
+	for _, key := range unspentKeys {
+		if unspent, ok := r.store.unspents[key]; ok {
+			if !unspent.IsExcluded() {
+				unspent.Exclude()
+				r.store.unspents[key] = unspent
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// IncludeUnspents ...
+func (r UnspentRepositoryImpl) IncludeUnspents(
+	_ context.Context,
+	unspentKeys []domain.UnspentKey,
+) (int, error) {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	count := 0
+	for _, key := range unspentKeys {
+		if unspent, ok := r.store.unspents[key]; ok {
+			if unspent.IsExcluded() {
+				unspent.Include()
+				r.store.unspents[key] = unspent
+				count++
+			}
+		}
+	}
+
+	return count, nil
+}
+
 func (r UnspentRepositoryImpl) addUnspents(unspents []domain.Unspent) error {
 	//add new unspent
 	for _, newUnspent := range unspents {
@@ -0,0 +1,123 @@
+package db_test
+
+import (
+	"context"
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	dbbadger "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/badger"
+)
+
+func TestEncryptedRepoManager(t *testing.T) {
+	dbDir, err := ioutil.TempDir("", "db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	key := randomKey(t)
+
+	repoManager, err := dbbadger.NewRepoManager(dbDir, nil, key)
+	require.NoError(t, err)
+	addMarket(t, repoManager, domain.MarketAccountStart)
+	repoManager.Close()
+
+	repoManager, err = dbbadger.NewRepoManager(dbDir, nil, key)
+	require.NoError(t, err)
+	requireMarkets(t, repoManager, 1)
+	repoManager.Close()
+
+	_, err = dbbadger.NewRepoManager(dbDir, nil, randomKey(t))
+	require.ErrorIs(t, err, dbbadger.ErrInvalidEncryptionKey)
+
+	_, err = dbbadger.NewRepoManager(dbDir, nil, nil)
+	require.Error(t, err)
+}
+
+func TestEncryptPlaintextRepoManager(t *testing.T) {
+	dbDir, err := ioutil.TempDir("", "db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	repoManager, err := dbbadger.NewRepoManager(dbDir, nil, nil)
+	require.NoError(t, err)
+	addMarket(t, repoManager, domain.MarketAccountStart)
+	addMarket(t, repoManager, domain.MarketAccountStart+1)
+	repoManager.Close()
+
+	key := randomKey(t)
+
+	repoManager, err = dbbadger.NewRepoManager(dbDir, nil, key)
+	require.NoError(t, err)
+	requireMarkets(t, repoManager, 2)
+	repoManager.Close()
+
+	_, err = dbbadger.NewRepoManager(dbDir, nil, nil)
+	require.Error(t, err)
+
+	_, err = dbbadger.NewRepoManager(dbDir, nil, randomKey(t))
+	require.ErrorIs(t, err, dbbadger.ErrInvalidEncryptionKey)
+}
+
+func TestEncryptPlaintextRepoManagerAfterInterruptedSwap(t *testing.T) {
+	dbDir, err := ioutil.TempDir("", "db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	repoManager, err := dbbadger.NewRepoManager(dbDir, nil, nil)
+	require.NoError(t, err)
+	addMarket(t, repoManager, domain.MarketAccountStart)
+	repoManager.Close()
+
+	// simulate a crash right after the plaintext main db has been moved aside
+	// and before its encrypted copy has taken its place.
+	mainDbDir := filepath.Join(dbDir, "main")
+	err = os.Rename(mainDbDir, mainDbDir+".bak")
+	require.NoError(t, err)
+
+	repoManager, err = dbbadger.NewRepoManager(dbDir, nil, randomKey(t))
+	require.NoError(t, err)
+	requireMarkets(t, repoManager, 1)
+	repoManager.Close()
+
+	_, err = os.Stat(mainDbDir + ".bak")
+	require.True(t, os.IsNotExist(err))
+}
+
+func addMarket(t *testing.T, repoManager ports.RepoManager, accountIndex int) {
+	_, err := repoManager.RunTransaction(
+		context.Background(),
+		false,
+		func(ctx context.Context) (interface{}, error) {
+			market, err := domain.NewMarket(accountIndex, 25)
+			if err != nil {
+				return nil, err
+			}
+			return repoManager.MarketRepository().GetOrCreateMarket(ctx, market)
+		},
+	)
+	require.NoError(t, err)
+}
+
+func requireMarkets(t *testing.T, repoManager ports.RepoManager, count int) {
+	markets, err := repoManager.RunTransaction(
+		context.Background(),
+		true,
+		func(ctx context.Context) (interface{}, error) {
+			return repoManager.MarketRepository().GetAllMarkets(ctx)
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, markets, count)
+}
+
+func randomKey(t *testing.T) []byte {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
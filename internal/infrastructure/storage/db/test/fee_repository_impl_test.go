@@ -0,0 +1,131 @@
+package db_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	dbbadger "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/badger"
+	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/inmemory"
+)
+
+func TestFeeRepositoryImplementations(t *testing.T) {
+	repositories := createFeeRepositories(t)
+
+	for i := range repositories {
+		repo := repositories[i]
+
+		t.Run(repo.Name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("testAddCollectedFee", func(t *testing.T) {
+				t.Parallel()
+				testAddCollectedFee(t, repo)
+			})
+		})
+	}
+}
+
+func testAddCollectedFee(t *testing.T, repo feeRepository) {
+	marketQuoteAsset := "0ddfa690c7b2ba3b8ecee8200da2420fc502f57f8312c83d466b6f8dced70441"
+	otherMarketQuoteAsset := "5ac9f65c0efcc4775e0baec4ec03abdde22473cd3cf33c0419ca290e0751b225"
+	fees := []domain.CollectedFee{
+		{
+			TradeID:          uuid.New(),
+			MarketQuoteAsset: marketQuoteAsset,
+			Amount:           200,
+			CollectionTime:   2,
+		},
+		{
+			TradeID:          uuid.New(),
+			MarketQuoteAsset: otherMarketQuoteAsset,
+			Amount:           300,
+			CollectionTime:   3,
+		},
+		{
+			TradeID:          uuid.New(),
+			MarketQuoteAsset: marketQuoteAsset,
+			Amount:           100,
+			CollectionTime:   1,
+		},
+	}
+
+	for _, fee := range fees {
+		fee := fee
+		_, err := repo.write(func(ctx context.Context) (interface{}, error) {
+			return nil, repo.Repository.AddCollectedFee(ctx, fee)
+		})
+		require.NoError(t, err)
+	}
+
+	// a fee already recorded for a trade is not overwritten.
+	duplicatedFee := fees[0]
+	duplicatedFee.Amount = 1000
+	_, err := repo.write(func(ctx context.Context) (interface{}, error) {
+		return nil, repo.Repository.AddCollectedFee(ctx, duplicatedFee)
+	})
+	require.NoError(t, err)
+
+	iAllFees, err := repo.read(func(ctx context.Context) (interface{}, error) {
+		return repo.Repository.GetAllCollectedFees(ctx)
+	})
+	require.NoError(t, err)
+	allFees := iAllFees.([]domain.CollectedFee)
+	require.Len(t, allFees, 3)
+
+	iMarketFees, err := repo.read(func(ctx context.Context) (interface{}, error) {
+		return repo.Repository.GetCollectedFeesByMarket(ctx, marketQuoteAsset)
+	})
+	require.NoError(t, err)
+	marketFees := iMarketFees.([]domain.CollectedFee)
+	require.Len(t, marketFees, 2)
+	require.Equal(t, fees[2].TradeID, marketFees[0].TradeID)
+	require.Equal(t, fees[0].TradeID, marketFees[1].TradeID)
+	require.Equal(t, fees[0].Amount, marketFees[1].Amount)
+
+	iRangeFees, err := repo.read(func(ctx context.Context) (interface{}, error) {
+		return repo.Repository.GetCollectedFeesByMarketInTimeRange(
+			ctx, marketQuoteAsset, 2, 3,
+		)
+	})
+	require.NoError(t, err)
+	rangeFees := iRangeFees.([]domain.CollectedFee)
+	require.Len(t, rangeFees, 1)
+	require.Equal(t, fees[0].TradeID, rangeFees[0].TradeID)
+}
+
+func createFeeRepositories(t *testing.T) []feeRepository {
+	inmemoryDBManager := inmemory.NewRepoManager()
+	badgerDBManager, err := dbbadger.NewRepoManager("", nil, nil)
+	require.NoError(t, err)
+
+	return []feeRepository{
+		{
+			Name:       "badger",
+			DBManager:  badgerDBManager,
+			Repository: badgerDBManager.FeeRepository(),
+		},
+		{
+			Name:       "inmemory",
+			DBManager:  inmemoryDBManager,
+			Repository: inmemoryDBManager.FeeRepository(),
+		},
+	}
+}
+
+type feeRepository struct {
+	Name       string
+	DBManager  ports.RepoManager
+	Repository domain.FeeRepository
+}
+
+func (r feeRepository) read(query func(context.Context) (interface{}, error)) (interface{}, error) {
+	return r.DBManager.RunTransaction(context.Background(), true, query)
+}
+
+func (r feeRepository) write(query func(context.Context) (interface{}, error)) (interface{}, error) {
+	return r.DBManager.RunTransaction(context.Background(), false, query)
+}
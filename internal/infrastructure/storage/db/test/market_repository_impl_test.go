@@ -44,6 +44,11 @@ func TestMarketRepositoryImplementations(t *testing.T) {
 				testGetMarketByAsset(t, repo)
 			})
 
+			t.Run("testGetMarketsByAsset", func(t *testing.T) {
+				t.Parallel()
+				testGetMarketsByAsset(t, repo)
+			})
+
 			t.Run("testGetLatestMarket", func(t *testing.T) {
 				t.Parallel()
 				testGetLatestMarket(t, repo)
@@ -201,6 +206,56 @@ func testGetMarketByAsset(t *testing.T, repo marketRepository) {
 	require.Equal(t, accountIndex, resp.(response).accountIndex)
 }
 
+func testGetMarketsByAsset(t *testing.T, repo marketRepository) {
+	accountIndex := domain.MarketAccountStart + 6
+	baseAsset := "1111111111111111111111111111111111111111111111111111111111111111"
+	quoteAsset := "dddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddd"
+	mockedOutpoints := mockMarketFunds(baseAsset, quoteAsset)
+
+	_, err := repo.write(
+		func(ctx context.Context) (interface{}, error) {
+			_, err := repo.Repository.GetOrCreateMarket(
+				ctx,
+				&domain.Market{AccountIndex: accountIndex, Fee: fee},
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, repo.Repository.UpdateMarket(
+				ctx,
+				accountIndex,
+				func(market *domain.Market) (*domain.Market, error) {
+					err := market.FundMarket(
+						mockedOutpoints,
+						baseAsset,
+					)
+					return market, err
+				},
+			)
+		},
+	)
+	require.NoError(t, err)
+
+	for _, asset := range []string{baseAsset, quoteAsset} {
+		iMarkets, err := repo.read(func(ctx context.Context) (interface{}, error) {
+			return repo.Repository.GetMarketsByAsset(ctx, asset)
+		})
+		require.NoError(t, err)
+		markets := iMarkets.([]domain.Market)
+		require.Len(t, markets, 1)
+		require.Equal(t, accountIndex, markets[0].AccountIndex)
+	}
+
+	iMarkets, err := repo.read(func(ctx context.Context) (interface{}, error) {
+		return repo.Repository.GetMarketsByAsset(
+			ctx, "eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee",
+		)
+	})
+	require.NoError(t, err)
+	require.Empty(t, iMarkets)
+}
+
 func testGetLatestMarket(t *testing.T, repo marketRepository) {
 	resp, err := repo.read(func(ctx context.Context) (interface{}, error) {
 		market, accountIndex, err := repo.Repository.GetLatestMarket(ctx)
@@ -352,7 +407,7 @@ func testWriteRollback(t *testing.T, repo marketRepository) {
 
 func createMarketRepositories(t *testing.T) []marketRepository {
 	inmemoryDBManager := inmemory.NewRepoManager()
-	badgerDBManager, err := dbbadger.NewRepoManager("", nil)
+	badgerDBManager, err := dbbadger.NewRepoManager("", nil, nil)
 	require.NoError(t, err)
 
 	return []marketRepository{
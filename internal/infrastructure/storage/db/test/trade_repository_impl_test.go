@@ -3,6 +3,8 @@ package db_test
 import (
 	"context"
 	"errors"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/google/uuid"
@@ -27,6 +29,11 @@ func TestTradeRepositoryImplementations(t *testing.T) {
 				testGetOrCreateTrade(t, repo)
 			})
 
+			t.Run("testGetTradeByID", func(t *testing.T) {
+				t.Parallel()
+				testGetTradeByID(t, repo)
+			})
+
 			t.Run("testGetAllTrades", func(t *testing.T) {
 				t.Parallel()
 				testGetAllTrades(t, repo)
@@ -42,6 +49,16 @@ func TestTradeRepositoryImplementations(t *testing.T) {
 				testGetCompletedTradesByMarket(t, repo)
 			})
 
+			t.Run("testGetTradesByStatus", func(t *testing.T) {
+				t.Parallel()
+				testGetTradesByStatus(t, repo)
+			})
+
+			t.Run("testForEachTradeByRequestTime", func(t *testing.T) {
+				t.Parallel()
+				testForEachTradeByRequestTime(t, repo)
+			})
+
 			t.Run("testGetTradeWithSwapAcceptID", func(t *testing.T) {
 				t.Parallel()
 				testGetTradeBySwapAcceptID(t, repo)
@@ -70,6 +87,29 @@ func testGetOrCreateTrade(t *testing.T, repo tradeRepository) {
 	require.NotNil(t, trade)
 }
 
+func testGetTradeByID(t *testing.T, repo tradeRepository) {
+	iTrade, err := repo.write(func(ctx context.Context) (interface{}, error) {
+		return repo.Repository.GetOrCreateTrade(ctx, nil)
+	})
+	require.NoError(t, err)
+	trade := iTrade.(*domain.Trade)
+
+	iTrade, err = repo.read(func(ctx context.Context) (interface{}, error) {
+		return repo.Repository.GetTradeByID(ctx, trade.ID)
+	})
+	require.NoError(t, err)
+	foundTrade, ok := iTrade.(*domain.Trade)
+	require.True(t, ok)
+	require.NotNil(t, foundTrade)
+	require.Equal(t, trade.ID, foundTrade.ID)
+
+	iTrade, err = repo.read(func(ctx context.Context) (interface{}, error) {
+		return repo.Repository.GetTradeByID(ctx, uuid.New())
+	})
+	require.NoError(t, err)
+	require.Nil(t, iTrade)
+}
+
 func testGetAllTrades(t *testing.T, repo tradeRepository) {
 	iTrades, err := repo.write(func(ctx context.Context) (interface{}, error) {
 		_, err := repo.Repository.GetOrCreateTrade(ctx, nil)
@@ -189,6 +229,119 @@ func testGetCompletedTradesByMarket(t *testing.T, repo tradeRepository) {
 	require.GreaterOrEqual(t, len(trades), 2)
 }
 
+func testForEachTradeByRequestTime(t *testing.T, repo tradeRepository) {
+	// the request times are far from those of the trades of the other tests.
+	requestTimes := []uint64{1000030, 1000010, 1000020, 1000040}
+	tradeIDs := make([]uuid.UUID, 0, len(requestTimes))
+	for _, requestTime := range requestTimes {
+		requestTime := requestTime
+		iTrade, err := repo.write(func(ctx context.Context) (interface{}, error) {
+			trade, err := repo.Repository.GetOrCreateTrade(ctx, nil)
+			if err != nil {
+				return nil, err
+			}
+			if err := repo.Repository.UpdateTrade(
+				ctx,
+				&trade.ID,
+				func(trade *domain.Trade) (*domain.Trade, error) {
+					trade.SwapRequest.Timestamp = requestTime
+					return trade, nil
+				},
+			); err != nil {
+				return nil, err
+			}
+			return trade, nil
+		})
+		require.NoError(t, err)
+		tradeIDs = append(tradeIDs, iTrade.(*domain.Trade).ID)
+	}
+
+	iTrades, err := repo.read(func(ctx context.Context) (interface{}, error) {
+		trades := make([]*domain.Trade, 0)
+		err := repo.Repository.ForEachTradeByRequestTime(
+			ctx, 1000010, 1000030,
+			func(trade *domain.Trade) error {
+				trades = append(trades, trade)
+				return nil
+			},
+		)
+		return trades, err
+	})
+	require.NoError(t, err)
+	trades := iTrades.([]*domain.Trade)
+	require.Len(t, trades, 3)
+	require.Equal(t, tradeIDs[1], trades[0].ID)
+	require.Equal(t, tradeIDs[2], trades[1].ID)
+	require.Equal(t, tradeIDs[0], trades[2].ID)
+
+	errStop := errors.New("stop")
+	count := 0
+	_, err = repo.read(func(ctx context.Context) (interface{}, error) {
+		return nil, repo.Repository.ForEachTradeByRequestTime(
+			ctx, 1000010, 1000040,
+			func(trade *domain.Trade) error {
+				count++
+				return errStop
+			},
+		)
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, 1, count)
+}
+
+func testGetTradesByStatus(t *testing.T, repo tradeRepository) {
+	var tradeID uuid.UUID
+
+	iTrades, err := repo.write(func(ctx context.Context) (interface{}, error) {
+		trade, err := repo.Repository.GetOrCreateTrade(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		tradeID = trade.ID
+		if err := repo.Repository.UpdateTrade(
+			ctx,
+			&trade.ID,
+			func(trade *domain.Trade) (*domain.Trade, error) {
+				trade.Status = domain.AcceptedStatus
+				return trade, nil
+			},
+		); err != nil {
+			return nil, err
+		}
+		return repo.Repository.GetTradesByStatus(ctx, domain.AcceptedStatus)
+	})
+	require.NoError(t, err)
+	trades, ok := iTrades.([]*domain.Trade)
+	require.True(t, ok)
+	require.True(t, containsTrade(trades, tradeID))
+
+	iTrades, err = repo.write(func(ctx context.Context) (interface{}, error) {
+		if err := repo.Repository.UpdateTrade(
+			ctx,
+			&tradeID,
+			func(trade *domain.Trade) (*domain.Trade, error) {
+				trade.Status = domain.CompletedStatus
+				return trade, nil
+			},
+		); err != nil {
+			return nil, err
+		}
+		return repo.Repository.GetTradesByStatus(ctx, domain.AcceptedStatus)
+	})
+	require.NoError(t, err)
+	trades, ok = iTrades.([]*domain.Trade)
+	require.True(t, ok)
+	require.False(t, containsTrade(trades, tradeID))
+
+	iTrades, err = repo.read(func(ctx context.Context) (interface{}, error) {
+		return repo.Repository.GetTradesByStatus(ctx, domain.CompletedStatus)
+	})
+	require.NoError(t, err)
+	trades, ok = iTrades.([]*domain.Trade)
+	require.True(t, ok)
+	require.True(t, containsTrade(trades, tradeID))
+}
+
 func testGetTradeBySwapAcceptID(t *testing.T, repo tradeRepository) {
 	swapAcceptID := uuid.New().String()
 
@@ -271,9 +424,95 @@ func testUpdateTradeRollback(t *testing.T, repo tradeRepository) {
 	require.Nil(t, trade)
 }
 
+// TestBadgerTradeIndex makes sure that the index of the trades of the badger
+// implementation is rebuilt when opening the store, and isn't affected by
+// changes that are not committed.
+func TestBadgerTradeIndex(t *testing.T) {
+	dbDir, err := ioutil.TempDir("", "db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	repoManager, err := dbbadger.NewRepoManager(dbDir, nil, nil)
+	require.NoError(t, err)
+	repo := tradeRepository{
+		Name:       "badger",
+		DBManager:  repoManager,
+		Repository: repoManager.TradeRepository(),
+	}
+	marketAsset := randomString(32)
+
+	var tradeID uuid.UUID
+	_, err = repo.write(func(ctx context.Context) (interface{}, error) {
+		trade, err := repo.Repository.GetOrCreateTrade(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		tradeID = trade.ID
+		return nil, repo.Repository.UpdateTrade(
+			ctx,
+			&trade.ID,
+			func(trade *domain.Trade) (*domain.Trade, error) {
+				trade.MarketQuoteAsset = marketAsset
+				trade.Status = domain.AcceptedStatus
+				return trade, nil
+			},
+		)
+	})
+	require.NoError(t, err)
+
+	expectedErr := errors.New("something went wrong")
+	_, err = repo.write(func(ctx context.Context) (interface{}, error) {
+		if err := repo.Repository.UpdateTrade(
+			ctx,
+			&tradeID,
+			func(trade *domain.Trade) (*domain.Trade, error) {
+				trade.Status = domain.CompletedStatus
+				return trade, nil
+			},
+		); err != nil {
+			return nil, err
+		}
+		return nil, expectedErr
+	})
+	require.EqualError(t, err, expectedErr.Error())
+
+	requireTradeIndexed := func(repo domain.TradeRepository) {
+		ctx := context.Background()
+		trades, err := repo.GetTradesByStatus(ctx, domain.AcceptedStatus)
+		require.NoError(t, err)
+		require.Len(t, trades, 1)
+		require.Equal(t, tradeID, trades[0].ID)
+
+		trades, err = repo.GetTradesByStatus(ctx, domain.CompletedStatus)
+		require.NoError(t, err)
+		require.Len(t, trades, 0)
+
+		trades, err = repo.GetAllTradesByMarket(ctx, marketAsset)
+		require.NoError(t, err)
+		require.Len(t, trades, 1)
+	}
+
+	requireTradeIndexed(repo.Repository)
+	repoManager.Close()
+
+	repoManager, err = dbbadger.NewRepoManager(dbDir, nil, nil)
+	require.NoError(t, err)
+	defer repoManager.Close()
+	requireTradeIndexed(repoManager.TradeRepository())
+}
+
+func containsTrade(trades []*domain.Trade, tradeID uuid.UUID) bool {
+	for _, t := range trades {
+		if t.ID == tradeID {
+			return true
+		}
+	}
+	return false
+}
+
 func createTradeRepositories(t *testing.T) []tradeRepository {
 	inmemoryDBManager := inmemory.NewRepoManager()
-	badgerDBManager, err := dbbadger.NewRepoManager("", nil)
+	badgerDBManager, err := dbbadger.NewRepoManager("", nil, nil)
 	require.NoError(t, err)
 
 	return []tradeRepository{
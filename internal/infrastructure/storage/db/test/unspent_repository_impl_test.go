@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/google/uuid"
@@ -81,6 +82,11 @@ func TestUnspentRepositoryImplementations(t *testing.T) {
 				t.Parallel()
 				testLockUnlockUnspents(t, repo)
 			})
+
+			t.Run("testExcludeIncludeUnspents", func(t *testing.T) {
+				t.Parallel()
+				testExcludeIncludeUnspents(t, repo)
+			})
 		})
 	}
 }
@@ -316,7 +322,7 @@ func testConfirmUnspents(t *testing.T, repo unspentRepository) {
 	}
 
 	iConfirmedUnspents, err := repo.write(func(ctx context.Context) (interface{}, error) {
-		if _, err := repo.Repository.ConfirmUnspents(ctx, unspentKeys); err != nil {
+		if _, err := repo.Repository.ConfirmUnspents(ctx, unspentKeys, 100, "blockhash"); err != nil {
 			return nil, err
 		}
 
@@ -331,6 +337,31 @@ func testConfirmUnspents(t *testing.T, repo unspentRepository) {
 		for _, u := range unspents {
 			if u.IsKeyEqual(key) {
 				require.True(t, u.IsConfirmed())
+				require.Equal(t, uint64(100), u.BlockHeight)
+				require.Equal(t, "blockhash", u.BlockHash)
+				break
+			}
+		}
+	}
+
+	iUnconfirmedUnspents, err := repo.write(func(ctx context.Context) (interface{}, error) {
+		if _, err := repo.Repository.UnconfirmUnspents(ctx, unspentKeys); err != nil {
+			return nil, err
+		}
+
+		return repo.Repository.GetAllUnspents(ctx), nil
+	})
+	require.NoError(t, err)
+
+	unspents, ok = iUnconfirmedUnspents.([]domain.Unspent)
+	require.True(t, ok)
+	require.NotNil(t, unspents)
+	for _, key := range unspentKeys {
+		for _, u := range unspents {
+			if u.IsKeyEqual(key) {
+				require.False(t, u.IsConfirmed())
+				require.Zero(t, u.BlockHeight)
+				require.Empty(t, u.BlockHash)
 				break
 			}
 		}
@@ -342,6 +373,7 @@ func testLockUnlockUnspents(t *testing.T, repo unspentRepository) {
 	mockedUnspents := mockedData.unspents
 	unspentKeys := mockedData.unspentKeys
 	mockedTradeID := uuid.New()
+	lockTime := uint64(time.Now().Unix())
 
 	iLockedUnspents, err := repo.write(func(ctx context.Context) (interface{}, error) {
 		if err := repo.Repository.AddUnspents(ctx, mockedUnspents); err != nil {
@@ -363,6 +395,7 @@ func testLockUnlockUnspents(t *testing.T, repo unspentRepository) {
 		for _, u := range unspents {
 			if u.IsKeyEqual(key) {
 				require.True(t, u.IsLocked())
+				require.GreaterOrEqual(t, u.LockTimestamp, lockTime)
 				break
 			}
 		}
@@ -384,6 +417,66 @@ func testLockUnlockUnspents(t *testing.T, repo unspentRepository) {
 		for _, u := range unspents {
 			if u.IsKeyEqual(key) {
 				require.False(t, u.IsLocked())
+				require.Zero(t, u.LockTimestamp)
+				break
+			}
+		}
+	}
+}
+
+func testExcludeIncludeUnspents(t *testing.T, repo unspentRepository) {
+	mockedData := mockUnspentTestData(opts{numOfUnspents: 1})
+	mockedUnspents := mockedData.unspents
+	unspentKeys := mockedData.unspentKeys
+	mockedTradeID := uuid.New()
+
+	iExcludedUnspents, err := repo.write(func(ctx context.Context) (interface{}, error) {
+		if err := repo.Repository.AddUnspents(ctx, mockedUnspents); err != nil {
+			return nil, err
+		}
+
+		if _, err := repo.Repository.LockUnspents(ctx, unspentKeys, mockedTradeID); err != nil {
+			return nil, err
+		}
+
+		if _, err := repo.Repository.ExcludeUnspents(ctx, unspentKeys); err != nil {
+			return nil, err
+		}
+
+		return repo.Repository.GetAllUnspents(ctx), nil
+	})
+	require.NoError(t, err)
+
+	unspents, ok := iExcludedUnspents.([]domain.Unspent)
+	require.True(t, ok)
+	require.NotNil(t, unspents)
+	for _, key := range unspentKeys {
+		for _, u := range unspents {
+			if u.IsKeyEqual(key) {
+				require.True(t, u.IsExcluded())
+				require.True(t, u.IsLocked())
+				break
+			}
+		}
+	}
+
+	iIncludedUnspents, err := repo.write(func(ctx context.Context) (interface{}, error) {
+		if _, err := repo.Repository.IncludeUnspents(ctx, unspentKeys); err != nil {
+			return nil, err
+		}
+
+		return repo.Repository.GetAllUnspents(ctx), nil
+	})
+	require.NoError(t, err)
+
+	unspents, ok = iIncludedUnspents.([]domain.Unspent)
+	require.True(t, ok)
+	require.NotNil(t, unspents)
+	for _, key := range unspentKeys {
+		for _, u := range unspents {
+			if u.IsKeyEqual(key) {
+				require.False(t, u.IsExcluded())
+				require.True(t, u.IsLocked())
 				break
 			}
 		}
@@ -392,7 +485,7 @@ func testLockUnlockUnspents(t *testing.T, repo unspentRepository) {
 
 func createUnspentRepositories(t *testing.T) []unspentRepository {
 	inmemoryDBManager := inmemory.NewRepoManager()
-	badgerDBManager, err := dbbadger.NewRepoManager("", nil)
+	badgerDBManager, err := dbbadger.NewRepoManager("", nil, nil)
 	require.NoError(t, err)
 
 	return []unspentRepository{
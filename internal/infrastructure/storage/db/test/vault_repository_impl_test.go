@@ -128,7 +128,7 @@ func testUpdateVaultRollback(t *testing.T, repo vaultRepository) {
 
 func createVaultRepositories(t *testing.T) []vaultRepository {
 	inmemoryDBManager := inmemory.NewRepoManager()
-	badgerDBManager, err := dbbadger.NewRepoManager("", nil)
+	badgerDBManager, err := dbbadger.NewRepoManager("", nil, nil)
 	require.NoError(t, err)
 
 	return []vaultRepository{
@@ -0,0 +1,79 @@
+package grpchandler
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/application"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// LivenessService is the name of the service to check with the standard
+	// gRPC health protocol to know whether the daemon is up. The same applies
+	// to the empty service name.
+	LivenessService = "liveness"
+	// ReadinessService is the name of the service to check with the standard
+	// gRPC health protocol to know whether the daemon is ready to serve trades.
+	ReadinessService = "readiness"
+)
+
+type healthHandler struct {
+	healthSvc application.HealthService
+}
+
+func NewHealthHandler(
+	healthSvc application.HealthService,
+) healthpb.HealthServer {
+	return newHealthHandler(healthSvc)
+}
+
+func newHealthHandler(
+	healthSvc application.HealthService,
+) *healthHandler {
+	return &healthHandler{
+		healthSvc: healthSvc,
+	}
+}
+
+func (h healthHandler) Check(
+	ctx context.Context,
+	req *healthpb.HealthCheckRequest,
+) (*healthpb.HealthCheckResponse, error) {
+	return h.check(ctx, req)
+}
+
+func (h healthHandler) Watch(
+	req *healthpb.HealthCheckRequest,
+	stream healthpb.Health_WatchServer,
+) error {
+	return status.Error(codes.Unimplemented, "health status watching is not supported")
+}
+
+func (h healthHandler) check(
+	ctx context.Context,
+	req *healthpb.HealthCheckRequest,
+) (*healthpb.HealthCheckResponse, error) {
+	switch req.GetService() {
+	case "", LivenessService:
+		return &healthpb.HealthCheckResponse{
+			Status: healthpb.HealthCheckResponse_SERVING,
+		}, nil
+	case ReadinessService:
+		if err := h.healthSvc.CheckReadiness(ctx); err != nil {
+			log.WithError(err).Debug("daemon is not ready")
+			return &healthpb.HealthCheckResponse{
+				Status: healthpb.HealthCheckResponse_NOT_SERVING,
+			}, nil
+		}
+		return &healthpb.HealthCheckResponse{
+			Status: healthpb.HealthCheckResponse_SERVING,
+		}, nil
+	default:
+		return nil, status.Errorf(
+			codes.NotFound, "unknown service %s", req.GetService(),
+		)
+	}
+}
@@ -1,8 +1,11 @@
 package grpchandler
 
 import (
+	"bufio"
 	"context"
+	"encoding/hex"
 	"errors"
+	"sort"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -16,28 +19,51 @@ import (
 	pbtypes "github.com/tdex-network/tdex-protobuf/generated/go/types"
 )
 
-const readOnlyTx = true
+const (
+	readOnlyTx = true
+
+	// defaultHeartbeatInterval is the time between heartbeats sent to trade
+	// subscribers if not specified otherwise.
+	defaultHeartbeatInterval = 30 * time.Second
+	// minHeartbeatInterval and maxHeartbeatInterval bound the time between
+	// heartbeats that trade subscribers can request.
+	minHeartbeatInterval = time.Second
+	maxHeartbeatInterval = time.Hour
+	// exportChunkSize is the max size of the chunks of trade history exports.
+	exportChunkSize = 64 * 1024
+)
 
 type operatorHandler struct {
 	pb.UnimplementedOperatorServer
 	operatorSvc application.OperatorService
+	tradeSvc    application.TradeService
 }
 
 // NewOperatorHandler is a constructor function returning an protobuf OperatorServer.
 func NewOperatorHandler(
 	operatorSvc application.OperatorService,
+	tradeSvc application.TradeService,
 ) pb.OperatorServer {
-	return newOperatorHandler(operatorSvc)
+	return newOperatorHandler(operatorSvc, tradeSvc)
 }
 
 func newOperatorHandler(
 	operatorSvc application.OperatorService,
+	tradeSvc application.TradeService,
 ) *operatorHandler {
 	return &operatorHandler{
 		operatorSvc: operatorSvc,
+		tradeSvc:    tradeSvc,
 	}
 }
 
+func (o operatorHandler) GetInfo(
+	ctx context.Context,
+	req *pb.GetInfoRequest,
+) (*pb.GetInfoReply, error) {
+	return o.getInfo(ctx, req)
+}
+
 func (o operatorHandler) DepositMarket(
 	ctx context.Context,
 	req *pb.DepositMarketRequest,
@@ -52,6 +78,13 @@ func (o operatorHandler) DepositFeeAccount(
 	return o.depositFeeAccount(ctx, req)
 }
 
+func (o operatorHandler) GetDepositAddress(
+	ctx context.Context,
+	req *pb.GetDepositAddressRequest,
+) (*pb.GetDepositAddressReply, error) {
+	return o.getDepositAddress(ctx, req)
+}
+
 func (o operatorHandler) OpenMarket(
 	ctx context.Context,
 	req *pb.OpenMarketRequest,
@@ -66,6 +99,20 @@ func (o operatorHandler) CloseMarket(
 	return o.closeMarket(ctx, req)
 }
 
+func (o operatorHandler) PauseMarket(
+	ctx context.Context,
+	req *pb.PauseMarketRequest,
+) (*pb.PauseMarketReply, error) {
+	return o.pauseMarket(ctx, req)
+}
+
+func (o operatorHandler) ResumeMarket(
+	ctx context.Context,
+	req *pb.ResumeMarketRequest,
+) (*pb.ResumeMarketReply, error) {
+	return o.resumeMarket(ctx, req)
+}
+
 func (o operatorHandler) UpdateMarketPercentageFee(
 	ctx context.Context,
 	req *pb.UpdateMarketPercentageFeeRequest,
@@ -80,6 +127,48 @@ func (o operatorHandler) UpdateMarketFixedFee(
 	return o.updateMarketFixedFee(ctx, req)
 }
 
+func (o operatorHandler) UpdateMarketFee(
+	ctx context.Context,
+	req *pb.UpdateMarketFeeRequest,
+) (*pb.UpdateMarketFeeReply, error) {
+	return o.updateMarketFee(ctx, req)
+}
+
+func (o operatorHandler) MarketFeeHistory(
+	ctx context.Context,
+	req *pb.MarketFeeHistoryRequest,
+) (*pb.MarketFeeHistoryReply, error) {
+	return o.marketFeeHistory(ctx, req)
+}
+
+func (o operatorHandler) UpdateMarketFeeTiers(
+	ctx context.Context,
+	req *pb.UpdateMarketFeeTiersRequest,
+) (*pb.UpdateMarketFeeTiersReply, error) {
+	return o.updateMarketFeeTiers(ctx, req)
+}
+
+func (o operatorHandler) UpdateMarketTradeLimits(
+	ctx context.Context,
+	req *pb.UpdateMarketTradeLimitsRequest,
+) (*pb.UpdateMarketTradeLimitsReply, error) {
+	return o.updateMarketTradeLimits(ctx, req)
+}
+
+func (o operatorHandler) UpdateMarketPricePrecision(
+	ctx context.Context,
+	req *pb.UpdateMarketPricePrecisionRequest,
+) (*pb.UpdateMarketPricePrecisionReply, error) {
+	return o.updateMarketPricePrecision(ctx, req)
+}
+
+func (o operatorHandler) UpdateMarketSettlementTimeout(
+	ctx context.Context,
+	req *pb.UpdateMarketSettlementTimeoutRequest,
+) (*pb.UpdateMarketSettlementTimeoutReply, error) {
+	return o.updateMarketSettlementTimeout(ctx, req)
+}
+
 func (o operatorHandler) UpdateMarketPrice(
 	ctx context.Context,
 	req *pb.UpdateMarketPriceRequest,
@@ -94,6 +183,13 @@ func (o operatorHandler) UpdateMarketStrategy(
 	return o.updateMarketStrategy(ctx, req)
 }
 
+func (o operatorHandler) SwitchMarketStrategy(
+	ctx context.Context,
+	req *pb.SwitchMarketStrategyRequest,
+) (*pb.SwitchMarketStrategyReply, error) {
+	return o.switchMarketStrategy(ctx, req)
+}
+
 func (o operatorHandler) ListTrades(
 	ctx context.Context,
 	req *pb.ListTradesRequest,
@@ -101,6 +197,41 @@ func (o operatorHandler) ListTrades(
 	return o.listTrades(ctx, req)
 }
 
+func (o operatorHandler) GetTradeByTxid(
+	ctx context.Context,
+	req *pb.GetTradeByTxidRequest,
+) (*pb.GetTradeByTxidReply, error) {
+	return o.getTradeByTxid(ctx, req)
+}
+
+func (o operatorHandler) CancelTrade(
+	ctx context.Context,
+	req *pb.CancelTradeRequest,
+) (*pb.CancelTradeReply, error) {
+	return o.cancelTrade(ctx, req)
+}
+
+func (o operatorHandler) TradeAudit(
+	ctx context.Context,
+	req *pb.TradeAuditRequest,
+) (*pb.TradeAuditReply, error) {
+	return o.tradeAudit(ctx, req)
+}
+
+func (o operatorHandler) ExportTrades(
+	req *pb.ExportTradesRequest,
+	stream pb.Operator_ExportTradesServer,
+) error {
+	return o.exportTrades(req, stream)
+}
+
+func (o operatorHandler) SubscribeTrades(
+	req *pb.SubscribeTradesRequest,
+	stream pb.Operator_SubscribeTradesServer,
+) error {
+	return o.subscribeTrades(req, stream)
+}
+
 func (o operatorHandler) WithdrawMarket(
 	ctx context.Context,
 	req *pb.WithdrawMarketRequest,
@@ -108,6 +239,27 @@ func (o operatorHandler) WithdrawMarket(
 	return o.withdrawMarket(ctx, req)
 }
 
+func (o operatorHandler) EstimateWithdrawFee(
+	ctx context.Context,
+	req *pb.EstimateWithdrawFeeRequest,
+) (*pb.EstimateWithdrawFeeReply, error) {
+	return o.estimateWithdrawFee(ctx, req)
+}
+
+func (o operatorHandler) WithdrawMultiple(
+	ctx context.Context,
+	req *pb.WithdrawMultipleRequest,
+) (*pb.WithdrawMultipleReply, error) {
+	return o.withdrawMultiple(ctx, req)
+}
+
+func (o operatorHandler) BumpWithdrawFee(
+	ctx context.Context,
+	req *pb.BumpWithdrawFeeRequest,
+) (*pb.BumpWithdrawFeeReply, error) {
+	return o.bumpWithdrawFee(ctx, req)
+}
+
 func (o operatorHandler) BalanceFeeAccount(
 	ctx context.Context,
 	req *pb.BalanceFeeAccountRequest,
@@ -115,6 +267,20 @@ func (o operatorHandler) BalanceFeeAccount(
 	return o.balanceFeeAccount(ctx, req)
 }
 
+func (o operatorHandler) TotalBalance(
+	ctx context.Context,
+	req *pb.TotalBalanceRequest,
+) (*pb.TotalBalanceReply, error) {
+	return o.totalBalance(ctx, req)
+}
+
+func (o operatorHandler) ConsolidateFeeAccount(
+	ctx context.Context,
+	req *pb.ConsolidateFeeAccountRequest,
+) (*pb.ConsolidateFeeAccountReply, error) {
+	return o.consolidateFeeAccount(ctx, req)
+}
+
 func (o operatorHandler) ClaimMarketDeposit(
 	ctx context.Context,
 	req *pb.ClaimMarketDepositRequest,
@@ -122,6 +288,13 @@ func (o operatorHandler) ClaimMarketDeposit(
 	return o.claimMarketDeposit(ctx, req)
 }
 
+func (o operatorHandler) BalanceByLabel(
+	ctx context.Context,
+	req *pb.BalanceByLabelRequest,
+) (*pb.BalanceByLabelReply, error) {
+	return o.balanceByLabel(ctx, req)
+}
+
 func (o operatorHandler) ClaimFeeDeposit(
 	ctx context.Context,
 	req *pb.ClaimFeeDepositRequest,
@@ -129,6 +302,20 @@ func (o operatorHandler) ClaimFeeDeposit(
 	return o.claimFeeDeposit(ctx, req)
 }
 
+func (o operatorHandler) FundMarket(
+	ctx context.Context,
+	req *pb.FundMarketRequest,
+) (*pb.FundMarketReply, error) {
+	return o.fundMarket(ctx, req)
+}
+
+func (o operatorHandler) ImportUtxo(
+	ctx context.Context,
+	req *pb.ImportUtxoRequest,
+) (*pb.ImportUtxoReply, error) {
+	return o.importUtxo(ctx, req)
+}
+
 func (o operatorHandler) ListDepositMarket(
 	ctx context.Context,
 	req *pb.ListDepositMarketRequest,
@@ -143,6 +330,34 @@ func (o operatorHandler) ListMarket(
 	return o.listMarket(ctx, req)
 }
 
+func (o operatorHandler) GetAssetInfo(
+	ctx context.Context,
+	req *pb.GetAssetInfoRequest,
+) (*pb.GetAssetInfoReply, error) {
+	return o.getAssetInfo(ctx, req)
+}
+
+func (o operatorHandler) GetBlindingData(
+	ctx context.Context,
+	req *pb.GetBlindingDataRequest,
+) (*pb.GetBlindingDataReply, error) {
+	return o.getBlindingData(ctx, req)
+}
+
+func (o operatorHandler) AccountXPub(
+	ctx context.Context,
+	req *pb.AccountXPubRequest,
+) (*pb.AccountXPubReply, error) {
+	return o.accountXPub(ctx, req)
+}
+
+func (o operatorHandler) RotateBlindingKey(
+	ctx context.Context,
+	req *pb.RotateBlindingKeyRequest,
+) (*pb.RotateBlindingKeyReply, error) {
+	return o.rotateBlindingKey(ctx, req)
+}
+
 func (o operatorHandler) ReportMarketFee(
 	ctx context.Context,
 	req *pb.ReportMarketFeeRequest,
@@ -150,6 +365,34 @@ func (o operatorHandler) ReportMarketFee(
 	return o.reportMarketFee(ctx, req)
 }
 
+func (o operatorHandler) ReportMarketFeeRange(
+	ctx context.Context,
+	req *pb.ReportMarketFeeRangeRequest,
+) (*pb.ReportMarketFeeReply, error) {
+	return o.reportMarketFeeRange(ctx, req)
+}
+
+func (o operatorHandler) VerifyFeeLedger(
+	ctx context.Context,
+	req *pb.VerifyFeeLedgerRequest,
+) (*pb.VerifyFeeLedgerReply, error) {
+	return o.verifyFeeLedger(ctx, req)
+}
+
+func (o operatorHandler) ProveReserves(
+	ctx context.Context,
+	req *pb.ProveReservesRequest,
+) (*pb.ProveReservesReply, error) {
+	return o.proveReserves(ctx, req)
+}
+
+func (o operatorHandler) TradeVolume(
+	ctx context.Context,
+	req *pb.TradeVolumeRequest,
+) (*pb.TradeVolumeReply, error) {
+	return o.tradeVolume(ctx, req)
+}
+
 func (o operatorHandler) ReloadUtxos(
 	ctx context.Context,
 	rew *pb.ReloadUtxosRequest,
@@ -160,6 +403,20 @@ func (o operatorHandler) ReloadUtxos(
 	return &pb.ReloadUtxosReply{}, nil
 }
 
+func (o operatorHandler) RescanAccount(
+	ctx context.Context,
+	req *pb.RescanAccountRequest,
+) (*pb.RescanAccountReply, error) {
+	return o.rescanAccount(ctx, req)
+}
+
+func (o operatorHandler) RescanAccountDryRun(
+	ctx context.Context,
+	req *pb.RescanAccountDryRunRequest,
+) (*pb.RescanAccountDryRunReply, error) {
+	return o.rescanAccountDryRun(ctx, req)
+}
+
 func (o operatorHandler) ListUtxos(
 	ctx context.Context,
 	req *pb.ListUtxosRequest,
@@ -167,6 +424,27 @@ func (o operatorHandler) ListUtxos(
 	return o.listUtxos(ctx, req)
 }
 
+func (o operatorHandler) ListUtxosForAccount(
+	ctx context.Context,
+	req *pb.ListUtxosForAccountRequest,
+) (*pb.ListUtxosForAccountReply, error) {
+	return o.listUtxosForAccount(ctx, req)
+}
+
+func (o operatorHandler) ListPendingDeposits(
+	ctx context.Context,
+	req *pb.ListPendingDepositsRequest,
+) (*pb.ListPendingDepositsReply, error) {
+	return o.listPendingDeposits(ctx, req)
+}
+
+func (o operatorHandler) ListLockedUtxos(
+	ctx context.Context,
+	req *pb.ListLockedUtxosRequest,
+) (*pb.ListLockedUtxosReply, error) {
+	return o.listLockedUtxos(ctx, req)
+}
+
 func (o operatorHandler) DropMarket(
 	ctx context.Context,
 	req *pb.DropMarketRequest,
@@ -188,6 +466,144 @@ func (o operatorHandler) dropMarket(
 	return &pb.DropMarketReply{}, nil
 }
 
+func (o operatorHandler) ExcludeUtxo(
+	ctx context.Context,
+	req *pb.ExcludeUtxoRequest,
+) (*pb.ExcludeUtxoReply, error) {
+	return o.excludeUtxo(ctx, req)
+}
+
+func (o operatorHandler) excludeUtxo(
+	ctx context.Context,
+	req *pb.ExcludeUtxoRequest,
+) (*pb.ExcludeUtxoReply, error) {
+	if req.GetOutpoint() == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing outpoint")
+	}
+
+	if err := o.operatorSvc.ExcludeUtxo(
+		ctx,
+		application.TxOutpoint{
+			Hash:  req.GetOutpoint().GetHash(),
+			Index: int(req.GetOutpoint().GetIndex()),
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.ExcludeUtxoReply{}, nil
+}
+
+func (o operatorHandler) IncludeUtxo(
+	ctx context.Context,
+	req *pb.IncludeUtxoRequest,
+) (*pb.IncludeUtxoReply, error) {
+	return o.includeUtxo(ctx, req)
+}
+
+func (o operatorHandler) includeUtxo(
+	ctx context.Context,
+	req *pb.IncludeUtxoRequest,
+) (*pb.IncludeUtxoReply, error) {
+	if req.GetOutpoint() == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing outpoint")
+	}
+
+	if err := o.operatorSvc.IncludeUtxo(
+		ctx,
+		application.TxOutpoint{
+			Hash:  req.GetOutpoint().GetHash(),
+			Index: int(req.GetOutpoint().GetIndex()),
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.IncludeUtxoReply{}, nil
+}
+
+func (o operatorHandler) ExportMarketsConfig(
+	ctx context.Context,
+	req *pb.ExportMarketsConfigRequest,
+) (*pb.ExportMarketsConfigReply, error) {
+	return o.exportMarketsConfig(ctx, req)
+}
+
+func (o operatorHandler) exportMarketsConfig(
+	ctx context.Context,
+	req *pb.ExportMarketsConfigRequest,
+) (*pb.ExportMarketsConfigReply, error) {
+	config, err := o.operatorSvc.ExportMarketsConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExportMarketsConfigReply{Config: string(config)}, nil
+}
+
+func (o operatorHandler) ImportMarketsConfig(
+	ctx context.Context,
+	req *pb.ImportMarketsConfigRequest,
+) (*pb.ImportMarketsConfigReply, error) {
+	return o.importMarketsConfig(ctx, req)
+}
+
+func (o operatorHandler) importMarketsConfig(
+	ctx context.Context,
+	req *pb.ImportMarketsConfigRequest,
+) (*pb.ImportMarketsConfigReply, error) {
+	if len(req.GetConfig()) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing config")
+	}
+
+	markets, err := o.operatorSvc.ImportMarketsConfig(ctx, []byte(req.GetConfig()))
+	if err != nil {
+		return nil, err
+	}
+
+	createdMarkets := make([]*pbtypes.Market, 0, len(markets))
+	for _, m := range markets {
+		createdMarkets = append(createdMarkets, &pbtypes.Market{
+			BaseAsset:  m.BaseAsset,
+			QuoteAsset: m.QuoteAsset,
+		})
+	}
+
+	return &pb.ImportMarketsConfigReply{CreatedMarkets: createdMarkets}, nil
+}
+
+func (o operatorHandler) rescanAccount(
+	ctx context.Context,
+	req *pb.RescanAccountRequest,
+) (*pb.RescanAccountReply, error) {
+	res, err := o.operatorSvc.RescanAccount(ctx, int(req.GetAccountIndex()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RescanAccountReply{
+		AddedUnspents: uint64(res.AddedUnspents),
+		SpentUnspents: uint64(res.SpentUnspents),
+	}, nil
+}
+
+func (o operatorHandler) rescanAccountDryRun(
+	ctx context.Context,
+	req *pb.RescanAccountDryRunRequest,
+) (*pb.RescanAccountDryRunReply, error) {
+	diff, err := o.operatorSvc.RescanAccountDryRun(
+		ctx, int(req.GetAccountIndex()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RescanAccountDryRunReply{
+		UnspentsToAdd:   utxoInfoToProto(diff.UnspentsToAdd),
+		UnspentsToSpend: utxoInfoToProto(diff.UnspentsToSpend),
+	}, nil
+}
+
 func (o operatorHandler) listUtxos(
 	ctx context.Context,
 	req *pb.ListUtxosRequest,
@@ -200,59 +616,82 @@ func (o operatorHandler) listUtxos(
 	}
 
 	infoPerAccount := make(map[uint64]*pb.UtxoInfoList)
-
 	for k, v := range utxoInfoPerAccount {
-		uLen := len(v.Unspents)
-		unspents := make([]*pb.UtxoInfo, uLen, uLen)
-		for i, u := range v.Unspents {
-			unspents[i] = &pb.UtxoInfo{
-				Outpoint: &pb.TxOutpoint{
-					Hash:  u.Outpoint.Hash,
-					Index: int32(u.Outpoint.Index),
-				},
-				Value: u.Value,
-				Asset: u.Asset,
-			}
-		}
+		infoPerAccount[k] = utxoInfoListToProto(v)
+	}
 
-		sLen := len(v.Spents)
-		spents := make([]*pb.UtxoInfo, sLen, sLen)
-		for i, u := range v.Spents {
-			spents[i] = &pb.UtxoInfo{
-				Outpoint: &pb.TxOutpoint{
-					Hash:  u.Outpoint.Hash,
-					Index: int32(u.Outpoint.Index),
-				},
-				Value: u.Value,
-				Asset: u.Asset,
-			}
-		}
+	return &pb.ListUtxosReply{
+		InfoPerAccount: infoPerAccount,
+	}, nil
+}
 
-		lLen := len(v.Locks)
-		locks := make([]*pb.UtxoInfo, lLen, lLen)
-		for i, u := range v.Locks {
-			locks[i] = &pb.UtxoInfo{
-				Outpoint: &pb.TxOutpoint{
-					Hash:  u.Outpoint.Hash,
-					Index: int32(u.Outpoint.Index),
-				},
-				Value: u.Value,
-				Asset: u.Asset,
-			}
+func (o operatorHandler) listUtxosForAccount(
+	ctx context.Context,
+	req *pb.ListUtxosForAccountRequest,
+) (*pb.ListUtxosForAccountReply, error) {
+	if asset := req.GetAsset(); asset != "" {
+		if err := validateAsset(asset); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+	}
 
-		infoPerAccount[k] = &pb.UtxoInfoList{
-			Unspents: unspents,
-			Spents:   spents,
-			Locks:    locks,
-		}
+	utxoInfo, err := o.operatorSvc.ListUtxosForAccount(
+		ctx,
+		req.GetAccountIndex(),
+		application.UtxoFilter{
+			Asset:         req.GetAsset(),
+			MinValue:      req.GetMinValue(),
+			ConfirmedOnly: req.GetConfirmedOnly(),
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	return &pb.ListUtxosReply{
-		InfoPerAccount: infoPerAccount,
+	return &pb.ListUtxosForAccountReply{
+		Info: utxoInfoListToProto(*utxoInfo),
 	}, nil
 }
 
+func (o operatorHandler) listLockedUtxos(
+	ctx context.Context,
+	req *pb.ListLockedUtxosRequest,
+) (*pb.ListLockedUtxosReply, error) {
+	locks, err := o.operatorSvc.ListLockedUtxos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListLockedUtxosReply{Locks: utxoInfoToProto(locks)}, nil
+}
+
+func (o operatorHandler) listPendingDeposits(
+	ctx context.Context,
+	req *pb.ListPendingDepositsRequest,
+) (*pb.ListPendingDepositsReply, error) {
+	deposits, err := o.operatorSvc.ListPendingDeposits(ctx, req.GetAccountIndex())
+	if err != nil {
+		return nil, err
+	}
+
+	pbDeposits := make([]*pb.PendingDeposit, 0, len(deposits))
+	for _, d := range deposits {
+		pbDeposits = append(pbDeposits, &pb.PendingDeposit{
+			Outpoint: &pb.TxOutpoint{
+				Hash:  d.Outpoint.Hash,
+				Index: int32(d.Outpoint.Index),
+			},
+			Value:         d.Value,
+			Asset:         d.Asset,
+			Address:       d.Address,
+			Pending:       d.Pending,
+			Confirmations: d.Confirmations,
+		})
+	}
+
+	return &pb.ListPendingDepositsReply{Deposits: pbDeposits}, nil
+}
+
 func (o operatorHandler) depositMarket(
 	ctx context.Context,
 	req *pb.DepositMarketRequest,
@@ -300,6 +739,40 @@ func (o operatorHandler) depositFeeAccount(
 	}, nil
 }
 
+func (o operatorHandler) getDepositAddress(
+	ctx context.Context,
+	req *pb.GetDepositAddressRequest,
+) (*pb.GetDepositAddressReply, error) {
+	market := req.GetMarket()
+	if err := validateMarket(market); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	deposits, err := o.operatorSvc.GetDepositAddress(
+		ctx,
+		application.Market{
+			BaseAsset:  market.GetBaseAsset(),
+			QuoteAsset: market.GetQuoteAsset(),
+		},
+		int(req.GetNumOfAddresses()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	addressesAndKeys := make([]*pbtypes.AddressWithBlindingKey, 0, len(deposits))
+	for _, d := range deposits {
+		addressesAndKeys = append(addressesAndKeys, &pbtypes.AddressWithBlindingKey{
+			Address:  d.Address,
+			Blinding: d.BlindingKey,
+		})
+	}
+
+	return &pb.GetDepositAddressReply{
+		AddressWithBlindingKey: addressesAndKeys,
+	}, nil
+}
+
 func (o operatorHandler) openMarket(
 	ctx context.Context,
 	req *pb.OpenMarketRequest,
@@ -340,9 +813,47 @@ func (o operatorHandler) closeMarket(
 	return &pb.CloseMarketReply{}, nil
 }
 
-func (o operatorHandler) updateMarketPercentageFee(
+func (o operatorHandler) pauseMarket(
 	ctx context.Context,
-	req *pb.UpdateMarketPercentageFeeRequest,
+	req *pb.PauseMarketRequest,
+) (*pb.PauseMarketReply, error) {
+	market := req.GetMarket()
+	if err := validateMarket(market); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := o.operatorSvc.PauseMarket(ctx, application.Market{
+		BaseAsset:  market.GetBaseAsset(),
+		QuoteAsset: market.GetQuoteAsset(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &pb.PauseMarketReply{}, nil
+}
+
+func (o operatorHandler) resumeMarket(
+	ctx context.Context,
+	req *pb.ResumeMarketRequest,
+) (*pb.ResumeMarketReply, error) {
+	market := req.GetMarket()
+	if err := validateMarket(market); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := o.operatorSvc.ResumeMarket(ctx, application.Market{
+		BaseAsset:  market.GetBaseAsset(),
+		QuoteAsset: market.GetQuoteAsset(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &pb.ResumeMarketReply{}, nil
+}
+
+func (o operatorHandler) updateMarketPercentageFee(
+	ctx context.Context,
+	req *pb.UpdateMarketPercentageFeeRequest,
 ) (*pb.UpdateMarketFeeReply, error) {
 	market := req.GetMarket()
 	if err := validateMarket(market); err != nil {
@@ -381,6 +892,75 @@ func (o operatorHandler) updateMarketPercentageFee(
 	}, nil
 }
 
+func (o operatorHandler) updateMarketFee(
+	ctx context.Context,
+	req *pb.UpdateMarketFeeRequest,
+) (*pb.UpdateMarketFeeReply, error) {
+	market := req.GetMarket()
+	if err := validateMarket(market); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := o.operatorSvc.UpdateMarketFee(
+		ctx,
+		application.Market{
+			BaseAsset:  market.GetBaseAsset(),
+			QuoteAsset: market.GetQuoteAsset(),
+		},
+		application.Fee{BasisPoint: req.GetBasisPoint()},
+		req.GetEffectiveAt(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateMarketFeeReply{
+		MarketWithFee: &pbtypes.MarketWithFee{
+			Market: &pbtypes.Market{
+				BaseAsset:  result.BaseAsset,
+				QuoteAsset: result.QuoteAsset,
+			},
+			Fee: &pbtypes.Fee{
+				BasisPoint: result.Fee.BasisPoint,
+				Fixed: &pbtypes.Fixed{
+					BaseFee:  result.FixedBaseFee,
+					QuoteFee: result.FixedQuoteFee,
+				},
+			},
+		},
+	}, nil
+}
+
+func (o operatorHandler) marketFeeHistory(
+	ctx context.Context,
+	req *pb.MarketFeeHistoryRequest,
+) (*pb.MarketFeeHistoryReply, error) {
+	market := req.GetMarket()
+	if err := validateMarket(market); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	history, err := o.operatorSvc.MarketFeeHistory(ctx, application.Market{
+		BaseAsset:  market.GetBaseAsset(),
+		QuoteAsset: market.GetQuoteAsset(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*pb.FeeChange, 0, len(history))
+	for _, change := range history {
+		changes = append(changes, &pb.FeeChange{
+			BasisPoint:  change.BasisPoint,
+			EffectiveAt: change.EffectiveAt,
+			CreatedAt:   change.CreatedAt,
+			Pending:     change.Pending,
+		})
+	}
+
+	return &pb.MarketFeeHistoryReply{Changes: changes}, nil
+}
+
 func (o operatorHandler) updateMarketFixedFee(
 	ctx context.Context,
 	req *pb.UpdateMarketFixedFeeRequest,
@@ -426,6 +1006,120 @@ func (o operatorHandler) updateMarketFixedFee(
 	}, nil
 }
 
+func (o operatorHandler) updateMarketFeeTiers(
+	ctx context.Context,
+	req *pb.UpdateMarketFeeTiersRequest,
+) (*pb.UpdateMarketFeeTiersReply, error) {
+	market := req.GetMarket()
+	if err := validateMarket(market); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tiers := make([]application.FeeTier, 0, len(req.GetFeeTiers()))
+	for _, tier := range req.GetFeeTiers() {
+		tiers = append(tiers, application.FeeTier{
+			MinVolume:  tier.GetMinVolume(),
+			BasisPoint: tier.GetBasisPoint(),
+		})
+	}
+
+	if err := o.operatorSvc.UpdateMarketFeeTiers(
+		ctx,
+		application.MarketFeeTiers{
+			Market: application.Market{
+				BaseAsset:  market.GetBaseAsset(),
+				QuoteAsset: market.GetQuoteAsset(),
+			},
+			Tiers: tiers,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateMarketFeeTiersReply{}, nil
+}
+
+func (o operatorHandler) updateMarketTradeLimits(
+	ctx context.Context,
+	req *pb.UpdateMarketTradeLimitsRequest,
+) (*pb.UpdateMarketTradeLimitsReply, error) {
+	market := req.GetMarket()
+	if err := validateMarket(market); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := o.operatorSvc.UpdateMarketTradeLimits(
+		ctx,
+		application.MarketTradeLimits{
+			Market: application.Market{
+				BaseAsset:  market.GetBaseAsset(),
+				QuoteAsset: market.GetQuoteAsset(),
+			},
+			MinTradeAmount: req.GetMinTradeAmount(),
+			MaxTradeAmount: req.GetMaxTradeAmount(),
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateMarketTradeLimitsReply{}, nil
+}
+
+func (o operatorHandler) updateMarketPricePrecision(
+	ctx context.Context,
+	req *pb.UpdateMarketPricePrecisionRequest,
+) (*pb.UpdateMarketPricePrecisionReply, error) {
+	market := req.GetMarket()
+	if err := validateMarket(market); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GetPrecision() > domain.MaxPricePrecision {
+		return nil, status.Error(
+			codes.InvalidArgument, domain.ErrInvalidPricePrecision.Error(),
+		)
+	}
+
+	if err := o.operatorSvc.UpdateMarketPricePrecision(
+		ctx,
+		application.MarketPricePrecision{
+			Market: application.Market{
+				BaseAsset:  market.GetBaseAsset(),
+				QuoteAsset: market.GetQuoteAsset(),
+			},
+			Precision: uint(req.GetPrecision()),
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateMarketPricePrecisionReply{}, nil
+}
+
+func (o operatorHandler) updateMarketSettlementTimeout(
+	ctx context.Context,
+	req *pb.UpdateMarketSettlementTimeoutRequest,
+) (*pb.UpdateMarketSettlementTimeoutReply, error) {
+	market := req.GetMarket()
+	if err := validateMarket(market); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := o.operatorSvc.UpdateMarketSettlementTimeout(
+		ctx,
+		application.MarketSettlementTimeout{
+			Market: application.Market{
+				BaseAsset:  market.GetBaseAsset(),
+				QuoteAsset: market.GetQuoteAsset(),
+			},
+			Timeout: req.GetTimeout(),
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateMarketSettlementTimeoutReply{}, nil
+}
+
 func (o operatorHandler) updateMarketPrice(
 	ctx context.Context,
 	req *pb.UpdateMarketPriceRequest,
@@ -484,6 +1178,42 @@ func (o operatorHandler) updateMarketStrategy(
 	return &pb.UpdateMarketStrategyReply{}, nil
 }
 
+func (o operatorHandler) switchMarketStrategy(
+	ctx context.Context,
+	req *pb.SwitchMarketStrategyRequest,
+) (*pb.SwitchMarketStrategyReply, error) {
+	market := req.GetMarket()
+	if err := validateMarket(market); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	strategyType := req.GetStrategyType()
+	if err := validateStrategyType(strategyType); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var params application.StrategyParams
+	if price := req.GetPrice(); price != nil {
+		params.Price = application.Price{
+			BasePrice:  decimal.NewFromFloat32(price.GetBasePrice()),
+			QuotePrice: decimal.NewFromFloat32(price.GetQuotePrice()),
+		}
+	}
+
+	if err := o.operatorSvc.SetStrategy(
+		ctx,
+		application.Market{
+			BaseAsset:  market.GetBaseAsset(),
+			QuoteAsset: market.GetQuoteAsset(),
+		},
+		domain.StrategyType(strategyType),
+		params,
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.SwitchMarketStrategyReply{}, nil
+}
+
 func (o operatorHandler) listTrades(
 	ctx context.Context,
 	req *pb.ListTradesRequest,
@@ -495,90 +1225,335 @@ func (o operatorHandler) listTrades(
 
 	pbTradeInfo := make([]*pb.TradeInfo, 0, len(tradeInfo))
 	for _, info := range tradeInfo {
-		basePrice, _ := info.Price.BasePrice.Float64()
-		quotePrice, _ := info.Price.QuotePrice.Float64()
-
-		pbInfo := &pb.TradeInfo{
-			TradeId: info.ID,
-			Status: &pb.TradeStatusInfo{
-				Status: pb.TradeStatus(info.Status.Code),
-				Failed: info.Status.Failed,
+		pbTradeInfo = append(pbTradeInfo, tradeInfoToProto(info))
+	}
+
+	return &pb.ListTradesReply{Trades: pbTradeInfo}, nil
+}
+
+func (o operatorHandler) getTradeByTxid(
+	ctx context.Context,
+	req *pb.GetTradeByTxidRequest,
+) (*pb.GetTradeByTxidReply, error) {
+	txid := req.GetTxid()
+	if len(txid) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing txid")
+	}
+
+	info, err := o.operatorSvc.GetTradeByTxid(ctx, txid)
+	if err != nil {
+		if errors.Is(err, application.ErrInvalidTxid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, application.ErrTradeNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &pb.GetTradeByTxidReply{Trade: tradeInfoToProto(*info)}, nil
+}
+
+func (o operatorHandler) cancelTrade(
+	ctx context.Context,
+	req *pb.CancelTradeRequest,
+) (*pb.CancelTradeReply, error) {
+	tradeID := req.GetTradeId()
+	if len(tradeID) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing trade id")
+	}
+
+	if err := o.tradeSvc.CancelTrade(ctx, tradeID); err != nil {
+		return nil, err
+	}
+	return &pb.CancelTradeReply{}, nil
+}
+
+func (o operatorHandler) tradeAudit(
+	ctx context.Context,
+	req *pb.TradeAuditRequest,
+) (*pb.TradeAuditReply, error) {
+	tradeID := req.GetTradeId()
+	if len(tradeID) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing trade id")
+	}
+
+	record, err := o.operatorSvc.TradeAudit(ctx, tradeID)
+	if err != nil {
+		if errors.Is(err, application.ErrInvalidTradeID) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, application.ErrTradeNotFound) ||
+			errors.Is(err, application.ErrTradeNotAudited) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &pb.TradeAuditReply{
+		TradeId:            record.TradeID,
+		Txid:               record.TxID,
+		SettleTimeUnix:     record.SettleTimeUnix,
+		MarketInputs:       auditOutpointsToProto(record.MarketInputs),
+		FeeInputs:          auditOutpointsToProto(record.FeeInputs),
+		MarketOutput:       auditOutpointToProto(record.MarketOutput),
+		ChangeOutputs:      auditOutpointsToProto(record.ChangeOutputs),
+		CounterpartyOutput: auditOutpointToProto(record.CounterpartyOutput),
+		CreatedAt:          record.CreatedAt,
+	}, nil
+}
+
+func (o operatorHandler) subscribeTrades(
+	req *pb.SubscribeTradesRequest,
+	stream pb.Operator_SubscribeTradesServer,
+) error {
+	filter := application.TradeFilter{MinStatus: int(req.GetMinStatus())}
+	if market := req.GetMarket(); market != nil {
+		if err := validateMarket(market); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.Market = application.Market{
+			BaseAsset:  market.GetBaseAsset(),
+			QuoteAsset: market.GetQuoteAsset(),
+		}
+	}
+
+	heartbeatInterval := defaultHeartbeatInterval
+	if i := req.GetHeartbeatInterval(); i > 0 {
+		// the interval is checked before converting it to a duration, that
+		// would overflow for huge values.
+		if i < uint64(minHeartbeatInterval/time.Second) ||
+			i > uint64(maxHeartbeatInterval/time.Second) {
+			return status.Errorf(
+				codes.InvalidArgument,
+				"heartbeat interval must be in range [%v, %v]",
+				minHeartbeatInterval, maxHeartbeatInterval,
+			)
+		}
+		heartbeatInterval = time.Duration(i) * time.Second
+	}
+
+	trades, chInfo, err := o.operatorSvc.SubscribeTrades(stream.Context(), filter)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range trades {
+		if err := stream.Send(&pb.SubscribeTradesReply{
+			Trade: tradeInfoToProto(info),
+		}); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case info, ok := <-chInfo:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&pb.SubscribeTradesReply{
+				Trade: tradeInfoToProto(info),
+			}); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := stream.Send(&pb.SubscribeTradesReply{
+				Heartbeat: true,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (o operatorHandler) exportTrades(
+	req *pb.ExportTradesRequest,
+	stream pb.Operator_ExportTradesServer,
+) error {
+	w := bufio.NewWriterSize(exportStreamWriter{stream}, exportChunkSize)
+	if err := o.operatorSvc.ExportTrades(
+		stream.Context(),
+		req.GetFrom(),
+		req.GetTo(),
+		application.ExportFormat(req.GetFormat()),
+		w,
+	); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// exportStreamWriter sends every chunk written to it as a reply of the
+// stream of a trade history export.
+type exportStreamWriter struct {
+	stream pb.Operator_ExportTradesServer
+}
+
+func (w exportStreamWriter) Write(chunk []byte) (int, error) {
+	buf := make([]byte, len(chunk))
+	copy(buf, chunk)
+	if err := w.stream.Send(&pb.ExportTradesReply{Chunk: buf}); err != nil {
+		return 0, err
+	}
+	return len(chunk), nil
+}
+
+func tradeInfoToProto(info application.TradeInfo) *pb.TradeInfo {
+	basePrice, _ := info.Price.BasePrice.Float64()
+	quotePrice, _ := info.Price.QuotePrice.Float64()
+
+	pbInfo := &pb.TradeInfo{
+		TradeId: info.ID,
+		Status: &pb.TradeStatusInfo{
+			Status: pb.TradeStatus(info.Status.Code),
+			Failed: info.Status.Failed,
+		},
+		MarketWithFee: &pbtypes.MarketWithFee{
+			Market: &pbtypes.Market{
+				BaseAsset:  info.MarketWithFee.BaseAsset,
+				QuoteAsset: info.MarketWithFee.QuoteAsset,
 			},
-			MarketWithFee: &pbtypes.MarketWithFee{
-				Market: &pbtypes.Market{
-					BaseAsset:  info.MarketWithFee.BaseAsset,
-					QuoteAsset: info.MarketWithFee.QuoteAsset,
-				},
-				Fee: &pbtypes.Fee{
-					BasisPoint: info.MarketWithFee.BasisPoint,
-					Fixed: &pbtypes.Fixed{
-						BaseFee:  info.MarketWithFee.FixedBaseFee,
-						QuoteFee: info.MarketWithFee.FixedQuoteFee,
-					},
+			Fee: &pbtypes.Fee{
+				BasisPoint: info.MarketWithFee.BasisPoint,
+				Fixed: &pbtypes.Fixed{
+					BaseFee:  info.MarketWithFee.FixedBaseFee,
+					QuoteFee: info.MarketWithFee.FixedQuoteFee,
 				},
 			},
-			Price: &pb.TradePrice{
-				BasePrice:  basePrice,
-				QuotePrice: quotePrice,
-			},
-			TxUrl:            info.TxURL,
-			RequestTimeUnix:  info.RequestTimeUnix,
-			AcceptTimeUnix:   info.AcceptTimeUnix,
-			CompleteTimeUnix: info.CompleteTimeUnix,
-			SettleTimeUnix:   info.SettleTimeUnix,
-			ExpiryTimeUnix:   info.ExpiryTimeUnix,
+		},
+		Price: &pb.TradePrice{
+			BasePrice:  basePrice,
+			QuotePrice: quotePrice,
+		},
+		TxUrl:            info.TxURL,
+		RequestTimeUnix:  info.RequestTimeUnix,
+		AcceptTimeUnix:   info.AcceptTimeUnix,
+		CompleteTimeUnix: info.CompleteTimeUnix,
+		SettleTimeUnix:   info.SettleTimeUnix,
+		ExpiryTimeUnix:   info.ExpiryTimeUnix,
+	}
+
+	swapInfoEmpty := info.SwapInfo == application.SwapInfo{}
+	if !swapInfoEmpty {
+		pbInfo.SwapInfo = &pb.SwapInfo{
+			AssetP:           info.SwapInfo.AssetP,
+			AmountP:          info.SwapInfo.AmountP,
+			AssetR:           info.SwapInfo.AssetR,
+			AmountR:          info.SwapInfo.AmountR,
+			ValueCommitmentP: info.SwapInfo.ValueCommitmentP,
+			AssetCommitmentP: info.SwapInfo.AssetCommitmentP,
+			ValueCommitmentR: info.SwapInfo.ValueCommitmentR,
+			AssetCommitmentR: info.SwapInfo.AssetCommitmentR,
+		}
+	}
+
+	failInfoEmpty := info.SwapFailInfo == application.SwapFailInfo{}
+	if !failInfoEmpty {
+		pbInfo.FailInfo = &pb.SwapFailInfo{
+			FailureCode:    uint32(info.SwapFailInfo.Code),
+			FailureMessage: info.SwapFailInfo.Message,
 		}
+	}
+
+	if tt := info.RequestTimeUnix; tt > 0 {
+		pbInfo.RequestTimeUtc = time.Unix(int64(tt), 0).UTC().String()
+	}
+	if tt := info.AcceptTimeUnix; tt > 0 {
+		pbInfo.AcceptTimeUtc = time.Unix(int64(tt), 0).UTC().String()
+	}
+	if tt := info.CompleteTimeUnix; tt > 0 {
+		pbInfo.CompleteTimeUtc = time.Unix(int64(tt), 0).UTC().String()
+	}
+	if tt := info.SettleTimeUnix; tt > 0 {
+		pbInfo.SettleTimeUtc = time.Unix(int64(tt), 0).UTC().String()
+	}
+	if tt := info.ExpiryTimeUnix; tt > 0 {
+		pbInfo.ExpiryTimeUtc = time.Unix(int64(tt), 0).UTC().String()
+	}
+
+	return pbInfo
+}
+
+func (o operatorHandler) withdrawMarket(
+	ctx context.Context,
+	req *pb.WithdrawMarketRequest,
+) (*pb.WithdrawMarketReply, error) {
+	wm, err := parseWithdrawMarketRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rawTx, err := o.operatorSvc.WithdrawMarketFunds(ctx, wm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.WithdrawMarketReply{RawTx: rawTx}, nil
+}
+
+func (o operatorHandler) estimateWithdrawFee(
+	ctx context.Context,
+	req *pb.EstimateWithdrawFeeRequest,
+) (*pb.EstimateWithdrawFeeReply, error) {
+	wm, err := parseWithdrawMarketRequest(req.GetWithdrawal())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		swapInfoEmpty := info.SwapInfo == application.SwapInfo{}
-		if !swapInfoEmpty {
-			pbInfo.SwapInfo = &pb.SwapInfo{
-				AssetP:  info.SwapInfo.AssetP,
-				AmountP: info.SwapInfo.AmountP,
-				AssetR:  info.SwapInfo.AssetR,
-				AmountR: info.SwapInfo.AmountR,
-			}
-		}
+	vsize, feeAmount, err := o.operatorSvc.EstimateWithdrawFee(ctx, wm)
+	if err != nil {
+		return nil, err
+	}
 
-		failInfoEmpty := info.SwapFailInfo == application.SwapFailInfo{}
-		if !failInfoEmpty {
-			pbInfo.FailInfo = &pb.SwapFailInfo{
-				FailureCode:    uint32(info.SwapFailInfo.Code),
-				FailureMessage: info.SwapFailInfo.Message,
-			}
-		}
+	return &pb.EstimateWithdrawFeeReply{
+		Vsize:     uint64(vsize),
+		FeeAmount: feeAmount,
+	}, nil
+}
 
-		if tt := info.RequestTimeUnix; tt > 0 {
-			pbInfo.RequestTimeUtc = time.Unix(int64(tt), 0).UTC().String()
-		}
-		if tt := info.AcceptTimeUnix; tt > 0 {
-			pbInfo.AcceptTimeUtc = time.Unix(int64(tt), 0).UTC().String()
-		}
-		if tt := info.CompleteTimeUnix; tt > 0 {
-			pbInfo.CompleteTimeUtc = time.Unix(int64(tt), 0).UTC().String()
-		}
-		if tt := info.SettleTimeUnix; tt > 0 {
-			pbInfo.SettleTimeUtc = time.Unix(int64(tt), 0).UTC().String()
-		}
-		if tt := info.ExpiryTimeUnix; tt > 0 {
-			pbInfo.ExpiryTimeUtc = time.Unix(int64(tt), 0).UTC().String()
+func (o operatorHandler) withdrawMultiple(
+	ctx context.Context,
+	req *pb.WithdrawMultipleRequest,
+) (*pb.WithdrawMultipleReply, error) {
+	if len(req.GetWithdrawals()) <= 0 {
+		return nil, status.Error(
+			codes.InvalidArgument, application.ErrNoWithdrawals.Error(),
+		)
+	}
+
+	withdrawals := make([]application.WithdrawMarketReq, 0, len(req.GetWithdrawals()))
+	for _, w := range req.GetWithdrawals() {
+		wm, err := parseWithdrawMarketRequest(w)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		withdrawals = append(withdrawals, wm)
+	}
 
-		pbTradeInfo = append(pbTradeInfo, pbInfo)
+	txid, err := o.operatorSvc.WithdrawMultiple(ctx, withdrawals)
+	if err != nil {
+		return nil, err
 	}
 
-	return &pb.ListTradesReply{Trades: pbTradeInfo}, nil
+	return &pb.WithdrawMultipleReply{Txid: txid}, nil
 }
 
-func (o operatorHandler) withdrawMarket(
-	ctx context.Context,
+func parseWithdrawMarketRequest(
 	req *pb.WithdrawMarketRequest,
-) (*pb.WithdrawMarketReply, error) {
-	market := req.GetMarket()
-	if err := validateMarket(market); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+) (application.WithdrawMarketReq, error) {
+	if err := validateMarket(req.GetMarket()); err != nil {
+		return application.WithdrawMarketReq{}, err
+	}
+	displayBalance, err := parseDisplayBalance(req.GetBalanceToWithdrawDisplay())
+	if err != nil {
+		return application.WithdrawMarketReq{}, err
 	}
 
-	wm := application.WithdrawMarketReq{
+	return application.WithdrawMarketReq{
 		Market: application.Market{
 			BaseAsset:  req.GetMarket().GetBaseAsset(),
 			QuoteAsset: req.GetMarket().GetQuoteAsset(),
@@ -587,17 +1562,59 @@ func (o operatorHandler) withdrawMarket(
 			BaseAmount:  req.GetBalanceToWithdraw().GetBaseAmount(),
 			QuoteAmount: req.GetBalanceToWithdraw().GetQuoteAmount(),
 		},
+		DisplayBalanceToWithdraw: displayBalance,
+		MinReserve: application.Balance{
+			BaseAmount:  req.GetMinReserve().GetBaseAmount(),
+			QuoteAmount: req.GetMinReserve().GetQuoteAmount(),
+		},
 		MillisatPerByte: req.GetMillisatPerByte(),
 		Address:         req.GetAddress(),
 		Push:            true,
+	}, nil
+}
+
+// parseDisplayBalance parses the given balance, the empty amounts are zero.
+func parseDisplayBalance(
+	balance *pb.DisplayBalance,
+) (*application.DisplayBalance, error) {
+	if balance == nil {
+		return nil, nil
 	}
 
-	rawTx, err := o.operatorSvc.WithdrawMarketFunds(ctx, wm)
+	amounts := make([]decimal.Decimal, 0, 2)
+	for _, str := range []string{balance.GetBaseAmount(), balance.GetQuoteAmount()} {
+		if str == "" {
+			amounts = append(amounts, decimal.Zero)
+			continue
+		}
+		amount, err := decimal.NewFromString(str)
+		if err != nil {
+			return nil, errors.New("invalid display amount")
+		}
+		amounts = append(amounts, amount)
+	}
+	return &application.DisplayBalance{
+		BaseAmount:  amounts[0],
+		QuoteAmount: amounts[1],
+	}, nil
+}
+
+func (o operatorHandler) bumpWithdrawFee(
+	ctx context.Context,
+	req *pb.BumpWithdrawFeeRequest,
+) (*pb.BumpWithdrawFeeReply, error) {
+	if len(req.GetTxid()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing txid")
+	}
+
+	txid, err := o.operatorSvc.BumpWithdrawFee(
+		ctx, req.GetTxid(), req.GetMillisatPerByte(),
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.WithdrawMarketReply{RawTx: rawTx}, nil
+	return &pb.BumpWithdrawFeeReply{Txid: txid}, nil
 }
 
 func (o operatorHandler) balanceFeeAccount(
@@ -612,6 +1629,44 @@ func (o operatorHandler) balanceFeeAccount(
 	return &pb.BalanceFeeAccountReply{Balance: balance}, nil
 }
 
+func (o operatorHandler) totalBalance(
+	ctx context.Context,
+	req *pb.TotalBalanceRequest,
+) (*pb.TotalBalanceReply, error) {
+	balances, err := o.operatorSvc.TotalBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := make(map[string]*pb.AssetBalanceInfo)
+	for asset, b := range balances {
+		balance[asset] = &pb.AssetBalanceInfo{
+			TotalBalance:       b.TotalBalance,
+			ConfirmedBalance:   b.ConfirmedBalance,
+			UnconfirmedBalance: b.UnconfirmedBalance,
+		}
+	}
+
+	return &pb.TotalBalanceReply{Balance: balance}, nil
+}
+
+func (o operatorHandler) consolidateFeeAccount(
+	ctx context.Context,
+	req *pb.ConsolidateFeeAccountRequest,
+) (*pb.ConsolidateFeeAccountReply, error) {
+	txid, numInputs, err := o.operatorSvc.ConsolidateFeeAccount(
+		ctx, int(req.GetMaxInputs()), req.GetMillisatPerByte(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ConsolidateFeeAccountReply{
+		Txid:      txid,
+		NumInputs: uint64(numInputs),
+	}, nil
+}
+
 func (o operatorHandler) claimMarketDeposit(
 	ctx context.Context,
 	req *pb.ClaimMarketDepositRequest,
@@ -624,18 +1679,49 @@ func (o operatorHandler) claimMarketDeposit(
 		})
 	}
 
-	if err := o.operatorSvc.ClaimMarketDeposit(
+	market := application.Market{
+		BaseAsset:  req.GetMarket().GetBaseAsset(),
+		QuoteAsset: req.GetMarket().GetQuoteAsset(),
+	}
+	if err := o.operatorSvc.ClaimMarketDeposit(ctx, market, outputs); err != nil {
+		return nil, err
+	}
+
+	if label := req.GetLabel(); label != "" {
+		if err := o.operatorSvc.LabelMarketDeposit(
+			ctx, market, outputs, label,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ClaimMarketDepositReply{}, nil
+}
+
+func (o operatorHandler) balanceByLabel(
+	ctx context.Context,
+	req *pb.BalanceByLabelRequest,
+) (*pb.BalanceByLabelReply, error) {
+	balanceByLabel, err := o.operatorSvc.BalanceByLabel(
 		ctx,
 		application.Market{
 			BaseAsset:  req.GetMarket().GetBaseAsset(),
 			QuoteAsset: req.GetMarket().GetQuoteAsset(),
 		},
-		outputs,
-	); err != nil {
+	)
+	if err != nil {
 		return nil, err
 	}
 
-	return &pb.ClaimMarketDepositReply{}, nil
+	balance := make(map[string]*pbtypes.Balance, len(balanceByLabel))
+	for label, b := range balanceByLabel {
+		balance[label] = &pbtypes.Balance{
+			BaseAmount:  b.BaseAmount,
+			QuoteAmount: b.QuoteAmount,
+		}
+	}
+
+	return &pb.BalanceByLabelReply{Balance: balance}, nil
 }
 
 func (o operatorHandler) claimFeeDeposit(
@@ -660,6 +1746,54 @@ func (o operatorHandler) claimFeeDeposit(
 	return &pb.ClaimFeeDepositReply{}, nil
 }
 
+func (o operatorHandler) fundMarket(
+	ctx context.Context,
+	req *pb.FundMarketRequest,
+) (*pb.FundMarketReply, error) {
+	if err := o.operatorSvc.FundMarket(
+		ctx,
+		application.Market{
+			BaseAsset:  req.GetMarket().GetBaseAsset(),
+			QuoteAsset: req.GetMarket().GetQuoteAsset(),
+		},
+		application.Balance{
+			BaseAmount:  req.GetAmounts().GetBaseAmount(),
+			QuoteAmount: req.GetAmounts().GetQuoteAmount(),
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.FundMarketReply{}, nil
+}
+
+func (o operatorHandler) importUtxo(
+	ctx context.Context,
+	req *pb.ImportUtxoRequest,
+) (*pb.ImportUtxoReply, error) {
+	if req.GetOutpoint() == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing outpoint")
+	}
+	blindingKey, err := hex.DecodeString(req.GetBlindingKey())
+	if err != nil || len(blindingKey) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid blinding key")
+	}
+
+	if err := o.operatorSvc.ImportUtxo(
+		ctx,
+		int(req.GetAccountIndex()),
+		application.TxOutpoint{
+			Hash:  req.GetOutpoint().GetHash(),
+			Index: int(req.GetOutpoint().GetIndex()),
+		},
+		blindingKey,
+	); err != nil {
+		return nil, err
+	}
+
+	return &pb.ImportUtxoReply{}, nil
+}
+
 func (o operatorHandler) listDepositMarket(
 	ctx context.Context,
 	req *pb.ListDepositMarketRequest,
@@ -684,7 +1818,27 @@ func (o operatorHandler) listDepositMarket(
 
 // ListMarket returns the result of the ListMarket method of the operator service.
 func (o operatorHandler) listMarket(ctx context.Context, req *pb.ListMarketRequest) (*pb.ListMarketReply, error) {
-	marketInfos, err := o.operatorSvc.ListMarket(ctx)
+	var marketInfos []application.MarketInfo
+	var err error
+	baseAsset, asset := req.GetBaseAsset(), req.GetAsset()
+	if baseAsset != "" && asset != "" {
+		return nil, status.Error(
+			codes.InvalidArgument, "base asset and asset filters are mutually exclusive",
+		)
+	}
+	if baseAsset != "" {
+		if err := validateAsset(baseAsset); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		marketInfos, err = o.operatorSvc.MarketsByBaseAsset(ctx, baseAsset)
+	} else if asset != "" {
+		if err := validateAsset(asset); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		marketInfos, err = o.operatorSvc.MarketsContainingAsset(ctx, asset)
+	} else {
+		marketInfos, err = o.operatorSvc.ListMarket(ctx)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -707,19 +1861,130 @@ func (o operatorHandler) listMarket(ctx context.Context, req *pb.ListMarketReque
 					QuoteFee: marketInfo.Fee.FixedQuoteFee,
 				},
 			},
-			Tradable:     marketInfo.Tradable,
-			StrategyType: pb.StrategyType(marketInfo.StrategyType),
-			AccountIndex: marketInfo.AccountIndex,
+			Tradable:          marketInfo.Tradable,
+			Paused:            marketInfo.Paused,
+			StrategyType:      pb.StrategyType(marketInfo.StrategyType),
+			AccountIndex:      marketInfo.AccountIndex,
+			PricePrecision:    uint32(marketInfo.PricePrecision),
+			SettlementTimeout: marketInfo.SettlementTimeout,
+			FeeTiers:          feeTiersToProto(marketInfo.FeeTiers),
 			Price: &pbtypes.Price{
 				BasePrice:  basePrice,
 				QuotePrice: quotePrice,
 			},
+			BaseAssetInfo:  assetInfoToProto(marketInfo.BaseAssetInfo),
+			QuoteAssetInfo: assetInfoToProto(marketInfo.QuoteAssetInfo),
 		})
 	}
 
 	return &pb.ListMarketReply{Markets: pbMarketInfos}, nil
 }
 
+func (o operatorHandler) getInfo(
+	ctx context.Context,
+	req *pb.GetInfoRequest,
+) (*pb.GetInfoReply, error) {
+	info, err := o.operatorSvc.GetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetInfoReply{
+		Version:       info.Version,
+		Commit:        info.Commit,
+		BuildDate:     info.Date,
+		Network:       info.Network,
+		StartTimeUnix: info.StartTimeUnix,
+		UptimeSeconds: uint64(info.Uptime.Seconds()),
+		NumOfMarkets:  uint64(info.NumOfMarkets),
+	}, nil
+}
+
+func (o operatorHandler) getAssetInfo(
+	ctx context.Context,
+	req *pb.GetAssetInfoRequest,
+) (*pb.GetAssetInfoReply, error) {
+	asset := req.GetAsset()
+	if err := validateAsset(asset); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	info, err := o.operatorSvc.AssetInfo(ctx, asset)
+	if err != nil {
+		if errors.Is(err, application.ErrAssetRegistryNotConfigured) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	return &pb.GetAssetInfoReply{Info: assetInfoToProto(info)}, nil
+}
+
+func (o operatorHandler) getBlindingData(
+	ctx context.Context,
+	req *pb.GetBlindingDataRequest,
+) (*pb.GetBlindingDataReply, error) {
+	if len(req.GetPset()) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing pset")
+	}
+
+	in, out, err := o.operatorSvc.GetBlindingData(
+		req.GetPset(), req.GetInputBlindingKeys(), req.GetOutputBlindingKeys(),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.GetBlindingDataReply{
+		Inputs:  blindingDataToProto(in),
+		Outputs: blindingDataToProto(out),
+	}, nil
+}
+
+func (o operatorHandler) accountXPub(
+	ctx context.Context,
+	req *pb.AccountXPubRequest,
+) (*pb.AccountXPubReply, error) {
+	xpub, err := o.operatorSvc.AccountXPub(ctx, req.GetAccountIndex())
+	if err != nil {
+		return nil, err
+	}
+
+	var masterBlindingKey string
+	if req.GetWithMasterBlindingKey() {
+		masterBlindingKey, err = o.operatorSvc.MasterBlindingKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.AccountXPubReply{
+		Xpub:              xpub,
+		MasterBlindingKey: masterBlindingKey,
+	}, nil
+}
+
+func (o operatorHandler) rotateBlindingKey(
+	ctx context.Context,
+	req *pb.RotateBlindingKeyRequest,
+) (*pb.RotateBlindingKeyReply, error) {
+	if len(req.GetAddress()) <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing address")
+	}
+
+	info, err := o.operatorSvc.RotateBlindingKey(ctx, req.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RotateBlindingKeyReply{
+		AddressWithBlindingKey: &pbtypes.AddressWithBlindingKey{
+			Address:  info.Address,
+			Blinding: info.BlindingKey,
+		},
+	}, nil
+}
+
 func (o operatorHandler) reportMarketFee(
 	ctx context.Context,
 	req *pb.ReportMarketFeeRequest,
@@ -739,21 +2004,153 @@ func (o operatorHandler) reportMarketFee(
 		return nil, err
 	}
 
+	return reportMarketFeeToProto(report), nil
+}
+
+func (o operatorHandler) reportMarketFeeRange(
+	ctx context.Context,
+	req *pb.ReportMarketFeeRangeRequest,
+) (*pb.ReportMarketFeeReply, error) {
+	if err := validateMarket(req.GetMarket()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	report, err := o.operatorSvc.ReportMarketFeeRange(
+		ctx,
+		application.Market{
+			BaseAsset:  req.GetMarket().GetBaseAsset(),
+			QuoteAsset: req.GetMarket().GetQuoteAsset(),
+		},
+		req.GetFrom(),
+		req.GetTo(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return reportMarketFeeToProto(report), nil
+}
+
+func reportMarketFeeToProto(
+	report *application.ReportMarketFee,
+) *pb.ReportMarketFeeReply {
 	collectedFees := make([]*pb.FeeInfo, 0)
 	for _, fee := range report.CollectedFees {
 		marketPrice, _ := fee.MarketPrice.BigFloat().Float32()
+		var fiatValue float32
+		if fee.FiatValue != nil {
+			fiatValue, _ = fee.FiatValue.BigFloat().Float32()
+		}
 		collectedFees = append(collectedFees, &pb.FeeInfo{
-			TradeId:     fee.TradeID,
-			BasisPoint:  fee.BasisPoint,
-			Asset:       fee.Asset,
-			Amount:      fee.Amount,
-			MarketPrice: marketPrice,
+			TradeId:          fee.TradeID,
+			BasisPoint:       fee.BasisPoint,
+			Asset:            fee.Asset,
+			Amount:           fee.Amount,
+			MarketPrice:      marketPrice,
+			FiatValue:        fiatValue,
+			BasisPointAmount: fee.BasisPointAmount,
+			FixedAmount:      fee.FixedAmount,
 		})
 	}
 
+	totalFiat := make(map[string]float32)
+	for asset, value := range report.TotalCollectedFeesFiat {
+		totalFiat[asset], _ = value.BigFloat().Float32()
+	}
+
 	return &pb.ReportMarketFeeReply{
 		CollectedFees:              collectedFees,
 		TotalCollectedFeesPerAsset: report.TotalCollectedFeesPerAsset,
+		TotalCollectedFeesFiat:     totalFiat,
+		FiatCurrency:               report.FiatCurrency,
+	}
+}
+
+func (o operatorHandler) tradeVolume(
+	ctx context.Context,
+	req *pb.TradeVolumeRequest,
+) (*pb.TradeVolumeReply, error) {
+	if err := validateMarket(req.GetMarket()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	report, err := o.operatorSvc.TradeVolume(
+		ctx,
+		application.Market{
+			BaseAsset:  req.GetMarket().GetBaseAsset(),
+			QuoteAsset: req.GetMarket().GetQuoteAsset(),
+		},
+		req.GetFrom(),
+		req.GetTo(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TradeVolumeReply{
+		BaseVolume:  report.BaseVolume,
+		QuoteVolume: report.QuoteVolume,
+		TradeCount:  uint64(report.TradeCount),
+	}, nil
+}
+
+func (o operatorHandler) verifyFeeLedger(
+	ctx context.Context,
+	req *pb.VerifyFeeLedgerRequest,
+) (*pb.VerifyFeeLedgerReply, error) {
+	discrepancies, err := o.operatorSvc.VerifyFeeLedger(ctx, req.GetRepair())
+	if err != nil {
+		return nil, err
+	}
+
+	pbDiscrepancies := make([]*pb.FeeLedgerDiscrepancy, 0, len(discrepancies))
+	for _, d := range discrepancies {
+		pbDiscrepancies = append(pbDiscrepancies, &pb.FeeLedgerDiscrepancy{
+			TradeId:          d.TradeID,
+			MarketQuoteAsset: d.MarketQuoteAsset,
+			Reason:           d.Reason,
+			Repaired:         d.Repaired,
+		})
+	}
+
+	return &pb.VerifyFeeLedgerReply{Discrepancies: pbDiscrepancies}, nil
+}
+
+func (o operatorHandler) proveReserves(
+	ctx context.Context,
+	req *pb.ProveReservesRequest,
+) (*pb.ProveReservesReply, error) {
+	proof, err := o.operatorSvc.ProveReserves(ctx, req.GetNonce())
+	if err != nil {
+		return nil, err
+	}
+
+	pbAccounts := make([]*pb.AccountReserves, 0, len(proof.Accounts))
+	for _, account := range proof.Accounts {
+		pbOutpoints := make([]*pb.OutpointReserve, 0, len(account.Outpoints))
+		for _, o := range account.Outpoints {
+			pbOutpoints = append(pbOutpoints, &pb.OutpointReserve{
+				Txid:          o.TxID,
+				Vout:          o.VOut,
+				Asset:         o.Asset,
+				Amount:        o.Amount,
+				AssetBlinder:  o.AssetBlinder,
+				AmountBlinder: o.AmountBlinder,
+				Script:        o.Script,
+				Pubkey:        o.PubKey,
+				Signature:     o.Signature,
+			})
+		}
+		pbAccounts = append(pbAccounts, &pb.AccountReserves{
+			AccountIndex: uint64(account.AccountIndex),
+			Outpoints:    pbOutpoints,
+		})
+	}
+
+	return &pb.ProveReservesReply{
+		Timestamp: proof.Timestamp,
+		Accounts:  pbAccounts,
+		Nonce:     proof.Nonce,
 	}, nil
 }
 
@@ -795,3 +2192,97 @@ func validateStrategyType(sType pb.StrategyType) error {
 	}
 	return nil
 }
+
+func utxoInfoListToProto(list application.UtxoInfoList) *pb.UtxoInfoList {
+	return &pb.UtxoInfoList{
+		Unspents: utxoInfoToProto(list.Unspents),
+		Spents:   utxoInfoToProto(list.Spents),
+		Locks:    utxoInfoToProto(list.Locks),
+	}
+}
+
+func utxoInfoToProto(utxos []application.UtxoInfo) []*pb.UtxoInfo {
+	list := make([]*pb.UtxoInfo, 0, len(utxos))
+	for _, u := range utxos {
+		list = append(list, &pb.UtxoInfo{
+			Outpoint: &pb.TxOutpoint{
+				Hash:  u.Outpoint.Hash,
+				Index: int32(u.Outpoint.Index),
+			},
+			Value:         u.Value,
+			Asset:         u.Asset,
+			TradeId:       u.TradeID,
+			LockTimestamp: u.LockTimestamp,
+			Excluded:      u.Excluded,
+		})
+	}
+	return list
+}
+
+func auditOutpointsToProto(
+	list []application.TradeAuditOutpoint,
+) []*pb.AuditOutpoint {
+	outpoints := make([]*pb.AuditOutpoint, 0, len(list))
+	for i := range list {
+		outpoints = append(outpoints, auditOutpointToProto(&list[i]))
+	}
+	return outpoints
+}
+
+func auditOutpointToProto(o *application.TradeAuditOutpoint) *pb.AuditOutpoint {
+	if o == nil {
+		return nil
+	}
+	return &pb.AuditOutpoint{
+		Txid:         o.TxID,
+		Vout:         o.VOut,
+		AccountIndex: int32(o.AccountIndex),
+		Asset:        o.Asset,
+		Amount:       o.Amount,
+		Script:       o.Script,
+	}
+}
+
+// blindingDataToProto returns the given blinding data sorted by index.
+func blindingDataToProto(
+	data map[int]application.BlindingData,
+) []*pb.BlindingData {
+	list := make([]*pb.BlindingData, 0, len(data))
+	for i, d := range data {
+		list = append(list, &pb.BlindingData{
+			Index:         uint32(i),
+			Asset:         d.Asset,
+			Amount:        d.Amount,
+			AssetBlinder:  d.AssetBlinder,
+			AmountBlinder: d.AmountBlinder,
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Index < list[j].Index
+	})
+	return list
+}
+
+func assetInfoToProto(info *application.AssetInfo) *pb.AssetInfo {
+	if info == nil {
+		return nil
+	}
+	return &pb.AssetInfo{
+		Asset:      info.AssetHash,
+		Ticker:     info.Ticker,
+		Name:       info.Name,
+		Precision:  uint32(info.Precision),
+		Registered: info.Registered,
+	}
+}
+
+func feeTiersToProto(tiers []application.FeeTier) []*pb.FeeTier {
+	pbTiers := make([]*pb.FeeTier, 0, len(tiers))
+	for _, tier := range tiers {
+		pbTiers = append(pbTiers, &pb.FeeTier{
+			MinVolume:  tier.MinVolume,
+			BasisPoint: tier.BasisPoint,
+		})
+	}
+	return pbTiers
+}
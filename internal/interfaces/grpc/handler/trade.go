@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"net"
 
 	"github.com/tdex-network/tdex-daemon/internal/core/application"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
@@ -12,6 +13,7 @@ import (
 	"github.com/tdex-network/tdex-protobuf/generated/go/types"
 	pbtypes "github.com/tdex-network/tdex-protobuf/generated/go/types"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -155,7 +157,7 @@ func (t traderHandler) marketPrice(
 	}
 
 	preview, err := t.traderSvc.GetMarketPrice(
-		ctx,
+		withPeer(ctx),
 		application.Market{
 			BaseAsset:  market.GetBaseAsset(),
 			QuoteAsset: market.GetQuoteAsset(),
@@ -219,7 +221,7 @@ func (t traderHandler) tradePropose(
 	}
 
 	accept, fail, swapExpiryTime, err := t.traderSvc.TradePropose(
-		stream.Context(),
+		withPeer(stream.Context()),
 		market,
 		int(tradeType),
 		swapRequest,
@@ -282,9 +284,8 @@ func (t traderHandler) tradeComplete(
 		swapCompletePtr,
 		swapFailPtr,
 	)
-
 	if err != nil {
-		return status.Error(codes.Internal, err.Error())
+		return err
 	}
 
 	var swapFailStub *pbswap.SwapFail
@@ -345,3 +346,18 @@ func validateAsset(asset string) error {
 	}
 	return nil
 }
+
+// withPeer adds the host of the trader's address, if known, to the given
+// context for rate limiting its requests and charging it its own fee, if any.
+// The port is dropped since it changes at every connection.
+func withPeer(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ctx
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return application.WithPeer(ctx, host)
+}
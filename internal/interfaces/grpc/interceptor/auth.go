@@ -0,0 +1,88 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/tdex-network/tdex-daemon/internal/core/application"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AuthTokenKey is the key of the gRPC metadata traders present their access
+// token with, when the trader interface is restricted to a set of peers.
+const AuthTokenKey = "x-tdex-auth-token"
+
+// healthServicePrefix is the prefix of the methods of the gRPC health
+// service, that are always public so that probes don't need any token.
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+var errUnauthenticated = status.Error(
+	codes.Unauthenticated, "missing or invalid auth token",
+)
+
+func unaryAuth(tokens map[string]string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, ok := authenticate(ctx, info.FullMethod, tokens)
+		if !ok {
+			return nil, errUnauthenticated
+		}
+		return handler(ctx, req)
+	}
+}
+
+func streamAuth(tokens map[string]string) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, ok := authenticate(stream.Context(), info.FullMethod, tokens)
+		if !ok {
+			return errUnauthenticated
+		}
+		wrapped := middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+// authenticate returns whether the request has been made to a public method
+// or by a peer presenting one of the given tokens. In the latter case, the
+// returned context carries the name of the peer the token has been issued
+// to, if any.
+func authenticate(
+	ctx context.Context,
+	method string,
+	tokens map[string]string,
+) (context.Context, bool) {
+	if strings.HasPrefix(method, healthServicePrefix) {
+		return ctx, true
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, false
+	}
+	for _, presented := range md.Get(AuthTokenKey) {
+		for token, peer := range tokens {
+			if subtle.ConstantTimeCompare([]byte(presented), []byte(token)) == 1 {
+				if peer != "" {
+					ctx = application.WithAuthenticatedPeer(ctx, peer)
+				}
+				return ctx, true
+			}
+		}
+	}
+	return ctx, false
+}
@@ -0,0 +1,105 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const tradeMethod = "/TradeService/TradePropose"
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (m mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+func TestAuth(t *testing.T) {
+	tokens := map[string]string{"token1": "alice", "token2": ""}
+
+	tests := []struct {
+		name          string
+		method        string
+		md            metadata.MD
+		authenticated bool
+	}{
+		{
+			name:          "missing token",
+			method:        tradeMethod,
+			authenticated: false,
+		},
+		{
+			name:          "wrong token",
+			method:        tradeMethod,
+			md:            metadata.Pairs(AuthTokenKey, "token3"),
+			authenticated: false,
+		},
+		{
+			name:          "named token",
+			method:        tradeMethod,
+			md:            metadata.Pairs(AuthTokenKey, "token1"),
+			authenticated: true,
+		},
+		{
+			name:          "unnamed token",
+			method:        tradeMethod,
+			md:            metadata.Pairs(AuthTokenKey, "token2"),
+			authenticated: true,
+		},
+		{
+			name:          "health method without token",
+			method:        healthServicePrefix + "Check",
+			authenticated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			handled := false
+			_, err := unaryAuth(tokens)(
+				ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(context.Context, interface{}) (interface{}, error) {
+					handled = true
+					return nil, nil
+				},
+			)
+			requireAuth(t, tt.authenticated, handled, err)
+
+			handled = false
+			err = streamAuth(tokens)(
+				nil, mockServerStream{ctx: ctx},
+				&grpc.StreamServerInfo{FullMethod: tt.method},
+				func(interface{}, grpc.ServerStream) error {
+					handled = true
+					return nil
+				},
+			)
+			requireAuth(t, tt.authenticated, handled, err)
+		})
+	}
+}
+
+func requireAuth(t *testing.T, authenticated, handled bool, err error) {
+	t.Helper()
+
+	require.Equal(t, authenticated, handled)
+	if authenticated {
+		require.NoError(t, err)
+		return
+	}
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
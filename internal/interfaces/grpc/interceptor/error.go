@@ -0,0 +1,171 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/application"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the google.rpc.ErrorInfo detail attached to
+// the errors returned by the daemon.
+const ErrorDomain = "tdex-daemon"
+
+// errorMapping associates an application or domain error with the gRPC
+// status code and the machine-readable reason it's returned with.
+type errorMapping struct {
+	err    error
+	code   codes.Code
+	reason string
+}
+
+// errorMappings is the enumeration of the errors that clients can tell apart
+// by the reason of their ErrorInfo detail, rather than by their message.
+// Errors are matched with errors.Is, therefore wrapped ones are mapped too.
+// Those not listed here are returned with code Unknown and no detail.
+// Every reason must be documented for clients in api-spec/errors.md.
+var errorMappings = []errorMapping{
+	// Referenced resources that don't exist.
+	{application.ErrMarketNotExist, codes.NotFound, "MARKET_NOT_FOUND"},
+	{application.ErrTradeNotFound, codes.NotFound, "TRADE_NOT_FOUND"},
+	{application.ErrUtxoNotFound, codes.NotFound, "UTXO_NOT_FOUND"},
+	{application.ErrWithdrawalNotFound, codes.NotFound, "WITHDRAWAL_NOT_FOUND"},
+	{application.ErrAddressNotOwned, codes.NotFound, "ADDRESS_NOT_FOUND"},
+	{application.ErrTradeNotAudited, codes.NotFound, "TRADE_NOT_AUDITED"},
+	{domain.ErrVaultAccountNotFound, codes.NotFound, "ACCOUNT_NOT_FOUND"},
+	{application.ErrUtxoAlreadyExists, codes.AlreadyExists, "UTXO_ALREADY_EXISTS"},
+	{domain.ErrVaultAlreadyInitialized, codes.AlreadyExists, "WALLET_ALREADY_INITIALIZED"},
+
+	// Malformed requests.
+	{application.ErrInvalidPage, codes.InvalidArgument, "INVALID_PAGE"},
+	{application.ErrInvalidTimeRange, codes.InvalidArgument, "INVALID_TIME_RANGE"},
+	{application.ErrInvalidInterval, codes.InvalidArgument, "INVALID_INTERVAL"},
+	{application.ErrTooManySnapshots, codes.InvalidArgument, "TOO_MANY_SNAPSHOTS"},
+	{application.ErrInvalidTxid, codes.InvalidArgument, "INVALID_TXID"},
+	{application.ErrInvalidTradeID, codes.InvalidArgument, "INVALID_TRADE_ID"},
+	{application.ErrInvalidOutpoint, codes.InvalidArgument, "INVALID_OUTPOINT"},
+	{application.ErrInvalidOutpoints, codes.InvalidArgument, "INVALID_OUTPOINT"},
+	{application.ErrAddressNotConfidential, codes.InvalidArgument, "ADDRESS_NOT_CONFIDENTIAL"},
+	{application.ErrMissingBlindingKey, codes.InvalidArgument, "MISSING_BLINDING_KEY"},
+	{application.ErrUtxoNotOwned, codes.InvalidArgument, "UTXO_NOT_OWNED"},
+	{application.ErrAssetNotInMarket, codes.InvalidArgument, "ASSET_NOT_IN_MARKET"},
+	{domain.ErrDepositAssetNotInMarket, codes.InvalidArgument, "ASSET_NOT_IN_MARKET"},
+	{domain.ErrEmptyDepositLabel, codes.InvalidArgument, "EMPTY_DEPOSIT_LABEL"},
+	{application.ErrUnknownExportFormat, codes.InvalidArgument, "UNKNOWN_EXPORT_FORMAT"},
+	{application.ErrUnknownStrategy, codes.InvalidArgument, "UNKNOWN_STRATEGY"},
+	{application.ErrInvalidStrategyParams, codes.InvalidArgument, "INVALID_STRATEGY_PARAMS"},
+	{application.ErrInvalidMarketsConfig, codes.InvalidArgument, "INVALID_MARKETS_CONFIG"},
+	{application.ErrNoWithdrawals, codes.InvalidArgument, "INVALID_WITHDRAWAL"},
+	{application.ErrEmptyWithdrawal, codes.InvalidArgument, "INVALID_WITHDRAWAL"},
+	{application.ErrDuplicatedWithdrawal, codes.InvalidArgument, "INVALID_WITHDRAWAL"},
+	{application.ErrAmbiguousWithdrawal, codes.InvalidArgument, "INVALID_WITHDRAWAL"},
+	{application.ErrInvalidDisplayAmount, codes.InvalidArgument, "INVALID_WITHDRAWAL"},
+	{application.ErrInvalidMaxInputs, codes.InvalidArgument, "INVALID_MAX_INPUTS"},
+	{application.ErrInvalidFaucetAmounts, codes.InvalidArgument, "INVALID_FAUCET_AMOUNTS"},
+	{application.ErrInvalidReserveProof, codes.InvalidArgument, "INVALID_RESERVE_PROOF"},
+	{domain.ErrMarketInvalidBaseAsset, codes.InvalidArgument, "INVALID_MARKET_ASSET"},
+	{domain.ErrMarketInvalidQuoteAsset, codes.InvalidArgument, "INVALID_MARKET_ASSET"},
+	{domain.ErrMarketInvalidBasePrice, codes.InvalidArgument, "INVALID_MARKET_PRICE"},
+	{domain.ErrMarketInvalidQuotePrice, codes.InvalidArgument, "INVALID_MARKET_PRICE"},
+	{domain.ErrMarketFeeTooLow, codes.InvalidArgument, "INVALID_MARKET_FEE"},
+	{domain.ErrMarketFeeTooHigh, codes.InvalidArgument, "INVALID_MARKET_FEE"},
+	{domain.ErrInvalidFixedFee, codes.InvalidArgument, "INVALID_MARKET_FEE"},
+	{domain.ErrMissingFixedFee, codes.InvalidArgument, "INVALID_MARKET_FEE"},
+	{domain.ErrInvalidFeeTiers, codes.InvalidArgument, "INVALID_MARKET_FEE"},
+	{domain.ErrInvalidTradeLimits, codes.InvalidArgument, "INVALID_TRADE_LIMITS"},
+	{domain.ErrInvalidPricePrecision, codes.InvalidArgument, "INVALID_PRICE_PRECISION"},
+	{domain.ErrTradeAmountTooLow, codes.InvalidArgument, "OUT_OF_TRADE_LIMITS"},
+	{domain.ErrTradeAmountTooHigh, codes.InvalidArgument, "OUT_OF_TRADE_LIMITS"},
+	{domain.ErrVaultInvalidPassphrase, codes.InvalidArgument, "INVALID_PASSWORD"},
+
+	// Requests not allowed by the current state of the daemon.
+	{domain.ErrMarketIsClosed, codes.FailedPrecondition, "MARKET_CLOSED"},
+	{domain.ErrMarketMustBeClosed, codes.FailedPrecondition, "MARKET_NOT_CLOSED"},
+	{domain.ErrMarketIsPaused, codes.FailedPrecondition, "MARKET_PAUSED"},
+	{domain.ErrMarketNotPaused, codes.FailedPrecondition, "MARKET_NOT_PAUSED"},
+	{domain.ErrTradeMustBeProposalOrAccepted, codes.FailedPrecondition, "TRADE_NOT_CANCELABLE"},
+	{domain.ErrMarketNotPriced, codes.FailedPrecondition, "MARKET_NOT_PRICED"},
+	{domain.ErrMarketNotFunded, codes.FailedPrecondition, "MARKET_NOT_FUNDED"},
+	{application.ErrMarketNotFunded, codes.FailedPrecondition, "MARKET_NOT_FUNDED"},
+	{application.ErrMissingNonFundedMarkets, codes.FailedPrecondition, "MARKET_NOT_FOUND"},
+	{application.ErrFeeAccountNotFunded, codes.FailedPrecondition, "FEE_ACCOUNT_NOT_FUNDED"},
+	{application.ErrMarketInsufficientBalance, codes.FailedPrecondition, "INSUFFICIENT_LIQUIDITY"},
+	{application.ErrMarketUtxosNotSelectable, codes.FailedPrecondition, "INSUFFICIENT_LIQUIDITY"},
+	{application.ErrWithdrawInsufficientBalance, codes.FailedPrecondition, "INSUFFICIENT_BALANCE"},
+	{application.ErrWithdrawBelowReserve, codes.FailedPrecondition, "INSUFFICIENT_BALANCE"},
+	{application.ErrTooManyInputs, codes.FailedPrecondition, "TOO_MANY_INPUTS"},
+	{application.ErrNothingToConsolidate, codes.FailedPrecondition, "INSUFFICIENT_BALANCE"},
+	{application.ErrAddressAlreadyUsed, codes.FailedPrecondition, "ADDRESS_ALREADY_USED"},
+	{application.ErrTxNotConfirmed, codes.FailedPrecondition, "TX_NOT_CONFIRMED"},
+	{application.ErrWithdrawalConfirmed, codes.FailedPrecondition, "TX_ALREADY_CONFIRMED"},
+	{application.ErrFeeRateBelowFloor, codes.FailedPrecondition, "FEE_RATE_TOO_LOW"},
+	{application.ErrFeeRateNotIncreased, codes.FailedPrecondition, "FEE_RATE_TOO_LOW"},
+	{application.ErrFeeTopUpNotSupported, codes.FailedPrecondition, "NOT_SUPPORTED"},
+	{application.ErrFaucetNotAllowed, codes.FailedPrecondition, "NOT_SUPPORTED"},
+	{application.ErrFaucetNotConfigured, codes.FailedPrecondition, "NOT_CONFIGURED"},
+	{application.ErrAssetRegistryNotConfigured, codes.FailedPrecondition, "NOT_CONFIGURED"},
+	{application.ErrPsetLogDisabled, codes.FailedPrecondition, "NOT_CONFIGURED"},
+	{application.ErrWalletNotInitialized, codes.FailedPrecondition, "WALLET_NOT_INITIALIZED"},
+	{application.ErrWalletNotFunded, codes.FailedPrecondition, "WALLET_NOT_FUNDED"},
+	{domain.ErrVaultMustBeUnlocked, codes.FailedPrecondition, "WALLET_LOCKED"},
+	{domain.ErrVaultMustBeLocked, codes.FailedPrecondition, "WALLET_UNLOCKED"},
+
+	// Temporary failures, the request can be retried later.
+	{application.ErrWalletIsSyncing, codes.Unavailable, "WALLET_SYNCING"},
+	{application.ErrServiceUnavailable, codes.Unavailable, "SERVICE_UNAVAILABLE"},
+	{application.ErrExplorerUnreachable, codes.Unavailable, "EXPLORER_UNREACHABLE"},
+	{application.ErrStoreClosed, codes.Unavailable, "SERVICE_UNAVAILABLE"},
+	{application.ErrFaucetTimeout, codes.DeadlineExceeded, "FAUCET_TIMEOUT"},
+}
+
+func unaryErrorMapper(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, toStatusError(err)
+}
+
+func streamErrorMapper(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return toStatusError(handler(srv, stream))
+}
+
+// toStatusError converts the given error, if known, into a gRPC status error
+// with the mapped code and an ErrorInfo detail carrying its reason. Errors
+// that are already gRPC status errors are returned as they are.
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	for _, m := range errorMappings {
+		if !errors.Is(err, m.err) {
+			continue
+		}
+		st, detailErr := status.New(m.code, err.Error()).WithDetails(
+			&errdetails.ErrorInfo{Reason: m.reason, Domain: ErrorDomain},
+		)
+		if detailErr != nil {
+			log.WithError(detailErr).Warn("unable to attach error details")
+			return status.Error(m.code, err.Error())
+		}
+		return st.Err()
+	}
+	return err
+}
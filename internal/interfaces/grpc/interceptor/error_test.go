@@ -0,0 +1,89 @@
+package interceptor
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/application"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusError(t *testing.T) {
+	statusErr := status.Error(codes.PermissionDenied, "denied")
+	unknownErr := errors.New("unknown")
+
+	tests := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{
+			name:   "mapped error",
+			err:    application.ErrMarketNotExist,
+			code:   codes.NotFound,
+			reason: "MARKET_NOT_FOUND",
+		},
+		{
+			name:   "wrapped error",
+			err:    fmt.Errorf("market %s: %w", "abc", domain.ErrMarketIsClosed),
+			code:   codes.FailedPrecondition,
+			reason: "MARKET_CLOSED",
+		},
+		{
+			name: "status error",
+			err:  statusErr,
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "unknown error",
+			err:  unknownErr,
+			code: codes.Unknown,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := toStatusError(tt.err)
+
+			st := status.Convert(err)
+			require.Equal(t, tt.code, st.Code())
+			require.Equal(t, status.Convert(tt.err).Message(), st.Message())
+
+			if tt.reason == "" {
+				require.Empty(t, st.Details())
+				return
+			}
+			require.Len(t, st.Details(), 1)
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			require.Equal(t, tt.reason, info.GetReason())
+			require.Equal(t, ErrorDomain, info.GetDomain())
+		})
+	}
+
+	// status errors pass through unchanged.
+	require.Equal(t, statusErr, toStatusError(statusErr))
+	require.NoError(t, toStatusError(nil))
+}
+
+func TestErrorReasonsAreDocumented(t *testing.T) {
+	doc, err := ioutil.ReadFile("../../../../api-spec/errors.md")
+	require.NoError(t, err)
+
+	for _, m := range errorMappings {
+		row := fmt.Sprintf("| `%s` | `%s` |", m.reason, m.code)
+		require.True(
+			t, strings.Contains(string(doc), row),
+			"reason %s with code %s is not documented", m.reason, m.code,
+		)
+	}
+}
@@ -10,6 +10,7 @@ func UnaryInterceptor() grpc.ServerOption {
 	return grpc.UnaryInterceptor(
 		middleware.ChainUnaryServer(
 			unaryLogger,
+			unaryErrorMapper,
 		),
 	)
 }
@@ -19,6 +20,38 @@ func StreamInterceptor() grpc.ServerOption {
 	return grpc.StreamInterceptor(
 		middleware.ChainStreamServer(
 			streamLogger,
+			streamErrorMapper,
+		),
+	)
+}
+
+// TraderUnaryInterceptor returns the unary interceptor of the trader
+// interface. If any auth token is given, the requests of the peers not
+// presenting one of them are rejected before reaching the handlers, while
+// those of the others carry the name of the peer the token is mapped to.
+func TraderUnaryInterceptor(authTokens map[string]string) grpc.ServerOption {
+	if len(authTokens) <= 0 {
+		return UnaryInterceptor()
+	}
+	return grpc.UnaryInterceptor(
+		middleware.ChainUnaryServer(
+			unaryLogger,
+			unaryAuth(authTokens),
+			unaryErrorMapper,
+		),
+	)
+}
+
+// TraderStreamInterceptor is the stream version of TraderUnaryInterceptor.
+func TraderStreamInterceptor(authTokens map[string]string) grpc.ServerOption {
+	if len(authTokens) <= 0 {
+		return StreamInterceptor()
+	}
+	return grpc.StreamInterceptor(
+		middleware.ChainStreamServer(
+			streamLogger,
+			streamAuth(authTokens),
+			streamErrorMapper,
 		),
 	)
 }
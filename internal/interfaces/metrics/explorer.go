@@ -0,0 +1,136 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+)
+
+// explorerService decorates an explorer.Service by counting the requests
+// that returned an error, labeled by method.
+type explorerService struct {
+	explorer.Service
+	failures *prometheus.CounterVec
+}
+
+func (e *explorerService) observe(method string, err error) {
+	if err != nil {
+		e.failures.WithLabelValues(method).Inc()
+	}
+}
+
+func (e *explorerService) GetUnspents(
+	addr string,
+	blindKeys [][]byte,
+) ([]explorer.Utxo, error) {
+	unspents, err := e.Service.GetUnspents(addr, blindKeys)
+	e.observe("GetUnspents", err)
+	return unspents, err
+}
+
+func (e *explorerService) GetUnspentsForAddresses(
+	addresses []string,
+	blindingKeys [][]byte,
+) ([]explorer.Utxo, error) {
+	unspents, err := e.Service.GetUnspentsForAddresses(addresses, blindingKeys)
+	e.observe("GetUnspentsForAddresses", err)
+	return unspents, err
+}
+
+func (e *explorerService) GetTransaction(
+	txid string,
+) (explorer.Transaction, error) {
+	tx, err := e.Service.GetTransaction(txid)
+	e.observe("GetTransaction", err)
+	return tx, err
+}
+
+func (e *explorerService) GetTransactionHex(txid string) (string, error) {
+	txhex, err := e.Service.GetTransactionHex(txid)
+	e.observe("GetTransactionHex", err)
+	return txhex, err
+}
+
+func (e *explorerService) IsTransactionConfirmed(txid string) (bool, error) {
+	confirmed, err := e.Service.IsTransactionConfirmed(txid)
+	e.observe("IsTransactionConfirmed", err)
+	return confirmed, err
+}
+
+func (e *explorerService) GetTransactionStatus(
+	txid string,
+) (map[string]interface{}, error) {
+	status, err := e.Service.GetTransactionStatus(txid)
+	e.observe("GetTransactionStatus", err)
+	return status, err
+}
+
+func (e *explorerService) GetTransactionsForAddress(
+	address string,
+	blindingKey []byte,
+) ([]explorer.Transaction, error) {
+	txs, err := e.Service.GetTransactionsForAddress(address, blindingKey)
+	e.observe("GetTransactionsForAddress", err)
+	return txs, err
+}
+
+func (e *explorerService) BroadcastTransaction(txhex string) (string, error) {
+	txid, err := e.Service.BroadcastTransaction(txhex)
+	e.observe("BroadcastTransaction", err)
+	return txid, err
+}
+
+func (e *explorerService) GetBlockHeight() (int, error) {
+	height, err := e.Service.GetBlockHeight()
+	e.observe("GetBlockHeight", err)
+	return height, err
+}
+
+func (e *explorerService) GetBlockHash(height uint64) (string, error) {
+	hash, err := e.Service.GetBlockHash(height)
+	e.observe("GetBlockHash", err)
+	return hash, err
+}
+
+func (e *explorerService) Faucet(
+	address string,
+	amount float64,
+	asset string,
+) (string, error) {
+	txid, err := e.Service.Faucet(address, amount, asset)
+	e.observe("Faucet", err)
+	return txid, err
+}
+
+func (e *explorerService) Mint(
+	address string,
+	amount float64,
+) (string, string, error) {
+	txid, asset, err := e.Service.Mint(address, amount)
+	e.observe("Mint", err)
+	return txid, asset, err
+}
+
+// StreamActivity forwards to the wrapped service, if it supports streaming,
+// so that wrapping doesn't hide the capability.
+func (e *explorerService) StreamActivity(
+	ctx context.Context,
+) (<-chan []string, error) {
+	streamer, ok := e.Service.(explorer.ActivityStreamer)
+	if !ok {
+		return nil, explorer.ErrStreamingNotSupported
+	}
+	chScripts, err := streamer.StreamActivity(ctx)
+	e.observe("StreamActivity", err)
+	return chScripts, err
+}
+
+// WithContext forwards to the wrapped service, if it supports it, so that
+// wrapping doesn't hide the capability.
+func (e *explorerService) WithContext(ctx context.Context) explorer.Service {
+	return &explorerService{
+		Service:  explorer.WithContext(ctx, e.Service),
+		failures: e.failures,
+	}
+}
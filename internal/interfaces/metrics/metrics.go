@@ -0,0 +1,268 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/application"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+)
+
+const namespace = "tdex"
+
+var statusLabels = map[int]string{
+	domain.Undefined: "undefined",
+	domain.Proposal:  "proposal",
+	domain.Accepted:  "accepted",
+	domain.Completed: "completed",
+	domain.Settled:   "settled",
+	domain.Expired:   "expired",
+	domain.Canceled:  "canceled",
+}
+
+// Exporter collects the stats about trades, market balances and explorer
+// requests of the daemon and exposes them in the Prometheus text format.
+type Exporter struct {
+	registry         *prometheus.Registry
+	trades           *prometheus.CounterVec
+	settlementTime   *prometheus.HistogramVec
+	explorerFailures *prometheus.CounterVec
+}
+
+// NewExporter returns a new Exporter, whose registry includes also the
+// standard Go runtime and process metrics.
+func NewExporter() *Exporter {
+	e := &Exporter{
+		registry: prometheus.NewRegistry(),
+		trades: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "trades_total",
+			Help:      "Number of trades that reached a certain status.",
+		}, []string{"market", "status", "failed"}),
+		settlementTime: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "trade_settlement_seconds",
+			Help:      "Time elapsed from the request to the settlement of trades.",
+			Buckets:   prometheus.ExponentialBuckets(15, 2, 10),
+		}, []string{"market"}),
+		explorerFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "explorer_request_failures_total",
+			Help:      "Number of requests to the explorer that returned an error.",
+		}, []string{"method"}),
+	}
+
+	e.registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		e.trades,
+		e.settlementTime,
+		e.explorerFailures,
+	)
+	return e
+}
+
+// Handler returns the http handler serving the collected metrics.
+func (e *Exporter) Handler() http.Handler {
+	return promhttp.HandlerFor(e.registry, promhttp.HandlerOpts{})
+}
+
+// Start subscribes to the status changes of all trades and registers the
+// gauges of the balances of the markets, computed at every scrape.
+// Trades are tracked until the given context is canceled.
+func (e *Exporter) Start(
+	ctx context.Context,
+	operatorSvc application.OperatorService,
+	tradeSvc application.TradeService,
+) error {
+	_, chInfo, err := operatorSvc.SubscribeTrades(ctx, application.TradeFilter{})
+	if err != nil {
+		return err
+	}
+
+	if err := e.registry.Register(
+		newBalanceCollector(operatorSvc, tradeSvc),
+	); err != nil {
+		return err
+	}
+	if err := e.registry.Register(
+		newRateLimiterCollector(tradeSvc),
+	); err != nil {
+		return err
+	}
+
+	go func() {
+		for info := range chInfo {
+			e.observeTrade(info)
+		}
+	}()
+	return nil
+}
+
+func (e *Exporter) observeTrade(info application.TradeInfo) {
+	market := info.MarketWithFee.QuoteAsset
+	e.trades.WithLabelValues(
+		market,
+		statusLabels[info.Status.Code],
+		strconv.FormatBool(info.Status.Failed),
+	).Inc()
+
+	if info.Status.Code == domain.Settled &&
+		info.SettleTimeUnix >= info.RequestTimeUnix {
+		e.settlementTime.WithLabelValues(market).Observe(
+			float64(info.SettleTimeUnix - info.RequestTimeUnix),
+		)
+	}
+}
+
+// WrapExplorer returns an explorer service that counts the failed requests
+// made to the given one.
+func (e *Exporter) WrapExplorer(svc explorer.Service) explorer.Service {
+	return &explorerService{svc, e.explorerFailures}
+}
+
+// balanceCollector exports the current balance of every market, and how far
+// it deviates from the target ratio of the market's strategy.
+type balanceCollector struct {
+	operatorSvc   application.OperatorService
+	tradeSvc      application.TradeService
+	desc          *prometheus.Desc
+	imbalanceDesc *prometheus.Desc
+	rebalanceDesc *prometheus.Desc
+}
+
+func newBalanceCollector(
+	operatorSvc application.OperatorService,
+	tradeSvc application.TradeService,
+) *balanceCollector {
+	return &balanceCollector{
+		operatorSvc: operatorSvc,
+		tradeSvc:    tradeSvc,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "market_balance"),
+			"Balance in satoshis of every asset of a market.",
+			[]string{"market", "asset"},
+			nil,
+		),
+		imbalanceDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "market_imbalance"),
+			"Deviation of the share of the value of a market held in base "+
+				"asset from the target one, positive if overweight.",
+			[]string{"market"},
+			nil,
+		),
+		rebalanceDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "market_rebalance_amount"),
+			"Amount in satoshis of every asset of a market to trade at spot "+
+				"price to restore the target ratio, negative if to be sold.",
+			[]string{"market", "asset"},
+			nil,
+		),
+	}
+}
+
+func (c *balanceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+	ch <- c.imbalanceDesc
+	ch <- c.rebalanceDesc
+}
+
+func (c *balanceCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+	markets, err := c.operatorSvc.ListMarket(ctx)
+	if err != nil {
+		log.WithError(err).Warn("unable to list markets for metrics")
+		return
+	}
+
+	for _, m := range markets {
+		// balances are not available while the wallet is locked
+		balance, err := c.tradeSvc.GetMarketBalance(ctx, m.Market)
+		if err != nil {
+			continue
+		}
+
+		market := m.Market.QuoteAsset
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(balance.Balance.BaseAmount),
+			market, m.Market.BaseAsset,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(balance.Balance.QuoteAmount),
+			market, m.Market.QuoteAsset,
+		)
+
+		// not priced or not funded markets have no imbalance
+		imbalance, err := c.tradeSvc.MarketImbalance(ctx, m.Market)
+		if err != nil {
+			continue
+		}
+		deviation, _ := imbalance.Deviation.Float64()
+		ch <- prometheus.MustNewConstMetric(
+			c.imbalanceDesc,
+			prometheus.GaugeValue,
+			deviation,
+			market,
+		)
+		if rebalance := imbalance.Rebalance; rebalance.SellAmount > 0 {
+			ch <- prometheus.MustNewConstMetric(
+				c.rebalanceDesc,
+				prometheus.GaugeValue,
+				-float64(rebalance.SellAmount),
+				market, rebalance.SellAsset,
+			)
+			ch <- prometheus.MustNewConstMetric(
+				c.rebalanceDesc,
+				prometheus.GaugeValue,
+				float64(rebalance.BuyAmount),
+				market, rebalance.BuyAsset,
+			)
+		}
+	}
+}
+
+// rateLimiterCollector exports the tokens left in the buckets limiting the
+// swap proposals of every market and peer.
+type rateLimiterCollector struct {
+	tradeSvc application.TradeService
+	desc     *prometheus.Desc
+}
+
+func newRateLimiterCollector(
+	tradeSvc application.TradeService,
+) *rateLimiterCollector {
+	return &rateLimiterCollector{
+		tradeSvc: tradeSvc,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "trade_rate_limit_tokens"),
+			"Number of swap proposals a market or a peer can still make before "+
+				"being rate limited.",
+			[]string{"scope", "key"},
+			nil,
+		),
+	}
+}
+
+func (c *rateLimiterCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *rateLimiterCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, s := range c.tradeSvc.RateLimiterState() {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			s.Tokens,
+			s.Scope, s.Key,
+		)
+	}
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/application"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+)
+
+const quoteAsset = "d73f5cd0954c1bf325f85d7a7ff43a6eb3ea3b516fd57064b85306d43bc1c9ff"
+
+type failingExplorer struct {
+	explorer.Service
+}
+
+func (f failingExplorer) GetBlockHeight() (int, error) {
+	return 0, errors.New("explorer unreachable")
+}
+
+func TestExporter(t *testing.T) {
+	exporter := NewExporter()
+
+	explorerSvc := exporter.WrapExplorer(failingExplorer{})
+	for i := 0; i < 2; i++ {
+		_, err := explorerSvc.GetBlockHeight()
+		require.Error(t, err)
+	}
+
+	info := application.TradeInfo{
+		MarketWithFee: application.MarketWithFee{
+			Market: application.Market{QuoteAsset: quoteAsset},
+		},
+		RequestTimeUnix: 1609459200,
+	}
+	info.Status = domain.AcceptedStatus
+	exporter.observeTrade(info)
+	info.Status = domain.SettledStatus
+	info.SettleTimeUnix = info.RequestTimeUnix + 90
+	exporter.observeTrade(info)
+
+	server := httptest.NewServer(exporter.Handler())
+	defer server.Close()
+
+	resp, err := server.Client().Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	metrics := string(body)
+	require.Contains(
+		t, metrics,
+		`tdex_explorer_request_failures_total{method="GetBlockHeight"} 2`,
+	)
+	require.Contains(
+		t, metrics,
+		`tdex_trades_total{failed="false",market="`+quoteAsset+`",status="accepted"} 1`,
+	)
+	require.Contains(
+		t, metrics,
+		`tdex_trades_total{failed="false",market="`+quoteAsset+`",status="settled"} 1`,
+	)
+	require.Contains(
+		t, metrics,
+		`tdex_trade_settlement_seconds_sum{market="`+quoteAsset+`"} 90`,
+	)
+}
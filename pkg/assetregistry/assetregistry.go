@@ -0,0 +1,196 @@
+package assetregistry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultEndpoint is the url of the Blockstream's Liquid asset registry
+	DefaultEndpoint = "https://assets.blockstream.info"
+	// DefaultPrecision is the precision of assets not found in the registry,
+	// matching the one of L-BTC
+	DefaultPrecision = 8
+
+	defaultCacheTTL       = time.Hour
+	defaultRequestTimeout = 10 * time.Second
+)
+
+var (
+	// ErrInvalidEndpoint ...
+	ErrInvalidEndpoint = errors.New(
+		"asset registry endpoint must be a valid http(s) url",
+	)
+	// ErrInvalidPrecision ...
+	ErrInvalidPrecision = errors.New(
+		"asset precision must be in range [0, 8]",
+	)
+)
+
+// AssetMetadata is the metadata of an asset as published in the registry.
+// Assets not found in the registry are returned with Registered set to false,
+// empty ticker and name, and default precision
+type AssetMetadata struct {
+	AssetHash  string
+	Ticker     string
+	Name       string
+	Precision  int
+	Registered bool
+}
+
+// Service is the interface for a source of asset metadata
+type Service interface {
+	// AssetInfo returns the metadata of the given asset.
+	AssetInfo(assetHash string) (AssetMetadata, error)
+}
+
+// Opts defines the parameters needed for creating an asset registry service
+// with NewService method
+type Opts struct {
+	// Endpoint is the url of the registry. Metadata are fetched with GET
+	// requests to <endpoint>/<asset_hash>, expecting a JSON response like
+	// {"ticker": "USDt", "name": "Tether USD", "precision": 8}, or a 404 status
+	// for unregistered assets. Defaults to DefaultEndpoint
+	Endpoint string
+	// CacheTTL is the time metadata are cached for, unregistered results
+	// included. Defaults to 1 hour
+	CacheTTL time.Duration
+	// RequestTimeout is the time to wait for a response. Defaults to 10 seconds
+	RequestTimeout time.Duration
+}
+
+func (o Opts) validate() error {
+	if o.Endpoint == "" {
+		return nil
+	}
+	u, err := url.Parse(o.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidEndpoint
+	}
+	return nil
+}
+
+type assetResponse struct {
+	Ticker    string `json:"ticker"`
+	Name      string `json:"name"`
+	Precision int    `json:"precision"`
+}
+
+type cachedMetadata struct {
+	metadata  AssetMetadata
+	expiresAt time.Time
+}
+
+type assetRegistry struct {
+	endpoint string
+	cacheTTL time.Duration
+	client   *http.Client
+
+	lock  *sync.RWMutex
+	cache map[string]cachedMetadata
+}
+
+// NewService returns an asset registry Service that fetches metadata from the
+// given registry and caches them for the configured time
+func NewService(opts Opts) (Service, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint := opts.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	cacheTTL := opts.CacheTTL
+	if cacheTTL <= 0 {
+		cacheTTL = defaultCacheTTL
+	}
+	requestTimeout := opts.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+
+	return &assetRegistry{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		cacheTTL: cacheTTL,
+		client:   &http.Client{Timeout: requestTimeout},
+		lock:     &sync.RWMutex{},
+		cache:    make(map[string]cachedMetadata),
+	}, nil
+}
+
+func (r *assetRegistry) AssetInfo(assetHash string) (AssetMetadata, error) {
+	if metadata, ok := r.getCached(assetHash); ok {
+		return metadata, nil
+	}
+
+	metadata, err := r.fetch(assetHash)
+	if err != nil {
+		return AssetMetadata{}, err
+	}
+
+	r.lock.Lock()
+	r.cache[assetHash] = cachedMetadata{metadata, time.Now().Add(r.cacheTTL)}
+	r.lock.Unlock()
+
+	return metadata, nil
+}
+
+func (r *assetRegistry) getCached(assetHash string) (AssetMetadata, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	cached, ok := r.cache[assetHash]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return AssetMetadata{}, false
+	}
+	return cached.metadata, true
+}
+
+func (r *assetRegistry) fetch(assetHash string) (AssetMetadata, error) {
+	resp, err := r.client.Get(
+		fmt.Sprintf("%s/%s", r.endpoint, url.PathEscape(assetHash)),
+	)
+	if err != nil {
+		return AssetMetadata{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return AssetMetadata{}, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return AssetMetadata{
+			AssetHash: assetHash,
+			Precision: DefaultPrecision,
+		}, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return AssetMetadata{}, fmt.Errorf(
+			"asset registry replied with status %d: %s", resp.StatusCode, body,
+		)
+	}
+
+	var res assetResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return AssetMetadata{}, err
+	}
+	if res.Precision < 0 || res.Precision > 8 {
+		return AssetMetadata{}, ErrInvalidPrecision
+	}
+	return AssetMetadata{
+		AssetHash:  assetHash,
+		Ticker:     res.Ticker,
+		Name:       res.Name,
+		Precision:  res.Precision,
+		Registered: true,
+	}, nil
+}
@@ -0,0 +1,114 @@
+package assetregistry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const usdt = "ce091c998b83c78bb71a632313ba3760f1763d9cfcffae02258ffa9865a37bd2"
+
+func TestAssetInfo(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, r *http.Request) {
+			calls++
+			if r.URL.Path != "/"+usdt {
+				rw.WriteHeader(http.StatusNotFound)
+				return
+			}
+			rw.Write([]byte(`{"asset_id":"` + usdt +
+				`","ticker":"USDt","name":"Tether USD","precision":8}`))
+		},
+	))
+	defer server.Close()
+
+	svc, err := NewService(Opts{Endpoint: server.URL + "/"})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		metadata, err := svc.AssetInfo(usdt)
+		require.NoError(t, err)
+		require.Equal(t, AssetMetadata{
+			AssetHash:  usdt,
+			Ticker:     "USDt",
+			Name:       "Tether USD",
+			Precision:  8,
+			Registered: true,
+		}, metadata)
+	}
+	require.Equal(t, 1, calls)
+
+	unknown := "00000000000000000000000000000000000000000000000000000000000000ff"
+	metadata, err := svc.AssetInfo(unknown)
+	require.NoError(t, err)
+	require.False(t, metadata.Registered)
+	require.Equal(t, unknown, metadata.AssetHash)
+	require.Equal(t, DefaultPrecision, metadata.Precision)
+}
+
+func TestAssetInfoCacheTTL(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, r *http.Request) {
+			calls++
+			rw.WriteHeader(http.StatusNotFound)
+		},
+	))
+	defer server.Close()
+
+	svc, err := NewService(Opts{
+		Endpoint: server.URL,
+		CacheTTL: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	_, err = svc.AssetInfo(usdt)
+	require.NoError(t, err)
+	time.Sleep(2 * time.Millisecond)
+	_, err = svc.AssetInfo(usdt)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+}
+
+func TestFailingAssetInfo(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		err    error
+	}{
+		{http.StatusOK, `{"ticker":"USDt","precision":9}`, ErrInvalidPrecision},
+		{http.StatusOK, `not json`, nil},
+		{http.StatusInternalServerError, `internal error`, nil},
+	}
+
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(
+			func(rw http.ResponseWriter, r *http.Request) {
+				rw.WriteHeader(tt.status)
+				rw.Write([]byte(tt.body))
+			},
+		))
+
+		svc, err := NewService(Opts{Endpoint: server.URL})
+		require.NoError(t, err)
+
+		_, err = svc.AssetInfo(usdt)
+		require.Error(t, err)
+		if tt.err != nil {
+			require.EqualError(t, err, tt.err.Error())
+		}
+		server.Close()
+	}
+}
+
+func TestFailingNewService(t *testing.T) {
+	endpoints := []string{"ftp://assets.io", "http://"}
+	for _, endpoint := range endpoints {
+		_, err := NewService(Opts{Endpoint: endpoint})
+		require.EqualError(t, err, ErrInvalidEndpoint.Error())
+	}
+}
@@ -47,11 +47,12 @@ func (a AddressEvent) Type() EventType {
 }
 
 type TransactionEvent struct {
-	TxID      string
-	TxHex     string
-	EventType EventType
-	BlockHash string
-	BlockTime float64
+	TxID        string
+	TxHex       string
+	EventType   EventType
+	BlockHash   string
+	BlockTime   float64
+	BlockHeight uint64
 }
 
 func (t TransactionEvent) Type() EventType {
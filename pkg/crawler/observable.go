@@ -133,6 +133,7 @@ func (t *TransactionObservable) observe(
 	var confirmed bool
 	var blockHash string
 	var blockTime float64
+	var blockHeight uint64
 
 	for k, v := range txStatus {
 		switch value := v.(type) {
@@ -148,6 +149,9 @@ func (t *TransactionObservable) observe(
 			if k == "block_time" {
 				blockTime = value
 			}
+			if k == "block_height" {
+				blockHeight = uint64(value)
+			}
 		}
 
 	}
@@ -158,11 +162,12 @@ func (t *TransactionObservable) observe(
 	}
 
 	event := TransactionEvent{
-		TxID:      t.TxID,
-		TxHex:     t.TxHex,
-		EventType: trxStatus,
-		BlockHash: blockHash,
-		BlockTime: blockTime,
+		TxID:        t.TxID,
+		TxHex:       t.TxHex,
+		EventType:   trxStatus,
+		BlockHash:   blockHash,
+		BlockTime:   blockTime,
+		BlockHeight: blockHeight,
 	}
 
 	eventChan <- event
@@ -180,8 +185,10 @@ type observableHandler struct {
 	eventChan        chan Event
 	errChan          chan error
 	stopChan         chan int
+	triggerChan      chan struct{}
 	observableStatus *observableStatus
 	rateLimiter      *rate.Limiter
+	isStreaming      func() bool
 }
 
 func newObservableHandler(
@@ -192,9 +199,11 @@ func newObservableHandler(
 	eventChan chan Event,
 	errChan chan error,
 	rateLimiter *rate.Limiter,
+	isStreaming func() bool,
 ) *observableHandler {
 	ticker := time.NewTicker(time.Duration(interval) * time.Millisecond)
 	stopChan := make(chan int, 1)
+	triggerChan := make(chan struct{}, 1)
 
 	return &observableHandler{
 		observable,
@@ -204,8 +213,10 @@ func newObservableHandler(
 		eventChan,
 		errChan,
 		stopChan,
+		triggerChan,
 		NewObservableStatus(),
 		rateLimiter,
+		isStreaming,
 	}
 }
 
@@ -215,15 +226,14 @@ func (oh *observableHandler) start() {
 	for {
 		select {
 		case <-oh.ticker.C:
-			if oh.observableStatus.Get() != Waiting {
-				oh.observable.observe(
-					oh.explorerSvc,
-					oh.errChan,
-					oh.eventChan,
-					oh.observableStatus,
-					oh.rateLimiter,
-				)
+			// addresses are observed only when triggered while the activity
+			// stream is up
+			if _, ok := oh.observable.(*AddressObservable); ok && oh.isStreaming() {
+				continue
 			}
+			oh.observe()
+		case <-oh.triggerChan:
+			oh.observe()
 		case <-oh.stopChan:
 			oh.ticker.Stop()
 			close(oh.stopChan)
@@ -232,6 +242,27 @@ func (oh *observableHandler) start() {
 	}
 }
 
+func (oh *observableHandler) observe() {
+	if oh.observableStatus.Get() != Waiting {
+		oh.observable.observe(
+			oh.explorerSvc,
+			oh.errChan,
+			oh.eventChan,
+			oh.observableStatus,
+			oh.rateLimiter,
+		)
+	}
+}
+
+// trigger makes the observable be observed right away, without waiting for
+// the next tick. Triggers happening while one is already pending are merged.
+func (oh *observableHandler) trigger() {
+	select {
+	case oh.triggerChan <- struct{}{}:
+	default:
+	}
+}
+
 func (oh *observableHandler) stop() {
 	oh.logAction("stop")
 	oh.stopChan <- 1
@@ -1,16 +1,25 @@
 package crawler
 
 import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+	"github.com/vulpemventures/go-elements/address"
 	"golang.org/x/time/rate"
 )
 
 const (
 	eventQueueMaxSize = 100
 	errorQueueMaxSize = 10
+	// streamRetryInterval is the time to wait before reconnecting to the
+	// explorer's activity stream after the connection dropped
+	streamRetryInterval = 10 * time.Second
 )
 
 type blockchainCrawler struct {
@@ -23,6 +32,12 @@ type blockchainCrawler struct {
 	mutex        *sync.RWMutex
 	wg           *sync.WaitGroup
 	rateLimiter  *rate.Limiter
+	// addressesByScript maps the output script of every observed address to
+	// the address itself, to find those involved in the streamed activity
+	addressesByScript map[string]string
+	streaming         int32
+	streamCtx         context.Context
+	cancelStream      context.CancelFunc
 }
 
 // Opts defines the parameters needed for creating a crawler service with NewService method
@@ -43,23 +58,33 @@ func NewService(opts Opts) Service {
 	everyMillisecond := oneSecInMillisecond / opts.ExplorerLimit
 	rt := rate.Every(time.Duration(everyMillisecond) * time.Millisecond)
 	rateLimiter := rate.NewLimiter(rt, opts.ExplorerTokenBurst)
+	streamCtx, cancelStream := context.WithCancel(context.Background())
 
 	return &blockchainCrawler{
-		interval:     opts.CrawlerInterval,
-		explorerSvc:  opts.ExplorerSvc,
-		errChan:      make(chan error, errorQueueMaxSize),
-		eventChan:    make(chan Event, eventQueueMaxSize),
-		observables:  map[string]*observableHandler{},
-		errorHandler: opts.ErrorHandler,
-		mutex:        &sync.RWMutex{},
-		wg:           &sync.WaitGroup{},
-		rateLimiter:  rateLimiter,
+		interval:          opts.CrawlerInterval,
+		explorerSvc:       opts.ExplorerSvc,
+		errChan:           make(chan error, errorQueueMaxSize),
+		eventChan:         make(chan Event, eventQueueMaxSize),
+		observables:       map[string]*observableHandler{},
+		errorHandler:      opts.ErrorHandler,
+		mutex:             &sync.RWMutex{},
+		wg:                &sync.WaitGroup{},
+		rateLimiter:       rateLimiter,
+		addressesByScript: map[string]string{},
+		streamCtx:         streamCtx,
+		cancelStream:      cancelStream,
 	}
 }
 
 // Start starts crawler which periodically "scans" blockchain for specific
-// events/Observable object
+// events/Observable object. If the explorer can stream the blockchain
+// activity, addresses are instead observed only when involved in new
+// transactions, falling back to polling whenever the stream is down.
 func (bc *blockchainCrawler) Start() {
+	if streamer, ok := bc.explorerSvc.(explorer.ActivityStreamer); ok {
+		go bc.streamActivity(streamer)
+	}
+
 	for {
 		err, more := <-bc.errChan
 		if !more {
@@ -71,6 +96,8 @@ func (bc *blockchainCrawler) Start() {
 
 // Stop stops crawler
 func (bc *blockchainCrawler) Stop() {
+	bc.cancelStream()
+
 	bc.mutex.Lock()
 	defer bc.mutex.Unlock()
 	for _, obsHandler := range bc.observables {
@@ -105,9 +132,20 @@ func (bc *blockchainCrawler) AddObservable(observable Observable) {
 			bc.eventChan,
 			bc.errChan,
 			bc.rateLimiter,
+			bc.isStreaming,
 		)
 
 		bc.observables[observable.key()] = obsHandler
+		if a, ok := observable.(*AddressObservable); ok {
+			if script, err := address.ToOutputScript(a.Address); err == nil {
+				bc.addressesByScript[hex.EncodeToString(script)] = a.Address
+			}
+			// while streaming, new addresses are not polled, therefore they're
+			// observed once right away
+			if bc.isStreaming() {
+				obsHandler.trigger()
+			}
+		}
 		go obsHandler.start()
 	}
 }
@@ -121,6 +159,11 @@ func (bc *blockchainCrawler) RemoveObservable(observable Observable) {
 
 		obsHandler.stop()
 		delete(bc.observables, observable.key())
+		if a, ok := observable.(*AddressObservable); ok {
+			if script, err := address.ToOutputScript(a.Address); err == nil {
+				delete(bc.addressesByScript, hex.EncodeToString(script))
+			}
+		}
 	}
 }
 
@@ -141,3 +184,60 @@ func (bc *blockchainCrawler) IsObservingAddresses(addresses []string) bool {
 	}
 	return true
 }
+
+func (bc *blockchainCrawler) isStreaming() bool {
+	return atomic.LoadInt32(&bc.streaming) == 1
+}
+
+// streamActivity keeps the crawler subscribed to the explorer's activity
+// stream, reconnecting whenever the connection drops, until the crawler is
+// stopped. Every time the stream is (re)established all addresses are
+// observed once, to catch up with what happened while it was down.
+func (bc *blockchainCrawler) streamActivity(streamer explorer.ActivityStreamer) {
+	for {
+		chScripts, err := streamer.StreamActivity(bc.streamCtx)
+		if err != nil {
+			if errors.Is(err, explorer.ErrStreamingNotSupported) {
+				return
+			}
+			if bc.streamCtx.Err() == nil {
+				go bc.errorHandler(
+					fmt.Errorf("activity stream unavailable, polling addresses: %w", err),
+				)
+			}
+		} else {
+			atomic.StoreInt32(&bc.streaming, 1)
+			bc.triggerAddresses(nil)
+			for scripts := range chScripts {
+				bc.triggerAddresses(scripts)
+			}
+			atomic.StoreInt32(&bc.streaming, 0)
+		}
+
+		select {
+		case <-bc.streamCtx.Done():
+			return
+		case <-time.After(streamRetryInterval):
+		}
+	}
+}
+
+// triggerAddresses makes the observed addresses with any of the given
+// scripts be observed right away. All addresses are triggered if no scripts
+// are given.
+func (bc *blockchainCrawler) triggerAddresses(scripts []string) {
+	bc.mutex.RLock()
+	defer bc.mutex.RUnlock()
+
+	if scripts == nil {
+		for _, addr := range bc.addressesByScript {
+			bc.observables[addr].trigger()
+		}
+		return
+	}
+	for _, script := range scripts {
+		if addr, ok := bc.addressesByScript[script]; ok {
+			bc.observables[addr].trigger()
+		}
+	}
+}
@@ -1,12 +1,18 @@
 package crawler
 
 import (
+	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
 	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/payment"
 	"github.com/vulpemventures/go-elements/transaction"
 )
 
@@ -34,6 +40,61 @@ func TestCrawler(t *testing.T) {
 	crawlSvc.Stop()
 }
 
+func TestCrawlerWithActivityStream(t *testing.T) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	p2wpkh := payment.FromPublicKey(key.PubKey(), &network.Regtest, nil)
+	addr, err := p2wpkh.WitnessPubKeyHash()
+	require.NoError(t, err)
+	script := hex.EncodeToString(p2wpkh.WitnessScript)
+
+	explorerSvc := &mockStreamingExplorer{chScripts: make(chan []string)}
+	crawlSvc := NewService(Opts{
+		ExplorerSvc:  explorerSvc,
+		ErrorHandler: func(err error) {},
+		// long enough to make sure addresses are observed only when triggered
+		CrawlerInterval:    60000,
+		ExplorerLimit:      10,
+		ExplorerTokenBurst: 1,
+	})
+	go crawlSvc.Start()
+	defer crawlSvc.Stop()
+
+	require.Eventually(t, crawlSvc.(*blockchainCrawler).isStreaming, time.Second, 10*time.Millisecond)
+
+	eventChan := crawlSvc.GetEventChannel()
+	waitEvent := func() (AddressEvent, bool) {
+		select {
+		case e := <-eventChan:
+			return e.(AddressEvent), true
+		case <-time.After(500 * time.Millisecond):
+			return AddressEvent{}, false
+		}
+	}
+
+	// new addresses are observed right away
+	crawlSvc.AddObservable(&AddressObservable{AccountIndex: 1, Address: addr})
+	event, ok := waitEvent()
+	require.True(t, ok)
+	require.Equal(t, addr, event.Address)
+
+	// unrelated activity doesn't trigger any observation
+	explorerSvc.chScripts <- []string{"0014" + hex.EncodeToString(make([]byte, 20))}
+	_, ok = waitEvent()
+	require.False(t, ok)
+
+	explorerSvc.chScripts <- []string{script}
+	event, ok = waitEvent()
+	require.True(t, ok)
+	require.Equal(t, addr, event.Address)
+
+	// polling is restored once the stream drops
+	close(explorerSvc.chScripts)
+	require.Eventually(t, func() bool {
+		return !crawlSvc.(*blockchainCrawler).isStreaming()
+	}, time.Second, 10*time.Millisecond)
+}
+
 func listen(t *testing.T, crawlSvc Service) {
 	eventChan := crawlSvc.GetEventChannel()
 	for {
@@ -88,6 +149,10 @@ func (m mockExplorer) GetBlockHeight() (int, error) {
 	panic("implement me")
 }
 
+func (m mockExplorer) GetBlockHash(height uint64) (string, error) {
+	panic("implement me")
+}
+
 func (m mockExplorer) GetUnspentsForAddresses(addresses []string, blindingKeys [][]byte) ([]explorer.Utxo, error) {
 	panic("implement me")
 }
@@ -147,6 +212,17 @@ func (m mockExplorer) Mint(addr string, amount float64) (string, string, error)
 	return "", "", errors.New("implement me")
 }
 
+type mockStreamingExplorer struct {
+	mockExplorer
+	chScripts chan []string
+}
+
+func (m *mockStreamingExplorer) StreamActivity(
+	_ context.Context,
+) (<-chan []string, error) {
+	return m.chScripts, nil
+}
+
 type mockUtxo struct {
 	value uint64
 }
@@ -214,3 +290,11 @@ func (m mockUtxo) Parse() (*transaction.TxInput, *transaction.TxOutput, error) {
 func (m mockUtxo) IsConfirmed() bool {
 	panic("implement me")
 }
+
+func (m mockUtxo) BlockHeight() uint64 {
+	panic("implement me")
+}
+
+func (m mockUtxo) BlockHash() string {
+	panic("implement me")
+}
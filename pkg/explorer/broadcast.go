@@ -0,0 +1,128 @@
+package explorer
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vulpemventures/go-elements/transaction"
+)
+
+// DefaultBroadcastWindow is the time a broadcasted txid is remembered for by
+// the Service returned by NewIdempotentBroadcaster.
+const DefaultBroadcastWindow = 10 * time.Minute
+
+// alreadyKnownTxErrors are the messages of the errors returned by Elements
+// nodes, either directly or through Esplora, when broadcasting a transaction
+// that's already in mempool or in a block.
+var alreadyKnownTxErrors = []string{
+	"txn-already-in-mempool",
+	"txn-already-known",
+	"transaction already in block chain",
+}
+
+type idempotentBroadcaster struct {
+	Service
+	window time.Duration
+
+	lock        *sync.Mutex
+	broadcasted map[string]time.Time
+}
+
+// NewIdempotentBroadcaster returns a Service that makes broadcasting a
+// transaction safe to retry. The txids broadcasted in the last window are
+// remembered so that a retry doesn't even reach the wrapped service, while
+// those errors denoting a transaction already in mempool or in a block, like
+// for a broadcast that timed out but actually succeeded, are treated as a
+// success. Any other request is forwarded as is.
+func NewIdempotentBroadcaster(svc Service, window time.Duration) Service {
+	if window <= 0 {
+		window = DefaultBroadcastWindow
+	}
+	return &idempotentBroadcaster{
+		Service:     svc,
+		window:      window,
+		lock:        &sync.Mutex{},
+		broadcasted: make(map[string]time.Time),
+	}
+}
+
+func (b *idempotentBroadcaster) BroadcastTransaction(
+	txhex string,
+) (string, error) {
+	tx, err := transaction.NewTxFromHex(txhex)
+	if err != nil {
+		return b.Service.BroadcastTransaction(txhex)
+	}
+	txid := tx.TxHash().String()
+
+	if b.isBroadcasted(txid) {
+		return txid, nil
+	}
+
+	if _, err := b.Service.BroadcastTransaction(txhex); err != nil {
+		if !isAlreadyKnownTxError(err) {
+			return "", err
+		}
+	}
+
+	b.addBroadcasted(txid)
+	return txid, nil
+}
+
+// StreamActivity forwards to the wrapped service, if it supports streaming,
+// so that wrapping doesn't hide the capability.
+func (b *idempotentBroadcaster) StreamActivity(
+	ctx context.Context,
+) (<-chan []string, error) {
+	streamer, ok := b.Service.(ActivityStreamer)
+	if !ok {
+		return nil, ErrStreamingNotSupported
+	}
+	return streamer.StreamActivity(ctx)
+}
+
+// WithContext binds the wrapped service to ctx, if it supports it, while
+// sharing the txids broadcasted so far with the returned copy.
+func (b *idempotentBroadcaster) WithContext(ctx context.Context) Service {
+	return &idempotentBroadcaster{
+		Service:     WithContext(ctx, b.Service),
+		window:      b.window,
+		lock:        b.lock,
+		broadcasted: b.broadcasted,
+	}
+}
+
+func (b *idempotentBroadcaster) isBroadcasted(txid string) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	broadcastTime, ok := b.broadcasted[txid]
+	return ok && time.Since(broadcastTime) < b.window
+}
+
+// addBroadcasted remembers the given txid and forgets those broadcasted
+// before the current window.
+func (b *idempotentBroadcaster) addBroadcasted(txid string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := time.Now()
+	for id, broadcastTime := range b.broadcasted {
+		if now.Sub(broadcastTime) >= b.window {
+			delete(b.broadcasted, id)
+		}
+	}
+	b.broadcasted[txid] = now
+}
+
+func isAlreadyKnownTxError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, e := range alreadyKnownTxErrors {
+		if strings.Contains(msg, e) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package explorer
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/go-elements/transaction"
+)
+
+func TestIdempotentBroadcast(t *testing.T) {
+	txhex, txid := newTestTx(t)
+
+	tests := []struct {
+		name       string
+		err        error
+		wantErr    bool
+		wantCalls  int
+		wantCached bool
+	}{
+		{
+			name:       "broadcast ok",
+			wantCalls:  1,
+			wantCached: true,
+		},
+		{
+			name: "already in mempool",
+			err: &ResponseError{
+				StatusCode: http.StatusBadRequest,
+				Message:    `sendrawtransaction RPC error: {"code":-26,"message":"txn-already-in-mempool"}`,
+			},
+			wantCalls:  1,
+			wantCached: true,
+		},
+		{
+			name: "already in block",
+			err: &ResponseError{
+				Message: "Transaction already in block chain",
+			},
+			wantCalls:  1,
+			wantCached: true,
+		},
+		{
+			name:      "rejected",
+			err:       &ResponseError{Message: "bad-txns-inputs-missingorspent"},
+			wantErr:   true,
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := &fakeBroadcaster{err: tt.err}
+			svc := NewIdempotentBroadcaster(backend, time.Minute)
+
+			for i := 0; i < 2; i++ {
+				gotTxid, err := svc.BroadcastTransaction(txhex)
+				if tt.wantErr {
+					require.Error(t, err)
+					continue
+				}
+				require.NoError(t, err)
+				require.Equal(t, txid, gotTxid)
+			}
+			require.Equal(t, tt.wantCalls, backend.calls)
+		})
+	}
+}
+
+func TestIdempotentBroadcastWindow(t *testing.T) {
+	txhex, _ := newTestTx(t)
+	backend := &fakeBroadcaster{}
+	svc := NewIdempotentBroadcaster(backend, time.Millisecond)
+
+	_, err := svc.BroadcastTransaction(txhex)
+	require.NoError(t, err)
+	time.Sleep(2 * time.Millisecond)
+	_, err = svc.BroadcastTransaction(txhex)
+	require.NoError(t, err)
+	require.Equal(t, 2, backend.calls)
+}
+
+func TestIdempotentBroadcastInvalidTx(t *testing.T) {
+	backend := &fakeBroadcaster{err: errors.New("decode failed")}
+	svc := NewIdempotentBroadcaster(backend, 0)
+
+	_, err := svc.BroadcastTransaction("invalidtx")
+	require.Error(t, err)
+	require.Equal(t, 1, backend.calls)
+}
+
+// fakeBroadcaster is a Service whose BroadcastTransaction either returns the
+// configured error or the txid of the given tx, counting the number of times
+// it gets called.
+type fakeBroadcaster struct {
+	Service
+	err   error
+	calls int
+}
+
+func (f *fakeBroadcaster) BroadcastTransaction(txhex string) (string, error) {
+	f.calls++
+	if f.err != nil {
+		return "", f.err
+	}
+	tx, _ := transaction.NewTxFromHex(txhex)
+	return tx.TxHash().String(), nil
+}
+
+func newTestTx(t *testing.T) (string, string) {
+	tx := transaction.NewTx(2)
+	tx.AddInput(transaction.NewTxInput(make([]byte, 32), 0))
+	tx.AddOutput(transaction.NewTxOutput(
+		append([]byte{0x01}, make([]byte, 32)...),
+		[]byte{0x01, 0, 0, 0, 0, 0, 0, 0x03, 0xe8},
+		[]byte{0x00, 0x14, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09,
+			0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14},
+	))
+	txhex, err := tx.ToHex()
+	require.NoError(t, err)
+	return txhex, tx.TxHash().String()
+}
@@ -1,7 +1,6 @@
 package explorer
 
 import (
-	"errors"
 	"fmt"
 	"sort"
 )
@@ -36,9 +35,7 @@ func SelectUnspents(
 	if len(indexes) <= 0 {
 		coins = nil
 		change = 0
-		err = errors.New(
-			"error on target amount: total utxo amount does not cover target amount",
-		)
+		err = ErrInsufficientFunds
 		return
 	}
 
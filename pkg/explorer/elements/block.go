@@ -17,3 +17,16 @@ func (e *elements) GetBlockHeight() (int, error) {
 	}
 	return height, nil
 }
+
+func (e *elements) GetBlockHash(height uint64) (string, error) {
+	r, err := e.client.call("getblockhash", []interface{}{height})
+	if err = handleError(err, &r); err != nil {
+		return "", err
+	}
+
+	var hash string
+	if err := json.Unmarshal(r.Result, &hash); err != nil {
+		return "", fmt.Errorf("unmarshal: %w", err)
+	}
+	return hash, nil
+}
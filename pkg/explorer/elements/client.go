@@ -9,6 +9,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"time"
+
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
 )
 
 // A RPCClient represents a JSON RPC client (over HTTP(s)).
@@ -57,22 +59,27 @@ type rpcResponse struct {
 }
 
 // NewClient retursn an RpcClient
-func NewClient(host string, port int, user, passwd string, useSSL bool, timeout int) (c *RPCClient, err error) {
+func NewClient(host string, port int, user, passwd string, useSSL bool, timeout int, opts ...explorer.HTTPOption) (c *RPCClient, err error) {
 	if len(host) == 0 {
 		err = errors.New("Bad call missing argument host")
 		return
 	}
+	httpConfig, err := explorer.NewHTTPConfig(opts...)
+	if err != nil {
+		return
+	}
 	var serverAddr string
 	var httpClient *http.Client
 	if useSSL {
 		serverAddr = "https://"
 		t := &http.Transport{
 			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			Proxy:           httpConfig.Proxy(),
 		}
 		httpClient = &http.Client{Transport: t}
 	} else {
 		serverAddr = "http://"
-		httpClient = &http.Client{}
+		httpClient = &http.Client{Transport: httpConfig.Transport()}
 	}
 	c = &RPCClient{serverAddr: fmt.Sprintf("%s%s:%d", serverAddr, host, port), user: user, passwd: passwd, httpClient: httpClient, timeout: timeout}
 	return
@@ -144,7 +151,7 @@ func handleError(err error, r *rpcResponse) error {
 		return err
 	}
 	if r.Err != nil {
-		return r.Err
+		return &explorer.ResponseError{Message: r.Err.Error()}
 	}
 
 	return nil
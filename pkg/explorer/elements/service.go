@@ -17,8 +17,13 @@ type elements struct {
 
 // NewService returns the Elements implementation of the Explorer interface.
 // It establishes an insecure connection with the JSON-RPC interface of the
-// node with no TLS termination.
-func NewService(endpoint string, rescanTimestamp interface{}) (
+// node with no TLS termination. The given options customize the HTTP client,
+// for example to route all requests through a proxy.
+func NewService(
+	endpoint string,
+	rescanTimestamp interface{},
+	opts ...explorer.HTTPOption,
+) (
 	explorer.Service,
 	error,
 ) {
@@ -28,6 +33,13 @@ func NewService(endpoint string, rescanTimestamp interface{}) (
 	if err := validateRescanTimestamp(rescanTimestamp); err != nil {
 		return nil, err
 	}
+	httpConfig, err := explorer.NewHTTPConfig(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := httpConfig.ValidateEndpoint(endpoint); err != nil {
+		return nil, err
+	}
 
 	parsedEndpoint, _ := url.Parse(endpoint)
 	host := parsedEndpoint.Hostname()
@@ -38,7 +50,7 @@ func NewService(endpoint string, rescanTimestamp interface{}) (
 		rescanTimestamp = "now"
 	}
 
-	client, err := NewClient(host, port, user, password, false, 30)
+	client, err := NewClient(host, port, user, password, false, 30, opts...)
 
 	if err != nil {
 		return nil, err
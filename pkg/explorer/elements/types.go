@@ -103,6 +103,8 @@ type elementsUnspent struct {
 	UAssetCommitment  string  `json:"assetcommitment,omitempty"`
 	UAmountBlinder    string  `json:"amountblinder,omitempty"`
 	UAssetBlinder     string  `json:"assetblinder,omitempty"`
+	UBlockHeight      uint64  `json:"-"`
+	UBlockHash        string  `json:"-"`
 	UNonce            []byte
 	URangeProof       []byte
 	USurjectionProof  []byte
@@ -168,6 +170,14 @@ func (eu elementsUnspent) IsConfirmed() bool {
 	return eu.UConfirmations > 0
 }
 
+func (eu elementsUnspent) BlockHeight() uint64 {
+	return eu.UBlockHeight
+}
+
+func (eu elementsUnspent) BlockHash() string {
+	return eu.UBlockHash
+}
+
 func (eu elementsUnspent) IsRevealed() bool {
 	return len(eu.UAmountBlinder) > 0 && len(eu.UAssetBlinder) > 0
 }
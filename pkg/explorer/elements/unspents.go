@@ -102,6 +102,12 @@ func (e *elements) toUtxos(unspents []elementsUnspent) ([]explorer.Utxo, error)
 		return nil, nil
 	}
 
+	// the node only tells the number of confirmations of an unspent, therefore
+	// the height of its block is derived from the current one.
+	if err := e.setUnspentsBlock(unspents); err != nil {
+		return nil, fmt.Errorf("block: %w", err)
+	}
+
 	utxos := make([]explorer.Utxo, 0, len(unspents))
 	chRes := make(chan utxoResult)
 	wg := &sync.WaitGroup{}
@@ -127,6 +133,34 @@ func (e *elements) toUtxos(unspents []elementsUnspent) ([]explorer.Utxo, error)
 	return utxos, nil
 }
 
+func (e *elements) setUnspentsBlock(unspents []elementsUnspent) error {
+	var blockHeight int
+	blockHashes := make(map[uint64]string)
+	for i, u := range unspents {
+		if u.UConfirmations <= 0 {
+			continue
+		}
+		if blockHeight <= 0 {
+			height, err := e.GetBlockHeight()
+			if err != nil {
+				return err
+			}
+			blockHeight = height
+		}
+		height := uint64(blockHeight - int(u.UConfirmations) + 1)
+		if _, ok := blockHashes[height]; !ok {
+			hash, err := e.GetBlockHash(height)
+			if err != nil {
+				return err
+			}
+			blockHashes[height] = hash
+		}
+		unspents[i].UBlockHeight = height
+		unspents[i].UBlockHash = blockHashes[height]
+	}
+	return nil
+}
+
 // TODO: this won't be required as soon as the wallet pkg handles blinding txs
 // also with blinders instead of unblinding ALL the owned inputs with blinding
 // private keys.
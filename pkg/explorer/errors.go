@@ -0,0 +1,61 @@
+package explorer
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	// ErrMissingBackends ...
+	ErrMissingBackends = errors.New("at least one explorer backend is required")
+	// ErrInvalidMaxRetries ...
+	ErrInvalidMaxRetries = errors.New("max retries must not be a negative number")
+	// ErrInvalidRetryBackoff ...
+	ErrInvalidRetryBackoff = errors.New("retry backoff must not be negative")
+	// ErrInvalidProxy ...
+	ErrInvalidProxy = errors.New(
+		"proxy address must be in the form host:port or socks5://host:port",
+	)
+	// ErrOnionEndpointWithoutProxy ...
+	ErrOnionEndpointWithoutProxy = errors.New(
+		"a proxy is required to connect to .onion endpoints",
+	)
+	// ErrInvalidStreamEndpoint ...
+	ErrInvalidStreamEndpoint = errors.New(
+		"activity stream endpoint must be a valid http(s) url",
+	)
+	// ErrStreamingNotSupported ...
+	ErrStreamingNotSupported = errors.New(
+		"explorer does not support streaming blockchain activity",
+	)
+	// ErrInsufficientFunds ...
+	ErrInsufficientFunds = errors.New(
+		"error on target amount: total utxo amount does not cover target amount",
+	)
+)
+
+// ResponseError is returned by an explorer service when the backend received
+// and processed a request, but replied with an error, like when a
+// transaction is not found.
+type ResponseError struct {
+	// StatusCode is the HTTP status code of the response. It's zero if the
+	// error has been returned by an RPC backend.
+	StatusCode int
+	// Message is the error message returned by the backend.
+	Message string
+}
+
+func (e *ResponseError) Error() string {
+	return e.Message
+}
+
+// isBackendFailure returns whether the given error is due to a failure of the
+// backend itself, either at transport level or because of a 5xx response,
+// rather than to a legit error response.
+func isBackendFailure(err error) bool {
+	var respErr *ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
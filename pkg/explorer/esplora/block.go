@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
 )
 
 func (e *esplora) GetBlockHeight() (int, error) {
@@ -11,12 +13,14 @@ func (e *esplora) GetBlockHeight() (int, error) {
 		"%v/blocks/tip/height",
 		e.apiURL,
 	)
-	status, resp, err := e.client.NewHTTPRequest("GET", url, "", nil)
+	status, resp, err := e.client.NewHTTPRequestWithContext(
+		e.context(), "GET", url, "", nil,
+	)
 	if err != nil {
 		return -1, err
 	}
 	if status != http.StatusOK {
-		return -1, fmt.Errorf(resp)
+		return -1, &explorer.ResponseError{StatusCode: status, Message: resp}
 	}
 
 	blockHeight, err := strconv.Atoi(resp)
@@ -26,3 +30,22 @@ func (e *esplora) GetBlockHeight() (int, error) {
 
 	return blockHeight, nil
 }
+
+func (e *esplora) GetBlockHash(height uint64) (string, error) {
+	url := fmt.Sprintf(
+		"%v/block-height/%d",
+		e.apiURL,
+		height,
+	)
+	status, resp, err := e.client.NewHTTPRequestWithContext(
+		e.context(), "GET", url, "", nil,
+	)
+	if err != nil {
+		return "", err
+	}
+	if status != http.StatusOK {
+		return "", &explorer.ResponseError{StatusCode: status, Message: resp}
+	}
+
+	return resp, nil
+}
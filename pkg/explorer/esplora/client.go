@@ -1,6 +1,7 @@
 package esplora
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -11,10 +12,38 @@ import (
 
 type Client struct {
 	*http.Client
+	maxRetries  int
+	backoffBase time.Duration
 }
 
-func NewHTTPClient(requestTimeout time.Duration) *Client {
-	return &Client{&http.Client{Timeout: requestTimeout}}
+// ClientOption customizes a Client.
+type ClientOption func(*Client)
+
+// WithMaxRetries makes the client attempt a request up to the given number of
+// further times if it fails at transport level or with a 5xx response.
+// Requests that are not idempotent, like POST ones, are never retried.
+func WithMaxRetries(maxRetries int) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// WithBackoffBase sets the time to wait before the first retry of a request,
+// doubled at every further attempt.
+func WithBackoffBase(backoffBase time.Duration) ClientOption {
+	return func(c *Client) {
+		c.backoffBase = backoffBase
+	}
+}
+
+// NewHTTPClient returns a client whose every request attempt, including the
+// reading of the response body, times out after the given duration.
+func NewHTTPClient(requestTimeout time.Duration, opts ...ClientOption) *Client {
+	c := &Client{Client: &http.Client{Timeout: requestTimeout}}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // NewHTTPRequest function builds http call
@@ -25,22 +54,53 @@ func (s *Client) NewHTTPRequest(
 	method, url, bodyString string,
 	header map[string]string,
 ) (int, string, error) {
+	return s.NewHTTPRequestWithContext(
+		context.Background(), method, url, bodyString, header,
+	)
+}
+
+// NewHTTPRequestWithContext is like NewHTTPRequest, but the request, along
+// with its retries, is aborted as soon as the given context is done.
+func (s *Client) NewHTTPRequestWithContext(
+	ctx context.Context,
+	method, url, bodyString string,
+	header map[string]string,
+) (int, string, error) {
+	var request func() (int, string, error)
 	switch method {
 	case "GET":
-		return s.get(url, header)
+		request = func() (int, string, error) { return s.get(ctx, url, header) }
 	case "LIST":
-		return s.list(url, header)
+		request = func() (int, string, error) { return s.list(ctx, url, header) }
 	case "DELETE":
-		return s.delete(url, header)
+		request = func() (int, string, error) { return s.delete(ctx, url, header) }
 	case "POST":
-		return s.post(url, bodyString, header)
+		return s.post(ctx, url, bodyString, header)
 	default:
 		return 0, "", fmt.Errorf("verb not supported %s", method)
 	}
+
+	status, resp, err := request()
+	for attempt := 0; attempt < s.maxRetries; attempt++ {
+		if err == nil && status < http.StatusInternalServerError {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return 0, "", ctx.Err()
+		case <-time.After(s.backoffBase << uint(attempt)):
+		}
+		status, resp, err = request()
+	}
+	return status, resp, err
 }
 
-func (s *Client) get(url string, header map[string]string) (int, string, error) {
-	req, err := http.NewRequest("GET", url, nil)
+func (s *Client) get(
+	ctx context.Context,
+	url string,
+	header map[string]string,
+) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return 0, "", err
 	}
@@ -65,8 +125,12 @@ func (s *Client) get(url string, header map[string]string) (int, string, error)
 	return rs.StatusCode, string(bodyBytes), nil
 }
 
-func (s *Client) list(url string, header map[string]string) (int, string, error) {
-	req, err := http.NewRequest("LIST", url, nil)
+func (s *Client) list(
+	ctx context.Context,
+	url string,
+	header map[string]string,
+) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, "LIST", url, nil)
 	if err != nil {
 		return 0, "", err
 	}
@@ -91,8 +155,12 @@ func (s *Client) list(url string, header map[string]string) (int, string, error)
 	return rs.StatusCode, string(bodyBytes), nil
 }
 
-func (s *Client) delete(url string, header map[string]string) (int, string, error) {
-	req, err := http.NewRequest("DELETE", url, nil)
+func (s *Client) delete(
+	ctx context.Context,
+	url string,
+	header map[string]string,
+) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return 0, "", err
 	}
@@ -117,9 +185,13 @@ func (s *Client) delete(url string, header map[string]string) (int, string, erro
 	return rs.StatusCode, string(bodyBytes), nil
 }
 
-func (s *Client) post(url, bodyString string, header map[string]string) (int, string, error) {
+func (s *Client) post(
+	ctx context.Context,
+	url, bodyString string,
+	header map[string]string,
+) (int, string, error) {
 	body := strings.NewReader(bodyString)
-	req, err := http.NewRequest("POST", url, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, body)
 	if err != nil {
 		return 0, "", err
 	}
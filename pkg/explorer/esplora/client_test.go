@@ -0,0 +1,112 @@
+package esplora
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+)
+
+func TestClientRetries(t *testing.T) {
+	var heightCalls, broadcastCalls int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/blocks/tip/height":
+				// the health check of the service is the first call.
+				if n := atomic.AddInt32(&heightCalls, 1); n > 1 && n <= 3 {
+					rw.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				rw.Write([]byte("100"))
+			case "/tx":
+				atomic.AddInt32(&broadcastCalls, 1)
+				rw.WriteHeader(http.StatusBadGateway)
+			default:
+				rw.WriteHeader(http.StatusNotFound)
+			}
+		},
+	))
+	defer server.Close()
+
+	explorerSvc, err := NewService(
+		server.URL, 5000, explorer.WithRetries(2, time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	height, err := explorerSvc.GetBlockHeight()
+	require.NoError(t, err)
+	require.Equal(t, 100, height)
+	require.Equal(t, int32(4), atomic.LoadInt32(&heightCalls))
+
+	_, err = explorerSvc.BroadcastTransaction("00")
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&broadcastCalls))
+}
+
+func TestClientHonorsContext(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			rw.WriteHeader(http.StatusInternalServerError)
+		},
+	))
+	defer server.Close()
+
+	client := NewHTTPClient(
+		5*time.Second, WithMaxRetries(10), WithBackoffBase(time.Second),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := client.NewHTTPRequestWithContext(
+		ctx, "GET", server.URL, "", nil,
+	)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, int64(time.Since(start)), int64(time.Second))
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestServiceHonorsContext(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, r *http.Request) {
+			// the health check of the service is the first call.
+			if atomic.AddInt32(&calls, 1) > 1 {
+				rw.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			rw.Write([]byte("100"))
+		},
+	))
+	defer server.Close()
+
+	explorerSvc, err := NewService(
+		server.URL, 5000, explorer.WithRetries(10, time.Second),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = explorer.WithContext(ctx, explorerSvc).GetBlockHeight()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, int64(time.Since(start)), int64(time.Second))
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestFailingWithRetries(t *testing.T) {
+	_, err := explorer.NewHTTPConfig(explorer.WithRetries(-1, time.Second))
+	require.EqualError(t, err, explorer.ErrInvalidMaxRetries.Error())
+
+	_, err = explorer.NewHTTPConfig(explorer.WithRetries(1, -time.Second))
+	require.EqualError(t, err, explorer.ErrInvalidRetryBackoff.Error())
+}
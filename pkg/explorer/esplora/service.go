@@ -1,7 +1,9 @@
 package esplora
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/tdex-network/tdex-daemon/pkg/explorer"
@@ -14,16 +16,50 @@ const (
 type esplora struct {
 	apiURL string
 	client *Client
+	// streamURL and streamClient are set only if an activity stream is
+	// configured. The stream client has no timeout since the connection is
+	// meant to stay open.
+	streamURL    string
+	streamClient *http.Client
+	// ctx, if set, aborts every request as soon as it's done.
+	ctx context.Context
 }
 
-// NewService returns a new esplora service as an explorer.Service interface
-func NewService(apiURL string, requestTimeout int) (explorer.Service, error) {
+// NewService returns a new esplora service as an explorer.Service interface.
+// The given options customize the HTTP client, for example to route all
+// requests through a proxy or to retry those failed because of the backend.
+// The request timeout, in milliseconds, applies to every single attempt.
+func NewService(
+	apiURL string,
+	requestTimeout int,
+	opts ...explorer.HTTPOption,
+) (explorer.Service, error) {
 	d := time.Duration(requestTimeout) * time.Millisecond
 	if d < minRequestTimeout {
 		return nil, fmt.Errorf("request timeout must be at least 5 seconds")
 	}
-	client := NewHTTPClient(d)
-	service := &esplora{apiURL, client}
+	httpConfig, err := explorer.NewHTTPConfig(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := httpConfig.ValidateEndpoint(apiURL); err != nil {
+		return nil, err
+	}
+	client := NewHTTPClient(
+		d,
+		WithMaxRetries(httpConfig.MaxRetries),
+		WithBackoffBase(httpConfig.RetryBackoff),
+	)
+	client.Transport = httpConfig.Transport()
+	service := &esplora{apiURL: apiURL, client: client}
+
+	if streamURL := httpConfig.StreamURL; streamURL != nil {
+		if err := httpConfig.ValidateEndpoint(streamURL.String()); err != nil {
+			return nil, err
+		}
+		service.streamURL = streamURL.String()
+		service.streamClient = &http.Client{Transport: httpConfig.Transport()}
+	}
 
 	if _, err := service.GetBlockHeight(); err != nil {
 		return nil, fmt.Errorf("health check: %w", err)
@@ -31,3 +67,18 @@ func NewService(apiURL string, requestTimeout int) (explorer.Service, error) {
 
 	return service, nil
 }
+
+// WithContext returns a copy of the service whose requests are aborted,
+// along with their retries, as soon as the given context is done.
+func (e *esplora) WithContext(ctx context.Context) explorer.Service {
+	svc := *e
+	svc.ctx = ctx
+	return &svc
+}
+
+func (e *esplora) context() context.Context {
+	if e.ctx == nil {
+		return context.Background()
+	}
+	return e.ctx
+}
@@ -0,0 +1,112 @@
+package esplora
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+)
+
+// maxStreamEventSize is the max size in bytes of a line of the activity
+// stream, large enough for the JSON of transactions with many inputs.
+const maxStreamEventSize = 4 * 1024 * 1024
+
+type streamTx struct {
+	Vin []struct {
+		Prevout *struct {
+			Scriptpubkey string `json:"scriptpubkey"`
+		} `json:"prevout"`
+	} `json:"vin"`
+	Vout []struct {
+		Scriptpubkey string `json:"scriptpubkey"`
+	} `json:"vout"`
+}
+
+func (e *esplora) StreamActivity(
+	ctx context.Context,
+) (<-chan []string, error) {
+	if e.streamURL == "" {
+		return nil, explorer.ErrStreamingNotSupported
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", e.streamURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := e.streamClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, &explorer.ResponseError{
+			StatusCode: resp.StatusCode,
+			Message:    string(body),
+		}
+	}
+
+	chScripts := make(chan []string)
+	go func() {
+		defer close(chScripts)
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxStreamEventSize)
+
+		// an event is made of all data lines up to the next empty one
+		data := make([]string, 0)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.HasPrefix(line, "data:") {
+				data = append(data, strings.TrimPrefix(line[len("data:"):], " "))
+				continue
+			}
+			if line != "" || len(data) <= 0 {
+				continue
+			}
+
+			scripts, err := parseStreamEvent(strings.Join(data, "\n"))
+			data = data[:0]
+			// malformed events are skipped
+			if err != nil {
+				continue
+			}
+			select {
+			case chScripts <- scripts:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return chScripts, nil
+}
+
+// parseStreamEvent returns the scripts of the outputs created and spent by
+// the given transaction in JSON format.
+func parseStreamEvent(txJSON string) ([]string, error) {
+	var t streamTx
+	if err := json.Unmarshal([]byte(txJSON), &t); err != nil {
+		return nil, err
+	}
+
+	scripts := make([]string, 0, len(t.Vin)+len(t.Vout))
+	for _, in := range t.Vin {
+		if in.Prevout != nil && in.Prevout.Scriptpubkey != "" {
+			scripts = append(scripts, in.Prevout.Scriptpubkey)
+		}
+	}
+	for _, out := range t.Vout {
+		if out.Scriptpubkey != "" {
+			scripts = append(scripts, out.Scriptpubkey)
+		}
+	}
+	return scripts, nil
+}
@@ -0,0 +1,83 @@
+package esplora
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+)
+
+func TestStreamActivity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/blocks/tip/height":
+				rw.Write([]byte("100"))
+			case "/stream":
+				require.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+				rw.Header().Set("Content-Type", "text/event-stream")
+				fmt.Fprint(rw, ": keep-alive\n\n")
+				fmt.Fprint(rw, "data: {\"vin\": [{\"prevout\": {\"scriptpubkey\": \"aa\"}}, {\"prevout\": null}],\n")
+				fmt.Fprint(rw, "data: \"vout\": [{\"scriptpubkey\": \"bb\"}, {\"scriptpubkey\": \"\"}]}\n\n")
+				fmt.Fprint(rw, "data: not a tx\n\n")
+				fmt.Fprint(rw, "event: tx\ndata: {\"vout\": [{\"scriptpubkey\": \"cc\"}]}\n\n")
+			default:
+				rw.WriteHeader(http.StatusNotFound)
+			}
+		},
+	))
+	defer server.Close()
+
+	explorerSvc, err := NewService(
+		server.URL, 5000, explorer.WithActivityStream(server.URL+"/stream"),
+	)
+	require.NoError(t, err)
+
+	streamer, ok := explorerSvc.(explorer.ActivityStreamer)
+	require.True(t, ok)
+
+	chScripts, err := streamer.StreamActivity(context.Background())
+	require.NoError(t, err)
+
+	activity := make([][]string, 0)
+	for scripts := range chScripts {
+		activity = append(activity, scripts)
+	}
+	require.Equal(t, [][]string{{"aa", "bb"}, {"cc"}}, activity)
+}
+
+func TestFailingStreamActivity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/blocks/tip/height":
+				rw.Write([]byte("100"))
+			default:
+				rw.WriteHeader(http.StatusNotFound)
+			}
+		},
+	))
+	defer server.Close()
+
+	explorerSvc, err := NewService(server.URL, 5000)
+	require.NoError(t, err)
+	_, err = explorerSvc.(explorer.ActivityStreamer).StreamActivity(
+		context.Background(),
+	)
+	require.EqualError(t, err, explorer.ErrStreamingNotSupported.Error())
+
+	explorerSvc, err = NewService(
+		server.URL, 5000, explorer.WithActivityStream(server.URL+"/stream"),
+	)
+	require.NoError(t, err)
+	_, err = explorerSvc.(explorer.ActivityStreamer).StreamActivity(
+		context.Background(),
+	)
+	var respErr *explorer.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	require.Equal(t, http.StatusNotFound, respErr.StatusCode)
+}
@@ -34,17 +34,72 @@ func (e *esplora) GetTransactionStatus(hash string) (map[string]interface{}, err
 	return e.getTransactionStatus(hash)
 }
 
+// addressTxsPageSize is the max number of confirmed txs of every page of the
+// history of an address returned by esplora.
+const addressTxsPageSize = 25
+
+// GetTransactionsForAddress returns the whole history of the given address.
+// The first page returned by esplora contains the unconfirmed txs and the
+// most recent confirmed ones, the older are fetched page by page, each
+// following the last confirmed tx seen, until a page is not full.
 func (e *esplora) GetTransactionsForAddress(address string, _ []byte) ([]explorer.Transaction, error) {
 	url := fmt.Sprintf("%s/address/%s/txs", e.apiURL, address)
-	status, resp, err := e.client.NewHTTPRequest("GET", url, "", nil)
+	txs := make([]explorer.Transaction, 0)
+	for {
+		page, lastSeenTxid, numConfirmed, err := e.getAddressTxsPage(url)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, page...)
+
+		if numConfirmed < addressTxsPageSize {
+			return txs, nil
+		}
+		url = fmt.Sprintf(
+			"%s/address/%s/txs/chain/%s", e.apiURL, address, lastSeenTxid,
+		)
+	}
+}
+
+// getAddressTxsPage returns the txs of the page at the given url, along with
+// the hash of the last, thus oldest, confirmed one and the number of those
+// confirmed.
+func (e *esplora) getAddressTxsPage(
+	url string,
+) ([]explorer.Transaction, string, int, error) {
+	statusCode, resp, err := e.client.NewHTTPRequestWithContext(
+		e.context(), "GET", url, "", nil,
+	)
 	if err != nil {
-		return nil, err
+		return nil, "", 0, err
 	}
-	if status != http.StatusOK {
-		return nil, fmt.Errorf(resp)
+	if statusCode != http.StatusOK {
+		return nil, "", 0, &explorer.ResponseError{StatusCode: statusCode, Message: resp}
 	}
 
-	return parseTransactions(resp)
+	// txs are parsed concurrently and thus lose their order, therefore the
+	// cursor for the next page is found beforehand.
+	var page []struct {
+		Txid   string `json:"txid"`
+		Status status `json:"status"`
+	}
+	if err := json.Unmarshal([]byte(resp), &page); err != nil {
+		return nil, "", 0, err
+	}
+	lastSeenTxid := ""
+	numConfirmed := 0
+	for _, t := range page {
+		if t.Status.Confirmed {
+			lastSeenTxid = t.Txid
+			numConfirmed++
+		}
+	}
+
+	txs, err := parseTransactions(resp)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return txs, lastSeenTxid, numConfirmed, nil
 }
 
 func (e *esplora) BroadcastTransaction(txHex string) (string, error) {
@@ -53,7 +108,8 @@ func (e *esplora) BroadcastTransaction(txHex string) (string, error) {
 		"Content-Type": "text/plain",
 	}
 
-	status, resp, err := e.client.NewHTTPRequest(
+	status, resp, err := e.client.NewHTTPRequestWithContext(
+		e.context(),
 		"POST",
 		url,
 		txHex,
@@ -63,7 +119,7 @@ func (e *esplora) BroadcastTransaction(txHex string) (string, error) {
 		return "", err
 	}
 	if status != http.StatusOK {
-		return "", fmt.Errorf(resp)
+		return "", &explorer.ResponseError{StatusCode: status, Message: resp}
 	}
 
 	return resp, nil
@@ -78,14 +134,15 @@ func (e *esplora) Faucet(address string, amount float64, asset string) (string,
 		"Content-Type": "application/json",
 	}
 
-	status, resp, err := e.client.NewHTTPRequest(
+	status, resp, err := e.client.NewHTTPRequestWithContext(
+		e.context(),
 		"POST", url, bodyString, headers,
 	)
 	if err != nil {
 		return "", err
 	}
 	if status != http.StatusOK {
-		return "", fmt.Errorf(resp)
+		return "", &explorer.ResponseError{StatusCode: status, Message: resp}
 	}
 
 	var rr map[string]string
@@ -102,14 +159,15 @@ func (e *esplora) Mint(address string, amount float64) (string, string, error) {
 	headers := map[string]string{
 		"Content-Type": "application/json",
 	}
-	status, resp, err := e.client.NewHTTPRequest(
+	status, resp, err := e.client.NewHTTPRequestWithContext(
+		e.context(),
 		"POST", url, bodyString, headers,
 	)
 	if err != nil {
 		return "", "", err
 	}
 	if status != http.StatusOK {
-		return "", "", fmt.Errorf(resp)
+		return "", "", &explorer.ResponseError{StatusCode: status, Message: resp}
 	}
 
 	var rr map[string]string
@@ -124,12 +182,14 @@ func (e *esplora) getTransactionHex(hash string) (string, error) {
 		e.apiURL,
 		hash,
 	)
-	status, resp, err := e.client.NewHTTPRequest("GET", url, "", nil)
+	status, resp, err := e.client.NewHTTPRequestWithContext(
+		e.context(), "GET", url, "", nil,
+	)
 	if err != nil {
 		return "", err
 	}
 	if status != http.StatusOK {
-		return "", fmt.Errorf(resp)
+		return "", &explorer.ResponseError{StatusCode: status, Message: resp}
 	}
 
 	return resp, nil
@@ -156,12 +216,14 @@ func (e *esplora) getTransactionStatus(hash string) (map[string]interface{}, err
 		e.apiURL,
 		hash,
 	)
-	status, resp, err := e.client.NewHTTPRequest("GET", url, "", nil)
+	status, resp, err := e.client.NewHTTPRequestWithContext(
+		e.context(), "GET", url, "", nil,
+	)
 	if err != nil {
 		return nil, err
 	}
 	if status != http.StatusOK {
-		return nil, fmt.Errorf(resp)
+		return nil, &explorer.ResponseError{StatusCode: status, Message: resp}
 	}
 
 	var trxStatus map[string]interface{}
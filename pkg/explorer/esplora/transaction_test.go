@@ -1,6 +1,10 @@
 package esplora
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -92,3 +96,52 @@ func TestGetTransactionsForAddress(t *testing.T) {
 	}
 	assert.Equal(t, 1, len(txs))
 }
+
+func TestGetTransactionsForAddressPaginated(t *testing.T) {
+	addr := "el1qqfttsemg4sapwrfmmccyztj4wa8gpn5yfetkda4z5uy5e2jysgrszmj0xa8tzftde78kvtl26dtxw6q6gcuawte5xeyvkunws"
+	newPage := func(prefix string, numUnconfirmed, numConfirmed int) string {
+		page := make([]map[string]interface{}, 0)
+		for i := 0; i < numUnconfirmed+numConfirmed; i++ {
+			page = append(page, map[string]interface{}{
+				"txid":   fmt.Sprintf("%s%d", prefix, i),
+				"status": map[string]interface{}{"confirmed": i >= numUnconfirmed},
+			})
+		}
+		buf, _ := json.Marshal(page)
+		return string(buf)
+	}
+	pages := map[string]string{
+		fmt.Sprintf("/address/%s/txs", addr):               newPage("a", 2, addressTxsPageSize),
+		fmt.Sprintf("/address/%s/txs/chain/a%d", addr, 26): newPage("b", 0, addressTxsPageSize),
+		fmt.Sprintf("/address/%s/txs/chain/b%d", addr, 24): newPage("c", 0, 3),
+	}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/blocks/tip/height" {
+				rw.Write([]byte("100"))
+				return
+			}
+			page, ok := pages[r.URL.Path]
+			if !ok {
+				rw.WriteHeader(http.StatusNotFound)
+				return
+			}
+			requests++
+			rw.Write([]byte(page))
+		},
+	))
+	defer server.Close()
+
+	explorerSvc, err := NewService(server.URL, 5000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txs, err := explorerSvc.GetTransactionsForAddress(addr, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, 2+2*addressTxsPageSize+3, len(txs))
+}
@@ -162,7 +162,9 @@ type witnessUtxo struct {
 }
 
 type status struct {
-	Confirmed bool `json:"confirmed"`
+	Confirmed   bool   `json:"confirmed"`
+	BlockHeight uint64 `json:"block_height"`
+	BlockHash   string `json:"block_hash"`
 }
 
 // NewUnconfidentialWitnessUtxo is the factory for a non-confidential witnessUtxo.
@@ -283,6 +285,14 @@ func (wu witnessUtxo) IsConfirmed() bool {
 	return wu.UStatus.Confirmed
 }
 
+func (wu witnessUtxo) BlockHeight() uint64 {
+	return wu.UStatus.BlockHeight
+}
+
+func (wu witnessUtxo) BlockHash() string {
+	return wu.UStatus.BlockHash
+}
+
 func (wu witnessUtxo) IsRevealed() bool {
 	return len(wu.ValueBlinder()) > 0 && len(wu.AssetBlinder()) > 0
 }
@@ -64,12 +64,14 @@ func (e *esplora) getUtxos(addr string, blindingKeys [][]byte) ([]explorer.Utxo,
 		e.apiURL,
 		addr,
 	)
-	status, resp, err := e.client.NewHTTPRequest("GET", url, "", nil)
+	status, resp, err := e.client.NewHTTPRequestWithContext(
+		e.context(), "GET", url, "", nil,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("error on retrieving utxos: %s", err)
 	}
 	if status != http.StatusOK {
-		return nil, fmt.Errorf(resp)
+		return nil, &explorer.ResponseError{StatusCode: status, Message: resp}
 	}
 
 	var witnessOuts []witnessUtxo
@@ -154,7 +156,7 @@ func (e *esplora) getUtxoDetails(
 	defer wg.Done()
 
 	// in case of error the status is defaulted to unconfirmed
-	confirmed, _ := e.IsTransactionConfirmed(utxo.Hash())
+	utxoStatus := e.getUtxoStatus(utxo.Hash())
 
 	prevoutTxHex, err := e.GetTransactionHex(utxo.Hash())
 	if err != nil {
@@ -170,11 +172,29 @@ func (e *esplora) getUtxoDetails(
 		utxo.USurjectionProof = prevout.SurjectionProof
 	}
 	utxo.UScript = prevout.Script
-	utxo.UStatus = status{Confirmed: confirmed}
+	utxo.UStatus = utxoStatus
 
 	chRes <- utxoResult{utxo: utxo}
 }
 
+func (e *esplora) getUtxoStatus(hash string) status {
+	txStatus, err := e.getTransactionStatus(hash)
+	if err != nil {
+		return status{}
+	}
+	confirmed, _ := txStatus["confirmed"].(bool)
+	if !confirmed {
+		return status{}
+	}
+	blockHeight, _ := txStatus["block_height"].(float64)
+	blockHash, _ := txStatus["block_hash"].(string)
+	return status{
+		Confirmed:   true,
+		BlockHeight: uint64(blockHeight),
+		BlockHash:   blockHash,
+	}
+}
+
 func unblindUtxo(
 	u explorer.Utxo,
 	blindKeys [][]byte,
@@ -1,6 +1,8 @@
 package explorer
 
 import (
+	"context"
+
 	"github.com/vulpemventures/go-elements/transaction"
 )
 
@@ -20,6 +22,12 @@ type Utxo interface {
 	SurjectionProof() []byte
 	IsConfidential() bool
 	IsConfirmed() bool
+	// BlockHeight returns the height of the block including the tx of the
+	// utxo, or 0 if not yet confirmed.
+	BlockHeight() uint64
+	// BlockHash returns the hash of the block including the tx of the utxo, or
+	// an empty string if not yet confirmed.
+	BlockHash() string
 	IsRevealed() bool
 	Parse() (*transaction.TxInput, *transaction.TxOutput, error)
 }
@@ -65,9 +73,40 @@ type Service interface {
 	BroadcastTransaction(txhex string) (txid string, err error)
 	// GetBlockHeight returns the the number of block of the blockchain.
 	GetBlockHeight() (int, error)
+	// GetBlockHash returns the hash of the block at the given height of the
+	// best chain.
+	GetBlockHash(height uint64) (string, error)
 	/**** REGTEST ONLY ****/
 	// Faucet funds the given address with the amount (in BTC) of provided asset
 	Faucet(address string, amount float64, asset string) (txid string, err error)
 	// Mint funds the given address with a certain amount (in BTC) of a new issued asset.
 	Mint(address string, amount float64) (txid string, asset string, err error)
 }
+
+// ActivityStreamer is optionally implemented by services able to push the
+// activity of the blockchain in real time, sparing clients from polling.
+type ActivityStreamer interface {
+	// StreamActivity subscribes to the stream of new transactions and returns
+	// a channel notifying, for each of them, the hex encoded scripts of the
+	// outputs they create or spend. The channel is closed once ctx is canceled
+	// or the connection drops. ErrStreamingNotSupported is returned if the
+	// backend doesn't provide such stream.
+	StreamActivity(ctx context.Context) (<-chan []string, error)
+}
+
+// ContextBinder is optionally implemented by services able to abort their
+// requests, along with any retry, as soon as the caller's context is done.
+type ContextBinder interface {
+	// WithContext returns a shallow copy of the service whose requests are
+	// bound to the given context.
+	WithContext(ctx context.Context) Service
+}
+
+// WithContext returns the given service with its requests bound to ctx if it
+// implements ContextBinder, or the service itself otherwise.
+func WithContext(ctx context.Context, svc Service) Service {
+	if binder, ok := svc.(ContextBinder); ok {
+		return binder.WithContext(ctx)
+	}
+	return svc
+}
@@ -0,0 +1,238 @@
+package explorer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// RetryPolicy defines how many times, and how often, a request is retried
+// against the same backend before failing over to the next one.
+type RetryPolicy struct {
+	// MaxRetries is the number of further attempts made against a backend
+	// after the first one failed.
+	MaxRetries int
+	// Interval is the time to wait between two consecutive attempts.
+	Interval time.Duration
+}
+
+// MultiService is the Service returned by NewMultiExplorer. Other than
+// implementing the Service interface, it exposes which backend ultimately
+// served the last request for debugging purposes.
+type MultiService interface {
+	Service
+	// LastServedBy returns the index of the backend that served the last
+	// request, or -1 if none has been served yet.
+	LastServedBy() int
+}
+
+type multiExplorer struct {
+	services []Service
+	policy   RetryPolicy
+	servedBy *int32
+}
+
+// NewMultiExplorer returns a Service that forwards every request to the given
+// list of services in order of priority. Whenever a backend fails because of
+// a transport error or a 5xx response, the request is retried according to
+// the given policy and eventually handed to the next backend. Legit error
+// responses, like a transaction not found, are instead returned immediately.
+func NewMultiExplorer(services []Service, policy RetryPolicy) (Service, error) {
+	if len(services) <= 0 {
+		return nil, ErrMissingBackends
+	}
+	if policy.MaxRetries < 0 {
+		return nil, ErrInvalidMaxRetries
+	}
+
+	servedBy := int32(-1)
+	return &multiExplorer{
+		services: services,
+		policy:   policy,
+		servedBy: &servedBy,
+	}, nil
+}
+
+func (m *multiExplorer) LastServedBy() int {
+	return int(atomic.LoadInt32(m.servedBy))
+}
+
+func (m *multiExplorer) GetUnspents(
+	addr string,
+	blindKeys [][]byte,
+) (unspents []Utxo, err error) {
+	err = m.do(func(svc Service) (e error) {
+		unspents, e = svc.GetUnspents(addr, blindKeys)
+		return
+	})
+	return
+}
+
+func (m *multiExplorer) GetUnspentsForAddresses(
+	addresses []string,
+	blindingKeys [][]byte,
+) (unspents []Utxo, err error) {
+	err = m.do(func(svc Service) (e error) {
+		unspents, e = svc.GetUnspentsForAddresses(addresses, blindingKeys)
+		return
+	})
+	return
+}
+
+func (m *multiExplorer) GetTransaction(txid string) (tx Transaction, err error) {
+	err = m.do(func(svc Service) (e error) {
+		tx, e = svc.GetTransaction(txid)
+		return
+	})
+	return
+}
+
+func (m *multiExplorer) GetTransactionHex(txid string) (txhex string, err error) {
+	err = m.do(func(svc Service) (e error) {
+		txhex, e = svc.GetTransactionHex(txid)
+		return
+	})
+	return
+}
+
+func (m *multiExplorer) IsTransactionConfirmed(
+	txid string,
+) (confirmed bool, err error) {
+	err = m.do(func(svc Service) (e error) {
+		confirmed, e = svc.IsTransactionConfirmed(txid)
+		return
+	})
+	return
+}
+
+func (m *multiExplorer) GetTransactionStatus(
+	txid string,
+) (status map[string]interface{}, err error) {
+	err = m.do(func(svc Service) (e error) {
+		status, e = svc.GetTransactionStatus(txid)
+		return
+	})
+	return
+}
+
+func (m *multiExplorer) GetTransactionsForAddress(
+	address string,
+	blindingKey []byte,
+) (txs []Transaction, err error) {
+	err = m.do(func(svc Service) (e error) {
+		txs, e = svc.GetTransactionsForAddress(address, blindingKey)
+		return
+	})
+	return
+}
+
+func (m *multiExplorer) BroadcastTransaction(txhex string) (txid string, err error) {
+	err = m.do(func(svc Service) (e error) {
+		txid, e = svc.BroadcastTransaction(txhex)
+		return
+	})
+	return
+}
+
+func (m *multiExplorer) GetBlockHeight() (height int, err error) {
+	err = m.do(func(svc Service) (e error) {
+		height, e = svc.GetBlockHeight()
+		return
+	})
+	return
+}
+
+func (m *multiExplorer) GetBlockHash(height uint64) (hash string, err error) {
+	err = m.do(func(svc Service) (e error) {
+		hash, e = svc.GetBlockHash(height)
+		return
+	})
+	return
+}
+
+func (m *multiExplorer) Faucet(
+	address string,
+	amount float64,
+	asset string,
+) (txid string, err error) {
+	err = m.do(func(svc Service) (e error) {
+		txid, e = svc.Faucet(address, amount, asset)
+		return
+	})
+	return
+}
+
+func (m *multiExplorer) Mint(
+	address string,
+	amount float64,
+) (txid string, asset string, err error) {
+	err = m.do(func(svc Service) (e error) {
+		txid, asset, e = svc.Mint(address, amount)
+		return
+	})
+	return
+}
+
+// StreamActivity subscribes to the activity stream of the first backend, in
+// order of priority, that provides one.
+func (m *multiExplorer) StreamActivity(
+	ctx context.Context,
+) (<-chan []string, error) {
+	err := ErrStreamingNotSupported
+	for _, svc := range m.services {
+		streamer, ok := svc.(ActivityStreamer)
+		if !ok {
+			continue
+		}
+		chScripts, e := streamer.StreamActivity(ctx)
+		if e == nil {
+			return chScripts, nil
+		}
+		if !errors.Is(e, ErrStreamingNotSupported) {
+			err = e
+		}
+	}
+	return nil, err
+}
+
+// WithContext binds every backend that supports it to ctx. The returned copy
+// keeps track of the backend serving the requests along with the original.
+func (m *multiExplorer) WithContext(ctx context.Context) Service {
+	services := make([]Service, 0, len(m.services))
+	for _, svc := range m.services {
+		services = append(services, WithContext(ctx, svc))
+	}
+	return &multiExplorer{
+		services: services,
+		policy:   m.policy,
+		servedBy: m.servedBy,
+	}
+}
+
+// do runs the given request against every backend, in order, until one
+// serves it. The returned error is either the one of the backend that served
+// the request, or a summary of all backend failures.
+func (m *multiExplorer) do(request func(svc Service) error) error {
+	failures := make([]string, 0, len(m.services))
+
+	for i, svc := range m.services {
+		var err error
+		for attempt := 0; attempt <= m.policy.MaxRetries; attempt++ {
+			if attempt > 0 {
+				time.Sleep(m.policy.Interval)
+			}
+			if err = request(svc); err == nil || !isBackendFailure(err) {
+				atomic.StoreInt32(m.servedBy, int32(i))
+				return err
+			}
+		}
+		failures = append(failures, fmt.Sprintf("backend %d: %s", i, err))
+	}
+
+	return fmt.Errorf(
+		"all explorer backends failed: %s", strings.Join(failures, ", "),
+	)
+}
@@ -0,0 +1,157 @@
+package explorer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiExplorerFailover(t *testing.T) {
+	tests := []struct {
+		name            string
+		primaryErr      error
+		wantServedBy    int
+		wantPrimaryCall int
+		wantErr         bool
+	}{
+		{
+			name:            "primary ok",
+			primaryErr:      nil,
+			wantServedBy:    0,
+			wantPrimaryCall: 1,
+		},
+		{
+			name:            "primary transport error",
+			primaryErr:      errors.New("connection refused"),
+			wantServedBy:    1,
+			wantPrimaryCall: 3,
+		},
+		{
+			name: "primary 5xx",
+			primaryErr: &ResponseError{
+				StatusCode: http.StatusBadGateway,
+				Message:    "bad gateway",
+			},
+			wantServedBy:    1,
+			wantPrimaryCall: 3,
+		},
+		{
+			name: "primary not found",
+			primaryErr: &ResponseError{
+				StatusCode: http.StatusNotFound,
+				Message:    "Transaction not found",
+			},
+			wantServedBy:    0,
+			wantPrimaryCall: 1,
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := &fakeService{height: 1, err: tt.primaryErr}
+			fallback := &fakeService{height: 2}
+
+			svc, err := NewMultiExplorer(
+				[]Service{primary, fallback},
+				RetryPolicy{MaxRetries: 2},
+			)
+			require.NoError(t, err)
+
+			height, err := svc.GetBlockHeight()
+			if tt.wantErr {
+				require.Equal(t, tt.primaryErr, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantServedBy+1, height)
+			}
+			require.Equal(t, tt.wantServedBy, svc.(MultiService).LastServedBy())
+			require.Equal(t, tt.wantPrimaryCall, primary.calls)
+		})
+	}
+}
+
+func TestMultiExplorerAllBackendsDown(t *testing.T) {
+	svc, err := NewMultiExplorer(
+		[]Service{
+			&fakeService{err: errors.New("timeout")},
+			&fakeService{err: &ResponseError{StatusCode: http.StatusServiceUnavailable}},
+		},
+		RetryPolicy{},
+	)
+	require.NoError(t, err)
+
+	_, err = svc.GetBlockHeight()
+	require.Error(t, err)
+	require.Equal(t, -1, svc.(MultiService).LastServedBy())
+}
+
+func TestFailingNewMultiExplorer(t *testing.T) {
+	_, err := NewMultiExplorer(nil, RetryPolicy{})
+	require.EqualError(t, err, ErrMissingBackends.Error())
+
+	_, err = NewMultiExplorer([]Service{&fakeService{}}, RetryPolicy{MaxRetries: -1})
+	require.EqualError(t, err, ErrInvalidMaxRetries.Error())
+}
+
+func TestMultiExplorerStreamActivity(t *testing.T) {
+	chScripts := make(chan []string)
+	svc, err := NewMultiExplorer(
+		[]Service{
+			&fakeService{},
+			&fakeStreamer{err: ErrStreamingNotSupported},
+			&fakeStreamer{chScripts: chScripts},
+		},
+		RetryPolicy{},
+	)
+	require.NoError(t, err)
+
+	ch, err := svc.(ActivityStreamer).StreamActivity(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, (<-chan []string)(chScripts), ch)
+
+	svc, err = NewMultiExplorer(
+		[]Service{&fakeService{}, &fakeStreamer{err: ErrStreamingNotSupported}},
+		RetryPolicy{},
+	)
+	require.NoError(t, err)
+	_, err = svc.(ActivityStreamer).StreamActivity(context.Background())
+	require.EqualError(t, err, ErrStreamingNotSupported.Error())
+}
+
+// fakeService is a Service whose GetBlockHeight either returns the configured
+// height or error, counting the number of times it gets called.
+type fakeService struct {
+	Service
+	height int
+	err    error
+	calls  int
+}
+
+func (f *fakeService) GetBlockHeight() (int, error) {
+	f.calls++
+	if f.err != nil {
+		return -1, f.err
+	}
+	return f.height, nil
+}
+
+// fakeStreamer is a Service whose StreamActivity returns either the
+// configured channel or error.
+type fakeStreamer struct {
+	fakeService
+	chScripts chan []string
+	err       error
+}
+
+func (f *fakeStreamer) StreamActivity(
+	_ context.Context,
+) (<-chan []string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.chScripts, nil
+}
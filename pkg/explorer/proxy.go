@@ -0,0 +1,131 @@
+package explorer
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	socks5Scheme = "socks5"
+	onionTLD     = ".onion"
+)
+
+// HTTPConfig holds the settings of the HTTP client used by a service to
+// connect to its backend.
+type HTTPConfig struct {
+	// ProxyURL is the SOCKS5 proxy where all connections are routed through.
+	// If nil, connections are direct.
+	ProxyURL *url.URL
+	// StreamURL is the endpoint of the Server-Sent Events stream of new
+	// transactions. If nil, services can't stream the blockchain activity.
+	StreamURL *url.URL
+	// MaxRetries is the number of further attempts made for an idempotent
+	// request that failed at transport level or with a 5xx response.
+	MaxRetries int
+	// RetryBackoff is the time to wait before the first retry of a request,
+	// doubled at every further attempt.
+	RetryBackoff time.Duration
+}
+
+// HTTPOption customizes an HTTPConfig.
+type HTTPOption func(*HTTPConfig) error
+
+// WithProxy routes all connections through the SOCKS5 proxy at the given
+// address, either in the form host:port or socks5://host:port. Hostnames are
+// resolved by the proxy, making it possible to reach .onion endpoints via Tor.
+func WithProxy(addr string) HTTPOption {
+	return func(c *HTTPConfig) error {
+		if !strings.Contains(addr, "://") {
+			addr = socks5Scheme + "://" + addr
+		}
+		u, err := url.Parse(addr)
+		if err != nil {
+			return ErrInvalidProxy
+		}
+		if u.Scheme != socks5Scheme || u.Hostname() == "" || u.Port() == "" {
+			return ErrInvalidProxy
+		}
+		c.ProxyURL = u
+		return nil
+	}
+}
+
+// WithActivityStream makes services subscribe to the Server-Sent Events
+// stream at the given endpoint to get notified of new transactions. Every
+// event's data is expected to be a transaction in the JSON format of the
+// Esplora REST API, including the previous outputs spent by its inputs.
+func WithActivityStream(endpoint string) HTTPOption {
+	return func(c *HTTPConfig) error {
+		u, err := url.Parse(endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") ||
+			u.Host == "" {
+			return ErrInvalidStreamEndpoint
+		}
+		c.StreamURL = u
+		return nil
+	}
+}
+
+// WithRetries makes REST services, like the esplora one, retry the idempotent
+// requests that fail because of the backend up to maxRetries times, waiting
+// an exponentially increasing time starting from backoffBase between
+// consecutive attempts.
+func WithRetries(maxRetries int, backoffBase time.Duration) HTTPOption {
+	return func(c *HTTPConfig) error {
+		if maxRetries < 0 {
+			return ErrInvalidMaxRetries
+		}
+		if backoffBase < 0 {
+			return ErrInvalidRetryBackoff
+		}
+		c.MaxRetries = maxRetries
+		c.RetryBackoff = backoffBase
+		return nil
+	}
+}
+
+// NewHTTPConfig returns the HTTPConfig resulting from applying the given
+// options in order.
+func NewHTTPConfig(opts ...HTTPOption) (*HTTPConfig, error) {
+	c := &HTTPConfig{}
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Proxy returns the function to be used as http.Transport's Proxy. It's nil
+// if no proxy is configured.
+func (c *HTTPConfig) Proxy() func(*http.Request) (*url.URL, error) {
+	if c.ProxyURL == nil {
+		return nil
+	}
+	return http.ProxyURL(c.ProxyURL)
+}
+
+// Transport returns the http.RoundTripper for the client, or nil, meaning
+// http.DefaultTransport, if no proxy is configured.
+func (c *HTTPConfig) Transport() http.RoundTripper {
+	if c.ProxyURL == nil {
+		return nil
+	}
+	return &http.Transport{Proxy: c.Proxy()}
+}
+
+// ValidateEndpoint makes sure that the given endpoint can be reached with the
+// current config, ie. that .onion endpoints are only used along with a proxy.
+func (c *HTTPConfig) ValidateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	if strings.HasSuffix(strings.ToLower(u.Hostname()), onionTLD) &&
+		c.ProxyURL == nil {
+		return ErrOnionEndpointWithoutProxy
+	}
+	return nil
+}
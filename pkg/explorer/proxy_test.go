@@ -0,0 +1,73 @@
+package explorer
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithProxy(t *testing.T) {
+	tests := []struct {
+		addr        string
+		expectedURL string
+	}{
+		{"127.0.0.1:9050", "socks5://127.0.0.1:9050"},
+		{"socks5://localhost:9050", "socks5://localhost:9050"},
+	}
+
+	for _, tt := range tests {
+		cfg, err := NewHTTPConfig(WithProxy(tt.addr))
+		require.NoError(t, err)
+		require.Equal(t, tt.expectedURL, cfg.ProxyURL.String())
+
+		req, _ := http.NewRequest(
+			"GET", "http://explorerzzzzzzzzzzzzzzz.onion/api/blocks/tip/height", nil,
+		)
+		proxyURL, err := cfg.Proxy()(req)
+		require.NoError(t, err)
+		require.Equal(t, tt.expectedURL, proxyURL.String())
+		require.NotNil(t, cfg.Transport())
+		require.NoError(t, cfg.ValidateEndpoint(req.URL.String()))
+	}
+
+	cfg, err := NewHTTPConfig()
+	require.NoError(t, err)
+	require.Nil(t, cfg.Proxy())
+	require.Nil(t, cfg.Transport())
+	require.NoError(t, cfg.ValidateEndpoint("https://blockstream.info/liquid/api"))
+}
+
+func TestFailingWithProxy(t *testing.T) {
+	tests := []string{
+		"127.0.0.1",
+		"http://127.0.0.1:9050",
+		"socks5://:9050",
+	}
+
+	for _, addr := range tests {
+		_, err := NewHTTPConfig(WithProxy(addr))
+		require.EqualError(t, err, ErrInvalidProxy.Error())
+	}
+
+	cfg, err := NewHTTPConfig()
+	require.NoError(t, err)
+	err = cfg.ValidateEndpoint("http://explorerzzzzzzzzzzzzzzz.onion/api")
+	require.EqualError(t, err, ErrOnionEndpointWithoutProxy.Error())
+}
+
+func TestWithActivityStream(t *testing.T) {
+	cfg, err := NewHTTPConfig(WithActivityStream("http://localhost:3001/stream"))
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:3001/stream", cfg.StreamURL.String())
+
+	cfg, err = NewHTTPConfig()
+	require.NoError(t, err)
+	require.Nil(t, cfg.StreamURL)
+
+	tests := []string{"", "localhost:3001", "ws://localhost:3001", "http://"}
+	for _, endpoint := range tests {
+		_, err := NewHTTPConfig(WithActivityStream(endpoint))
+		require.EqualError(t, err, ErrInvalidStreamEndpoint.Error())
+	}
+}
@@ -0,0 +1,131 @@
+package fiatprice
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const defaultRequestTimeout = 10 * time.Second
+
+var (
+	// ErrInvalidEndpoint ...
+	ErrInvalidEndpoint = errors.New(
+		"fiat price source endpoint must be a valid http(s) url",
+	)
+	// ErrInvalidPrice ...
+	ErrInvalidPrice = errors.New("fiat price must be a positive decimal number")
+)
+
+// Service is the interface for a source of fiat prices
+type Service interface {
+	// Currency returns the code of the fiat currency prices are expressed in.
+	Currency() string
+	// GetPrice returns the fiat price of one unit (10^8 sats) of the given
+	// asset at the given time, expressed in Unix seconds.
+	GetPrice(asset string, timestamp uint64) (decimal.Decimal, error)
+}
+
+// Opts defines the parameters needed for creating a fiat price service with
+// NewService method
+type Opts struct {
+	// Endpoint is the url of the rate API. Prices are fetched with GET requests
+	// to this url, with asset and timestamp query parameters, expecting a JSON
+	// response like {"price": "12345.67"}
+	Endpoint string
+	// Currency is the code of the fiat currency returned by the rate API.
+	// Defaults to USD
+	Currency string
+	// RequestTimeout is the time to wait for a response. Defaults to 10 seconds
+	RequestTimeout time.Duration
+}
+
+func (o Opts) validate() error {
+	u, err := url.Parse(o.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidEndpoint
+	}
+	return nil
+}
+
+type priceResponse struct {
+	Price string `json:"price"`
+}
+
+type fiatPrice struct {
+	endpoint *url.URL
+	currency string
+	client   *http.Client
+}
+
+// NewService returns a fiat price Service that fetches prices from the given
+// rate API
+func NewService(opts Opts) (Service, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	endpoint, _ := url.Parse(opts.Endpoint)
+	currency := opts.Currency
+	if currency == "" {
+		currency = "USD"
+	}
+	requestTimeout := opts.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+
+	return &fiatPrice{
+		endpoint: endpoint,
+		currency: currency,
+		client:   &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+func (f *fiatPrice) Currency() string {
+	return f.currency
+}
+
+func (f *fiatPrice) GetPrice(
+	asset string,
+	timestamp uint64,
+) (decimal.Decimal, error) {
+	u := *f.endpoint
+	query := u.Query()
+	query.Set("asset", asset)
+	query.Set("timestamp", strconv.FormatUint(timestamp, 10))
+	u.RawQuery = query.Encode()
+
+	resp, err := f.client.Get(u.String())
+	if err != nil {
+		return decimal.Zero, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return decimal.Zero, fmt.Errorf(
+			"rate API replied with status %d: %s", resp.StatusCode, body,
+		)
+	}
+
+	var res priceResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return decimal.Zero, err
+	}
+	price, err := decimal.NewFromString(res.Price)
+	if err != nil || !price.IsPositive() {
+		return decimal.Zero, ErrInvalidPrice
+	}
+	return price, nil
+}
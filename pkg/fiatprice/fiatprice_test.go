@@ -0,0 +1,67 @@
+package fiatprice
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPrice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "lbtc", r.URL.Query().Get("asset"))
+			require.Equal(t, "1609459200", r.URL.Query().Get("timestamp"))
+			rw.Write([]byte(`{"price": "29374.15"}`))
+		},
+	))
+	defer server.Close()
+
+	svc, err := NewService(Opts{Endpoint: server.URL, Currency: "EUR"})
+	require.NoError(t, err)
+	require.Equal(t, "EUR", svc.Currency())
+
+	price, err := svc.GetPrice("lbtc", 1609459200)
+	require.NoError(t, err)
+	require.Equal(t, "29374.15", price.String())
+}
+
+func TestFailingGetPrice(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		err    error
+	}{
+		{http.StatusOK, `{"price": "-1"}`, ErrInvalidPrice},
+		{http.StatusOK, `{"price": "abc"}`, ErrInvalidPrice},
+		{http.StatusNotFound, `not found`, nil},
+	}
+
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(
+			func(rw http.ResponseWriter, r *http.Request) {
+				rw.WriteHeader(tt.status)
+				rw.Write([]byte(tt.body))
+			},
+		))
+
+		svc, err := NewService(Opts{Endpoint: server.URL})
+		require.NoError(t, err)
+
+		_, err = svc.GetPrice("lbtc", 0)
+		require.Error(t, err)
+		if tt.err != nil {
+			require.EqualError(t, err, tt.err.Error())
+		}
+		server.Close()
+	}
+}
+
+func TestFailingNewService(t *testing.T) {
+	endpoints := []string{"", "ftp://rates.io", "http://"}
+	for _, endpoint := range endpoints {
+		_, err := NewService(Opts{Endpoint: endpoint})
+		require.EqualError(t, err, ErrInvalidEndpoint.Error())
+	}
+}
@@ -8,18 +8,38 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// ErrCode is the failure code of a SwapFail message. Codes are documented for
+// clients in api-spec/errors.md.
 type ErrCode int
 
 const (
 	ErrCodeInvalidSwapRequest ErrCode = iota
 	ErrCodeRejectedSwapRequest
 	ErrCodeFailedToComplete
+	ErrCodeOutOfTradeLimits
+	ErrCodeTradeExpired
+	ErrCodeSlippageExceeded
+	ErrCodeMarketPaused
+	ErrCodeRateLimited
+	ErrCodePriceOutOfBand
+	ErrCodeTradeCanceled
+	ErrCodeInvalidTransaction
+	ErrCodeSettlementTimeout
 )
 
 var errMsg = map[ErrCode]string{
 	ErrCodeInvalidSwapRequest:  "invalid swap request",
 	ErrCodeRejectedSwapRequest: "swap request not accepted",
 	ErrCodeFailedToComplete:    "swap not completed",
+	ErrCodeOutOfTradeLimits:    "swap request amount out of market trade limits",
+	ErrCodeTradeExpired:        "swap not completed before expiration",
+	ErrCodeSlippageExceeded:    "swap request price too far from market price",
+	ErrCodeMarketPaused:        "market is paused",
+	ErrCodeRateLimited:         "too many swap requests",
+	ErrCodePriceOutOfBand:      "market price too far from reference price",
+	ErrCodeTradeCanceled:       "swap canceled before completion",
+	ErrCodeInvalidTransaction:  "swap request transaction does not match the swap terms",
+	ErrCodeSettlementTimeout:   "swap not settled before deadline",
 }
 
 type FailOpts struct {
@@ -0,0 +1,133 @@
+package wallet
+
+import (
+	"strings"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/vulpemventures/go-elements/address"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/payment"
+)
+
+const (
+	hashSize           = 20
+	blindingPubkeySize = 33
+)
+
+const (
+	// NativeSegwitAddress is the type of the confidential P2WPKH addresses.
+	NativeSegwitAddress = "p2wpkh"
+	// NestedSegwitAddress is the type of the confidential P2SH-P2WPKH
+	// addresses, for wallets not supporting the native segwit ones.
+	NestedSegwitAddress = "p2sh-p2wpkh"
+)
+
+// IsValidAddressType returns whether the given one is either a native or a
+// nested segwit address type.
+func IsValidAddressType(addressType string) bool {
+	return addressType == NativeSegwitAddress ||
+		addressType == NestedSegwitAddress
+}
+
+// NestedWitnessScript returns the P2SH output script wrapping the given
+// witness one, used as redeem script.
+func NestedWitnessScript(witnessScript []byte) []byte {
+	script, _ := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_HASH160).
+		AddData(payment.Hash160(witnessScript)).
+		AddOp(txscript.OP_EQUAL).
+		Script()
+	return script
+}
+
+// ParseAddress returns the output script and, for confidential addresses, the
+// blinding public key of the given address of the given network. Unlike the
+// address package of go-elements, that only knows about the built-in
+// networks, it supports also the addresses of custom Elements chains.
+func ParseAddress(
+	addr string,
+	net *network.Network,
+) (script, blindingKey []byte, err error) {
+	if net == nil {
+		net = &network.Liquid
+	}
+
+	switch {
+	case strings.HasPrefix(addr, net.Blech32):
+		bl, err := address.FromBlech32(addr)
+		if err != nil {
+			return nil, nil, err
+		}
+		if bl.Prefix != net.Blech32 {
+			return nil, nil, ErrAddressNetworkMismatch
+		}
+		script, err := witnessScript(bl.Version, bl.Program)
+		if err != nil {
+			return nil, nil, err
+		}
+		return script, bl.PublicKey, nil
+	case strings.HasPrefix(addr, net.Bech32):
+		bc, err := address.FromBech32(addr)
+		if err != nil {
+			return nil, nil, err
+		}
+		if bc.Prefix != net.Bech32 {
+			return nil, nil, ErrAddressNetworkMismatch
+		}
+		script, err := witnessScript(bc.Version, bc.Program)
+		if err != nil {
+			return nil, nil, err
+		}
+		return script, nil, nil
+	}
+
+	payload, version, err := base58.CheckDecode(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if version == net.Confidential {
+		if len(payload) != 1+blindingPubkeySize+hashSize {
+			return nil, nil, ErrUnsupportedAddressType
+		}
+		version = payload[0]
+		blindingKey = payload[1 : 1+blindingPubkeySize]
+		payload = payload[1+blindingPubkeySize:]
+	}
+	if len(payload) != hashSize {
+		return nil, nil, ErrUnsupportedAddressType
+	}
+
+	switch version {
+	case net.PubKeyHash:
+		script, err = txscript.NewScriptBuilder().
+			AddOp(txscript.OP_DUP).
+			AddOp(txscript.OP_HASH160).
+			AddData(payload).
+			AddOp(txscript.OP_EQUALVERIFY).
+			AddOp(txscript.OP_CHECKSIG).
+			Script()
+	case net.ScriptHash:
+		script, err = txscript.NewScriptBuilder().
+			AddOp(txscript.OP_HASH160).
+			AddData(payload).
+			AddOp(txscript.OP_EQUAL).
+			Script()
+	default:
+		return nil, nil, ErrAddressNetworkMismatch
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return script, blindingKey, nil
+}
+
+func witnessScript(version byte, program []byte) ([]byte, error) {
+	if version != 0 {
+		return nil, ErrUnsupportedAddressType
+	}
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).
+		AddData(program).
+		Script()
+}
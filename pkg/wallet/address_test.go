@@ -0,0 +1,104 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vulpemventures/go-elements/address"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/payment"
+)
+
+var customNetwork = network.Network{
+	Name:         "custom",
+	Bech32:       "cex",
+	Blech32:      "clq",
+	HDPublicKey:  [4]byte{0x04, 0x88, 0xb2, 0x1e},
+	HDPrivateKey: [4]byte{0x04, 0x88, 0xad, 0xe4},
+	PubKeyHash:   28,
+	ScriptHash:   88,
+	Wif:          0x80,
+	Confidential: 25,
+	AssetID:      "144c654344aa716d6f3abcc1ca90e5641e4e2a7f633bc09fe3baf64585819a49",
+}
+
+func TestParseAddress(t *testing.T) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	blindingKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	blindingPubkey := blindingKey.PubKey()
+
+	for _, net := range []*network.Network{
+		&network.Liquid, &network.Regtest, &customNetwork,
+	} {
+		p := payment.FromPublicKey(key.PubKey(), net, blindingPubkey)
+		p2pkh, err := p.PubKeyHash()
+		require.NoError(t, err)
+		ctP2pkh, err := p.ConfidentialPubKeyHash()
+		require.NoError(t, err)
+		p2wpkh, err := p.WitnessPubKeyHash()
+		require.NoError(t, err)
+		ctP2wpkh, err := p.ConfidentialWitnessPubKeyHash()
+		require.NoError(t, err)
+
+		tests := []struct {
+			addr           string
+			script         []byte
+			isConfidential bool
+		}{
+			{p2pkh, p.Script, false},
+			{ctP2pkh, p.Script, true},
+			{p2wpkh, p.WitnessScript, false},
+			{ctP2wpkh, p.WitnessScript, true},
+		}
+
+		for _, tt := range tests {
+			script, blindingKey, err := ParseAddress(tt.addr, net)
+			require.NoError(t, err, tt.addr)
+			assert.Equal(t, tt.script, script)
+			if tt.isConfidential {
+				assert.Equal(t, blindingPubkey.SerializeCompressed(), blindingKey)
+			} else {
+				assert.Nil(t, blindingKey)
+			}
+
+			// the addresses of the built-in networks are parsed the same way by
+			// go-elements.
+			if net.Name != customNetwork.Name {
+				expectedScript, err := address.ToOutputScript(tt.addr)
+				require.NoError(t, err)
+				assert.Equal(t, expectedScript, script)
+			}
+		}
+	}
+}
+
+func TestFailingParseAddress(t *testing.T) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	p := payment.FromPublicKey(key.PubKey(), &customNetwork, key.PubKey())
+	ctP2wpkh, err := p.ConfidentialWitnessPubKeyHash()
+	require.NoError(t, err)
+	p2pkh, err := p.PubKeyHash()
+	require.NoError(t, err)
+
+	tests := []struct {
+		addr string
+		net  *network.Network
+		err  error
+	}{
+		{ctP2wpkh, &network.Liquid, nil},
+		{p2pkh, &network.Regtest, ErrAddressNetworkMismatch},
+	}
+
+	for _, tt := range tests {
+		_, _, err := ParseAddress(tt.addr, tt.net)
+		require.Error(t, err)
+		if tt.err != nil {
+			assert.Equal(t, tt.err, err)
+		}
+	}
+}
@@ -512,3 +512,54 @@ func ExtractBlindingDataFromTx(
 
 	return
 }
+
+// VerifyPsetBalance returns whether, for every asset, the amount of the
+// inputs of the given tx (in pset's base64 format) equals that of the outputs
+// plus the network fees. Inputs and outputs are revealed with the given
+// blinding data, like those returned by ExtractBlindingDataFromTx, mapped by
+// index, while the fee outputs are always explicit.
+func VerifyPsetBalance(
+	psetBase64 string,
+	inBlindingData, outBlindingData map[int]BlindingData,
+) (bool, error) {
+	ptx, err := pset.NewPsetFromBase64(psetBase64)
+	if err != nil {
+		return false, err
+	}
+
+	inAmounts := make(map[string]uint64)
+	for i := range ptx.Inputs {
+		data, ok := inBlindingData[i]
+		if !ok {
+			return false, ErrMissingInBlindingData
+		}
+		inAmounts[data.Asset] += data.Amount
+	}
+
+	outAmounts := make(map[string]uint64)
+	for i, out := range ptx.UnsignedTx.Outputs {
+		if len(out.Script) <= 0 {
+			res, ok := transactionutil.UnblindOutput(out, nil)
+			if !ok || res == nil {
+				return false, ErrConfidentialFeeOutput
+			}
+			outAmounts[res.AssetHash] += res.Value
+			continue
+		}
+		data, ok := outBlindingData[i]
+		if !ok {
+			return false, ErrMissingOutBlindingData
+		}
+		outAmounts[data.Asset] += data.Amount
+	}
+
+	if len(inAmounts) != len(outAmounts) {
+		return false, nil
+	}
+	for asset, amount := range inAmounts {
+		if outAmounts[asset] != amount {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/pset"
+	"github.com/vulpemventures/go-elements/transaction"
 )
 
 func TestBlindTransactionWithKeys(t *testing.T) {
@@ -97,3 +99,82 @@ func randomBytes(len int) []byte {
 	rand.Read(b)
 	return b
 }
+
+func TestVerifyPsetBalance(t *testing.T) {
+	lbtc := network.Regtest.AssetID
+	asset := strings.Repeat("ab", 32)
+	script, _ := hex.DecodeString("0014c2c87d69d673b21c99af8fc9c2b87ccf66b82885")
+	ptx, err := pset.New(
+		[]*transaction.TxInput{
+			transaction.NewTxInput(make([]byte, 32), 0),
+			transaction.NewTxInput(make([]byte, 32), 1),
+		},
+		[]*transaction.TxOutput{
+			newTestOutput(t, asset, 1000, script),
+			newTestOutput(t, lbtc, 9500, script),
+			newTestOutput(t, lbtc, 500, nil),
+		},
+		2,
+		0,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	psetBase64, err := ptx.ToBase64()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inBlindingData := map[int]BlindingData{
+		0: {Asset: asset, Amount: 1000},
+		1: {Asset: lbtc, Amount: 10000},
+	}
+	outBlindingData := map[int]BlindingData{
+		0: {Asset: asset, Amount: 1000},
+		1: {Asset: lbtc, Amount: 9500},
+	}
+
+	balanced, err := VerifyPsetBalance(psetBase64, inBlindingData, outBlindingData)
+	assert.NoError(t, err)
+	assert.True(t, balanced)
+
+	outBlindingData[1] = BlindingData{Asset: lbtc, Amount: 9000}
+	balanced, err = VerifyPsetBalance(psetBase64, inBlindingData, outBlindingData)
+	assert.NoError(t, err)
+	assert.False(t, balanced)
+
+	delete(outBlindingData, 1)
+	_, err = VerifyPsetBalance(psetBase64, inBlindingData, outBlindingData)
+	assert.Equal(t, ErrMissingOutBlindingData, err)
+
+	delete(inBlindingData, 0)
+	_, err = VerifyPsetBalance(psetBase64, inBlindingData, outBlindingData)
+	assert.Equal(t, ErrMissingInBlindingData, err)
+
+	// a confidential output with an empty script can't be revealed.
+	feeOut := ptx.UnsignedTx.Outputs[2]
+	feeOut.Asset = append([]byte{10}, make([]byte, 32)...)
+	feeOut.Value = append([]byte{8}, make([]byte, 32)...)
+	feeOut.Nonce = append([]byte{2}, make([]byte, 32)...)
+	psetBase64, err = ptx.ToBase64()
+	if err != nil {
+		t.Fatal(err)
+	}
+	inBlindingData[0] = BlindingData{Asset: asset, Amount: 1000}
+	outBlindingData[1] = BlindingData{Asset: lbtc, Amount: 9500}
+	_, err = VerifyPsetBalance(psetBase64, inBlindingData, outBlindingData)
+	assert.Equal(t, ErrConfidentialFeeOutput, err)
+}
+
+func newTestOutput(
+	t *testing.T,
+	asset string,
+	value uint64,
+	script []byte,
+) *transaction.TxOutput {
+	out, err := newTxOutput(asset, value, script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
@@ -0,0 +1,295 @@
+package wallet
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+)
+
+const (
+	// CoinSelectionLargestFirst selects coins from the largest one, reducing
+	// the number of inputs and consolidating those of small value over time.
+	CoinSelectionLargestFirst = "largest_first"
+	// CoinSelectionSmallestFirst selects coins from the smallest one, spending
+	// those of small value before they accumulate as dust.
+	CoinSelectionSmallestFirst = "smallest_first"
+	// CoinSelectionBranchAndBound searches for the combination of coins that
+	// minimizes the change.
+	CoinSelectionBranchAndBound = "branch_and_bound"
+
+	// maxBranchAndBoundTries is the max number of combinations explored by the
+	// branch-and-bound selector before returning the best found so far.
+	maxBranchAndBoundTries = 100000
+)
+
+var (
+	// ErrInvalidCoinSelectionStrategy ...
+	ErrInvalidCoinSelectionStrategy = errors.New("unknown coin selection strategy")
+	// ErrInsufficientFunds ...
+	ErrInsufficientFunds = explorer.ErrInsufficientFunds
+	// ErrTooManyInputs ...
+	ErrTooManyInputs = errors.New(
+		"too many utxos required to cover the amount, the account is too " +
+			"fragmented: consolidate its utxos first",
+	)
+	// ErrUnblindedUtxosRequired ...
+	ErrUnblindedUtxosRequired = errors.New(
+		"error on utxos: all confidential utxos must be already unblinded",
+	)
+)
+
+// CoinSelector is the interface for coin selection algorithms.
+type CoinSelector interface {
+	// Select returns a subset of the given utxos, all of the same asset, whose
+	// total value covers the target amount, along with such total.
+	Select(utxos []explorer.Utxo, target uint64) ([]explorer.Utxo, uint64, error)
+}
+
+// NewCoinSelector returns the CoinSelector implementing the given strategy.
+func NewCoinSelector(strategy string) (CoinSelector, error) {
+	switch strategy {
+	case CoinSelectionLargestFirst:
+		return largestFirstSelector{}, nil
+	case CoinSelectionSmallestFirst:
+		return smallestFirstSelector{}, nil
+	case CoinSelectionBranchAndBound:
+		return branchAndBoundSelector{}, nil
+	default:
+		return nil, ErrInvalidCoinSelectionStrategy
+	}
+}
+
+// NewMaxInputsCoinSelector returns a CoinSelector that selects at most
+// maxInputs coins. The given selector, or the default strategy of
+// explorer.SelectUnspents if nil, is tried first and, if it selects too many
+// coins, they're selected from the largest one instead. ErrTooManyInputs is
+// returned if not even those are enough.
+func NewMaxInputsCoinSelector(
+	selector CoinSelector,
+	maxInputs int,
+) CoinSelector {
+	return maxInputsSelector{selector, maxInputs}
+}
+
+type maxInputsSelector struct {
+	selector  CoinSelector
+	maxInputs int
+}
+
+func (s maxInputsSelector) Select(
+	utxos []explorer.Utxo,
+	target uint64,
+) ([]explorer.Utxo, uint64, error) {
+	var coins []explorer.Utxo
+	var total uint64
+	var err error
+	if s.selector != nil {
+		coins, total, err = s.selector.Select(utxos, target)
+	} else if len(utxos) > 0 {
+		var change uint64
+		coins, change, err = explorer.SelectUnspents(
+			utxos, target, utxos[0].Asset(),
+		)
+		total = target + change
+	} else {
+		err = ErrInsufficientFunds
+	}
+	if err != nil || len(coins) <= s.maxInputs {
+		return coins, total, err
+	}
+
+	coins, total, err = largestFirstSelector{}.Select(utxos, target)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(coins) > s.maxInputs {
+		return nil, 0, ErrTooManyInputs
+	}
+	return coins, total, nil
+}
+
+type largestFirstSelector struct{}
+
+func (largestFirstSelector) Select(
+	utxos []explorer.Utxo,
+	target uint64,
+) ([]explorer.Utxo, uint64, error) {
+	sorted := sortUtxosByValue(utxos, true)
+	return selectInOrder(sorted, target)
+}
+
+type smallestFirstSelector struct{}
+
+func (smallestFirstSelector) Select(
+	utxos []explorer.Utxo,
+	target uint64,
+) ([]explorer.Utxo, uint64, error) {
+	sorted := sortUtxosByValue(utxos, false)
+	return selectInOrder(sorted, target)
+}
+
+// branchAndBoundSelector explores the combinations of coins, from the largest
+// one, pruning those that can't cover the target or that exceed the best
+// total found. An exact match ends the search immediately.
+type branchAndBoundSelector struct{}
+
+func (branchAndBoundSelector) Select(
+	utxos []explorer.Utxo,
+	target uint64,
+) ([]explorer.Utxo, uint64, error) {
+	sorted := sortUtxosByValue(utxos, true)
+
+	// remaining[i] is the total value of the utxos from index i on
+	remaining := make([]uint64, len(sorted)+1)
+	for i := len(sorted) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + sorted[i].Value()
+	}
+	if remaining[0] < target {
+		return nil, 0, ErrInsufficientFunds
+	}
+
+	var best []int
+	bestTotal := uint64(0)
+	selected := make([]int, 0, len(sorted))
+	tries := 0
+
+	var search func(index int, total uint64) bool
+	search = func(index int, total uint64) bool {
+		tries++
+		if total >= target {
+			if best == nil || total < bestTotal {
+				best = append([]int{}, selected...)
+				bestTotal = total
+			}
+			return total == target
+		}
+		if index >= len(sorted) || tries >= maxBranchAndBoundTries {
+			return false
+		}
+		if total+remaining[index] < target {
+			return false
+		}
+		if best != nil && total >= bestTotal {
+			return false
+		}
+
+		selected = append(selected, index)
+		if search(index+1, total+sorted[index].Value()) {
+			return true
+		}
+		selected = selected[:len(selected)-1]
+
+		return search(index+1, total)
+	}
+	search(0, 0)
+
+	coins := make([]explorer.Utxo, 0, len(best))
+	for _, i := range best {
+		coins = append(coins, sorted[i])
+	}
+	return coins, bestTotal, nil
+}
+
+func sortUtxosByValue(utxos []explorer.Utxo, descending bool) []explorer.Utxo {
+	sorted := make([]explorer.Utxo, len(utxos))
+	copy(sorted, utxos)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if descending {
+			return sorted[i].Value() > sorted[j].Value()
+		}
+		return sorted[i].Value() < sorted[j].Value()
+	})
+	return sorted
+}
+
+func selectInOrder(
+	utxos []explorer.Utxo,
+	target uint64,
+) ([]explorer.Utxo, uint64, error) {
+	coins := make([]explorer.Utxo, 0)
+	total := uint64(0)
+	for _, u := range utxos {
+		if total >= target {
+			break
+		}
+		coins = append(coins, u)
+		total += u.Value()
+	}
+	if total < target {
+		return nil, 0, ErrInsufficientFunds
+	}
+	return coins, total, nil
+}
+
+// selectUnspents performs a coin selection over those of the given utxos of
+// type targetAsset to cover the targetAmount and returns the selected coins
+// along with the change amount. If selector is nil, the default strategy of
+// explorer.SelectUnspents is used.
+func selectUnspents(
+	selector CoinSelector,
+	utxos []explorer.Utxo,
+	targetAmount uint64,
+	targetAsset string,
+) ([]explorer.Utxo, uint64, error) {
+	if selector == nil {
+		return explorer.SelectUnspents(utxos, targetAmount, targetAsset)
+	}
+
+	utxosOfAsset := make([]explorer.Utxo, 0, len(utxos))
+	for _, u := range utxos {
+		if u.IsConfidential() && !u.IsRevealed() {
+			return nil, 0, ErrUnblindedUtxosRequired
+		}
+		if u.Asset() == targetAsset {
+			utxosOfAsset = append(utxosOfAsset, u)
+		}
+	}
+
+	coins, total, err := selector.Select(utxosOfAsset, targetAmount)
+	if err != nil {
+		return nil, 0, err
+	}
+	return coins, total - targetAmount, nil
+}
+
+// selectUnspentsAvoidingDust is like selectUnspents, but if the change would
+// be dust, ie. lower than the given threshold, it looks for another set of
+// coins leaving either no change or one not lower than the threshold. If there
+// isn't any, the first set is returned, along with its dust change.
+func selectUnspentsAvoidingDust(
+	selector CoinSelector,
+	utxos []explorer.Utxo,
+	targetAmount uint64,
+	targetAsset string,
+	dustThreshold uint64,
+) ([]explorer.Utxo, uint64, error) {
+	coins, change, err := selectUnspents(selector, utxos, targetAmount, targetAsset)
+	if err != nil || !isDust(change, dustThreshold) {
+		return coins, change, err
+	}
+
+	// covering the target plus the threshold leaves a change above the latter
+	if c, ch, err := selectUnspents(
+		selector, utxos, targetAmount+dustThreshold, targetAsset,
+	); err == nil {
+		return c, ch + dustThreshold, nil
+	}
+	// otherwise, look for the combination of coins with the smallest change,
+	// that might match the target exactly, still within the max number of
+	// inputs, if any.
+	var fallback CoinSelector = branchAndBoundSelector{}
+	if s, ok := selector.(maxInputsSelector); ok {
+		fallback = maxInputsSelector{fallback, s.maxInputs}
+	}
+	if c, ch, err := selectUnspents(
+		fallback, utxos, targetAmount, targetAsset,
+	); err == nil && !isDust(ch, dustThreshold) {
+		return c, ch, nil
+	}
+	return coins, change, nil
+}
+
+func isDust(amount, dustThreshold uint64) bool {
+	return amount > 0 && amount < dustThreshold
+}
@@ -0,0 +1,161 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer"
+	"github.com/tdex-network/tdex-daemon/pkg/explorer/esplora"
+)
+
+func TestCoinSelectors(t *testing.T) {
+	utxos := mockUtxosWithValues(1000, 5000, 300, 2000, 700)
+
+	tests := []struct {
+		strategy       string
+		target         uint64
+		expectedValues []uint64
+	}{
+		{CoinSelectionLargestFirst, 5500, []uint64{5000, 2000}},
+		{CoinSelectionSmallestFirst, 1500, []uint64{300, 700, 1000}},
+		{CoinSelectionBranchAndBound, 3000, []uint64{2000, 1000}},
+		{CoinSelectionBranchAndBound, 1900, []uint64{2000}},
+		{CoinSelectionBranchAndBound, 9000, []uint64{5000, 2000, 1000, 700, 300}},
+	}
+
+	for _, tt := range tests {
+		selector, err := NewCoinSelector(tt.strategy)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		coins, total, err := selector.Select(utxos, tt.target)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		values := make([]uint64, 0, len(coins))
+		expectedTotal := uint64(0)
+		for _, c := range coins {
+			values = append(values, c.Value())
+		}
+		for _, v := range tt.expectedValues {
+			expectedTotal += v
+		}
+		assert.ElementsMatch(t, tt.expectedValues, values, tt.strategy)
+		assert.Equal(t, expectedTotal, total, tt.strategy)
+	}
+}
+
+func TestFailingCoinSelectors(t *testing.T) {
+	_, err := NewCoinSelector("random")
+	assert.Equal(t, ErrInvalidCoinSelectionStrategy, err)
+
+	utxos := mockUtxosWithValues(1000, 2000)
+	strategies := []string{
+		CoinSelectionLargestFirst,
+		CoinSelectionSmallestFirst,
+		CoinSelectionBranchAndBound,
+	}
+	for _, strategy := range strategies {
+		selector, _ := NewCoinSelector(strategy)
+		_, _, err := selector.Select(utxos, 3001)
+		assert.Equal(t, ErrInsufficientFunds, err, strategy)
+	}
+}
+
+func TestMaxInputsCoinSelector(t *testing.T) {
+	utxos := mockUtxosWithValues(1000, 5000, 300, 2000, 700)
+	smallestFirst, _ := NewCoinSelector(CoinSelectionSmallestFirst)
+
+	tests := []struct {
+		selector       CoinSelector
+		maxInputs      int
+		target         uint64
+		expectedValues []uint64
+	}{
+		// the given selector is within the limit
+		{smallestFirst, 3, 1500, []uint64{300, 700, 1000}},
+		// the given selector exceeds the limit, the largest coins are selected
+		{smallestFirst, 2, 1500, []uint64{5000}},
+		// the default strategy is used if no selector is given
+		{nil, 2, 6000, []uint64{5000, 2000}},
+	}
+
+	for _, tt := range tests {
+		selector := NewMaxInputsCoinSelector(tt.selector, tt.maxInputs)
+		coins, total, err := selector.Select(utxos, tt.target)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		values := make([]uint64, 0, len(coins))
+		expectedTotal := uint64(0)
+		for _, c := range coins {
+			values = append(values, c.Value())
+		}
+		for _, v := range tt.expectedValues {
+			expectedTotal += v
+		}
+		assert.ElementsMatch(t, tt.expectedValues, values)
+		assert.Equal(t, expectedTotal, total)
+	}
+
+	selector := NewMaxInputsCoinSelector(smallestFirst, 2)
+	_, _, err := selector.Select(utxos, 8500)
+	assert.Equal(t, ErrTooManyInputs, err)
+
+	_, _, err = selector.Select(utxos, 10000)
+	assert.Equal(t, ErrInsufficientFunds, err)
+}
+
+func TestSelectUnspentsAvoidingDust(t *testing.T) {
+	lbtc := "5ac9f65c0efcc4775e0baec4ec03abdde22473cd3cf33c0419ca290e0751b225"
+	selector, _ := NewCoinSelector(CoinSelectionSmallestFirst)
+
+	tests := []struct {
+		utxos          []explorer.Utxo
+		target         uint64
+		expectedValues []uint64
+		expectedChange uint64
+	}{
+		// 1000 would leave a dust change of 100, the next coin is added
+		{mockUtxosWithValues(1000, 5000), 900, []uint64{1000, 5000}, 5100},
+		// all coins would leave a dust change, an exact match is preferred
+		{mockUtxosWithValues(400, 800, 1200), 2000, []uint64{800, 1200}, 0},
+		// a change above the threshold is left untouched
+		{mockUtxosWithValues(1000, 5000), 500, []uint64{1000}, 500},
+		// the dust change can't be avoided
+		{mockUtxosWithValues(1000), 900, []uint64{1000}, 100},
+	}
+
+	for _, tt := range tests {
+		coins, change, err := selectUnspentsAvoidingDust(
+			selector, tt.utxos, tt.target, lbtc, 500,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		values := make([]uint64, 0, len(coins))
+		for _, c := range coins {
+			values = append(values, c.Value())
+		}
+		assert.ElementsMatch(t, tt.expectedValues, values)
+		assert.Equal(t, tt.expectedChange, change)
+	}
+}
+
+func mockUtxosWithValues(values ...uint64) []explorer.Utxo {
+	utxos := make([]explorer.Utxo, 0, len(values))
+	for i, v := range values {
+		utxos = append(utxos, esplora.NewUnconfidentialWitnessUtxo(
+			"b83173f53cc0b0bb6de9faa2a11f0311040e61ded5529cecdb07a8efa7aa84db",
+			uint32(i),
+			v,
+			"5ac9f65c0efcc4775e0baec4ec03abdde22473cd3cf33c0419ca290e0751b225",
+			h2b("0014e12851aa15a3eea94587a6f11d818d1e7809764c"),
+		))
+	}
+	return utxos
+}
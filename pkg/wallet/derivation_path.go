@@ -13,6 +13,10 @@ import (
 // deterministic wallet account
 type DerivationPath []uint32
 
+// DefaultGapLimit is the default number of consecutive unused addresses of an
+// account to look up before considering it fully discovered
+const DefaultGapLimit = 20
+
 var (
 	// DefaultBaseDerivationPath m/84'/0'
 	DefaultBaseDerivationPath = DerivationPath{
@@ -57,6 +57,12 @@ var (
 	ErrInvalidDerivationPathLength = errors.New(
 		"derivation path must be a relative path in the form \"account'/branch/index\"",
 	)
+	// ErrInvalidBaseDerivationPath ...
+	ErrInvalidBaseDerivationPath = errors.New(
+		"base derivation path must be a valid path like \"m/84'/0'\"",
+	)
+	// ErrInvalidGapLimit ...
+	ErrInvalidGapLimit = errors.New("gap limit must not be a negative number")
 	// ErrInvalidDerivationPathAccount ...
 	ErrInvalidDerivationPathAccount = errors.New(
 		"derivation path's account (first elem) must be hardened (suffix \"'\")",
@@ -94,6 +100,17 @@ var (
 	ErrInvalidInBlindingKey = errors.New("unable to recover input blinding data with provided key")
 	// ErrInvalidOutBlindingKey ...
 	ErrInvalidOutBlindingKey = errors.New("unable to recover output blinding data with provided key")
+	// ErrInvalidMultisigPubKeys ...
+	ErrInvalidMultisigPubKeys = fmt.Errorf(
+		"number of multisig pubkeys must be in range [1, %d]",
+		MaxMultisigKeys,
+	)
+	// ErrInvalidMultisigThreshold ...
+	ErrInvalidMultisigThreshold = errors.New(
+		"multisig threshold must be in range [1, number of pubkeys]",
+	)
+	// ErrInvalidMultisigScript ...
+	ErrInvalidMultisigScript = errors.New("witness script must be a multisig script")
 
 	// ErrEmptyDerivationPaths ...
 	ErrEmptyDerivationPaths = errors.New("derivation path list must not be empty")
@@ -124,4 +141,26 @@ var (
 	ErrMissingInBlindingKey = errors.New("missing blinding key for input")
 	// ErrMissingOutBlindingKey ...
 	ErrMissingOutBlindingKey = errors.New("missing blinding key for output")
+	// ErrMissingInBlindingData ...
+	ErrMissingInBlindingData = errors.New("missing blinding data for input")
+	// ErrMissingOutBlindingData ...
+	ErrMissingOutBlindingData = errors.New("missing blinding data for output")
+	// ErrConfidentialFeeOutput ...
+	ErrConfidentialFeeOutput = errors.New("fee output must not be confidential")
+
+	// ErrMultisigScriptMismatch ...
+	ErrMultisigScriptMismatch = errors.New(
+		"witness script does not match the script of the input witness utxo",
+	)
+	// ErrMultisigKeyNotFound ...
+	ErrMultisigKeyNotFound = errors.New(
+		"signing pubkey not found in the multisig witness script",
+	)
+
+	// ErrAddressNetworkMismatch ...
+	ErrAddressNetworkMismatch = errors.New(
+		"address does not belong to the given network",
+	)
+	// ErrUnsupportedAddressType ...
+	ErrUnsupportedAddressType = errors.New("unsupported address type")
 )
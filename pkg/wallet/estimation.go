@@ -1,5 +1,7 @@
 package wallet
 
+import "github.com/vulpemventures/go-elements/pset"
+
 const (
 	P2PK = iota
 	P2PKH
@@ -37,6 +39,25 @@ func EstimateTxSize(
 	return vsize
 }
 
+// EstimateFeeAmount makes an estimation of the network fees of the given
+// partial transaction, at the given rate in millisatoshi per byte, as if no
+// other input or output but the explicit fee one was going to be added.
+func EstimateFeeAmount(psetBase64 string, milliSatsPerByte uint64) (uint64, error) {
+	ptx, err := pset.NewPsetFromBase64(psetBase64)
+	if err != nil {
+		return 0, err
+	}
+
+	inScriptTypes, inAuxiliaryRedeemScriptSize, inAuxiliaryWitnessSize,
+		outScriptTypes, outAuxiliaryRedeemScriptSize := extractScriptTypesFromPset(ptx)
+	txSize := EstimateTxSize(
+		inScriptTypes, inAuxiliaryRedeemScriptSize, inAuxiliaryWitnessSize,
+		outScriptTypes, outAuxiliaryRedeemScriptSize,
+	)
+	millisatsPerByte := float64(milliSatsPerByte) / 1000
+	return uint64(float64(txSize) * millisatsPerByte), nil
+}
+
 func calcTxSize(
 	withWitness bool,
 	inScriptTypes, inAuxiliaryRedeemScriptSize, inAuxiliaryWitnessSize,
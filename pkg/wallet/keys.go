@@ -1,6 +1,8 @@
 package wallet
 
 import (
+	"encoding/hex"
+
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/btcsuite/btcutil/hdkeychain"
@@ -76,6 +78,19 @@ func (w *Wallet) ExtendedPublicKey(opts ExtendedKeyOpts) (string, error) {
 	return xpub.String(), nil
 }
 
+// MasterBlindingKey returns the hex encoded SLIP77 master blinding key, from
+// which the blinding private keys of all the scripts of the wallet derive.
+// It allows to unblind the outputs of the wallet, not to spend them.
+func (w *Wallet) MasterBlindingKey() (string, error) {
+	if err := w.validate(); err != nil {
+		return "", err
+	}
+	if len(w.blindingMasterKey) <= 0 {
+		return "", ErrNullBlindingMasterKey
+	}
+	return hex.EncodeToString(w.blindingMasterKey), nil
+}
+
 // DeriveSigningKeyPairOpts is the struct given to DeriveSigningKeyPair method
 type DeriveSigningKeyPairOpts struct {
 	DerivationPath string
@@ -170,10 +185,28 @@ func (w *Wallet) DeriveBlindingKeyPair(opts DeriveBlindingKeyPairOpts) (
 	return slip77Node.DeriveKey(opts.Script)
 }
 
+// BlindingKeyByScript returns the serialized SLIP77 blinding key pair of the
+// provided output script. Keys are deterministically derived from the master
+// blinding key, the same way Elements Core and Blockstream Green do, therefore
+// they can always be recovered from the mnemonic only.
+// Both keys are nil in case the derivation fails.
+func (w *Wallet) BlindingKeyByScript(script []byte) (priv, pub []byte) {
+	prvkey, pubkey, err := w.DeriveBlindingKeyPair(DeriveBlindingKeyPairOpts{
+		Script: script,
+	})
+	if err != nil {
+		return nil, nil
+	}
+	return prvkey.Serialize(), pubkey.SerializeCompressed()
+}
+
 // DeriveConfidentialAddressOpts is the struct given to DeriveConfidentialAddress method
 type DeriveConfidentialAddressOpts struct {
 	DerivationPath string
 	Network        *network.Network
+	// Nested makes the address a P2SH-P2WPKH one, with the segwit program
+	// nested in a P2SH script, instead of a native P2WPKH one.
+	Nested bool
 }
 
 func (o DeriveConfidentialAddressOpts) validate() error {
@@ -214,6 +247,9 @@ func (w *Wallet) DeriveConfidentialAddress(
 	}
 
 	script := payment.FromPublicKey(pubkey, opts.Network, nil).WitnessScript
+	if opts.Nested {
+		script = NestedWitnessScript(script)
+	}
 
 	_, blindingPubkey, err := w.DeriveBlindingKeyPair(DeriveBlindingKeyPairOpts{
 		Script: script,
@@ -223,6 +259,18 @@ func (w *Wallet) DeriveConfidentialAddress(
 	}
 
 	p2wpkh := payment.FromPublicKey(pubkey, opts.Network, blindingPubkey)
+	if opts.Nested {
+		p2sh, err := payment.FromPayment(p2wpkh)
+		if err != nil {
+			return "", nil, err
+		}
+		addr, err := p2sh.ConfidentialScriptHash()
+		if err != nil {
+			return "", nil, err
+		}
+		return addr, p2sh.Script, nil
+	}
+
 	addr, err := p2wpkh.ConfidentialWitnessPubKeyHash()
 	if err != nil {
 		return "", nil, err
@@ -2,9 +2,11 @@ package wallet
 
 import (
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/vulpemventures/go-elements/address"
 	"github.com/vulpemventures/go-elements/network"
 )
 
@@ -63,6 +65,61 @@ func TestDeriveBlindingKeyPair(t *testing.T) {
 	assert.NotNil(t, blindingPubkey)
 }
 
+func TestBlindingKeyByScript(t *testing.T) {
+	// SLIP77 test vector
+	mnemonic := strings.Split(
+		"all all all all all all all all all all all all", " ",
+	)
+	wallet, err := NewWalletFromMnemonic(NewWalletFromMnemonicOpts{
+		SigningMnemonic: mnemonic,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	script, _ := hex.DecodeString(
+		"76a914a579388225827d9f2fe9014add644487808c695d88ac",
+	)
+
+	prvkey, pubkey := wallet.BlindingKeyByScript(script)
+	assert.Equal(
+		t,
+		"4e6e94df28448c7bb159271fe546da464ea863b3887d2eec6afd841184b70592",
+		hex.EncodeToString(prvkey),
+	)
+	assert.Equal(
+		t,
+		"0223ef5cf5d1185f86204b9386c8541061a24b6f72fa4a29e3a0b60e1c20ffaf5b",
+		hex.EncodeToString(pubkey),
+	)
+
+	prvkey, pubkey = wallet.BlindingKeyByScript(nil)
+	assert.Nil(t, prvkey)
+	assert.Nil(t, pubkey)
+}
+
+func TestMasterBlindingKey(t *testing.T) {
+	// SLIP77 test vector
+	mnemonic := strings.Split(
+		"all all all all all all all all all all all all", " ",
+	)
+	wallet, err := NewWalletFromMnemonic(NewWalletFromMnemonicOpts{
+		SigningMnemonic: mnemonic,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	masterBlindingKey, err := wallet.MasterBlindingKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(
+		t,
+		"6c2de18eabeff3f7822bc724ad482bef0557f3e1c1e1c75b7a393a5ced4de616",
+		masterBlindingKey,
+	)
+}
+
 func TestFailingExtendedKey(t *testing.T) {
 	wallet, err := newTestWallet()
 	if err != nil {
@@ -163,6 +220,34 @@ func TestDeriveConfidentialAddress(t *testing.T) {
 	assert.Equal(t, true, len(script) > 0)
 }
 
+func TestDeriveNestedConfidentialAddress(t *testing.T) {
+	wallet, err := newTestWallet()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := DeriveConfidentialAddressOpts{
+		DerivationPath: "0'/0/0",
+		Network:        &network.Liquid,
+		Nested:         true,
+	}
+	ctAddress, script, err := wallet.DeriveConfidentialAddress(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsedScript, blindingPubkey, err := ParseAddress(ctAddress, &network.Liquid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, expectedBlindingPubkey, _ := wallet.DeriveBlindingKeyPair(
+		DeriveBlindingKeyPairOpts{Script: script},
+	)
+	assert.Equal(t, script, parsedScript)
+	assert.Equal(t, expectedBlindingPubkey.SerializeCompressed(), blindingPubkey)
+	assert.Equal(t, address.P2ShScript, address.GetScriptType(script))
+}
+
 func TestFailingDeriveConfidentialAddress(t *testing.T) {
 	wallet, err := newTestWallet()
 	if err != nil {
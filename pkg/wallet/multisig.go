@@ -0,0 +1,325 @@
+package wallet
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/payment"
+	"github.com/vulpemventures/go-elements/pset"
+)
+
+const (
+	// MaxMultisigKeys is the max number of public keys of a standard multisig
+	// witness script
+	MaxMultisigKeys = 15
+)
+
+// MultisigScriptOpts is the struct given to MultisigScript function
+type MultisigScriptOpts struct {
+	Threshold int
+	PubKeys   [][]byte
+}
+
+func (o MultisigScriptOpts) validate() error {
+	if len(o.PubKeys) <= 0 || len(o.PubKeys) > MaxMultisigKeys {
+		return ErrInvalidMultisigPubKeys
+	}
+	if o.Threshold <= 0 || o.Threshold > len(o.PubKeys) {
+		return ErrInvalidMultisigThreshold
+	}
+	for i, key := range o.PubKeys {
+		if _, err := btcec.ParsePubKey(key, btcec.S256()); err != nil {
+			return fmt.Errorf("invalid pubkey %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// MultisigScript returns the threshold-of-n CHECKMULTISIG witness script of
+// the provided compressed pubkeys. Keys are sorted lexicographically (BIP67)
+// so that every cosigner builds the same script regardless of their order.
+func MultisigScript(opts MultisigScriptOpts) ([]byte, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	pubkeys := make([][]byte, len(opts.PubKeys))
+	copy(pubkeys, opts.PubKeys)
+	sort.Slice(pubkeys, func(i, j int) bool {
+		return bytes.Compare(pubkeys[i], pubkeys[j]) < 0
+	})
+
+	builder := txscript.NewScriptBuilder().AddInt64(int64(opts.Threshold))
+	for _, key := range pubkeys {
+		builder.AddData(key)
+	}
+	builder.AddInt64(int64(len(pubkeys)))
+	builder.AddOp(txscript.OP_CHECKMULTISIG)
+	return builder.Script()
+}
+
+// IsMultisigScript returns whether the given script is a CHECKMULTISIG one.
+func IsMultisigScript(script []byte) bool {
+	return txscript.GetScriptClass(script) == txscript.MultiSigTy
+}
+
+// MultisigOutputScript returns the P2WSH output script locking the funds to
+// the given multisig witness script.
+func MultisigOutputScript(witnessScript []byte) ([]byte, error) {
+	redeem, err := payment.FromScript(witnessScript, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	p2wsh, err := payment.FromPayment(redeem)
+	if err != nil {
+		return nil, err
+	}
+	return p2wsh.WitnessScript, nil
+}
+
+// AccountExtendedPublicKey returns the signing extended public key in base58
+// format of the hardened account with the provided index, that is the one to
+// share with the cosigners of a multisig account.
+func (w *Wallet) AccountExtendedPublicKey(opts ExtendedKeyOpts) (string, error) {
+	if err := opts.validate(); err != nil {
+		return "", err
+	}
+	if err := w.validate(); err != nil {
+		return "", err
+	}
+
+	masterKey, err := hdkeychain.NewKeyFromString(
+		base58.Encode(w.signingMasterKey),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	xprv, err := masterKey.Child(opts.Account + hdkeychain.HardenedKeyStart)
+	if err != nil {
+		return "", err
+	}
+
+	xpub, err := xprv.Neuter()
+	if err != nil {
+		return "", err
+	}
+	return xpub.String(), nil
+}
+
+// DeriveMultisigConfidentialAddressOpts is the struct given to
+// DeriveMultisigConfidentialAddress method.
+// CosignerXPubs are the account extended public keys of the other signers,
+// as returned by their AccountExtendedPublicKey method for the account of the
+// derivation path.
+type DeriveMultisigConfidentialAddressOpts struct {
+	DerivationPath string
+	CosignerXPubs  []string
+	Threshold      int
+	Network        *network.Network
+}
+
+func (o DeriveMultisigConfidentialAddressOpts) validate() error {
+	derivationPath, err := ParseDerivationPath(o.DerivationPath)
+	if err != nil {
+		return err
+	}
+	if err := checkDerivationPath(derivationPath); err != nil {
+		return err
+	}
+	if len(o.CosignerXPubs) <= 0 || len(o.CosignerXPubs) >= MaxMultisigKeys {
+		return ErrInvalidMultisigPubKeys
+	}
+	if o.Threshold <= 0 || o.Threshold > len(o.CosignerXPubs)+1 {
+		return ErrInvalidMultisigThreshold
+	}
+	for i, xpub := range o.CosignerXPubs {
+		key, err := hdkeychain.NewKeyFromString(xpub)
+		if err != nil {
+			return fmt.Errorf("invalid cosigner xpub %d: %v", i, err)
+		}
+		if key.IsPrivate() {
+			return fmt.Errorf("cosigner xpub %d must not be private", i)
+		}
+	}
+	if o.Network == nil {
+		return ErrNullNetwork
+	}
+	return nil
+}
+
+// DeriveMultisigConfidentialAddress derives the signing pubkey of the wallet
+// and those of the cosigners for the provided derivation path, and returns
+// the confidential P2WSH address of their multisig witness script, along with
+// the output and the witness scripts. The blinding key is derived from the
+// output script, like for single-key addresses.
+// The accounts of the daemon's vault are single-key ones, therefore this is
+// meant for external tools sharing a wallet with the cosigners, not for
+// deriving the addresses of market accounts.
+func (w *Wallet) DeriveMultisigConfidentialAddress(
+	opts DeriveMultisigConfidentialAddressOpts,
+) (addr string, script, witnessScript []byte, err error) {
+	if err = opts.validate(); err != nil {
+		return
+	}
+	if err = w.validate(); err != nil {
+		return
+	}
+
+	_, pubkey, err := w.DeriveSigningKeyPair(DeriveSigningKeyPairOpts{
+		DerivationPath: opts.DerivationPath,
+	})
+	if err != nil {
+		return
+	}
+
+	derivationPath, _ := ParseDerivationPath(opts.DerivationPath)
+	pubkeys := [][]byte{pubkey.SerializeCompressed()}
+	for _, xpub := range opts.CosignerXPubs {
+		hdNode, _ := hdkeychain.NewKeyFromString(xpub)
+		// the account is the hardened step the xpub was derived at.
+		for _, step := range derivationPath[1:] {
+			hdNode, err = hdNode.Child(step)
+			if err != nil {
+				return
+			}
+		}
+		var cosignerPubkey *btcec.PublicKey
+		cosignerPubkey, err = hdNode.ECPubKey()
+		if err != nil {
+			return
+		}
+		pubkeys = append(pubkeys, cosignerPubkey.SerializeCompressed())
+	}
+
+	witnessScript, err = MultisigScript(MultisigScriptOpts{
+		Threshold: opts.Threshold,
+		PubKeys:   pubkeys,
+	})
+	if err != nil {
+		return
+	}
+	script, err = MultisigOutputScript(witnessScript)
+	if err != nil {
+		return
+	}
+
+	_, blindingPubkey, err := w.DeriveBlindingKeyPair(DeriveBlindingKeyPairOpts{
+		Script: script,
+	})
+	if err != nil {
+		return
+	}
+
+	redeem, err := payment.FromScript(witnessScript, opts.Network, blindingPubkey)
+	if err != nil {
+		return
+	}
+	p2wsh, err := payment.FromPayment(redeem)
+	if err != nil {
+		return
+	}
+	addr, err = p2wsh.ConfidentialWitnessScriptHash()
+	return
+}
+
+// SignMultisigInputOpts is the struct given to SignMultisigInput method
+type SignMultisigInputOpts struct {
+	PsetBase64     string
+	InIndex        uint32
+	DerivationPath string
+	WitnessScript  []byte
+}
+
+func (o SignMultisigInputOpts) validate() error {
+	if err := (SignInputOpts{
+		PsetBase64:     o.PsetBase64,
+		InIndex:        o.InIndex,
+		DerivationPath: o.DerivationPath,
+	}).validate(); err != nil {
+		return err
+	}
+	if !IsMultisigScript(o.WitnessScript) {
+		return ErrInvalidMultisigScript
+	}
+
+	ptx, _ := pset.NewPsetFromBase64(o.PsetBase64)
+	script, err := MultisigOutputScript(o.WitnessScript)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(ptx.Inputs[o.InIndex].WitnessUtxo.Script, script) {
+		return ErrMultisigScriptMismatch
+	}
+	return nil
+}
+
+// SignMultisigInput adds the signature of the wallet to the given multisig
+// input of a partial transaction, along with its witness script. The
+// transaction can be finalized once the threshold of signatures is reached,
+// therefore it's meant to be passed around the cosigners until then.
+// Fills and withdrawals of the daemon never spend multisig inputs, so they're
+// always fully signed by it instead.
+func (w *Wallet) SignMultisigInput(opts SignMultisigInputOpts) (string, error) {
+	if err := opts.validate(); err != nil {
+		return "", err
+	}
+	if err := w.validate(); err != nil {
+		return "", err
+	}
+
+	ptx, _ := pset.NewPsetFromBase64(opts.PsetBase64)
+	inIndex := int(opts.InIndex)
+	updater, err := pset.NewUpdater(ptx)
+	if err != nil {
+		return "", err
+	}
+
+	prvkey, pubkey, err := w.DeriveSigningKeyPair(DeriveSigningKeyPairOpts{
+		DerivationPath: opts.DerivationPath,
+	})
+	if err != nil {
+		return "", err
+	}
+	if !bytes.Contains(opts.WitnessScript, pubkey.SerializeCompressed()) {
+		return "", ErrMultisigKeyNotFound
+	}
+
+	hashForSignature := ptx.UnsignedTx.HashForWitnessV0(
+		inIndex,
+		opts.WitnessScript,
+		ptx.Inputs[inIndex].WitnessUtxo.Value,
+		txscript.SigHashAll,
+	)
+
+	signature, err := prvkey.Sign(hashForSignature[:])
+	if err != nil {
+		return "", err
+	}
+
+	if !signature.Verify(hashForSignature[:], pubkey) {
+		return "", fmt.Errorf(
+			"signature verification failed for input %d",
+			inIndex,
+		)
+	}
+
+	sigWithSigHashType := append(signature.Serialize(), byte(txscript.SigHashAll))
+	if _, err := updater.Sign(
+		inIndex,
+		sigWithSigHashType,
+		pubkey.SerializeCompressed(),
+		nil,
+		opts.WitnessScript,
+	); err != nil {
+		return "", err
+	}
+
+	return ptx.ToBase64()
+}
@@ -0,0 +1,179 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/pkg/bufferutil"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/pset"
+	"github.com/vulpemventures/go-elements/transaction"
+)
+
+func TestMultisig(t *testing.T) {
+	derivationPath := "0'/0/0"
+	wallets := make([]*Wallet, 0, 3)
+	xpubs := make([]string, 0, 3)
+	for i := 0; i < 3; i++ {
+		w, err := newTestWallet()
+		require.NoError(t, err)
+		xpub, err := w.AccountExtendedPublicKey(ExtendedKeyOpts{Account: 0})
+		require.NoError(t, err)
+		wallets = append(wallets, w)
+		xpubs = append(xpubs, xpub)
+	}
+
+	// every cosigner must derive the same 2-of-3 address.
+	var script, witnessScript []byte
+	for i, w := range wallets {
+		cosignerXPubs := make([]string, 0, 2)
+		for j, xpub := range xpubs {
+			if j != i {
+				cosignerXPubs = append(cosignerXPubs, xpub)
+			}
+		}
+		addr, s, ws, err := w.DeriveMultisigConfidentialAddress(
+			DeriveMultisigConfidentialAddressOpts{
+				DerivationPath: derivationPath,
+				CosignerXPubs:  cosignerXPubs,
+				Threshold:      2,
+				Network:        &network.Regtest,
+			},
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, addr)
+		require.True(t, IsMultisigScript(ws))
+		if i > 0 {
+			require.Equal(t, script, s)
+			require.Equal(t, witnessScript, ws)
+		}
+		script, witnessScript = s, ws
+	}
+
+	psetBase64 := newTestMultisigPset(t, script)
+	for _, w := range wallets[:2] {
+		signedPset, err := w.SignMultisigInput(SignMultisigInputOpts{
+			PsetBase64:     psetBase64,
+			InIndex:        0,
+			DerivationPath: derivationPath,
+			WitnessScript:  witnessScript,
+		})
+		require.NoError(t, err)
+		psetBase64 = signedPset
+	}
+
+	ptx, err := pset.NewPsetFromBase64(psetBase64)
+	require.NoError(t, err)
+	require.Len(t, ptx.Inputs[0].PartialSigs, 2)
+	ok, err := ptx.ValidateAllSignatures()
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, pset.FinalizeAll(ptx))
+	tx, err := pset.Extract(ptx)
+	require.NoError(t, err)
+	require.Len(t, tx.Inputs[0].Witness, 4)
+	require.Equal(t, witnessScript, tx.Inputs[0].Witness[3])
+}
+
+func TestFailingMultisig(t *testing.T) {
+	w, err := newTestWallet()
+	require.NoError(t, err)
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	pubkey := key.PubKey().SerializeCompressed()
+
+	scriptTests := []struct {
+		opts MultisigScriptOpts
+		err  error
+	}{
+		{
+			opts: MultisigScriptOpts{Threshold: 1},
+			err:  ErrInvalidMultisigPubKeys,
+		},
+		{
+			opts: MultisigScriptOpts{Threshold: 2, PubKeys: [][]byte{pubkey}},
+			err:  ErrInvalidMultisigThreshold,
+		},
+	}
+	for _, tt := range scriptTests {
+		_, err := MultisigScript(tt.opts)
+		assert.Equal(t, tt.err, err)
+	}
+
+	witnessScript, err := MultisigScript(MultisigScriptOpts{
+		Threshold: 1,
+		PubKeys:   [][]byte{pubkey},
+	})
+	require.NoError(t, err)
+	script, err := MultisigOutputScript(witnessScript)
+	require.NoError(t, err)
+	otherScript, err := MultisigOutputScript(append(
+		[]byte{}, witnessScript[:len(witnessScript)-2]...,
+	))
+	require.NoError(t, err)
+
+	signTests := []struct {
+		opts SignMultisigInputOpts
+		err  error
+	}{
+		{
+			opts: SignMultisigInputOpts{
+				PsetBase64:     newTestMultisigPset(t, script),
+				DerivationPath: "0'/0/0",
+				WitnessScript:  script,
+			},
+			err: ErrInvalidMultisigScript,
+		},
+		{
+			opts: SignMultisigInputOpts{
+				PsetBase64:     newTestMultisigPset(t, otherScript),
+				DerivationPath: "0'/0/0",
+				WitnessScript:  witnessScript,
+			},
+			err: ErrMultisigScriptMismatch,
+		},
+		{
+			opts: SignMultisigInputOpts{
+				PsetBase64:     newTestMultisigPset(t, script),
+				DerivationPath: "0'/0/0",
+				WitnessScript:  witnessScript,
+			},
+			err: ErrMultisigKeyNotFound,
+		},
+	}
+	for _, tt := range signTests {
+		_, err := w.SignMultisigInput(tt.opts)
+		assert.Equal(t, tt.err, err)
+	}
+}
+
+func newTestMultisigPset(t *testing.T, script []byte) string {
+	asset, err := bufferutil.AssetHashToBytes(network.Regtest.AssetID)
+	require.NoError(t, err)
+	value, err := elementsutil.SatoshiToElementsValue(100000000)
+	require.NoError(t, err)
+	outValue, err := elementsutil.SatoshiToElementsValue(99999500)
+	require.NoError(t, err)
+
+	ptx, err := pset.New(
+		[]*transaction.TxInput{transaction.NewTxInput(make([]byte, 32), 0)},
+		[]*transaction.TxOutput{transaction.NewTxOutput(asset, outValue, script)},
+		2,
+		0,
+	)
+	require.NoError(t, err)
+	updater, err := pset.NewUpdater(ptx)
+	require.NoError(t, err)
+	err = updater.AddInWitnessUtxo(
+		transaction.NewTxOutput(asset, value, script), 0,
+	)
+	require.NoError(t, err)
+
+	psetBase64, err := ptx.ToBase64()
+	require.NoError(t, err)
+	return psetBase64
+}
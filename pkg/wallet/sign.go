@@ -1,10 +1,12 @@
 package wallet
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 
 	"github.com/btcsuite/btcd/txscript"
+	"github.com/vulpemventures/go-elements/address"
 	"github.com/vulpemventures/go-elements/payment"
 	"github.com/vulpemventures/go-elements/pset"
 )
@@ -149,7 +151,21 @@ func (w *Wallet) signInput(ptx *pset.Pset, inIndex int, derivationPath string) e
 		return err
 	}
 
-	pay, err := payment.FromScript(ptx.Inputs[inIndex].WitnessUtxo.Script, nil, nil)
+	prevoutScript := ptx.Inputs[inIndex].WitnessUtxo.Script
+	// P2SH-P2WPKH inputs are signed with the script code of the nested
+	// P2WPKH script, that is added to the input as redeem script.
+	var redeemScript []byte
+	if address.GetScriptType(prevoutScript) == address.P2ShScript {
+		redeemScript = payment.FromPublicKey(pubkey, nil, nil).WitnessScript
+		if !bytes.Equal(NestedWitnessScript(redeemScript), prevoutScript) {
+			return fmt.Errorf(
+				"unsupported script type for input %d", inIndex,
+			)
+		}
+		prevoutScript = redeemScript
+	}
+
+	pay, err := payment.FromScript(prevoutScript, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -179,7 +195,7 @@ func (w *Wallet) signInput(ptx *pset.Pset, inIndex int, derivationPath string) e
 		inIndex,
 		sigWithSigHashType,
 		pubkey.SerializeCompressed(),
-		nil,
+		redeemScript,
 		nil,
 	)
 	if err != nil {
@@ -31,6 +31,11 @@ type UpdateSwapTxOpts struct {
 	OutputDerivationPath string
 	ChangeDerivationPath string
 	Network              *network.Network
+	// CoinSelector is optional, defaults to explorer.SelectUnspents' strategy
+	CoinSelector CoinSelector
+	// DustThreshold is optional. If set, coins are selected so that the change
+	// is not lower than it, whenever possible
+	DustThreshold uint64
 }
 
 func (o UpdateSwapTxOpts) validate() error {
@@ -140,10 +145,12 @@ func (w *Wallet) UpdateSwapTx(opts UpdateSwapTxOpts) (string, []explorer.Utxo, e
 
 	ptx, _ := pset.NewPsetFromBase64(opts.PsetBase64)
 
-	selectedUnspents, change, err := explorer.SelectUnspents(
+	selectedUnspents, change, err := selectUnspentsAvoidingDust(
+		opts.CoinSelector,
 		opts.Unspents,
 		opts.InputAmount,
 		opts.InputAsset,
+		opts.DustThreshold,
 	)
 	if err != nil {
 		return "", nil, err
@@ -184,6 +191,13 @@ type UpdateTxOpts struct {
 	Network              *network.Network
 	WantPrivateBlindKeys bool
 	WantChangeForFees    bool
+	// CoinSelector is optional, defaults to explorer.SelectUnspents' strategy
+	CoinSelector CoinSelector
+	// DustThreshold is optional. If set, coins are selected so that the changes
+	// are not lower than it, whenever possible. When WantChangeForFees is set,
+	// an eventual LBTC change lower than the threshold is added to the fee
+	// amount instead of being added as an output
+	DustThreshold uint64
 }
 
 func (o UpdateTxOpts) validate() error {
@@ -318,10 +332,12 @@ func (w *Wallet) UpdateTx(opts UpdateTxOpts) (*UpdateTxResult, error) {
 		// list of outputs to add by adding the change output if necessary
 		for _, asset := range inAssets {
 			if totalAmountsByAsset[asset] > 0 {
-				selectedUnspents, change, err := explorer.SelectUnspents(
+				selectedUnspents, change, err := selectUnspentsAvoidingDust(
+					opts.CoinSelector,
 					opts.Unspents,
 					totalAmountsByAsset[asset],
 					asset,
+					opts.DustThreshold,
 				)
 				if err != nil {
 					return nil, err
@@ -397,22 +413,33 @@ func (w *Wallet) UpdateTx(opts UpdateTxOpts) (*UpdateTxResult, error) {
 				changeOutputIndex := outputIndexByScript(outputsToAdd, lbtcChangeScript)
 				changeAmount := bufferutil.ValueFromBytes(outputsToAdd[changeOutputIndex].Value)
 				if feeAmount < changeAmount {
-					outputsToAdd[changeOutputIndex].Value, _ = bufferutil.ValueToBytes(changeAmount - feeAmount)
+					changeAmount -= feeAmount
 				} else {
 					unspents := getRemainingUnspents(opts.Unspents, inputsToAdd)
-					selectedUnspents, change, err := explorer.SelectUnspents(
+					selectedUnspents, change, err := selectUnspentsAvoidingDust(
+						opts.CoinSelector,
 						unspents,
 						feeAmount,
 						opts.Network.AssetID,
+						opts.DustThreshold,
 					)
 					if err != nil {
 						return nil, err
 					}
 					inputsToAdd = append(inputsToAdd, selectedUnspents...)
+					changeAmount += change
+				}
 
-					if change > 0 {
-						outputsToAdd[changeOutputIndex].Value, _ = bufferutil.ValueToBytes(changeAmount + change)
-					}
+				// a dust change is not worth its output, it's rather added to the fees
+				if isDust(changeAmount, opts.DustThreshold) {
+					feeAmount += changeAmount
+					outputsToAdd = append(
+						outputsToAdd[:changeOutputIndex],
+						outputsToAdd[changeOutputIndex+1:]...,
+					)
+					delete(changeOutputsBlindingKeys, hex.EncodeToString(lbtcChangeScript))
+				} else {
+					outputsToAdd[changeOutputIndex].Value, _ = bufferutil.ValueToBytes(changeAmount)
 				}
 			} else {
 				// In case there's no LBTC change, it's necessary to choose some other
@@ -420,17 +447,21 @@ func (w *Wallet) UpdateTx(opts UpdateTxOpts) (*UpdateTxResult, error) {
 				// inputs to add to the tx and add another output for the eventual change
 				// returned by the coin selection
 				unspents := getRemainingUnspents(opts.Unspents, inputsToAdd)
-				selectedUnspents, change, err := explorer.SelectUnspents(
+				selectedUnspents, change, err := selectUnspentsAvoidingDust(
+					opts.CoinSelector,
 					unspents,
 					feeAmount,
 					opts.Network.AssetID,
+					opts.DustThreshold,
 				)
 				if err != nil {
 					return nil, err
 				}
 				inputsToAdd = append(inputsToAdd, selectedUnspents...)
 
-				if change > 0 {
+				if isDust(change, opts.DustThreshold) {
+					feeAmount += change
+				} else if change > 0 {
 					lbtcChangeOutput, _ := newTxOutput(
 						opts.Network.AssetID,
 						change,
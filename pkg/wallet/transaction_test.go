@@ -414,6 +414,125 @@ func TestUpdateTx(t *testing.T) {
 	}
 }
 
+func TestUpdateSwapTxWithDustThreshold(t *testing.T) {
+	wallet, err := NewWalletFromMnemonic(NewWalletFromMnemonicOpts{
+		SigningMnemonic:  strings.Split("quarter multiply swarm depth slice security flight glad arrow express worth legend wasp mobile anchor dinner mutual six sure wear section delay initial thank", " "),
+		BlindingMnemonic: strings.Split("okay door hammer betray reason zero fiction rigid vivid scorpion thunder crucial focus riot cancel wear autumn rely kangaroo rug raven mystery ability stem", " "),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	psetBase64, err := wallet.CreateTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dustThreshold := uint64(1000)
+	selector, _ := NewCoinSelector(CoinSelectionSmallestFirst)
+
+	updatedPsetBase64, selectedUnspents, err := wallet.UpdateSwapTx(UpdateSwapTxOpts{
+		PsetBase64:           psetBase64,
+		Unspents:             mockUtxosWithValues(10000, 50000),
+		InputAmount:          9500,
+		InputAsset:           network.Regtest.AssetID,
+		OutputAmount:         100000,
+		OutputAsset:          "1adcc1e8564a6f01c957a0f7fcb8badce9c126d790550e6d6817aa752369ae5f",
+		OutputDerivationPath: "0'/0/1",
+		ChangeDerivationPath: "0'/1/0",
+		Network:              &network.Regtest,
+		CoinSelector:         selector,
+		DustThreshold:        dustThreshold,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the smallest coin alone would leave a dust change
+	assert.Equal(t, 2, len(selectedUnspents))
+
+	ptx, _ := pset.NewPsetFromBase64(updatedPsetBase64)
+	assert.Equal(t, 2, len(ptx.Outputs))
+	change := bufferutil.ValueFromBytes(ptx.UnsignedTx.Outputs[1].Value)
+	assert.Equal(t, uint64(50500), change)
+}
+
+func TestUpdateTxWithDustThreshold(t *testing.T) {
+	wallet, err := NewWalletFromMnemonic(NewWalletFromMnemonicOpts{
+		SigningMnemonic:  strings.Split("quarter multiply swarm depth slice security flight glad arrow express worth legend wasp mobile anchor dinner mutual six sure wear section delay initial thank", " "),
+		BlindingMnemonic: strings.Split("okay door hammer betray reason zero fiction rigid vivid scorpion thunder crucial focus riot cancel wear autumn rely kangaroo rug raven mystery ability stem", " "),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	psetBase64, err := wallet.CreateTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dustThreshold := uint64(1000)
+	selector, _ := NewCoinSelector(CoinSelectionSmallestFirst)
+
+	tests := []struct {
+		unspents          []explorer.Utxo
+		outputAmount      float64
+		wantChangeForFees bool
+		expectedOuts      int
+	}{
+		// a dust change is avoided by selecting one coin more
+		{mockUtxosWithValues(10000, 50000), 0.000095, false, 2},
+		// the change left after paying fees is dust, it's added to fees
+		{mockUtxosWithValues(10000), 0.000092, true, 1},
+		// the change of the coins selected for fees is dust, it's added to fees
+		{mockUtxosWithValues(10000, 800), 0.0001, true, 1},
+	}
+
+	for i, tt := range tests {
+		outputs := outputList{
+			{
+				network.Regtest.AssetID,
+				tt.outputAmount,
+				"0014595a242dc9f345268b40cbe669e5d5f746301bb9",
+			},
+		}.TxOutputs()
+
+		res, err := wallet.UpdateTx(UpdateTxOpts{
+			PsetBase64: psetBase64,
+			Unspents:   tt.unspents,
+			Outputs:    outputs,
+			ChangePathsByAsset: map[string]string{
+				network.Regtest.AssetID: "0'/1/1",
+			},
+			MilliSatsPerBytes: 100,
+			Network:           &network.Regtest,
+			WantChangeForFees: tt.wantChangeForFees,
+			CoinSelector:      selector,
+			DustThreshold:     dustThreshold,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ptx, _ := pset.NewPsetFromBase64(res.PsetBase64)
+		assert.Equal(t, tt.expectedOuts, len(ptx.Outputs), i)
+		assert.Equal(t, len(ptx.Outputs)-1, len(res.ChangeOutputsBlindingKeys), i)
+
+		totalIn := uint64(0)
+		for _, u := range res.SelectedUnspents {
+			totalIn += u.Value()
+		}
+		totalOut := res.FeeAmount
+		for j, out := range ptx.UnsignedTx.Outputs {
+			value := bufferutil.ValueFromBytes(out.Value)
+			if j > 0 {
+				assert.GreaterOrEqual(t, value, dustThreshold, i)
+			}
+			totalOut += value
+		}
+		assert.Equal(t, totalIn, totalOut, i)
+	}
+}
+
 func TestFailingUpdateTx(t *testing.T) {
 	tests := []struct {
 		unspents           []explorer.Utxo
@@ -188,7 +188,9 @@ func extractScriptTypesFromPset(ptx *pset.Pset) ([]int, []int, []int, []int, []i
 				scriptLen := len(in.RedeemScript)
 				scriptSize := 1 + (1+72)*m + 1 + varIntSerializeSize(uint64(scriptLen)) + scriptLen
 				inAuxiliaryWitnessSize = append(inAuxiliaryWitnessSize, scriptSize)
-			} else if in.RedeemScript != nil {
+			} else {
+				// the redeem script of nested P2WPKH inputs is added only when
+				// signing, therefore it might be missing here.
 				inScriptTypes[i] = P2SH_P2WPKH
 			}
 			break
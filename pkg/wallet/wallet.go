@@ -8,6 +8,7 @@ type Wallet struct {
 	signingMasterKey  []byte
 	blindingMnemonic  []string
 	blindingMasterKey []byte
+	gapLimit          int
 }
 
 // NewWalletOpts is the struct given to the NewWallet method
@@ -48,6 +49,7 @@ func NewWallet(opts NewWalletOpts) (*Wallet, error) {
 		signingMasterKey:  signingMasterKey,
 		blindingMnemonic:  blindingMnemonic,
 		blindingMasterKey: blindingMasterKey,
+		gapLimit:          DefaultGapLimit,
 	}, nil
 }
 
@@ -55,6 +57,12 @@ func NewWallet(opts NewWalletOpts) (*Wallet, error) {
 type NewWalletFromMnemonicOpts struct {
 	SigningMnemonic  []string
 	BlindingMnemonic []string
+	// BaseDerivationPath is the absolute path of the signing master key the
+	// accounts are derived from. Defaults to DefaultBaseDerivationPath.
+	BaseDerivationPath string
+	// GapLimit is the number of consecutive unused addresses after which an
+	// account is considered fully discovered. Defaults to DefaultGapLimit.
+	GapLimit int
 }
 
 func (o NewWalletFromMnemonicOpts) validate() error {
@@ -69,6 +77,14 @@ func (o NewWalletFromMnemonicOpts) validate() error {
 			return ErrInvalidBlindingMnemonic
 		}
 	}
+	if len(o.BaseDerivationPath) > 0 {
+		if _, err := ParseDerivationPath(o.BaseDerivationPath); err != nil {
+			return ErrInvalidBaseDerivationPath
+		}
+	}
+	if o.GapLimit < 0 {
+		return ErrInvalidGapLimit
+	}
 	return nil
 }
 
@@ -79,10 +95,19 @@ func NewWalletFromMnemonic(opts NewWalletFromMnemonicOpts) (*Wallet, error) {
 		return nil, err
 	}
 
+	baseDerivationPath := DefaultBaseDerivationPath
+	if len(opts.BaseDerivationPath) > 0 {
+		baseDerivationPath, _ = ParseDerivationPath(opts.BaseDerivationPath)
+	}
+	gapLimit := DefaultGapLimit
+	if opts.GapLimit > 0 {
+		gapLimit = opts.GapLimit
+	}
+
 	signingSeed := generateSeedFromMnemonic(opts.SigningMnemonic)
 	signingMasterKey, err := generateSigningMasterKey(
 		signingSeed,
-		DefaultBaseDerivationPath,
+		baseDerivationPath,
 	)
 	if err != nil {
 		return nil, err
@@ -106,6 +131,7 @@ func NewWalletFromMnemonic(opts NewWalletFromMnemonicOpts) (*Wallet, error) {
 		signingMasterKey:  signingMasterKey,
 		blindingMnemonic:  blindingMnemonic,
 		blindingMasterKey: blindingMasterKey,
+		gapLimit:          gapLimit,
 	}, nil
 }
 
@@ -146,3 +172,9 @@ func (w *Wallet) BlindingMnemonic() ([]string, error) {
 	}
 	return w.blindingMnemonic, nil
 }
+
+// GapLimit returns the number of consecutive unused addresses after which an
+// account of the wallet is considered fully discovered
+func (w *Wallet) GapLimit() int {
+	return w.gapLimit
+}
@@ -71,6 +71,34 @@ func TestNewWalletFromMnemonic(t *testing.T) {
 	assert.Equal(t, *wallet, *otherWallet)
 }
 
+func TestNewWalletFromMnemonicWithCustomOpts(t *testing.T) {
+	wallet, err := newTestWallet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingMnemonic, _ := wallet.SigningMnemonic()
+
+	otherWallet, err := NewWalletFromMnemonic(NewWalletFromMnemonicOpts{
+		SigningMnemonic:    signingMnemonic,
+		BaseDerivationPath: "m/49'/1776'",
+		GapLimit:           50,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, DefaultGapLimit, wallet.GapLimit())
+	assert.Equal(t, 50, otherWallet.GapLimit())
+
+	path := "0'/0/0"
+	_, pubkey, _ := wallet.DeriveSigningKeyPair(DeriveSigningKeyPairOpts{
+		DerivationPath: path,
+	})
+	_, otherPubkey, _ := otherWallet.DeriveSigningKeyPair(
+		DeriveSigningKeyPairOpts{DerivationPath: path},
+	)
+	assert.NotEqual(t, pubkey, otherPubkey)
+}
+
 func TestFailingNewWalletFromMnemonic(t *testing.T) {
 	tests := []struct {
 		opts NewWalletFromMnemonicOpts
@@ -95,6 +123,20 @@ func TestFailingNewWalletFromMnemonic(t *testing.T) {
 			},
 			err: ErrInvalidBlindingMnemonic,
 		},
+		{
+			opts: NewWalletFromMnemonicOpts{
+				SigningMnemonic:    strings.Split("letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter always", " "),
+				BaseDerivationPath: "m//0'",
+			},
+			err: ErrInvalidBaseDerivationPath,
+		},
+		{
+			opts: NewWalletFromMnemonicOpts{
+				SigningMnemonic: strings.Split("letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter always", " "),
+				GapLimit:        -1,
+			},
+			err: ErrInvalidGapLimit,
+		},
 	}
 	for _, tt := range tests {
 		_, err := NewWalletFromMnemonic(tt.opts)
@@ -0,0 +1,166 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	// SignatureHeader is the HTTP header containing the hex encoded
+	// HMAC-SHA256 of the request body, prefixed by "sha256=". It is set only
+	// if a secret is configured.
+	SignatureHeader = "X-Tdex-Signature"
+
+	defaultMaxAttempts     = 5
+	defaultBackoffInterval = time.Second
+	defaultRequestTimeout  = 10 * time.Second
+)
+
+var (
+	// ErrInvalidEndpoint ...
+	ErrInvalidEndpoint = errors.New("webhook endpoint must be a valid http(s) url")
+	// ErrInvalidMaxAttempts ...
+	ErrInvalidMaxAttempts = errors.New("max attempts must not be a negative number")
+	// ErrInvalidBackoffInterval ...
+	ErrInvalidBackoffInterval = errors.New("backoff interval must not be a negative duration")
+)
+
+// Service is the interface for a webhook client
+type Service interface {
+	// Send posts the JSON encoding of the given payload to the configured
+	// endpoint, retrying with exponential backoff in case of failure. It blocks
+	// until the payload is delivered or all attempts are exhausted.
+	Send(payload interface{}) error
+}
+
+// Opts defines the parameters needed for creating a webhook service with
+// NewService method
+type Opts struct {
+	// Endpoint is the url where payloads are POSTed to
+	Endpoint string
+	// Secret, if not empty, is used to sign every request body
+	Secret string
+	// MaxAttempts is the max number of delivery attempts of a payload.
+	// Defaults to 5
+	MaxAttempts int
+	// BackoffInterval is the time to wait after the first failed attempt. It's
+	// doubled after every further failure. Defaults to 1 second
+	BackoffInterval time.Duration
+	// RequestTimeout is the time to wait for a response. Defaults to 10 seconds
+	RequestTimeout time.Duration
+}
+
+func (o Opts) validate() error {
+	u, err := url.Parse(o.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidEndpoint
+	}
+	if o.MaxAttempts < 0 {
+		return ErrInvalidMaxAttempts
+	}
+	if o.BackoffInterval < 0 {
+		return ErrInvalidBackoffInterval
+	}
+	return nil
+}
+
+type webhook struct {
+	endpoint        string
+	secret          []byte
+	maxAttempts     int
+	backoffInterval time.Duration
+	client          *http.Client
+}
+
+// NewService returns a webhook Service that delivers payloads to the given
+// endpoint
+func NewService(opts Opts) (Service, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	maxAttempts := opts.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	backoffInterval := opts.BackoffInterval
+	if backoffInterval == 0 {
+		backoffInterval = defaultBackoffInterval
+	}
+	requestTimeout := opts.RequestTimeout
+	if requestTimeout <= 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+
+	return &webhook{
+		endpoint:        opts.Endpoint,
+		secret:          []byte(opts.Secret),
+		maxAttempts:     maxAttempts,
+		backoffInterval: backoffInterval,
+		client:          &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+func (w *webhook) Send(payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	interval := w.backoffInterval
+	for attempt := 1; ; attempt++ {
+		err = w.post(body)
+		if err == nil {
+			return nil
+		}
+		if attempt >= w.maxAttempts {
+			return fmt.Errorf(
+				"failed to deliver payload after %d attempts: %s", attempt, err,
+			)
+		}
+		time.Sleep(interval)
+		interval *= 2
+	}
+}
+
+func (w *webhook) post(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, w.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(w.secret) > 0 {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(w.secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("endpoint replied with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the given body. Receivers can
+// use it to verify the authenticity of a request by comparing the result
+// against the value of the SignatureHeader.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
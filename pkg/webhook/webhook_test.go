@@ -0,0 +1,97 @@
+package webhook
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSend(t *testing.T) {
+	secret := "secret"
+	payload := map[string]string{"id": "trade"}
+	failures := int32(2)
+	calls := int32(0)
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) <= failures {
+				rw.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			body, _ := ioutil.ReadAll(r.Body)
+			require.Equal(t, `{"id":"trade"}`, string(body))
+			require.Equal(
+				t,
+				"sha256="+Sign([]byte(secret), body),
+				r.Header.Get(SignatureHeader),
+			)
+			rw.WriteHeader(http.StatusOK)
+		},
+	))
+	defer server.Close()
+
+	svc, err := NewService(Opts{
+		Endpoint:        server.URL,
+		Secret:          secret,
+		MaxAttempts:     3,
+		BackoffInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	err = svc.Send(payload)
+	require.NoError(t, err)
+	require.Equal(t, failures+1, atomic.LoadInt32(&calls))
+}
+
+func TestFailingSend(t *testing.T) {
+	calls := int32(0)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			require.Empty(t, r.Header.Get(SignatureHeader))
+			rw.WriteHeader(http.StatusBadGateway)
+		},
+	))
+	defer server.Close()
+
+	svc, err := NewService(Opts{
+		Endpoint:        server.URL,
+		MaxAttempts:     2,
+		BackoffInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	err = svc.Send(map[string]string{})
+	require.Error(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestFailingNewService(t *testing.T) {
+	tests := []struct {
+		opts Opts
+		err  error
+	}{
+		{
+			opts: Opts{Endpoint: "localhost:8080"},
+			err:  ErrInvalidEndpoint,
+		},
+		{
+			opts: Opts{Endpoint: "http://localhost:8080", MaxAttempts: -1},
+			err:  ErrInvalidMaxAttempts,
+		},
+		{
+			opts: Opts{Endpoint: "http://localhost:8080", BackoffInterval: -1},
+			err:  ErrInvalidBackoffInterval,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := NewService(tt.opts)
+		require.Equal(t, tt.err, err)
+	}
+}